@@ -36,23 +36,40 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/Rizz404/inventory-api/config"
 	_ "github.com/Rizz404/inventory-api/docs"
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/localstorage"
 	"github.com/Rizz404/inventory-api/internal/postgresql"
 	"github.com/Rizz404/inventory-api/internal/rest"
 	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	apiKey "github.com/Rizz404/inventory-api/services/api_key"
 	"github.com/Rizz404/inventory-api/services/asset"
+	assetDocument "github.com/Rizz404/inventory-api/services/asset_document"
+	assetLoan "github.com/Rizz404/inventory-api/services/asset_loan"
 	assetMovement "github.com/Rizz404/inventory-api/services/asset_movement"
+	auditLog "github.com/Rizz404/inventory-api/services/audit_log"
+	auditSession "github.com/Rizz404/inventory-api/services/audit_session"
 	"github.com/Rizz404/inventory-api/services/auth"
 	"github.com/Rizz404/inventory-api/services/category"
+	directorySync "github.com/Rizz404/inventory-api/services/directory_sync"
+	exportJob "github.com/Rizz404/inventory-api/services/export_job"
 	issueReport "github.com/Rizz404/inventory-api/services/issue_report"
+	"github.com/Rizz404/inventory-api/services/job"
 	"github.com/Rizz404/inventory-api/services/location"
 	maintenanceRecord "github.com/Rizz404/inventory-api/services/maintenance_record"
 	maintenanceSchedule "github.com/Rizz404/inventory-api/services/maintenance_schedule"
 	"github.com/Rizz404/inventory-api/services/notification"
+	"github.com/Rizz404/inventory-api/services/role"
+	savedFilter "github.com/Rizz404/inventory-api/services/saved_filter"
 	scanLog "github.com/Rizz404/inventory-api/services/scan_log"
+	"github.com/Rizz404/inventory-api/services/search"
+	stockItem "github.com/Rizz404/inventory-api/services/stock_item"
 	"github.com/Rizz404/inventory-api/services/user"
+	"github.com/Rizz404/inventory-api/services/webhook"
+	workOrder "github.com/Rizz404/inventory-api/services/work_order"
 	"github.com/common-nighthawk/go-figure"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/compress"
@@ -89,6 +106,12 @@ func main() {
 	}
 	defer sqlDB.Close()
 
+	// * Harus sebelum repository dipakai, query dengan context dari WithinTransaction ikut transaksinya
+	transactor, err := postgresql.NewTransactor(db)
+	if err != nil {
+		log.Fatalf("failed to initialize transactor: %v", err)
+	}
+
 	// *===================================EXTERNAL CLIENTS===================================*
 	clients := config.InitializeClients()
 
@@ -97,40 +120,156 @@ func main() {
 	categoryRepository := postgresql.NewCategoryRepository(db)
 	locationRepository := postgresql.NewLocationRepository(db)
 	assetRepository := postgresql.NewAssetRepository(db)
+	assetDocumentRepository := postgresql.NewAssetDocumentRepository(db)
 	scanLogRepository := postgresql.NewScanLogRepository(db)
 	notificationRepository := postgresql.NewNotificationRepository(db)
+	notificationPreferenceRepository := postgresql.NewNotificationPreferenceRepository(db)
 	issueReportRepository := postgresql.NewIssueReportRepository(db)
 	assetMovementRepository := postgresql.NewAssetMovementRepository(db)
 	maintenanceScheduleRepository := postgresql.NewMaintenanceScheduleRepository(db)
 	maintenanceRecordRepository := postgresql.NewMaintenanceRecordRepository(db)
+	assetLoanRepository := postgresql.NewAssetLoanRepository(db)
+	auditLogRepository := postgresql.NewAuditLogRepository(db)
+	auditSessionRepository := postgresql.NewAuditSessionRepository(db)
+	workOrderRepository := postgresql.NewWorkOrderRepository(db)
+	stockItemRepository := postgresql.NewStockItemRepository(db)
+	roleRepository := postgresql.NewRoleRepository(db)
+	userSessionRepository := postgresql.NewUserSessionRepository(db)
+	passwordResetRepository := postgresql.NewPasswordResetRepository(db)
+	twoFactorRepository := postgresql.NewTwoFactorRepository(db)
+	oidcRepository := postgresql.NewOIDCRepository(db)
+	apiKeyRepository := postgresql.NewAPIKeyRepository(db)
+	webhookRepository := postgresql.NewWebhookRepository(db)
+	jobRepository := postgresql.NewJobRepository(db)
+	searchRepository := postgresql.NewSearchRepository(db)
+	savedFilterRepository := postgresql.NewSavedFilterRepository(db)
+	exportJobRepository := postgresql.NewExportJobRepository(db)
 
 	// *===================================SERVICE===================================*
-	authService := auth.NewService(userRepository, clients.SMTP)
-	userService := user.NewService(userRepository, clients.Cloudinary)
-	notificationService := notification.NewService(notificationRepository, userRepository, clients.FCM)
-	categoryService := category.NewService(categoryRepository, notificationService, userRepository, clients.Cloudinary, clients.Translator)
-	locationService := location.NewService(locationRepository, notificationService, userRepository, clients.Translator)
-	assetService := asset.NewService(assetRepository, clients.Cloudinary, notificationService, categoryService, userRepository)
-	scanLogService := scanLog.NewService(scanLogRepository)
-	issueReportService := issueReport.NewService(issueReportRepository, notificationService, assetService, userRepository, clients.Translator)
-	assetMovementService := assetMovement.NewService(assetMovementRepository, assetService, locationService, userService, notificationService)
-	maintenanceScheduleService := maintenanceSchedule.NewService(maintenanceScheduleRepository, assetService, userService, notificationService, clients.Translator)
-	maintenanceRecordService := maintenanceRecord.NewService(maintenanceRecordRepository, assetService, userService, notificationService, clients.Translator)
+	jobService := job.NewService(jobRepository, transactor)
+	auditLogService := auditLog.NewService(auditLogRepository)
+	roleService := role.NewService(roleRepository, userRepository, auditLogService, transactor)
+	apiKeyService := apiKey.NewService(apiKeyRepository, auditLogService, transactor)
+	webhookService := webhook.NewService(webhookRepository, auditLogService, transactor)
+	userService := user.NewService(userRepository, userSessionRepository, clients.Storage, auditLogService, transactor)
+	notificationService := notification.NewService(notificationRepository, notificationPreferenceRepository, userRepository, clients.FCM, clients.SMTP, jobService)
+	categoryService := category.NewService(categoryRepository, notificationService, userRepository, clients.Storage, clients.Translator, auditLogService, jobService)
+	locationService := location.NewService(locationRepository, notificationService, userRepository, clients.Translator, auditLogService, jobService)
+	authService := auth.NewService(userRepository, userSessionRepository, passwordResetRepository, twoFactorRepository, oidcRepository, clients.SMTP, clients.OIDC, clients.LDAP, roleService, locationService, auditLogService, transactor)
+	assetService := asset.NewService(assetRepository, clients.Storage, notificationService, categoryService, userRepository, auditLogService, webhookService, jobService)
+	assetDocumentService := assetDocument.NewService(assetDocumentRepository, assetRepository, maintenanceRecordRepository, clients.Storage, auditLogService, transactor)
+	auditSessionService := auditSession.NewService(auditSessionRepository, assetService, locationService, categoryService)
+	scanLogService := scanLog.NewService(scanLogRepository, auditSessionService)
+	issueReportService := issueReport.NewService(issueReportRepository, notificationService, assetService, userRepository, clients.Translator, auditLogService, webhookService, jobService)
+	assetMovementService := assetMovement.NewService(assetMovementRepository, assetService, locationService, userService, notificationService, webhookService, jobService)
+	maintenanceScheduleService := maintenanceSchedule.NewService(maintenanceScheduleRepository, assetService, userService, notificationService, clients.Translator, auditLogService, webhookService, jobService)
+	stockItemService := stockItem.NewService(stockItemRepository, locationService, notificationService, userRepository, auditLogService, transactor)
+	maintenanceRecordService := maintenanceRecord.NewService(maintenanceRecordRepository, assetService, userService, notificationService, clients.Translator, auditLogService, stockItemService, webhookService, jobService)
+	assetLoanService := assetLoan.NewService(assetLoanRepository, assetService, locationService, userService, notificationService, webhookService, jobService)
+	workOrderService := workOrder.NewService(workOrderRepository, assetService, userService, maintenanceScheduleService, issueReportService, notificationService, auditLogService, webhookService, transactor)
+	searchService := search.NewService(searchRepository)
+	savedFilterService := savedFilter.NewService(savedFilterRepository, userRepository, roleService, locationService, savedFilter.Exporters{
+		Asset:               assetService,
+		AssetMovement:       assetMovementService,
+		IssueReport:         issueReportService,
+		MaintenanceSchedule: maintenanceScheduleService,
+		MaintenanceRecord:   maintenanceRecordService,
+		ScanLog:             scanLogService,
+		User:                userService,
+	}, clients.Storage, clients.SMTP, jobService)
+	exportJobService := exportJob.NewService(exportJobRepository, userRepository, roleService, locationService, exportJob.Sources{
+		Asset:               assetRepository,
+		AssetMovement:       assetMovementRepository,
+		IssueReport:         issueReportRepository,
+		MaintenanceSchedule: maintenanceScheduleRepository,
+		MaintenanceRecord:   maintenanceRecordRepository,
+		ScanLog:             scanLogRepository,
+		User:                userRepository,
+	}, clients.Storage, jobService)
 
 	// *===================================CRON SERVICE===================================*
-	assetCronService := asset.NewCronService(assetRepository, notificationService)
+	assetCronService := asset.NewCronService(assetRepository, assetLoanRepository, notificationService)
 	if err := assetCronService.Start(); err != nil {
 		log.Fatalf("Failed to start asset cron service: %v", err)
 	}
 	defer assetCronService.Stop()
 
+	maintenanceScheduleCronService := maintenanceSchedule.NewCronService(maintenanceScheduleRepository, maintenanceRecordRepository, workOrderRepository, assetService, notificationService)
+	if err := maintenanceScheduleCronService.Start(); err != nil {
+		log.Fatalf("Failed to start maintenance schedule cron service: %v", err)
+	}
+	defer maintenanceScheduleCronService.Stop()
+
+	notificationCronService := notification.NewCronService(notificationPreferenceRepository, jobService)
+	if err := notificationCronService.Start(); err != nil {
+		log.Fatalf("Failed to start notification cron service: %v", err)
+	}
+	defer notificationCronService.Stop()
+
+	savedFilterCronService := savedFilter.NewCronService(savedFilterRepository, jobService)
+	if err := savedFilterCronService.Start(); err != nil {
+		log.Fatalf("Failed to start report schedule cron service: %v", err)
+	}
+	defer savedFilterCronService.Stop()
+
+	exportJobCronService := exportJob.NewCronService(exportJobRepository, clients.Storage)
+	if err := exportJobCronService.Start(); err != nil {
+		log.Fatalf("Failed to start export job cleanup cron service: %v", err)
+	}
+	defer exportJobCronService.Stop()
+
+	directorySyncService := directorySync.NewService(userRepository, oidcRepository, userSessionRepository, auditLogService, transactor, clients.LDAP)
+	if err := directorySyncService.Start(); err != nil {
+		log.Fatalf("Failed to start directory sync service: %v", err)
+	}
+	defer directorySyncService.Stop()
+
+	webhookDispatcher := webhook.NewDispatcher(webhookRepository)
+	if err := webhookDispatcher.Start(); err != nil {
+		log.Fatalf("Failed to start webhook dispatcher: %v", err)
+	}
+	defer webhookDispatcher.Stop()
+
+	// * Concurrency 0 berarti hanya dibatasi JOB_WORKER_CONCURRENCY, FCM, SMTP dan Google Translate dibatasi supaya tidak kena rate limit
+	jobWorker := job.NewWorker(jobRepository)
+	jobWorker.Register(domain.JobTypeNotificationPush, 5, notificationService.HandlePushJob)
+	jobWorker.Register(domain.JobTypeNotificationEmail, 3, notificationService.HandleEmailJob)
+	jobWorker.Register(domain.JobTypeNotificationDigest, 3, notificationService.HandleDigestJob)
+	jobWorker.Register(domain.JobTypeAssetNotification, 0, assetService.HandleNotificationJob)
+	jobWorker.Register(domain.JobTypeAssetMovementNotification, 0, assetMovementService.HandleNotificationJob)
+	jobWorker.Register(domain.JobTypeAssetLoanNotification, 0, assetLoanService.HandleNotificationJob)
+	jobWorker.Register(domain.JobTypeIssueReportNotification, 0, issueReportService.HandleNotificationJob)
+	jobWorker.Register(domain.JobTypeMaintenanceScheduleNotification, 0, maintenanceScheduleService.HandleNotificationJob)
+	jobWorker.Register(domain.JobTypeMaintenanceRecordNotification, 0, maintenanceRecordService.HandleNotificationJob)
+	jobWorker.Register(domain.JobTypeCategoryTranslation, 2, categoryService.HandleTranslationJob)
+	jobWorker.Register(domain.JobTypeLocationTranslation, 2, locationService.HandleTranslationJob)
+	jobWorker.Register(domain.JobTypeIssueReportTranslation, 2, issueReportService.HandleTranslationJob)
+	jobWorker.Register(domain.JobTypeMaintenanceScheduleTranslation, 2, maintenanceScheduleService.HandleTranslationJob)
+	jobWorker.Register(domain.JobTypeMaintenanceRecordTranslation, 2, maintenanceRecordService.HandleTranslationJob)
+	jobWorker.Register(domain.JobTypeReportDelivery, 2, savedFilterService.HandleDeliveryJob)
+	jobWorker.Register(domain.JobTypeExportList, 2, exportJobService.HandleExportJob)
+	if err := jobWorker.Start(); err != nil {
+		log.Fatalf("Failed to start job worker: %v", err)
+	}
+	defer jobWorker.Stop()
+
 	// *===================================SERVER CONFIG===================================*
-	app := fiber.New(fiber.Config{
+	fiberConfig := fiber.Config{
 		AppName:       "Project Management Api",
 		BodyLimit:     10 * 1024 * 1024,
 		CaseSensitive: true,
 		// StrictRouting: true, // ! berbahaya asw
-	})
+	}
+
+	// * Di belakang reverse proxy (Caddy) c.IP() harus dari X-Forwarded-For, kalau tidak rate limit per IP jadi satu untuk semua client
+	if trustedProxies := os.Getenv("TRUSTED_PROXIES"); trustedProxies != "" {
+		fiberConfig.ProxyHeader = fiber.HeaderXForwardedFor
+		fiberConfig.EnableTrustedProxyCheck = true
+		fiberConfig.TrustedProxies = strings.Split(trustedProxies, ",")
+		fiberConfig.EnableIPValidation = true
+	}
+
+	app := fiber.New(fiberConfig)
 
 	// *===================================MIDDLEWARE===================================*
 	app.Use(recovermw.New())
@@ -220,28 +359,54 @@ func main() {
 	// Swagger documentation route
 	app.Get("/docs/*", swagger.New(swagger.Config{}))
 
+	// * File dari local storage, URL ditandatangani jadi tidak lewat API key
+	if localStorage, ok := clients.Storage.(*localstorage.Client); ok {
+		rest.NewFileHandler(app, localStorage)
+	}
+
+	// * AuthMiddleware menolak access token dari session yang sudah dicabut
+	middleware.ConfigureSessionCheck(userSessionRepository)
+
 	api := app.Group("/api")
-	v1 := api.Group("/v1", middleware.APIKeyMiddleware())
+	v1 := api.Group("/v1",
+		middleware.APIKeyMiddleware(apiKeyService),
+		middleware.RateLimitMiddleware(middleware.LoadRateLimitConfig()),
+	)
 
 	v1.Get("/", func(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusOK).JSON(fiber.Map{
 			"message":   "You are at /api/v1. Use the resource endpoints below.",
-			"resources": []string{"/api/v1/auth/login", "/api/v1/users", "/api/v1/categories", "/api/v1/locations", "/api/v1/assets", "/api/v1/notifications", "/api/v1/issue-reports", "/api/v1/asset-movements", "/api/v1/maintenance-schedules", "/api/v1/maintenance-records", "/api/v1/scan-logs"},
+			"resources": []string{"/api/v1/auth/login", "/api/v1/users", "/api/v1/categories", "/api/v1/locations", "/api/v1/assets", "/api/v1/notifications", "/api/v1/issue-reports", "/api/v1/asset-movements", "/api/v1/asset-loans", "/api/v1/audit-logs", "/api/v1/audit-sessions", "/api/v1/maintenance-schedules", "/api/v1/maintenance-records", "/api/v1/maintenance/work-orders", "/api/v1/scan-logs", "/api/v1/roles", "/api/v1/api-keys", "/api/v1/directory-sync", "/api/v1/webhooks", "/api/v1/jobs", "/api/v1/search", "/api/v1/saved-filters", "/api/v1/export-jobs"},
 			"docs":      "/docs/index.html",
 		})
 	})
 
 	rest.NewAuthHandler(v1, authService)
 	rest.NewUserHandler(v1, userService)
+	rest.NewRoleHandler(v1, roleService)
+	rest.NewAPIKeyHandler(v1, apiKeyService)
 	rest.NewCategoryHandler(v1, categoryService)
 	rest.NewLocationHandler(v1, locationService)
 	rest.NewAssetHandler(v1, assetService)
+	rest.NewAssetDocumentHandler(v1, assetDocumentService)
 	rest.NewScanLogHandler(v1, scanLogService)
 	rest.NewNotificationHandler(v1, notificationService)
 	rest.NewIssueReportHandler(v1, issueReportService)
 	rest.NewAssetMovementHandler(v1, assetMovementService)
+	rest.NewAssetLoanHandler(v1, assetLoanService)
+	rest.NewAuditLogHandler(v1, auditLogService)
+	rest.NewAuditSessionHandler(v1, auditSessionService)
 	rest.NewMaintenanceScheduleHandler(v1, maintenanceScheduleService)
 	rest.NewMaintenanceRecordHandler(v1, maintenanceRecordService)
+	rest.NewWorkOrderHandler(v1, workOrderService)
+	rest.NewStockItemHandler(v1, stockItemService)
+	rest.NewMaintenanceJobHandler(v1, maintenanceScheduleCronService)
+	rest.NewDirectorySyncHandler(v1, directorySyncService)
+	rest.NewWebhookHandler(v1, webhookService)
+	rest.NewJobHandler(v1, jobService)
+	rest.NewSearchHandler(v1, searchService)
+	rest.NewSavedFilterHandler(v1, savedFilterService)
+	rest.NewExportJobHandler(v1, exportJobService)
 
 	// *===================================SERVER===================================*
 	log.Printf("server running on http://localhost%s", addr)
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Rizz404/inventory-api/config/client"
+	"github.com/Rizz404/inventory-api/internal/client/cloudinary"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+	"github.com/joho/godotenv"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func init() {
+	if err := godotenv.Load(); err != nil {
+		log.Println("⚠️ .env file not found, using system environment variables")
+	}
+}
+
+// Target is a storage backend the files can be copied into with the same public ID
+type Target interface {
+	Put(ctx context.Context, publicID, ext string, data []byte, overwrite bool) (*storage.UploadResult, error)
+}
+
+// column is a database column that holds file URLs
+type column struct {
+	Table  string
+	Column string
+}
+
+// * Semua kolom yang menyimpan URL hasil upload
+var columns = []column{
+	{Table: "users", Column: "avatar_url"},
+	{Table: "categories", Column: "image_url"},
+	{Table: "assets", Column: "data_matrix_image_url"},
+	{Table: "images", Column: "image_url"},
+}
+
+func main() {
+	var (
+		dryRun = flag.Bool("dry-run", false, "List the files that would be migrated without copying or updating anything")
+		help   = flag.Bool("help", false, "Show help message")
+	)
+	flag.Parse()
+
+	if *help {
+		showHelp()
+		return
+	}
+
+	target, err := initTarget()
+	if err != nil {
+		log.Fatalf("Failed to initialize target storage: %v", err)
+	}
+
+	db, err := initDatabase()
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+
+	ctx := context.Background()
+	httpClient := &http.Client{Timeout: 60 * time.Second}
+
+	// * URL yang sama (misal template image dipakai banyak asset) cukup dicopy sekali
+	migrated := make(map[string]string)
+	var copied, updated, failed int
+
+	for _, col := range columns {
+		var urls []string
+		err := db.WithContext(ctx).
+			Table(col.Table).
+			Distinct(col.Column).
+			Where(col.Column+" LIKE ?", "%res.cloudinary.com%").
+			Pluck(col.Column, &urls).Error
+		if err != nil {
+			log.Fatalf("Failed to read %s.%s: %v", col.Table, col.Column, err)
+		}
+
+		fmt.Printf("%s.%s: %d Cloudinary URLs\n", col.Table, col.Column, len(urls))
+
+		for _, oldURL := range urls {
+			newURL, ok := migrated[oldURL]
+			if !ok {
+				publicID, ext := parseCloudinaryURL(oldURL)
+				if publicID == "" {
+					log.Printf("Skipping %s: cannot extract public ID", oldURL)
+					failed++
+					continue
+				}
+
+				if *dryRun {
+					fmt.Printf("  %s -> %s%s\n", oldURL, publicID, ext)
+					continue
+				}
+
+				data, err := download(ctx, httpClient, oldURL)
+				if err != nil {
+					log.Printf("Failed to download %s: %v", oldURL, err)
+					failed++
+					continue
+				}
+
+				result, err := target.Put(ctx, publicID, ext, data, true)
+				if err != nil {
+					log.Printf("Failed to store %s: %v", publicID, err)
+					failed++
+					continue
+				}
+
+				newURL = result.SecureURL
+				migrated[oldURL] = newURL
+				copied++
+			}
+
+			// * public_id di tabel images tidak berubah karena object disimpan dengan public ID yang sama
+			result := db.WithContext(ctx).
+				Table(col.Table).
+				Where(col.Column+" = ?", oldURL).
+				Update(col.Column, newURL)
+			if result.Error != nil {
+				log.Printf("Failed to update %s.%s for %s: %v", col.Table, col.Column, oldURL, result.Error)
+				failed++
+				continue
+			}
+			updated += int(result.RowsAffected)
+		}
+	}
+
+	if *dryRun {
+		fmt.Println("Dry run, nothing was copied or updated")
+		return
+	}
+
+	fmt.Printf("✅ Copied %d files, updated %d rows, %d failed\n", copied, updated, failed)
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func initTarget() (Target, error) {
+	switch driver := strings.ToLower(os.Getenv("STORAGE_DRIVER")); driver {
+	case "local":
+		return client.InitLocalStorage()
+	case "s3":
+		return client.InitS3()
+	default:
+		return nil, fmt.Errorf("STORAGE_DRIVER must be local or s3, got %q", driver)
+	}
+}
+
+func initDatabase() (*gorm.DB, error) {
+	DSN := os.Getenv("DSN")
+	if DSN == "" {
+		return nil, fmt.Errorf("DSN environment variable not set")
+	}
+
+	db, err := gorm.Open(postgres.New(postgres.Config{
+		DSN: DSN,
+	}), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open connection to the database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get generic database object: %v", err)
+	}
+
+	if err = sqlDB.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %v", err)
+	}
+
+	log.Printf("Successfully connected to database")
+	return db, nil
+}
+
+// parseCloudinaryURL returns the public ID and the lowercase extension of a Cloudinary delivery URL
+func parseCloudinaryURL(rawURL string) (string, string) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", ""
+	}
+
+	return cloudinary.ExtractPublicIDFromURL(u.Path), strings.ToLower(path.Ext(u.Path))
+}
+
+func download(ctx context.Context, httpClient *http.Client, fileURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func showHelp() {
+	fmt.Println("Storage Migration - Copy Cloudinary files to the configured storage backend")
+	fmt.Println()
+	fmt.Println("Usage:")
+	fmt.Println("  go run cmd/migrate-storage/main.go [flags]")
+	fmt.Println()
+	fmt.Println("Flags:")
+	fmt.Println("  -dry-run   List the files that would be migrated without copying or updating anything")
+	fmt.Println("  -help      Show this help message")
+	fmt.Println()
+	fmt.Println("Environment Variables Required:")
+	fmt.Println("  DSN - PostgreSQL database connection string")
+	fmt.Println("  STORAGE_DRIVER - Target backend, local or s3, plus its STORAGE_* variables")
+	fmt.Println()
+	fmt.Println("Note: Files are not deleted from Cloudinary. The command is safe to run again,")
+	fmt.Println("rows that already point to the new storage are skipped.")
+}
@@ -8,18 +8,22 @@ import (
 	"os"
 	"strings"
 
-	"github.com/Rizz404/inventory-api/internal/client/cloudinary"
+	"github.com/Rizz404/inventory-api/config/client"
 	"github.com/Rizz404/inventory-api/internal/postgresql"
 	"github.com/Rizz404/inventory-api/seeders"
 	"github.com/Rizz404/inventory-api/services/asset"
 	"github.com/Rizz404/inventory-api/services/asset_movement"
+	"github.com/Rizz404/inventory-api/services/audit_log"
 	"github.com/Rizz404/inventory-api/services/category"
 	"github.com/Rizz404/inventory-api/services/issue_report"
+	"github.com/Rizz404/inventory-api/services/job"
 	"github.com/Rizz404/inventory-api/services/location"
 	"github.com/Rizz404/inventory-api/services/maintenance_record"
 	"github.com/Rizz404/inventory-api/services/maintenance_schedule"
 	"github.com/Rizz404/inventory-api/services/notification"
+	"github.com/Rizz404/inventory-api/services/stock_item"
 	"github.com/Rizz404/inventory-api/services/user"
+	"github.com/Rizz404/inventory-api/services/webhook"
 	"github.com/joho/godotenv"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -185,16 +189,8 @@ type Services struct {
 }
 
 func initServices(db *gorm.DB) *Services {
-	// Initialize Cloudinary client (optional for seeding)
-	var cloudinaryClient *cloudinary.Client
-	cloudinaryURL := os.Getenv("CLOUDINARY_URL")
-	if cloudinaryURL != "" {
-		var err error
-		cloudinaryClient, err = cloudinary.NewClientFromURL(cloudinaryURL)
-		if err != nil {
-			log.Printf("Warning: Failed to initialize Cloudinary client: %v. Avatar URLs will be mock URLs.", err)
-		}
-	}
+	// Initialize file storage (optional for seeding)
+	fileStorage := client.InitStorage()
 
 	// Initialize repositories
 	userRepository := postgresql.NewUserRepository(db)
@@ -204,19 +200,35 @@ func initServices(db *gorm.DB) *Services {
 	assetMovementRepository := postgresql.NewAssetMovementRepository(db)
 	issueReportRepository := postgresql.NewIssueReportRepository(db)
 	notificationRepository := postgresql.NewNotificationRepository(db)
+	notificationPreferenceRepository := postgresql.NewNotificationPreferenceRepository(db)
 	maintenanceScheduleRepository := postgresql.NewMaintenanceScheduleRepository(db)
 	maintenanceRecordRepository := postgresql.NewMaintenanceRecordRepository(db)
+	auditLogRepository := postgresql.NewAuditLogRepository(db)
+	stockItemRepository := postgresql.NewStockItemRepository(db)
+	userSessionRepository := postgresql.NewUserSessionRepository(db)
+	webhookRepository := postgresql.NewWebhookRepository(db)
+	jobRepository := postgresql.NewJobRepository(db)
+
+	// Jobs are only enqueued here, the API worker processes them
+	transactor, err := postgresql.NewTransactor(db)
+	if err != nil {
+		log.Fatalf("Failed to initialize transactor: %v", err)
+	}
 
 	// Initialize services
-	userService := user.NewService(userRepository, cloudinaryClient)
-	notificationService := notification.NewService(notificationRepository, userRepository, nil)                            // nil for FCM client in seeder
-	categoryService := category.NewService(categoryRepository, notificationService, userRepository, cloudinaryClient, nil) // nil for translator in seeder
-	locationService := location.NewService(locationRepository, notificationService, userRepository, nil)
-	assetService := asset.NewService(assetRepository, cloudinaryClient, notificationService, categoryService, userRepository)
-	assetMovementService := asset_movement.NewService(assetMovementRepository, assetService, locationService, userService, notificationService)
-	issueReportService := issue_report.NewService(issueReportRepository, notificationService, assetService, userRepository, nil)
-	maintenanceScheduleService := maintenance_schedule.NewService(maintenanceScheduleRepository, assetService, userService, notificationService, nil)
-	maintenanceRecordService := maintenance_record.NewService(maintenanceRecordRepository, assetService, userService, notificationService, nil)
+	jobService := job.NewService(jobRepository, transactor)
+	auditLogService := audit_log.NewService(auditLogRepository)
+	webhookService := webhook.NewService(webhookRepository, auditLogService, transactor)
+	userService := user.NewService(userRepository, userSessionRepository, fileStorage, auditLogService, transactor)
+	notificationService := notification.NewService(notificationRepository, notificationPreferenceRepository, userRepository, nil, nil, jobService) // nil for FCM and SMTP client in seeder
+	categoryService := category.NewService(categoryRepository, notificationService, userRepository, fileStorage, nil, auditLogService, jobService) // nil for translator in seeder
+	locationService := location.NewService(locationRepository, notificationService, userRepository, nil, auditLogService, jobService)
+	assetService := asset.NewService(assetRepository, fileStorage, notificationService, categoryService, userRepository, auditLogService, webhookService, jobService)
+	assetMovementService := asset_movement.NewService(assetMovementRepository, assetService, locationService, userService, notificationService, webhookService, jobService)
+	issueReportService := issue_report.NewService(issueReportRepository, notificationService, assetService, userRepository, nil, auditLogService, webhookService, jobService)
+	maintenanceScheduleService := maintenance_schedule.NewService(maintenanceScheduleRepository, assetService, userService, notificationService, nil, auditLogService, webhookService, jobService)
+	stockItemService := stock_item.NewService(stockItemRepository, locationService, notificationService, userRepository, auditLogService, transactor)
+	maintenanceRecordService := maintenance_record.NewService(maintenanceRecordRepository, assetService, userService, notificationService, nil, auditLogService, stockItemService, webhookService, jobService)
 
 	return &Services{
 		User:                userService,
@@ -310,7 +322,7 @@ func showHelp() {
 	fmt.Println()
 	fmt.Println("Environment Variables Required:")
 	fmt.Println("  DSN - PostgreSQL database connection string")
-	fmt.Println("  CLOUDINARY_URL - Cloudinary URL (optional, for avatar uploads)")
+	fmt.Println("  STORAGE_DRIVER - File storage backend: cloudinary, local or s3 (optional, for avatar uploads)")
 	fmt.Println()
 	fmt.Println("Note: Some seed types require existing data. Make sure to seed in the correct order:")
 	fmt.Println("1. users, categories, locations (can be seeded independently)")
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"crypto/tls"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/Rizz404/inventory-api/internal/client/ldap"
+)
+
+// InitLDAP initializes the LDAP / Active Directory client used by directory sync and LDAP login
+func InitLDAP() *ldap.Client {
+	enableLDAP := os.Getenv("ENABLE_LDAP") == "true"
+	if !enableLDAP {
+		log.Printf("LDAP directory disabled via ENABLE_LDAP environment variable")
+		return nil
+	}
+
+	url := os.Getenv("LDAP_URL")
+	baseDN := os.Getenv("LDAP_BASE_DN")
+
+	if url == "" || baseDN == "" {
+		log.Printf("Warning: LDAP_URL or LDAP_BASE_DN not set. LDAP directory will be disabled.")
+		return nil
+	}
+
+	userFilter := os.Getenv("LDAP_USER_FILTER")
+	if userFilter == "" {
+		userFilter = "(&(objectClass=person)(mail=*))"
+	}
+
+	pageSize := uint32(500)
+	if pageSizeStr := os.Getenv("LDAP_PAGE_SIZE"); pageSizeStr != "" {
+		if p, err := strconv.ParseUint(pageSizeStr, 10, 32); err == nil && p > 0 {
+			pageSize = uint32(p)
+		}
+	}
+
+	timeout := 10 * time.Second
+	if timeoutStr := os.Getenv("LDAP_TIMEOUT"); timeoutStr != "" {
+		if t, err := time.ParseDuration(timeoutStr); err == nil && t > 0 {
+			timeout = t
+		}
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// ! Hanya untuk development dengan sertifikat self-signed
+		InsecureSkipVerify: os.Getenv("LDAP_TLS_INSECURE_SKIP_VERIFY") == "true",
+	}
+
+	log.Printf("LDAP client initialized successfully for %s", url)
+
+	return &ldap.Client{
+		URL:          url,
+		BindDN:       os.Getenv("LDAP_BIND_DN"),
+		BindPassword: os.Getenv("LDAP_BIND_PASSWORD"),
+		BaseDN:       baseDN,
+		UserFilter:   userFilter,
+		StartTLS:     os.Getenv("LDAP_START_TLS") == "true",
+		TLSConfig:    tlsConfig,
+		PageSize:     pageSize,
+		Timeout:      timeout,
+		Attributes: ldap.AttributeMapping{
+			ID:          envOrDefault("LDAP_ATTR_ID", "entryUUID"),
+			Username:    envOrDefault("LDAP_ATTR_USERNAME", "uid"),
+			FullName:    envOrDefault("LDAP_ATTR_FULL_NAME", "cn"),
+			Email:       envOrDefault("LDAP_ATTR_EMAIL", "mail"),
+			EmployeeID:  envOrDefault("LDAP_ATTR_EMPLOYEE_ID", "employeeNumber"),
+			PhoneNumber: envOrDefault("LDAP_ATTR_PHONE_NUMBER", "telephoneNumber"),
+		},
+	}
+}
+
+// envOrDefault returns the fallback only when the variable is unset, nilai kosong dipakai untuk mematikan mapping atribut
+func envOrDefault(key string, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
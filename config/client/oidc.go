@@ -0,0 +1,52 @@
+package client
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Rizz404/inventory-api/internal/client/oidc"
+)
+
+// InitOIDC initializes the OpenID Connect single sign-on client
+func InitOIDC() *oidc.Client {
+	enableOIDC := os.Getenv("ENABLE_OIDC") == "true"
+	if !enableOIDC {
+		log.Printf("OIDC single sign-on disabled via ENABLE_OIDC environment variable")
+		return nil
+	}
+
+	issuerURL := os.Getenv("OIDC_ISSUER_URL")
+	clientID := os.Getenv("OIDC_CLIENT_ID")
+	redirectURL := os.Getenv("OIDC_REDIRECT_URL")
+
+	if issuerURL == "" || clientID == "" || redirectURL == "" {
+		log.Printf("Warning: OIDC_ISSUER_URL, OIDC_CLIENT_ID or OIDC_REDIRECT_URL not set. OIDC single sign-on will be disabled.")
+		return nil
+	}
+
+	scopes := []string{"openid", "profile", "email"}
+	if scopesStr := os.Getenv("OIDC_SCOPES"); scopesStr != "" {
+		scopes = strings.Fields(scopesStr)
+	}
+
+	groupsClaim := os.Getenv("OIDC_GROUPS_CLAIM")
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+
+	log.Printf("OIDC client initialized successfully for %s", issuerURL)
+
+	return &oidc.Client{
+		IssuerURL: issuerURL,
+		ClientID:  clientID,
+		// * Boleh kosong untuk public client, PKCE tetap dipakai
+		ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:  redirectURL,
+		Scopes:       scopes,
+		GroupsClaim:  groupsClaim,
+		HTTPClient:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"log"
+	"os"
+	"strings"
+
+	"github.com/Rizz404/inventory-api/internal/client/localstorage"
+	"github.com/Rizz404/inventory-api/internal/client/s3"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+)
+
+// InitStorage initializes the file storage backend selected by STORAGE_DRIVER (cloudinary, local, s3)
+func InitStorage() storage.Storage {
+	driver := strings.ToLower(os.Getenv("STORAGE_DRIVER"))
+
+	switch driver {
+	case "", "cloudinary":
+		// * Return nil interface, bukan (*cloudinary.Client)(nil), supaya pengecekan nil di service tetap benar
+		if client := InitCloudinary(); client != nil {
+			return client
+		}
+		return nil
+
+	case "local":
+		client, err := InitLocalStorage()
+		if err != nil {
+			log.Printf("Warning: Failed to initialize local storage: %v. File upload will be disabled.", err)
+			return nil
+		}
+		log.Printf("Local storage initialized successfully")
+		return client
+
+	case "s3":
+		client, err := InitS3()
+		if err != nil {
+			log.Printf("Warning: Failed to initialize S3 storage: %v. File upload will be disabled.", err)
+			return nil
+		}
+		log.Printf("S3 storage initialized successfully")
+		return client
+
+	default:
+		log.Printf("Warning: Unknown STORAGE_DRIVER %q. File upload will be disabled.", driver)
+		return nil
+	}
+}
+
+// InitLocalStorage initializes the local filesystem storage client
+func InitLocalStorage() (*localstorage.Client, error) {
+	root := os.Getenv("STORAGE_LOCAL_ROOT")
+	if root == "" {
+		root = "./storage"
+	}
+
+	baseURL := os.Getenv("STORAGE_LOCAL_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:5000"
+	}
+
+	return localstorage.NewClient(root, baseURL, os.Getenv("STORAGE_SIGNING_KEY"))
+}
+
+// InitS3 initializes the S3-compatible storage client
+func InitS3() (*s3.Client, error) {
+	return s3.NewClient(s3.Config{
+		Endpoint:  os.Getenv("STORAGE_S3_ENDPOINT"),
+		Region:    os.Getenv("STORAGE_S3_REGION"),
+		Bucket:    os.Getenv("STORAGE_S3_BUCKET"),
+		AccessKey: os.Getenv("STORAGE_S3_ACCESS_KEY"),
+		SecretKey: os.Getenv("STORAGE_S3_SECRET_KEY"),
+		PublicURL: os.Getenv("STORAGE_S3_PUBLIC_URL"),
+		PathStyle: os.Getenv("STORAGE_S3_PATH_STYLE") != "false",
+	})
+}
@@ -2,26 +2,32 @@ package config
 
 import (
 	"github.com/Rizz404/inventory-api/config/client"
-	"github.com/Rizz404/inventory-api/internal/client/cloudinary"
 	"github.com/Rizz404/inventory-api/internal/client/fcm"
 	"github.com/Rizz404/inventory-api/internal/client/gtranslate"
+	"github.com/Rizz404/inventory-api/internal/client/ldap"
+	"github.com/Rizz404/inventory-api/internal/client/oidc"
 	"github.com/Rizz404/inventory-api/internal/client/smtp"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
 )
 
 // Clients holds all external service clients
 type Clients struct {
-	Cloudinary *cloudinary.Client
+	Storage    storage.Storage
 	FCM        *fcm.Client
 	SMTP       *smtp.Client
 	Translator *gtranslate.Client
+	OIDC       *oidc.Client
+	LDAP       *ldap.Client
 }
 
 // InitializeClients initializes all external service clients
 func InitializeClients() *Clients {
 	return &Clients{
-		Cloudinary: client.InitCloudinary(),
+		Storage:    client.InitStorage(),
 		FCM:        client.InitFCM(),
 		SMTP:       client.InitSMTP(),
 		Translator: client.InitGTranslate(),
+		OIDC:       client.InitOIDC(),
+		LDAP:       client.InitLDAP(),
 	}
 }
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE depreciation_method AS ENUM (
+  'StraightLine',
+  'DecliningBalance',
+  'SumOfYearsDigits'
+);
+
+ALTER TABLE categories
+ADD COLUMN depreciation_method depreciation_method NULL,
+ADD COLUMN useful_life_years INTEGER NULL CHECK (useful_life_years > 0),
+ADD COLUMN salvage_value_percent DECIMAL(5, 2) NULL CHECK (
+  salvage_value_percent >= 0
+  AND salvage_value_percent <= 100
+);
+
+COMMENT ON COLUMN categories.depreciation_method IS 'Depreciation method applied to assets in this category';
+
+COMMENT ON COLUMN categories.useful_life_years IS 'Useful life in years used for depreciation';
+
+COMMENT ON COLUMN categories.salvage_value_percent IS 'Salvage value as percentage of purchase price';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE categories
+DROP COLUMN IF EXISTS salvage_value_percent,
+DROP COLUMN IF EXISTS useful_life_years,
+DROP COLUMN IF EXISTS depreciation_method;
+
+DROP TYPE IF EXISTS depreciation_method;
+
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose Up
+CREATE TYPE asset_loan_status AS ENUM ('Active', 'Returned');
+
+CREATE TABLE asset_loans (
+  id VARCHAR(26) PRIMARY KEY,
+  asset_id VARCHAR(26) NOT NULL,
+  borrower_id VARCHAR(26) NOT NULL,
+  checked_out_by VARCHAR(26) NOT NULL,
+  checked_in_by VARCHAR(26) NULL,
+  checkout_date TIMESTAMP WITH TIME ZONE NOT NULL,
+  expected_return_date TIMESTAMP WITH TIME ZONE NOT NULL,
+  returned_date TIMESTAMP WITH TIME ZONE NULL,
+  checkout_condition asset_condition NOT NULL,
+  return_condition asset_condition NULL,
+  status asset_loan_status DEFAULT 'Active',
+  checkout_movement_id VARCHAR(26) NULL,
+  return_movement_id VARCHAR(26) NULL,
+  notes TEXT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (asset_id) REFERENCES assets(id) ON DELETE CASCADE,
+  FOREIGN KEY (borrower_id) REFERENCES users(id) ON DELETE RESTRICT,
+  FOREIGN KEY (checked_out_by) REFERENCES users(id) ON DELETE RESTRICT,
+  FOREIGN KEY (checked_in_by) REFERENCES users(id) ON DELETE
+  SET NULL,
+    FOREIGN KEY (checkout_movement_id) REFERENCES asset_movements(id) ON DELETE
+  SET NULL,
+    FOREIGN KEY (return_movement_id) REFERENCES asset_movements(id) ON DELETE
+  SET NULL
+);
+
+CREATE INDEX idx_asset_loans_asset_id ON asset_loans(asset_id);
+
+CREATE INDEX idx_asset_loans_borrower_status ON asset_loans(borrower_id, status);
+
+CREATE INDEX idx_asset_loans_status_expected_return ON asset_loans(status, expected_return_date);
+
+-- Hanya boleh ada satu pinjaman aktif per aset
+CREATE UNIQUE INDEX idx_asset_loans_active_asset ON asset_loans(asset_id)
+WHERE status = 'Active';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_asset_loans_active_asset;
+
+DROP INDEX IF EXISTS idx_asset_loans_status_expected_return;
+
+DROP INDEX IF EXISTS idx_asset_loans_borrower_status;
+
+DROP INDEX IF EXISTS idx_asset_loans_asset_id;
+
+DROP TABLE IF EXISTS asset_loans;
+
+DROP TYPE IF EXISTS asset_loan_status;
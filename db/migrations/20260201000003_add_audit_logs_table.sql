@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE audit_logs (
+  id VARCHAR(26) PRIMARY KEY,
+  entity_type VARCHAR(50) NOT NULL,
+  entity_id VARCHAR(26) NOT NULL,
+  action VARCHAR(20) NOT NULL,
+  actor_id VARCHAR(26) NULL,
+  changes JSONB NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE
+  SET NULL
+);
+
+CREATE INDEX idx_audit_logs_entity ON audit_logs(entity_type, entity_id);
+
+CREATE INDEX idx_audit_logs_actor_id ON audit_logs(actor_id);
+
+CREATE INDEX idx_audit_logs_created_at ON audit_logs(created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_audit_logs_created_at;
+
+DROP INDEX IF EXISTS idx_audit_logs_actor_id;
+
+DROP INDEX IF EXISTS idx_audit_logs_entity;
+
+DROP TABLE IF EXISTS audit_logs;
@@ -0,0 +1,103 @@
+-- +goose Up
+CREATE TYPE audit_session_status AS ENUM ('Open', 'Closed');
+
+CREATE TYPE audit_item_result AS ENUM (
+  'Pending',
+  'Found',
+  'Missing',
+  'Unexpected',
+  'WrongLocation'
+);
+
+CREATE TABLE audit_sessions (
+  id VARCHAR(26) PRIMARY KEY,
+  title VARCHAR(255) NOT NULL,
+  location_id VARCHAR(26) NULL,
+  category_id VARCHAR(26) NULL,
+  status audit_session_status DEFAULT 'Open',
+  started_by VARCHAR(26) NOT NULL,
+  started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  closed_by VARCHAR(26) NULL,
+  closed_at TIMESTAMP WITH TIME ZONE NULL,
+  notes TEXT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (location_id) REFERENCES locations(id) ON DELETE
+  SET NULL,
+    FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE
+  SET NULL,
+    FOREIGN KEY (started_by) REFERENCES users(id) ON DELETE RESTRICT,
+    FOREIGN KEY (closed_by) REFERENCES users(id) ON DELETE
+  SET NULL,
+    -- Sesi harus punya cakupan lokasi atau kategori
+    CHECK (
+      location_id IS NOT NULL
+      OR category_id IS NOT NULL
+    )
+);
+
+CREATE INDEX idx_audit_sessions_status ON audit_sessions(status);
+
+CREATE INDEX idx_audit_sessions_location_id ON audit_sessions(location_id);
+
+CREATE INDEX idx_audit_sessions_category_id ON audit_sessions(category_id);
+
+CREATE TABLE audit_session_items (
+  id VARCHAR(26) PRIMARY KEY,
+  session_id VARCHAR(26) NOT NULL,
+  asset_id VARCHAR(26) NOT NULL,
+  is_expected BOOLEAN NOT NULL DEFAULT FALSE,
+  expected_location_id VARCHAR(26) NULL,
+  result audit_item_result DEFAULT 'Pending',
+  scan_log_id VARCHAR(26) NULL,
+  scanned_by VARCHAR(26) NULL,
+  scanned_at TIMESTAMP WITH TIME ZONE NULL,
+  correction_movement_id VARCHAR(26) NULL,
+  marked_lost BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (session_id) REFERENCES audit_sessions(id) ON DELETE CASCADE,
+  FOREIGN KEY (asset_id) REFERENCES assets(id) ON DELETE CASCADE,
+  FOREIGN KEY (expected_location_id) REFERENCES locations(id) ON DELETE
+  SET NULL,
+    FOREIGN KEY (scan_log_id) REFERENCES scan_logs(id) ON DELETE
+  SET NULL,
+    FOREIGN KEY (scanned_by) REFERENCES users(id) ON DELETE
+  SET NULL,
+    FOREIGN KEY (correction_movement_id) REFERENCES asset_movements(id) ON DELETE
+  SET NULL
+);
+
+-- Satu aset hanya muncul sekali per sesi
+CREATE UNIQUE INDEX idx_audit_session_items_session_asset ON audit_session_items(session_id, asset_id);
+
+CREATE INDEX idx_audit_session_items_result ON audit_session_items(session_id, result);
+
+ALTER TABLE scan_logs
+ADD COLUMN audit_session_id VARCHAR(26) NULL REFERENCES audit_sessions(id) ON DELETE
+SET NULL;
+
+CREATE INDEX idx_scan_logs_audit_session_id ON scan_logs(audit_session_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_scan_logs_audit_session_id;
+
+ALTER TABLE scan_logs DROP COLUMN IF EXISTS audit_session_id;
+
+DROP INDEX IF EXISTS idx_audit_session_items_result;
+
+DROP INDEX IF EXISTS idx_audit_session_items_session_asset;
+
+DROP TABLE IF EXISTS audit_session_items;
+
+DROP INDEX IF EXISTS idx_audit_sessions_category_id;
+
+DROP INDEX IF EXISTS idx_audit_sessions_location_id;
+
+DROP INDEX IF EXISTS idx_audit_sessions_status;
+
+DROP TABLE IF EXISTS audit_sessions;
+
+DROP TYPE IF EXISTS audit_item_result;
+
+DROP TYPE IF EXISTS audit_session_status;
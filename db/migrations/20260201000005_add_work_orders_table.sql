@@ -0,0 +1,57 @@
+-- +goose Up
+CREATE TYPE work_order_status AS ENUM ('Open', 'Done');
+
+CREATE TABLE work_orders (
+  id VARCHAR(26) PRIMARY KEY,
+  schedule_id VARCHAR(26) NULL,
+  asset_id VARCHAR(26) NOT NULL,
+  status work_order_status DEFAULT 'Open',
+  due_date TIMESTAMP WITH TIME ZONE NOT NULL,
+  completed_by VARCHAR(26) NULL,
+  completed_at TIMESTAMP WITH TIME ZONE NULL,
+  maintenance_record_id VARCHAR(26) NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (schedule_id) REFERENCES maintenance_schedules(id) ON DELETE
+  SET NULL,
+    FOREIGN KEY (asset_id) REFERENCES assets(id) ON DELETE CASCADE,
+    FOREIGN KEY (completed_by) REFERENCES users(id) ON DELETE
+  SET NULL,
+    FOREIGN KEY (maintenance_record_id) REFERENCES maintenance_records(id) ON DELETE
+  SET NULL
+);
+
+CREATE INDEX idx_work_orders_asset_id ON work_orders(asset_id);
+
+CREATE INDEX idx_work_orders_status_due_date ON work_orders(status, due_date);
+
+-- Satu work order per kemunculan jadwal
+CREATE UNIQUE INDEX idx_work_orders_schedule_due_date ON work_orders(schedule_id, due_date)
+WHERE schedule_id IS NOT NULL;
+
+CREATE TABLE work_order_translations (
+  id VARCHAR(26) PRIMARY KEY,
+  work_order_id VARCHAR(26) NOT NULL,
+  lang_code VARCHAR(5) NOT NULL,
+  title VARCHAR(200) NOT NULL,
+  description TEXT NULL,
+  UNIQUE (work_order_id, lang_code),
+  FOREIGN KEY (work_order_id) REFERENCES work_orders(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_work_order_translations_order_lang ON work_order_translations(work_order_id, lang_code);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_work_order_translations_order_lang;
+
+DROP TABLE IF EXISTS work_order_translations;
+
+DROP INDEX IF EXISTS idx_work_orders_schedule_due_date;
+
+DROP INDEX IF EXISTS idx_work_orders_status_due_date;
+
+DROP INDEX IF EXISTS idx_work_orders_asset_id;
+
+DROP TABLE IF EXISTS work_orders;
+
+DROP TYPE IF EXISTS work_order_status;
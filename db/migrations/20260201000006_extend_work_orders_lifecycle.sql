@@ -0,0 +1,71 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- ALTER TYPE ... ADD VALUE tidak bisa dipakai di dalam transaksi yang sama
+ALTER TYPE work_order_status ADD VALUE IF NOT EXISTS 'Assigned' AFTER 'Open';
+
+ALTER TYPE work_order_status ADD VALUE IF NOT EXISTS 'In Progress' AFTER 'Assigned';
+
+ALTER TYPE work_order_status ADD VALUE IF NOT EXISTS 'On Hold' AFTER 'In Progress';
+
+ALTER TYPE work_order_status ADD VALUE IF NOT EXISTS 'Cancelled' AFTER 'Done';
+
+CREATE TYPE work_order_priority AS ENUM ('Low', 'Medium', 'High', 'Critical');
+
+ALTER TABLE work_orders
+ADD COLUMN issue_report_id VARCHAR(26) NULL,
+  ADD COLUMN priority work_order_priority DEFAULT 'Medium',
+  ADD COLUMN assigned_to VARCHAR(26) NULL,
+  ADD COLUMN assigned_at TIMESTAMP WITH TIME ZONE NULL,
+  ADD COLUMN started_at TIMESTAMP WITH TIME ZONE NULL,
+  ADD COLUMN cancelled_at TIMESTAMP WITH TIME ZONE NULL,
+  ADD COLUMN created_by VARCHAR(26) NULL,
+  ADD CONSTRAINT fk_work_orders_issue_report FOREIGN KEY (issue_report_id) REFERENCES issue_reports(id) ON DELETE
+SET NULL,
+  ADD CONSTRAINT fk_work_orders_assigned_to FOREIGN KEY (assigned_to) REFERENCES users(id) ON DELETE
+SET NULL,
+  ADD CONSTRAINT fk_work_orders_created_by FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE
+SET NULL;
+
+CREATE INDEX idx_work_orders_issue_report_id ON work_orders(issue_report_id);
+
+CREATE INDEX idx_work_orders_assigned_to_status ON work_orders(assigned_to, status);
+
+CREATE TABLE work_order_parts (
+  id VARCHAR(26) PRIMARY KEY,
+  work_order_id VARCHAR(26) NOT NULL,
+  part_name VARCHAR(150) NOT NULL,
+  part_number VARCHAR(100) NULL,
+  quantity INT NOT NULL DEFAULT 1 CHECK (quantity > 0),
+  unit_cost DECIMAL(12, 2) NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (work_order_id) REFERENCES work_orders(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_work_order_parts_work_order_id ON work_order_parts(work_order_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_work_order_parts_work_order_id;
+
+DROP TABLE IF EXISTS work_order_parts;
+
+DROP INDEX IF EXISTS idx_work_orders_assigned_to_status;
+
+DROP INDEX IF EXISTS idx_work_orders_issue_report_id;
+
+ALTER TABLE work_orders DROP CONSTRAINT IF EXISTS fk_work_orders_created_by,
+  DROP CONSTRAINT IF EXISTS fk_work_orders_assigned_to,
+  DROP CONSTRAINT IF EXISTS fk_work_orders_issue_report,
+  DROP COLUMN IF EXISTS created_by,
+  DROP COLUMN IF EXISTS cancelled_at,
+  DROP COLUMN IF EXISTS started_at,
+  DROP COLUMN IF EXISTS assigned_at,
+  DROP COLUMN IF EXISTS assigned_to,
+  DROP COLUMN IF EXISTS priority,
+  DROP COLUMN IF EXISTS issue_report_id;
+
+DROP TYPE IF EXISTS work_order_priority;
+
+-- Nilai enum tidak bisa dihapus, kembalikan work order yang masih berjalan ke status awal
+UPDATE work_orders
+SET status = 'Open'
+WHERE status IN ('Assigned', 'In Progress', 'On Hold');
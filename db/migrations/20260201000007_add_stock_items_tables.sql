@@ -0,0 +1,81 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- ALTER TYPE ... ADD VALUE tidak bisa dipakai di dalam transaksi yang sama
+ALTER TYPE notification_type ADD VALUE IF NOT EXISTS 'LOW_STOCK';
+
+CREATE TYPE stock_transaction_type AS ENUM ('Receive', 'Issue', 'Transfer', 'Adjust');
+
+CREATE TABLE stock_items (
+  id VARCHAR(26) PRIMARY KEY,
+  sku VARCHAR(50) UNIQUE NOT NULL,
+  item_name VARCHAR(150) NOT NULL,
+  description TEXT NULL,
+  unit VARCHAR(20) NOT NULL,
+  reorder_point INT NOT NULL DEFAULT 0 CHECK (reorder_point >= 0),
+  unit_cost DECIMAL(12, 2) NULL,
+  is_active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_stock_items_is_active ON stock_items(is_active);
+
+CREATE TABLE stock_levels (
+  id VARCHAR(26) PRIMARY KEY,
+  item_id VARCHAR(26) NOT NULL,
+  location_id VARCHAR(26) NOT NULL,
+  quantity INT NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (item_id) REFERENCES stock_items(id) ON DELETE CASCADE,
+  FOREIGN KEY (location_id) REFERENCES locations(id) ON DELETE RESTRICT,
+  UNIQUE (item_id, location_id)
+);
+
+CREATE INDEX idx_stock_levels_location_id ON stock_levels(location_id);
+
+-- Quantity selalu positif, arah pergerakan ditentukan oleh from/to location
+CREATE TABLE stock_transactions (
+  id VARCHAR(26) PRIMARY KEY,
+  item_id VARCHAR(26) NOT NULL,
+  type stock_transaction_type NOT NULL,
+  quantity INT NOT NULL CHECK (quantity > 0),
+  from_location_id VARCHAR(26) NULL,
+  to_location_id VARCHAR(26) NULL,
+  unit_cost DECIMAL(12, 2) NULL,
+  maintenance_record_id VARCHAR(26) NULL,
+  performed_by VARCHAR(26) NULL,
+  notes TEXT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (item_id) REFERENCES stock_items(id) ON DELETE RESTRICT,
+  FOREIGN KEY (from_location_id) REFERENCES locations(id) ON DELETE SET NULL,
+  FOREIGN KEY (to_location_id) REFERENCES locations(id) ON DELETE SET NULL,
+  FOREIGN KEY (maintenance_record_id) REFERENCES maintenance_records(id) ON DELETE SET NULL,
+  FOREIGN KEY (performed_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_stock_transactions_item_id_created_at ON stock_transactions(item_id, created_at);
+
+CREATE INDEX idx_stock_transactions_maintenance_record_id ON stock_transactions(maintenance_record_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_stock_transactions_maintenance_record_id;
+
+DROP INDEX IF EXISTS idx_stock_transactions_item_id_created_at;
+
+DROP TABLE IF EXISTS stock_transactions;
+
+DROP INDEX IF EXISTS idx_stock_levels_location_id;
+
+DROP TABLE IF EXISTS stock_levels;
+
+DROP INDEX IF EXISTS idx_stock_items_is_active;
+
+DROP TABLE IF EXISTS stock_items;
+
+DROP TYPE IF EXISTS stock_transaction_type;
+
+-- Nilai enum tidak bisa dihapus, pindahkan notifikasi stok ke tipe umum
+UPDATE notifications
+SET type = 'MAINTENANCE'
+WHERE type = 'LOW_STOCK';
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE categories
+ADD COLUMN attributes JSONB NULL;
+
+COMMENT ON COLUMN categories.attributes IS 'Typed custom attribute schema for assets in this category';
+
+ALTER TABLE assets
+ADD COLUMN custom_attributes JSONB NULL;
+
+COMMENT ON COLUMN assets.custom_attributes IS 'Custom attribute values validated against the category attribute schema';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE assets DROP COLUMN IF EXISTS custom_attributes;
+
+ALTER TABLE categories DROP COLUMN IF EXISTS attributes;
+
+-- +goose StatementEnd
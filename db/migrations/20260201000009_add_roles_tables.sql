@@ -0,0 +1,75 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE roles (
+  id VARCHAR(26) PRIMARY KEY,
+  name VARCHAR(50) UNIQUE NOT NULL,
+  description VARCHAR(255) NULL,
+  is_system BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE role_permissions (
+  role_id VARCHAR(26) NOT NULL,
+  permission VARCHAR(100) NOT NULL,
+  PRIMARY KEY (role_id, permission),
+  FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE
+);
+
+-- Role tambahan per user, base role dari users.role tetap berlaku
+CREATE TABLE user_roles (
+  user_id VARCHAR(26) NOT NULL,
+  role_id VARCHAR(26) NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id, role_id),
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_roles_role_id ON user_roles(role_id);
+
+-- System role namanya sama dengan enum user_role, permission awal mengikuti AuthorizeRole sebelumnya
+INSERT INTO roles (id, name, description, is_system) VALUES
+  ('00000000000000000000000001', 'Admin', 'Full access to every resource', TRUE),
+  ('00000000000000000000000002', 'Staff', 'Manage assets, movements, loans, maintenance and stock', TRUE),
+  ('00000000000000000000000003', 'Employee', 'Read access and personal actions only', TRUE);
+
+INSERT INTO role_permissions (role_id, permission) VALUES
+  ('00000000000000000000000001', '*'),
+  ('00000000000000000000000002', 'asset:create'),
+  ('00000000000000000000000002', 'asset:update'),
+  ('00000000000000000000000002', 'asset:delete'),
+  ('00000000000000000000000002', 'asset:export'),
+  ('00000000000000000000000002', 'asset_loan:manage'),
+  ('00000000000000000000000002', 'asset_movement:create'),
+  ('00000000000000000000000002', 'asset_movement:update'),
+  ('00000000000000000000000002', 'asset_movement:delete'),
+  ('00000000000000000000000002', 'asset_movement:export'),
+  ('00000000000000000000000002', 'audit_log:history'),
+  ('00000000000000000000000002', 'audit_session:manage'),
+  ('00000000000000000000000002', 'audit_session:export'),
+  ('00000000000000000000000002', 'issue_report:delete'),
+  ('00000000000000000000000002', 'maintenance_record:create'),
+  ('00000000000000000000000002', 'maintenance_record:update'),
+  ('00000000000000000000000002', 'maintenance_record:delete'),
+  ('00000000000000000000000002', 'maintenance_schedule:create'),
+  ('00000000000000000000000002', 'maintenance_schedule:update'),
+  ('00000000000000000000000002', 'maintenance_schedule:delete'),
+  ('00000000000000000000000002', 'stock_item:create'),
+  ('00000000000000000000000002', 'stock_item:update'),
+  ('00000000000000000000000002', 'stock_item:transact'),
+  ('00000000000000000000000002', 'work_order:create'),
+  ('00000000000000000000000002', 'work_order:update'),
+  ('00000000000000000000000002', 'work_order:assign'),
+  ('00000000000000000000000002', 'work_order:delete');
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_roles;
+
+DROP TABLE IF EXISTS role_permissions;
+
+DROP TABLE IF EXISTS roles;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- User hanya bisa akses data di lokasi yang punya baris di sini, tanpa baris berarti tidak punya akses.
+-- Akses semua lokasi harus eksplisit lewat permission location:all (role Admin lewat "*")
+CREATE TABLE user_locations (
+  user_id VARCHAR(26) NOT NULL,
+  location_id VARCHAR(26) NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id, location_id),
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (location_id) REFERENCES locations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_locations_location_id ON user_locations(location_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_locations;
+
+-- +goose StatementEnd
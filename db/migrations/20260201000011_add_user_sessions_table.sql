@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Satu baris per login (device), current_token_id dirotasi setiap refresh untuk deteksi reuse refresh token
+CREATE TABLE user_sessions (
+  id VARCHAR(26) PRIMARY KEY,
+  user_id VARCHAR(26) NOT NULL,
+  current_token_id VARCHAR(26) NOT NULL,
+  device_name VARCHAR(100) NULL,
+  user_agent VARCHAR(255) NULL,
+  ip_address VARCHAR(45) NULL,
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  last_used_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  revoked_at TIMESTAMP WITH TIME ZONE NULL,
+  revoked_reason VARCHAR(30) NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_sessions_user_id ON user_sessions(user_id);
+
+CREATE INDEX idx_user_sessions_active ON user_sessions(user_id, expires_at)
+WHERE
+  revoked_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_sessions;
+
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Kode disimpan dalam bentuk hash, baris lama tetap disimpan untuk throttling per email dan per IP
+-- Request untuk email yang tidak terdaftar tetap dicatat tanpa user_id dan langsung dianggap terpakai
+CREATE TABLE password_reset_codes (
+  id VARCHAR(26) PRIMARY KEY,
+  user_id VARCHAR(26) NULL,
+  email VARCHAR(255) NOT NULL,
+  code_hash VARCHAR(255) NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  requested_ip VARCHAR(45) NULL,
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  used_at TIMESTAMP WITH TIME ZONE NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_password_reset_codes_email_created_at ON password_reset_codes(email, created_at);
+
+CREATE INDEX idx_password_reset_codes_requested_ip_created_at ON password_reset_codes(requested_ip, created_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS password_reset_codes;
+
+-- +goose StatementEnd
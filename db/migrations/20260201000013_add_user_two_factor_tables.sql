@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- enabled_at NULL berarti enrolment belum dikonfirmasi dengan kode TOTP pertama
+CREATE TABLE user_two_factors (
+  user_id VARCHAR(26) PRIMARY KEY,
+  secret VARCHAR(64) NOT NULL,
+  enabled_at TIMESTAMP WITH TIME ZONE NULL,
+  -- Time step TOTP terakhir yang diterima, kode dengan step sama atau lebih lama ditolak (anti replay)
+  last_used_step BIGINT NOT NULL DEFAULT 0,
+  failed_attempts INTEGER NOT NULL DEFAULT 0,
+  locked_until TIMESTAMP WITH TIME ZONE NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE user_recovery_codes (
+  id VARCHAR(26) PRIMARY KEY,
+  user_id VARCHAR(26) NOT NULL,
+  code_hash VARCHAR(64) NOT NULL,
+  used_at TIMESTAMP WITH TIME ZONE NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_recovery_codes_user_id ON user_recovery_codes(user_id, code_hash);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_recovery_codes;
+
+DROP TABLE IF EXISTS user_two_factors;
+
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Satu key per client integrasi, key asli hanya ditampilkan sekali saat dibuat atau dirotasi
+CREATE TABLE api_keys (
+  id VARCHAR(26) PRIMARY KEY,
+  name VARCHAR(100) UNIQUE NOT NULL,
+  description VARCHAR(255) NULL,
+  key_prefix VARCHAR(16) NOT NULL,
+  key_hash VARCHAR(64) UNIQUE NOT NULL,
+  scopes JSONB NOT NULL DEFAULT '[]',
+  expires_at TIMESTAMP WITH TIME ZONE NULL,
+  last_used_at TIMESTAMP WITH TIME ZONE NULL,
+  last_used_ip VARCHAR(45) NULL,
+  usage_count BIGINT NOT NULL DEFAULT 0,
+  revoked_at TIMESTAMP WITH TIME ZONE NULL,
+  created_by VARCHAR(26) NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS api_keys;
+
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Dihitung ulang dari nol setiap login berhasil atau setelah akun dikunci
+ALTER TABLE users
+ADD COLUMN failed_login_attempts INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE users
+ADD COLUMN locked_until TIMESTAMP WITH TIME ZONE NULL;
+
+CREATE INDEX idx_users_locked_until ON users(locked_until) WHERE locked_until IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_users_locked_until;
+
+ALTER TABLE users DROP COLUMN IF EXISTS locked_until;
+
+ALTER TABLE users DROP COLUMN IF EXISTS failed_login_attempts;
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Login SSO yang sedang berjalan, state dan PKCE verifier tidak pernah keluar dari server
+CREATE TABLE oidc_auth_requests (
+  id VARCHAR(26) PRIMARY KEY,
+  state_hash VARCHAR(64) NOT NULL UNIQUE,
+  code_verifier VARCHAR(128) NOT NULL,
+  nonce VARCHAR(64) NOT NULL,
+  device_name VARCHAR(100) NULL,
+  requested_ip VARCHAR(45) NULL,
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  consumed_at TIMESTAMP WITH TIME ZONE NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_oidc_auth_requests_expires_at ON oidc_auth_requests(expires_at);
+
+-- Akun IdP yang terhubung ke user, satu subject hanya boleh terhubung ke satu user
+CREATE TABLE user_identities (
+  id VARCHAR(26) PRIMARY KEY,
+  user_id VARCHAR(26) NOT NULL,
+  issuer VARCHAR(255) NOT NULL,
+  subject VARCHAR(255) NOT NULL,
+  email VARCHAR(255) NULL,
+  last_login_at TIMESTAMP WITH TIME ZONE NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+  UNIQUE (issuer, subject)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities(user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_identities;
+
+DROP TABLE IF EXISTS oidc_auth_requests;
+
+-- +goose StatementEnd
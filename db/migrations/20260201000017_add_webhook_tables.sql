@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Endpoint milik sistem eksternal (helpdesk, ERP), secret dipakai untuk HMAC signature jadi disimpan apa adanya
+CREATE TABLE webhook_endpoints (
+  id VARCHAR(26) PRIMARY KEY,
+  name VARCHAR(100) UNIQUE NOT NULL,
+  url VARCHAR(500) NOT NULL,
+  description VARCHAR(255) NULL,
+  secret VARCHAR(100) NOT NULL,
+  event_types JSONB NOT NULL DEFAULT '[]',
+  is_active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_by VARCHAR(26) NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Outbox sekaligus log pengiriman, satu baris per event per endpoint
+CREATE TABLE webhook_deliveries (
+  id VARCHAR(26) PRIMARY KEY,
+  endpoint_id VARCHAR(26) NOT NULL,
+  event_id VARCHAR(26) NOT NULL,
+  event_type VARCHAR(50) NOT NULL,
+  payload JSONB NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMP WITH TIME ZONE NULL,
+  locked_until TIMESTAMP WITH TIME ZONE NULL,
+  last_attempt_at TIMESTAMP WITH TIME ZONE NULL,
+  response_status INTEGER NULL,
+  response_body TEXT NULL,
+  error TEXT NULL,
+  duration_ms BIGINT NULL,
+  delivered_at TIMESTAMP WITH TIME ZONE NULL,
+  redelivery_of VARCHAR(26) NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (endpoint_id) REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+  FOREIGN KEY (redelivery_of) REFERENCES webhook_deliveries(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_endpoint ON webhook_deliveries(endpoint_id, id);
+CREATE INDEX idx_webhook_deliveries_event ON webhook_deliveries(event_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP TABLE IF EXISTS webhook_endpoints;
+
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Antrean background job, di-enqueue dalam transaksi yang sama dengan mutasi datanya
+CREATE TABLE jobs (
+  id VARCHAR(26) PRIMARY KEY,
+  type VARCHAR(100) NOT NULL,
+  payload JSONB NOT NULL DEFAULT '{}',
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'succeeded', 'dead')),
+  attempts INTEGER NOT NULL DEFAULT 0,
+  max_attempts INTEGER NOT NULL DEFAULT 5,
+  run_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  locked_by VARCHAR(100) NULL,
+  locked_until TIMESTAMP WITH TIME ZONE NULL,
+  last_error TEXT NULL,
+  completed_at TIMESTAMP WITH TIME ZONE NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_jobs_due ON jobs(run_at) WHERE status IN ('pending', 'running');
+CREATE INDEX idx_jobs_status_type ON jobs(status, type);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS jobs;
+
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Channel notifikasi per user per tipe, tipe tanpa baris memakai default (semua channel aktif)
+CREATE TABLE notification_preferences (
+  user_id VARCHAR(26) NOT NULL,
+  type notification_type NOT NULL,
+  in_app BOOLEAN NOT NULL DEFAULT TRUE,
+  push BOOLEAN NOT NULL DEFAULT TRUE,
+  email BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id, type),
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- email_digest TRUE berarti email notifikasi tidak dikirim satu per satu, tapi digabung jadi satu email harian
+CREATE TABLE user_notification_settings (
+  user_id VARCHAR(26) PRIMARY KEY,
+  email_digest BOOLEAN NOT NULL DEFAULT FALSE,
+  last_digest_sent_at TIMESTAMP WITH TIME ZONE NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_notification_settings_email_digest ON user_notification_settings(email_digest) WHERE email_digest = TRUE;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_notification_settings;
+DROP TABLE IF EXISTS notification_preferences;
+
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE asset_document_type AS ENUM (
+  'Invoice',
+  'PurchaseOrder',
+  'Manual',
+  'WarrantyCertificate',
+  'DisposalCertificate',
+  'Other'
+);
+
+-- Dokumen milik asset, maintenance_record_id diisi kalau dokumen berasal dari maintenance asset tersebut
+CREATE TABLE asset_documents (
+  id VARCHAR(26) PRIMARY KEY,
+  asset_id VARCHAR(26) NOT NULL,
+  maintenance_record_id VARCHAR(26) NULL,
+  type asset_document_type NOT NULL,
+  title VARCHAR(200) NOT NULL,
+  document_number VARCHAR(100) NULL,
+  issued_at DATE NULL,
+  expires_at DATE NULL,
+  notes TEXT NULL,
+  file_url TEXT NOT NULL,
+  public_id VARCHAR(255) NOT NULL,
+  file_name VARCHAR(255) NOT NULL,
+  mime_type VARCHAR(100) NULL,
+  file_size BIGINT NOT NULL DEFAULT 0,
+  uploaded_by VARCHAR(26) NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (asset_id) REFERENCES assets(id) ON DELETE CASCADE,
+  FOREIGN KEY (maintenance_record_id) REFERENCES maintenance_records(id) ON DELETE SET NULL,
+  FOREIGN KEY (uploaded_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_asset_documents_asset_id ON asset_documents(asset_id, type);
+CREATE INDEX idx_asset_documents_maintenance_record_id ON asset_documents(maintenance_record_id) WHERE maintenance_record_id IS NOT NULL;
+CREATE INDEX idx_asset_documents_expires_at ON asset_documents(expires_at) WHERE expires_at IS NOT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS asset_documents;
+
+DROP TYPE IF EXISTS asset_document_type;
+
+-- +goose StatementEnd
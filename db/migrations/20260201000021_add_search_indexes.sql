@@ -0,0 +1,142 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Config 'simple' karena isi data campur id-ID, en-US dan ja-JP, jadi tanpa stemming dan stopword
+-- Search vector asset ikut berisi nama category dan location semua bahasa, jadi tidak bisa generated column dan diisi lewat trigger
+ALTER TABLE assets ADD COLUMN search_vector tsvector;
+
+CREATE OR REPLACE FUNCTION asset_search_vector(a assets) RETURNS tsvector AS $$
+  SELECT
+    setweight(to_tsvector('simple', coalesce(a.asset_tag, '') || ' ' || coalesce(a.serial_number, '') || ' ' || coalesce(a.asset_name, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(a.brand, '') || ' ' || coalesce(a.model, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce((SELECT string_agg(ct.category_name, ' ') FROM category_translations ct WHERE ct.category_id = a.category_id), '')), 'C') ||
+    setweight(to_tsvector('simple', coalesce((SELECT string_agg(lt.location_name, ' ') FROM location_translations lt WHERE lt.location_id = a.location_id), '')), 'C') ||
+    setweight(to_tsvector('simple', coalesce((SELECT string_agg(ca.value, ' ') FROM jsonb_each_text(a.custom_attributes) ca), '')), 'D')
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION assets_search_vector_trigger() RETURNS trigger AS $$
+BEGIN
+  NEW.search_vector := asset_search_vector(NEW);
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER assets_search_vector_update
+BEFORE INSERT OR UPDATE OF asset_tag, asset_name, brand, model, serial_number, category_id, location_id, custom_attributes ON assets
+FOR EACH ROW EXECUTE FUNCTION assets_search_vector_trigger();
+
+-- Nama category atau location berubah, search vector asset yang memakainya ikut dihitung ulang
+CREATE OR REPLACE FUNCTION category_translations_search_vector_trigger() RETURNS trigger AS $$
+DECLARE
+  target_category_id VARCHAR(26);
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    target_category_id := OLD.category_id;
+  ELSE
+    target_category_id := NEW.category_id;
+  END IF;
+
+  UPDATE assets a SET search_vector = asset_search_vector(a) WHERE a.category_id = target_category_id;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER category_translations_search_vector_update
+AFTER INSERT OR UPDATE OF category_name OR DELETE ON category_translations
+FOR EACH ROW EXECUTE FUNCTION category_translations_search_vector_trigger();
+
+CREATE OR REPLACE FUNCTION location_translations_search_vector_trigger() RETURNS trigger AS $$
+DECLARE
+  target_location_id VARCHAR(26);
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    target_location_id := OLD.location_id;
+  ELSE
+    target_location_id := NEW.location_id;
+  END IF;
+
+  UPDATE assets a SET search_vector = asset_search_vector(a) WHERE a.location_id = target_location_id;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER location_translations_search_vector_update
+AFTER INSERT OR UPDATE OF location_name OR DELETE ON location_translations
+FOR EACH ROW EXECUTE FUNCTION location_translations_search_vector_trigger();
+
+UPDATE assets a SET search_vector = asset_search_vector(a);
+
+CREATE INDEX idx_assets_search_vector ON assets USING GIN (search_vector);
+
+-- Trigram untuk typo dan substring (ILIKE) di tag, serial number dan nama asset
+CREATE INDEX idx_assets_asset_tag_trgm ON assets USING GIN (asset_tag gin_trgm_ops);
+CREATE INDEX idx_assets_serial_number_trgm ON assets USING GIN (serial_number gin_trgm_ops);
+CREATE INDEX idx_assets_asset_name_trgm ON assets USING GIN (asset_name gin_trgm_ops);
+
+-- Entity lain cukup generated column karena semua field yang dicari ada di satu tabel
+ALTER TABLE users ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce(full_name, '') || ' ' || coalesce(name, '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce(employee_id, '') || ' ' || coalesce(email, '')), 'B')
+) STORED;
+
+ALTER TABLE category_translations ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce(category_name, '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce(description, '')), 'C')
+) STORED;
+
+ALTER TABLE location_translations ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce(location_name, '')), 'A')
+) STORED;
+
+ALTER TABLE issue_report_translations ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce(description, '')), 'B') ||
+  setweight(to_tsvector('simple', coalesce(resolution_notes, '')), 'C')
+) STORED;
+
+ALTER TABLE maintenance_record_translations ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+  setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+  setweight(to_tsvector('simple', coalesce(notes, '')), 'B')
+) STORED;
+
+CREATE INDEX idx_users_search_vector ON users USING GIN (search_vector);
+CREATE INDEX idx_category_translations_search_vector ON category_translations USING GIN (search_vector);
+CREATE INDEX idx_location_translations_search_vector ON location_translations USING GIN (search_vector);
+CREATE INDEX idx_issue_report_translations_search_vector ON issue_report_translations USING GIN (search_vector);
+CREATE INDEX idx_maintenance_record_translations_search_vector ON maintenance_record_translations USING GIN (search_vector);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_maintenance_record_translations_search_vector;
+DROP INDEX IF EXISTS idx_issue_report_translations_search_vector;
+DROP INDEX IF EXISTS idx_location_translations_search_vector;
+DROP INDEX IF EXISTS idx_category_translations_search_vector;
+DROP INDEX IF EXISTS idx_users_search_vector;
+
+ALTER TABLE maintenance_record_translations DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE issue_report_translations DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE location_translations DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE category_translations DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE users DROP COLUMN IF EXISTS search_vector;
+
+DROP INDEX IF EXISTS idx_assets_asset_name_trgm;
+DROP INDEX IF EXISTS idx_assets_serial_number_trgm;
+DROP INDEX IF EXISTS idx_assets_asset_tag_trgm;
+DROP INDEX IF EXISTS idx_assets_search_vector;
+
+DROP TRIGGER IF EXISTS location_translations_search_vector_update ON location_translations;
+DROP TRIGGER IF EXISTS category_translations_search_vector_update ON category_translations;
+DROP TRIGGER IF EXISTS assets_search_vector_update ON assets;
+
+DROP FUNCTION IF EXISTS location_translations_search_vector_trigger();
+DROP FUNCTION IF EXISTS category_translations_search_vector_trigger();
+DROP FUNCTION IF EXISTS assets_search_vector_trigger();
+DROP FUNCTION IF EXISTS asset_search_vector(assets);
+
+ALTER TABLE assets DROP COLUMN IF EXISTS search_vector;
+
+DROP EXTENSION IF EXISTS pg_trgm;
+
+-- +goose StatementEnd
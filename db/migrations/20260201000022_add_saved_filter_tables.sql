@@ -0,0 +1,72 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Preset filter milik user per entity, isi search_query, filters dan sort sama dengan body endpoint export list
+CREATE TABLE saved_filters (
+  id VARCHAR(26) PRIMARY KEY,
+  user_id VARCHAR(26) NOT NULL,
+  entity_type VARCHAR(30) NOT NULL CHECK (entity_type IN ('asset', 'asset_movement', 'issue_report', 'maintenance_schedule', 'maintenance_record', 'scan_log', 'user')),
+  name VARCHAR(100) NOT NULL,
+  search_query VARCHAR(255) NULL,
+  filters JSONB NULL,
+  sort JSONB NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+  UNIQUE (user_id, entity_type, name)
+);
+
+-- Jadwal kirim report, satu per saved filter. next_run_at NULL berarti jadwal tidak aktif
+CREATE TABLE report_schedules (
+  id VARCHAR(26) PRIMARY KEY,
+  saved_filter_id VARCHAR(26) UNIQUE NOT NULL,
+  cron_expression VARCHAR(100) NOT NULL,
+  timezone VARCHAR(50) NOT NULL DEFAULT 'UTC',
+  format VARCHAR(10) NOT NULL CHECK (format IN ('pdf', 'excel')),
+  recipients JSONB NOT NULL DEFAULT '[]',
+  lang_code VARCHAR(10) NOT NULL DEFAULT 'en-US',
+  is_active BOOLEAN NOT NULL DEFAULT TRUE,
+  next_run_at TIMESTAMP WITH TIME ZONE NULL,
+  last_run_at TIMESTAMP WITH TIME ZONE NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (saved_filter_id) REFERENCES saved_filters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_report_schedules_due ON report_schedules(next_run_at) WHERE is_active = TRUE;
+
+-- Riwayat eksekusi report (terjadwal atau manual) beserta file hasil export
+CREATE TABLE report_runs (
+  id VARCHAR(26) PRIMARY KEY,
+  saved_filter_id VARCHAR(26) NOT NULL,
+  trigger_type VARCHAR(20) NOT NULL CHECK (trigger_type IN ('scheduled', 'manual')),
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'succeeded', 'failed')),
+  format VARCHAR(10) NOT NULL CHECK (format IN ('pdf', 'excel')),
+  recipients JSONB NOT NULL DEFAULT '[]',
+  lang_code VARCHAR(10) NOT NULL DEFAULT 'en-US',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  file_name VARCHAR(255) NULL,
+  file_url TEXT NULL,
+  public_id VARCHAR(255) NULL,
+  file_size BIGINT NULL,
+  error TEXT NULL,
+  started_at TIMESTAMP WITH TIME ZONE NULL,
+  finished_at TIMESTAMP WITH TIME ZONE NULL,
+  created_by VARCHAR(26) NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (saved_filter_id) REFERENCES saved_filters(id) ON DELETE CASCADE,
+  FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_report_runs_saved_filter ON report_runs(saved_filter_id, id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS report_runs;
+
+DROP TABLE IF EXISTS report_schedules;
+
+DROP TABLE IF EXISTS saved_filters;
+
+-- +goose StatementEnd
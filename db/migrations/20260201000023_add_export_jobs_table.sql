@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Export list yang diproses di background per batch, file hasilnya disimpan sampai expires_at lalu dihapus cleanup
+CREATE TABLE export_jobs (
+  id VARCHAR(26) PRIMARY KEY,
+  user_id VARCHAR(26) NOT NULL,
+  entity_type VARCHAR(30) NOT NULL CHECK (entity_type IN ('asset', 'asset_movement', 'issue_report', 'maintenance_schedule', 'maintenance_record', 'scan_log', 'user')),
+  format VARCHAR(10) NOT NULL CHECK (format IN ('pdf', 'excel')),
+  search_query VARCHAR(255) NULL,
+  filters JSONB NULL,
+  sort JSONB NULL,
+  lang_code VARCHAR(10) NOT NULL DEFAULT 'en-US',
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'succeeded', 'failed', 'expired')),
+  total_rows INTEGER NULL,
+  processed_rows INTEGER NOT NULL DEFAULT 0,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  file_name VARCHAR(255) NULL,
+  file_url TEXT NULL,
+  public_id VARCHAR(255) NULL,
+  file_size BIGINT NULL,
+  error TEXT NULL,
+  started_at TIMESTAMP WITH TIME ZONE NULL,
+  finished_at TIMESTAMP WITH TIME ZONE NULL,
+  expires_at TIMESTAMP WITH TIME ZONE NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_export_jobs_user ON export_jobs(user_id, id);
+
+CREATE INDEX idx_export_jobs_active ON export_jobs(user_id) WHERE status IN ('pending', 'running');
+
+CREATE INDEX idx_export_jobs_expires ON export_jobs(expires_at) WHERE status IN ('succeeded', 'failed');
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS export_jobs;
+
+-- +goose StatementEnd
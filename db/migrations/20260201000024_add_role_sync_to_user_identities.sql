@@ -0,0 +1,14 @@
+-- +goose Up
+-- User yang dibuat saat login SSO pertama, hanya user ini yang role-nya ikut group IdP.
+-- Akun lokal yang di-link lewat email tetap FALSE
+ALTER TABLE user_identities
+ADD COLUMN provisioned BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Role terakhir yang diterapkan dari group IdP, beda dengan users.role berarti role diubah manual oleh admin
+ALTER TABLE user_identities
+ADD COLUMN synced_role user_role NULL;
+
+-- +goose Down
+ALTER TABLE user_identities DROP COLUMN IF EXISTS synced_role;
+
+ALTER TABLE user_identities DROP COLUMN IF EXISTS provisioned;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Diisi saat directory sync menonaktifkan user karena hilang dari direktori.
+-- Sync hanya mengaktifkan kembali user yang dinonaktifkan sync sendiri dan belum diubah lagi setelahnya
+ALTER TABLE user_identities
+ADD COLUMN sync_deactivated_at TIMESTAMPTZ NULL;
+
+ALTER TABLE user_identities
+ADD COLUMN sync_deactivated_reason VARCHAR(50) NULL;
+
+-- +goose Down
+ALTER TABLE user_identities DROP COLUMN IF EXISTS sync_deactivated_reason;
+
+ALTER TABLE user_identities DROP COLUMN IF EXISTS sync_deactivated_at;
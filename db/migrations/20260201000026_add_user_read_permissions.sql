@@ -0,0 +1,9 @@
+-- +goose Up
+-- Route baca data user dan export user sekarang butuh permission, Staff tetap bisa melihat dan export daftar user
+INSERT INTO role_permissions (role_id, permission) VALUES
+  ('00000000000000000000000002', 'user:read'),
+  ('00000000000000000000000002', 'user:export')
+ON CONFLICT DO NOTHING;
+
+-- +goose Down
+DELETE FROM role_permissions WHERE permission IN ('user:read', 'user:export');
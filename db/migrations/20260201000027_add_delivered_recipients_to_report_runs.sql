@@ -0,0 +1,7 @@
+-- +goose Up
+-- Penerima yang sudah berhasil dikirimi email, retry job hanya mengirim ke penerima yang belum ada di sini
+ALTER TABLE report_runs
+ADD COLUMN delivered_recipients JSONB NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE report_runs DROP COLUMN IF EXISTS delivered_recipients;
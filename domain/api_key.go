@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// --- Enums ---
+
+type APIKeyScope string
+
+const (
+	// GET request selain export
+	APIKeyScopeRead APIKeyScope = "read"
+	// Endpoint /export/* (PDF, Excel, data matrix)
+	APIKeyScopeExport APIKeyScope = "export"
+	// Request yang mengubah data (POST, PATCH, PUT, DELETE)
+	APIKeyScopeWrite APIKeyScope = "write"
+)
+
+// --- Structs ---
+
+type APIKey struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description *string       `json:"description"`
+	KeyPrefix   string        `json:"keyPrefix"`
+	KeyHash     string        `json:"-"`
+	Scopes      []APIKeyScope `json:"scopes"`
+	ExpiresAt   *time.Time    `json:"expiresAt"`
+	LastUsedAt  *time.Time    `json:"lastUsedAt"`
+	LastUsedIP  *string       `json:"lastUsedIp"`
+	UsageCount  int64         `json:"usageCount"`
+	RevokedAt   *time.Time    `json:"revokedAt"`
+	CreatedBy   *string       `json:"createdBy"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+}
+
+// IsExpired reports whether the key passed its expiry date
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// HasScope reports whether the key may be used for the given kind of request
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+type APIKeyResponse struct {
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Description *string       `json:"description"`
+	KeyPrefix   string        `json:"keyPrefix" example:"inv_k7m2x9qp"`
+	Scopes      []APIKeyScope `json:"scopes"`
+	IsActive    bool          `json:"isActive"`
+	ExpiresAt   *time.Time    `json:"expiresAt"`
+	LastUsedAt  *time.Time    `json:"lastUsedAt"`
+	LastUsedIP  *string       `json:"lastUsedIp"`
+	UsageCount  int64         `json:"usageCount"`
+	RevokedAt   *time.Time    `json:"revokedAt"`
+	CreatedBy   *string       `json:"createdBy"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+}
+
+// APIKeySecretResponse is returned once after create or rotate, the plain key cannot be retrieved again
+type APIKeySecretResponse struct {
+	APIKeyResponse
+	Key string `json:"key" example:"inv_k7m2x9qpa3fd5t6hgyw2c4mj8nbr7e5kq2zx"`
+}
+
+// --- Payloads ---
+
+type CreateAPIKeyPayload struct {
+	Name        string        `json:"name" validate:"required,max=100"`
+	Description *string       `json:"description,omitempty" validate:"omitempty,max=255"`
+	Scopes      []APIKeyScope `json:"scopes" validate:"required,min=1,dive,oneof=read export write"`
+	ExpiresAt   *time.Time    `json:"expiresAt,omitempty" validate:"omitempty"`
+}
+
+type UpdateAPIKeyPayload struct {
+	Name        *string       `json:"name,omitempty" validate:"omitempty,max=100"`
+	Description *string       `json:"description,omitempty" validate:"omitempty,max=255"`
+	Scopes      []APIKeyScope `json:"scopes,omitempty" validate:"omitempty,min=1,dive,oneof=read export write"` // Replaces the scope set when present
+	ExpiresAt   *time.Time    `json:"expiresAt,omitempty" validate:"omitempty"`
+}
@@ -38,6 +38,8 @@ const (
 	AssetSortByCondition     AssetSortField = "condition"
 	AssetSortByCreatedAt     AssetSortField = "createdAt"
 	AssetSortByUpdatedAt     AssetSortField = "updatedAt"
+	// * Hanya berlaku kalau ada search, default sort saat search tanpa sortBy
+	AssetSortByRelevance     AssetSortField = "relevance"
 )
 
 type ExportFormat string
@@ -106,6 +108,7 @@ type Asset struct {
 	Condition          AssetCondition `json:"condition"`
 	LocationID         *string        `json:"locationId"`
 	AssignedTo         *string        `json:"assignedTo"`
+	CustomAttributes   map[string]any `json:"customAttributes"`
 	CreatedAt          time.Time      `json:"createdAt"`
 	UpdatedAt          time.Time      `json:"updatedAt"`
 	// * Populated
@@ -117,24 +120,29 @@ type Asset struct {
 }
 
 type AssetResponse struct {
-	ID                 string            `json:"id"`
-	AssetTag           string            `json:"assetTag"`
-	DataMatrixImageUrl string            `json:"dataMatrixImageUrl"`
-	AssetName          string            `json:"assetName"`
-	CategoryID         string            `json:"categoryId"`
-	Brand              *string           `json:"brand"`
-	Model              *string           `json:"model"`
-	SerialNumber       *string           `json:"serialNumber"`
-	PurchaseDate       *time.Time        `json:"purchaseDate"`
-	PurchasePrice      *NullableDecimal2 `json:"purchasePrice"` // Custom type to ensure 2 decimal places as number
-	VendorName         *string           `json:"vendorName"`
-	WarrantyEnd        *time.Time        `json:"warrantyEnd"`
-	Status             AssetStatus       `json:"status"`
-	Condition          AssetCondition    `json:"condition"`
-	LocationID         *string           `json:"locationId"`
-	AssignedToID       *string           `json:"assignedToId"`
-	CreatedAt          time.Time         `json:"createdAt"`
-	UpdatedAt          time.Time         `json:"updatedAt"`
+	ID                      string            `json:"id"`
+	AssetTag                string            `json:"assetTag"`
+	DataMatrixImageUrl      string            `json:"dataMatrixImageUrl"`
+	AssetName               string            `json:"assetName"`
+	CategoryID              string            `json:"categoryId"`
+	Brand                   *string           `json:"brand"`
+	Model                   *string           `json:"model"`
+	SerialNumber            *string           `json:"serialNumber"`
+	PurchaseDate            *time.Time        `json:"purchaseDate"`
+	PurchasePrice           *NullableDecimal2 `json:"purchasePrice"`           // Custom type to ensure 2 decimal places as number
+	BookValue               *NullableDecimal2 `json:"bookValue"`               // Calculated from category depreciation settings
+	AccumulatedDepreciation *NullableDecimal2 `json:"accumulatedDepreciation"` // Calculated from category depreciation settings
+	VendorName              *string           `json:"vendorName"`
+	WarrantyEnd             *time.Time        `json:"warrantyEnd"`
+	Status                  AssetStatus       `json:"status"`
+	Condition               AssetCondition    `json:"condition"`
+	LocationID              *string           `json:"locationId"`
+	AssignedToID            *string           `json:"assignedToId"`
+	CustomAttributes        map[string]any    `json:"customAttributes"`
+	CreatedAt               time.Time         `json:"createdAt"`
+	UpdatedAt               time.Time         `json:"updatedAt"`
+	// * Snippet yang cocok dengan search, kata yang match dibungkus <mark>
+	Highlight               *string           `json:"highlight,omitempty"`
 	// ???
 	Category   *CategoryResponse     `json:"category"`
 	Location   *LocationResponse     `json:"location"`
@@ -143,24 +151,27 @@ type AssetResponse struct {
 }
 
 type AssetListResponse struct {
-	ID                 string            `json:"id"`
-	AssetTag           string            `json:"assetTag"`
-	DataMatrixImageUrl string            `json:"dataMatrixImageUrl"`
-	AssetName          string            `json:"assetName"`
-	CategoryID         string            `json:"categoryId"`
-	Brand              *string           `json:"brand"`
-	Model              *string           `json:"model"`
-	SerialNumber       *string           `json:"serialNumber"`
-	PurchaseDate       *time.Time        `json:"purchaseDate"`
-	PurchasePrice      *NullableDecimal2 `json:"purchasePrice"` // Custom type to ensure 2 decimal places as number
-	VendorName         *string           `json:"vendorName"`
-	WarrantyEnd        *time.Time        `json:"warrantyEnd"`
-	Status             AssetStatus       `json:"status"`
-	Condition          AssetCondition    `json:"condition"`
-	LocationID         *string           `json:"locationId"`
-	AssignedToID       *string           `json:"assignedToId"`
-	CreatedAt          time.Time         `json:"createdAt"`
-	UpdatedAt          time.Time         `json:"updatedAt"`
+	ID                      string            `json:"id"`
+	AssetTag                string            `json:"assetTag"`
+	DataMatrixImageUrl      string            `json:"dataMatrixImageUrl"`
+	AssetName               string            `json:"assetName"`
+	CategoryID              string            `json:"categoryId"`
+	Brand                   *string           `json:"brand"`
+	Model                   *string           `json:"model"`
+	SerialNumber            *string           `json:"serialNumber"`
+	PurchaseDate            *time.Time        `json:"purchaseDate"`
+	PurchasePrice           *NullableDecimal2 `json:"purchasePrice"`           // Custom type to ensure 2 decimal places as number
+	BookValue               *NullableDecimal2 `json:"bookValue"`               // Calculated from category depreciation settings
+	AccumulatedDepreciation *NullableDecimal2 `json:"accumulatedDepreciation"` // Calculated from category depreciation settings
+	VendorName              *string           `json:"vendorName"`
+	WarrantyEnd             *time.Time        `json:"warrantyEnd"`
+	Status                  AssetStatus       `json:"status"`
+	Condition               AssetCondition    `json:"condition"`
+	LocationID              *string           `json:"locationId"`
+	AssignedToID            *string           `json:"assignedToId"`
+	CustomAttributes        map[string]any    `json:"customAttributes"`
+	CreatedAt               time.Time         `json:"createdAt"`
+	UpdatedAt               time.Time         `json:"updatedAt"`
 	// * Populated
 	Category   *CategoryResponse     `json:"category"`
 	Location   *LocationResponse     `json:"location"`
@@ -205,6 +216,7 @@ type CreateAssetPayload struct {
 	LocationID         *string        `json:"locationId,omitempty" validate:"omitempty"`
 	AssignedTo         *string        `json:"assignedTo,omitempty" validate:"omitempty"`
 	ImageUrls          []string       `json:"imageUrls,omitempty" validate:"omitempty,max=10,dive,url"`
+	CustomAttributes   map[string]any `json:"customAttributes,omitempty" validate:"omitempty,max=50"`
 }
 
 type UpdateAssetPayload struct {
@@ -223,6 +235,7 @@ type UpdateAssetPayload struct {
 	Condition          *AssetCondition `json:"condition,omitempty" validate:"omitempty,oneof=Good Fair Poor Damaged"`
 	LocationID         *string         `json:"locationId,omitempty" validate:"omitempty"`
 	AssignedTo         *string         `json:"assignedTo,omitempty" validate:"omitempty"`
+	CustomAttributes   map[string]any  `json:"customAttributes,omitempty" validate:"omitempty,max=50"` // Merged into existing values, null removes a key
 }
 
 type BulkDeleteAssetsPayload struct {
@@ -338,6 +351,8 @@ type AssetFilterOptions struct {
 	AssignedTo *string         `json:"assignedTo,omitempty"`
 	Brand      *string         `json:"brand,omitempty"`
 	Model      *string         `json:"model,omitempty"`
+	// Exact match on custom attribute values keyed by attribute key
+	CustomAttributes map[string]string `json:"customAttributes,omitempty"`
 }
 
 type AssetSortOptions struct {
@@ -409,6 +424,7 @@ type AssetAssignmentStatistics struct {
 
 type AssetValueStatistics struct {
 	TotalValue         *float64 `json:"totalValue"`
+	TotalBookValue     *float64 `json:"totalBookValue"`
 	AverageValue       *float64 `json:"averageValue"`
 	MinValue           *float64 `json:"minValue"`
 	MaxValue           *float64 `json:"maxValue"`
@@ -508,10 +524,11 @@ type AssetAssignmentStatisticsResponse struct {
 }
 
 type AssetValueStatisticsResponse struct {
-	TotalValue         *NullableDecimal2 `json:"totalValue"`   // Custom type to ensure 2 decimal places as number
-	AverageValue       *NullableDecimal2 `json:"averageValue"` // Custom type to ensure 2 decimal places as number
-	MinValue           *NullableDecimal2 `json:"minValue"`     // Custom type to ensure 2 decimal places as number
-	MaxValue           *NullableDecimal2 `json:"maxValue"`     // Custom type to ensure 2 decimal places as number
+	TotalValue         *NullableDecimal2 `json:"totalValue"`     // Custom type to ensure 2 decimal places as number
+	TotalBookValue     *NullableDecimal2 `json:"totalBookValue"` // Custom type to ensure 2 decimal places as number
+	AverageValue       *NullableDecimal2 `json:"averageValue"`   // Custom type to ensure 2 decimal places as number
+	MinValue           *NullableDecimal2 `json:"minValue"`       // Custom type to ensure 2 decimal places as number
+	MaxValue           *NullableDecimal2 `json:"maxValue"`       // Custom type to ensure 2 decimal places as number
 	AssetsWithValue    int               `json:"assetsWithValue"`
 	AssetsWithoutValue int               `json:"assetsWithoutValue"`
 }
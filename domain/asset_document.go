@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"io"
+	"slices"
+	"time"
+)
+
+// --- Enums ---
+
+type AssetDocumentType string
+
+const (
+	AssetDocumentTypeInvoice             AssetDocumentType = "Invoice"
+	AssetDocumentTypePurchaseOrder       AssetDocumentType = "PurchaseOrder"
+	AssetDocumentTypeManual              AssetDocumentType = "Manual"
+	AssetDocumentTypeWarrantyCertificate AssetDocumentType = "WarrantyCertificate"
+	AssetDocumentTypeDisposalCertificate AssetDocumentType = "DisposalCertificate"
+	AssetDocumentTypeOther               AssetDocumentType = "Other"
+)
+
+// AssetDocumentTypes is the catalog of document types an asset can carry
+var AssetDocumentTypes = []AssetDocumentType{
+	AssetDocumentTypeInvoice,
+	AssetDocumentTypePurchaseOrder,
+	AssetDocumentTypeManual,
+	AssetDocumentTypeWarrantyCertificate,
+	AssetDocumentTypeDisposalCertificate,
+	AssetDocumentTypeOther,
+}
+
+// IsValid reports whether the document type is known
+func (t AssetDocumentType) IsValid() bool {
+	return slices.Contains(AssetDocumentTypes, t)
+}
+
+// --- Structs ---
+
+type AssetDocument struct {
+	ID                  string            `json:"id"`
+	AssetID             string            `json:"assetId"`
+	MaintenanceRecordID *string           `json:"maintenanceRecordId"`
+	Type                AssetDocumentType `json:"type"`
+	Title               string            `json:"title"`
+	DocumentNumber      *string           `json:"documentNumber"`
+	IssuedAt            *time.Time        `json:"issuedAt"`
+	ExpiresAt           *time.Time        `json:"expiresAt"`
+	Notes               *string           `json:"notes"`
+	FileURL             string            `json:"-"`
+	PublicID            string            `json:"-"`
+	FileName            string            `json:"fileName"`
+	MimeType            *string           `json:"mimeType"`
+	FileSize            int64             `json:"fileSize"`
+	UploadedBy          *string           `json:"uploadedBy"`
+	CreatedAt           time.Time         `json:"createdAt"`
+	UpdatedAt           time.Time         `json:"updatedAt"`
+}
+
+// AssetDocumentFile is the content of a stored document, dipakai untuk endpoint download
+type AssetDocumentFile struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// AssetDocumentZip is a ZIP bundle written straight to the response body, Write dipanggil sekali saat body dikirim
+type AssetDocumentZip struct {
+	FileName string
+	Write    func(w io.Writer) error
+}
+
+// --- Responses ---
+
+type AssetDocumentResponse struct {
+	ID                  string            `json:"id"`
+	AssetID             string            `json:"assetId"`
+	MaintenanceRecordID *string           `json:"maintenanceRecordId"`
+	Type                AssetDocumentType `json:"type" example:"WarrantyCertificate"`
+	Title               string            `json:"title" example:"Garansi laptop 3 tahun"`
+	DocumentNumber      *string           `json:"documentNumber" example:"WR-2025-000123"`
+	IssuedAt            *time.Time        `json:"issuedAt"`
+	ExpiresAt           *time.Time        `json:"expiresAt"`
+	IsExpired           bool              `json:"isExpired"`
+	Notes               *string           `json:"notes"`
+	FileName            string            `json:"fileName" example:"warranty.pdf"`
+	MimeType            *string           `json:"mimeType" example:"application/pdf"`
+	FileSize            int64             `json:"fileSize"`
+	UploadedBy          *string           `json:"uploadedBy"`
+	CreatedAt           time.Time         `json:"createdAt"`
+	UpdatedAt           time.Time         `json:"updatedAt"`
+}
+
+// --- Payloads ---
+
+// CreateAssetDocumentPayload is sent as multipart form fields together with the file field
+type CreateAssetDocumentPayload struct {
+	Type                AssetDocumentType `json:"type" form:"type" validate:"required,oneof=Invoice PurchaseOrder Manual WarrantyCertificate DisposalCertificate Other"`
+	Title               string            `json:"title" form:"title" validate:"required,max=200"`
+	DocumentNumber      *string           `json:"documentNumber,omitempty" form:"documentNumber" validate:"omitempty,max=100"`
+	IssuedAt            *string           `json:"issuedAt,omitempty" form:"issuedAt" validate:"omitempty,datetime=2006-01-02"`
+	ExpiresAt           *string           `json:"expiresAt,omitempty" form:"expiresAt" validate:"omitempty,datetime=2006-01-02"`
+	Notes               *string           `json:"notes,omitempty" form:"notes" validate:"omitempty"`
+	MaintenanceRecordID *string           `json:"maintenanceRecordId,omitempty" form:"maintenanceRecordId" validate:"omitempty"`
+}
+
+// --- Query Parameters ---
+
+type AssetDocumentFilterOptions struct {
+	Type                *AssetDocumentType `json:"type,omitempty"`
+	MaintenanceRecordID *string            `json:"maintenanceRecordId,omitempty"`
+	// * Dokumen yang expiresAt-nya sebelum tanggal ini (format 2006-01-02), termasuk yang sudah expired
+	ExpiresBefore *string `json:"expiresBefore,omitempty"`
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"time"
+)
+
+// --- Enums ---
+
+type AssetLoanStatus string
+
+const (
+	AssetLoanStatusActive   AssetLoanStatus = "Active"
+	AssetLoanStatusReturned AssetLoanStatus = "Returned"
+)
+
+type AssetLoanSortField string
+
+const (
+	AssetLoanSortByCheckoutDate       AssetLoanSortField = "checkoutDate"
+	AssetLoanSortByExpectedReturnDate AssetLoanSortField = "expectedReturnDate"
+	AssetLoanSortByReturnedDate       AssetLoanSortField = "returnedDate"
+	AssetLoanSortByCreatedAt          AssetLoanSortField = "createdAt"
+	AssetLoanSortByUpdatedAt          AssetLoanSortField = "updatedAt"
+)
+
+// --- Structs ---
+
+type AssetLoan struct {
+	ID                 string          `json:"id"`
+	AssetID            string          `json:"assetId"`
+	BorrowerID         string          `json:"borrowerId"`
+	CheckedOutBy       string          `json:"checkedOutBy"`
+	CheckedInBy        *string         `json:"checkedInBy"`
+	CheckoutDate       time.Time       `json:"checkoutDate"`
+	ExpectedReturnDate time.Time       `json:"expectedReturnDate"`
+	ReturnedDate       *time.Time      `json:"returnedDate"`
+	CheckoutCondition  AssetCondition  `json:"checkoutCondition"`
+	ReturnCondition    *AssetCondition `json:"returnCondition"`
+	Status             AssetLoanStatus `json:"status"`
+	CheckoutMovementID *string         `json:"checkoutMovementId"`
+	ReturnMovementID   *string         `json:"returnMovementId"`
+	Notes              *string         `json:"notes"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	UpdatedAt          time.Time       `json:"updatedAt"`
+	// * Populated
+	Asset            *Asset `json:"asset,omitempty"`
+	Borrower         *User  `json:"borrower,omitempty"`
+	CheckedOutByUser *User  `json:"checkedOutByUser,omitempty"`
+	CheckedInByUser  *User  `json:"checkedInByUser,omitempty"`
+}
+
+// IsOverdue reports whether an active loan has passed its expected return date
+func (l *AssetLoan) IsOverdue(now time.Time) bool {
+	return l.Status == AssetLoanStatusActive && now.After(l.ExpectedReturnDate)
+}
+
+// DaysOverdue returns the number of full days an active loan is past its expected return date
+func (l *AssetLoan) DaysOverdue(now time.Time) int {
+	if !l.IsOverdue(now) {
+		return 0
+	}
+	return int(now.Sub(l.ExpectedReturnDate).Hours() / 24)
+}
+
+// --- Responses ---
+
+type AssetLoanResponse struct {
+	ID                 string          `json:"id"`
+	AssetID            string          `json:"assetId"`
+	BorrowerID         string          `json:"borrowerId"`
+	CheckedOutByID     string          `json:"checkedOutById"`
+	CheckedInByID      *string         `json:"checkedInById"`
+	CheckoutDate       time.Time       `json:"checkoutDate"`
+	ExpectedReturnDate time.Time       `json:"expectedReturnDate"`
+	ReturnedDate       *time.Time      `json:"returnedDate"`
+	CheckoutCondition  AssetCondition  `json:"checkoutCondition"`
+	ReturnCondition    *AssetCondition `json:"returnCondition"`
+	Status             AssetLoanStatus `json:"status"`
+	IsOverdue          bool            `json:"isOverdue"`
+	DaysOverdue        int             `json:"daysOverdue"`
+	CheckoutMovementID *string         `json:"checkoutMovementId"`
+	ReturnMovementID   *string         `json:"returnMovementId"`
+	Notes              *string         `json:"notes"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	UpdatedAt          time.Time       `json:"updatedAt"`
+	// * Populated
+	Asset        *AssetResponse `json:"asset"`
+	Borrower     *UserResponse  `json:"borrower"`
+	CheckedOutBy *UserResponse  `json:"checkedOutBy"`
+	CheckedInBy  *UserResponse  `json:"checkedInBy"`
+}
+
+// --- Payloads ---
+
+type CheckOutAssetPayload struct {
+	AssetID            string  `json:"assetId" validate:"required"`
+	BorrowerID         string  `json:"borrowerId" validate:"required"`
+	ExpectedReturnDate string  `json:"expectedReturnDate" validate:"required,datetime=2006-01-02"`
+	Notes              *string `json:"notes,omitempty" validate:"omitempty"`
+}
+
+type CheckInAssetPayload struct {
+	ReturnCondition  AssetCondition `json:"returnCondition" validate:"required,oneof=Good Fair Poor Damaged"`
+	ReturnLocationID *string        `json:"returnLocationId,omitempty" validate:"omitempty"`
+	Notes            *string        `json:"notes,omitempty" validate:"omitempty"`
+}
+
+// --- Query Parameters ---
+
+type AssetLoanFilterOptions struct {
+	AssetID      *string          `json:"assetId,omitempty"`
+	BorrowerID   *string          `json:"borrowerId,omitempty"`
+	CheckedOutBy *string          `json:"checkedOutBy,omitempty"`
+	Status       *AssetLoanStatus `json:"status,omitempty"`
+	IsOverdue    *bool            `json:"isOverdue,omitempty"`
+	DateFrom     *time.Time       `json:"dateFrom,omitempty"`
+	DateTo       *time.Time       `json:"dateTo,omitempty"`
+}
+
+type AssetLoanSortOptions struct {
+	Field AssetLoanSortField `json:"field" example:"checkoutDate"`
+	Order SortOrder          `json:"order" example:"desc"`
+}
+
+type AssetLoanParams struct {
+	SearchQuery *string                 `json:"searchQuery,omitempty"`
+	Filters     *AssetLoanFilterOptions `json:"filters,omitempty"`
+	Sort        *AssetLoanSortOptions   `json:"sort,omitempty"`
+	Pagination  *PaginationOptions      `json:"pagination,omitempty"`
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"time"
+)
+
+// --- Enums ---
+
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "CREATE"
+	AuditActionUpdate AuditAction = "UPDATE"
+	AuditActionDelete AuditAction = "DELETE"
+)
+
+type AuditEntityType string
+
+const (
+	AuditEntityAsset               AuditEntityType = "asset"
+	AuditEntityCategory            AuditEntityType = "category"
+	AuditEntityLocation            AuditEntityType = "location"
+	AuditEntityUser                AuditEntityType = "user"
+	AuditEntityMaintenanceSchedule AuditEntityType = "maintenance_schedule"
+	AuditEntityMaintenanceRecord   AuditEntityType = "maintenance_record"
+	AuditEntityIssueReport         AuditEntityType = "issue_report"
+	AuditEntityWorkOrder           AuditEntityType = "work_order"
+	AuditEntityStockItem           AuditEntityType = "stock_item"
+	AuditEntityRole                AuditEntityType = "role"
+	AuditEntityAPIKey              AuditEntityType = "api_key"
+	AuditEntityWebhookEndpoint     AuditEntityType = "webhook_endpoint"
+	AuditEntityAssetDocument       AuditEntityType = "asset_document"
+)
+
+type AuditLogSortField string
+
+const (
+	AuditLogSortByCreatedAt AuditLogSortField = "createdAt"
+)
+
+// --- Structs ---
+
+// AuditFieldChange holds the old and new value of a single field
+type AuditFieldChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+type AuditLog struct {
+	ID         string                      `json:"id"`
+	EntityType AuditEntityType             `json:"entityType"`
+	EntityID   string                      `json:"entityId"`
+	Action     AuditAction                 `json:"action"`
+	ActorID    *string                     `json:"actorId"`
+	Changes    map[string]AuditFieldChange `json:"changes"`
+	CreatedAt  time.Time                   `json:"createdAt"`
+	// * Populated
+	Actor *User `json:"actor,omitempty"`
+}
+
+// --- Responses ---
+
+type AuditLogResponse struct {
+	ID         string                      `json:"id"`
+	EntityType AuditEntityType             `json:"entityType"`
+	EntityID   string                      `json:"entityId"`
+	Action     AuditAction                 `json:"action"`
+	ActorID    *string                     `json:"actorId"`
+	Changes    map[string]AuditFieldChange `json:"changes"`
+	CreatedAt  time.Time                   `json:"createdAt"`
+	// * Populated
+	Actor *UserResponse `json:"actor"`
+}
+
+// --- Query Parameters ---
+
+type AuditLogFilterOptions struct {
+	EntityType *AuditEntityType `json:"entityType,omitempty"`
+	EntityID   *string          `json:"entityId,omitempty"`
+	ActorID    *string          `json:"actorId,omitempty"`
+	Action     *AuditAction     `json:"action,omitempty"`
+	DateFrom   *time.Time       `json:"dateFrom,omitempty"`
+	DateTo     *time.Time       `json:"dateTo,omitempty"`
+}
+
+type AuditLogSortOptions struct {
+	Field AuditLogSortField `json:"field" example:"createdAt"`
+	Order SortOrder         `json:"order" example:"desc"`
+}
+
+type AuditLogParams struct {
+	Filters    *AuditLogFilterOptions `json:"filters,omitempty"`
+	Sort       *AuditLogSortOptions   `json:"sort,omitempty"`
+	Pagination *PaginationOptions     `json:"pagination,omitempty"`
+}
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"time"
+)
+
+// --- Enums ---
+
+type AuditSessionStatus string
+
+const (
+	AuditSessionStatusOpen   AuditSessionStatus = "Open"
+	AuditSessionStatusClosed AuditSessionStatus = "Closed"
+)
+
+type AuditItemResult string
+
+const (
+	AuditItemResultPending       AuditItemResult = "Pending"
+	AuditItemResultFound         AuditItemResult = "Found"
+	AuditItemResultMissing       AuditItemResult = "Missing"
+	AuditItemResultUnexpected    AuditItemResult = "Unexpected"
+	AuditItemResultWrongLocation AuditItemResult = "WrongLocation"
+)
+
+type AuditSessionSortField string
+
+const (
+	AuditSessionSortByTitle     AuditSessionSortField = "title"
+	AuditSessionSortByStartedAt AuditSessionSortField = "startedAt"
+	AuditSessionSortByClosedAt  AuditSessionSortField = "closedAt"
+	AuditSessionSortByCreatedAt AuditSessionSortField = "createdAt"
+	AuditSessionSortByUpdatedAt AuditSessionSortField = "updatedAt"
+)
+
+// --- Structs ---
+
+type AuditSession struct {
+	ID         string             `json:"id"`
+	Title      string             `json:"title"`
+	LocationID *string            `json:"locationId"`
+	CategoryID *string            `json:"categoryId"`
+	Status     AuditSessionStatus `json:"status"`
+	StartedBy  string             `json:"startedBy"`
+	StartedAt  time.Time          `json:"startedAt"`
+	ClosedBy   *string            `json:"closedBy"`
+	ClosedAt   *time.Time         `json:"closedAt"`
+	Notes      *string            `json:"notes"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	UpdatedAt  time.Time          `json:"updatedAt"`
+	// * Populated
+	Location      *Location `json:"location,omitempty"`
+	Category      *Category `json:"category,omitempty"`
+	StartedByUser *User     `json:"startedByUser,omitempty"`
+	ClosedByUser  *User     `json:"closedByUser,omitempty"`
+}
+
+type AuditSessionItem struct {
+	ID                   string          `json:"id"`
+	SessionID            string          `json:"sessionId"`
+	AssetID              string          `json:"assetId"`
+	IsExpected           bool            `json:"isExpected"`
+	ExpectedLocationID   *string         `json:"expectedLocationId"`
+	Result               AuditItemResult `json:"result"`
+	ScanLogID            *string         `json:"scanLogId"`
+	ScannedBy            *string         `json:"scannedBy"`
+	ScannedAt            *time.Time      `json:"scannedAt"`
+	CorrectionMovementID *string         `json:"correctionMovementId"`
+	MarkedLost           bool            `json:"markedLost"`
+	CreatedAt            time.Time       `json:"createdAt"`
+	UpdatedAt            time.Time       `json:"updatedAt"`
+	// * Populated
+	Asset            *Asset    `json:"asset,omitempty"`
+	ExpectedLocation *Location `json:"expectedLocation,omitempty"`
+}
+
+// --- Responses ---
+
+type AuditSessionResponse struct {
+	ID          string             `json:"id"`
+	Title       string             `json:"title"`
+	LocationID  *string            `json:"locationId"`
+	CategoryID  *string            `json:"categoryId"`
+	Status      AuditSessionStatus `json:"status"`
+	StartedByID string             `json:"startedById"`
+	StartedAt   time.Time          `json:"startedAt"`
+	ClosedByID  *string            `json:"closedById"`
+	ClosedAt    *time.Time         `json:"closedAt"`
+	Notes       *string            `json:"notes"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	UpdatedAt   time.Time          `json:"updatedAt"`
+	// * Populated
+	Location  *LocationResponse `json:"location"`
+	Category  *CategoryResponse `json:"category"`
+	StartedBy *UserResponse     `json:"startedBy"`
+	ClosedBy  *UserResponse     `json:"closedBy"`
+}
+
+type AuditSessionItemResponse struct {
+	ID                   string          `json:"id"`
+	SessionID            string          `json:"sessionId"`
+	AssetID              string          `json:"assetId"`
+	IsExpected           bool            `json:"isExpected"`
+	ExpectedLocationID   *string         `json:"expectedLocationId"`
+	Result               AuditItemResult `json:"result"`
+	ScanLogID            *string         `json:"scanLogId"`
+	ScannedByID          *string         `json:"scannedById"`
+	ScannedAt            *time.Time      `json:"scannedAt"`
+	CorrectionMovementID *string         `json:"correctionMovementId"`
+	MarkedLost           bool            `json:"markedLost"`
+	CreatedAt            time.Time       `json:"createdAt"`
+	UpdatedAt            time.Time       `json:"updatedAt"`
+	// * Populated
+	Asset            *AssetResponse    `json:"asset"`
+	ExpectedLocation *LocationResponse `json:"expectedLocation"`
+}
+
+// --- Reconciliation Report ---
+
+type AuditSessionReportSummary struct {
+	ExpectedCount      int      `json:"expectedCount"`
+	ScannedCount       int      `json:"scannedCount"`
+	FoundCount         int      `json:"foundCount"`
+	MissingCount       int      `json:"missingCount"`
+	UnexpectedCount    int      `json:"unexpectedCount"`
+	WrongLocationCount int      `json:"wrongLocationCount"`
+	CorrectedCount     int      `json:"correctedCount"`
+	MarkedLostCount    int      `json:"markedLostCount"`
+	AccuracyPercentage Decimal2 `json:"accuracyPercentage"`
+}
+
+type AuditSessionReportResponse struct {
+	Session       AuditSessionResponse       `json:"session"`
+	Summary       AuditSessionReportSummary  `json:"summary"`
+	Found         []AuditSessionItemResponse `json:"found"`
+	Missing       []AuditSessionItemResponse `json:"missing"`
+	Unexpected    []AuditSessionItemResponse `json:"unexpected"`
+	WrongLocation []AuditSessionItemResponse `json:"wrongLocation"`
+}
+
+// --- Payloads ---
+
+type CreateAuditSessionPayload struct {
+	Title      string  `json:"title" validate:"required,max=255"`
+	LocationID *string `json:"locationId,omitempty" validate:"omitempty"`
+	CategoryID *string `json:"categoryId,omitempty" validate:"omitempty"`
+	Notes      *string `json:"notes,omitempty" validate:"omitempty"`
+}
+
+type CloseAuditSessionPayload struct {
+	CreateMovementCorrections bool    `json:"createMovementCorrections"`
+	MarkMissingAsLost         bool    `json:"markMissingAsLost"`
+	Notes                     *string `json:"notes,omitempty" validate:"omitempty"`
+}
+
+type ExportAuditSessionReportPayload struct {
+	Format ExportFormat `json:"format" validate:"required,oneof=pdf excel"`
+}
+
+// --- Query Parameters ---
+
+type AuditSessionFilterOptions struct {
+	Status     *AuditSessionStatus `json:"status,omitempty"`
+	LocationID *string             `json:"locationId,omitempty"`
+	CategoryID *string             `json:"categoryId,omitempty"`
+	StartedBy  *string             `json:"startedBy,omitempty"`
+	DateFrom   *time.Time          `json:"dateFrom,omitempty"`
+	DateTo     *time.Time          `json:"dateTo,omitempty"`
+}
+
+type AuditSessionSortOptions struct {
+	Field AuditSessionSortField `json:"field" example:"startedAt"`
+	Order SortOrder             `json:"order" example:"desc"`
+}
+
+type AuditSessionParams struct {
+	SearchQuery *string                    `json:"searchQuery,omitempty"`
+	Filters     *AuditSessionFilterOptions `json:"filters,omitempty"`
+	Sort        *AuditSessionSortOptions   `json:"sort,omitempty"`
+	Pagination  *PaginationOptions         `json:"pagination,omitempty"`
+}
@@ -16,14 +16,18 @@ const (
 // --- Structs ---
 
 type Category struct {
-	ID           string                `json:"id"`
-	ParentID     *string               `json:"parentId"`
-	CategoryCode string                `json:"categoryCode"`
-	ImageURL     *string               `json:"imageUrl,omitempty"`
-	CreatedAt    time.Time             `json:"createdAt"`
-	UpdatedAt    time.Time             `json:"updatedAt"`
-	Parent       *Category             `json:"parent,omitempty"`
-	Translations []CategoryTranslation `json:"translations,omitempty"`
+	ID                  string                `json:"id"`
+	ParentID            *string               `json:"parentId"`
+	CategoryCode        string                `json:"categoryCode"`
+	ImageURL            *string               `json:"imageUrl,omitempty"`
+	DepreciationMethod  *DepreciationMethod   `json:"depreciationMethod"`
+	UsefulLifeYears     *int                  `json:"usefulLifeYears"`
+	SalvageValuePercent *float64              `json:"salvageValuePercent"`
+	Attributes          []CategoryAttribute   `json:"attributes"`
+	CreatedAt           time.Time             `json:"createdAt"`
+	UpdatedAt           time.Time             `json:"updatedAt"`
+	Parent              *Category             `json:"parent,omitempty"`
+	Translations        []CategoryTranslation `json:"translations,omitempty"`
 }
 
 type CategoryTranslation struct {
@@ -46,28 +50,35 @@ type CategoryTranslationResponse struct {
 }
 
 type CategoryResponse struct {
-	ID           string                        `json:"id"`
-	ParentID     *string                       `json:"parentId"`
-	CategoryCode string                        `json:"categoryCode"`
-	CategoryName string                        `json:"categoryName"`
-	Description  *string                       `json:"description"`
-	ImageURL     *string                       `json:"imageUrl"`
-	Parent       *CategoryResponse             `json:"parent"`
-	CreatedAt    time.Time                     `json:"createdAt"`
-	UpdatedAt    time.Time                     `json:"updatedAt"`
-	Translations []CategoryTranslationResponse `json:"translations"`
+	ID                  string                        `json:"id"`
+	ParentID            *string                       `json:"parentId"`
+	CategoryCode        string                        `json:"categoryCode"`
+	CategoryName        string                        `json:"categoryName"`
+	Description         *string                       `json:"description"`
+	ImageURL            *string                       `json:"imageUrl"`
+	DepreciationMethod  *DepreciationMethod           `json:"depreciationMethod"`
+	UsefulLifeYears     *int                          `json:"usefulLifeYears"`
+	SalvageValuePercent *NullableDecimal2             `json:"salvageValuePercent"`
+	Attributes          []CategoryAttribute           `json:"attributes"`
+	Parent              *CategoryResponse             `json:"parent"`
+	CreatedAt           time.Time                     `json:"createdAt"`
+	UpdatedAt           time.Time                     `json:"updatedAt"`
+	Translations        []CategoryTranslationResponse `json:"translations"`
 }
 
 type CategoryListResponse struct {
-	ID           string                `json:"id"`
-	ParentID     *string               `json:"parentId"`
-	CategoryCode string                `json:"categoryCode"`
-	CategoryName string                `json:"categoryName"`
-	Description  *string               `json:"description"`
-	ImageURL     *string               `json:"imageUrl"`
-	Parent       *CategoryListResponse `json:"parent"`
-	CreatedAt    time.Time             `json:"createdAt"`
-	UpdatedAt    time.Time             `json:"updatedAt"`
+	ID                  string                `json:"id"`
+	ParentID            *string               `json:"parentId"`
+	CategoryCode        string                `json:"categoryCode"`
+	CategoryName        string                `json:"categoryName"`
+	Description         *string               `json:"description"`
+	ImageURL            *string               `json:"imageUrl"`
+	DepreciationMethod  *DepreciationMethod   `json:"depreciationMethod"`
+	UsefulLifeYears     *int                  `json:"usefulLifeYears"`
+	SalvageValuePercent *NullableDecimal2     `json:"salvageValuePercent"`
+	Parent              *CategoryListResponse `json:"parent"`
+	CreatedAt           time.Time             `json:"createdAt"`
+	UpdatedAt           time.Time             `json:"updatedAt"`
 }
 
 type BulkDeleteCategoriesResponse struct {
@@ -88,10 +99,14 @@ type BulkCreateCategoriesResponse struct {
 // --- Payloads ---
 
 type CreateCategoryPayload struct {
-	ParentID     *string                            `json:"parentId,omitempty" validate:"omitempty"`
-	CategoryCode string                             `json:"categoryCode" validate:"required,max=20"`
-	ImageURL     *string                            `json:"imageUrl,omitempty" validate:"omitempty,url"`
-	Translations []CreateCategoryTranslationPayload `json:"translations" validate:"required,min=1,dive"`
+	ParentID            *string                            `json:"parentId,omitempty" validate:"omitempty"`
+	CategoryCode        string                             `json:"categoryCode" validate:"required,max=20"`
+	ImageURL            *string                            `json:"imageUrl,omitempty" validate:"omitempty,url"`
+	DepreciationMethod  *DepreciationMethod                `json:"depreciationMethod,omitempty" validate:"omitempty,oneof=StraightLine DecliningBalance SumOfYearsDigits"`
+	UsefulLifeYears     *int                               `json:"usefulLifeYears,omitempty" validate:"omitempty,min=1,max=100"`
+	SalvageValuePercent *float64                           `json:"salvageValuePercent,omitempty" validate:"omitempty,min=0,max=100"`
+	Attributes          []CategoryAttribute                `json:"attributes,omitempty" validate:"omitempty,max=50,dive"`
+	Translations        []CreateCategoryTranslationPayload `json:"translations" validate:"required,min=1,dive"`
 }
 
 type CreateCategoryTranslationPayload struct {
@@ -101,10 +116,14 @@ type CreateCategoryTranslationPayload struct {
 }
 
 type UpdateCategoryPayload struct {
-	ParentID     *string                            `json:"parentId,omitempty" validate:"omitempty"`
-	CategoryCode *string                            `json:"categoryCode,omitempty" validate:"omitempty,max=20"`
-	ImageURL     *string                            `json:"imageUrl,omitempty" validate:"omitempty,url"`
-	Translations []UpdateCategoryTranslationPayload `json:"translations,omitempty" validate:"omitempty,dive"`
+	ParentID            *string                            `json:"parentId,omitempty" validate:"omitempty"`
+	CategoryCode        *string                            `json:"categoryCode,omitempty" validate:"omitempty,max=20"`
+	ImageURL            *string                            `json:"imageUrl,omitempty" validate:"omitempty,url"`
+	DepreciationMethod  *DepreciationMethod                `json:"depreciationMethod,omitempty" validate:"omitempty,oneof=StraightLine DecliningBalance SumOfYearsDigits"`
+	UsefulLifeYears     *int                               `json:"usefulLifeYears,omitempty" validate:"omitempty,min=1,max=100"`
+	SalvageValuePercent *float64                           `json:"salvageValuePercent,omitempty" validate:"omitempty,min=0,max=100"`
+	Attributes          *[]CategoryAttribute               `json:"attributes,omitempty" validate:"omitempty,max=50,dive"` // Replaces the whole schema, empty array clears it
+	Translations        []UpdateCategoryTranslationPayload `json:"translations,omitempty" validate:"omitempty,dive"`
 }
 
 type UpdateCategoryTranslationPayload struct {
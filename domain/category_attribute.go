@@ -0,0 +1,229 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+// --- Enums ---
+
+type AttributeType string
+
+const (
+	AttributeTypeString  AttributeType = "string"
+	AttributeTypeNumber  AttributeType = "number"
+	AttributeTypeDate    AttributeType = "date"
+	AttributeTypeEnum    AttributeType = "enum"
+	AttributeTypeBoolean AttributeType = "boolean"
+)
+
+// --- Structs ---
+
+// CategoryAttribute defines one custom attribute carried by assets of a category.
+// Min and Max bound the value for number attributes and the length for string attributes.
+type CategoryAttribute struct {
+	Key        string        `json:"key" validate:"required,max=50"`
+	Label      string        `json:"label" validate:"required,max=100"`
+	Type       AttributeType `json:"type" validate:"required,oneof=string number date enum boolean"`
+	IsRequired bool          `json:"isRequired"`
+	Options    []string      `json:"options,omitempty" validate:"omitempty,max=100,dive,required,max=100"`
+	Min        *float64      `json:"min,omitempty"`
+	Max        *float64      `json:"max,omitempty"`
+	Pattern    *string       `json:"pattern,omitempty" validate:"omitempty,max=255"`
+}
+
+var attributeKeyPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+// ValidateCategoryAttributes checks that an attribute schema is consistent: unique keys,
+// options only for enums and validation rules that fit the attribute type.
+func ValidateCategoryAttributes(attributes []CategoryAttribute) error {
+	seen := make(map[string]struct{}, len(attributes))
+	for _, attribute := range attributes {
+		if !attributeKeyPattern.MatchString(attribute.Key) {
+			return ErrBadRequestWithKey(utils.ErrCategoryAttributeKeyInvalidKey, attribute.Key)
+		}
+		if _, exists := seen[attribute.Key]; exists {
+			return ErrBadRequestWithKey(utils.ErrCategoryAttributeDuplicateKey, attribute.Key)
+		}
+		seen[attribute.Key] = struct{}{}
+
+		if attribute.Type == AttributeTypeEnum && len(attribute.Options) == 0 {
+			return ErrBadRequestWithKey(utils.ErrCategoryAttributeOptionsRequiredKey, attribute.Key)
+		}
+		if attribute.Type != AttributeTypeEnum && len(attribute.Options) > 0 {
+			return ErrBadRequestWithKey(utils.ErrCategoryAttributeRuleInvalidKey, attribute.Key)
+		}
+
+		if attribute.Min != nil || attribute.Max != nil {
+			if attribute.Type != AttributeTypeNumber && attribute.Type != AttributeTypeString {
+				return ErrBadRequestWithKey(utils.ErrCategoryAttributeRuleInvalidKey, attribute.Key)
+			}
+			if attribute.Min != nil && attribute.Max != nil && *attribute.Min > *attribute.Max {
+				return ErrBadRequestWithKey(utils.ErrCategoryAttributeRuleInvalidKey, attribute.Key)
+			}
+			if attribute.Type == AttributeTypeString && ((attribute.Min != nil && *attribute.Min < 0) || (attribute.Max != nil && *attribute.Max < 0)) {
+				return ErrBadRequestWithKey(utils.ErrCategoryAttributeRuleInvalidKey, attribute.Key)
+			}
+		}
+
+		if attribute.Pattern != nil {
+			if attribute.Type != AttributeTypeString {
+				return ErrBadRequestWithKey(utils.ErrCategoryAttributeRuleInvalidKey, attribute.Key)
+			}
+			if _, err := regexp.Compile(*attribute.Pattern); err != nil {
+				return ErrBadRequestWithKey(utils.ErrCategoryAttributeRuleInvalidKey, attribute.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// NormalizeAttributeValues validates asset attribute values against a category schema and
+// returns them in canonical form: numbers as float64, booleans as bool and dates as YYYY-MM-DD.
+// Null and empty string values are treated as not set.
+func NormalizeAttributeValues(schema []CategoryAttribute, values map[string]any) (map[string]any, error) {
+	definitions := make(map[string]CategoryAttribute, len(schema))
+	for _, attribute := range schema {
+		definitions[attribute.Key] = attribute
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	normalized := make(map[string]any, len(values))
+	for _, key := range keys {
+		definition, exists := definitions[key]
+		if !exists {
+			return nil, ErrBadRequestWithKey(utils.ErrAssetAttributeUnknownKey, key)
+		}
+
+		value := values[key]
+		if value == nil {
+			continue
+		}
+		if str, ok := value.(string); ok && strings.TrimSpace(str) == "" {
+			continue
+		}
+
+		normalizedValue, err := normalizeAttributeValue(definition, value)
+		if err != nil {
+			return nil, err
+		}
+		normalized[key] = normalizedValue
+	}
+
+	for _, attribute := range schema {
+		if _, exists := normalized[attribute.Key]; attribute.IsRequired && !exists {
+			return nil, ErrBadRequestWithKey(utils.ErrAssetAttributeRequiredKey, attribute.Key)
+		}
+	}
+
+	return normalized, nil
+}
+
+func normalizeAttributeValue(definition CategoryAttribute, value any) (any, error) {
+	invalid := ErrBadRequestWithKey(utils.ErrAssetAttributeInvalidKey, definition.Key)
+	outOfRange := ErrBadRequestWithKey(utils.ErrAssetAttributeOutOfRangeKey, definition.Key)
+
+	switch definition.Type {
+	case AttributeTypeString:
+		str, ok := value.(string)
+		if !ok {
+			return nil, invalid
+		}
+		length := float64(utf8.RuneCountInString(str))
+		if (definition.Min != nil && length < *definition.Min) || (definition.Max != nil && length > *definition.Max) {
+			return nil, outOfRange
+		}
+		if definition.Pattern != nil {
+			if matched, err := regexp.MatchString(*definition.Pattern, str); err != nil || !matched {
+				return nil, invalid
+			}
+		}
+		return str, nil
+
+	case AttributeTypeNumber:
+		var number float64
+		switch v := value.(type) {
+		case float64:
+			number = v
+		case int:
+			number = float64(v)
+		case int64:
+			number = float64(v)
+		case string:
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, invalid
+			}
+			number = parsed
+		default:
+			return nil, invalid
+		}
+		if (definition.Min != nil && number < *definition.Min) || (definition.Max != nil && number > *definition.Max) {
+			return nil, outOfRange
+		}
+		return number, nil
+
+	case AttributeTypeDate:
+		str, ok := value.(string)
+		if !ok {
+			return nil, invalid
+		}
+		parsed, err := time.Parse("2006-01-02", strings.TrimSpace(str))
+		if err != nil {
+			return nil, invalid
+		}
+		return parsed.Format("2006-01-02"), nil
+
+	case AttributeTypeEnum:
+		str, ok := value.(string)
+		if !ok || !slices.Contains(definition.Options, str) {
+			return nil, invalid
+		}
+		return str, nil
+
+	case AttributeTypeBoolean:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			parsed, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return nil, invalid
+			}
+			return parsed, nil
+		default:
+			return nil, invalid
+		}
+	}
+
+	return nil, invalid
+}
+
+// FormatAttributeValue renders a stored attribute value as plain text for exports.
+func FormatAttributeValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
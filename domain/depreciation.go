@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// --- Enums ---
+
+type DepreciationMethod string
+
+const (
+	DepreciationStraightLine     DepreciationMethod = "StraightLine"
+	DepreciationDecliningBalance DepreciationMethod = "DecliningBalance"
+	DepreciationSumOfYearsDigits DepreciationMethod = "SumOfYearsDigits"
+)
+
+// --- Structs ---
+
+// AssetDepreciation is the calculated depreciation state of an asset at a given date
+type AssetDepreciation struct {
+	Method                  *DepreciationMethod `json:"method"`
+	UsefulLifeYears         *int                `json:"usefulLifeYears"`
+	PurchasePrice           float64             `json:"purchasePrice"`
+	SalvageValue            float64             `json:"salvageValue"`
+	AccumulatedDepreciation float64             `json:"accumulatedDepreciation"`
+	BookValue               float64             `json:"bookValue"`
+	ElapsedMonths           int                 `json:"elapsedMonths"`
+	IsFullyDepreciated      bool                `json:"isFullyDepreciated"`
+}
+
+// --- Responses ---
+
+type AssetDepreciationResponse struct {
+	AssetID                 string              `json:"assetId"`
+	AssetTag                string              `json:"assetTag"`
+	AssetName               string              `json:"assetName"`
+	CategoryID              string              `json:"categoryId"`
+	CategoryCode            string              `json:"categoryCode"`
+	CategoryName            string              `json:"categoryName"`
+	Status                  AssetStatus         `json:"status"`
+	DepreciationMethod      *DepreciationMethod `json:"depreciationMethod"`
+	UsefulLifeYears         *int                `json:"usefulLifeYears"`
+	PurchaseDate            *time.Time          `json:"purchaseDate"`
+	PurchasePrice           Decimal2            `json:"purchasePrice"`
+	SalvageValue            Decimal2            `json:"salvageValue"`
+	AccumulatedDepreciation Decimal2            `json:"accumulatedDepreciation"`
+	BookValue               Decimal2            `json:"bookValue"`
+	ElapsedMonths           int                 `json:"elapsedMonths"`
+	IsFullyDepreciated      bool                `json:"isFullyDepreciated"`
+}
+
+type AssetDepreciationSummaryResponse struct {
+	TotalAssets                  int      `json:"totalAssets"`
+	TotalPurchaseValue           Decimal2 `json:"totalPurchaseValue"`
+	TotalAccumulatedDepreciation Decimal2 `json:"totalAccumulatedDepreciation"`
+	TotalBookValue               Decimal2 `json:"totalBookValue"`
+	FullyDepreciatedAssets       int      `json:"fullyDepreciatedAssets"`
+}
+
+type AssetDepreciationReportResponse struct {
+	AsOfDate time.Time                        `json:"asOfDate"`
+	Summary  AssetDepreciationSummaryResponse `json:"summary"`
+	Assets   []AssetDepreciationResponse      `json:"assets"`
+}
+
+// --- Payloads ---
+
+type ExportAssetDepreciationPayload struct {
+	Format      ExportFormat        `json:"format" validate:"required,oneof=pdf excel"`
+	AsOfDate    *string             `json:"asOfDate,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	SearchQuery *string             `json:"searchQuery,omitempty"`
+	Filters     *AssetFilterOptions `json:"filters,omitempty"`
+	Sort        *AssetSortOptions   `json:"sort,omitempty"`
+}
+
+// --- Calculation ---
+
+// CalculateDepreciation calculates accumulated depreciation and book value as of the given date.
+// Assets without a depreciation method, useful life or purchase date keep their purchase price as book value.
+func CalculateDepreciation(purchasePrice float64, purchaseDate *time.Time, method *DepreciationMethod, usefulLifeYears *int, salvageValuePercent *float64, asOf time.Time) AssetDepreciation {
+	result := AssetDepreciation{
+		Method:          method,
+		UsefulLifeYears: usefulLifeYears,
+		PurchasePrice:   purchasePrice,
+		BookValue:       purchasePrice,
+	}
+
+	if salvageValuePercent != nil {
+		result.SalvageValue = purchasePrice * (*salvageValuePercent) / 100
+	}
+
+	if method == nil || usefulLifeYears == nil || *usefulLifeYears <= 0 || purchaseDate == nil || purchasePrice <= 0 {
+		return result
+	}
+
+	lifeYears := *usefulLifeYears
+	lifeMonths := lifeYears * 12
+	depreciableBase := purchasePrice - result.SalvageValue
+	elapsed := monthsBetween(*purchaseDate, asOf)
+	if elapsed > lifeMonths {
+		elapsed = lifeMonths
+	}
+	result.ElapsedMonths = elapsed
+
+	var accumulated float64
+	switch *method {
+	case DepreciationStraightLine:
+		accumulated = depreciableBase * float64(elapsed) / float64(lifeMonths)
+
+	case DepreciationDecliningBalance:
+		// * Double declining balance, book value tidak boleh di bawah salvage value
+		rate := 2.0 / float64(lifeYears)
+		bookValue := purchasePrice
+		fullYears := elapsed / 12
+		remainingMonths := elapsed % 12
+		for i := 0; i < fullYears; i++ {
+			bookValue -= bookValue * rate
+		}
+		bookValue -= bookValue * rate * float64(remainingMonths) / 12
+		if bookValue < result.SalvageValue || elapsed >= lifeMonths {
+			bookValue = result.SalvageValue
+		}
+		accumulated = purchasePrice - bookValue
+
+	case DepreciationSumOfYearsDigits:
+		sumOfYears := float64(lifeYears*(lifeYears+1)) / 2
+		fullYears := elapsed / 12
+		remainingMonths := elapsed % 12
+		for year := 1; year <= fullYears; year++ {
+			accumulated += depreciableBase * float64(lifeYears-year+1) / sumOfYears
+		}
+		if fullYears < lifeYears {
+			accumulated += depreciableBase * float64(lifeYears-fullYears) / sumOfYears * float64(remainingMonths) / 12
+		}
+
+	default:
+		return result
+	}
+
+	accumulated = math.Max(0, math.Min(accumulated, depreciableBase))
+	result.AccumulatedDepreciation = accumulated
+	result.BookValue = purchasePrice - accumulated
+	result.IsFullyDepreciated = elapsed >= lifeMonths
+
+	return result
+}
+
+// Depreciation calculates the depreciation of the asset using its category configuration.
+// Returns nil when the asset has no purchase price.
+func (a *Asset) Depreciation(asOf time.Time) *AssetDepreciation {
+	if a.PurchasePrice == nil {
+		return nil
+	}
+
+	var method *DepreciationMethod
+	var usefulLifeYears *int
+	var salvageValuePercent *float64
+	if a.Category != nil {
+		method = a.Category.DepreciationMethod
+		usefulLifeYears = a.Category.UsefulLifeYears
+		salvageValuePercent = a.Category.SalvageValuePercent
+	}
+
+	depreciation := CalculateDepreciation(*a.PurchasePrice, a.PurchaseDate, method, usefulLifeYears, salvageValuePercent, asOf)
+	return &depreciation
+}
+
+// monthsBetween returns the number of complete months between two dates
+func monthsBetween(from, to time.Time) int {
+	if !to.After(from) {
+		return 0
+	}
+
+	months := (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+	if to.Day() < from.Day() {
+		months--
+	}
+	if months < 0 {
+		return 0
+	}
+	return months
+}
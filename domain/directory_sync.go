@@ -0,0 +1,74 @@
+package domain
+
+import "time"
+
+// LDAPIdentityIssuer is the issuer stored in user_identities for users managed by the LDAP directory
+const LDAPIdentityIssuer = "ldap"
+
+// --- Enums ---
+
+type DirectorySyncAction string
+
+const (
+	DirectorySyncActionCreate     DirectorySyncAction = "create"
+	DirectorySyncActionUpdate     DirectorySyncAction = "update"
+	DirectorySyncActionDeactivate DirectorySyncAction = "deactivate"
+	DirectorySyncActionSkip       DirectorySyncAction = "skip"
+)
+
+// DirectorySyncDeactivatedRemoved is the reason stored when a linked user is no longer in the directory
+const DirectorySyncDeactivatedRemoved = "removed_from_directory"
+
+type DirectorySyncTrigger string
+
+const (
+	DirectorySyncTriggerSchedule DirectorySyncTrigger = "schedule"
+	DirectorySyncTriggerManual   DirectorySyncTrigger = "manual"
+)
+
+// --- Responses ---
+
+type DirectorySyncFieldChange struct {
+	Field  string `json:"field" example:"fullName"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+// DirectorySyncChange is a single planned (dry run) or applied change for one directory entry or user
+type DirectorySyncChange struct {
+	Action      DirectorySyncAction        `json:"action" example:"update"`
+	UserID      *string                    `json:"userId" example:"01J9ZQ6N7Y3K2V8R5T4W1X0ABC"`
+	DirectoryID string                     `json:"directoryId" example:"5f1c9a2e-8d3b-4c7a-9e61-2b4d7f0a1c33"`
+	Username    string                     `json:"username" example:"jdoe"`
+	Email       string                     `json:"email" example:"john.doe@example.com"`
+	Linked      bool                       `json:"linked" example:"false"` // * User lama yang baru dihubungkan ke direktori lewat email
+	Fields      []DirectorySyncFieldChange `json:"fields,omitempty"`
+	Reason      *string                    `json:"reason,omitempty" example:"email_conflict"`
+	Error       *string                    `json:"error,omitempty"`
+}
+
+type DirectorySyncSummary struct {
+	DirectoryEntries int `json:"directoryEntries" example:"250"`
+	Created          int `json:"created" example:"3"`
+	Updated          int `json:"updated" example:"12"`
+	Deactivated      int `json:"deactivated" example:"1"`
+	Unchanged        int `json:"unchanged" example:"234"`
+	Skipped          int `json:"skipped" example:"0"`
+	Failed           int `json:"failed" example:"0"`
+}
+
+type DirectorySyncResult struct {
+	DryRun     bool                  `json:"dryRun" example:"true"`
+	Trigger    DirectorySyncTrigger  `json:"trigger" example:"manual"`
+	Summary    DirectorySyncSummary  `json:"summary"`
+	Changes    []DirectorySyncChange `json:"changes"`
+	StartedAt  time.Time             `json:"startedAt" example:"2023-01-01T02:00:00Z"`
+	FinishedAt time.Time             `json:"finishedAt" example:"2023-01-01T02:00:05Z"`
+}
+
+// --- Payloads ---
+
+type RunDirectorySyncPayload struct {
+	// * Default true, perubahan hanya diterapkan kalau dryRun dikirim false secara eksplisit
+	DryRun *bool `json:"dryRun,omitempty" example:"true"`
+}
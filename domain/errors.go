@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/Rizz404/inventory-api/internal/utils"
@@ -94,6 +95,11 @@ func ErrConflictWithKey(messageKey utils.MessageKey, params ...string) *AppError
 	return NewAppErrorWithKey(409, messageKey, params, nil)
 }
 
+// * ErrTooManyRequestsWithKey creates a too many requests error with i18n support
+func ErrTooManyRequestsWithKey(messageKey utils.MessageKey, params ...string) *AppError {
+	return NewAppErrorWithKey(429, messageKey, params, nil)
+}
+
 func ErrInternal(err error) *AppError {
 	return NewAppError(500, "an unexpected internal error occured", err)
 }
@@ -107,3 +113,28 @@ func ErrInternalWithMessage(message string) *AppError {
 func ErrInternalWithKey(messageKey utils.MessageKey, params ...string) *AppError {
 	return NewAppErrorWithKey(500, messageKey, params, nil)
 }
+
+// * IsNotFound reports whether err is a 404 AppError, mis. entitas sudah dihapus sebelum background job jalan
+func IsNotFound(err error) bool {
+	var appErr *AppError
+	return errors.As(err, &appErr) && appErr.Code == 404
+}
+
+// * IsClientError reports whether err is a 4xx AppError, hasilnya tidak akan berubah kalau background job di-retry
+func IsClientError(err error) bool {
+	var appErr *AppError
+	return errors.As(err, &appErr) && appErr.Code >= 400 && appErr.Code < 500
+}
+
+// * ErrorMessageWithCause is the error stored on failed background work, pesan AppError dilokalisasi dan cause-nya ikut disimpan
+func ErrorMessageWithCause(err error, langCode string) string {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		msg := appErr.GetLocalizedMessage(langCode)
+		if appErr.Err != nil {
+			msg += ": " + appErr.Err.Error()
+		}
+		return msg
+	}
+	return err.Error()
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// --- Enums ---
+
+type ExportJobStatus string
+
+const (
+	ExportJobStatusPending   ExportJobStatus = "pending"
+	ExportJobStatusRunning   ExportJobStatus = "running"
+	ExportJobStatusSucceeded ExportJobStatus = "succeeded"
+	ExportJobStatusFailed    ExportJobStatus = "failed"
+	// File sudah dihapus cleanup, job tetap ada sebagai riwayat
+	ExportJobStatusExpired ExportJobStatus = "expired"
+)
+
+// IsActive reports whether the job still counts toward the per-user limit
+func (s ExportJobStatus) IsActive() bool {
+	return s == ExportJobStatusPending || s == ExportJobStatusRunning
+}
+
+// --- Structs ---
+
+// ExportJob is an export list processed in the background, entity dan parameternya sama dengan endpoint export list
+type ExportJob struct {
+	ID            string                `json:"id"`
+	UserID        string                `json:"userId"`
+	EntityType    SavedFilterEntityType `json:"entityType"`
+	Format        ExportFormat          `json:"format"`
+	SearchQuery   *string               `json:"searchQuery"`
+	Filters       json.RawMessage       `json:"filters"`
+	Sort          json.RawMessage       `json:"sort"`
+	LangCode      string                `json:"langCode"`
+	Status        ExportJobStatus       `json:"status"`
+	TotalRows     *int                  `json:"totalRows"`
+	ProcessedRows int                   `json:"processedRows"`
+	Attempts      int                   `json:"attempts"`
+	FileName      *string               `json:"fileName"`
+	FileURL       *string               `json:"-"`
+	PublicID      *string               `json:"-"`
+	FileSize      *int64                `json:"fileSize"`
+	Error         *string               `json:"error"`
+	StartedAt     *time.Time            `json:"startedAt"`
+	FinishedAt    *time.Time            `json:"finishedAt"`
+	ExpiresAt     *time.Time            `json:"expiresAt"`
+	CreatedAt     time.Time             `json:"createdAt"`
+	UpdatedAt     time.Time             `json:"updatedAt"`
+}
+
+// ExportJobFile is the generated file of an export job, dipakai untuk endpoint download.
+// Content di-stream langsung dari storage tanpa ditahan di memory, size -1 berarti ukuran tidak diketahui
+type ExportJobFile struct {
+	FileName    string
+	ContentType string
+	Content     io.ReadCloser
+	Size        int64
+}
+
+// --- Responses ---
+
+type ExportJobResponse struct {
+	ID            string                `json:"id"`
+	EntityType    SavedFilterEntityType `json:"entityType"`
+	Format        ExportFormat          `json:"format"`
+	SearchQuery   *string               `json:"searchQuery"`
+	Filters       json.RawMessage       `json:"filters"`
+	Sort          json.RawMessage       `json:"sort"`
+	LangCode      string                `json:"langCode"`
+	Status        ExportJobStatus       `json:"status"`
+	TotalRows     *int                  `json:"totalRows"`
+	ProcessedRows int                   `json:"processedRows"`
+	// Persentase 0-100, null selama total belum dihitung
+	Progress   *int       `json:"progress"`
+	FileName   *string    `json:"fileName"`
+	FileSize   *int64     `json:"fileSize"`
+	Error      *string    `json:"error"`
+	StartedAt  *time.Time `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	// Link download bertanda tangan, hanya ada saat status succeeded dan berlaku sampai downloadUrlExpiresAt
+	DownloadURL          *string    `json:"downloadUrl"`
+	DownloadURLExpiresAt *time.Time `json:"downloadUrlExpiresAt"`
+	CreatedAt            time.Time  `json:"createdAt"`
+	UpdatedAt            time.Time  `json:"updatedAt"`
+}
+
+// --- Payloads ---
+
+// CreateExportJobPayload has the same searchQuery, filters and sort as the entity's export list body
+type CreateExportJobPayload struct {
+	EntityType  SavedFilterEntityType `json:"entityType" validate:"required"`
+	Format      ExportFormat          `json:"format" validate:"required,oneof=pdf excel"`
+	SearchQuery *string               `json:"searchQuery,omitempty" validate:"omitempty,max=255"`
+	Filters     json.RawMessage       `json:"filters,omitempty"`
+	Sort        json.RawMessage       `json:"sort,omitempty"`
+}
+
+// --- Query Parameters ---
+
+type ExportJobFilterOptions struct {
+	Status     *ExportJobStatus       `json:"status,omitempty"`
+	EntityType *SavedFilterEntityType `json:"entityType,omitempty"`
+}
+
+type ExportJobParams struct {
+	Filters    *ExportJobFilterOptions `json:"filters,omitempty"`
+	Pagination *PaginationOptions      `json:"pagination,omitempty"`
+}
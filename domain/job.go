@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// --- Enums ---
+
+type JobType string
+
+const (
+	// Push FCM untuk notifikasi yang sudah tersimpan
+	JobTypeNotificationPush JobType = "notification.push"
+
+	// Email notifikasi, langsung atau digest harian sesuai preferensi user
+	JobTypeNotificationEmail  JobType = "notification.email"
+	JobTypeNotificationDigest JobType = "notification.digest"
+
+	// Notifikasi in-app yang dipicu perubahan data
+	JobTypeAssetNotification               JobType = "asset.notification"
+	JobTypeAssetMovementNotification       JobType = "asset_movement.notification"
+	JobTypeAssetLoanNotification           JobType = "asset_loan.notification"
+	JobTypeIssueReportNotification         JobType = "issue_report.notification"
+	JobTypeMaintenanceScheduleNotification JobType = "maintenance_schedule.notification"
+	JobTypeMaintenanceRecordNotification   JobType = "maintenance_record.notification"
+
+	// Auto-translate bahasa yang belum diisi user
+	JobTypeCategoryTranslation            JobType = "category.translation"
+	JobTypeLocationTranslation            JobType = "location.translation"
+	JobTypeIssueReportTranslation         JobType = "issue_report.translation"
+	JobTypeMaintenanceScheduleTranslation JobType = "maintenance_schedule.translation"
+	JobTypeMaintenanceRecordTranslation   JobType = "maintenance_record.translation"
+
+	// Report dari saved filter, terjadwal atau dijalankan manual
+	JobTypeReportDelivery JobType = "report.delivery"
+
+	// Export list besar yang diproses per batch, hasilnya di-download lewat link bertanda tangan
+	JobTypeExportList JobType = "export.list"
+)
+
+type JobStatus string
+
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	// Dead letter, attempt habis dan menunggu retry manual atau purge
+	JobStatusDead JobStatus = "dead"
+)
+
+// --- Structs ---
+
+type Job struct {
+	ID          string     `json:"id"`
+	Type        JobType    `json:"type"`
+	Payload     string     `json:"-"`
+	Status      JobStatus  `json:"status"`
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"maxAttempts"`
+	RunAt       time.Time  `json:"runAt"`
+	LockedBy    *string    `json:"lockedBy"`
+	LockedUntil *time.Time `json:"lockedUntil"`
+	LastError   *string    `json:"lastError"`
+	CompletedAt *time.Time `json:"completedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// DecodePayload unmarshals the job payload into v
+func (j *Job) DecodePayload(v any) error {
+	return json.Unmarshal([]byte(j.Payload), v)
+}
+
+type JobStatistics struct {
+	ByStatus []JobStatusCount `json:"byStatus"`
+	ByType   []JobTypeCount   `json:"byType"`
+}
+
+type JobStatusCount struct {
+	Status JobStatus `json:"status"`
+	Count  int64     `json:"count"`
+}
+
+type JobTypeCount struct {
+	Type    JobType `json:"type"`
+	Pending int64   `json:"pending"`
+	Running int64   `json:"running"`
+	Dead    int64   `json:"dead"`
+}
+
+// --- Responses ---
+
+type JobResponse struct {
+	ID          string          `json:"id"`
+	Type        JobType         `json:"type"`
+	Payload     json.RawMessage `json:"payload"`
+	Status      JobStatus       `json:"status"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"maxAttempts"`
+	RunAt       time.Time       `json:"runAt"`
+	LockedBy    *string         `json:"lockedBy"`
+	LockedUntil *time.Time      `json:"lockedUntil"`
+	LastError   *string         `json:"lastError"`
+	CompletedAt *time.Time      `json:"completedAt"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+type JobStatisticsResponse struct {
+	ByStatus []JobStatusCount `json:"byStatus"`
+	ByType   []JobTypeCount   `json:"byType"`
+}
+
+type RetryJobsResponse struct {
+	Retried int64 `json:"retried"`
+}
+
+type PurgeJobsResponse struct {
+	Purged int64 `json:"purged"`
+}
+
+// --- Payloads ---
+
+type RetryJobsPayload struct {
+	Type *JobType `json:"type,omitempty"` // Empty retries every dead job
+}
+
+type PurgeJobsPayload struct {
+	Status    JobStatus  `json:"status" validate:"required,oneof=succeeded dead"`
+	Type      *JobType   `json:"type,omitempty"`
+	OlderThan *time.Time `json:"olderThan,omitempty"`
+}
+
+// --- Query Parameters ---
+
+type JobFilterOptions struct {
+	Status *JobStatus `json:"status,omitempty"`
+	Type   *JobType   `json:"type,omitempty"`
+}
+
+type JobParams struct {
+	Filters    *JobFilterOptions  `json:"filters,omitempty"`
+	Pagination *PaginationOptions `json:"pagination,omitempty"`
+}
@@ -74,6 +74,14 @@ type BulkDeleteLocationsResponse struct {
 	DeletedIDS   []string `json:"deletedIds"`
 }
 
+// --- User Location Scope ---
+
+type UserLocationsResponse struct {
+	UserID string `json:"userId"`
+	// Empty means the user has no location access unless their role grants location:all
+	Locations []LocationResponse `json:"locations"`
+}
+
 // --- Bulk Create ---
 
 type BulkCreateLocationsPayload struct {
@@ -118,6 +126,10 @@ type BulkDeleteLocationsPayload struct {
 	IDS []string `json:"ids" validate:"required,min=1,max=100,dive,required"`
 }
 
+type AssignUserLocationsPayload struct {
+	LocationIDs []string `json:"locationIds" validate:"required,max=100,dive,required"` // Replaces the user's locations, empty array removes access to every location
+}
+
 // --- Query Parameters ---
 
 type LocationFilterOptions struct {
@@ -36,6 +36,7 @@ type MaintenanceRecord struct {
 	CreatedAt         time.Time                      `json:"createdAt"`
 	UpdatedAt         time.Time                      `json:"updatedAt"`
 	Translations      []MaintenanceRecordTranslation `json:"translations,omitempty"`
+	StockUsages       []StockTransaction             `json:"stockUsages,omitempty"`
 	// * Preloaded relationships
 	Schedule *MaintenanceSchedule `json:"schedule,omitempty"`
 	Asset    *Asset               `json:"asset,omitempty"`
@@ -72,6 +73,7 @@ type MaintenanceRecordResponse struct {
 	CreatedAt         time.Time                              `json:"createdAt"`
 	UpdatedAt         time.Time                              `json:"updatedAt"`
 	Translations      []MaintenanceRecordTranslationResponse `json:"translations"`
+	StockUsages       []StockTransactionResponse             `json:"stockUsages"`
 	// * Populated
 	Schedule        *MaintenanceScheduleResponse `json:"schedule"`
 	Asset           AssetResponse                `json:"asset"`
@@ -132,6 +134,7 @@ type CreateMaintenanceRecordPayload struct {
 	Result            MaintenanceResult                           `json:"result" validate:"required,oneof=Success Partial Failed Rescheduled"`
 	ActualCost        *float64                                    `json:"actualCost,omitempty" validate:"omitempty,gt=0"`
 	Translations      []CreateMaintenanceRecordTranslationPayload `json:"translations" validate:"required,min=1,dive"`
+	StockUsages       []MaintenanceStockUsagePayload              `json:"stockUsages,omitempty" validate:"omitempty,max=50,dive"`
 }
 
 type CreateMaintenanceRecordTranslationPayload struct {
@@ -33,6 +33,14 @@ const (
 	IntervalYears   IntervalUnit = "Years"
 )
 
+type MaintenanceJob string
+
+const (
+	MaintenanceJobDueSoon          MaintenanceJob = "due-soon"
+	MaintenanceJobOverdue          MaintenanceJob = "overdue"
+	MaintenanceJobProcessSchedules MaintenanceJob = "process-schedules"
+)
+
 type MaintenanceScheduleSortField string
 
 const (
@@ -415,3 +423,15 @@ type MaintenanceScheduleSummaryStatisticsResponse struct {
 	EarliestScheduleDate              time.Time `json:"earliestScheduleDate"`
 	TotalUniqueCreators               int       `json:"totalUniqueCreators"`
 }
+
+// --- Cron Jobs ---
+
+type MaintenanceJobRunResponse struct {
+	Job               MaintenanceJob `json:"job"`
+	Processed         int            `json:"processed"`
+	RecordsCreated    int            `json:"recordsCreated"`
+	WorkOrdersCreated int            `json:"workOrdersCreated"`
+	Failed            int            `json:"failed"`
+	StartedAt         time.Time      `json:"startedAt"`
+	FinishedAt        time.Time      `json:"finishedAt"`
+}
@@ -15,6 +15,7 @@ const (
 	NotificationTypeStatusChange   NotificationType = "STATUS_CHANGE"   // Asset status changes
 	NotificationTypeLocationChange NotificationType = "LOCATION_CHANGE" // Location changes
 	NotificationTypeCategoryChange NotificationType = "CATEGORY_CHANGE" // Category changes
+	NotificationTypeLowStock       NotificationType = "LOW_STOCK"       // Stock item at or below reorder point
 )
 
 type NotificationPriority string
@@ -36,6 +37,18 @@ func (p NotificationPriority) ToFCMPriority() string {
 	}
 }
 
+// NotificationTypes lists every notification type, dipakai untuk mengisi preferensi default
+var NotificationTypes = []NotificationType{
+	NotificationTypeMaintenance,
+	NotificationTypeWarranty,
+	NotificationTypeIssue,
+	NotificationTypeMovement,
+	NotificationTypeStatusChange,
+	NotificationTypeLocationChange,
+	NotificationTypeCategoryChange,
+	NotificationTypeLowStock,
+}
+
 type NotificationSortField string
 
 const (
@@ -121,11 +134,58 @@ type NotificationListResponse struct {
 	Message           string               `json:"message"`
 }
 
+// NotificationPreference holds the channels a user receives for one notification type
+type NotificationPreference struct {
+	UserID    string           `json:"userId"`
+	Type      NotificationType `json:"type"`
+	InApp     bool             `json:"inApp"`
+	Push      bool             `json:"push"`
+	Email     bool             `json:"email"`
+	CreatedAt time.Time        `json:"createdAt"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// DefaultNotificationPreference is used when the user has not set a preference for the type
+func DefaultNotificationPreference(userId string, notificationType NotificationType) NotificationPreference {
+	return NotificationPreference{
+		UserID: userId,
+		Type:   notificationType,
+		InApp:  true,
+		Push:   true,
+		Email:  true,
+	}
+}
+
+type UserNotificationSettings struct {
+	UserID string `json:"userId"`
+	// Email notifikasi digabung jadi satu email harian, bukan dikirim satu per satu
+	EmailDigest      bool       `json:"emailDigest"`
+	LastDigestSentAt *time.Time `json:"lastDigestSentAt"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        time.Time  `json:"updatedAt"`
+}
+
 type BulkDeleteNotifications struct {
 	RequestedIDS []string `json:"requestedIds"`
 	DeletedIDS   []string `json:"deletedIds"`
 }
 
+type NotificationPreferenceResponse struct {
+	Type  NotificationType `json:"type" example:"MAINTENANCE"`
+	InApp bool             `json:"inApp" example:"true"`
+	Push  bool             `json:"push" example:"true"`
+	Email bool             `json:"email" example:"true"`
+}
+
+type NotificationPreferencesResponse struct {
+	EmailDigest      bool                             `json:"emailDigest" example:"false"`
+	LastDigestSentAt *time.Time                       `json:"lastDigestSentAt" example:"2023-01-01T07:00:00Z"`
+	Preferences      []NotificationPreferenceResponse `json:"preferences"`
+	// * Channel yang belum dikonfigurasi di server tidak akan terkirim walaupun preferensinya aktif
+	PushAvailable  bool `json:"pushAvailable" example:"true"`
+	EmailAvailable bool `json:"emailAvailable" example:"true"`
+}
+
 type BulkDeleteNotificationsResponse struct {
 	RequestedIDS []string `json:"requestedIds"`
 	DeletedIDS   []string `json:"deletedIds"`
@@ -177,6 +237,19 @@ type MarkNotificationsPayload struct {
 	NotificationIDs []string `json:"notificationIds" validate:"required,min=1,dive"`
 }
 
+type UpdateNotificationPreferencesPayload struct {
+	EmailDigest *bool                                     `json:"emailDigest,omitempty"`
+	Preferences []UpdateNotificationPreferenceItemPayload `json:"preferences,omitempty" validate:"omitempty,max=20,dive"`
+}
+
+// Channel yang tidak dikirim tidak diubah
+type UpdateNotificationPreferenceItemPayload struct {
+	Type  NotificationType `json:"type" validate:"required,oneof=MAINTENANCE WARRANTY ISSUE MOVEMENT STATUS_CHANGE LOCATION_CHANGE CATEGORY_CHANGE LOW_STOCK"`
+	InApp *bool            `json:"inApp,omitempty"`
+	Push  *bool            `json:"push,omitempty"`
+	Email *bool            `json:"email,omitempty"`
+}
+
 // --- Query Parameters ---
 
 type NotificationFilterOptions struct {
@@ -224,6 +297,7 @@ type NotificationTypeStatistics struct {
 	StatusChange   int `json:"statusChange"`
 	LocationChange int `json:"locationChange"`
 	CategoryChange int `json:"categoryChange"`
+	LowStock       int `json:"lowStock"`
 }
 
 type NotificationStatusStatistics struct {
@@ -267,6 +341,7 @@ type NotificationTypeStatisticsResponse struct {
 	StatusChange   int `json:"statusChange"`
 	LocationChange int `json:"locationChange"`
 	CategoryChange int `json:"categoryChange"`
+	LowStock       int `json:"lowStock"`
 }
 
 type NotificationStatusStatisticsResponse struct {
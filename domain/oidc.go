@@ -0,0 +1,50 @@
+package domain
+
+import "time"
+
+// --- Structs ---
+
+// OIDCAuthRequest is a pending SSO login, state disimpan dalam bentuk hash dan hanya bisa dipakai sekali
+type OIDCAuthRequest struct {
+	ID           string     `json:"id"`
+	StateHash    string     `json:"-"`
+	CodeVerifier string     `json:"-"`
+	Nonce        string     `json:"-"`
+	DeviceName   *string    `json:"deviceName"`
+	RequestedIP  *string    `json:"requestedIp"`
+	ExpiresAt    time.Time  `json:"expiresAt"`
+	ConsumedAt   *time.Time `json:"consumedAt"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
+
+// UserIdentity links a user to a subject of the identity provider
+type UserIdentity struct {
+	ID                    string     `json:"id"`
+	UserID                string     `json:"userId"`
+	Issuer                string     `json:"issuer"`
+	Subject               string     `json:"subject"`
+	Email                 *string    `json:"email"`
+	Provisioned           bool       `json:"provisioned"`           // User dibuat lewat identity ini, bukan akun lokal yang di-link
+	SyncedRole            *UserRole  `json:"syncedRole"`            // Role terakhir yang diterapkan dari group IdP
+	SyncDeactivatedAt     *time.Time `json:"syncDeactivatedAt"`     // Waktu user dinonaktifkan directory sync
+	SyncDeactivatedReason *string    `json:"syncDeactivatedReason"` // Alasan directory sync menonaktifkan user
+	LastLoginAt           *time.Time `json:"lastLoginAt"`
+	CreatedAt             time.Time  `json:"createdAt"`
+	UpdatedAt             time.Time  `json:"updatedAt"`
+}
+
+// --- Responses ---
+
+type OIDCAuthorizationResponse struct {
+	AuthorizationURL string    `json:"authorizationUrl" example:"https://idp.example.com/authorize?client_id=inventory&response_type=code"`
+	State            string    `json:"state" example:"k2VxM0m3Tq3Zb1ZqD6g8nA"`
+	ExpiresAt        time.Time `json:"expiresAt" example:"2023-01-01T00:10:00Z"`
+}
+
+// --- Payloads ---
+
+// OIDCCallbackPayload is what the identity provider sends back to the redirect URI
+type OIDCCallbackPayload struct {
+	Code  string `json:"code" example:"SplxlOBeZQQYbYS6WxSbIA" form:"code" validate:"required"`
+	State string `json:"state" example:"k2VxM0m3Tq3Zb1ZqD6g8nA" form:"state" validate:"required"`
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// --- Structs ---
+
+type PasswordResetCode struct {
+	ID          string     `json:"id"`
+	UserID      *string    `json:"userId"` // Nil kalau email tidak terdaftar
+	Email       string     `json:"email"`
+	CodeHash    string     `json:"-"`
+	Attempts    int        `json:"attempts"`
+	RequestedIP *string    `json:"requestedIp"`
+	ExpiresAt   time.Time  `json:"expiresAt"`
+	UsedAt      *time.Time `json:"usedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
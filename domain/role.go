@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// --- Enums ---
+
+// Permission is a named capability in the form "resource:action".
+// "*" grants every permission and "resource:*" grants every action on a resource.
+type Permission string
+
+const (
+	PermissionAll Permission = "*"
+
+	PermissionAssetCreate Permission = "asset:create"
+	PermissionAssetUpdate Permission = "asset:update"
+	PermissionAssetDelete Permission = "asset:delete"
+	PermissionAssetExport Permission = "asset:export"
+
+	PermissionAssetLoanManage Permission = "asset_loan:manage"
+
+	PermissionAssetMovementCreate Permission = "asset_movement:create"
+	PermissionAssetMovementUpdate Permission = "asset_movement:update"
+	PermissionAssetMovementDelete Permission = "asset_movement:delete"
+	PermissionAssetMovementExport Permission = "asset_movement:export"
+
+	PermissionAuditLogRead    Permission = "audit_log:read"
+	PermissionAuditLogHistory Permission = "audit_log:history"
+
+	PermissionAuditSessionManage Permission = "audit_session:manage"
+	PermissionAuditSessionExport Permission = "audit_session:export"
+
+	PermissionCategoryCreate Permission = "category:create"
+	PermissionCategoryUpdate Permission = "category:update"
+	PermissionCategoryDelete Permission = "category:delete"
+
+	PermissionDirectorySyncRun Permission = "directory_sync:run"
+
+	PermissionIssueReportDelete Permission = "issue_report:delete"
+
+	PermissionLocationCreate Permission = "location:create"
+	PermissionLocationUpdate Permission = "location:update"
+	PermissionLocationDelete Permission = "location:delete"
+	PermissionLocationAssign Permission = "location:assign"
+	PermissionLocationAll    Permission = "location:all" // Akses semua lokasi tanpa melihat user_locations
+
+	PermissionMaintenanceJobRun Permission = "maintenance_job:run"
+
+	PermissionMaintenanceRecordCreate Permission = "maintenance_record:create"
+	PermissionMaintenanceRecordUpdate Permission = "maintenance_record:update"
+	PermissionMaintenanceRecordDelete Permission = "maintenance_record:delete"
+
+	PermissionMaintenanceScheduleCreate Permission = "maintenance_schedule:create"
+	PermissionMaintenanceScheduleUpdate Permission = "maintenance_schedule:update"
+	PermissionMaintenanceScheduleDelete Permission = "maintenance_schedule:delete"
+
+	PermissionNotificationCreate Permission = "notification:create"
+	PermissionNotificationUpdate Permission = "notification:update"
+	PermissionNotificationDelete Permission = "notification:delete"
+
+	PermissionScanLogDelete Permission = "scan_log:delete"
+
+	PermissionStockItemCreate   Permission = "stock_item:create"
+	PermissionStockItemUpdate   Permission = "stock_item:update"
+	PermissionStockItemDelete   Permission = "stock_item:delete"
+	PermissionStockItemTransact Permission = "stock_item:transact"
+
+	PermissionUserRead   Permission = "user:read"
+	PermissionUserCreate Permission = "user:create"
+	PermissionUserUpdate Permission = "user:update"
+	PermissionUserDelete Permission = "user:delete"
+	PermissionUserExport Permission = "user:export"
+
+	PermissionWorkOrderCreate Permission = "work_order:create"
+	PermissionWorkOrderUpdate Permission = "work_order:update"
+	PermissionWorkOrderAssign Permission = "work_order:assign"
+	PermissionWorkOrderDelete Permission = "work_order:delete"
+
+	PermissionRoleRead   Permission = "role:read"
+	PermissionRoleManage Permission = "role:manage"
+
+	PermissionSessionManage Permission = "session:manage"
+
+	PermissionAPIKeyManage Permission = "api_key:manage"
+
+	PermissionWebhookManage Permission = "webhook:manage"
+
+	PermissionJobManage Permission = "job:manage"
+)
+
+// AllPermissions is the catalog of permissions checked by the API routes
+var AllPermissions = []Permission{
+	PermissionAssetCreate, PermissionAssetUpdate, PermissionAssetDelete, PermissionAssetExport,
+	PermissionAssetLoanManage,
+	PermissionAssetMovementCreate, PermissionAssetMovementUpdate, PermissionAssetMovementDelete, PermissionAssetMovementExport,
+	PermissionAuditLogRead, PermissionAuditLogHistory,
+	PermissionAuditSessionManage, PermissionAuditSessionExport,
+	PermissionCategoryCreate, PermissionCategoryUpdate, PermissionCategoryDelete,
+	PermissionDirectorySyncRun,
+	PermissionIssueReportDelete,
+	PermissionLocationCreate, PermissionLocationUpdate, PermissionLocationDelete, PermissionLocationAssign, PermissionLocationAll,
+	PermissionMaintenanceJobRun,
+	PermissionMaintenanceRecordCreate, PermissionMaintenanceRecordUpdate, PermissionMaintenanceRecordDelete,
+	PermissionMaintenanceScheduleCreate, PermissionMaintenanceScheduleUpdate, PermissionMaintenanceScheduleDelete,
+	PermissionNotificationCreate, PermissionNotificationUpdate, PermissionNotificationDelete,
+	PermissionScanLogDelete,
+	PermissionStockItemCreate, PermissionStockItemUpdate, PermissionStockItemDelete, PermissionStockItemTransact,
+	PermissionUserRead, PermissionUserCreate, PermissionUserUpdate, PermissionUserDelete, PermissionUserExport,
+	PermissionWorkOrderCreate, PermissionWorkOrderUpdate, PermissionWorkOrderAssign, PermissionWorkOrderDelete,
+	PermissionRoleRead, PermissionRoleManage,
+	PermissionSessionManage,
+	PermissionAPIKeyManage,
+	PermissionWebhookManage,
+	PermissionJobManage,
+}
+
+// IsValid reports whether the permission is "*", a known permission or a wildcard on a known resource
+func (p Permission) IsValid() bool {
+	if p == PermissionAll || slices.Contains(AllPermissions, p) {
+		return true
+	}
+
+	resource, found := strings.CutSuffix(string(p), ":*")
+	if !found {
+		return false
+	}
+	return slices.ContainsFunc(AllPermissions, func(known Permission) bool {
+		return strings.HasPrefix(string(known), resource+":")
+	})
+}
+
+// HasPermission reports whether the granted permissions cover the required one
+func HasPermission(granted []string, required Permission) bool {
+	resource, _, _ := strings.Cut(string(required), ":")
+	for _, permission := range granted {
+		if permission == string(PermissionAll) || permission == string(required) || permission == resource+":*" {
+			return true
+		}
+	}
+	return false
+}
+
+// --- Structs ---
+
+type Role struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description *string      `json:"description"`
+	IsSystem    bool         `json:"isSystem"`
+	Permissions []Permission `json:"permissions"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+}
+
+type RoleResponse struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description *string      `json:"description"`
+	IsSystem    bool         `json:"isSystem"`
+	Permissions []Permission `json:"permissions"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+}
+
+type UserRolesResponse struct {
+	UserID   string         `json:"userId"`
+	BaseRole UserRole       `json:"baseRole"`
+	Roles    []RoleResponse `json:"roles"`
+	// Effective permissions from the base role and every assigned role
+	Permissions []string `json:"permissions"`
+}
+
+// --- Payloads ---
+
+type CreateRolePayload struct {
+	Name        string       `json:"name" validate:"required,max=50"`
+	Description *string      `json:"description,omitempty" validate:"omitempty,max=255"`
+	Permissions []Permission `json:"permissions" validate:"required,min=1,dive,required,max=100"`
+}
+
+type UpdateRolePayload struct {
+	Name        *string      `json:"name,omitempty" validate:"omitempty,max=50"`
+	Description *string      `json:"description,omitempty" validate:"omitempty,max=255"`
+	Permissions []Permission `json:"permissions,omitempty" validate:"omitempty,dive,required,max=100"` // Replaces the permission set when present
+}
+
+type AssignUserRolesPayload struct {
+	RoleIDs []string `json:"roleIds" validate:"required,max=20,dive,required"` // Replaces the user's assigned roles, empty array clears them
+}
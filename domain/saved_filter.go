@@ -0,0 +1,277 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// --- Enums ---
+
+type SavedFilterEntityType string
+
+const (
+	SavedFilterEntityAsset               SavedFilterEntityType = "asset"
+	SavedFilterEntityAssetMovement       SavedFilterEntityType = "asset_movement"
+	SavedFilterEntityIssueReport         SavedFilterEntityType = "issue_report"
+	SavedFilterEntityMaintenanceSchedule SavedFilterEntityType = "maintenance_schedule"
+	SavedFilterEntityMaintenanceRecord   SavedFilterEntityType = "maintenance_record"
+	SavedFilterEntityScanLog             SavedFilterEntityType = "scan_log"
+	SavedFilterEntityUser                SavedFilterEntityType = "user"
+)
+
+// SavedFilterEntityTypes is the list of entities that have an export list endpoint and can be saved
+var SavedFilterEntityTypes = []SavedFilterEntityType{
+	SavedFilterEntityAsset,
+	SavedFilterEntityAssetMovement,
+	SavedFilterEntityIssueReport,
+	SavedFilterEntityMaintenanceSchedule,
+	SavedFilterEntityMaintenanceRecord,
+	SavedFilterEntityScanLog,
+	SavedFilterEntityUser,
+}
+
+// IsValid reports whether filters for the entity type can be saved
+func (t SavedFilterEntityType) IsValid() bool {
+	return slices.Contains(SavedFilterEntityTypes, t)
+}
+
+// ExportPermission returns the permission the export list endpoint of the entity requires, false kalau cukup login
+func (t SavedFilterEntityType) ExportPermission() (Permission, bool) {
+	switch t {
+	case SavedFilterEntityAsset:
+		return PermissionAssetExport, true
+	case SavedFilterEntityAssetMovement:
+		return PermissionAssetMovementExport, true
+	default:
+		return "", false
+	}
+}
+
+// DecodeExportListPayload rebuilds the export list body (format, searchQuery, filters, sort) and decodes it
+// into the entity's export payload pointer, field yang tidak dikenal ditolak
+func (t SavedFilterEntityType) DecodeExportListPayload(format ExportFormat, searchQuery *string, filters json.RawMessage, sort json.RawMessage) (any, error) {
+	var payload any
+	switch t {
+	case SavedFilterEntityAsset:
+		payload = &ExportAssetListPayload{}
+	case SavedFilterEntityAssetMovement:
+		payload = &ExportAssetMovementListPayload{}
+	case SavedFilterEntityIssueReport:
+		payload = &ExportIssueReportListPayload{}
+	case SavedFilterEntityMaintenanceSchedule:
+		payload = &ExportMaintenanceScheduleListPayload{}
+	case SavedFilterEntityMaintenanceRecord:
+		payload = &ExportMaintenanceRecordListPayload{}
+	case SavedFilterEntityScanLog:
+		payload = &ExportScanLogListPayload{}
+	case SavedFilterEntityUser:
+		payload = &ExportUserListPayload{}
+	default:
+		return nil, fmt.Errorf("unsupported entity type %q", t)
+	}
+
+	body, err := json.Marshal(struct {
+		Format      ExportFormat    `json:"format"`
+		SearchQuery *string         `json:"searchQuery,omitempty"`
+		Filters     json.RawMessage `json:"filters,omitempty"`
+		Sort        json.RawMessage `json:"sort,omitempty"`
+	}{
+		Format:      format,
+		SearchQuery: searchQuery,
+		Filters:     filters,
+		Sort:        sort,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+type ReportRunStatus string
+
+const (
+	ReportRunStatusPending   ReportRunStatus = "pending"
+	ReportRunStatusRunning   ReportRunStatus = "running"
+	ReportRunStatusSucceeded ReportRunStatus = "succeeded"
+	ReportRunStatusFailed    ReportRunStatus = "failed"
+)
+
+type ReportRunTrigger string
+
+const (
+	ReportRunTriggerScheduled ReportRunTrigger = "scheduled"
+	ReportRunTriggerManual    ReportRunTrigger = "manual"
+)
+
+// * Batas supaya jadwal salah ketik (mis. "* * * * *") tidak mengirim email tiap menit
+const MinReportScheduleInterval = time.Hour
+
+// --- Structs ---
+
+type SavedFilter struct {
+	ID          string                `json:"id"`
+	UserID      string                `json:"userId"`
+	EntityType  SavedFilterEntityType `json:"entityType"`
+	Name        string                `json:"name"`
+	SearchQuery *string               `json:"searchQuery"`
+	Filters     json.RawMessage       `json:"filters"`
+	Sort        json.RawMessage       `json:"sort"`
+	Schedule    *ReportSchedule       `json:"schedule"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	UpdatedAt   time.Time             `json:"updatedAt"`
+}
+
+type ReportSchedule struct {
+	ID             string       `json:"id"`
+	SavedFilterID  string       `json:"savedFilterId"`
+	CronExpression string       `json:"cronExpression"`
+	Timezone       string       `json:"timezone"`
+	Format         ExportFormat `json:"format"`
+	Recipients     []string     `json:"recipients"`
+	LangCode       string       `json:"langCode"`
+	IsActive       bool         `json:"isActive"`
+	NextRunAt      *time.Time   `json:"nextRunAt"`
+	LastRunAt      *time.Time   `json:"lastRunAt"`
+	CreatedAt      time.Time    `json:"createdAt"`
+	UpdatedAt      time.Time    `json:"updatedAt"`
+}
+
+// ReportRun is one execution of a saved filter export, baris ini sekaligus riwayat dan lokasi file hasilnya
+type ReportRun struct {
+	ID                  string           `json:"id"`
+	SavedFilterID       string           `json:"savedFilterId"`
+	Trigger             ReportRunTrigger `json:"trigger"`
+	Status              ReportRunStatus  `json:"status"`
+	Format              ExportFormat     `json:"format"`
+	Recipients          []string         `json:"recipients"`
+	DeliveredRecipients []string         `json:"deliveredRecipients"`
+	LangCode            string           `json:"langCode"`
+	Attempts            int              `json:"attempts"`
+	FileName            *string          `json:"fileName"`
+	FileURL             *string          `json:"-"`
+	PublicID            *string          `json:"-"`
+	FileSize            *int64           `json:"fileSize"`
+	Error               *string          `json:"error"`
+	StartedAt           *time.Time       `json:"startedAt"`
+	FinishedAt          *time.Time       `json:"finishedAt"`
+	CreatedBy           *string          `json:"createdBy"`
+	CreatedAt           time.Time        `json:"createdAt"`
+	UpdatedAt           time.Time        `json:"updatedAt"`
+}
+
+// ReportRunFile is the generated file of a run, dipakai untuk endpoint download
+type ReportRunFile struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// --- Responses ---
+
+type SavedFilterResponse struct {
+	ID          string                  `json:"id"`
+	UserID      string                  `json:"userId"`
+	EntityType  SavedFilterEntityType   `json:"entityType"`
+	Name        string                  `json:"name"`
+	SearchQuery *string                 `json:"searchQuery"`
+	Filters     json.RawMessage         `json:"filters"`
+	Sort        json.RawMessage         `json:"sort"`
+	Schedule    *ReportScheduleResponse `json:"schedule"`
+	CreatedAt   time.Time               `json:"createdAt"`
+	UpdatedAt   time.Time               `json:"updatedAt"`
+}
+
+type ReportScheduleResponse struct {
+	ID             string       `json:"id"`
+	CronExpression string       `json:"cronExpression" example:"0 7 * * 1"`
+	Timezone       string       `json:"timezone" example:"Asia/Jakarta"`
+	Format         ExportFormat `json:"format"`
+	Recipients     []string     `json:"recipients"`
+	LangCode       string       `json:"langCode"`
+	IsActive       bool         `json:"isActive"`
+	NextRunAt      *time.Time   `json:"nextRunAt"`
+	LastRunAt      *time.Time   `json:"lastRunAt"`
+	CreatedAt      time.Time    `json:"createdAt"`
+	UpdatedAt      time.Time    `json:"updatedAt"`
+}
+
+type ReportRunResponse struct {
+	ID                  string           `json:"id"`
+	SavedFilterID       string           `json:"savedFilterId"`
+	Trigger             ReportRunTrigger `json:"trigger"`
+	Status              ReportRunStatus  `json:"status"`
+	Format              ExportFormat     `json:"format"`
+	Recipients          []string         `json:"recipients"`
+	DeliveredRecipients []string         `json:"deliveredRecipients"`
+	LangCode            string           `json:"langCode"`
+	Attempts            int              `json:"attempts"`
+	FileName            *string          `json:"fileName"`
+	FileSize            *int64           `json:"fileSize"`
+	HasFile             bool             `json:"hasFile"`
+	Error               *string          `json:"error"`
+	StartedAt           *time.Time       `json:"startedAt"`
+	FinishedAt          *time.Time       `json:"finishedAt"`
+	CreatedBy           *string          `json:"createdBy"`
+	CreatedAt           time.Time        `json:"createdAt"`
+	UpdatedAt           time.Time        `json:"updatedAt"`
+}
+
+// --- Payloads ---
+
+// CreateSavedFilterPayload stores the same searchQuery, filters and sort the entity's export list endpoint accepts
+type CreateSavedFilterPayload struct {
+	EntityType  SavedFilterEntityType `json:"entityType" validate:"required"`
+	Name        string                `json:"name" validate:"required,max=100"`
+	SearchQuery *string               `json:"searchQuery,omitempty" validate:"omitempty,max=255"`
+	Filters     json.RawMessage       `json:"filters,omitempty"`
+	Sort        json.RawMessage       `json:"sort,omitempty"`
+}
+
+type UpdateSavedFilterPayload struct {
+	Name        *string         `json:"name,omitempty" validate:"omitempty,max=100"`
+	SearchQuery *string         `json:"searchQuery,omitempty" validate:"omitempty,max=255"` // Empty string clears the search query
+	Filters     json.RawMessage `json:"filters,omitempty"`                                  // null clears the filters
+	Sort        json.RawMessage `json:"sort,omitempty"`                                     // null clears the sort
+}
+
+// UpsertReportSchedulePayload creates or replaces the delivery schedule, cron 5 field standar atau descriptor seperti @weekly
+type UpsertReportSchedulePayload struct {
+	CronExpression string       `json:"cronExpression" validate:"required,max=100"`
+	Timezone       *string      `json:"timezone,omitempty" validate:"omitempty,max=50"`
+	Format         ExportFormat `json:"format" validate:"required,oneof=pdf excel"`
+	Recipients     []string     `json:"recipients" validate:"required,min=1,max=20,dive,email"`
+	LangCode       *string      `json:"langCode,omitempty" validate:"omitempty,oneof=en-US id-ID ja-JP"`
+	IsActive       *bool        `json:"isActive,omitempty"`
+}
+
+// RunSavedFilterPayload queues a manual run, field kosong memakai nilai dari schedule
+type RunSavedFilterPayload struct {
+	Format     *ExportFormat `json:"format,omitempty" validate:"omitempty,oneof=pdf excel"`
+	Recipients []string      `json:"recipients,omitempty" validate:"omitempty,max=20,dive,email"`
+	LangCode   *string       `json:"langCode,omitempty" validate:"omitempty,oneof=en-US id-ID ja-JP"`
+}
+
+// --- Query Parameters ---
+
+type SavedFilterFilterOptions struct {
+	EntityType *SavedFilterEntityType `json:"entityType,omitempty"`
+}
+
+type ReportRunFilterOptions struct {
+	Status *ReportRunStatus `json:"status,omitempty"`
+}
+
+type ReportRunParams struct {
+	Filters    *ReportRunFilterOptions `json:"filters,omitempty"`
+	Pagination *PaginationOptions      `json:"pagination,omitempty"`
+}
@@ -42,6 +42,7 @@ type ScanLog struct {
 	ScanLocationLat *float64       `json:"scanLocationLat"`
 	ScanLocationLng *float64       `json:"scanLocationLng"`
 	ScanResult      ScanResultType `json:"scanResult"`
+	AuditSessionID  *string        `json:"auditSessionId"`
 }
 
 type ScanLogResponse struct {
@@ -54,6 +55,7 @@ type ScanLogResponse struct {
 	ScanLocationLat *float64       `json:"scanLocationLat"`
 	ScanLocationLng *float64       `json:"scanLocationLng"`
 	ScanResult      ScanResultType `json:"scanResult"`
+	AuditSessionID  *string        `json:"auditSessionId"`
 	// * Populated
 	// ! cuma scan log gak perlu populated table biar gak berat
 	// Asset     *AssetResponse `json:"asset,omitempty"`
@@ -70,6 +72,7 @@ type ScanLogListResponse struct {
 	ScanLocationLat *float64       `json:"scanLocationLat"`
 	ScanLocationLng *float64       `json:"scanLocationLng"`
 	ScanResult      ScanResultType `json:"scanResult"`
+	AuditSessionID  *string        `json:"auditSessionId"`
 }
 
 type BulkDeleteScanLogs struct {
@@ -101,6 +104,7 @@ type CreateScanLogPayload struct {
 	ScanLocationLat *float64       `json:"scanLocationLat,omitempty" validate:"omitempty,latitude"`
 	ScanLocationLng *float64       `json:"scanLocationLng,omitempty" validate:"omitempty,longitude"`
 	ScanResult      ScanResultType `json:"scanResult"`
+	AuditSessionID  *string        `json:"auditSessionId,omitempty" validate:"omitempty"` // * Kosong = pakai sesi audit yang sedang terbuka untuk aset ini
 }
 
 type BulkDeleteScanLogsPayload struct {
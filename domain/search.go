@@ -0,0 +1,80 @@
+package domain
+
+import "slices"
+
+// --- Enums ---
+
+type SearchEntityType string
+
+const (
+	SearchEntityAsset             SearchEntityType = "asset"
+	SearchEntityUser              SearchEntityType = "user"
+	SearchEntityLocation          SearchEntityType = "location"
+	SearchEntityCategory          SearchEntityType = "category"
+	SearchEntityIssueReport       SearchEntityType = "issue_report"
+	SearchEntityMaintenanceRecord SearchEntityType = "maintenance_record"
+)
+
+// SearchEntityTypes is the list of groups returned by the global search, in response order
+var SearchEntityTypes = []SearchEntityType{
+	SearchEntityAsset,
+	SearchEntityUser,
+	SearchEntityLocation,
+	SearchEntityCategory,
+	SearchEntityIssueReport,
+	SearchEntityMaintenanceRecord,
+}
+
+// IsValid reports whether the entity type can be searched
+func (t SearchEntityType) IsValid() bool {
+	return slices.Contains(SearchEntityTypes, t)
+}
+
+const (
+	// * Batas hasil per group di global search
+	DefaultSearchLimit = 5
+	MaxSearchLimit     = 20
+	// * Query lebih pendek dari ini terlalu banyak match dan tidak bisa pakai index trigram
+	MinSearchQueryLength = 2
+)
+
+// --- Structs ---
+
+// SearchHit is a single global search match, Snippet berisi teks yang cocok dengan <mark> di sekitar kata yang match
+type SearchHit struct {
+	ID       string  `json:"id"`
+	Title    string  `json:"title"`
+	Subtitle *string `json:"subtitle"`
+	Snippet  string  `json:"snippet"`
+	Rank     float64 `json:"rank"`
+}
+
+// --- Query Parameters ---
+
+type GlobalSearchParams struct {
+	Query string             `json:"query"`
+	Types []SearchEntityType `json:"types,omitempty"`
+	Limit int                `json:"limit"`
+}
+
+// --- Responses ---
+
+type SearchHitResponse struct {
+	ID       string  `json:"id"`
+	Title    string  `json:"title"`
+	Subtitle *string `json:"subtitle"`
+	Snippet  string  `json:"snippet"`
+	Rank     float64 `json:"rank"`
+}
+
+// GlobalSearchResponse groups hits per entity, group yang tidak diminta lewat types tetap dikirim sebagai array kosong
+type GlobalSearchResponse struct {
+	Query              string              `json:"query"`
+	Total              int                 `json:"total"`
+	Assets             []SearchHitResponse `json:"assets"`
+	Users              []SearchHitResponse `json:"users"`
+	Locations          []SearchHitResponse `json:"locations"`
+	Categories         []SearchHitResponse `json:"categories"`
+	IssueReports       []SearchHitResponse `json:"issueReports"`
+	MaintenanceRecords []SearchHitResponse `json:"maintenanceRecords"`
+}
@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"time"
+)
+
+// --- Enums ---
+
+type StockTransactionType string
+
+const (
+	StockTransactionReceive  StockTransactionType = "Receive"
+	StockTransactionIssue    StockTransactionType = "Issue"
+	StockTransactionTransfer StockTransactionType = "Transfer"
+	StockTransactionAdjust   StockTransactionType = "Adjust"
+)
+
+type StockItemSortField string
+
+const (
+	StockItemSortBySKU          StockItemSortField = "sku"
+	StockItemSortByItemName     StockItemSortField = "itemName"
+	StockItemSortByReorderPoint StockItemSortField = "reorderPoint"
+	StockItemSortByUnitCost     StockItemSortField = "unitCost"
+	StockItemSortByCreatedAt    StockItemSortField = "createdAt"
+	StockItemSortByUpdatedAt    StockItemSortField = "updatedAt"
+)
+
+type StockTransactionSortField string
+
+const (
+	StockTransactionSortByQuantity  StockTransactionSortField = "quantity"
+	StockTransactionSortByCreatedAt StockTransactionSortField = "createdAt"
+)
+
+// --- Structs ---
+
+type StockItem struct {
+	ID           string       `json:"id"`
+	SKU          string       `json:"sku"`
+	ItemName     string       `json:"itemName"`
+	Description  *string      `json:"description"`
+	Unit         string       `json:"unit"`
+	ReorderPoint int          `json:"reorderPoint"`
+	UnitCost     *float64     `json:"unitCost"`
+	IsActive     bool         `json:"isActive"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	UpdatedAt    time.Time    `json:"updatedAt"`
+	Levels       []StockLevel `json:"levels,omitempty"`
+}
+
+// TotalQuantity sums the quantity held at every location
+func (s StockItem) TotalQuantity() int {
+	total := 0
+	for _, level := range s.Levels {
+		total += level.Quantity
+	}
+	return total
+}
+
+// IsLowStock reports whether the total quantity reached the reorder point
+func (s StockItem) IsLowStock() bool {
+	return s.TotalQuantity() <= s.ReorderPoint
+}
+
+// QuantityAt returns the quantity held at the given location
+func (s StockItem) QuantityAt(locationId string) int {
+	for _, level := range s.Levels {
+		if level.LocationID == locationId {
+			return level.Quantity
+		}
+	}
+	return 0
+}
+
+type StockLevel struct {
+	ID         string    `json:"id"`
+	ItemID     string    `json:"itemId"`
+	LocationID string    `json:"locationId"`
+	Quantity   int       `json:"quantity"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	// * Populated
+	Location *Location `json:"location,omitempty"`
+}
+
+type StockTransaction struct {
+	ID                  string               `json:"id"`
+	ItemID              string               `json:"itemId"`
+	Type                StockTransactionType `json:"type"`
+	Quantity            int                  `json:"quantity"`
+	FromLocationID      *string              `json:"fromLocationId"`
+	ToLocationID        *string              `json:"toLocationId"`
+	UnitCost            *float64             `json:"unitCost"`
+	MaintenanceRecordID *string              `json:"maintenanceRecordId"`
+	PerformedBy         *string              `json:"performedBy"`
+	Notes               *string              `json:"notes"`
+	CreatedAt           time.Time            `json:"createdAt"`
+	// * Populated
+	Item            *StockItem `json:"item,omitempty"`
+	FromLocation    *Location  `json:"fromLocation,omitempty"`
+	ToLocation      *Location  `json:"toLocation,omitempty"`
+	PerformedByUser *User      `json:"performedByUser,omitempty"`
+}
+
+// --- Responses ---
+
+type StockItemResponse struct {
+	ID            string               `json:"id"`
+	SKU           string               `json:"sku"`
+	ItemName      string               `json:"itemName"`
+	Description   *string              `json:"description"`
+	Unit          string               `json:"unit"`
+	ReorderPoint  int                  `json:"reorderPoint"`
+	UnitCost      *NullableDecimal2    `json:"unitCost"` // Custom type to ensure 2 decimal places as number
+	IsActive      bool                 `json:"isActive"`
+	TotalQuantity int                  `json:"totalQuantity"`
+	IsLowStock    bool                 `json:"isLowStock"`
+	CreatedAt     time.Time            `json:"createdAt"`
+	UpdatedAt     time.Time            `json:"updatedAt"`
+	Levels        []StockLevelResponse `json:"levels"`
+}
+
+type StockLevelResponse struct {
+	ID         string    `json:"id"`
+	ItemID     string    `json:"itemId"`
+	LocationID string    `json:"locationId"`
+	Quantity   int       `json:"quantity"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	// * Populated
+	Location *LocationResponse `json:"location"`
+}
+
+type StockTransactionResponse struct {
+	ID                  string               `json:"id"`
+	ItemID              string               `json:"itemId"`
+	Type                StockTransactionType `json:"type"`
+	Quantity            int                  `json:"quantity"`
+	FromLocationID      *string              `json:"fromLocationId"`
+	ToLocationID        *string              `json:"toLocationId"`
+	UnitCost            *NullableDecimal2    `json:"unitCost"`  // Custom type to ensure 2 decimal places as number
+	TotalCost           *NullableDecimal2    `json:"totalCost"` // Custom type to ensure 2 decimal places as number
+	MaintenanceRecordID *string              `json:"maintenanceRecordId"`
+	PerformedByID       *string              `json:"performedById"`
+	Notes               *string              `json:"notes"`
+	CreatedAt           time.Time            `json:"createdAt"`
+	// * Populated
+	ItemSKU      string            `json:"itemSku"`
+	ItemName     string            `json:"itemName"`
+	FromLocation *LocationResponse `json:"fromLocation"`
+	ToLocation   *LocationResponse `json:"toLocation"`
+	PerformedBy  *UserResponse     `json:"performedBy"`
+}
+
+// --- Payloads ---
+
+type CreateStockItemPayload struct {
+	SKU          string   `json:"sku" validate:"required,max=50"`
+	ItemName     string   `json:"itemName" validate:"required,max=150"`
+	Description  *string  `json:"description,omitempty" validate:"omitempty"`
+	Unit         string   `json:"unit" validate:"required,max=20"`
+	ReorderPoint int      `json:"reorderPoint" validate:"gte=0"`
+	UnitCost     *float64 `json:"unitCost,omitempty" validate:"omitempty,gte=0"`
+}
+
+type UpdateStockItemPayload struct {
+	SKU          *string  `json:"sku,omitempty" validate:"omitempty,max=50"`
+	ItemName     *string  `json:"itemName,omitempty" validate:"omitempty,max=150"`
+	Description  *string  `json:"description,omitempty" validate:"omitempty"`
+	Unit         *string  `json:"unit,omitempty" validate:"omitempty,max=20"`
+	ReorderPoint *int     `json:"reorderPoint,omitempty" validate:"omitempty,gte=0"`
+	UnitCost     *float64 `json:"unitCost,omitempty" validate:"omitempty,gte=0"`
+	IsActive     *bool    `json:"isActive,omitempty"`
+}
+
+// CreateStockTransactionPayload moves stock of one item.
+// Receive needs toLocationId, Issue needs fromLocationId, Transfer needs both,
+// Adjust needs exactly one of them (toLocationId adds stock, fromLocationId removes it)
+type CreateStockTransactionPayload struct {
+	Type           StockTransactionType `json:"type" validate:"required,oneof=Receive Issue Transfer Adjust"`
+	Quantity       int                  `json:"quantity" validate:"required,gt=0"`
+	FromLocationID *string              `json:"fromLocationId,omitempty" validate:"omitempty"`
+	ToLocationID   *string              `json:"toLocationId,omitempty" validate:"omitempty"`
+	UnitCost       *float64             `json:"unitCost,omitempty" validate:"omitempty,gte=0"`
+	Notes          *string              `json:"notes,omitempty" validate:"omitempty"`
+}
+
+// MaintenanceStockUsagePayload consumes stock from a location for a maintenance record
+type MaintenanceStockUsagePayload struct {
+	StockItemID string `json:"stockItemId" validate:"required"`
+	LocationID  string `json:"locationId" validate:"required"`
+	Quantity    int    `json:"quantity" validate:"required,gt=0"`
+}
+
+// --- Query Parameters ---
+
+type StockItemFilterOptions struct {
+	IsActive   *bool   `json:"isActive,omitempty"`
+	Unit       *string `json:"unit,omitempty"`
+	LocationID *string `json:"locationId,omitempty"`
+	IsLowStock *bool   `json:"isLowStock,omitempty"`
+}
+
+type StockItemSortOptions struct {
+	Field StockItemSortField `json:"field" example:"sku"`
+	Order SortOrder          `json:"order" example:"asc"`
+}
+
+type StockItemParams struct {
+	SearchQuery *string                 `json:"searchQuery,omitempty"`
+	Filters     *StockItemFilterOptions `json:"filters,omitempty"`
+	Sort        *StockItemSortOptions   `json:"sort,omitempty"`
+	Pagination  *PaginationOptions      `json:"pagination,omitempty"`
+}
+
+type StockTransactionFilterOptions struct {
+	ItemID              *string               `json:"itemId,omitempty"`
+	Type                *StockTransactionType `json:"type,omitempty"`
+	LocationID          *string               `json:"locationId,omitempty"`
+	MaintenanceRecordID *string               `json:"maintenanceRecordId,omitempty"`
+	PerformedBy         *string               `json:"performedBy,omitempty"`
+	DateFrom            *time.Time            `json:"dateFrom,omitempty"`
+	DateTo              *time.Time            `json:"dateTo,omitempty"`
+}
+
+type StockTransactionSortOptions struct {
+	Field StockTransactionSortField `json:"field" example:"createdAt"`
+	Order SortOrder                 `json:"order" example:"desc"`
+}
+
+type StockTransactionParams struct {
+	Filters    *StockTransactionFilterOptions `json:"filters,omitempty"`
+	Sort       *StockTransactionSortOptions   `json:"sort,omitempty"`
+	Pagination *PaginationOptions             `json:"pagination,omitempty"`
+}
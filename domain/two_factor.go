@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// --- Structs ---
+
+type UserTwoFactor struct {
+	UserID string `json:"userId"`
+	// Base32 TOTP secret, tidak pernah dikirim ke client setelah enrolment
+	Secret         string     `json:"-"`
+	EnabledAt      *time.Time `json:"enabledAt"`
+	LastUsedStep   int64      `json:"-"`
+	FailedAttempts int        `json:"-"`
+	LockedUntil    *time.Time `json:"-"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+// IsEnabled reports whether enrolment has been confirmed with a valid code
+func (t *UserTwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
+
+// IsLocked reports whether verification is temporarily blocked after too many wrong codes
+func (t *UserTwoFactor) IsLocked(now time.Time) bool {
+	return t.LockedUntil != nil && now.Before(*t.LockedUntil)
+}
+
+// --- Responses ---
+
+type TwoFactorStatusResponse struct {
+	Enabled                bool       `json:"enabled" example:"true"`
+	Required               bool       `json:"required" example:"false"`
+	EnabledAt              *time.Time `json:"enabledAt" example:"2023-01-01T00:00:00Z"`
+	RecoveryCodesRemaining int64      `json:"recoveryCodesRemaining" example:"10"`
+}
+
+type TwoFactorSetupResponse struct {
+	Secret     string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	OtpauthURI string `json:"otpauthUri" example:"otpauth://totp/Inventory:john.doe@example.com?secret=JBSWY3DPEHPK3PXP&issuer=Inventory"`
+	// PNG QR code dalam bentuk data URI supaya bisa langsung dipakai di tag img
+	QRCode string `json:"qrCode" example:"data:image/png;base64,iVBORw0KGgo..."`
+}
+
+type TwoFactorRecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes" example:"k7m2-x9qp"`
+}
+
+// --- Payloads ---
+
+type TwoFactorCodePayload struct {
+	Code string `json:"code" form:"code" validate:"required,len=6,numeric" example:"123456"`
+}
+
+type DisableTwoFactorPayload struct {
+	Password string `json:"password" form:"password" validate:"required" example:"password123"`
+	Code     string `json:"code" form:"code" validate:"required,len=6,numeric" example:"123456"`
+}
+
+type TwoFactorChallengeSetupPayload struct {
+	ChallengeToken string `json:"challengeToken" form:"challengeToken" validate:"required"`
+}
+
+type TwoFactorChallengeVerifyPayload struct {
+	ChallengeToken string `json:"challengeToken" form:"challengeToken" validate:"required"`
+	// Salah satu dari code atau recoveryCode wajib diisi
+	Code         string `json:"code,omitempty" form:"code" validate:"required_without=RecoveryCode,omitempty,len=6,numeric" example:"123456"`
+	RecoveryCode string `json:"recoveryCode,omitempty" form:"recoveryCode" validate:"required_without=Code,omitempty,max=20" example:"k7m2-x9qp"`
+}
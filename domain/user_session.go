@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// --- Enums ---
+
+type SessionRevokedReason string
+
+const (
+	SessionRevokedLogout          SessionRevokedReason = "logout"
+	SessionRevokedLogoutAll       SessionRevokedReason = "logout_all"
+	SessionRevokedTokenReuse      SessionRevokedReason = "token_reuse"
+	SessionRevokedByAdmin         SessionRevokedReason = "admin"
+	SessionRevokedUserDeactivated SessionRevokedReason = "user_deactivated"
+	SessionRevokedPasswordChanged SessionRevokedReason = "password_changed"
+)
+
+// --- Structs ---
+
+type UserSession struct {
+	ID     string `json:"id"`
+	UserID string `json:"userId"`
+	// Token id (jti) refresh token terakhir yang dikeluarkan, token lama yang dipakai lagi berarti reuse
+	CurrentTokenID string                `json:"currentTokenId"`
+	DeviceName     *string               `json:"deviceName"`
+	UserAgent      *string               `json:"userAgent"`
+	IPAddress      *string               `json:"ipAddress"`
+	ExpiresAt      time.Time             `json:"expiresAt"`
+	LastUsedAt     time.Time             `json:"lastUsedAt"`
+	RevokedAt      *time.Time            `json:"revokedAt"`
+	RevokedReason  *SessionRevokedReason `json:"revokedReason"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+}
+
+// IsActive reports whether the session can still be refreshed
+func (s *UserSession) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// SessionClientInfo describes the client a session is bound to
+type SessionClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+type UserSessionResponse struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"userId"`
+	DeviceName *string   `json:"deviceName"`
+	UserAgent  *string   `json:"userAgent"`
+	IPAddress  *string   `json:"ipAddress"`
+	IsCurrent  bool      `json:"isCurrent"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// --- Payloads ---
+
+type LogoutPayload struct {
+	RefreshToken string `json:"refreshToken" form:"refreshToken" validate:"required"`
+}
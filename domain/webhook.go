@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// --- Enums ---
+
+type WebhookEventType string
+
+const (
+	// Berlangganan semua event, termasuk event yang ditambahkan nanti
+	WebhookEventAll WebhookEventType = "*"
+	// Dikirim manual lewat endpoint ping untuk mengetes receiver
+	WebhookEventPing WebhookEventType = "webhook.ping"
+
+	WebhookEventAssetCreated          WebhookEventType = "asset.created"
+	WebhookEventAssetAssigned         WebhookEventType = "asset.assigned"
+	WebhookEventAssetUnassigned       WebhookEventType = "asset.unassigned"
+	WebhookEventAssetStatusChanged    WebhookEventType = "asset.status_changed"
+	WebhookEventAssetConditionChanged WebhookEventType = "asset.condition_changed"
+
+	WebhookEventMovementCreated WebhookEventType = "movement.created"
+
+	WebhookEventLoanCheckedOut WebhookEventType = "loan.checked_out"
+	WebhookEventLoanCheckedIn  WebhookEventType = "loan.checked_in"
+
+	WebhookEventIssueReported WebhookEventType = "issue.reported"
+	WebhookEventIssueUpdated  WebhookEventType = "issue.updated"
+	WebhookEventIssueResolved WebhookEventType = "issue.resolved"
+
+	WebhookEventMaintenanceScheduled WebhookEventType = "maintenance.scheduled"
+	WebhookEventMaintenanceCompleted WebhookEventType = "maintenance.completed"
+	WebhookEventMaintenanceFailed    WebhookEventType = "maintenance.failed"
+
+	WebhookEventWorkOrderCreated   WebhookEventType = "work_order.created"
+	WebhookEventWorkOrderAssigned  WebhookEventType = "work_order.assigned"
+	WebhookEventWorkOrderCancelled WebhookEventType = "work_order.cancelled"
+)
+
+// AllWebhookEventTypes is the catalog of events an endpoint can subscribe to
+var AllWebhookEventTypes = []WebhookEventType{
+	WebhookEventAssetCreated, WebhookEventAssetAssigned, WebhookEventAssetUnassigned, WebhookEventAssetStatusChanged, WebhookEventAssetConditionChanged,
+	WebhookEventMovementCreated,
+	WebhookEventLoanCheckedOut, WebhookEventLoanCheckedIn,
+	WebhookEventIssueReported, WebhookEventIssueUpdated, WebhookEventIssueResolved,
+	WebhookEventMaintenanceScheduled, WebhookEventMaintenanceCompleted, WebhookEventMaintenanceFailed,
+	WebhookEventWorkOrderCreated, WebhookEventWorkOrderAssigned, WebhookEventWorkOrderCancelled,
+}
+
+// IsValid reports whether the event type is "*" or a known event
+func (e WebhookEventType) IsValid() bool {
+	return e == WebhookEventAll || slices.Contains(AllWebhookEventTypes, e)
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// --- Structs ---
+
+type WebhookEndpoint struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	URL         string             `json:"url"`
+	Description *string            `json:"description"`
+	Secret      string             `json:"-"`
+	EventTypes  []WebhookEventType `json:"eventTypes"`
+	IsActive    bool               `json:"isActive"`
+	CreatedBy   *string            `json:"createdBy"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	UpdatedAt   time.Time          `json:"updatedAt"`
+}
+
+// Subscribes reports whether the endpoint wants deliveries for the event type
+func (e *WebhookEndpoint) Subscribes(eventType WebhookEventType) bool {
+	return slices.Contains(e.EventTypes, WebhookEventAll) || slices.Contains(e.EventTypes, eventType)
+}
+
+// WebhookEvent is the JSON body posted to every subscribed endpoint
+type WebhookEvent struct {
+	ID        string           `json:"id"`
+	Type      WebhookEventType `json:"type"`
+	CreatedAt time.Time        `json:"createdAt"`
+	Data      any              `json:"data"`
+}
+
+// WebhookDelivery is one event queued for one endpoint, the row doubles as the delivery log
+type WebhookDelivery struct {
+	ID             string                `json:"id"`
+	EndpointID     string                `json:"endpointId"`
+	EventID        string                `json:"eventId"`
+	EventType      WebhookEventType      `json:"eventType"`
+	Payload        string                `json:"-"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  *time.Time            `json:"nextAttemptAt"`
+	LastAttemptAt  *time.Time            `json:"lastAttemptAt"`
+	ResponseStatus *int                  `json:"responseStatus"`
+	ResponseBody   *string               `json:"responseBody"`
+	Error          *string               `json:"error"`
+	DurationMs     *int64                `json:"durationMs"`
+	DeliveredAt    *time.Time            `json:"deliveredAt"`
+	RedeliveryOf   *string               `json:"redeliveryOf"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+}
+
+// WebhookDeliveryAttempt is the outcome of a single HTTP attempt
+type WebhookDeliveryAttempt struct {
+	Status         WebhookDeliveryStatus
+	AttemptedAt    time.Time
+	NextAttemptAt  *time.Time
+	ResponseStatus *int
+	ResponseBody   *string
+	Error          *string
+	DurationMs     int64
+}
+
+// * Data untuk event perubahan, berisi entitas terbaru dan nilai sebelumnya
+type WebhookAssetStatusChangedData struct {
+	Asset          AssetResponse `json:"asset"`
+	PreviousStatus AssetStatus   `json:"previousStatus"`
+	Status         AssetStatus   `json:"status"`
+}
+
+type WebhookAssetConditionChangedData struct {
+	Asset             AssetResponse  `json:"asset"`
+	PreviousCondition AssetCondition `json:"previousCondition"`
+	Condition         AssetCondition `json:"condition"`
+}
+
+type WebhookAssetAssignmentData struct {
+	Asset                AssetResponse `json:"asset"`
+	AssignedToID         *string       `json:"assignedToId"`
+	PreviousAssignedToID *string       `json:"previousAssignedToId"`
+}
+
+type WebhookIssueUpdatedData struct {
+	IssueReport    IssueReportResponse `json:"issueReport"`
+	PreviousStatus IssueStatus         `json:"previousStatus"`
+}
+
+type WebhookMaintenanceFailedData struct {
+	MaintenanceRecord MaintenanceRecordResponse `json:"maintenanceRecord"`
+	Reason            string                    `json:"reason"`
+}
+
+// --- Responses ---
+
+type WebhookEndpointResponse struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	URL         string             `json:"url" example:"https://erp.example.com/hooks/inventory"`
+	Description *string            `json:"description"`
+	EventTypes  []WebhookEventType `json:"eventTypes"`
+	IsActive    bool               `json:"isActive"`
+	CreatedBy   *string            `json:"createdBy"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	UpdatedAt   time.Time          `json:"updatedAt"`
+}
+
+// WebhookEndpointSecretResponse is returned once after create or rotate, dipakai receiver untuk verifikasi signature
+type WebhookEndpointSecretResponse struct {
+	WebhookEndpointResponse
+	Secret string `json:"secret" example:"whsec_4f9a0c3e8b7d6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string                `json:"id"`
+	EndpointID     string                `json:"endpointId"`
+	EventID        string                `json:"eventId"`
+	EventType      WebhookEventType      `json:"eventType"`
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  *time.Time            `json:"nextAttemptAt"`
+	LastAttemptAt  *time.Time            `json:"lastAttemptAt"`
+	ResponseStatus *int                  `json:"responseStatus"`
+	ResponseBody   *string               `json:"responseBody"`
+	Error          *string               `json:"error"`
+	DurationMs     *int64                `json:"durationMs"`
+	DeliveredAt    *time.Time            `json:"deliveredAt"`
+	RedeliveryOf   *string               `json:"redeliveryOf"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+}
+
+// --- Payloads ---
+
+type CreateWebhookEndpointPayload struct {
+	Name        string             `json:"name" validate:"required,max=100"`
+	URL         string             `json:"url" validate:"required,url,max=500"`
+	Description *string            `json:"description,omitempty" validate:"omitempty,max=255"`
+	EventTypes  []WebhookEventType `json:"eventTypes" validate:"required,min=1"`
+	IsActive    *bool              `json:"isActive,omitempty"`
+}
+
+type UpdateWebhookEndpointPayload struct {
+	Name        *string            `json:"name,omitempty" validate:"omitempty,max=100"`
+	URL         *string            `json:"url,omitempty" validate:"omitempty,url,max=500"`
+	Description *string            `json:"description,omitempty" validate:"omitempty,max=255"`
+	EventTypes  []WebhookEventType `json:"eventTypes,omitempty" validate:"omitempty,min=1"` // Replaces the subscription when present
+	IsActive    *bool              `json:"isActive,omitempty"`
+}
+
+// --- Query Parameters ---
+
+type WebhookDeliveryFilterOptions struct {
+	Status    *WebhookDeliveryStatus `json:"status,omitempty"`
+	EventType *WebhookEventType      `json:"eventType,omitempty"`
+}
+
+type WebhookDeliveryParams struct {
+	Filters    *WebhookDeliveryFilterOptions `json:"filters,omitempty"`
+	Pagination *PaginationOptions            `json:"pagination,omitempty"`
+}
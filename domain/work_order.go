@@ -0,0 +1,362 @@
+package domain
+
+import "time"
+
+// --- Enums ---
+
+type WorkOrderStatus string
+
+const (
+	WorkOrderStatusOpen       WorkOrderStatus = "Open"
+	WorkOrderStatusAssigned   WorkOrderStatus = "Assigned"
+	WorkOrderStatusInProgress WorkOrderStatus = "In Progress"
+	WorkOrderStatusOnHold     WorkOrderStatus = "On Hold"
+	WorkOrderStatusDone       WorkOrderStatus = "Done"
+	WorkOrderStatusCancelled  WorkOrderStatus = "Cancelled"
+)
+
+// workOrderTransitions lists the statuses each status may move to
+var workOrderTransitions = map[WorkOrderStatus][]WorkOrderStatus{
+	WorkOrderStatusOpen:       {WorkOrderStatusAssigned, WorkOrderStatusDone, WorkOrderStatusCancelled},
+	WorkOrderStatusAssigned:   {WorkOrderStatusInProgress, WorkOrderStatusOnHold, WorkOrderStatusDone, WorkOrderStatusCancelled},
+	WorkOrderStatusInProgress: {WorkOrderStatusOnHold, WorkOrderStatusDone, WorkOrderStatusCancelled},
+	WorkOrderStatusOnHold:     {WorkOrderStatusInProgress, WorkOrderStatusCancelled},
+}
+
+// CanTransitionTo reports whether a work order in this status may move to next
+func (s WorkOrderStatus) CanTransitionTo(next WorkOrderStatus) bool {
+	for _, allowed := range workOrderTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal reports whether no further transitions are possible
+func (s WorkOrderStatus) IsTerminal() bool {
+	return s == WorkOrderStatusDone || s == WorkOrderStatusCancelled
+}
+
+type WorkOrderPriority string
+
+const (
+	WorkOrderPriorityLow      WorkOrderPriority = "Low"
+	WorkOrderPriorityMedium   WorkOrderPriority = "Medium"
+	WorkOrderPriorityHigh     WorkOrderPriority = "High"
+	WorkOrderPriorityCritical WorkOrderPriority = "Critical"
+)
+
+type WorkOrderSortField string
+
+const (
+	WorkOrderSortByDueDate     WorkOrderSortField = "dueDate"
+	WorkOrderSortByStatus      WorkOrderSortField = "status"
+	WorkOrderSortByPriority    WorkOrderSortField = "priority"
+	WorkOrderSortByCompletedAt WorkOrderSortField = "completedAt"
+	WorkOrderSortByCreatedAt   WorkOrderSortField = "createdAt"
+	WorkOrderSortByUpdatedAt   WorkOrderSortField = "updatedAt"
+)
+
+// --- Structs ---
+
+type WorkOrder struct {
+	ID                  string                 `json:"id"`
+	ScheduleID          *string                `json:"scheduleId"`
+	IssueReportID       *string                `json:"issueReportId"`
+	AssetID             string                 `json:"assetId"`
+	Status              WorkOrderStatus        `json:"status"`
+	Priority            WorkOrderPriority      `json:"priority"`
+	DueDate             time.Time              `json:"dueDate"`
+	AssignedTo          *string                `json:"assignedTo"`
+	AssignedAt          *time.Time             `json:"assignedAt"`
+	StartedAt           *time.Time             `json:"startedAt"`
+	CompletedBy         *string                `json:"completedBy"`
+	CompletedAt         *time.Time             `json:"completedAt"`
+	CancelledAt         *time.Time             `json:"cancelledAt"`
+	MaintenanceRecordID *string                `json:"maintenanceRecordId"`
+	CreatedBy           *string                `json:"createdBy"`
+	CreatedAt           time.Time              `json:"createdAt"`
+	UpdatedAt           time.Time              `json:"updatedAt"`
+	Translations        []WorkOrderTranslation `json:"translations,omitempty"`
+	Parts               []WorkOrderPart        `json:"parts,omitempty"`
+	// * Preloaded relationships
+	Schedule        *MaintenanceSchedule `json:"schedule,omitempty"`
+	IssueReport     *IssueReport         `json:"issueReport,omitempty"`
+	Asset           *Asset               `json:"asset,omitempty"`
+	AssignedToUser  *User                `json:"assignedToUser,omitempty"`
+	CompletedByUser *User                `json:"completedByUser,omitempty"`
+	CreatedByUser   *User                `json:"createdByUser,omitempty"`
+}
+
+type WorkOrderTranslation struct {
+	ID          string  `json:"id"`
+	WorkOrderID string  `json:"workOrderId"`
+	LangCode    string  `json:"langCode"`
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+}
+
+type WorkOrderPart struct {
+	ID          string    `json:"id"`
+	WorkOrderID string    `json:"workOrderId"`
+	PartName    string    `json:"partName"`
+	PartNumber  *string   `json:"partNumber"`
+	Quantity    int       `json:"quantity"`
+	UnitCost    *float64  `json:"unitCost"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type WorkOrderTranslationResponse struct {
+	LangCode    string  `json:"langCode"`
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+}
+
+type WorkOrderPartResponse struct {
+	ID         string            `json:"id"`
+	PartName   string            `json:"partName"`
+	PartNumber *string           `json:"partNumber"`
+	Quantity   int               `json:"quantity"`
+	UnitCost   *NullableDecimal2 `json:"unitCost"`  // Custom type to ensure 2 decimal places as number
+	TotalCost  *NullableDecimal2 `json:"totalCost"` // Custom type to ensure 2 decimal places as number
+}
+
+type WorkOrderResponse struct {
+	ID                  string                         `json:"id"`
+	ScheduleID          *string                        `json:"scheduleId"`
+	IssueReportID       *string                        `json:"issueReportId"`
+	AssetID             string                         `json:"assetId"`
+	Status              WorkOrderStatus                `json:"status"`
+	Priority            WorkOrderPriority              `json:"priority"`
+	DueDate             time.Time                      `json:"dueDate"`
+	AssignedToID        *string                        `json:"assignedToId"`
+	AssignedAt          *time.Time                     `json:"assignedAt"`
+	StartedAt           *time.Time                     `json:"startedAt"`
+	CompletedByID       *string                        `json:"completedById"`
+	CompletedAt         *time.Time                     `json:"completedAt"`
+	CancelledAt         *time.Time                     `json:"cancelledAt"`
+	MaintenanceRecordID *string                        `json:"maintenanceRecordId"`
+	CreatedByID         *string                        `json:"createdById"`
+	IsOverdue           bool                           `json:"isOverdue"`
+	PartsCost           *NullableDecimal2              `json:"partsCost"` // Custom type to ensure 2 decimal places as number
+	Title               string                         `json:"title"`
+	Description         *string                        `json:"description"`
+	CreatedAt           time.Time                      `json:"createdAt"`
+	UpdatedAt           time.Time                      `json:"updatedAt"`
+	Translations        []WorkOrderTranslationResponse `json:"translations"`
+	Parts               []WorkOrderPartResponse        `json:"parts"`
+	// * Populated
+	Schedule    *MaintenanceScheduleListResponse `json:"schedule"`
+	IssueReport *IssueReportListResponse         `json:"issueReport"`
+	Asset       AssetResponse                    `json:"asset"`
+	AssignedTo  *UserResponse                    `json:"assignedTo"`
+	CompletedBy *UserResponse                    `json:"completedBy"`
+	CreatedBy   *UserResponse                    `json:"createdBy"`
+}
+
+// --- Payloads ---
+
+type CreateWorkOrderPayload struct {
+	AssetID       string                              `json:"assetId" validate:"required"`
+	ScheduleID    *string                             `json:"scheduleId,omitempty"`
+	IssueReportID *string                             `json:"issueReportId,omitempty"`
+	Priority      *WorkOrderPriority                  `json:"priority,omitempty" validate:"omitempty,oneof=Low Medium High Critical"`
+	DueDate       string                              `json:"dueDate" validate:"required,datetime=2006-01-02"`
+	AssignedTo    *string                             `json:"assignedTo,omitempty"`
+	Translations  []CreateWorkOrderTranslationPayload `json:"translations" validate:"required,min=1,dive"`
+	Parts         []WorkOrderPartPayload              `json:"parts,omitempty" validate:"omitempty,dive"`
+}
+
+type CreateWorkOrderTranslationPayload struct {
+	LangCode    string  `json:"langCode" validate:"required,max=5"`
+	Title       string  `json:"title" validate:"required,max=200"`
+	Description *string `json:"description,omitempty"`
+}
+
+type WorkOrderPartPayload struct {
+	PartName   string   `json:"partName" validate:"required,max=150"`
+	PartNumber *string  `json:"partNumber,omitempty" validate:"omitempty,max=100"`
+	Quantity   int      `json:"quantity" validate:"required,gt=0"`
+	UnitCost   *float64 `json:"unitCost,omitempty" validate:"omitempty,gte=0"`
+}
+
+type UpdateWorkOrderPayload struct {
+	Priority     *WorkOrderPriority                  `json:"priority,omitempty" validate:"omitempty,oneof=Low Medium High Critical"`
+	DueDate      *string                             `json:"dueDate,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	Translations []UpdateWorkOrderTranslationPayload `json:"translations,omitempty" validate:"omitempty,dive"`
+	// * Replaces the whole parts list when present, send an empty list to clear it
+	Parts []WorkOrderPartPayload `json:"parts,omitempty" validate:"omitempty,dive"`
+}
+
+type UpdateWorkOrderTranslationPayload struct {
+	LangCode    string  `json:"langCode" validate:"required,max=5"`
+	Title       *string `json:"title,omitempty" validate:"omitempty,max=200"`
+	Description *string `json:"description,omitempty"`
+}
+
+type AssignWorkOrderPayload struct {
+	AssignedTo string `json:"assignedTo" validate:"required"`
+}
+
+type UpdateWorkOrderStatusPayload struct {
+	Status WorkOrderStatus `json:"status" validate:"required,oneof='In Progress' 'On Hold' Cancelled"`
+}
+
+type CompleteWorkOrderPayload struct {
+	CompletionDate    *string           `json:"completionDate,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	DurationMinutes   *int              `json:"durationMinutes,omitempty" validate:"omitempty,gt=0"`
+	PerformedByVendor *string           `json:"performedByVendor,omitempty" validate:"omitempty,max=150"`
+	Result            MaintenanceResult `json:"result" validate:"required,oneof=Success Partial Failed Rescheduled"`
+	ActualCost        *float64          `json:"actualCost,omitempty" validate:"omitempty,gt=0"` // Defaults to the parts cost when omitted
+	Notes             *string           `json:"notes,omitempty"`
+}
+
+type ExportWorkOrderListPayload struct {
+	Format      ExportFormat            `json:"format" validate:"required,oneof=pdf excel"`
+	SearchQuery *string                 `json:"searchQuery,omitempty"`
+	Filters     *WorkOrderFilterOptions `json:"filters,omitempty"`
+	Sort        *WorkOrderSortOptions   `json:"sort,omitempty"`
+}
+
+// --- Query Parameters ---
+
+type WorkOrderFilterOptions struct {
+	Status        *WorkOrderStatus   `json:"status,omitempty"`
+	Priority      *WorkOrderPriority `json:"priority,omitempty"`
+	AssetID       *string            `json:"assetId,omitempty"`
+	ScheduleID    *string            `json:"scheduleId,omitempty"`
+	IssueReportID *string            `json:"issueReportId,omitempty"`
+	AssignedTo    *string            `json:"assignedTo,omitempty"`
+	IsOverdue     *bool              `json:"isOverdue,omitempty"`
+	FromDate      *string            `json:"fromDate,omitempty"` // YYYY-MM-DD
+	ToDate        *string            `json:"toDate,omitempty"`   // YYYY-MM-DD
+}
+
+type WorkOrderSortOptions struct {
+	Field WorkOrderSortField `json:"field" example:"dueDate"`
+	Order SortOrder          `json:"order" example:"asc"`
+}
+
+type WorkOrderParams struct {
+	SearchQuery *string                 `json:"searchQuery,omitempty"`
+	Filters     *WorkOrderFilterOptions `json:"filters,omitempty"`
+	Sort        *WorkOrderSortOptions   `json:"sort,omitempty"`
+	Pagination  *PaginationOptions      `json:"pagination,omitempty"`
+}
+
+// --- Statistics ---
+
+// Internal statistics structs (used in repository layer)
+type WorkOrderStatistics struct {
+	Total           WorkOrderCountStatistics        `json:"total"`
+	ByStatus        WorkOrderStatusStatistics       `json:"byStatus"`
+	ByPriority      WorkOrderPriorityStatistics     `json:"byPriority"`
+	ByTechnician    []TechnicianWorkOrderStatistics `json:"byTechnician"`
+	CompletionTrend []WorkOrderCompletionTrend      `json:"completionTrend"`
+	Summary         WorkOrderSummaryStatistics      `json:"summary"`
+}
+
+type WorkOrderCountStatistics struct {
+	Count int `json:"count"`
+}
+
+type WorkOrderStatusStatistics struct {
+	Open       int `json:"open"`
+	Assigned   int `json:"assigned"`
+	InProgress int `json:"inProgress"`
+	OnHold     int `json:"onHold"`
+	Done       int `json:"done"`
+	Cancelled  int `json:"cancelled"`
+}
+
+type WorkOrderPriorityStatistics struct {
+	Low      int `json:"low"`
+	Medium   int `json:"medium"`
+	High     int `json:"high"`
+	Critical int `json:"critical"`
+}
+
+type TechnicianWorkOrderStatistics struct {
+	UserID                 string  `json:"userId"`
+	UserName               string  `json:"userName"`
+	UserEmail              string  `json:"userEmail"`
+	AssignedCount          int     `json:"assignedCount"`
+	ActiveCount            int     `json:"activeCount"`
+	CompletedCount         int     `json:"completedCount"`
+	AverageCompletionHours float64 `json:"averageCompletionHours"`
+}
+
+type WorkOrderCompletionTrend struct {
+	Date  time.Time `json:"date"`
+	Count int       `json:"count"`
+}
+
+type WorkOrderSummaryStatistics struct {
+	TotalWorkOrders        int     `json:"totalWorkOrders"`
+	ActiveWorkOrders       int     `json:"activeWorkOrders"`
+	OverdueWorkOrders      int     `json:"overdueWorkOrders"`
+	UnassignedWorkOrders   int     `json:"unassignedWorkOrders"`
+	FromSchedules          int     `json:"fromSchedules"`
+	FromIssueReports       int     `json:"fromIssueReports"`
+	CompletionRate         float64 `json:"completionRate"`
+	AverageCompletionHours float64 `json:"averageCompletionHours"`
+	TotalPartsCost         float64 `json:"totalPartsCost"`
+}
+
+// Response statistics structs (used in service/handler layer)
+type WorkOrderStatisticsResponse struct {
+	Total           WorkOrderCountStatisticsResponse        `json:"total"`
+	ByStatus        WorkOrderStatusStatisticsResponse       `json:"byStatus"`
+	ByPriority      WorkOrderPriorityStatisticsResponse     `json:"byPriority"`
+	ByTechnician    []TechnicianWorkOrderStatisticsResponse `json:"byTechnician"`
+	CompletionTrend []WorkOrderCompletionTrendResponse      `json:"completionTrend"`
+	Summary         WorkOrderSummaryStatisticsResponse      `json:"summary"`
+}
+
+type WorkOrderCountStatisticsResponse struct {
+	Count int `json:"count"`
+}
+
+type WorkOrderStatusStatisticsResponse struct {
+	Open       int `json:"open"`
+	Assigned   int `json:"assigned"`
+	InProgress int `json:"inProgress"`
+	OnHold     int `json:"onHold"`
+	Done       int `json:"done"`
+	Cancelled  int `json:"cancelled"`
+}
+
+type WorkOrderPriorityStatisticsResponse struct {
+	Low      int `json:"low"`
+	Medium   int `json:"medium"`
+	High     int `json:"high"`
+	Critical int `json:"critical"`
+}
+
+type TechnicianWorkOrderStatisticsResponse struct {
+	UserID                 string   `json:"userId"`
+	UserName               string   `json:"userName"`
+	UserEmail              string   `json:"userEmail"`
+	AssignedCount          int      `json:"assignedCount"`
+	ActiveCount            int      `json:"activeCount"`
+	CompletedCount         int      `json:"completedCount"`
+	AverageCompletionHours Decimal2 `json:"averageCompletionHours"` // Custom type to ensure 2 decimal places as number
+}
+
+type WorkOrderCompletionTrendResponse struct {
+	Date  time.Time `json:"date"`
+	Count int       `json:"count"`
+}
+
+type WorkOrderSummaryStatisticsResponse struct {
+	TotalWorkOrders        int      `json:"totalWorkOrders"`
+	ActiveWorkOrders       int      `json:"activeWorkOrders"`
+	OverdueWorkOrders      int      `json:"overdueWorkOrders"`
+	UnassignedWorkOrders   int      `json:"unassignedWorkOrders"`
+	FromSchedules          int      `json:"fromSchedules"`
+	FromIssueReports       int      `json:"fromIssueReports"`
+	CompletionRate         Decimal2 `json:"completionRate"`         // Custom type to ensure 2 decimal places as number
+	AverageCompletionHours Decimal2 `json:"averageCompletionHours"` // Custom type to ensure 2 decimal places as number
+	TotalPartsCost         Decimal2 `json:"totalPartsCost"`         // Custom type to ensure 2 decimal places as number
+}
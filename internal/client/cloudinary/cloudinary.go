@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/Rizz404/inventory-api/internal/client/storage"
 	"github.com/cloudinary/cloudinary-go/v2"
 	"github.com/cloudinary/cloudinary-go/v2/api"
 	"github.com/cloudinary/cloudinary-go/v2/api/admin"
@@ -17,38 +18,16 @@ type Client struct {
 	cld *cloudinary.Cloudinary
 }
 
-type UploadConfig struct {
-	AllowedTypes   []string `json:"allowedTypes"`   // e.g., ["image/jpeg", "image/png", "image/gif"]
-	FolderName     string   `json:"folderName"`     // e.g., "avatars", "documents"
-	InputName      string   `json:"inputName"`      // e.g., "avatar", "file"
-	MaxFiles       int      `json:"maxFiles"`       // Maximum number of files for multiple upload
-	MaxFileSize    int64    `json:"maxFileSize"`    // Maximum file size in bytes (e.g., 5MB = 5*1024*1024)
-	PublicID       *string  `json:"publicId"`       // Optional custom public ID
-	Overwrite      bool     `json:"overwrite"`      // Whether to overwrite existing files
-	Transformation string   `json:"transformation"` // Incoming transformation applied to original (e.g., "w_1920,c_limit/f_webp,q_auto")
-}
-
-type UploadResult struct {
-	PublicID     string `json:"publicId"`
-	URL          string `json:"url"`
-	SecureURL    string `json:"secureUrl"`
-	Format       string `json:"format"`
-	ResourceType string `json:"resourceType"`
-	Bytes        int    `json:"bytes"`
-	Width        int    `json:"width"`
-	Height       int    `json:"height"`
-	OriginalName string `json:"originalName"`
-}
-
-type MultiUploadResult struct {
-	Results []UploadResult `json:"results"`
-	Failed  []UploadError  `json:"failed"`
-}
+// * Tipe upload dipakai bersama semua backend storage
+type (
+	UploadConfig      = storage.UploadConfig
+	UploadResult      = storage.UploadResult
+	MultiUploadResult = storage.MultiUploadResult
+	UploadError       = storage.UploadError
+)
 
-type UploadError struct {
-	FileName string `json:"fileName"`
-	Error    string `json:"error"`
-}
+// * Ensure Client implements storage.Storage interface
+var _ storage.Storage = (*Client)(nil)
 
 // NewClient creates a new Cloudinary client
 func NewClient(cloudName, apiKey, apiSecret string) (*Client, error) {
@@ -323,150 +302,9 @@ func (c *Client) GenerateTransformationURL(publicID string, transformations stri
 	return urlString, nil
 }
 
-// GetAvatarUploadConfig returns a pre-configured upload config for user avatars
-// * Incoming transformation: resize max 500px + WebP + auto quality
-// * Original stored optimized, ~70-80% smaller
-func GetAvatarUploadConfig() UploadConfig {
-	return UploadConfig{
-		AllowedTypes: []string{
-			".jpg",
-			".jpeg",
-			".png",
-			".gif",
-			".webp",
-			".bmp",
-			".tiff",
-			".tif",
-			".svg",
-			".ico",
-			".heic",
-			".heif",
-			".avif",
-		},
-		FolderName:     "sigma-asset/avatars",
-		InputName:      "avatar",
-		MaxFiles:       1,
-		MaxFileSize:    10 * 1024 * 1024, // 10MB
-		Overwrite:      true,
-		Transformation: "w_500,c_limit/f_webp,q_auto", // Resize max 500px + WebP + auto quality
-	}
-}
-
-// GetDataMatrixImageUploadConfig returns a pre-configured upload config for asset data matrix images
-// * Incoming transformation: keep PNG for barcode clarity + best quality compression
-// * Original stored optimized while maintaining scanability
-func GetDataMatrixImageUploadConfig() UploadConfig {
-	return UploadConfig{
-		AllowedTypes: []string{
-			".jpg",
-			".jpeg",
-			".png",
-			".gif",
-			".webp",
-			".bmp",
-			".tiff",
-			".tif",
-			".svg",
-			".avif",
-		},
-		FolderName:     "sigma-asset/datamatrix",
-		InputName:      "dataMatrixImage",
-		MaxFiles:       1,
-		MaxFileSize:    2 * 1024 * 1024, // 2MB
-		Overwrite:      true,
-		Transformation: "f_png,q_auto:best", // Keep PNG for barcode clarity + best quality compression
-	}
-}
-
-// GetBulkDataMatrixImageUploadConfig returns a pre-configured upload config for bulk data matrix images
-// * Incoming transformation: keep PNG for barcode clarity + best quality compression
-func GetBulkDataMatrixImageUploadConfig() UploadConfig {
-	return UploadConfig{
-		AllowedTypes: []string{
-			".jpg",
-			".jpeg",
-			".png",
-			".gif",
-			".webp",
-			".bmp",
-			".tiff",
-			".tif",
-			".svg",
-			".avif",
-		},
-		FolderName:     "sigma-asset/datamatrix",
-		InputName:      "dataMatrixImages",
-		MaxFiles:       0,                // No limit for bulk upload
-		MaxFileSize:    10 * 1024 * 1024, // 10MB per file
-		Overwrite:      true,
-		Transformation: "f_png,q_auto:best", // Keep PNG for barcode clarity
-	}
-}
-
-// GetDocumentUploadConfig returns a pre-configured upload config for documents
-// * Incoming transformation: auto format + quality for images, PDFs unchanged
-func GetDocumentUploadConfig() UploadConfig {
-	return UploadConfig{
-		AllowedTypes: []string{
-			".pdf",
-			".jpg",
-			".jpeg",
-			".png",
-			".gif",
-			".webp",
-			".tiff",
-			".tif",
-			".bmp",
-		},
-		FolderName:     "sigma-asset/documents",
-		InputName:      "documents",
-		MaxFiles:       10,
-		MaxFileSize:    10 * 1024 * 1024, // 10MB
-		Overwrite:      false,
-		Transformation: "f_auto,q_auto", // Auto format + quality for images
-	}
-}
-
-// GetCategoryImageUploadConfig returns a pre-configured upload config for category images
-// * Incoming transformation: resize to max 800px + WebP + auto quality
-// * Original stored optimized, ~75-85% smaller
-func GetCategoryImageUploadConfig() UploadConfig {
-	return UploadConfig{
-		AllowedTypes: []string{
-			".jpg",
-			".jpeg",
-			".png",
-			".gif",
-			".webp",
-		},
-		FolderName:     "sigma-asset/categories",
-		InputName:      "image",
-		MaxFiles:       1,
-		MaxFileSize:    10 * 1024 * 1024, // 10MB
-		Overwrite:      false,
-		Transformation: "w_800,c_limit/f_webp,q_auto", // Resize max 800px + WebP + auto quality
-	}
-}
-
-// GetAssetImageUploadConfig returns a pre-configured upload config for asset images
-// * Incoming transformation: resize to max 1920px + WebP + auto quality
-// * Original stored optimized, ~70-80% smaller
-func GetAssetImageUploadConfig() UploadConfig {
-	return UploadConfig{
-		AllowedTypes: []string{
-			".jpg",
-			".jpeg",
-			".png",
-			".gif",
-			".webp",
-		},
-		FolderName:     "sigma-asset/assets",
-		InputName:      "images",
-		MaxFiles:       10,               // Allow up to 10 images per asset
-		MaxFileSize:    10 * 1024 * 1024, // 10MB per image
-		Overwrite:      false,
-		Transformation: "w_1920,c_limit/f_webp,q_auto", // Resize max 1920px + WebP + auto quality
-	}
+// ExtractPublicIDFromURL implements storage.Storage
+func (c *Client) ExtractPublicIDFromURL(url string) string {
+	return ExtractPublicIDFromURL(url)
 }
 
 // ExtractPublicIDFromURL extracts the public ID from a Cloudinary URL
@@ -0,0 +1,219 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// Client reads users from an LDAP / Active Directory server using a service account
+type Client struct {
+	URL          string
+	BindDN       string
+	BindPassword string
+	BaseDN       string
+	UserFilter   string
+	StartTLS     bool
+	TLSConfig    *tls.Config
+	PageSize     uint32
+	Timeout      time.Duration
+	Attributes   AttributeMapping
+}
+
+// AttributeMapping maps directory attributes to user fields, attribute kosong berarti field tidak disinkronkan
+type AttributeMapping struct {
+	ID          string // * Harus stabil walaupun user di-rename, misal entryUUID atau objectGUID
+	Username    string
+	FullName    string
+	Email       string
+	EmployeeID  string
+	PhoneNumber string
+}
+
+// Entry is a directory user with the mapped attributes already extracted
+type Entry struct {
+	DN          string
+	ID          string
+	Username    string
+	FullName    string
+	Email       string
+	EmployeeID  string
+	PhoneNumber string
+}
+
+// * Atribut biner yang di-encode hex supaya bisa disimpan sebagai subject
+var binaryIDAttributes = map[string]bool{
+	"objectguid": true,
+}
+
+// SearchUsers returns every entry under BaseDN that matches UserFilter, memakai paging supaya tidak kena size limit server
+func (c *Client) SearchUsers(ctx context.Context) ([]Entry, error) {
+	conn, closeConn, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer closeConn()
+
+	request := goldap.NewSearchRequest(
+		c.BaseDN,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 0, 0, false,
+		c.UserFilter,
+		c.Attributes.list(),
+		nil,
+	)
+
+	result, err := conn.SearchWithPaging(request, c.PageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search directory users: %w", err)
+	}
+
+	entries := make([]Entry, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		entries = append(entries, c.toEntry(entry))
+	}
+
+	return entries, nil
+}
+
+// Authenticate binds as the user identified by the ID attribute, false berarti password salah atau user tidak ada di direktori
+func (c *Client) Authenticate(ctx context.Context, id string, password string) (bool, error) {
+	// ! Bind dengan password kosong adalah unauthenticated bind dan akan selalu sukses
+	if id == "" || password == "" {
+		return false, nil
+	}
+
+	conn, closeConn, err := c.connect(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer closeConn()
+
+	filter := fmt.Sprintf("(&%s(%s=%s))", c.UserFilter, c.Attributes.ID, c.escapeID(id))
+	request := goldap.NewSearchRequest(
+		c.BaseDN,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 2, 0, false,
+		filter,
+		[]string{"dn"},
+		nil,
+	)
+
+	result, err := conn.Search(request)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up directory user: %w", err)
+	}
+	if len(result.Entries) != 1 {
+		return false, nil
+	}
+
+	if err := conn.Bind(result.Entries[0].DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to bind as directory user: %w", err)
+	}
+
+	return true, nil
+}
+
+// connect dials the server, upgrades to TLS when configured and binds with the service account.
+// Fungsi close yang dikembalikan wajib dipanggil, koneksi juga ditutup otomatis kalau context dibatalkan.
+func (c *Client) connect(ctx context.Context) (*goldap.Conn, func(), error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := goldap.DialURL(c.URL,
+		goldap.DialWithDialer(&net.Dialer{Timeout: c.Timeout}),
+		goldap.DialWithTLSConfig(c.TLSConfig),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to directory: %w", err)
+	}
+	conn.SetTimeout(c.Timeout)
+
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	closeConn := func() {
+		stop()
+		conn.Close()
+	}
+
+	if c.StartTLS && !strings.HasPrefix(strings.ToLower(c.URL), "ldaps://") {
+		if err := conn.StartTLS(c.TLSConfig); err != nil {
+			closeConn()
+			return nil, nil, fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if c.BindDN != "" {
+		if err := conn.Bind(c.BindDN, c.BindPassword); err != nil {
+			closeConn()
+			return nil, nil, fmt.Errorf("failed to bind service account: %w", err)
+		}
+	}
+
+	return conn, closeConn, nil
+}
+
+func (c *Client) toEntry(entry *goldap.Entry) Entry {
+	return Entry{
+		DN:          entry.DN,
+		ID:          c.idValue(entry),
+		Username:    attributeValue(entry, c.Attributes.Username),
+		FullName:    attributeValue(entry, c.Attributes.FullName),
+		Email:       attributeValue(entry, c.Attributes.Email),
+		EmployeeID:  attributeValue(entry, c.Attributes.EmployeeID),
+		PhoneNumber: attributeValue(entry, c.Attributes.PhoneNumber),
+	}
+}
+
+func (c *Client) idValue(entry *goldap.Entry) string {
+	raw := entry.GetRawAttributeValue(c.Attributes.ID)
+	if len(raw) == 0 {
+		return ""
+	}
+	if c.binaryID() {
+		return hex.EncodeToString(raw)
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+// escapeID builds the filter value for the ID attribute, atribut biner di-escape per byte
+func (c *Client) escapeID(id string) string {
+	if c.binaryID() {
+		if raw, err := hex.DecodeString(id); err == nil {
+			var builder strings.Builder
+			for _, b := range raw {
+				fmt.Fprintf(&builder, "\\%02x", b)
+			}
+			return builder.String()
+		}
+	}
+	return goldap.EscapeFilter(id)
+}
+
+func (c *Client) binaryID() bool {
+	return binaryIDAttributes[strings.ToLower(c.Attributes.ID)]
+}
+
+func (m AttributeMapping) list() []string {
+	attributes := make([]string, 0, 6)
+	for _, attribute := range []string{m.ID, m.Username, m.FullName, m.Email, m.EmployeeID, m.PhoneNumber} {
+		if attribute != "" {
+			attributes = append(attributes, attribute)
+		}
+	}
+	return attributes
+}
+
+func attributeValue(entry *goldap.Entry, attribute string) string {
+	if attribute == "" {
+		return ""
+	}
+	return strings.TrimSpace(entry.GetAttributeValue(attribute))
+}
@@ -0,0 +1,347 @@
+package localstorage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+	"github.com/oklog/ulid/v2"
+)
+
+// RoutePrefix is the path the files are served from, lihat rest.NewFileHandler
+const RoutePrefix = "/files/"
+
+// Client stores files on the local disk, file disajikan Fiber lewat URL yang ditandatangani HMAC
+type Client struct {
+	root       string
+	baseURL    string
+	signingKey []byte
+}
+
+// * Ensure Client implements storage.Storage, storage.Reader and storage.Opener interfaces
+var (
+	_ storage.Storage = (*Client)(nil)
+	_ storage.Reader  = (*Client)(nil)
+	_ storage.Opener  = (*Client)(nil)
+)
+
+// NewClient creates a local storage client, baseURL adalah URL publik API tanpa trailing slash
+func NewClient(root, baseURL, signingKey string) (*Client, error) {
+	if signingKey == "" {
+		return nil, fmt.Errorf("signing key is required")
+	}
+
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve storage root: %w", err)
+	}
+	if err := os.MkdirAll(absRoot, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage root: %w", err)
+	}
+
+	return &Client{
+		root:       absRoot,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		signingKey: []byte(signingKey),
+	}, nil
+}
+
+// UploadSingleFile stores a single file under its folder and public ID
+func (c *Client) UploadSingleFile(ctx context.Context, file *multipart.FileHeader, config storage.UploadConfig) (*storage.UploadResult, error) {
+	if err := storage.ValidateFile(file, config); err != nil {
+		return nil, err
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	publicID := strings.ToLower(ulid.Make().String())
+	if config.PublicID != nil && *config.PublicID != "" {
+		publicID = *config.PublicID
+	}
+
+	result, err := c.Put(ctx, storage.ObjectKey(config.FolderName, publicID), filepath.Ext(file.Filename), data, config.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+	result.OriginalName = file.Filename
+
+	return result, nil
+}
+
+// UploadMultipleFiles stores multiple files with generated public IDs
+func (c *Client) UploadMultipleFiles(ctx context.Context, files []*multipart.FileHeader, baseConfig storage.UploadConfig) (*storage.MultiUploadResult, error) {
+	return storage.UploadMultiple(ctx, c.UploadSingleFile, files, nil, baseConfig)
+}
+
+// UploadMultipleFilesWithPublicIDs stores multiple files with custom public IDs for each file
+func (c *Client) UploadMultipleFilesWithPublicIDs(ctx context.Context, files []*multipart.FileHeader, publicIDs []string, baseConfig storage.UploadConfig) (*storage.MultiUploadResult, error) {
+	return storage.UploadMultiple(ctx, c.UploadSingleFile, files, publicIDs, baseConfig)
+}
+
+// Put writes data as publicID + ext. Seperti Cloudinary, file yang sudah ada dengan public ID sama
+// dikembalikan apa adanya kalau overwrite false, dan diganti (termasuk yang beda ekstensi) kalau true
+func (c *Client) Put(ctx context.Context, publicID, ext string, data []byte, overwrite bool) (*storage.UploadResult, error) {
+	ext = strings.ToLower(ext)
+
+	existing, err := c.findFiles(publicID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 && !overwrite {
+		return c.fileResult(publicID, existing[0])
+	}
+
+	name := publicID + ext
+	path, err := c.path(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	// * Tulis ke file sementara lalu rename, supaya request yang sedang membaca tidak dapat file setengah jadi
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return nil, fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return nil, fmt.Errorf("failed to store file: %w", err)
+	}
+
+	for _, old := range existing {
+		if old != path {
+			os.Remove(old)
+		}
+	}
+
+	result, err := c.fileResult(publicID, path)
+	if err != nil {
+		return nil, err
+	}
+	if config, _, err := image.DecodeConfig(bytes.NewReader(data)); err == nil {
+		result.Width = config.Width
+		result.Height = config.Height
+	}
+
+	return result, nil
+}
+
+// DeleteFile deletes a file by public ID, public ID yang tidak ada bukan error
+func (c *Client) DeleteFile(ctx context.Context, publicID string) error {
+	files, err := c.findFiles(publicID)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to delete file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteMultipleFiles deletes multiple files by public IDs
+func (c *Client) DeleteMultipleFiles(ctx context.Context, publicIDs []string) (int, []string, error) {
+	return storage.DeleteMultiple(ctx, c.DeleteFile, publicIDs)
+}
+
+// ExtractPublicIDFromURL extracts the public ID from a URL produced by this client
+// Example: https://api.example.com/files/sigma-asset/avatars/user-01HQXXX-avatar.webp?v=1700000000&sig=...
+// Returns: sigma-asset/avatars/user-01HQXXX-avatar
+func (c *Client) ExtractPublicIDFromURL(rawURL string) string {
+	name := c.fileName(rawURL)
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
+
+// ReadFile reads a stored file directly from disk, tanpa request HTTP ke API sendiri
+func (c *Client) ReadFile(ctx context.Context, fileURL string) ([]byte, error) {
+	name := c.fileName(fileURL)
+	if name == "" {
+		return nil, fmt.Errorf("file URL was not produced by local storage: %q", fileURL)
+	}
+
+	path, err := c.FilePath(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return os.ReadFile(path)
+}
+
+// OpenFile opens a stored file directly from disk for streaming
+func (c *Client) OpenFile(ctx context.Context, fileURL string) (io.ReadCloser, int64, error) {
+	name := c.fileName(fileURL)
+	if name == "" {
+		return nil, 0, fmt.Errorf("file URL was not produced by local storage: %q", fileURL)
+	}
+
+	path, err := c.FilePath(name)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return file, info.Size(), nil
+}
+
+// Verify checks the signature of a file URL
+func (c *Client) Verify(name, signature string) bool {
+	return hmac.Equal([]byte(signature), []byte(c.sign(name)))
+}
+
+// FilePath returns the path on disk of a stored file name (public ID + ekstensi)
+func (c *Client) FilePath(name string) (string, error) {
+	path, err := c.path(name)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if !info.Mode().IsRegular() {
+		return "", os.ErrNotExist
+	}
+
+	return path, nil
+}
+
+// URL returns the signed URL of a stored file name, v berubah tiap file ditimpa supaya cache client ikut berubah
+func (c *Client) URL(name string, version int64) string {
+	segments := strings.Split(name, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return c.baseURL + RoutePrefix + strings.Join(segments, "/") + "?v=" + strconv.FormatInt(version, 10) + "&sig=" + c.sign(name)
+}
+
+// fileName returns the stored file name (public ID + ekstensi) of a URL produced by this client
+func (c *Client) fileName(rawURL string) string {
+	prefix := c.baseURL + RoutePrefix
+	if !strings.HasPrefix(rawURL, prefix) {
+		return ""
+	}
+
+	escapedName, _, _ := strings.Cut(strings.TrimPrefix(rawURL, prefix), "?")
+	name, err := url.PathUnescape(escapedName)
+	if err != nil {
+		return ""
+	}
+
+	return name
+}
+
+func (c *Client) sign(name string) string {
+	mac := hmac.New(sha256.New, c.signingKey)
+	mac.Write([]byte(name))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// path resolves a file name inside the storage root, nama yang keluar dari root ditolak
+func (c *Client) path(name string) (string, error) {
+	if name == "" || strings.Contains(name, "\\") {
+		return "", fmt.Errorf("invalid file name: %q", name)
+	}
+
+	path := filepath.Join(c.root, filepath.FromSlash(filepath.Clean("/"+name)))
+	if !strings.HasPrefix(path, c.root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid file name: %q", name)
+	}
+
+	return path, nil
+}
+
+// findFiles returns the stored files of a public ID with any extension
+func (c *Client) findFiles(publicID string) ([]string, error) {
+	path, err := c.path(publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read folder: %w", err)
+	}
+
+	base := filepath.Base(path)
+	var files []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && strings.TrimSuffix(name, filepath.Ext(name)) == base {
+			files = append(files, filepath.Join(filepath.Dir(path), name))
+		}
+	}
+
+	return files, nil
+}
+
+func (c *Client) fileResult(publicID, path string) (*storage.UploadResult, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	ext := filepath.Ext(path)
+	fileURL := c.URL(publicID+ext, info.ModTime().Unix())
+
+	return &storage.UploadResult{
+		PublicID:     publicID,
+		URL:          fileURL,
+		SecureURL:    fileURL,
+		Format:       strings.TrimPrefix(ext, "."),
+		ResourceType: storage.ResourceType(ext),
+		Bytes:        int(info.Size()),
+	}, nil
+}
@@ -0,0 +1,284 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MicahParks/keyfunc/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// Client talks to a single OpenID Connect provider using the authorization code flow with PKCE
+type Client struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	GroupsClaim  string
+	HTTPClient   *http.Client
+
+	// * Discovery dilakukan saat pertama dipakai supaya server tetap bisa start walaupun IdP belum siap
+	mu       sync.Mutex
+	provider *ProviderMetadata
+	jwks     *keyfunc.JWKS
+}
+
+// ProviderMetadata is the subset of the discovery document we need
+type ProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Identity is the verified user information taken from the ID token (and userinfo when needed)
+type Identity struct {
+	Issuer            string
+	Subject           string
+	Email             string
+	EmailVerified     *bool
+	Name              string
+	PreferredUsername string
+	Groups            []string
+}
+
+var idTokenSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// AuthCodeURL builds the URL the user is sent to, PKCE challenge dan nonce ikut dikirim
+func (c *Client) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	provider, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return c.oauth2Config(provider).AuthCodeURL(state,
+		oauth2.S256ChallengeOption(codeVerifier),
+		oauth2.SetAuthURLParam("nonce", nonce),
+	), nil
+}
+
+// ExchangeCode trades the authorization code for tokens and verifies the ID token signature, issuer, audience and nonce
+func (c *Client) ExchangeCode(ctx context.Context, code string, codeVerifier string, nonce string) (Identity, error) {
+	provider, err := c.discover(ctx)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	token, err := c.oauth2Config(provider).Exchange(c.httpContext(ctx), code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return Identity{}, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return Identity{}, fmt.Errorf("token response does not contain an id_token")
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, c.jwks.Keyfunc,
+		jwt.WithValidMethods(idTokenSigningMethods),
+		jwt.WithIssuer(provider.Issuer),
+		jwt.WithAudience(c.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return Identity{}, fmt.Errorf("invalid id_token: %w", err)
+	}
+
+	if claimString(claims, "nonce") != nonce {
+		return Identity{}, fmt.Errorf("id_token nonce mismatch")
+	}
+
+	identity := c.identityFromClaims(provider.Issuer, claims)
+	if identity.Subject == "" {
+		return Identity{}, fmt.Errorf("id_token has no subject")
+	}
+
+	// * Beberapa IdP tidak menaruh email/groups di ID token, ambil dari userinfo
+	if (identity.Email == "" || identity.Groups == nil) && provider.UserinfoEndpoint != "" {
+		if err := c.mergeUserinfo(ctx, provider, token, &identity); err != nil {
+			return Identity{}, err
+		}
+	}
+
+	return identity, nil
+}
+
+func (c *Client) oauth2Config(provider *ProviderMetadata) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		RedirectURL:  c.RedirectURL,
+		Scopes:       c.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  provider.AuthorizationEndpoint,
+			TokenURL: provider.TokenEndpoint,
+		},
+	}
+}
+
+func (c *Client) httpContext(ctx context.Context) context.Context {
+	if c.HTTPClient == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, c.HTTPClient)
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
+}
+
+// discover loads the discovery document and JWKS once, kegagalan tidak di-cache supaya dicoba lagi di request berikutnya
+func (c *Client) discover(ctx context.Context) (*ProviderMetadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.provider != nil {
+		return c.provider, nil
+	}
+
+	issuer := strings.TrimSuffix(c.IssuerURL, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build discovery request: %w", err)
+	}
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("discovery document returned status %d", resp.StatusCode)
+	}
+
+	var provider ProviderMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&provider); err != nil {
+		return nil, fmt.Errorf("failed to decode discovery document: %w", err)
+	}
+
+	if strings.TrimSuffix(provider.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discovery issuer %q does not match configured issuer %q", provider.Issuer, c.IssuerURL)
+	}
+	if provider.AuthorizationEndpoint == "" || provider.TokenEndpoint == "" || provider.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery document is missing required endpoints")
+	}
+
+	jwks, err := keyfunc.Get(provider.JWKSURI, keyfunc.Options{
+		Client:            c.httpClient(),
+		RefreshInterval:   time.Hour,
+		RefreshRateLimit:  5 * time.Minute,
+		RefreshUnknownKID: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load provider JWKS: %w", err)
+	}
+
+	c.provider = &provider
+	c.jwks = jwks
+
+	return c.provider, nil
+}
+
+func (c *Client) mergeUserinfo(ctx context.Context, provider *ProviderMetadata, token *oauth2.Token, identity *Identity) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, provider.UserinfoEndpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build userinfo request: %w", err)
+	}
+	token.SetAuthHeader(req)
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch userinfo: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("userinfo returned status %d", resp.StatusCode)
+	}
+
+	claims := map[string]any{}
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return fmt.Errorf("failed to decode userinfo: %w", err)
+	}
+
+	// ! Userinfo untuk subject lain tidak boleh dipakai (OIDC Core 5.3.2)
+	if claimString(claims, "sub") != identity.Subject {
+		return fmt.Errorf("userinfo subject does not match id_token")
+	}
+
+	userinfo := c.identityFromClaims(identity.Issuer, claims)
+	if identity.Email == "" {
+		identity.Email = userinfo.Email
+		identity.EmailVerified = userinfo.EmailVerified
+	}
+	if identity.Name == "" {
+		identity.Name = userinfo.Name
+	}
+	if identity.PreferredUsername == "" {
+		identity.PreferredUsername = userinfo.PreferredUsername
+	}
+	if identity.Groups == nil {
+		identity.Groups = userinfo.Groups
+	}
+
+	return nil
+}
+
+func (c *Client) identityFromClaims(issuer string, claims map[string]any) Identity {
+	identity := Identity{
+		Issuer:            issuer,
+		Subject:           claimString(claims, "sub"),
+		Email:             strings.ToLower(strings.TrimSpace(claimString(claims, "email"))),
+		Name:              claimString(claims, "name"),
+		PreferredUsername: claimString(claims, "preferred_username"),
+	}
+
+	// * Sebagian IdP mengirim email_verified sebagai string
+	switch verified := claims["email_verified"].(type) {
+	case bool:
+		identity.EmailVerified = &verified
+	case string:
+		value := strings.EqualFold(verified, "true")
+		identity.EmailVerified = &value
+	}
+
+	groupsClaim := c.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+
+	switch groups := claims[groupsClaim].(type) {
+	case []any:
+		identity.Groups = make([]string, 0, len(groups))
+		for _, group := range groups {
+			if name, ok := group.(string); ok {
+				identity.Groups = append(identity.Groups, name)
+			}
+		}
+	case string:
+		identity.Groups = []string{groups}
+	}
+
+	return identity
+}
+
+func claimString(claims map[string]any, key string) string {
+	if value, ok := claims[key].(string); ok {
+		return value
+	}
+	return ""
+}
@@ -0,0 +1,475 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+	"github.com/oklog/ulid/v2"
+)
+
+// Config holds the connection settings of an S3-compatible bucket (AWS S3, MinIO, R2, dll)
+type Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// * PublicURL adalah base URL untuk membaca object publik, default endpoint + bucket. Hanya folder publik
+	// * (avatar, gambar) yang perlu public-read, folder private selalu dibaca lewat signed request
+	PublicURL string
+	// * PathStyle wajib true untuk MinIO, false untuk virtual-hosted style (bucket.endpoint)
+	PathStyle bool
+}
+
+// Client stores files in an S3-compatible bucket, request ditandatangani dengan AWS Signature V4
+type Client struct {
+	endpoint   *url.URL
+	region     string
+	bucket     string
+	accessKey  string
+	secretKey  string
+	publicURL  string
+	pathStyle  bool
+	httpClient *http.Client
+	// * Tanpa timeout total, dipakai OpenFile supaya download file besar tidak terpotong
+	streamClient *http.Client
+}
+
+// * Ensure Client implements storage.Storage, storage.Reader and storage.Opener interfaces
+var (
+	_ storage.Storage = (*Client)(nil)
+	_ storage.Reader  = (*Client)(nil)
+	_ storage.Opener  = (*Client)(nil)
+)
+
+// NewClient creates an S3-compatible storage client
+func NewClient(config Config) (*Client, error) {
+	if config.Endpoint == "" || config.Bucket == "" || config.AccessKey == "" || config.SecretKey == "" {
+		return nil, fmt.Errorf("endpoint, bucket, access key and secret key are required")
+	}
+
+	endpoint, err := url.Parse(strings.TrimRight(config.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint: %q", config.Endpoint)
+	}
+
+	region := config.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	client := &Client{
+		endpoint:   endpoint,
+		region:     region,
+		bucket:     config.Bucket,
+		accessKey:  config.AccessKey,
+		secretKey:  config.SecretKey,
+		pathStyle:  config.PathStyle,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = 60 * time.Second
+	client.streamClient = &http.Client{Transport: transport}
+
+	client.publicURL = strings.TrimRight(config.PublicURL, "/")
+	if client.publicURL == "" {
+		client.publicURL = client.bucketURL().String()
+	}
+
+	return client, nil
+}
+
+// UploadSingleFile uploads a single file under its folder and public ID
+func (c *Client) UploadSingleFile(ctx context.Context, file *multipart.FileHeader, config storage.UploadConfig) (*storage.UploadResult, error) {
+	if err := storage.ValidateFile(file, config); err != nil {
+		return nil, err
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	publicID := strings.ToLower(ulid.Make().String())
+	if config.PublicID != nil && *config.PublicID != "" {
+		publicID = *config.PublicID
+	}
+
+	result, err := c.Put(ctx, storage.ObjectKey(config.FolderName, publicID), filepath.Ext(file.Filename), data, config.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+	result.OriginalName = file.Filename
+
+	return result, nil
+}
+
+// UploadMultipleFiles uploads multiple files with generated public IDs
+func (c *Client) UploadMultipleFiles(ctx context.Context, files []*multipart.FileHeader, baseConfig storage.UploadConfig) (*storage.MultiUploadResult, error) {
+	return storage.UploadMultiple(ctx, c.UploadSingleFile, files, nil, baseConfig)
+}
+
+// UploadMultipleFilesWithPublicIDs uploads multiple files with custom public IDs for each file
+func (c *Client) UploadMultipleFilesWithPublicIDs(ctx context.Context, files []*multipart.FileHeader, publicIDs []string, baseConfig storage.UploadConfig) (*storage.MultiUploadResult, error) {
+	return storage.UploadMultiple(ctx, c.UploadSingleFile, files, publicIDs, baseConfig)
+}
+
+// Put uploads data as publicID + ext. Seperti Cloudinary, object yang sudah ada dengan public ID sama
+// dikembalikan apa adanya kalau overwrite false, dan diganti (termasuk yang beda ekstensi) kalau true
+func (c *Client) Put(ctx context.Context, publicID, ext string, data []byte, overwrite bool) (*storage.UploadResult, error) {
+	ext = strings.ToLower(ext)
+
+	existing, err := c.findObjects(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 && !overwrite {
+		return c.objectResult(publicID, existing[0].Key, existing[0].Size, existing[0].LastModified), nil
+	}
+
+	key := publicID + ext
+	contentType := mime.TypeByExtension(ext)
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+
+	if err := c.do(ctx, http.MethodPut, key, nil, data, contentType, nil); err != nil {
+		return nil, err
+	}
+
+	for _, old := range existing {
+		if old.Key != key {
+			if err := c.do(ctx, http.MethodDelete, old.Key, nil, nil, "", nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result := c.objectResult(publicID, key, int64(len(data)), time.Now())
+	if config, _, err := image.DecodeConfig(bytes.NewReader(data)); err == nil {
+		result.Width = config.Width
+		result.Height = config.Height
+	}
+
+	return result, nil
+}
+
+// DeleteFile deletes an object by public ID, public ID yang tidak ada bukan error
+func (c *Client) DeleteFile(ctx context.Context, publicID string) error {
+	objects, err := c.findObjects(ctx, publicID)
+	if err != nil {
+		return err
+	}
+
+	for _, object := range objects {
+		if err := c.do(ctx, http.MethodDelete, object.Key, nil, nil, "", nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteMultipleFiles deletes multiple objects by public IDs
+func (c *Client) DeleteMultipleFiles(ctx context.Context, publicIDs []string) (int, []string, error) {
+	return storage.DeleteMultiple(ctx, c.DeleteFile, publicIDs)
+}
+
+// ExtractPublicIDFromURL extracts the public ID from a URL produced by this client
+// Example: http://localhost:9000/sigma-asset/sigma-asset/avatars/user-01HQXXX-avatar.webp?v=1700000000
+// Returns: sigma-asset/avatars/user-01HQXXX-avatar
+func (c *Client) ExtractPublicIDFromURL(rawURL string) string {
+	key := c.objectKey(rawURL)
+	return strings.TrimSuffix(key, filepath.Ext(key))
+}
+
+// ReadFile downloads an object with a signed request, jadi tetap bisa dibaca walaupun public URL-nya CDN
+func (c *Client) ReadFile(ctx context.Context, fileURL string) ([]byte, error) {
+	key := c.objectKey(fileURL)
+	if key == "" {
+		return nil, fmt.Errorf("file URL was not produced by s3 storage: %q", fileURL)
+	}
+
+	var data []byte
+	if err := c.do(ctx, http.MethodGet, key, nil, nil, "", &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// OpenFile streams an object with a signed request
+func (c *Client) OpenFile(ctx context.Context, fileURL string) (io.ReadCloser, int64, error) {
+	key := c.objectKey(fileURL)
+	if key == "" {
+		return nil, 0, fmt.Errorf("file URL was not produced by s3 storage: %q", fileURL)
+	}
+
+	resp, err := c.send(ctx, c.streamClient, http.MethodGet, key, nil, nil, "")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp.Body, resp.ContentLength, nil
+}
+
+// objectKey returns the object key of a URL produced by this client
+func (c *Client) objectKey(rawURL string) string {
+	var escapedKey string
+	for _, base := range []string{c.publicURL, c.bucketURL().String()} {
+		if rest, ok := strings.CutPrefix(rawURL, base+"/"); ok {
+			escapedKey, _, _ = strings.Cut(rest, "?")
+			break
+		}
+	}
+	if escapedKey == "" {
+		return ""
+	}
+
+	key, err := url.PathUnescape(escapedKey)
+	if err != nil {
+		return ""
+	}
+
+	return key
+}
+
+type object struct {
+	Key          string    `xml:"Key"`
+	Size         int64     `xml:"Size"`
+	LastModified time.Time `xml:"LastModified"`
+}
+
+type listBucketResult struct {
+	Contents []object `xml:"Contents"`
+}
+
+// findObjects returns the objects of a public ID with any extension
+func (c *Client) findObjects(ctx context.Context, publicID string) ([]object, error) {
+	query := url.Values{}
+	query.Set("list-type", "2")
+	query.Set("prefix", publicID)
+
+	var result listBucketResult
+	if err := c.do(ctx, http.MethodGet, "", query, nil, "", &result); err != nil {
+		return nil, err
+	}
+
+	var objects []object
+	for _, obj := range result.Contents {
+		if strings.TrimSuffix(obj.Key, filepath.Ext(obj.Key)) == publicID {
+			objects = append(objects, obj)
+		}
+	}
+
+	return objects, nil
+}
+
+func (c *Client) objectResult(publicID, key string, size int64, modified time.Time) *storage.UploadResult {
+	ext := filepath.Ext(key)
+
+	// * File private disimpan dengan URL bucket langsung, bukan PublicURL/CDN. URL ini tidak bisa dibuka tanpa
+	// * signature, API membacanya lewat OpenFile/ReadFile untuk endpoint download yang butuh auth
+	baseURL := c.publicURL
+	if storage.IsPrivateFile(key) {
+		baseURL = c.bucketURL().String()
+	}
+	objectURL := baseURL + "/" + escapePath(key) + "?v=" + strconv.FormatInt(modified.Unix(), 10)
+
+	return &storage.UploadResult{
+		PublicID:     publicID,
+		URL:          objectURL,
+		SecureURL:    objectURL,
+		Format:       strings.TrimPrefix(ext, "."),
+		ResourceType: storage.ResourceType(ext),
+		Bytes:        int(size),
+	}
+}
+
+// *===========================REQUEST SIGNING===========================*
+
+func (c *Client) bucketURL() *url.URL {
+	u := *c.endpoint
+	if c.pathStyle {
+		u.Path = strings.TrimRight(u.Path, "/") + "/" + c.bucket
+	} else {
+		u.Host = c.bucket + "." + u.Host
+	}
+	return &u
+}
+
+// do sends a signed request for an object key (atau bucket kalau key kosong) and decodes the XML response into out,
+// out *[]byte menerima body apa adanya
+func (c *Client) do(ctx context.Context, method, key string, query url.Values, body []byte, contentType string, out any) error {
+	resp, err := c.send(ctx, c.httpClient, method, key, query, body, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch v := out.(type) {
+	case nil:
+	case *[]byte:
+		if *v, err = io.ReadAll(resp.Body); err != nil {
+			return fmt.Errorf("failed to read s3 response: %w", err)
+		}
+	default:
+		if err := xml.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode s3 response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// send sends a signed request and returns the response of a 2xx status, caller wajib menutup body-nya
+func (c *Client) send(ctx context.Context, httpClient *http.Client, method, key string, query url.Values, body []byte, contentType string) (*http.Response, error) {
+	u := c.bucketURL()
+	rawPath := escapePath(u.Path)
+	if key != "" {
+		u.Path += "/" + key
+		rawPath += "/" + escapePath(key)
+	}
+	if rawPath == "" {
+		rawPath = "/"
+	}
+	u.RawPath = rawPath
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	c.sign(req, rawPath, body, time.Now())
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 %s %s failed: %w", method, key, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, fmt.Errorf("s3 %s %s failed: %s: %s", method, key, resp.Status, strings.TrimSpace(string(message)))
+	}
+
+	return resp, nil
+}
+
+// sign adds the AWS Signature V4 headers to the request
+func (c *Client) sign(req *http.Request, canonicalURI string, body []byte, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := hashHex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI,
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + c.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hashHex([]byte(canonicalRequest))
+
+	signingKey := hmacSHA256([]byte("AWS4"+c.secretKey), date)
+	signingKey = hmacSHA256(signingKey, c.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.accessKey, scope, signedHeaders, signature,
+	))
+}
+
+// canonicalQuery encodes query parameters sorted by key, sama persis dengan yang ditandatangani
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, key := range keys {
+		for _, value := range query[key] {
+			parts = append(parts, escape(key, false)+"="+escape(value, false))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+func escapePath(path string) string {
+	return escape(path, true)
+}
+
+// escape percent-encodes everything except the unreserved characters, sesuai aturan SigV4
+func escape(s string, keepSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ('A' <= ch && ch <= 'Z') || ('a' <= ch && ch <= 'z') || ('0' <= ch && ch <= '9') ||
+			ch == '-' || ch == '_' || ch == '.' || ch == '~' || (keepSlash && ch == '/') {
+			b.WriteByte(ch)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,113 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// NotificationEmail is a notification or daily digest email, semua teks sudah dilokalisasi oleh caller
+type NotificationEmail struct {
+	LangCode    string
+	Subject     string
+	Greeting    string
+	Intro       string
+	Items       []NotificationEmailItem
+	MoreText    string
+	ActionURL   string
+	ActionLabel string
+	Footer      string
+}
+
+type NotificationEmailItem struct {
+	Title   string
+	Message string
+	// Kosong untuk prioritas LOW dan NORMAL
+	PriorityLabel string
+	Urgent        bool
+	Time          string
+}
+
+// * html/template supaya judul dan isi notifikasi (bisa berisi input user) ter-escape
+var notificationEmailTemplate = template.Must(template.New("notification").Parse(`<!DOCTYPE html>
+<html lang="{{.LangCode}}">
+<head>
+    <meta charset="UTF-8">
+    <title>{{.Subject}}</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .item { padding: 12px 16px; background: #f5f5f5; border-left: 4px solid #4CAF50; border-radius: 4px; margin: 12px 0; }
+        .item.high { border-left-color: #FF9800; }
+        .item.urgent { border-left-color: #F44336; }
+        .item-title { font-weight: bold; margin: 0; }
+        .item-message { margin: 4px 0 0 0; }
+        .item-meta { color: #888; font-size: 12px; margin: 4px 0 0 0; }
+        .badge { display: inline-block; font-size: 11px; font-weight: bold; color: #fff; background: #FF9800; border-radius: 3px; padding: 0 6px; margin-right: 6px; }
+        .badge.urgent { background: #F44336; }
+        .action { display: inline-block; margin: 16px 0; padding: 10px 20px; background: #4CAF50; color: #fff; text-decoration: none; border-radius: 4px; }
+        .footer { color: #666; font-size: 12px; margin-top: 24px; border-top: 1px solid #eee; padding-top: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <p>{{.Greeting}}</p>
+        <p>{{.Intro}}</p>
+        {{range .Items}}
+        <div class="item{{if .Urgent}} urgent{{else if .PriorityLabel}} high{{end}}">
+            <p class="item-title">{{if .PriorityLabel}}<span class="badge{{if .Urgent}} urgent{{end}}">{{.PriorityLabel}}</span>{{end}}{{.Title}}</p>
+            <p class="item-message">{{.Message}}</p>
+            {{if .Time}}<p class="item-meta">{{.Time}}</p>{{end}}
+        </div>
+        {{end}}
+        {{if .MoreText}}<p>{{.MoreText}}</p>{{end}}
+        {{if .ActionURL}}<a class="action" href="{{.ActionURL}}">{{.ActionLabel}}</a>{{end}}
+        <p class="footer">{{.Footer}}</p>
+    </div>
+</body>
+</html>
+`))
+
+// SendNotificationEmail renders and sends a notification email with a plain text alternative
+func (c *Client) SendNotificationEmail(ctx context.Context, to string, email *NotificationEmail) error {
+	var htmlBody bytes.Buffer
+	if err := notificationEmailTemplate.Execute(&htmlBody, email); err != nil {
+		return fmt.Errorf("failed to render notification email: %w", err)
+	}
+
+	return c.SendEmail(ctx, &EmailMessage{
+		To:       to,
+		Subject:  email.Subject,
+		Body:     notificationEmailPlainText(email),
+		HTMLBody: htmlBody.String(),
+	})
+}
+
+func notificationEmailPlainText(email *NotificationEmail) string {
+	var b strings.Builder
+
+	b.WriteString(email.Greeting + "\n\n")
+	b.WriteString(email.Intro + "\n\n")
+	for _, item := range email.Items {
+		if item.PriorityLabel != "" {
+			b.WriteString("[" + item.PriorityLabel + "] ")
+		}
+		b.WriteString(item.Title + "\n")
+		b.WriteString(item.Message + "\n")
+		if item.Time != "" {
+			b.WriteString(item.Time + "\n")
+		}
+		b.WriteString("\n")
+	}
+	if email.MoreText != "" {
+		b.WriteString(email.MoreText + "\n\n")
+	}
+	if email.ActionURL != "" {
+		b.WriteString(email.ActionLabel + ": " + email.ActionURL + "\n\n")
+	}
+	b.WriteString("--\n" + email.Footer + "\n")
+
+	return b.String()
+}
@@ -0,0 +1,83 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// ReportEmail is a scheduled report email with the export attached, semua teks sudah dilokalisasi oleh caller
+type ReportEmail struct {
+	LangCode string
+	Subject  string
+	Greeting string
+	Intro    string
+	Details  []ReportEmailDetail
+	Footer   string
+}
+
+type ReportEmailDetail struct {
+	Label string
+	Value string
+}
+
+// * html/template supaya nama saved filter (input user) ter-escape
+var reportEmailTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="{{.LangCode}}">
+<head>
+    <meta charset="UTF-8">
+    <title>{{.Subject}}</title>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .details { width: 100%; border-collapse: collapse; margin: 16px 0; }
+        .details td { padding: 6px 12px; border-bottom: 1px solid #eee; vertical-align: top; }
+        .details td.label { color: #666; width: 35%; }
+        .footer { color: #666; font-size: 12px; margin-top: 24px; border-top: 1px solid #eee; padding-top: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <p>{{.Greeting}}</p>
+        <p>{{.Intro}}</p>
+        <table class="details">
+            {{range .Details}}
+            <tr><td class="label">{{.Label}}</td><td>{{.Value}}</td></tr>
+            {{end}}
+        </table>
+        <p class="footer">{{.Footer}}</p>
+    </div>
+</body>
+</html>
+`))
+
+// SendReportEmail renders and sends a report email with the generated file attached
+func (c *Client) SendReportEmail(ctx context.Context, to string, email *ReportEmail, attachment EmailAttachment) error {
+	var htmlBody bytes.Buffer
+	if err := reportEmailTemplate.Execute(&htmlBody, email); err != nil {
+		return fmt.Errorf("failed to render report email: %w", err)
+	}
+
+	return c.SendEmail(ctx, &EmailMessage{
+		To:          to,
+		Subject:     email.Subject,
+		Body:        reportEmailPlainText(email),
+		HTMLBody:    htmlBody.String(),
+		Attachments: []EmailAttachment{attachment},
+	})
+}
+
+func reportEmailPlainText(email *ReportEmail) string {
+	var b strings.Builder
+
+	b.WriteString(email.Greeting + "\n\n")
+	b.WriteString(email.Intro + "\n\n")
+	for _, detail := range email.Details {
+		b.WriteString(detail.Label + ": " + detail.Value + "\n")
+	}
+	b.WriteString("\n--\n" + email.Footer + "\n")
+
+	return b.String()
+}
@@ -1,6 +1,7 @@
 package smtp
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -15,10 +16,18 @@ type Client struct {
 
 // EmailMessage represents an email to be sent
 type EmailMessage struct {
-	To       string
-	Subject  string
-	Body     string
-	HTMLBody string
+	To          string
+	Subject     string
+	Body        string
+	HTMLBody    string
+	Attachments []EmailAttachment
+}
+
+// EmailAttachment is a file attached to an email, ContentType kosong ditebak dari ekstensi nama file
+type EmailAttachment struct {
+	FileName    string
+	ContentType string
+	Data        []byte
 }
 
 // SendEmail sends a plain text email
@@ -46,6 +55,16 @@ func (c *Client) SendEmail(ctx context.Context, msg *EmailMessage) error {
 		message.SetBodyString(mail.TypeTextPlain, msg.Body)
 	}
 
+	for _, attachment := range msg.Attachments {
+		var opts []mail.FileOption
+		if attachment.ContentType != "" {
+			opts = append(opts, mail.WithFileContentType(mail.ContentType(attachment.ContentType)))
+		}
+		if err := message.AttachReader(attachment.FileName, bytes.NewReader(attachment.Data), opts...); err != nil {
+			return fmt.Errorf("failed to attach %s: %w", attachment.FileName, err)
+		}
+	}
+
 	if err := c.MailClient.DialAndSend(message); err != nil {
 		return fmt.Errorf("failed to send email: %w", err)
 	}
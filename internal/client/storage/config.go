@@ -0,0 +1,199 @@
+package storage
+
+import "strings"
+
+// * Transformation hanya diterapkan backend Cloudinary, backend local dan S3 menyimpan file apa adanya
+
+const (
+	documentFolder = "sigma-asset/documents"
+	reportFolder   = "sigma-asset/reports"
+	exportFolder   = "sigma-asset/exports"
+)
+
+// IsPrivateFile reports whether a stored file name belongs to a folder that is only served through
+// authenticated download endpoints, file ini tidak boleh disajikan lewat URL publik
+func IsPrivateFile(name string) bool {
+	for _, folder := range []string{documentFolder, reportFolder, exportFolder} {
+		if strings.HasPrefix(name, folder+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAvatarUploadConfig returns a pre-configured upload config for user avatars
+// * Incoming transformation: resize max 500px + WebP + auto quality
+// * Original stored optimized, ~70-80% smaller
+func GetAvatarUploadConfig() UploadConfig {
+	return UploadConfig{
+		AllowedTypes: []string{
+			".jpg",
+			".jpeg",
+			".png",
+			".gif",
+			".webp",
+			".bmp",
+			".tiff",
+			".tif",
+			".svg", // Cloudinary only, lihat storage.ValidateFile
+			".ico",
+			".heic",
+			".heif",
+			".avif",
+		},
+		FolderName:     "sigma-asset/avatars",
+		InputName:      "avatar",
+		MaxFiles:       1,
+		MaxFileSize:    10 * 1024 * 1024, // 10MB
+		Overwrite:      true,
+		Transformation: "w_500,c_limit/f_webp,q_auto", // Resize max 500px + WebP + auto quality
+	}
+}
+
+// GetDataMatrixImageUploadConfig returns a pre-configured upload config for asset data matrix images
+// * Incoming transformation: keep PNG for barcode clarity + best quality compression
+// * Original stored optimized while maintaining scanability
+func GetDataMatrixImageUploadConfig() UploadConfig {
+	return UploadConfig{
+		AllowedTypes: []string{
+			".jpg",
+			".jpeg",
+			".png",
+			".gif",
+			".webp",
+			".bmp",
+			".tiff",
+			".tif",
+			".svg", // Cloudinary only, lihat storage.ValidateFile
+			".avif",
+		},
+		FolderName:     "sigma-asset/datamatrix",
+		InputName:      "dataMatrixImage",
+		MaxFiles:       1,
+		MaxFileSize:    2 * 1024 * 1024, // 2MB
+		Overwrite:      true,
+		Transformation: "f_png,q_auto:best", // Keep PNG for barcode clarity + best quality compression
+	}
+}
+
+// GetBulkDataMatrixImageUploadConfig returns a pre-configured upload config for bulk data matrix images
+// * Incoming transformation: keep PNG for barcode clarity + best quality compression
+func GetBulkDataMatrixImageUploadConfig() UploadConfig {
+	return UploadConfig{
+		AllowedTypes: []string{
+			".jpg",
+			".jpeg",
+			".png",
+			".gif",
+			".webp",
+			".bmp",
+			".tiff",
+			".tif",
+			".svg", // Cloudinary only, lihat storage.ValidateFile
+			".avif",
+		},
+		FolderName:     "sigma-asset/datamatrix",
+		InputName:      "dataMatrixImages",
+		MaxFiles:       0,                // No limit for bulk upload
+		MaxFileSize:    10 * 1024 * 1024, // 10MB per file
+		Overwrite:      true,
+		Transformation: "f_png,q_auto:best", // Keep PNG for barcode clarity
+	}
+}
+
+// GetDocumentUploadConfig returns a pre-configured upload config for documents
+// * No transformation: invoice, sertifikat dan scan lain disimpan persis seperti yang diupload
+func GetDocumentUploadConfig() UploadConfig {
+	return UploadConfig{
+		AllowedTypes: []string{
+			".pdf",
+			".jpg",
+			".jpeg",
+			".png",
+			".gif",
+			".webp",
+			".tiff",
+			".tif",
+			".bmp",
+		},
+		FolderName:  documentFolder,
+		InputName:   "documents",
+		MaxFiles:    10,
+		MaxFileSize: 10 * 1024 * 1024, // 10MB
+		Overwrite:   false,
+	}
+}
+
+// GetCategoryImageUploadConfig returns a pre-configured upload config for category images
+// * Incoming transformation: resize to max 800px + WebP + auto quality
+// * Original stored optimized, ~75-85% smaller
+func GetCategoryImageUploadConfig() UploadConfig {
+	return UploadConfig{
+		AllowedTypes: []string{
+			".jpg",
+			".jpeg",
+			".png",
+			".gif",
+			".webp",
+		},
+		FolderName:     "sigma-asset/categories",
+		InputName:      "image",
+		MaxFiles:       1,
+		MaxFileSize:    10 * 1024 * 1024, // 10MB
+		Overwrite:      false,
+		Transformation: "w_800,c_limit/f_webp,q_auto", // Resize max 800px + WebP + auto quality
+	}
+}
+
+// GetAssetImageUploadConfig returns a pre-configured upload config for asset images
+// * Incoming transformation: resize to max 1920px + WebP + auto quality
+// * Original stored optimized, ~70-80% smaller
+func GetAssetImageUploadConfig() UploadConfig {
+	return UploadConfig{
+		AllowedTypes: []string{
+			".jpg",
+			".jpeg",
+			".png",
+			".gif",
+			".webp",
+		},
+		FolderName:     "sigma-asset/assets",
+		InputName:      "images",
+		MaxFiles:       10,               // Allow up to 10 images per asset
+		MaxFileSize:    10 * 1024 * 1024, // 10MB per image
+		Overwrite:      false,
+		Transformation: "w_1920,c_limit/f_webp,q_auto", // Resize max 1920px + WebP + auto quality
+	}
+}
+
+// GetReportUploadConfig returns a pre-configured upload config for scheduled report files
+// * No transformation: PDF dan Excel hasil export disimpan apa adanya untuk riwayat report
+func GetReportUploadConfig() UploadConfig {
+	return UploadConfig{
+		AllowedTypes: []string{
+			".pdf",
+			".xlsx",
+		},
+		FolderName:  reportFolder,
+		InputName:   "report",
+		MaxFiles:    1,
+		MaxFileSize: 50 * 1024 * 1024, // 50MB
+		Overwrite:   false,
+	}
+}
+
+// GetExportUploadConfig returns a pre-configured upload config for export job files
+// * File dihapus cleanup export job setelah masa simpan habis
+func GetExportUploadConfig() UploadConfig {
+	return UploadConfig{
+		AllowedTypes: []string{
+			".pdf",
+			".xlsx",
+		},
+		FolderName:  exportFolder,
+		InputName:   "export",
+		MaxFiles:    1,
+		MaxFileSize: 500 * 1024 * 1024, // 500MB
+		Overwrite:   false,
+	}
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Reader is implemented by backends that can read a stored file without going through its public URL
+type Reader interface {
+	ReadFile(ctx context.Context, fileURL string) ([]byte, error)
+}
+
+// Opener is implemented by backends that can stream a stored file without going through its public URL
+type Opener interface {
+	OpenFile(ctx context.Context, fileURL string) (io.ReadCloser, int64, error)
+}
+
+var readClient = &http.Client{Timeout: 60 * time.Second}
+
+// streamClient has no overall timeout, file besar butuh waktu lebih lama dari readClient untuk dikirim ke client
+var streamClient = &http.Client{Transport: newStreamTransport()}
+
+func newStreamTransport() http.RoundTripper {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = 60 * time.Second
+	return transport
+}
+
+// ReadFile reads a stored file, lewat backend kalau mendukung Reader, selain itu download dari URL publiknya
+func ReadFile(ctx context.Context, s Storage, fileURL string) ([]byte, error) {
+	if reader, ok := s.(Reader); ok {
+		return reader.ReadFile(ctx, fileURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := readClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download file: unexpected status %s", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// OpenFile opens a stored file for streaming, size -1 berarti ukuran tidak diketahui. Caller wajib menutup reader-nya
+func OpenFile(ctx context.Context, s Storage, fileURL string) (io.ReadCloser, int64, error) {
+	if opener, ok := s.(Opener); ok {
+		return opener.OpenFile(ctx, fileURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := streamClient.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to download file: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, 0, fmt.Errorf("failed to download file: unexpected status %s", resp.Status)
+	}
+
+	return resp.Body, resp.ContentLength, nil
+}
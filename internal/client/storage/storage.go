@@ -0,0 +1,263 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Storage is the file storage backend used by upload paths, dipilih lewat STORAGE_DRIVER
+type Storage interface {
+	UploadSingleFile(ctx context.Context, file *multipart.FileHeader, config UploadConfig) (*UploadResult, error)
+	UploadMultipleFiles(ctx context.Context, files []*multipart.FileHeader, baseConfig UploadConfig) (*MultiUploadResult, error)
+	UploadMultipleFilesWithPublicIDs(ctx context.Context, files []*multipart.FileHeader, publicIDs []string, baseConfig UploadConfig) (*MultiUploadResult, error)
+	DeleteFile(ctx context.Context, publicID string) error
+	DeleteMultipleFiles(ctx context.Context, publicIDs []string) (int, []string, error)
+	// ExtractPublicIDFromURL returns "" when the URL was not produced by this backend
+	ExtractPublicIDFromURL(url string) string
+}
+
+type UploadConfig struct {
+	AllowedTypes []string `json:"allowedTypes"` // e.g., [".jpg", ".png", ".gif"]
+	FolderName   string   `json:"folderName"`   // e.g., "avatars", "documents"
+	InputName    string   `json:"inputName"`    // e.g., "avatar", "file"
+	MaxFiles     int      `json:"maxFiles"`     // Maximum number of files for multiple upload
+	MaxFileSize  int64    `json:"maxFileSize"`  // Maximum file size in bytes (e.g., 5MB = 5*1024*1024)
+	PublicID     *string  `json:"publicId"`     // Optional custom public ID
+	Overwrite    bool     `json:"overwrite"`    // Whether to overwrite existing files
+	// Incoming transformation applied to original (e.g., "w_1920,c_limit/f_webp,q_auto"), hanya dipakai backend Cloudinary
+	Transformation string `json:"transformation"`
+}
+
+type UploadResult struct {
+	PublicID     string `json:"publicId"`
+	URL          string `json:"url"`
+	SecureURL    string `json:"secureUrl"`
+	Format       string `json:"format"`
+	ResourceType string `json:"resourceType"`
+	Bytes        int    `json:"bytes"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	OriginalName string `json:"originalName"`
+}
+
+type MultiUploadResult struct {
+	Results []UploadResult `json:"results"`
+	Failed  []UploadError  `json:"failed"`
+}
+
+type UploadError struct {
+	FileName string `json:"fileName"`
+	Error    string `json:"error"`
+}
+
+// UploadFunc uploads one file, dipakai helper multi upload untuk backend selain Cloudinary
+type UploadFunc func(ctx context.Context, file *multipart.FileHeader, config UploadConfig) (*UploadResult, error)
+
+// DeleteFunc deletes one file by public ID
+type DeleteFunc func(ctx context.Context, publicID string) error
+
+// scriptableTypes can carry script, hanya Cloudinary yang mengubahnya jadi raster lewat transformation
+var scriptableTypes = map[string]bool{
+	".svg": true,
+}
+
+// ValidateFile checks the file extension and size against the upload config, dipakai backend yang menyimpan file apa adanya
+func ValidateFile(file *multipart.FileHeader, config UploadConfig) error {
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if scriptableTypes[ext] {
+		return fmt.Errorf("file type not allowed: %s is only accepted by the cloudinary backend", ext)
+	}
+
+	if len(config.AllowedTypes) > 0 {
+		allowed := false
+		for _, allowedExt := range config.AllowedTypes {
+			if ext == allowedExt {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("file type not allowed: %s, allowed types: %v", ext, config.AllowedTypes)
+		}
+	}
+
+	if config.MaxFileSize > 0 && file.Size > config.MaxFileSize {
+		return fmt.Errorf("file size too large: %d bytes, max allowed: %d bytes", file.Size, config.MaxFileSize)
+	}
+
+	return nil
+}
+
+// UploadMultiple uploads each file with upload, publicIDs boleh nil untuk public ID otomatis
+func UploadMultiple(ctx context.Context, upload UploadFunc, files []*multipart.FileHeader, publicIDs []string, baseConfig UploadConfig) (*MultiUploadResult, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files provided")
+	}
+
+	if publicIDs != nil && len(files) != len(publicIDs) {
+		return nil, fmt.Errorf("number of files (%d) must match number of public IDs (%d)", len(files), len(publicIDs))
+	}
+
+	// Validate number of files
+	if baseConfig.MaxFiles > 0 && len(files) > baseConfig.MaxFiles {
+		return nil, fmt.Errorf("too many files: got %d, max allowed %d", len(files), baseConfig.MaxFiles)
+	}
+
+	result := &MultiUploadResult{
+		Results: make([]UploadResult, 0, len(files)),
+		Failed:  make([]UploadError, 0),
+	}
+
+	for i, file := range files {
+		fileConfig := baseConfig
+		if publicIDs != nil {
+			fileConfig.PublicID = &publicIDs[i]
+		}
+
+		uploadResult, err := upload(ctx, file, fileConfig)
+		if err != nil {
+			result.Failed = append(result.Failed, UploadError{
+				FileName: file.Filename,
+				Error:    err.Error(),
+			})
+			continue
+		}
+
+		result.Results = append(result.Results, *uploadResult)
+	}
+
+	// Return error if all uploads failed
+	if len(result.Results) == 0 && len(result.Failed) > 0 {
+		return nil, fmt.Errorf("all %d file uploads failed", len(files))
+	}
+
+	return result, nil
+}
+
+// DeleteMultiple deletes each public ID with del and returns the deleted count and failed IDs
+func DeleteMultiple(ctx context.Context, del DeleteFunc, publicIDs []string) (int, []string, error) {
+	if len(publicIDs) == 0 {
+		return 0, nil, fmt.Errorf("no public IDs provided")
+	}
+
+	deletedCount := 0
+	failedIDs := []string{}
+
+	for _, publicID := range publicIDs {
+		if err := del(ctx, publicID); err != nil {
+			failedIDs = append(failedIDs, publicID)
+			continue
+		}
+		deletedCount++
+	}
+
+	return deletedCount, failedIDs, nil
+}
+
+// UploadBytes uploads a file generated by the server (export, report) through the same path as a form upload
+func UploadBytes(ctx context.Context, s Storage, fileName string, data []byte, config UploadConfig) (*UploadResult, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	part, err := writer.CreateFormFile(config.InputName, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create form file: %w", err)
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to write form file: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close form: %w", err)
+	}
+
+	// * maxMemory sebesar file supaya tidak ditulis ke temp file
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(int64(len(data)) + 1024)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read form: %w", err)
+	}
+	defer form.RemoveAll()
+
+	files := form.File[config.InputName]
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no file in form")
+	}
+
+	return s.UploadSingleFile(ctx, files[0], config)
+}
+
+// uploadFileMaxMemory keeps the form of UploadFile small in memory, sisanya ditulis ke temp file
+const uploadFileMaxMemory = 1 << 20
+
+// UploadFile uploads a file on disk without loading it into memory, dipakai untuk file export yang besar
+func UploadFile(ctx context.Context, s Storage, fileName string, path string, config UploadConfig) (*UploadResult, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	go func() {
+		part, err := writer.CreateFormFile(config.InputName, fileName)
+		if err != nil {
+			pw.CloseWithError(fmt.Errorf("failed to create form file: %w", err))
+			return
+		}
+		if _, err := io.Copy(part, file); err != nil {
+			pw.CloseWithError(fmt.Errorf("failed to write form file: %w", err))
+			return
+		}
+		pw.CloseWithError(writer.Close())
+	}()
+
+	form, err := multipart.NewReader(pr, writer.Boundary()).ReadForm(uploadFileMaxMemory)
+	// * Pastikan goroutine penulis berhenti kalau pembacaan gagal di tengah
+	pr.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read form: %w", err)
+	}
+	defer form.RemoveAll()
+
+	files := form.File[config.InputName]
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no file in form")
+	}
+
+	return s.UploadSingleFile(ctx, files[0], config)
+}
+
+// ObjectKey joins the folder and public ID like Cloudinary does, tanpa ekstensi
+func ObjectKey(folder, publicID string) string {
+	if folder == "" {
+		return publicID
+	}
+	return strings.Trim(folder, "/") + "/" + publicID
+}
+
+// IsRasterImage reports whether a file is an image format browsers cannot execute script from
+func IsRasterImage(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".jpg", ".jpeg", ".png", ".gif", ".webp", ".bmp", ".tiff", ".tif", ".ico", ".heic", ".heif", ".avif":
+		return true
+	default:
+		return false
+	}
+}
+
+// ResourceType mirrors the Cloudinary resource type of a file extension
+func ResourceType(ext string) string {
+	switch strings.ToLower(ext) {
+	case ".jpg", ".jpeg", ".png", ".gif", ".webp", ".bmp", ".tiff", ".tif", ".svg", ".ico", ".heic", ".heif", ".avif":
+		return "image"
+	default:
+		return "raw"
+	}
+}
@@ -0,0 +1,125 @@
+package messages
+
+// Asset Loan notification message keys
+const (
+	// Asset Checked Out
+	NotifAssetLoanCheckedOutTitleKey   NotificationMessageKey = "notification.asset_loan.checked_out.title"
+	NotifAssetLoanCheckedOutMessageKey NotificationMessageKey = "notification.asset_loan.checked_out.message"
+
+	// Asset Checked In
+	NotifAssetLoanCheckedInTitleKey   NotificationMessageKey = "notification.asset_loan.checked_in.title"
+	NotifAssetLoanCheckedInMessageKey NotificationMessageKey = "notification.asset_loan.checked_in.message"
+
+	// Asset Loan Due Soon
+	NotifAssetLoanDueSoonTitleKey   NotificationMessageKey = "notification.asset_loan.due_soon.title"
+	NotifAssetLoanDueSoonMessageKey NotificationMessageKey = "notification.asset_loan.due_soon.message"
+
+	// Asset Loan Overdue
+	NotifAssetLoanOverdueTitleKey   NotificationMessageKey = "notification.asset_loan.overdue.title"
+	NotifAssetLoanOverdueMessageKey NotificationMessageKey = "notification.asset_loan.overdue.message"
+)
+
+// assetLoanNotificationTranslations contains all asset loan notification message translations
+var assetLoanNotificationTranslations = map[NotificationMessageKey]map[string]string{
+	// ==================== ASSET CHECKED OUT ====================
+	NotifAssetLoanCheckedOutTitleKey: {
+		"en-US": "Asset Checked Out to You",
+		"id-ID": "Aset Dipinjamkan kepada Anda",
+		"ja-JP": "資産があなたに貸し出されました",
+	},
+	NotifAssetLoanCheckedOutMessageKey: {
+		"en-US": "Asset \"{assetName}\" ({assetTag}) has been checked out to you. Please return it by {expectedReturnDate}.",
+		"id-ID": "Aset \"{assetName}\" ({assetTag}) telah dipinjamkan kepada Anda. Harap kembalikan sebelum {expectedReturnDate}.",
+		"ja-JP": "資産 \"{assetName}\" ({assetTag}) があなたに貸し出されました。{expectedReturnDate} までに返却してください。",
+	},
+
+	// ==================== ASSET CHECKED IN ====================
+	NotifAssetLoanCheckedInTitleKey: {
+		"en-US": "Asset Returned",
+		"id-ID": "Aset Dikembalikan",
+		"ja-JP": "資産が返却されました",
+	},
+	NotifAssetLoanCheckedInMessageKey: {
+		"en-US": "Asset \"{assetName}\" ({assetTag}) has been checked in with condition \"{returnCondition}\".",
+		"id-ID": "Aset \"{assetName}\" ({assetTag}) telah dikembalikan dengan kondisi \"{returnCondition}\".",
+		"ja-JP": "資産 \"{assetName}\" ({assetTag}) が状態 \"{returnCondition}\" で返却されました。",
+	},
+
+	// ==================== ASSET LOAN DUE SOON ====================
+	NotifAssetLoanDueSoonTitleKey: {
+		"en-US": "Asset Return Due Soon",
+		"id-ID": "Pengembalian Aset Segera Jatuh Tempo",
+		"ja-JP": "資産の返却期限が近づいています",
+	},
+	NotifAssetLoanDueSoonMessageKey: {
+		"en-US": "Asset \"{assetName}\" ({assetTag}) is due to be returned on {expectedReturnDate}.",
+		"id-ID": "Aset \"{assetName}\" ({assetTag}) harus dikembalikan pada {expectedReturnDate}.",
+		"ja-JP": "資産 \"{assetName}\" ({assetTag}) の返却期限は {expectedReturnDate} です。",
+	},
+
+	// ==================== ASSET LOAN OVERDUE ====================
+	NotifAssetLoanOverdueTitleKey: {
+		"en-US": "Asset Return Overdue",
+		"id-ID": "Pengembalian Aset Terlambat",
+		"ja-JP": "資産の返却期限が過ぎています",
+	},
+	NotifAssetLoanOverdueMessageKey: {
+		"en-US": "Asset \"{assetName}\" ({assetTag}) borrowed by {borrowerName} was due on {expectedReturnDate} and is {daysOverdue} day(s) overdue.",
+		"id-ID": "Aset \"{assetName}\" ({assetTag}) yang dipinjam oleh {borrowerName} seharusnya dikembalikan pada {expectedReturnDate} dan sudah terlambat {daysOverdue} hari.",
+		"ja-JP": "{borrowerName} が借りている資産 \"{assetName}\" ({assetTag}) の返却期限は {expectedReturnDate} で、{daysOverdue} 日経過しています。",
+	},
+}
+
+// GetAssetLoanNotificationMessage returns the localized asset loan notification message
+func GetAssetLoanNotificationMessage(key NotificationMessageKey, langCode string, params map[string]string) string {
+	return GetNotificationMessage(key, langCode, params, assetLoanNotificationTranslations)
+}
+
+// GetAssetLoanNotificationTranslations returns all translations for an asset loan notification
+func GetAssetLoanNotificationTranslations(titleKey, messageKey NotificationMessageKey, params map[string]string) []NotificationTranslation {
+	return GetNotificationTranslations(titleKey, messageKey, params, assetLoanNotificationTranslations)
+}
+
+// ==================== ASSET LOAN NOTIFICATION HELPER FUNCTIONS ====================
+
+// AssetLoanCheckedOutNotification creates notification for asset checked out to a borrower
+func AssetLoanCheckedOutNotification(assetName, assetTag, expectedReturnDate string) (NotificationMessageKey, NotificationMessageKey, map[string]string) {
+	params := map[string]string{
+		"assetName":          assetName,
+		"assetTag":           assetTag,
+		"expectedReturnDate": expectedReturnDate,
+	}
+	return NotifAssetLoanCheckedOutTitleKey, NotifAssetLoanCheckedOutMessageKey, params
+}
+
+// AssetLoanCheckedInNotification creates notification for asset returned by a borrower
+func AssetLoanCheckedInNotification(assetName, assetTag, returnCondition string) (NotificationMessageKey, NotificationMessageKey, map[string]string) {
+	params := map[string]string{
+		"assetName":       assetName,
+		"assetTag":        assetTag,
+		"returnCondition": returnCondition,
+	}
+	return NotifAssetLoanCheckedInTitleKey, NotifAssetLoanCheckedInMessageKey, params
+}
+
+// AssetLoanDueSoonNotification creates notification for loan due soon
+func AssetLoanDueSoonNotification(assetName, assetTag, expectedReturnDate string) (NotificationMessageKey, NotificationMessageKey, map[string]string) {
+	params := map[string]string{
+		"assetName":          assetName,
+		"assetTag":           assetTag,
+		"expectedReturnDate": expectedReturnDate,
+	}
+	return NotifAssetLoanDueSoonTitleKey, NotifAssetLoanDueSoonMessageKey, params
+}
+
+// AssetLoanOverdueNotification creates notification for overdue loan
+func AssetLoanOverdueNotification(assetName, assetTag, borrowerName, expectedReturnDate, daysOverdue string) (NotificationMessageKey, NotificationMessageKey, map[string]string) {
+	params := map[string]string{
+		"assetName":          assetName,
+		"assetTag":           assetTag,
+		"borrowerName":       borrowerName,
+		"expectedReturnDate": expectedReturnDate,
+		"daysOverdue":        daysOverdue,
+	}
+	return NotifAssetLoanOverdueTitleKey, NotifAssetLoanOverdueMessageKey, params
+}
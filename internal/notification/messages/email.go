@@ -0,0 +1,84 @@
+package messages
+
+// Email notification message keys, judul dan isi notifikasi sendiri diambil dari translation notifikasinya
+const (
+	// Single notification email
+	EmailNotificationSubjectKey NotificationMessageKey = "notification.email.subject"
+	EmailNotificationIntroKey   NotificationMessageKey = "notification.email.intro"
+
+	// Daily digest email
+	EmailDigestSubjectKey NotificationMessageKey = "notification.email.digest.subject"
+	EmailDigestIntroKey   NotificationMessageKey = "notification.email.digest.intro"
+	EmailDigestMoreKey    NotificationMessageKey = "notification.email.digest.more"
+
+	// Shared parts
+	EmailGreetingKey       NotificationMessageKey = "notification.email.greeting"
+	EmailPriorityHighKey   NotificationMessageKey = "notification.email.priority.high"
+	EmailPriorityUrgentKey NotificationMessageKey = "notification.email.priority.urgent"
+	EmailActionKey         NotificationMessageKey = "notification.email.action"
+	EmailFooterKey         NotificationMessageKey = "notification.email.footer"
+)
+
+// emailTranslations contains all notification email template translations
+var emailTranslations = map[NotificationMessageKey]map[string]string{
+	// ==================== SINGLE NOTIFICATION ====================
+	EmailNotificationSubjectKey: {
+		"en-US": "[Inventory] {title}",
+		"id-ID": "[Inventory] {title}",
+		"ja-JP": "[Inventory] {title}",
+	},
+	EmailNotificationIntroKey: {
+		"en-US": "You have a new notification:",
+		"id-ID": "Anda memiliki notifikasi baru:",
+		"ja-JP": "新しい通知があります：",
+	},
+
+	// ==================== DAILY DIGEST ====================
+	EmailDigestSubjectKey: {
+		"en-US": "[Inventory] Daily summary: {count} unread notifications",
+		"id-ID": "[Inventory] Ringkasan harian: {count} notifikasi belum dibaca",
+		"ja-JP": "[Inventory] 毎日のまとめ：未読の通知 {count} 件",
+	},
+	EmailDigestIntroKey: {
+		"en-US": "Here are the notifications you have not read since the last summary:",
+		"id-ID": "Berikut notifikasi yang belum Anda baca sejak ringkasan terakhir:",
+		"ja-JP": "前回のまとめ以降に未読の通知は以下の通りです：",
+	},
+	EmailDigestMoreKey: {
+		"en-US": "...and {count} more notifications. Open the app to see all of them.",
+		"id-ID": "...dan {count} notifikasi lainnya. Buka aplikasi untuk melihat semuanya.",
+		"ja-JP": "...他 {count} 件の通知があります。すべて確認するにはアプリを開いてください。",
+	},
+
+	// ==================== SHARED ====================
+	EmailGreetingKey: {
+		"en-US": "Hi {name},",
+		"id-ID": "Halo {name},",
+		"ja-JP": "{name} さん、",
+	},
+	EmailPriorityHighKey: {
+		"en-US": "Important",
+		"id-ID": "Penting",
+		"ja-JP": "重要",
+	},
+	EmailPriorityUrgentKey: {
+		"en-US": "Urgent",
+		"id-ID": "Mendesak",
+		"ja-JP": "緊急",
+	},
+	EmailActionKey: {
+		"en-US": "Open notifications",
+		"id-ID": "Buka notifikasi",
+		"ja-JP": "通知を開く",
+	},
+	EmailFooterKey: {
+		"en-US": "You are receiving this email because email notifications are enabled for your account. You can turn them off per notification type or switch to a daily summary in your notification preferences.",
+		"id-ID": "Anda menerima email ini karena notifikasi email aktif untuk akun Anda. Anda dapat mematikannya per tipe notifikasi atau beralih ke ringkasan harian di preferensi notifikasi.",
+		"ja-JP": "このメールは、アカウントでメール通知が有効になっているため送信されています。通知設定で通知の種類ごとに無効にするか、毎日のまとめに切り替えることができます。",
+	},
+}
+
+// GetEmailMessage returns the localized notification email text
+func GetEmailMessage(key NotificationMessageKey, langCode string, params map[string]string) string {
+	return GetNotificationMessage(key, langCode, params, emailTranslations)
+}
@@ -0,0 +1,113 @@
+package messages
+
+// Report email message keys, dipakai untuk email report terjadwal dari saved filter
+const (
+	ReportEmailSubjectKey  NotificationMessageKey = "report.email.subject"
+	ReportEmailGreetingKey NotificationMessageKey = "report.email.greeting"
+	ReportEmailIntroKey    NotificationMessageKey = "report.email.intro"
+	ReportEmailFooterKey   NotificationMessageKey = "report.email.footer"
+
+	// Detail rows
+	ReportEmailReportLabelKey      NotificationMessageKey = "report.email.label.report"
+	ReportEmailDataLabelKey        NotificationMessageKey = "report.email.label.data"
+	ReportEmailGeneratedAtLabelKey NotificationMessageKey = "report.email.label.generated_at"
+	ReportEmailOwnerLabelKey       NotificationMessageKey = "report.email.label.owner"
+
+	// Entity names
+	ReportEntityAssetKey               NotificationMessageKey = "report.entity.asset"
+	ReportEntityAssetMovementKey       NotificationMessageKey = "report.entity.asset_movement"
+	ReportEntityIssueReportKey         NotificationMessageKey = "report.entity.issue_report"
+	ReportEntityMaintenanceScheduleKey NotificationMessageKey = "report.entity.maintenance_schedule"
+	ReportEntityMaintenanceRecordKey   NotificationMessageKey = "report.entity.maintenance_record"
+	ReportEntityScanLogKey             NotificationMessageKey = "report.entity.scan_log"
+	ReportEntityUserKey                NotificationMessageKey = "report.entity.user"
+)
+
+// reportEmailTranslations contains all report email template translations
+var reportEmailTranslations = map[NotificationMessageKey]map[string]string{
+	// ==================== EMAIL ====================
+	ReportEmailSubjectKey: {
+		"en-US": "[Inventory] Report: {name}",
+		"id-ID": "[Inventory] Laporan: {name}",
+		"ja-JP": "[Inventory] レポート：{name}",
+	},
+	ReportEmailGreetingKey: {
+		"en-US": "Hello,",
+		"id-ID": "Halo,",
+		"ja-JP": "こんにちは、",
+	},
+	ReportEmailIntroKey: {
+		"en-US": "Your scheduled report is attached to this email.",
+		"id-ID": "Laporan terjadwal Anda terlampir pada email ini.",
+		"ja-JP": "スケジュールされたレポートをこのメールに添付しました。",
+	},
+	ReportEmailFooterKey: {
+		"en-US": "You are receiving this email because {owner} added you as a recipient of this report. Ask them to remove you from the report schedule if you no longer want to receive it.",
+		"id-ID": "Anda menerima email ini karena {owner} menambahkan Anda sebagai penerima laporan ini. Minta mereka menghapus Anda dari jadwal laporan jika tidak ingin menerimanya lagi.",
+		"ja-JP": "このメールは、{owner} さんがあなたをこのレポートの受信者に追加したため送信されています。受信を停止したい場合は、レポートのスケジュールから削除するよう依頼してください。",
+	},
+
+	// ==================== DETAILS ====================
+	ReportEmailReportLabelKey: {
+		"en-US": "Report",
+		"id-ID": "Laporan",
+		"ja-JP": "レポート",
+	},
+	ReportEmailDataLabelKey: {
+		"en-US": "Data",
+		"id-ID": "Data",
+		"ja-JP": "データ",
+	},
+	ReportEmailGeneratedAtLabelKey: {
+		"en-US": "Generated at",
+		"id-ID": "Dibuat pada",
+		"ja-JP": "作成日時",
+	},
+	ReportEmailOwnerLabelKey: {
+		"en-US": "Scheduled by",
+		"id-ID": "Dijadwalkan oleh",
+		"ja-JP": "スケジュール作成者",
+	},
+
+	// ==================== ENTITIES ====================
+	ReportEntityAssetKey: {
+		"en-US": "Assets",
+		"id-ID": "Aset",
+		"ja-JP": "資産",
+	},
+	ReportEntityAssetMovementKey: {
+		"en-US": "Asset movements",
+		"id-ID": "Perpindahan aset",
+		"ja-JP": "資産の移動",
+	},
+	ReportEntityIssueReportKey: {
+		"en-US": "Issue reports",
+		"id-ID": "Laporan masalah",
+		"ja-JP": "問題報告",
+	},
+	ReportEntityMaintenanceScheduleKey: {
+		"en-US": "Maintenance schedules",
+		"id-ID": "Jadwal maintenance",
+		"ja-JP": "メンテナンススケジュール",
+	},
+	ReportEntityMaintenanceRecordKey: {
+		"en-US": "Maintenance records",
+		"id-ID": "Catatan maintenance",
+		"ja-JP": "メンテナンス記録",
+	},
+	ReportEntityScanLogKey: {
+		"en-US": "Scan logs",
+		"id-ID": "Log scan",
+		"ja-JP": "スキャンログ",
+	},
+	ReportEntityUserKey: {
+		"en-US": "Users",
+		"id-ID": "Pengguna",
+		"ja-JP": "ユーザー",
+	},
+}
+
+// GetReportEmailMessage returns the localized report email text
+func GetReportEmailMessage(key NotificationMessageKey, langCode string, params map[string]string) string {
+	return GetNotificationMessage(key, langCode, params, reportEmailTranslations)
+}
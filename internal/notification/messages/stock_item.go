@@ -0,0 +1,72 @@
+package messages
+
+// Stock Item notification message keys
+const (
+	// Low Stock
+	NotifStockItemLowStockTitleKey   NotificationMessageKey = "notification.stock_item.low_stock.title"
+	NotifStockItemLowStockMessageKey NotificationMessageKey = "notification.stock_item.low_stock.message"
+
+	// Out of Stock
+	NotifStockItemOutOfStockTitleKey   NotificationMessageKey = "notification.stock_item.out_of_stock.title"
+	NotifStockItemOutOfStockMessageKey NotificationMessageKey = "notification.stock_item.out_of_stock.message"
+)
+
+// stockItemNotificationTranslations contains all stock item notification message translations
+var stockItemNotificationTranslations = map[NotificationMessageKey]map[string]string{
+	// ==================== LOW STOCK ====================
+	NotifStockItemLowStockTitleKey: {
+		"en-US": "Low Stock Alert",
+		"id-ID": "Peringatan Stok Menipis",
+		"ja-JP": "在庫不足アラート",
+	},
+	NotifStockItemLowStockMessageKey: {
+		"en-US": "Stock item \"{itemName}\" ({sku}) is running low: {quantity} {unit} left, reorder point is {reorderPoint} {unit}.",
+		"id-ID": "Stok item \"{itemName}\" ({sku}) menipis: tersisa {quantity} {unit}, titik pemesanan ulang {reorderPoint} {unit}.",
+		"ja-JP": "在庫品目 \"{itemName}\" ({sku}) が不足しています: 残り {quantity} {unit}、発注点は {reorderPoint} {unit} です。",
+	},
+
+	// ==================== OUT OF STOCK ====================
+	NotifStockItemOutOfStockTitleKey: {
+		"en-US": "Out of Stock",
+		"id-ID": "Stok Habis",
+		"ja-JP": "在庫切れ",
+	},
+	NotifStockItemOutOfStockMessageKey: {
+		"en-US": "Stock item \"{itemName}\" ({sku}) is out of stock at every location.",
+		"id-ID": "Stok item \"{itemName}\" ({sku}) habis di semua lokasi.",
+		"ja-JP": "在庫品目 \"{itemName}\" ({sku}) はすべての場所で在庫切れです。",
+	},
+}
+
+// GetStockItemNotificationMessage returns the localized stock item notification message
+func GetStockItemNotificationMessage(key NotificationMessageKey, langCode string, params map[string]string) string {
+	return GetNotificationMessage(key, langCode, params, stockItemNotificationTranslations)
+}
+
+// GetStockItemNotificationTranslations returns all translations for a stock item notification
+func GetStockItemNotificationTranslations(titleKey, messageKey NotificationMessageKey, params map[string]string) []NotificationTranslation {
+	return GetNotificationTranslations(titleKey, messageKey, params, stockItemNotificationTranslations)
+}
+
+// ==================== STOCK ITEM NOTIFICATION HELPER FUNCTIONS ====================
+
+// StockItemLowStockNotification creates notification for a stock item that reached its reorder point
+func StockItemLowStockNotification(itemName, sku, quantity, unit, reorderPoint string) (NotificationMessageKey, NotificationMessageKey, map[string]string) {
+	params := map[string]string{
+		"itemName":     itemName,
+		"sku":          sku,
+		"quantity":     quantity,
+		"unit":         unit,
+		"reorderPoint": reorderPoint,
+	}
+	return NotifStockItemLowStockTitleKey, NotifStockItemLowStockMessageKey, params
+}
+
+// StockItemOutOfStockNotification creates notification for a stock item with no quantity left
+func StockItemOutOfStockNotification(itemName, sku string) (NotificationMessageKey, NotificationMessageKey, map[string]string) {
+	params := map[string]string{
+		"itemName": itemName,
+		"sku":      sku,
+	}
+	return NotifStockItemOutOfStockTitleKey, NotifStockItemOutOfStockMessageKey, params
+}
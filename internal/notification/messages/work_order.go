@@ -0,0 +1,73 @@
+package messages
+
+// Work Order notification message keys
+const (
+	// Work Order Assigned
+	NotifWorkOrderAssignedTitleKey   NotificationMessageKey = "notification.work_order.assigned.title"
+	NotifWorkOrderAssignedMessageKey NotificationMessageKey = "notification.work_order.assigned.message"
+
+	// Work Order Cancelled
+	NotifWorkOrderCancelledTitleKey   NotificationMessageKey = "notification.work_order.cancelled.title"
+	NotifWorkOrderCancelledMessageKey NotificationMessageKey = "notification.work_order.cancelled.message"
+)
+
+// workOrderNotificationTranslations contains all work order notification message translations
+var workOrderNotificationTranslations = map[NotificationMessageKey]map[string]string{
+	// ==================== WORK ORDER ASSIGNED ====================
+	NotifWorkOrderAssignedTitleKey: {
+		"en-US": "Work Order Assigned to You",
+		"id-ID": "Perintah Kerja Ditugaskan kepada Anda",
+		"ja-JP": "作業指示があなたに割り当てられました",
+	},
+	NotifWorkOrderAssignedMessageKey: {
+		"en-US": "Work order \"{workOrderTitle}\" for asset \"{assetName}\" ({assetTag}) has been assigned to you with {priority} priority. Due date: {dueDate}.",
+		"id-ID": "Perintah kerja \"{workOrderTitle}\" untuk aset \"{assetName}\" ({assetTag}) telah ditugaskan kepada Anda dengan prioritas {priority}. Tanggal jatuh tempo: {dueDate}.",
+		"ja-JP": "資産 \"{assetName}\" ({assetTag}) の作業指示 \"{workOrderTitle}\" が優先度 {priority} であなたに割り当てられました。期限: {dueDate}。",
+	},
+
+	// ==================== WORK ORDER CANCELLED ====================
+	NotifWorkOrderCancelledTitleKey: {
+		"en-US": "Work Order Cancelled",
+		"id-ID": "Perintah Kerja Dibatalkan",
+		"ja-JP": "作業指示がキャンセルされました",
+	},
+	NotifWorkOrderCancelledMessageKey: {
+		"en-US": "Work order \"{workOrderTitle}\" for asset \"{assetName}\" ({assetTag}) has been cancelled.",
+		"id-ID": "Perintah kerja \"{workOrderTitle}\" untuk aset \"{assetName}\" ({assetTag}) telah dibatalkan.",
+		"ja-JP": "資産 \"{assetName}\" ({assetTag}) の作業指示 \"{workOrderTitle}\" がキャンセルされました。",
+	},
+}
+
+// GetWorkOrderNotificationMessage returns the localized work order notification message
+func GetWorkOrderNotificationMessage(key NotificationMessageKey, langCode string, params map[string]string) string {
+	return GetNotificationMessage(key, langCode, params, workOrderNotificationTranslations)
+}
+
+// GetWorkOrderNotificationTranslations returns all translations for a work order notification
+func GetWorkOrderNotificationTranslations(titleKey, messageKey NotificationMessageKey, params map[string]string) []NotificationTranslation {
+	return GetNotificationTranslations(titleKey, messageKey, params, workOrderNotificationTranslations)
+}
+
+// ==================== WORK ORDER NOTIFICATION HELPER FUNCTIONS ====================
+
+// WorkOrderAssignedNotification creates notification for a work order assigned to a technician
+func WorkOrderAssignedNotification(workOrderTitle, assetName, assetTag, priority, dueDate string) (NotificationMessageKey, NotificationMessageKey, map[string]string) {
+	params := map[string]string{
+		"workOrderTitle": workOrderTitle,
+		"assetName":      assetName,
+		"assetTag":       assetTag,
+		"priority":       priority,
+		"dueDate":        dueDate,
+	}
+	return NotifWorkOrderAssignedTitleKey, NotifWorkOrderAssignedMessageKey, params
+}
+
+// WorkOrderCancelledNotification creates notification for a cancelled work order
+func WorkOrderCancelledNotification(workOrderTitle, assetName, assetTag string) (NotificationMessageKey, NotificationMessageKey, map[string]string) {
+	params := map[string]string{
+		"workOrderTitle": workOrderTitle,
+		"assetName":      assetName,
+		"assetTag":       assetTag,
+	}
+	return NotifWorkOrderCancelledTitleKey, NotifWorkOrderCancelledMessageKey, params
+}
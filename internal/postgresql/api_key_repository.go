@@ -0,0 +1,160 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) *APIKeyRepository {
+	return &APIKeyRepository{
+		db: db,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, payload *domain.APIKey) (domain.APIKey, error) {
+	modelKey := mapper.ToModelAPIKeyForCreate(payload)
+	if err := r.db.WithContext(ctx).Create(&modelKey).Error; err != nil {
+		return domain.APIKey{}, domain.ErrInternal(err)
+	}
+
+	return r.GetAPIKeyById(ctx, modelKey.ID.String())
+}
+
+func (r *APIKeyRepository) UpdateAPIKey(ctx context.Context, keyId string, payload *domain.UpdateAPIKeyPayload) (domain.APIKey, error) {
+	updates := mapper.ToModelAPIKeyUpdateMap(payload)
+	updates["updated_at"] = time.Now()
+
+	result := r.db.WithContext(ctx).Model(&model.APIKey{}).Where("id = ?", keyId).Updates(updates)
+	if result.Error != nil {
+		return domain.APIKey{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.APIKey{}, domain.ErrNotFound("api key")
+	}
+
+	return r.GetAPIKeyById(ctx, keyId)
+}
+
+// RotateAPIKey replaces the secret of an active key, the previous secret stops working immediately
+func (r *APIKeyRepository) RotateAPIKey(ctx context.Context, keyId string, keyPrefix string, keyHash string) (domain.APIKey, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", keyId).
+		Updates(map[string]any{
+			"key_prefix": keyPrefix,
+			"key_hash":   keyHash,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return domain.APIKey{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.APIKey{}, domain.ErrNotFound("api key")
+	}
+
+	return r.GetAPIKeyById(ctx, keyId)
+}
+
+func (r *APIKeyRepository) RevokeAPIKey(ctx context.Context, keyId string) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", keyId).
+		Updates(map[string]any{
+			"revoked_at": now,
+			"updated_at": now,
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// RecordAPIKeyUsage bumps the usage counter, dipanggil di background jadi tidak mengubah updated_at
+func (r *APIKeyRepository) RecordAPIKeyUsage(ctx context.Context, keyId string, ipAddress *string, usedAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("id = ?", keyId).
+		UpdateColumns(map[string]any{
+			"usage_count":  gorm.Expr("usage_count + 1"),
+			"last_used_at": usedAt,
+			"last_used_ip": ipAddress,
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (r *APIKeyRepository) GetAPIKeys(ctx context.Context) ([]domain.APIKey, error) {
+	var keys []model.APIKey
+
+	err := r.db.WithContext(ctx).
+		Order("created_at DESC").
+		Find(&keys).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAPIKeys(keys), nil
+}
+
+func (r *APIKeyRepository) GetAPIKeyById(ctx context.Context, keyId string) (domain.APIKey, error) {
+	var key model.APIKey
+
+	err := r.db.WithContext(ctx).
+		First(&key, "id = ?", keyId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.APIKey{}, domain.ErrNotFound("api key")
+		}
+		return domain.APIKey{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAPIKey(&key), nil
+}
+
+// GetAPIKeyByHash looks up the key sent by a client, revoked and expired keys are returned too so the caller can tell them apart
+func (r *APIKeyRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (domain.APIKey, bool, error) {
+	var key model.APIKey
+
+	err := r.db.WithContext(ctx).
+		Where("key_hash = ?", keyHash).
+		First(&key).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.APIKey{}, false, nil
+		}
+		return domain.APIKey{}, false, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAPIKey(&key), true, nil
+}
+
+func (r *APIKeyRepository) CheckAPIKeyNameExists(ctx context.Context, name string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.APIKey{}).Where("LOWER(name) = LOWER(?)", name).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *APIKeyRepository) CheckAPIKeyNameExistsExcluding(ctx context.Context, name string, excludeKeyId string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.APIKey{}).Where("LOWER(name) = LOWER(?) AND id != ?", name, excludeKeyId).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,109 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+)
+
+type AssetDocumentRepository struct {
+	db *gorm.DB
+}
+
+func NewAssetDocumentRepository(db *gorm.DB) *AssetDocumentRepository {
+	return &AssetDocumentRepository{
+		db: db,
+	}
+}
+
+func (r *AssetDocumentRepository) applyAssetDocumentFilters(db *gorm.DB, filters *domain.AssetDocumentFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.Type != nil && *filters.Type != "" {
+		db = db.Where("type = ?", *filters.Type)
+	}
+
+	if filters.MaintenanceRecordID != nil && *filters.MaintenanceRecordID != "" {
+		db = db.Where("maintenance_record_id = ?", *filters.MaintenanceRecordID)
+	}
+
+	if filters.ExpiresBefore != nil && *filters.ExpiresBefore != "" {
+		db = db.Where("expires_at < ?", *filters.ExpiresBefore)
+	}
+
+	return db
+}
+
+// *===========================MUTATION===========================*
+func (r *AssetDocumentRepository) CreateAssetDocument(ctx context.Context, payload *domain.AssetDocument) (domain.AssetDocument, error) {
+	modelDocument := mapper.ToModelAssetDocumentForCreate(payload)
+	if err := r.db.WithContext(ctx).Create(&modelDocument).Error; err != nil {
+		return domain.AssetDocument{}, domain.ErrInternal(err)
+	}
+
+	return r.GetAssetDocumentById(ctx, payload.AssetID, modelDocument.ID.String())
+}
+
+func (r *AssetDocumentRepository) DeleteAssetDocument(ctx context.Context, assetId string, documentId string) error {
+	result := r.db.WithContext(ctx).
+		Where("id = ? AND asset_id = ?", documentId, assetId).
+		Delete(&model.AssetDocument{})
+	if result.Error != nil {
+		return domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound("asset document")
+	}
+
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (r *AssetDocumentRepository) GetAssetDocuments(ctx context.Context, assetId string, filters *domain.AssetDocumentFilterOptions) ([]domain.AssetDocument, error) {
+	var documents []model.AssetDocument
+
+	db := r.db.WithContext(ctx).Where("asset_id = ?", assetId)
+	db = r.applyAssetDocumentFilters(db, filters)
+
+	if err := db.Order("type ASC, created_at DESC").Find(&documents).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAssetDocuments(documents), nil
+}
+
+func (r *AssetDocumentRepository) GetAssetDocumentById(ctx context.Context, assetId string, documentId string) (domain.AssetDocument, error) {
+	var document model.AssetDocument
+
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND asset_id = ?", documentId, assetId).
+		First(&document).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.AssetDocument{}, domain.ErrNotFound("asset document")
+		}
+		return domain.AssetDocument{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAssetDocument(&document), nil
+}
+
+func (r *AssetDocumentRepository) GetMaintenanceRecordDocuments(ctx context.Context, recordId string) ([]domain.AssetDocument, error) {
+	var documents []model.AssetDocument
+
+	err := r.db.WithContext(ctx).
+		Where("maintenance_record_id = ?", recordId).
+		Order("type ASC, created_at DESC").
+		Find(&documents).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAssetDocuments(documents), nil
+}
@@ -0,0 +1,348 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+)
+
+type AssetLoanRepository struct {
+	db *gorm.DB
+}
+
+func NewAssetLoanRepository(db *gorm.DB) *AssetLoanRepository {
+	return &AssetLoanRepository{
+		db: db,
+	}
+}
+
+func (r *AssetLoanRepository) applyAssetLoanFilters(db *gorm.DB, filters *domain.AssetLoanFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.AssetID != nil && *filters.AssetID != "" {
+		db = db.Where("al.asset_id = ?", *filters.AssetID)
+	}
+
+	if filters.BorrowerID != nil && *filters.BorrowerID != "" {
+		db = db.Where("al.borrower_id = ?", *filters.BorrowerID)
+	}
+
+	if filters.CheckedOutBy != nil && *filters.CheckedOutBy != "" {
+		db = db.Where("al.checked_out_by = ?", *filters.CheckedOutBy)
+	}
+
+	if filters.Status != nil {
+		db = db.Where("al.status = ?", *filters.Status)
+	}
+
+	if filters.IsOverdue != nil {
+		now := time.Now().UTC()
+		if *filters.IsOverdue {
+			db = db.Where("al.status = ? AND al.expected_return_date < ?", domain.AssetLoanStatusActive, now)
+		} else {
+			db = db.Where("NOT (al.status = ? AND al.expected_return_date < ?)", domain.AssetLoanStatusActive, now)
+		}
+	}
+
+	if filters.DateFrom != nil {
+		db = db.Where("al.checkout_date >= ?", *filters.DateFrom)
+	}
+
+	if filters.DateTo != nil {
+		db = db.Where("al.checkout_date <= ?", *filters.DateTo)
+	}
+
+	return db
+}
+
+func (r *AssetLoanRepository) applyAssetLoanSorts(db *gorm.DB, sort *domain.AssetLoanSortOptions) *gorm.DB {
+	if sort == nil || sort.Field == "" {
+		return db.Order("al.checkout_date DESC")
+	}
+
+	// Map camelCase sort field to snake_case database column
+	columnName := mapper.MapAssetLoanSortFieldToColumn(sort.Field)
+
+	order := "DESC"
+	if sort.Order == domain.SortOrderAsc {
+		order = "ASC"
+	}
+	return db.Order(fmt.Sprintf("%s %s", columnName, order))
+}
+
+func (r *AssetLoanRepository) applyAssetLoanSearch(db *gorm.DB, searchQuery *string) *gorm.DB {
+	if searchQuery == nil || *searchQuery == "" {
+		return db
+	}
+
+	// Join with assets table for search in asset tag/name
+	return db.Joins("LEFT JOIN assets a ON al.asset_id = a.id").
+		Where("a.asset_tag ILIKE ? OR a.asset_name ILIKE ? OR a.serial_number ILIKE ?",
+			"%"+*searchQuery+"%", "%"+*searchQuery+"%", "%"+*searchQuery+"%")
+}
+
+func (r *AssetLoanRepository) preloadAssetLoanRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Asset").
+		Preload("Asset.Category").
+		Preload("Asset.Category.Translations").
+		Preload("Asset.Location").
+		Preload("Asset.Location.Translations").
+		Preload("Borrower").
+		Preload("CheckedOutByUser").
+		Preload("CheckedInByUser")
+}
+
+// *===========================MUTATION===========================*
+
+// CheckOutAsset creates the loan together with its checkout movement and assigns the asset to the borrower
+func (r *AssetLoanRepository) CheckOutAsset(ctx context.Context, loan *domain.AssetLoan, movement *domain.AssetMovement) (domain.AssetLoan, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.AssetLoan{}, domain.ErrInternal(tx.Error)
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Create checkout movement
+	modelMovement := mapper.ToModelAssetMovementForCreate(movement)
+	if err := tx.Create(&modelMovement).Error; err != nil {
+		tx.Rollback()
+		return domain.AssetLoan{}, domain.ErrInternal(err)
+	}
+
+	// Create loan
+	movementID := modelMovement.ID.String()
+	loan.CheckoutMovementID = &movementID
+	modelLoan := mapper.ToModelAssetLoanForCreate(loan)
+	if err := tx.Create(&modelLoan).Error; err != nil {
+		tx.Rollback()
+		return domain.AssetLoan{}, domain.ErrInternal(err)
+	}
+
+	// * Assign asset to borrower
+	if err := tx.Table("assets").Where("id = ?", loan.AssetID).Update("assigned_to", loan.BorrowerID).Error; err != nil {
+		tx.Rollback()
+		return domain.AssetLoan{}, domain.ErrInternal(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.AssetLoan{}, domain.ErrInternal(err)
+	}
+
+	return r.GetAssetLoanById(ctx, modelLoan.ID.String())
+}
+
+// CheckInAsset closes the loan, records the return movement and releases the asset from the borrower
+func (r *AssetLoanRepository) CheckInAsset(ctx context.Context, loan *domain.AssetLoan, movement *domain.AssetMovement) (domain.AssetLoan, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.AssetLoan{}, domain.ErrInternal(tx.Error)
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Create return movement
+	modelMovement := mapper.ToModelAssetMovementForCreate(movement)
+	if err := tx.Create(&modelMovement).Error; err != nil {
+		tx.Rollback()
+		return domain.AssetLoan{}, domain.ErrInternal(err)
+	}
+
+	// Close loan, only if it is still active to prevent double check-in
+	movementID := modelMovement.ID.String()
+	loan.ReturnMovementID = &movementID
+	result := tx.Table("asset_loans").
+		Where("id = ? AND status = ?", loan.ID, domain.AssetLoanStatusActive).
+		Updates(mapper.ToModelAssetLoanCheckInMap(loan))
+	if result.Error != nil {
+		tx.Rollback()
+		return domain.AssetLoan{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return domain.AssetLoan{}, domain.ErrNotFound("active asset loan")
+	}
+
+	// * Release asset and record returned condition
+	assetUpdates := map[string]any{
+		"assigned_to": nil,
+	}
+	if loan.ReturnCondition != nil {
+		assetUpdates["condition_status"] = *loan.ReturnCondition
+	}
+	if movement.ToLocationID != nil {
+		assetUpdates["location_id"] = *movement.ToLocationID
+	}
+	if err := tx.Table("assets").Where("id = ?", loan.AssetID).Updates(assetUpdates).Error; err != nil {
+		tx.Rollback()
+		return domain.AssetLoan{}, domain.ErrInternal(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.AssetLoan{}, domain.ErrInternal(err)
+	}
+
+	return r.GetAssetLoanById(ctx, loan.ID)
+}
+
+// *===========================QUERY===========================*
+func (r *AssetLoanRepository) GetAssetLoansPaginated(ctx context.Context, params domain.AssetLoanParams, langCode string) ([]domain.AssetLoan, error) {
+	var loans []model.AssetLoan
+	db := r.preloadAssetLoanRelations(r.db.WithContext(ctx).Table("asset_loans al"))
+
+	db = r.applyAssetLoanSearch(db, params.SearchQuery)
+	db = r.applyAssetLoanFilters(db, params.Filters)
+	db = r.applyAssetLoanSorts(db, params.Sort)
+	if params.Pagination != nil {
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
+	if err := db.Find(&loans).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAssetLoans(loans), nil
+}
+
+func (r *AssetLoanRepository) GetAssetLoansCursor(ctx context.Context, params domain.AssetLoanParams, langCode string) ([]domain.AssetLoan, error) {
+	var loans []model.AssetLoan
+	db := r.preloadAssetLoanRelations(r.db.WithContext(ctx).Table("asset_loans al"))
+
+	db = r.applyAssetLoanSearch(db, params.SearchQuery)
+	db = r.applyAssetLoanFilters(db, params.Filters)
+
+	// Apply sorting - for cursor pagination, we need consistent ordering by ID
+	if params.Sort != nil && params.Sort.Field != "" {
+		db = r.applyAssetLoanSorts(db, params.Sort)
+	}
+	db = db.Order("al.id DESC")
+
+	// Apply cursor-based pagination
+	if params.Pagination != nil {
+		if params.Pagination.Cursor != "" {
+			db = db.Where("al.id < ?", params.Pagination.Cursor)
+		}
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+	}
+
+	if err := db.Find(&loans).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAssetLoans(loans), nil
+}
+
+func (r *AssetLoanRepository) GetAssetLoanById(ctx context.Context, loanId string) (domain.AssetLoan, error) {
+	var loan model.AssetLoan
+
+	err := r.preloadAssetLoanRelations(r.db.WithContext(ctx).Table("asset_loans al")).
+		First(&loan, "al.id = ?", loanId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.AssetLoan{}, domain.ErrNotFound("asset loan")
+		}
+		return domain.AssetLoan{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAssetLoan(&loan), nil
+}
+
+func (r *AssetLoanRepository) GetActiveAssetLoanByAssetId(ctx context.Context, assetId string) (domain.AssetLoan, error) {
+	var loan model.AssetLoan
+
+	err := r.preloadAssetLoanRelations(r.db.WithContext(ctx).Table("asset_loans al")).
+		Where("al.asset_id = ? AND al.status = ?", assetId, domain.AssetLoanStatusActive).
+		First(&loan).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.AssetLoan{}, domain.ErrNotFound("asset loan")
+		}
+		return domain.AssetLoan{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAssetLoan(&loan), nil
+}
+
+func (r *AssetLoanRepository) CheckActiveAssetLoanExist(ctx context.Context, assetId string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Table("asset_loans al").
+		Where("al.asset_id = ? AND al.status = ?", assetId, domain.AssetLoanStatusActive).
+		Count(&count).Error
+	if err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *AssetLoanRepository) CountAssetLoans(ctx context.Context, params domain.AssetLoanParams) (int64, error) {
+	var count int64
+	db := r.db.WithContext(ctx).Table("asset_loans al")
+
+	db = r.applyAssetLoanSearch(db, params.SearchQuery)
+	db = r.applyAssetLoanFilters(db, params.Filters)
+
+	if err := db.Count(&count).Error; err != nil {
+		return 0, domain.ErrInternal(err)
+	}
+	return count, nil
+}
+
+// GetAssetLoansDueSoon retrieves active loans that are due within specified days
+func (r *AssetLoanRepository) GetAssetLoansDueSoon(ctx context.Context, daysFromNow int) ([]domain.AssetLoan, error) {
+	var loans []model.AssetLoan
+
+	now := time.Now().UTC()
+	futureDate := now.AddDate(0, 0, daysFromNow)
+
+	db := r.preloadAssetLoanRelations(r.db.WithContext(ctx).Table("asset_loans al")).
+		Where("al.status = ?", domain.AssetLoanStatusActive).
+		Where("al.expected_return_date >= ?", now).
+		Where("al.expected_return_date <= ?", futureDate)
+
+	if err := db.Find(&loans).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAssetLoans(loans), nil
+}
+
+// GetOverdueAssetLoans retrieves active loans past their expected return date
+func (r *AssetLoanRepository) GetOverdueAssetLoans(ctx context.Context) ([]domain.AssetLoan, error) {
+	var loans []model.AssetLoan
+
+	now := time.Now().UTC()
+
+	db := r.preloadAssetLoanRelations(r.db.WithContext(ctx).Table("asset_loans al")).
+		Where("al.status = ?", domain.AssetLoanStatusActive).
+		Where("al.expected_return_date < ?", now)
+
+	if err := db.Find(&loans).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAssetLoans(loans), nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/query"
 	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -23,7 +24,13 @@ func NewAssetMovementRepository(db *gorm.DB) *AssetMovementRepository {
 	}
 }
 
+// * Movement terlihat oleh user yang pegang lokasi asal atau lokasi tujuan
+const assetMovementLocationScope = "from_location_id IN ? OR to_location_id IN ?"
+
 func (r *AssetMovementRepository) applyAssetMovementFilters(db *gorm.DB, filters *domain.AssetMovementFilterOptions) *gorm.DB {
+	// * Scope lokasi selalu berlaku walaupun tanpa filter
+	db = db.Scopes(query.LocationScope(assetMovementLocationScope))
+
 	if filters == nil {
 		return db
 	}
@@ -316,7 +323,7 @@ func (r *AssetMovementRepository) DeleteAssetMovement(ctx context.Context, movem
 	}
 
 	// Delete asset movement
-	result := tx.Where("id = ?", movementId).Delete(&model.AssetMovement{})
+	result := tx.Scopes(query.LocationScope(assetMovementLocationScope)).Where("id = ?", movementId).Delete(&model.AssetMovement{})
 	if result.Error != nil {
 		tx.Rollback()
 		return domain.ErrInternal(result.Error)
@@ -344,7 +351,7 @@ func (r *AssetMovementRepository) BulkDeleteAssetMovements(ctx context.Context,
 
 	// First, find which movements actually exist
 	var existingMovements []model.AssetMovement
-	if err := r.db.WithContext(ctx).Select("id").Where("id IN ?", movementIds).Find(&existingMovements).Error; err != nil {
+	if err := r.db.WithContext(ctx).Scopes(query.LocationScope(assetMovementLocationScope)).Select("id").Where("id IN ?", movementIds).Find(&existingMovements).Error; err != nil {
 		return result, domain.ErrInternal(err)
 	}
 
@@ -512,6 +519,7 @@ func (r *AssetMovementRepository) GetAssetMovementById(ctx context.Context, move
 		Preload("FromUser").
 		Preload("ToUser").
 		Preload("MovedByUser").
+		Scopes(query.LocationScope(assetMovementLocationScope)).
 		First(&movement, "am.id = ?", movementId).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -585,9 +593,12 @@ func (r *AssetMovementRepository) CountAssetMovements(ctx context.Context, param
 func (r *AssetMovementRepository) GetAssetMovementStatistics(ctx context.Context) (domain.AssetMovementStatistics, error) {
 	var stats domain.AssetMovementStatistics
 
+	// * Raw query statistik dibatasi lokasi user yang request
+	scopeSQL, scopeArgs := query.LocationScopeSQL(ctx, assetMovementLocationScope)
+
 	// Get total asset movement count
 	var totalCount int64
-	if err := r.db.WithContext(ctx).Table("asset_movements").Count(&totalCount).Error; err != nil {
+	if err := r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).Count(&totalCount).Error; err != nil {
 		return stats, err
 	}
 	stats.Total.Count = int(totalCount)
@@ -601,6 +612,7 @@ func (r *AssetMovementRepository) GetAssetMovementStatistics(ctx context.Context
 	}
 	if err := r.db.WithContext(ctx).
 		Table("asset_movements am").
+		Scopes(query.LocationScope(assetMovementLocationScope)).
 		Select("am.asset_id, a.asset_tag, COALESCE(a.asset_name, '') as asset_name, COUNT(*) as movement_count").
 		Joins("LEFT JOIN assets a ON am.asset_id = a.id").
 		Group("am.asset_id, a.asset_tag, a.asset_name").
@@ -640,19 +652,19 @@ func (r *AssetMovementRepository) GetAssetMovementStatistics(ctx context.Context
 		LEFT JOIN (
 			SELECT to_location_id as location_id, COUNT(*) as count
 			FROM asset_movements
-			WHERE to_location_id IS NOT NULL
+			WHERE to_location_id IS NOT NULL AND `+scopeSQL+`
 			GROUP BY to_location_id
 		) incoming ON l.id = incoming.location_id
 		LEFT JOIN (
 			SELECT from_location_id as location_id, COUNT(*) as count
 			FROM asset_movements
-			WHERE from_location_id IS NOT NULL
+			WHERE from_location_id IS NOT NULL AND `+scopeSQL+`
 			GROUP BY from_location_id
 		) outgoing ON l.id = outgoing.location_id
 		WHERE incoming.count > 0 OR outgoing.count > 0
 		ORDER BY (COALESCE(incoming.count, 0) + COALESCE(outgoing.count, 0)) DESC
 		LIMIT 10
-	`).Find(&locationStats).Error; err != nil {
+	`, append(append([]any{}, scopeArgs...), scopeArgs...)...).Find(&locationStats).Error; err != nil {
 		return stats, err
 	}
 
@@ -676,6 +688,7 @@ func (r *AssetMovementRepository) GetAssetMovementStatistics(ctx context.Context
 	}
 	if err := r.db.WithContext(ctx).
 		Table("asset_movements am").
+		Scopes(query.LocationScope(assetMovementLocationScope)).
 		Select("am.moved_by as user_id, u.name as user_name, COUNT(*) as movement_count").
 		Joins("LEFT JOIN users u ON am.moved_by = u.id").
 		Group("am.moved_by, u.name").
@@ -704,27 +717,27 @@ func (r *AssetMovementRepository) GetAssetMovementStatistics(ctx context.Context
 	}
 
 	// Location to Location
-	r.db.WithContext(ctx).Table("asset_movements").
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).
 		Where("from_location_id IS NOT NULL AND to_location_id IS NOT NULL").
 		Count(&typeStats.LocationToLocation)
 
 	// Location to User
-	r.db.WithContext(ctx).Table("asset_movements").
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).
 		Where("from_location_id IS NOT NULL AND to_user_id IS NOT NULL").
 		Count(&typeStats.LocationToUser)
 
 	// User to Location
-	r.db.WithContext(ctx).Table("asset_movements").
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).
 		Where("from_user_id IS NOT NULL AND to_location_id IS NOT NULL").
 		Count(&typeStats.UserToLocation)
 
 	// User to User
-	r.db.WithContext(ctx).Table("asset_movements").
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).
 		Where("from_user_id IS NOT NULL AND to_user_id IS NOT NULL").
 		Count(&typeStats.UserToUser)
 
 	// New Asset (no from location or user)
-	r.db.WithContext(ctx).Table("asset_movements").
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).
 		Where("from_location_id IS NULL AND from_user_id IS NULL").
 		Count(&typeStats.NewAsset)
 
@@ -766,9 +779,10 @@ func (r *AssetMovementRepository) GetAssetMovementStatistics(ctx context.Context
 		LEFT JOIN users fu ON am.from_user_id = fu.id
 		LEFT JOIN users tu ON am.to_user_id = tu.id
 		LEFT JOIN users mb ON am.moved_by = mb.id
+		WHERE `+scopeSQL+`
 		ORDER BY am.movement_date DESC
 		LIMIT 10
-	`).Find(&recentMovements).Error; err != nil {
+	`, scopeArgs...).Find(&recentMovements).Error; err != nil {
 		return stats, err
 	}
 
@@ -809,10 +823,10 @@ func (r *AssetMovementRepository) GetAssetMovementStatistics(ctx context.Context
 			DATE(movement_date) as date,
 			COUNT(*) as count
 		FROM asset_movements
-		WHERE movement_date >= CURRENT_DATE - INTERVAL '30 days'
+		WHERE movement_date >= CURRENT_DATE - INTERVAL '30 days' AND `+scopeSQL+`
 		GROUP BY DATE(movement_date)
 		ORDER BY date DESC
-	`).Find(&movementTrends).Error; err != nil {
+	`, scopeArgs...).Find(&movementTrends).Error; err != nil {
 		return stats, err
 	}
 
@@ -829,21 +843,21 @@ func (r *AssetMovementRepository) GetAssetMovementStatistics(ctx context.Context
 
 	// Get movements today
 	var movementsToday int64
-	r.db.WithContext(ctx).Table("asset_movements").
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).
 		Where("DATE(movement_date) = CURRENT_DATE").
 		Count(&movementsToday)
 	stats.Summary.MovementsToday = int(movementsToday)
 
 	// Get movements this week
 	var movementsThisWeek int64
-	r.db.WithContext(ctx).Table("asset_movements").
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).
 		Where("movement_date >= DATE_TRUNC('week', CURRENT_DATE)").
 		Count(&movementsThisWeek)
 	stats.Summary.MovementsThisWeek = int(movementsThisWeek)
 
 	// Get movements this month
 	var movementsThisMonth int64
-	r.db.WithContext(ctx).Table("asset_movements").
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).
 		Where("movement_date >= DATE_TRUNC('month', CURRENT_DATE)").
 		Count(&movementsThisMonth)
 	stats.Summary.MovementsThisMonth = int(movementsThisMonth)
@@ -861,9 +875,11 @@ func (r *AssetMovementRepository) GetAssetMovementStatistics(ctx context.Context
 
 	// Get unique counts
 	var uniqueAssets, uniqueLocations, uniqueUsers int64
-	r.db.WithContext(ctx).Table("asset_movements").Select("COUNT(DISTINCT asset_id)").Row().Scan(&uniqueAssets)
-	r.db.WithContext(ctx).Raw("SELECT COUNT(DISTINCT location_id) FROM (SELECT from_location_id as location_id FROM asset_movements WHERE from_location_id IS NOT NULL UNION SELECT to_location_id as location_id FROM asset_movements WHERE to_location_id IS NOT NULL) t").Row().Scan(&uniqueLocations)
-	r.db.WithContext(ctx).Raw("SELECT COUNT(DISTINCT user_id) FROM (SELECT from_user_id as user_id FROM asset_movements WHERE from_user_id IS NOT NULL UNION SELECT to_user_id as user_id FROM asset_movements WHERE to_user_id IS NOT NULL UNION SELECT moved_by as user_id FROM asset_movements) t").Row().Scan(&uniqueUsers)
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).Select("COUNT(DISTINCT asset_id)").Row().Scan(&uniqueAssets)
+	r.db.WithContext(ctx).Raw("SELECT COUNT(DISTINCT location_id) FROM (SELECT from_location_id as location_id FROM asset_movements WHERE from_location_id IS NOT NULL AND "+scopeSQL+" UNION SELECT to_location_id as location_id FROM asset_movements WHERE to_location_id IS NOT NULL AND "+scopeSQL+") t",
+		append(append([]any{}, scopeArgs...), scopeArgs...)...).Row().Scan(&uniqueLocations)
+	r.db.WithContext(ctx).Raw("SELECT COUNT(DISTINCT user_id) FROM (SELECT from_user_id as user_id FROM asset_movements WHERE from_user_id IS NOT NULL AND "+scopeSQL+" UNION SELECT to_user_id as user_id FROM asset_movements WHERE to_user_id IS NOT NULL AND "+scopeSQL+" UNION SELECT moved_by as user_id FROM asset_movements WHERE "+scopeSQL+") t",
+		append(append(append([]any{}, scopeArgs...), scopeArgs...), scopeArgs...)...).Row().Scan(&uniqueUsers)
 
 	stats.Summary.UniqueAssetsWithMovements = int(uniqueAssets)
 	stats.Summary.UniqueLocationsInvolved = int(uniqueLocations)
@@ -871,8 +887,8 @@ func (r *AssetMovementRepository) GetAssetMovementStatistics(ctx context.Context
 
 	// Get earliest and latest movement dates
 	var earliestDate, latestDate *time.Time
-	r.db.WithContext(ctx).Table("asset_movements").Select("MIN(movement_date)").Row().Scan(&earliestDate)
-	r.db.WithContext(ctx).Table("asset_movements").Select("MAX(movement_date)").Row().Scan(&latestDate)
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).Select("MIN(movement_date)").Row().Scan(&earliestDate)
+	r.db.WithContext(ctx).Table("asset_movements").Scopes(query.LocationScope(assetMovementLocationScope)).Select("MAX(movement_date)").Row().Scan(&latestDate)
 
 	if earliestDate != nil {
 		stats.Summary.EarliestMovementDate = *earliestDate
@@ -924,6 +940,18 @@ func (r *AssetMovementRepository) GetAssetMovementsForExport(ctx context.Context
 	db = r.applyAssetMovementFilters(db, params.Filters)
 	db = r.applyAssetMovementSorts(db, params.Sort)
 
+	// * Tanpa pagination ambil semua data, export job mengisi limit/offset untuk ambil per batch
+	// * dan ID sebagai tie-breaker supaya urutan antar batch stabil
+	if params.Pagination != nil {
+		db = db.Order("am.id")
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
 	if err := db.Find(&movements).Error; err != nil {
 		return nil, domain.ErrInternal(err)
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/query"
 	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -23,7 +24,15 @@ func NewAssetRepository(db *gorm.DB) *AssetRepository {
 	}
 }
 
+// * scopedAssets query dasar tabel assets yang sudah dibatasi lokasi user yang request
+func (r *AssetRepository) scopedAssets(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&model.Asset{}).Scopes(query.LocationScope("assets.location_id IN ?"))
+}
+
 func (r *AssetRepository) applyAssetFilters(db *gorm.DB, filters *domain.AssetFilterOptions) *gorm.DB {
+	// * Scope lokasi selalu berlaku walaupun tanpa filter
+	db = db.Scopes(query.LocationScope("a.location_id IN ?"))
+
 	if filters == nil {
 		return db
 	}
@@ -49,10 +58,50 @@ func (r *AssetRepository) applyAssetFilters(db *gorm.DB, filters *domain.AssetFi
 	if filters.Model != nil {
 		db = db.Where("a.model ILIKE ?", "%"+*filters.Model+"%")
 	}
+	for key, value := range filters.CustomAttributes {
+		db = db.Where("a.custom_attributes ->> ? = ?", key, value)
+	}
 	return db
 }
 
-func (r *AssetRepository) applyAssetSorts(db *gorm.DB, sort *domain.AssetSortOptions) *gorm.DB {
+// * Full-text di search_vector (tag, serial number, nama, brand, model, nama category dan location semua bahasa, custom attribute),
+// * ditambah substring dan trigram similarity di tag dan serial number supaya typo tetap ketemu
+const assetSearchCondition = "(a.search_vector @@ to_tsquery('simple', ?) OR a.asset_tag ILIKE ? OR a.serial_number ILIKE ? OR a.asset_tag % ? OR a.serial_number % ?)"
+
+// * Exact match tag atau serial number selalu paling atas, sisanya ts_rank ditambah similarity tag/serial number
+const assetSearchRank = "ts_rank_cd(a.search_vector, to_tsquery('simple', ?)) + GREATEST(similarity(a.asset_tag, ?), similarity(COALESCE(a.serial_number, ''), ?)) + CASE WHEN lower(a.asset_tag) = lower(?) OR lower(a.serial_number) = lower(?) THEN 1 ELSE 0 END"
+
+// * Teks yang dipakai ts_headline untuk snippet highlight asset
+const assetSearchDocument = "concat_ws(' ', a.asset_name, a.asset_tag, a.serial_number, a.brand, a.model)"
+
+func assetSearchConditionArgs(term searchTerm) []any {
+	return []any{term.TsQuery, term.Pattern, term.Pattern, term.Text, term.Text}
+}
+
+func assetSearchRankArgs(term searchTerm) []any {
+	return []any{term.TsQuery, term.Text, term.Text, term.Text, term.Text}
+}
+
+func (r *AssetRepository) applyAssetSearch(db *gorm.DB, searchQuery *string) *gorm.DB {
+	if searchQuery == nil || *searchQuery == "" {
+		return db
+	}
+
+	term := newSearchTerm(*searchQuery)
+	return db.Where(assetSearchCondition, assetSearchConditionArgs(term)...)
+}
+
+// * Saat search tanpa sortBy (atau sortBy=relevance) hasil diurutkan berdasarkan relevansi
+func (r *AssetRepository) applyAssetSorts(db *gorm.DB, sort *domain.AssetSortOptions, searchQuery *string) *gorm.DB {
+	if searchQuery != nil && *searchQuery != "" && (sort == nil || sort.Field == "" || sort.Field == domain.AssetSortByRelevance) {
+		term := newSearchTerm(*searchQuery)
+		return db.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                assetSearchRank + " DESC, a.id DESC",
+			Vars:               assetSearchRankArgs(term),
+			WithoutParentheses: true,
+		}})
+	}
+
 	if sort == nil || sort.Field == "" {
 		return db.Order("a.created_at DESC")
 	}
@@ -168,7 +217,7 @@ func (r *AssetRepository) BulkDeleteAssets(ctx context.Context, assetIds []strin
 
 	// First, find which assets actually exist
 	var existingAssets []model.Asset
-	if err := r.db.WithContext(ctx).Select("id").Where("id IN ?", assetIds).Find(&existingAssets).Error; err != nil {
+	if err := r.scopedAssets(ctx).Select("id").Where("id IN ?", assetIds).Find(&existingAssets).Error; err != nil {
 		return result, domain.ErrInternal(err)
 	}
 
@@ -219,17 +268,13 @@ func (r *AssetRepository) GetAssetsPaginated(ctx context.Context, params domain.
 		Preload("User").
 		Preload("AssetImages.Image")
 
-	if params.SearchQuery != nil && *params.SearchQuery != "" {
-		searchPattern := "%" + *params.SearchQuery + "%"
-		db = db.Where("a.asset_tag ILIKE ? OR a.asset_name ILIKE ? OR a.brand ILIKE ? OR a.model ILIKE ? OR a.serial_number ILIKE ?",
-			searchPattern, searchPattern, searchPattern, searchPattern, searchPattern)
-	}
+	db = r.applyAssetSearch(db, params.SearchQuery)
 
 	// Apply filters
 	db = r.applyAssetFilters(db, params.Filters)
 
 	// Apply sorting
-	db = r.applyAssetSorts(db, params.Sort)
+	db = r.applyAssetSorts(db, params.Sort, params.SearchQuery)
 
 	// Apply pagination
 	if params.Pagination != nil {
@@ -260,18 +305,15 @@ func (r *AssetRepository) GetAssetsCursor(ctx context.Context, params domain.Ass
 		Preload("User").
 		Preload("AssetImages.Image")
 
-	if params.SearchQuery != nil && *params.SearchQuery != "" {
-		searchPattern := "%" + *params.SearchQuery + "%"
-		db = db.Where("a.asset_tag ILIKE ? OR a.asset_name ILIKE ? OR a.brand ILIKE ? OR a.model ILIKE ? OR a.serial_number ILIKE ?",
-			searchPattern, searchPattern, searchPattern, searchPattern, searchPattern)
-	}
+	db = r.applyAssetSearch(db, params.SearchQuery)
 
 	// Apply filters
 	db = r.applyAssetFilters(db, params.Filters)
 
 	// Apply sorting - for cursor pagination, we need consistent ordering by ID
+	// * Relevance tidak bisa dipakai dengan cursor ID, jadi search di cursor tetap urut sesuai sortBy/ID
 	if params.Sort != nil && params.Sort.Field != "" {
-		db = r.applyAssetSorts(db, params.Sort)
+		db = r.applyAssetSorts(db, params.Sort, nil)
 		// Always add secondary sort by ID DESC for consistency (ULID = newer = larger)
 		db = db.Order("a.id DESC")
 	} else {
@@ -307,6 +349,7 @@ func (r *AssetRepository) GetAssetById(ctx context.Context, assetId string) (dom
 		Preload("Location.Translations").
 		Preload("User").
 		Preload("AssetImages.Image").
+		Scopes(query.LocationScope("assets.location_id IN ?")).
 		First(&asset, "id = ?", assetId).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -328,6 +371,7 @@ func (r *AssetRepository) GetAssetByAssetTag(ctx context.Context, assetTag strin
 		Preload("Location.Translations").
 		Preload("User").
 		Preload("AssetImages.Image").
+		Scopes(query.LocationScope("assets.location_id IN ?")).
 		Where("asset_tag = ?", assetTag).First(&asset).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -341,7 +385,7 @@ func (r *AssetRepository) GetAssetByAssetTag(ctx context.Context, assetTag strin
 
 func (r *AssetRepository) CheckAssetExists(ctx context.Context, assetId string) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("id = ?", assetId).Count(&count).Error
+	err := r.scopedAssets(ctx).Where("id = ?", assetId).Count(&count).Error
 	if err != nil {
 		return false, domain.ErrInternal(err)
 	}
@@ -388,11 +432,7 @@ func (r *AssetRepository) CountAssets(ctx context.Context, params domain.AssetPa
 	var count int64
 	db := r.db.WithContext(ctx).Table("assets a")
 
-	if params.SearchQuery != nil && *params.SearchQuery != "" {
-		searchPattern := "%" + *params.SearchQuery + "%"
-		db = db.Where("a.asset_tag ILIKE ? OR a.asset_name ILIKE ? OR a.brand ILIKE ? OR a.model ILIKE ? OR a.serial_number ILIKE ?",
-			searchPattern, searchPattern, searchPattern, searchPattern, searchPattern)
-	}
+	db = r.applyAssetSearch(db, params.SearchQuery)
 
 	// Apply filters
 	db = r.applyAssetFilters(db, params.Filters)
@@ -408,23 +448,23 @@ func (r *AssetRepository) GetAssetStatistics(ctx context.Context) (domain.AssetS
 
 	// Get total asset count
 	var totalCount int64
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Count(&totalCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Count(&totalCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	stats.Total.Count = int(totalCount)
 
 	// Get asset counts by status
 	var activeCount, maintenanceCount, disposedCount, lostCount int64
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("status = ?", domain.StatusActive).Count(&activeCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("status = ?", domain.StatusActive).Count(&activeCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("status = ?", domain.StatusMaintenance).Count(&maintenanceCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("status = ?", domain.StatusMaintenance).Count(&maintenanceCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("status = ?", domain.StatusDisposed).Count(&disposedCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("status = ?", domain.StatusDisposed).Count(&disposedCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("status = ?", domain.StatusLost).Count(&lostCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("status = ?", domain.StatusLost).Count(&lostCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	stats.ByStatus.Active = int(activeCount)
@@ -434,16 +474,16 @@ func (r *AssetRepository) GetAssetStatistics(ctx context.Context) (domain.AssetS
 
 	// Get asset counts by condition
 	var goodCount, fairCount, poorCount, damagedCount int64
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("condition_status = ?", domain.ConditionGood).Count(&goodCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("condition_status = ?", domain.ConditionGood).Count(&goodCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("condition_status = ?", domain.ConditionFair).Count(&fairCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("condition_status = ?", domain.ConditionFair).Count(&fairCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("condition_status = ?", domain.ConditionPoor).Count(&poorCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("condition_status = ?", domain.ConditionPoor).Count(&poorCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("condition_status = ?", domain.ConditionDamaged).Count(&damagedCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("condition_status = ?", domain.ConditionDamaged).Count(&damagedCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	stats.ByCondition.Good = int(goodCount)
@@ -453,10 +493,10 @@ func (r *AssetRepository) GetAssetStatistics(ctx context.Context) (domain.AssetS
 
 	// Get assignment statistics
 	var assignedCount, unassignedCount int64
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("assigned_to IS NOT NULL").Count(&assignedCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("assigned_to IS NOT NULL").Count(&assignedCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("assigned_to IS NULL").Count(&unassignedCount).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("assigned_to IS NULL").Count(&unassignedCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	stats.ByAssignment.Assigned = int(assignedCount)
@@ -472,20 +512,48 @@ func (r *AssetRepository) GetAssetStatistics(ctx context.Context) (domain.AssetS
 		AssetsWithoutValue int64    `json:"assets_without_value"`
 	}
 
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).
+	if err := r.scopedAssets(ctx).
 		Select("SUM(purchase_price) as total_value, AVG(purchase_price) as average_value, MIN(purchase_price) as min_value, MAX(purchase_price) as max_value").
 		Where("purchase_price IS NOT NULL").
 		Scan(&valueStats).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("purchase_price IS NOT NULL").Count(&valueStats.AssetsWithValue).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("purchase_price IS NOT NULL").Count(&valueStats.AssetsWithValue).Error; err != nil {
+		return stats, domain.ErrInternal(err)
+	}
+	if err := r.scopedAssets(ctx).Where("purchase_price IS NULL").Count(&valueStats.AssetsWithoutValue).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("purchase_price IS NULL").Count(&valueStats.AssetsWithoutValue).Error; err != nil {
+
+	// Book value dihitung di aplikasi karena metode depresiasi per kategori
+	var depreciationRows []struct {
+		PurchasePrice       float64
+		PurchaseDate        *time.Time
+		DepreciationMethod  *domain.DepreciationMethod
+		UsefulLifeYears     *int
+		SalvageValuePercent *float64
+	}
+	if err := r.db.WithContext(ctx).
+		Table("assets a").
+		Scopes(query.LocationScope("a.location_id IN ?")).
+		Select("a.purchase_price, a.purchase_date, c.depreciation_method, c.useful_life_years, c.salvage_value_percent").
+		Joins("LEFT JOIN categories c ON c.id = a.category_id").
+		Where("a.purchase_price IS NOT NULL").
+		Scan(&depreciationRows).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 
+	if len(depreciationRows) > 0 {
+		var totalBookValue float64
+		asOf := time.Now()
+		for _, row := range depreciationRows {
+			depreciation := domain.CalculateDepreciation(row.PurchasePrice, row.PurchaseDate, row.DepreciationMethod, row.UsefulLifeYears, row.SalvageValuePercent, asOf)
+			totalBookValue += depreciation.BookValue
+		}
+		stats.ValueStatistics.TotalBookValue = &totalBookValue
+	}
+
 	stats.ValueStatistics.TotalValue = valueStats.TotalValue
 	stats.ValueStatistics.AverageValue = valueStats.AverageValue
 	stats.ValueStatistics.MinValue = valueStats.MinValue
@@ -496,13 +564,13 @@ func (r *AssetRepository) GetAssetStatistics(ctx context.Context) (domain.AssetS
 	// Get warranty statistics
 	var activeWarranties, expiredWarranties, noWarrantyInfo int64
 	currentTime := time.Now().UTC()
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("warranty_end IS NOT NULL AND warranty_end > ?", currentTime).Count(&activeWarranties).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("warranty_end IS NOT NULL AND warranty_end > ?", currentTime).Count(&activeWarranties).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("warranty_end IS NOT NULL AND warranty_end <= ?", currentTime).Count(&expiredWarranties).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("warranty_end IS NOT NULL AND warranty_end <= ?", currentTime).Count(&expiredWarranties).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("warranty_end IS NULL").Count(&noWarrantyInfo).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("warranty_end IS NULL").Count(&noWarrantyInfo).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	stats.WarrantyStatistics.ActiveWarranties = int(activeWarranties)
@@ -514,7 +582,7 @@ func (r *AssetRepository) GetAssetStatistics(ctx context.Context) (domain.AssetS
 		Date  time.Time `json:"date"`
 		Count int64     `json:"count"`
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).
+	if err := r.scopedAssets(ctx).
 		Select("DATE(created_at) as date, COUNT(*) as count").
 		Where("created_at >= NOW() - INTERVAL '30 days'").
 		Group("DATE(created_at)").
@@ -540,6 +608,7 @@ func (r *AssetRepository) GetAssetStatistics(ctx context.Context) (domain.AssetS
 	}
 	if err := r.db.WithContext(ctx).
 		Table("assets a").
+		Scopes(query.LocationScope("a.location_id IN ?")).
 		Select("c.id as category_id, c.category_code, COALESCE(ct.category_name, c.category_code) as category_name, COUNT(a.id) as asset_count").
 		Joins("INNER JOIN categories c ON a.category_id = c.id").
 		Joins("LEFT JOIN category_translations ct ON c.id = ct.category_id AND ct.lang_code = 'en'").
@@ -573,6 +642,7 @@ func (r *AssetRepository) GetAssetStatistics(ctx context.Context) (domain.AssetS
 	}
 	if err := r.db.WithContext(ctx).
 		Table("assets a").
+		Scopes(query.LocationScope("a.location_id IN ?")).
 		Select("l.id as location_id, l.location_code, COALESCE(lt.location_name, l.location_code) as location_name, COUNT(a.id) as asset_count").
 		Joins("INNER JOIN locations l ON a.location_id = l.id").
 		Joins("LEFT JOIN location_translations lt ON l.id = lt.location_id AND lt.lang_code = 'en'").
@@ -600,10 +670,10 @@ func (r *AssetRepository) GetAssetStatistics(ctx context.Context) (domain.AssetS
 
 	// Count unique categories and locations for summary
 	var uniqueCategories, uniqueLocations int64
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Distinct("category_id").Count(&uniqueCategories).Error; err != nil {
+	if err := r.scopedAssets(ctx).Distinct("category_id").Count(&uniqueCategories).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Where("location_id IS NOT NULL").Distinct("location_id").Count(&uniqueLocations).Error; err != nil {
+	if err := r.scopedAssets(ctx).Where("location_id IS NOT NULL").Distinct("location_id").Count(&uniqueLocations).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	stats.Summary.TotalCategories = int(uniqueCategories)
@@ -635,10 +705,10 @@ func (r *AssetRepository) GetAssetStatistics(ctx context.Context) (domain.AssetS
 
 	// Get earliest and latest creation dates
 	var earliestDate, latestDate time.Time
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Select("MIN(created_at)").Scan(&earliestDate).Error; err != nil {
+	if err := r.scopedAssets(ctx).Select("MIN(created_at)").Scan(&earliestDate).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Select("MAX(created_at)").Scan(&latestDate).Error; err != nil {
+	if err := r.scopedAssets(ctx).Select("MAX(created_at)").Scan(&latestDate).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 
@@ -712,19 +782,26 @@ func (r *AssetRepository) GetAssetsForExport(ctx context.Context, params domain.
 		Preload("User").
 		Preload("AssetImages.Image")
 
-	if params.SearchQuery != nil && *params.SearchQuery != "" {
-		searchPattern := "%" + *params.SearchQuery + "%"
-		db = db.Where("a.asset_tag ILIKE ? OR a.asset_name ILIKE ? OR a.brand ILIKE ? OR a.model ILIKE ? OR a.serial_number ILIKE ?",
-			searchPattern, searchPattern, searchPattern, searchPattern, searchPattern)
-	}
+	db = r.applyAssetSearch(db, params.SearchQuery)
 
 	// Apply filters
 	db = r.applyAssetFilters(db, params.Filters)
 
 	// Apply sorting
-	db = r.applyAssetSorts(db, params.Sort)
+	db = r.applyAssetSorts(db, params.Sort, params.SearchQuery)
+
+	// * Tanpa pagination ambil semua data, export job mengisi limit/offset untuk ambil per batch
+	// * dan ID sebagai tie-breaker supaya urutan antar batch stabil
+	if params.Pagination != nil {
+		db = db.Order("a.id")
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
 
-	// No pagination for export - get all matching assets
 	if err := db.Find(&assets).Error; err != nil {
 		return nil, domain.ErrInternal(err)
 	}
@@ -733,6 +810,70 @@ func (r *AssetRepository) GetAssetsForExport(ctx context.Context, params domain.
 	return mapper.ToDomainAssets(assets), nil
 }
 
+// GetCategoriesForExport returns the categories used by the assets matching params,
+// export job butuh schema atribut-nya untuk header kolom sebelum baris pertama ditulis
+func (r *AssetRepository) GetCategoriesForExport(ctx context.Context, params domain.AssetParams) ([]domain.Category, error) {
+	categoryIds := r.db.WithContext(ctx).Table("assets a").Select("DISTINCT a.category_id")
+	categoryIds = r.applyAssetSearch(categoryIds, params.SearchQuery)
+	categoryIds = r.applyAssetFilters(categoryIds, params.Filters)
+
+	var categories []model.Category
+	err := r.db.WithContext(ctx).
+		Where("id IN (?)", categoryIds).
+		Order("category_code ASC").
+		Find(&categories).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainCategories(categories), nil
+}
+
+// GetCustomAttributeKeysForExport returns every custom attribute key stored on the assets matching params
+func (r *AssetRepository) GetCustomAttributeKeysForExport(ctx context.Context, params domain.AssetParams) ([]string, error) {
+	db := r.db.WithContext(ctx).
+		Table("assets a").
+		Select("DISTINCT jsonb_object_keys(a.custom_attributes)").
+		Where("jsonb_typeof(a.custom_attributes) = 'object'")
+	db = r.applyAssetSearch(db, params.SearchQuery)
+	db = r.applyAssetFilters(db, params.Filters)
+
+	var keys []string
+	if err := db.Scan(&keys).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return keys, nil
+}
+
+// GetAssetSearchHighlights returns highlighted snippets keyed by asset ID for assets returned by a search
+func (r *AssetRepository) GetAssetSearchHighlights(ctx context.Context, assetIds []string, searchQuery string) (map[string]string, error) {
+	highlights := make(map[string]string, len(assetIds))
+	if len(assetIds) == 0 || searchQuery == "" {
+		return highlights, nil
+	}
+
+	term := newSearchTerm(searchQuery)
+	var rows []struct {
+		ID        string
+		Highlight string
+	}
+	err := r.db.WithContext(ctx).
+		Table("assets a").
+		Select("a.id, ts_headline('simple', "+assetSearchDocument+", to_tsquery('simple', ?), ?) AS highlight", term.TsQuery, searchHeadlineOptions).
+		Where("a.id IN ?", assetIds).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	for _, row := range rows {
+		highlights[row.ID] = formatSearchHighlight(row.Highlight)
+	}
+
+	return highlights, nil
+}
+
 // GetAssetsWithWarrantyExpiring retrieves assets with warranties expiring within specified days
 func (r *AssetRepository) GetAssetsWithWarrantyExpiring(ctx context.Context, daysFromNow int) ([]domain.Asset, error) {
 	var assets []model.Asset
@@ -803,7 +944,7 @@ func (r *AssetRepository) CreateImage(ctx context.Context, imageURL string, publ
 	return mapper.ToDomainImage(&modelImage), nil
 }
 
-// GetImageByPublicID finds an existing image by Cloudinary public_id
+// GetImageByPublicID finds an existing image by storage public_id
 func (r *AssetRepository) GetImageByPublicID(ctx context.Context, publicID string) (*domain.Image, error) {
 	var modelImage model.Image
 	err := r.db.WithContext(ctx).Where("public_id = ?", publicID).First(&modelImage).Error
@@ -0,0 +1,121 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+)
+
+type AuditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) *AuditLogRepository {
+	return &AuditLogRepository{
+		db: db,
+	}
+}
+
+func (r *AuditLogRepository) applyAuditLogFilters(db *gorm.DB, filters *domain.AuditLogFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.EntityType != nil && *filters.EntityType != "" {
+		db = db.Where("aul.entity_type = ?", *filters.EntityType)
+	}
+
+	if filters.EntityID != nil && *filters.EntityID != "" {
+		db = db.Where("aul.entity_id = ?", *filters.EntityID)
+	}
+
+	if filters.ActorID != nil && *filters.ActorID != "" {
+		db = db.Where("aul.actor_id = ?", *filters.ActorID)
+	}
+
+	if filters.Action != nil && *filters.Action != "" {
+		db = db.Where("aul.action = ?", *filters.Action)
+	}
+
+	if filters.DateFrom != nil {
+		db = db.Where("aul.created_at >= ?", *filters.DateFrom)
+	}
+
+	if filters.DateTo != nil {
+		db = db.Where("aul.created_at <= ?", *filters.DateTo)
+	}
+
+	return db
+}
+
+func (r *AuditLogRepository) applyAuditLogSorts(db *gorm.DB, sort *domain.AuditLogSortOptions) *gorm.DB {
+	if sort == nil || sort.Field == "" {
+		return db.Order("aul.created_at DESC")
+	}
+
+	// Map camelCase sort field to snake_case database column
+	columnName := mapper.MapAuditLogSortFieldToColumn(sort.Field)
+
+	order := "DESC"
+	if sort.Order == domain.SortOrderAsc {
+		order = "ASC"
+	}
+	return db.Order(fmt.Sprintf("%s %s", columnName, order))
+}
+
+// *===========================MUTATION===========================*
+func (r *AuditLogRepository) CreateAuditLog(ctx context.Context, payload *domain.AuditLog) (domain.AuditLog, error) {
+	modelLog := mapper.ToModelAuditLogForCreate(payload)
+
+	if err := r.db.WithContext(ctx).Create(&modelLog).Error; err != nil {
+		return domain.AuditLog{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAuditLog(&modelLog), nil
+}
+
+// *===========================QUERY===========================*
+func (r *AuditLogRepository) GetAuditLogsCursor(ctx context.Context, params domain.AuditLogParams) ([]domain.AuditLog, error) {
+	var logs []model.AuditLog
+	db := r.db.WithContext(ctx).Table("audit_logs aul").Preload("Actor")
+
+	db = r.applyAuditLogFilters(db, params.Filters)
+
+	// Apply sorting - for cursor pagination, we need consistent ordering by ID
+	if params.Sort != nil && params.Sort.Field != "" {
+		db = r.applyAuditLogSorts(db, params.Sort)
+	}
+	db = db.Order("aul.id DESC")
+
+	// Apply cursor-based pagination
+	if params.Pagination != nil {
+		if params.Pagination.Cursor != "" {
+			db = db.Where("aul.id < ?", params.Pagination.Cursor)
+		}
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+	}
+
+	if err := db.Find(&logs).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAuditLogs(logs), nil
+}
+
+func (r *AuditLogRepository) CountAuditLogs(ctx context.Context, params domain.AuditLogParams) (int64, error) {
+	var count int64
+	db := r.db.WithContext(ctx).Table("audit_logs aul")
+
+	db = r.applyAuditLogFilters(db, params.Filters)
+
+	if err := db.Count(&count).Error; err != nil {
+		return 0, domain.ErrInternal(err)
+	}
+	return count, nil
+}
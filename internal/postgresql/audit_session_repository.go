@@ -0,0 +1,427 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AuditSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditSessionRepository(db *gorm.DB) *AuditSessionRepository {
+	return &AuditSessionRepository{
+		db: db,
+	}
+}
+
+func (r *AuditSessionRepository) applyAuditSessionFilters(db *gorm.DB, filters *domain.AuditSessionFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.Status != nil {
+		db = db.Where("aus.status = ?", *filters.Status)
+	}
+
+	if filters.LocationID != nil && *filters.LocationID != "" {
+		db = db.Where("aus.location_id = ?", *filters.LocationID)
+	}
+
+	if filters.CategoryID != nil && *filters.CategoryID != "" {
+		db = db.Where("aus.category_id = ?", *filters.CategoryID)
+	}
+
+	if filters.StartedBy != nil && *filters.StartedBy != "" {
+		db = db.Where("aus.started_by = ?", *filters.StartedBy)
+	}
+
+	if filters.DateFrom != nil {
+		db = db.Where("aus.started_at >= ?", *filters.DateFrom)
+	}
+
+	if filters.DateTo != nil {
+		db = db.Where("aus.started_at <= ?", *filters.DateTo)
+	}
+
+	return db
+}
+
+func (r *AuditSessionRepository) applyAuditSessionSorts(db *gorm.DB, sort *domain.AuditSessionSortOptions) *gorm.DB {
+	if sort == nil || sort.Field == "" {
+		return db.Order("aus.started_at DESC")
+	}
+
+	// Map camelCase sort field to snake_case database column
+	columnName := mapper.MapAuditSessionSortFieldToColumn(sort.Field)
+
+	order := "DESC"
+	if sort.Order == domain.SortOrderAsc {
+		order = "ASC"
+	}
+	return db.Order(fmt.Sprintf("%s %s", columnName, order))
+}
+
+func (r *AuditSessionRepository) applyAuditSessionSearch(db *gorm.DB, searchQuery *string) *gorm.DB {
+	if searchQuery == nil || *searchQuery == "" {
+		return db
+	}
+
+	return db.Where("aus.title ILIKE ?", "%"+*searchQuery+"%")
+}
+
+func (r *AuditSessionRepository) preloadAuditSessionRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Location").
+		Preload("Location.Translations").
+		Preload("Category").
+		Preload("Category.Translations").
+		Preload("StartedByUser").
+		Preload("ClosedByUser")
+}
+
+func (r *AuditSessionRepository) preloadAuditSessionItemRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Asset").
+		Preload("Asset.Category").
+		Preload("Asset.Category.Translations").
+		Preload("Asset.Location").
+		Preload("Asset.Location.Translations").
+		Preload("ExpectedLocation").
+		Preload("ExpectedLocation.Translations")
+}
+
+// *===========================MUTATION===========================*
+
+// CreateAuditSession creates the session and snapshots every asset expected within its scope
+func (r *AuditSessionRepository) CreateAuditSession(ctx context.Context, payload *domain.AuditSession) (domain.AuditSession, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.AuditSession{}, domain.ErrInternal(tx.Error)
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	modelSession := mapper.ToModelAuditSessionForCreate(payload)
+	if err := tx.Omit(clause.Associations).Create(&modelSession).Error; err != nil {
+		tx.Rollback()
+		return domain.AuditSession{}, domain.ErrInternal(err)
+	}
+
+	// * Snapshot expected assets, disposed and lost assets are not expected to be found
+	var expectedAssets []model.Asset
+	assetQuery := tx.Table("assets a").
+		Select("a.id, a.location_id").
+		Where("a.status IN ?", []domain.AssetStatus{domain.StatusActive, domain.StatusMaintenance})
+	if payload.LocationID != nil {
+		assetQuery = assetQuery.Where("a.location_id = ?", *payload.LocationID)
+	}
+	if payload.CategoryID != nil {
+		assetQuery = assetQuery.Where("a.category_id = ?", *payload.CategoryID)
+	}
+	if err := assetQuery.Find(&expectedAssets).Error; err != nil {
+		tx.Rollback()
+		return domain.AuditSession{}, domain.ErrInternal(err)
+	}
+
+	if len(expectedAssets) > 0 {
+		items := make([]*model.AuditSessionItem, len(expectedAssets))
+		for i, asset := range expectedAssets {
+			items[i] = &model.AuditSessionItem{
+				SessionID:          modelSession.ID,
+				AssetID:            asset.ID,
+				IsExpected:         true,
+				ExpectedLocationID: asset.LocationID,
+				Result:             domain.AuditItemResultPending,
+			}
+		}
+
+		if err := tx.
+			Omit(clause.Associations).
+			Session(&gorm.Session{CreateBatchSize: 500}).
+			Create(&items).Error; err != nil {
+			tx.Rollback()
+			return domain.AuditSession{}, domain.ErrInternal(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.AuditSession{}, domain.ErrInternal(err)
+	}
+
+	return r.GetAuditSessionById(ctx, modelSession.ID.String())
+}
+
+func (r *AuditSessionRepository) CreateAuditSessionItem(ctx context.Context, payload *domain.AuditSessionItem) (domain.AuditSessionItem, error) {
+	modelItem := mapper.ToModelAuditSessionItemForCreate(payload)
+
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).Create(&modelItem).Error; err != nil {
+		return domain.AuditSessionItem{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAuditSessionItem(&modelItem), nil
+}
+
+// UpdateAuditSessionItemScan stores the scan outcome, only the first scan of an asset is kept
+func (r *AuditSessionRepository) UpdateAuditSessionItemScan(ctx context.Context, item *domain.AuditSessionItem) error {
+	err := r.db.WithContext(ctx).Table("audit_session_items").
+		Where("id = ? AND scanned_at IS NULL", item.ID).
+		Updates(map[string]any{
+			"result":      item.Result,
+			"scan_log_id": item.ScanLogID,
+			"scanned_by":  item.ScannedBy,
+			"scanned_at":  item.ScannedAt,
+			"updated_at":  time.Now(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// CloseAuditSession finalizes pending items, applies the requested corrections and closes the session
+func (r *AuditSessionRepository) CloseAuditSession(ctx context.Context, session *domain.AuditSession, corrections []domain.AssetMovement, lostAssetIds []string) (domain.AuditSession, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.AuditSession{}, domain.ErrInternal(tx.Error)
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	now := time.Now()
+
+	// * Expected assets that were never scanned are missing
+	if err := tx.Table("audit_session_items").
+		Where("session_id = ? AND result = ?", session.ID, domain.AuditItemResultPending).
+		Updates(map[string]any{"result": domain.AuditItemResultMissing, "updated_at": now}).Error; err != nil {
+		tx.Rollback()
+		return domain.AuditSession{}, domain.ErrInternal(err)
+	}
+
+	// * Move wrong-location assets to where they were actually found
+	for i := range corrections {
+		modelMovement := mapper.ToModelAssetMovementForCreate(&corrections[i])
+		if err := tx.Create(&modelMovement).Error; err != nil {
+			tx.Rollback()
+			return domain.AuditSession{}, domain.ErrInternal(err)
+		}
+
+		if err := tx.Table("assets").Where("id = ?", corrections[i].AssetID).
+			Update("location_id", corrections[i].ToLocationID).Error; err != nil {
+			tx.Rollback()
+			return domain.AuditSession{}, domain.ErrInternal(err)
+		}
+
+		if err := tx.Table("audit_session_items").
+			Where("session_id = ? AND asset_id = ?", session.ID, corrections[i].AssetID).
+			Updates(map[string]any{"correction_movement_id": modelMovement.ID.String(), "updated_at": now}).Error; err != nil {
+			tx.Rollback()
+			return domain.AuditSession{}, domain.ErrInternal(err)
+		}
+	}
+
+	// * Mark missing assets as lost
+	if len(lostAssetIds) > 0 {
+		if err := tx.Table("assets").Where("id IN ?", lostAssetIds).
+			Update("status", domain.StatusLost).Error; err != nil {
+			tx.Rollback()
+			return domain.AuditSession{}, domain.ErrInternal(err)
+		}
+
+		if err := tx.Table("audit_session_items").
+			Where("session_id = ? AND asset_id IN ?", session.ID, lostAssetIds).
+			Updates(map[string]any{"marked_lost": true, "updated_at": now}).Error; err != nil {
+			tx.Rollback()
+			return domain.AuditSession{}, domain.ErrInternal(err)
+		}
+	}
+
+	// * Close session, only if it is still open to prevent double close
+	sessionUpdates := map[string]any{
+		"status":     domain.AuditSessionStatusClosed,
+		"closed_by":  session.ClosedBy,
+		"closed_at":  session.ClosedAt,
+		"updated_at": now,
+	}
+	if session.Notes != nil {
+		sessionUpdates["notes"] = *session.Notes
+	}
+	result := tx.Table("audit_sessions").
+		Where("id = ? AND status = ?", session.ID, domain.AuditSessionStatusOpen).
+		Updates(sessionUpdates)
+	if result.Error != nil {
+		tx.Rollback()
+		return domain.AuditSession{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return domain.AuditSession{}, domain.ErrNotFound("open audit session")
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.AuditSession{}, domain.ErrInternal(err)
+	}
+
+	return r.GetAuditSessionById(ctx, session.ID)
+}
+
+// *===========================QUERY===========================*
+func (r *AuditSessionRepository) GetAuditSessionsPaginated(ctx context.Context, params domain.AuditSessionParams) ([]domain.AuditSession, error) {
+	var sessions []model.AuditSession
+	db := r.preloadAuditSessionRelations(r.db.WithContext(ctx).Table("audit_sessions aus"))
+
+	db = r.applyAuditSessionSearch(db, params.SearchQuery)
+	db = r.applyAuditSessionFilters(db, params.Filters)
+	db = r.applyAuditSessionSorts(db, params.Sort)
+	if params.Pagination != nil {
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
+	if err := db.Find(&sessions).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAuditSessions(sessions), nil
+}
+
+func (r *AuditSessionRepository) GetAuditSessionsCursor(ctx context.Context, params domain.AuditSessionParams) ([]domain.AuditSession, error) {
+	var sessions []model.AuditSession
+	db := r.preloadAuditSessionRelations(r.db.WithContext(ctx).Table("audit_sessions aus"))
+
+	db = r.applyAuditSessionSearch(db, params.SearchQuery)
+	db = r.applyAuditSessionFilters(db, params.Filters)
+
+	// Apply sorting - for cursor pagination, we need consistent ordering by ID
+	if params.Sort != nil && params.Sort.Field != "" {
+		db = r.applyAuditSessionSorts(db, params.Sort)
+	}
+	db = db.Order("aus.id DESC")
+
+	// Apply cursor-based pagination
+	if params.Pagination != nil {
+		if params.Pagination.Cursor != "" {
+			db = db.Where("aus.id < ?", params.Pagination.Cursor)
+		}
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+	}
+
+	if err := db.Find(&sessions).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAuditSessions(sessions), nil
+}
+
+func (r *AuditSessionRepository) GetAuditSessionById(ctx context.Context, sessionId string) (domain.AuditSession, error) {
+	var session model.AuditSession
+
+	err := r.preloadAuditSessionRelations(r.db.WithContext(ctx).Table("audit_sessions aus")).
+		First(&session, "aus.id = ?", sessionId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.AuditSession{}, domain.ErrNotFound("audit session")
+		}
+		return domain.AuditSession{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAuditSession(&session), nil
+}
+
+// CheckOpenAuditSessionExists checks whether an open session with exactly the same scope exists
+func (r *AuditSessionRepository) CheckOpenAuditSessionExists(ctx context.Context, locationId *string, categoryId *string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Table("audit_sessions aus").
+		Where("aus.status = ?", domain.AuditSessionStatusOpen).
+		Where("aus.location_id IS NOT DISTINCT FROM ?", locationId).
+		Where("aus.category_id IS NOT DISTINCT FROM ?", categoryId).
+		Count(&count).Error
+	if err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+// GetOpenAuditSessionIdForAsset returns the most recent open session that expects the asset, nil if there is none
+func (r *AuditSessionRepository) GetOpenAuditSessionIdForAsset(ctx context.Context, assetId string) (*string, error) {
+	var sessionIds []string
+	err := r.db.WithContext(ctx).Table("audit_sessions aus").
+		Joins("JOIN audit_session_items asi ON asi.session_id = aus.id").
+		Where("aus.status = ? AND asi.asset_id = ?", domain.AuditSessionStatusOpen, assetId).
+		Order("aus.started_at DESC").
+		Limit(1).
+		Pluck("aus.id", &sessionIds).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+	if len(sessionIds) == 0 {
+		return nil, nil
+	}
+	return &sessionIds[0], nil
+}
+
+func (r *AuditSessionRepository) GetAuditSessionItems(ctx context.Context, sessionId string, result *domain.AuditItemResult) ([]domain.AuditSessionItem, error) {
+	var items []model.AuditSessionItem
+	db := r.preloadAuditSessionItemRelations(r.db.WithContext(ctx).Table("audit_session_items asi")).
+		Where("asi.session_id = ?", sessionId)
+
+	if result != nil {
+		db = db.Where("asi.result = ?", *result)
+	}
+
+	if err := db.Order("asi.created_at ASC").Find(&items).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAuditSessionItems(items), nil
+}
+
+func (r *AuditSessionRepository) GetAuditSessionItemByAssetId(ctx context.Context, sessionId string, assetId string) (domain.AuditSessionItem, error) {
+	var item model.AuditSessionItem
+
+	err := r.db.WithContext(ctx).Table("audit_session_items asi").
+		First(&item, "asi.session_id = ? AND asi.asset_id = ?", sessionId, assetId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.AuditSessionItem{}, domain.ErrNotFound("audit session item")
+		}
+		return domain.AuditSessionItem{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainAuditSessionItem(&item), nil
+}
+
+func (r *AuditSessionRepository) CountAuditSessions(ctx context.Context, params domain.AuditSessionParams) (int64, error) {
+	var count int64
+	db := r.db.WithContext(ctx).Table("audit_sessions aus")
+
+	db = r.applyAuditSessionSearch(db, params.SearchQuery)
+	db = r.applyAuditSessionFilters(db, params.Filters)
+
+	if err := db.Count(&count).Error; err != nil {
+		return 0, domain.ErrInternal(err)
+	}
+	return count, nil
+}
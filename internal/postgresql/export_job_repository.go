@@ -0,0 +1,231 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+)
+
+type ExportJobRepository struct {
+	db *gorm.DB
+}
+
+func NewExportJobRepository(db *gorm.DB) *ExportJobRepository {
+	return &ExportJobRepository{
+		db: db,
+	}
+}
+
+func (r *ExportJobRepository) applyExportJobFilters(db *gorm.DB, filters *domain.ExportJobFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.Status != nil && *filters.Status != "" {
+		db = db.Where("status = ?", *filters.Status)
+	}
+
+	if filters.EntityType != nil && *filters.EntityType != "" {
+		db = db.Where("entity_type = ?", *filters.EntityType)
+	}
+
+	return db
+}
+
+// *===========================MUTATION===========================*
+func (r *ExportJobRepository) CreateExportJob(ctx context.Context, payload *domain.ExportJob) (domain.ExportJob, error) {
+	modelJob := mapper.ToModelExportJobForCreate(payload)
+	if err := r.db.WithContext(ctx).Create(&modelJob).Error; err != nil {
+		return domain.ExportJob{}, domain.ErrInternal(err)
+	}
+
+	return r.GetExportJobById(ctx, modelJob.ID.String())
+}
+
+// LockUserExportJobs serializes job creation of one user sampai transaksi selesai,
+// supaya cek limit job aktif tidak bisa dilewati dengan request paralel. Panggil di dalam transaksi
+func (r *ExportJobRepository) LockUserExportJobs(ctx context.Context, userId string) error {
+	if err := r.db.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "export_jobs:"+userId).Error; err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// StartExportJob marks an attempt as running, progress dan error dari attempt sebelumnya direset
+func (r *ExportJobRepository) StartExportJob(ctx context.Context, exportJobId string) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).
+		Model(&model.ExportJob{}).
+		Where("id = ?", exportJobId).
+		Updates(map[string]any{
+			"status":         domain.ExportJobStatusRunning,
+			"attempts":       gorm.Expr("attempts + 1"),
+			"processed_rows": 0,
+			"error":          nil,
+			"started_at":     now,
+			"finished_at":    nil,
+			"updated_at":     now,
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+func (r *ExportJobRepository) UpdateExportJobProgress(ctx context.Context, exportJobId string, processedRows int, totalRows int) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.ExportJob{}).
+		Where("id = ?", exportJobId).
+		Updates(map[string]any{
+			"processed_rows": processedRows,
+			"total_rows":     totalRows,
+			"updated_at":     time.Now(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// CompleteExportJob stores the uploaded file, file dihapus cleanup setelah expiresAt
+func (r *ExportJobRepository) CompleteExportJob(ctx context.Context, exportJobId string, fileName string, fileURL string, publicID string, fileSize int64, expiresAt time.Time) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).
+		Model(&model.ExportJob{}).
+		Where("id = ?", exportJobId).
+		Updates(map[string]any{
+			"status":      domain.ExportJobStatusSucceeded,
+			"file_name":   fileName,
+			"file_url":    fileURL,
+			"public_id":   publicID,
+			"file_size":   fileSize,
+			"error":       nil,
+			"finished_at": now,
+			"expires_at":  expiresAt,
+			"updated_at":  now,
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// FailExportJob marks the job failed, expiresAt menentukan kapan job dianggap expired oleh cleanup
+func (r *ExportJobRepository) FailExportJob(ctx context.Context, exportJobId string, errMsg string, expiresAt time.Time) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).
+		Model(&model.ExportJob{}).
+		Where("id = ?", exportJobId).
+		Updates(map[string]any{
+			"status":      domain.ExportJobStatusFailed,
+			"error":       errMsg,
+			"finished_at": now,
+			"expires_at":  expiresAt,
+			"updated_at":  now,
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// MarkExportJobExpired clears the file of a job, dipanggil setelah file dihapus dari storage
+func (r *ExportJobRepository) MarkExportJobExpired(ctx context.Context, exportJobId string) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.ExportJob{}).
+		Where("id = ?", exportJobId).
+		Updates(map[string]any{
+			"status":     domain.ExportJobStatusExpired,
+			"file_url":   nil,
+			"public_id":  nil,
+			"updated_at": time.Now(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+func (r *ExportJobRepository) DeleteExportJob(ctx context.Context, exportJobId string) error {
+	result := r.db.WithContext(ctx).Delete(&model.ExportJob{}, "id = ?", exportJobId)
+	if result.Error != nil {
+		return domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound("export job")
+	}
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (r *ExportJobRepository) GetExportJobsCursor(ctx context.Context, userId string, params domain.ExportJobParams) ([]domain.ExportJob, error) {
+	var jobs []model.ExportJob
+	db := r.db.WithContext(ctx).Where("user_id = ?", userId)
+
+	db = r.applyExportJobFilters(db, params.Filters)
+	db = db.Order("id DESC")
+
+	// Apply cursor-based pagination
+	if params.Pagination != nil {
+		if params.Pagination.Cursor != "" {
+			db = db.Where("id < ?", params.Pagination.Cursor)
+		}
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+	}
+
+	if err := db.Find(&jobs).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainExportJobs(jobs), nil
+}
+
+func (r *ExportJobRepository) GetExportJobById(ctx context.Context, exportJobId string) (domain.ExportJob, error) {
+	var job model.ExportJob
+
+	err := r.db.WithContext(ctx).
+		First(&job, "id = ?", exportJobId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ExportJob{}, domain.ErrNotFound("export job")
+		}
+		return domain.ExportJob{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainExportJob(&job), nil
+}
+
+func (r *ExportJobRepository) CountActiveExportJobs(ctx context.Context, userId string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.ExportJob{}).
+		Where("user_id = ? AND status IN ?", userId, []domain.ExportJobStatus{domain.ExportJobStatusPending, domain.ExportJobStatusRunning}).
+		Count(&count).Error
+	if err != nil {
+		return 0, domain.ErrInternal(err)
+	}
+	return count, nil
+}
+
+// GetExpiredExportJobs returns finished jobs past their retention, oldest first
+func (r *ExportJobRepository) GetExpiredExportJobs(ctx context.Context, now time.Time, limit int) ([]domain.ExportJob, error) {
+	var jobs []model.ExportJob
+
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND expires_at <= ?", []domain.ExportJobStatus{domain.ExportJobStatusSucceeded, domain.ExportJobStatusFailed}, now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainExportJobs(jobs), nil
+}
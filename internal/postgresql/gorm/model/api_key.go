@@ -0,0 +1,41 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type APIKey struct {
+	ID          SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	Name        string  `gorm:"type:varchar(100);unique;not null"`
+	Description *string `gorm:"type:varchar(255)"`
+	KeyPrefix   string  `gorm:"type:varchar(16);not null"`
+	KeyHash     string  `gorm:"type:varchar(64);unique;not null"`
+	Scopes      string  `gorm:"type:jsonb;not null"`
+	ExpiresAt   *time.Time
+	LastUsedAt  *time.Time
+	LastUsedIP  *string `gorm:"type:varchar(45)"`
+	UsageCount  int64   `gorm:"not null;default:0"`
+	RevokedAt   *time.Time
+	CreatedBy   *SQLULID `gorm:"type:varchar(26)"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+func (u *APIKey) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 APIKey.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for APIKey: %s", u.ID.String())
+	}
+
+	return nil
+}
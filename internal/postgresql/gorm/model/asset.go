@@ -26,6 +26,7 @@ type Asset struct {
 	Condition          domain.AssetCondition `gorm:"type:asset_condition;default:'Good';column:condition_status"`
 	LocationID         *SQLULID              `gorm:"type:varchar(26)"`
 	AssignedTo         *SQLULID              `gorm:"type:varchar(26)"`
+	CustomAttributes   *string               `gorm:"type:jsonb"`
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 	Category           Category      `gorm:"foreignKey:CategoryID"`
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type AssetDocument struct {
+	ID                  SQLULID                  `gorm:"primaryKey;type:varchar(26)"`
+	AssetID             SQLULID                  `gorm:"type:varchar(26);not null"`
+	MaintenanceRecordID *SQLULID                 `gorm:"type:varchar(26)"`
+	Type                domain.AssetDocumentType `gorm:"type:asset_document_type;not null"`
+	Title               string                   `gorm:"type:varchar(200);not null"`
+	DocumentNumber      *string                  `gorm:"type:varchar(100)"`
+	IssuedAt            *time.Time               `gorm:"type:date"`
+	ExpiresAt           *time.Time               `gorm:"type:date"`
+	Notes               *string                  `gorm:"type:text"`
+	FileURL             string                   `gorm:"type:text;not null"`
+	PublicID            string                   `gorm:"type:varchar(255);not null"`
+	FileName            string                   `gorm:"type:varchar(255);not null"`
+	MimeType            *string                  `gorm:"type:varchar(100)"`
+	FileSize            int64                    `gorm:"type:bigint;not null;default:0"`
+	UploadedBy          *SQLULID                 `gorm:"type:varchar(26)"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func (AssetDocument) TableName() string {
+	return "asset_documents"
+}
+
+func (u *AssetDocument) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 AssetDocument.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for AssetDocument: %s", u.ID.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type AssetLoan struct {
+	ID                 SQLULID                `gorm:"primaryKey;type:varchar(26)"`
+	AssetID            SQLULID                `gorm:"type:varchar(26);not null"`
+	BorrowerID         SQLULID                `gorm:"type:varchar(26);not null"`
+	CheckedOutBy       SQLULID                `gorm:"type:varchar(26);not null"`
+	CheckedInBy        *SQLULID               `gorm:"type:varchar(26)"`
+	CheckoutDate       time.Time              `gorm:"not null"`
+	ExpectedReturnDate time.Time              `gorm:"not null"`
+	ReturnedDate       *time.Time             `gorm:"type:timestamp with time zone"`
+	CheckoutCondition  domain.AssetCondition  `gorm:"type:asset_condition;not null"`
+	ReturnCondition    *domain.AssetCondition `gorm:"type:asset_condition"`
+	Status             domain.AssetLoanStatus `gorm:"type:asset_loan_status;default:'Active'"`
+	CheckoutMovementID *SQLULID               `gorm:"type:varchar(26)"`
+	ReturnMovementID   *SQLULID               `gorm:"type:varchar(26)"`
+	Notes              *string                `gorm:"type:text"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	Asset              Asset `gorm:"foreignKey:AssetID"`
+	Borrower           User  `gorm:"foreignKey:BorrowerID"`
+	CheckedOutByUser   User  `gorm:"foreignKey:CheckedOutBy"`
+	CheckedInByUser    *User `gorm:"foreignKey:CheckedInBy"`
+}
+
+func (AssetLoan) TableName() string {
+	return "asset_loans"
+}
+
+func (u *AssetLoan) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 AssetLoan.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for AssetLoan: %s", u.ID.String())
+	}
+
+	return nil
+}
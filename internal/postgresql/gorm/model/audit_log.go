@@ -0,0 +1,36 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type AuditLog struct {
+	ID         SQLULID                `gorm:"primaryKey;type:varchar(26)"`
+	EntityType domain.AuditEntityType `gorm:"type:varchar(50);not null"`
+	EntityID   string                 `gorm:"type:varchar(26);not null"`
+	Action     domain.AuditAction     `gorm:"type:varchar(20);not null"`
+	ActorID    *SQLULID               `gorm:"type:varchar(26)"`
+	Changes    *string                `gorm:"type:jsonb"`
+	CreatedAt  time.Time
+	Actor      *User `gorm:"foreignKey:ActorID"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+func (u *AuditLog) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 AuditLog.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for AuditLog: %s", u.ID.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type AuditSession struct {
+	ID            SQLULID                   `gorm:"primaryKey;type:varchar(26)"`
+	Title         string                    `gorm:"type:varchar(255);not null"`
+	LocationID    *SQLULID                  `gorm:"type:varchar(26)"`
+	CategoryID    *SQLULID                  `gorm:"type:varchar(26)"`
+	Status        domain.AuditSessionStatus `gorm:"type:audit_session_status;default:'Open'"`
+	StartedBy     SQLULID                   `gorm:"type:varchar(26);not null"`
+	StartedAt     time.Time                 `gorm:"not null"`
+	ClosedBy      *SQLULID                  `gorm:"type:varchar(26)"`
+	ClosedAt      *time.Time                `gorm:"type:timestamp with time zone"`
+	Notes         *string                   `gorm:"type:text"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Location      *Location `gorm:"foreignKey:LocationID"`
+	Category      *Category `gorm:"foreignKey:CategoryID"`
+	StartedByUser User      `gorm:"foreignKey:StartedBy"`
+	ClosedByUser  *User     `gorm:"foreignKey:ClosedBy"`
+}
+
+func (AuditSession) TableName() string {
+	return "audit_sessions"
+}
+
+func (u *AuditSession) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 AuditSession.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for AuditSession: %s", u.ID.String())
+	}
+
+	return nil
+}
+
+type AuditSessionItem struct {
+	ID                   SQLULID                `gorm:"primaryKey;type:varchar(26)"`
+	SessionID            SQLULID                `gorm:"type:varchar(26);not null"`
+	AssetID              SQLULID                `gorm:"type:varchar(26);not null"`
+	IsExpected           bool                   `gorm:"not null;default:false"`
+	ExpectedLocationID   *SQLULID               `gorm:"type:varchar(26)"`
+	Result               domain.AuditItemResult `gorm:"type:audit_item_result;default:'Pending'"`
+	ScanLogID            *SQLULID               `gorm:"type:varchar(26)"`
+	ScannedBy            *SQLULID               `gorm:"type:varchar(26)"`
+	ScannedAt            *time.Time             `gorm:"type:timestamp with time zone"`
+	CorrectionMovementID *SQLULID               `gorm:"type:varchar(26)"`
+	MarkedLost           bool                   `gorm:"not null;default:false"`
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	Asset                Asset     `gorm:"foreignKey:AssetID"`
+	ExpectedLocation     *Location `gorm:"foreignKey:ExpectedLocationID"`
+}
+
+func (AuditSessionItem) TableName() string {
+	return "audit_session_items"
+}
+
+func (u *AuditSessionItem) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 AuditSessionItem.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for AuditSessionItem: %s", u.ID.String())
+	}
+
+	return nil
+}
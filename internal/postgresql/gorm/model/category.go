@@ -4,20 +4,25 @@ import (
 	"log"
 	"time"
 
+	"github.com/Rizz404/inventory-api/domain"
 	"github.com/oklog/ulid/v2"
 	"gorm.io/gorm"
 )
 
 type Category struct {
-	ID           SQLULID  `gorm:"primaryKey;type:varchar(26)"`
-	ParentID     *SQLULID `gorm:"type:varchar(26)"`
-	CategoryCode string   `gorm:"type:varchar(20);unique;not null"`
-	ImageURL     *string  `gorm:"type:text"`
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	Parent       *Category             `gorm:"foreignKey:ParentID"`
-	Children     []Category            `gorm:"foreignKey:ParentID"`
-	Translations []CategoryTranslation `gorm:"foreignKey:CategoryID"`
+	ID                  SQLULID                    `gorm:"primaryKey;type:varchar(26)"`
+	ParentID            *SQLULID                   `gorm:"type:varchar(26)"`
+	CategoryCode        string                     `gorm:"type:varchar(20);unique;not null"`
+	ImageURL            *string                    `gorm:"type:text"`
+	DepreciationMethod  *domain.DepreciationMethod `gorm:"type:depreciation_method"`
+	UsefulLifeYears     *int                       `gorm:"type:integer"`
+	SalvageValuePercent *float64                   `gorm:"type:decimal(5,2)"`
+	Attributes          *string                    `gorm:"type:jsonb"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	Parent              *Category             `gorm:"foreignKey:ParentID"`
+	Children            []Category            `gorm:"foreignKey:ParentID"`
+	Translations        []CategoryTranslation `gorm:"foreignKey:CategoryID"`
 }
 
 func (Category) TableName() string {
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type ExportJob struct {
+	ID            SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	UserID        SQLULID `gorm:"type:varchar(26);not null"`
+	EntityType    string  `gorm:"type:varchar(30);not null"`
+	Format        string  `gorm:"type:varchar(10);not null"`
+	SearchQuery   *string `gorm:"type:varchar(255)"`
+	Filters       *string `gorm:"type:jsonb"`
+	Sort          *string `gorm:"type:jsonb"`
+	LangCode      string  `gorm:"type:varchar(10);not null"`
+	Status        string  `gorm:"type:varchar(20);not null"`
+	TotalRows     *int
+	ProcessedRows int     `gorm:"not null;default:0"`
+	Attempts      int     `gorm:"not null;default:0"`
+	FileName      *string `gorm:"type:varchar(255)"`
+	FileURL       *string `gorm:"type:text"`
+	PublicID      *string `gorm:"type:varchar(255)"`
+	FileSize      *int64
+	Error         *string `gorm:"type:text"`
+	StartedAt     *time.Time
+	FinishedAt    *time.Time
+	ExpiresAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (ExportJob) TableName() string {
+	return "export_jobs"
+}
+
+func (u *ExportJob) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 ExportJob.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for ExportJob: %s", u.ID.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type Job struct {
+	ID          SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	Type        string  `gorm:"type:varchar(100);not null"`
+	Payload     string  `gorm:"type:jsonb;not null"`
+	Status      string  `gorm:"type:varchar(20);not null"`
+	Attempts    int     `gorm:"not null;default:0"`
+	MaxAttempts int     `gorm:"not null"`
+	RunAt       time.Time
+	LockedBy    *string `gorm:"type:varchar(100)"`
+	LockedUntil *time.Time
+	LastError   *string `gorm:"type:text"`
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (Job) TableName() string {
+	return "jobs"
+}
+
+func (u *Job) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 Job.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for Job: %s", u.ID.String())
+	}
+
+	return nil
+}
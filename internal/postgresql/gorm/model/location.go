@@ -52,3 +52,13 @@ func (u *LocationTranslation) BeforeCreate(tx *gorm.DB) error {
 
 	return nil
 }
+
+type UserLocation struct {
+	UserID     SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	LocationID SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	CreatedAt  time.Time
+}
+
+func (UserLocation) TableName() string {
+	return "user_locations"
+}
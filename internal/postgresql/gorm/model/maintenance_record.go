@@ -26,6 +26,7 @@ type MaintenanceRecord struct {
 	Asset             Asset                          `gorm:"foreignKey:AssetID"`
 	User              *User                          `gorm:"foreignKey:PerformedByUser"`
 	Translations      []MaintenanceRecordTranslation `gorm:"foreignKey:RecordID"`
+	StockUsages       []StockTransaction             `gorm:"foreignKey:MaintenanceRecordID"`
 }
 
 func (MaintenanceRecord) TableName() string {
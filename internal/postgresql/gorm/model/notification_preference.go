@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+)
+
+type NotificationPreference struct {
+	UserID    SQLULID                 `gorm:"primaryKey;type:varchar(26)"`
+	Type      domain.NotificationType `gorm:"primaryKey;type:notification_type"`
+	InApp     bool                    `gorm:"not null;default:true"`
+	Push      bool                    `gorm:"not null;default:true"`
+	Email     bool                    `gorm:"not null;default:true"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
+
+type UserNotificationSettings struct {
+	UserID           SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	EmailDigest      bool    `gorm:"not null;default:false"`
+	LastDigestSentAt *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (UserNotificationSettings) TableName() string {
+	return "user_notification_settings"
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type OIDCAuthRequest struct {
+	ID           SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	StateHash    string  `gorm:"type:varchar(64);unique;not null"`
+	CodeVerifier string  `gorm:"type:varchar(128);not null"`
+	Nonce        string  `gorm:"type:varchar(64);not null"`
+	DeviceName   *string `gorm:"type:varchar(100)"`
+	RequestedIP  *string `gorm:"type:varchar(45)"`
+	ExpiresAt    time.Time
+	ConsumedAt   *time.Time
+	CreatedAt    time.Time
+}
+
+func (OIDCAuthRequest) TableName() string {
+	return "oidc_auth_requests"
+}
+
+func (u *OIDCAuthRequest) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 OIDCAuthRequest.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for OIDCAuthRequest: %s", u.ID.String())
+	}
+
+	return nil
+}
+
+type UserIdentity struct {
+	ID                    SQLULID          `gorm:"primaryKey;type:varchar(26)"`
+	UserID                SQLULID          `gorm:"type:varchar(26);not null"`
+	Issuer                string           `gorm:"type:varchar(255);not null"`
+	Subject               string           `gorm:"type:varchar(255);not null"`
+	Email                 *string          `gorm:"type:varchar(255)"`
+	Provisioned           bool             `gorm:"not null;default:false"`
+	SyncedRole            *domain.UserRole `gorm:"type:user_role"`
+	SyncDeactivatedAt     *time.Time
+	SyncDeactivatedReason *string `gorm:"type:varchar(50)"`
+	LastLoginAt           *time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
+
+func (u *UserIdentity) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 UserIdentity.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for UserIdentity: %s", u.ID.String())
+	}
+
+	return nil
+}
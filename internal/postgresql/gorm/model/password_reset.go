@@ -0,0 +1,36 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type PasswordResetCode struct {
+	ID          SQLULID  `gorm:"primaryKey;type:varchar(26)"`
+	UserID      *SQLULID `gorm:"type:varchar(26)"`
+	Email       string   `gorm:"type:varchar(255);not null"`
+	CodeHash    string   `gorm:"type:varchar(255);not null"`
+	Attempts    int      `gorm:"not null;default:0"`
+	RequestedIP *string  `gorm:"type:varchar(45)"`
+	ExpiresAt   time.Time
+	UsedAt      *time.Time
+	CreatedAt   time.Time
+}
+
+func (PasswordResetCode) TableName() string {
+	return "password_reset_codes"
+}
+
+func (u *PasswordResetCode) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 PasswordResetCode.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for PasswordResetCode: %s", u.ID.String())
+	}
+
+	return nil
+}
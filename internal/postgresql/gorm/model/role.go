@@ -0,0 +1,54 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type Role struct {
+	ID          SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	Name        string  `gorm:"type:varchar(50);unique;not null"`
+	Description *string `gorm:"type:varchar(255)"`
+	IsSystem    bool    `gorm:"not null;default:false"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Permissions []RolePermission `gorm:"foreignKey:RoleID"`
+}
+
+func (Role) TableName() string {
+	return "roles"
+}
+
+func (u *Role) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 Role.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for Role: %s", u.ID.String())
+	}
+
+	return nil
+}
+
+type RolePermission struct {
+	RoleID     SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	Permission string  `gorm:"primaryKey;type:varchar(100)"`
+}
+
+func (RolePermission) TableName() string {
+	return "role_permissions"
+}
+
+type UserRole struct {
+	UserID    SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	RoleID    SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	CreatedAt time.Time
+	Role      Role `gorm:"foreignKey:RoleID"`
+}
+
+func (UserRole) TableName() string {
+	return "user_roles"
+}
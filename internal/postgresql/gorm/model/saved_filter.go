@@ -0,0 +1,104 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type SavedFilter struct {
+	ID          SQLULID         `gorm:"primaryKey;type:varchar(26)"`
+	UserID      SQLULID         `gorm:"type:varchar(26);not null"`
+	EntityType  string          `gorm:"type:varchar(30);not null"`
+	Name        string          `gorm:"type:varchar(100);not null"`
+	SearchQuery *string         `gorm:"type:varchar(255)"`
+	Filters     *string         `gorm:"type:jsonb"`
+	Sort        *string         `gorm:"type:jsonb"`
+	Schedule    *ReportSchedule `gorm:"foreignKey:SavedFilterID"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (SavedFilter) TableName() string {
+	return "saved_filters"
+}
+
+func (u *SavedFilter) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 SavedFilter.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for SavedFilter: %s", u.ID.String())
+	}
+
+	return nil
+}
+
+type ReportSchedule struct {
+	ID             SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	SavedFilterID  SQLULID `gorm:"type:varchar(26);unique;not null"`
+	CronExpression string  `gorm:"type:varchar(100);not null"`
+	Timezone       string  `gorm:"type:varchar(50);not null"`
+	Format         string  `gorm:"type:varchar(10);not null"`
+	Recipients     string  `gorm:"type:jsonb;not null"`
+	LangCode       string  `gorm:"type:varchar(10);not null"`
+	IsActive       bool    `gorm:"not null"`
+	NextRunAt      *time.Time
+	LastRunAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (ReportSchedule) TableName() string {
+	return "report_schedules"
+}
+
+func (u *ReportSchedule) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 ReportSchedule.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for ReportSchedule: %s", u.ID.String())
+	}
+
+	return nil
+}
+
+type ReportRun struct {
+	ID                  SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	SavedFilterID       SQLULID `gorm:"type:varchar(26);not null"`
+	TriggerType         string  `gorm:"type:varchar(20);not null"`
+	Status              string  `gorm:"type:varchar(20);not null"`
+	Format              string  `gorm:"type:varchar(10);not null"`
+	Recipients          string  `gorm:"type:jsonb;not null"`
+	DeliveredRecipients string  `gorm:"type:jsonb;not null"`
+	LangCode            string  `gorm:"type:varchar(10);not null"`
+	Attempts            int     `gorm:"not null;default:0"`
+	FileName            *string `gorm:"type:varchar(255)"`
+	FileURL             *string `gorm:"type:text"`
+	PublicID            *string `gorm:"type:varchar(255)"`
+	FileSize            *int64
+	Error               *string `gorm:"type:text"`
+	StartedAt           *time.Time
+	FinishedAt          *time.Time
+	CreatedBy           *SQLULID `gorm:"type:varchar(26)"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func (ReportRun) TableName() string {
+	return "report_runs"
+}
+
+func (u *ReportRun) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 ReportRun.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for ReportRun: %s", u.ID.String())
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ type ScanLog struct {
 	ScanLocationLat *float64              `gorm:"type:decimal(11,8)"`
 	ScanLocationLng *float64              `gorm:"type:decimal(11,8)"`
 	ScanResult      domain.ScanResultType `gorm:"type:scan_result_type;not null"`
+	AuditSessionID  *SQLULID              `gorm:"type:varchar(26)"`
 }
 
 func (ScanLog) TableName() string {
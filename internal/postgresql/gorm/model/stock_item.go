@@ -0,0 +1,97 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type StockItem struct {
+	ID           SQLULID  `gorm:"primaryKey;type:varchar(26)"`
+	SKU          string   `gorm:"column:sku;type:varchar(50);unique;not null"`
+	ItemName     string   `gorm:"type:varchar(150);not null"`
+	Description  *string  `gorm:"type:text"`
+	Unit         string   `gorm:"type:varchar(20);not null"`
+	ReorderPoint int      `gorm:"type:int;not null;default:0"`
+	UnitCost     *float64 `gorm:"type:decimal(12,2)"`
+	IsActive     bool     `gorm:"not null;default:true"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Levels       []StockLevel `gorm:"foreignKey:ItemID"`
+}
+
+func (StockItem) TableName() string {
+	return "stock_items"
+}
+
+func (u *StockItem) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 StockItem.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for StockItem: %s", u.ID.String())
+	}
+
+	return nil
+}
+
+type StockLevel struct {
+	ID         SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	ItemID     SQLULID `gorm:"type:varchar(26);not null"`
+	LocationID SQLULID `gorm:"type:varchar(26);not null"`
+	Quantity   int     `gorm:"type:int;not null;default:0"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Location   Location `gorm:"foreignKey:LocationID"`
+}
+
+func (StockLevel) TableName() string {
+	return "stock_levels"
+}
+
+func (u *StockLevel) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 StockLevel.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for StockLevel: %s", u.ID.String())
+	}
+
+	return nil
+}
+
+type StockTransaction struct {
+	ID                  SQLULID                     `gorm:"primaryKey;type:varchar(26)"`
+	ItemID              SQLULID                     `gorm:"type:varchar(26);not null"`
+	Type                domain.StockTransactionType `gorm:"type:stock_transaction_type;not null"`
+	Quantity            int                         `gorm:"type:int;not null"`
+	FromLocationID      *SQLULID                    `gorm:"type:varchar(26)"`
+	ToLocationID        *SQLULID                    `gorm:"type:varchar(26)"`
+	UnitCost            *float64                    `gorm:"type:decimal(12,2)"`
+	MaintenanceRecordID *SQLULID                    `gorm:"type:varchar(26)"`
+	PerformedBy         *SQLULID                    `gorm:"type:varchar(26)"`
+	Notes               *string                     `gorm:"type:text"`
+	CreatedAt           time.Time
+	Item                StockItem `gorm:"foreignKey:ItemID"`
+	FromLocation        *Location `gorm:"foreignKey:FromLocationID"`
+	ToLocation          *Location `gorm:"foreignKey:ToLocationID"`
+	PerformedByUser     *User     `gorm:"foreignKey:PerformedBy"`
+}
+
+func (StockTransaction) TableName() string {
+	return "stock_transactions"
+}
+
+func (u *StockTransaction) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 StockTransaction.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for StockTransaction: %s", u.ID.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type UserTwoFactor struct {
+	UserID         SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	Secret         string  `gorm:"type:varchar(64);not null"`
+	EnabledAt      *time.Time
+	LastUsedStep   int64 `gorm:"not null;default:0"`
+	FailedAttempts int   `gorm:"not null;default:0"`
+	LockedUntil    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (UserTwoFactor) TableName() string {
+	return "user_two_factors"
+}
+
+type UserRecoveryCode struct {
+	ID        SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	UserID    SQLULID `gorm:"type:varchar(26);not null"`
+	CodeHash  string  `gorm:"type:varchar(64);not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (UserRecoveryCode) TableName() string {
+	return "user_recovery_codes"
+}
+
+func (u *UserRecoveryCode) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 UserRecoveryCode.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for UserRecoveryCode: %s", u.ID.String())
+	}
+
+	return nil
+}
@@ -23,8 +23,11 @@ type User struct {
 	PhoneNumber   *string         `gorm:"type:varchar(20)"`
 	FCMToken      *string         `gorm:"type:text"`
 	LastLogin     *time.Time
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	// * Lockout login, di-reset saat login berhasil
+	FailedLoginAttempts int `gorm:"not null;default:0"`
+	LockedUntil         *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
 }
 
 func (User) TableName() string {
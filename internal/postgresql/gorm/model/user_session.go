@@ -0,0 +1,39 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type UserSession struct {
+	ID             SQLULID   `gorm:"primaryKey;type:varchar(26)"`
+	UserID         SQLULID   `gorm:"type:varchar(26);not null"`
+	CurrentTokenID string    `gorm:"type:varchar(26);not null"`
+	DeviceName     *string   `gorm:"type:varchar(100)"`
+	UserAgent      *string   `gorm:"type:varchar(255)"`
+	IPAddress      *string   `gorm:"type:varchar(45)"`
+	ExpiresAt      time.Time `gorm:"not null"`
+	LastUsedAt     time.Time `gorm:"not null"`
+	RevokedAt      *time.Time
+	RevokedReason  *string `gorm:"type:varchar(30)"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (UserSession) TableName() string {
+	return "user_sessions"
+}
+
+func (u *UserSession) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 UserSession.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for UserSession: %s", u.ID.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type WebhookEndpoint struct {
+	ID          SQLULID  `gorm:"primaryKey;type:varchar(26)"`
+	Name        string   `gorm:"type:varchar(100);unique;not null"`
+	URL         string   `gorm:"type:varchar(500);not null"`
+	Description *string  `gorm:"type:varchar(255)"`
+	Secret      string   `gorm:"type:varchar(100);not null"`
+	EventTypes  string   `gorm:"type:jsonb;not null"`
+	IsActive    bool     `gorm:"not null"`
+	CreatedBy   *SQLULID `gorm:"type:varchar(26)"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (WebhookEndpoint) TableName() string {
+	return "webhook_endpoints"
+}
+
+func (u *WebhookEndpoint) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 WebhookEndpoint.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for WebhookEndpoint: %s", u.ID.String())
+	}
+
+	return nil
+}
+
+type WebhookDelivery struct {
+	ID             SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	EndpointID     SQLULID `gorm:"type:varchar(26);not null"`
+	EventID        string  `gorm:"type:varchar(26);not null"`
+	EventType      string  `gorm:"type:varchar(50);not null"`
+	Payload        string  `gorm:"type:jsonb;not null"`
+	Status         string  `gorm:"type:varchar(20);not null"`
+	Attempts       int     `gorm:"not null;default:0"`
+	NextAttemptAt  *time.Time
+	LockedUntil    *time.Time
+	LastAttemptAt  *time.Time
+	ResponseStatus *int
+	ResponseBody   *string `gorm:"type:text"`
+	Error          *string `gorm:"type:text"`
+	DurationMs     *int64
+	DeliveredAt    *time.Time
+	RedeliveryOf   *SQLULID `gorm:"type:varchar(26)"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (u *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 WebhookDelivery.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for WebhookDelivery: %s", u.ID.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+)
+
+type WorkOrder struct {
+	ID                  SQLULID                  `gorm:"primaryKey;type:varchar(26)"`
+	ScheduleID          *SQLULID                 `gorm:"type:varchar(26)"`
+	IssueReportID       *SQLULID                 `gorm:"type:varchar(26)"`
+	AssetID             SQLULID                  `gorm:"type:varchar(26);not null"`
+	Status              domain.WorkOrderStatus   `gorm:"type:work_order_status;default:'Open'"`
+	Priority            domain.WorkOrderPriority `gorm:"type:work_order_priority;default:'Medium'"`
+	DueDate             time.Time                `gorm:"type:timestamp with time zone;not null"`
+	AssignedTo          *SQLULID                 `gorm:"type:varchar(26)"`
+	AssignedAt          *time.Time               `gorm:"type:timestamp with time zone"`
+	StartedAt           *time.Time               `gorm:"type:timestamp with time zone"`
+	CompletedBy         *SQLULID                 `gorm:"type:varchar(26)"`
+	CompletedAt         *time.Time               `gorm:"type:timestamp with time zone"`
+	CancelledAt         *time.Time               `gorm:"type:timestamp with time zone"`
+	MaintenanceRecordID *SQLULID                 `gorm:"type:varchar(26)"`
+	CreatedBy           *SQLULID                 `gorm:"type:varchar(26)"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	Schedule            *MaintenanceSchedule   `gorm:"foreignKey:ScheduleID"`
+	IssueReport         *IssueReport           `gorm:"foreignKey:IssueReportID"`
+	Asset               Asset                  `gorm:"foreignKey:AssetID"`
+	AssignedToUser      *User                  `gorm:"foreignKey:AssignedTo"`
+	CompletedByUser     *User                  `gorm:"foreignKey:CompletedBy"`
+	CreatedByUser       *User                  `gorm:"foreignKey:CreatedBy"`
+	Translations        []WorkOrderTranslation `gorm:"foreignKey:WorkOrderID"`
+	Parts               []WorkOrderPart        `gorm:"foreignKey:WorkOrderID"`
+}
+
+func (WorkOrder) TableName() string {
+	return "work_orders"
+}
+
+func (u *WorkOrder) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 WorkOrder.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for WorkOrder: %s", u.ID.String())
+	}
+
+	return nil
+}
+
+type WorkOrderTranslation struct {
+	ID          SQLULID `gorm:"primaryKey;type:varchar(26)"`
+	WorkOrderID SQLULID `gorm:"type:varchar(26);not null;uniqueIndex:idx_wo_lang"`
+	LangCode    string  `gorm:"type:varchar(5);not null;uniqueIndex:idx_wo_lang"`
+	Title       string  `gorm:"type:varchar(200);not null"`
+	Description *string `gorm:"type:text"`
+}
+
+func (WorkOrderTranslation) TableName() string {
+	return "work_order_translations"
+}
+
+func (u *WorkOrderTranslation) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 WorkOrderTranslation.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for WorkOrderTranslation: %s", u.ID.String())
+	}
+
+	return nil
+}
+
+type WorkOrderPart struct {
+	ID          SQLULID  `gorm:"primaryKey;type:varchar(26)"`
+	WorkOrderID SQLULID  `gorm:"type:varchar(26);not null"`
+	PartName    string   `gorm:"type:varchar(150);not null"`
+	PartNumber  *string  `gorm:"type:varchar(100)"`
+	Quantity    int      `gorm:"type:int;not null;default:1"`
+	UnitCost    *float64 `gorm:"type:decimal(12,2)"`
+	CreatedAt   time.Time
+}
+
+func (WorkOrderPart) TableName() string {
+	return "work_order_parts"
+}
+
+func (u *WorkOrderPart) BeforeCreate(tx *gorm.DB) error {
+	log.Printf("🚀 WorkOrderPart.BeforeCreate called! Current ID: %s, IsZero: %t", u.ID.String(), u.ID.IsZero())
+
+	if u.ID.IsZero() {
+		u.ID = SQLULID(ulid.Make())
+		log.Printf("🚀 Generated new ULID for WorkOrderPart: %s", u.ID.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Rizz404/inventory-api/internal/web"
+	"gorm.io/gorm"
+)
+
+// * AssetLocationCondition untuk tabel yang punya kolom asset_id, datanya mengikuti lokasi asset.
+// * Sengaja tanpa alias tabel supaya bisa dipakai di query dengan alias apapun selama join-nya tidak punya kolom asset_id juga.
+const AssetLocationCondition = "asset_id IN (SELECT sa.id FROM assets sa WHERE sa.location_id IN ?)"
+
+// * LocationScope membatasi query ke lokasi user yang sedang request, diambil dari context yang di-pass lewat WithContext.
+// * condition berisi satu atau lebih placeholder "?" yang semuanya diisi daftar location id,
+// * contoh "a.location_id IN ?". Tanpa scope di context (permission location:all, cron, background job) query tidak diubah,
+// * scope kosong menghasilkan "IN (NULL)" jadi tidak ada data yang lolos.
+func LocationScope(condition string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		scopeSQL, args := LocationScopeSQL(db.Statement.Context, condition)
+		if args == nil {
+			return db
+		}
+		return db.Where(scopeSQL, args...)
+	}
+}
+
+// * LocationScopeSQL versi LocationScope untuk raw SQL, hasilnya disambung pakai AND.
+// * Tanpa scope hasilnya "TRUE" tanpa args jadi query tetap valid.
+func LocationScopeSQL(ctx context.Context, condition string) (string, []any) {
+	locationIds, scoped := web.GetLocationScopeFromRequestContext(ctx)
+	if !scoped {
+		return "TRUE", nil
+	}
+
+	args := make([]any, strings.Count(condition, "?"))
+	for i := range args {
+		args[i] = locationIds
+	}
+	return "(" + condition + ")", args
+}
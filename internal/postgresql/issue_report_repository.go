@@ -8,6 +8,7 @@ import (
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/query"
 	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
 	"github.com/oklog/ulid/v2"
 	"gorm.io/gorm"
@@ -24,7 +25,15 @@ func NewIssueReportRepository(db *gorm.DB) *IssueReportRepository {
 	}
 }
 
+// * scopedIssueReports query dasar tabel issue_reports yang sudah dibatasi lokasi user yang request
+func (r *IssueReportRepository) scopedIssueReports(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&model.IssueReport{}).Scopes(query.LocationScope(query.AssetLocationCondition))
+}
+
 func (r *IssueReportRepository) applyIssueReportFilters(db *gorm.DB, filters *domain.IssueReportFilterOptions) *gorm.DB {
+	// * Scope lokasi selalu berlaku walaupun tanpa filter
+	db = db.Scopes(query.LocationScope(query.AssetLocationCondition))
+
 	if filters == nil {
 		return db
 	}
@@ -314,7 +323,7 @@ func (r *IssueReportRepository) BulkDeleteIssueReports(ctx context.Context, repo
 
 	// First, find which reports actually exist
 	var existingReports []model.IssueReport
-	if err := r.db.WithContext(ctx).Select("id").Where("id IN ?", reportIds).Find(&existingReports).Error; err != nil {
+	if err := r.scopedIssueReports(ctx).Select("id").Where("id IN ?", reportIds).Find(&existingReports).Error; err != nil {
 		return result, domain.ErrInternal(err)
 	}
 
@@ -477,6 +486,7 @@ func (r *IssueReportRepository) GetIssueReportById(ctx context.Context, issueRep
 		Preload("Asset.User").
 		Preload("ReportedByUser").
 		Preload("ResolvedByUser").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		First(&issueReport, "id = ?", issueReportId).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -490,7 +500,7 @@ func (r *IssueReportRepository) GetIssueReportById(ctx context.Context, issueRep
 
 func (r *IssueReportRepository) CheckIssueReportExist(ctx context.Context, issueReportId string) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&model.IssueReport{}).Where("id = ?", issueReportId).Count(&count).Error
+	err := r.scopedIssueReports(ctx).Where("id = ?", issueReportId).Count(&count).Error
 	if err != nil {
 		return false, domain.ErrInternal(err)
 	}
@@ -526,7 +536,7 @@ func (r *IssueReportRepository) GetIssueReportStatistics(ctx context.Context) (d
 
 	// Get total issue report count
 	var totalCount int64
-	if err := r.db.WithContext(ctx).Model(&model.IssueReport{}).Count(&totalCount).Error; err != nil {
+	if err := r.scopedIssueReports(ctx).Count(&totalCount).Error; err != nil {
 		return domain.IssueReportStatistics{}, domain.ErrInternal(err)
 	}
 	stats.Total.Count = int(totalCount)
@@ -536,7 +546,7 @@ func (r *IssueReportRepository) GetIssueReportStatistics(ctx context.Context) (d
 		Priority domain.IssuePriority `json:"priority"`
 		Count    int64                `json:"count"`
 	}
-	if err := r.db.WithContext(ctx).Model(&model.IssueReport{}).
+	if err := r.scopedIssueReports(ctx).
 		Select("priority, COUNT(*) as count").
 		Group("priority").
 		Find(&priorityStats).Error; err != nil {
@@ -561,7 +571,7 @@ func (r *IssueReportRepository) GetIssueReportStatistics(ctx context.Context) (d
 		Status domain.IssueStatus `json:"status"`
 		Count  int64              `json:"count"`
 	}
-	if err := r.db.WithContext(ctx).Model(&model.IssueReport{}).
+	if err := r.scopedIssueReports(ctx).
 		Select("status, COUNT(*) as count").
 		Group("status").
 		Find(&statusStats).Error; err != nil {
@@ -586,7 +596,7 @@ func (r *IssueReportRepository) GetIssueReportStatistics(ctx context.Context) (d
 		IssueType string `json:"issue_type"`
 		Count     int64  `json:"count"`
 	}
-	if err := r.db.WithContext(ctx).Model(&model.IssueReport{}).
+	if err := r.scopedIssueReports(ctx).
 		Select("issue_type, COUNT(*) as count").
 		Group("issue_type").
 		Find(&typeStats).Error; err != nil {
@@ -603,7 +613,7 @@ func (r *IssueReportRepository) GetIssueReportStatistics(ctx context.Context) (d
 		Date  time.Time `json:"date"`
 		Count int64     `json:"count"`
 	}
-	if err := r.db.WithContext(ctx).Model(&model.IssueReport{}).
+	if err := r.scopedIssueReports(ctx).
 		Select("DATE(reported_date) as date, COUNT(*) as count").
 		Where("reported_date >= ?", time.Now().UTC().AddDate(0, 0, -30)).
 		Group("DATE(reported_date)").
@@ -633,7 +643,7 @@ func (r *IssueReportRepository) GetIssueReportStatistics(ctx context.Context) (d
 
 	// Get critical unresolved count
 	var criticalUnresolvedCount int64
-	if err := r.db.WithContext(ctx).Model(&model.IssueReport{}).
+	if err := r.scopedIssueReports(ctx).
 		Where("priority = ? AND status IN ('Open', 'In Progress')", domain.PriorityCritical).
 		Count(&criticalUnresolvedCount).Error; err != nil {
 		return domain.IssueReportStatistics{}, domain.ErrInternal(err)
@@ -673,7 +683,7 @@ func (r *IssueReportRepository) GetIssueReportStatistics(ctx context.Context) (d
 
 	// Calculate average resolution time
 	var avgResolutionDays float64
-	if err := r.db.WithContext(ctx).Model(&model.IssueReport{}).
+	if err := r.scopedIssueReports(ctx).
 		Select("AVG(EXTRACT(DAY FROM (resolved_date - reported_date))) as avg_days").
 		Where("resolved_date IS NOT NULL").
 		Row().Scan(&avgResolutionDays); err == nil {
@@ -682,7 +692,7 @@ func (r *IssueReportRepository) GetIssueReportStatistics(ctx context.Context) (d
 
 	// Get earliest and latest creation dates
 	var earliestDate, latestDate *time.Time
-	if err := r.db.WithContext(ctx).Model(&model.IssueReport{}).
+	if err := r.scopedIssueReports(ctx).
 		Select("MIN(reported_date) as earliest, MAX(reported_date) as latest").
 		Row().Scan(&earliestDate, &latestDate); err != nil {
 		return domain.IssueReportStatistics{}, domain.ErrInternal(err)
@@ -738,7 +748,18 @@ func (r *IssueReportRepository) GetIssueReportsForExport(ctx context.Context, pa
 	// Apply sorting
 	db = r.applyIssueReportSorts(db, params.Sort)
 
-	// No pagination for export - get all matching issue reports
+	// * Tanpa pagination ambil semua data, export job mengisi limit/offset untuk ambil per batch
+	// * dan ID sebagai tie-breaker supaya urutan antar batch stabil
+	if params.Pagination != nil {
+		db = db.Order("issue_reports.id")
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
 	if err := db.Find(&issueReports).Error; err != nil {
 		return nil, domain.ErrInternal(err)
 	}
@@ -0,0 +1,255 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JobRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRepository(db *gorm.DB) *JobRepository {
+	return &JobRepository{
+		db: db,
+	}
+}
+
+func (r *JobRepository) applyJobFilters(db *gorm.DB, filters *domain.JobFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.Status != nil && *filters.Status != "" {
+		db = db.Where("status = ?", *filters.Status)
+	}
+
+	if filters.Type != nil && *filters.Type != "" {
+		db = db.Where("type = ?", *filters.Type)
+	}
+
+	return db
+}
+
+// *===========================MUTATION===========================*
+
+// CreateJob enqueues a job, context dari Transactor.WithinTransaction membuat job ikut commit/rollback bersama mutasinya
+func (r *JobRepository) CreateJob(ctx context.Context, payload *domain.Job) error {
+	modelJob := mapper.ToModelJobForCreate(payload)
+	if err := r.db.WithContext(ctx).Create(&modelJob).Error; err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// ClaimDueJob leases the oldest due job of the given types, job running yang lease-nya habis (worker mati) ikut diambil lagi
+func (r *JobRepository) ClaimDueJob(ctx context.Context, jobTypes []domain.JobType, workerId string, lease time.Duration) (domain.Job, bool, error) {
+	now := time.Now()
+
+	dueId := r.db.
+		Model(&model.Job{}).
+		Select("id").
+		Where("type IN ? AND run_at <= ?", jobTypes, now).
+		Where("status = ? OR (status = ? AND locked_until < ?)", domain.JobStatusPending, domain.JobStatusRunning, now).
+		Order("run_at ASC").
+		Limit(1).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+
+	var claimed []model.Job
+	err := r.db.WithContext(ctx).
+		Model(&claimed).
+		Clauses(clause.Returning{}).
+		Where("id IN (?)", dueId).
+		Updates(map[string]any{
+			"status":       domain.JobStatusRunning,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"locked_by":    workerId,
+			"locked_until": now.Add(lease),
+			"updated_at":   now,
+		}).Error
+	if err != nil {
+		return domain.Job{}, false, domain.ErrInternal(err)
+	}
+	if len(claimed) == 0 {
+		return domain.Job{}, false, nil
+	}
+
+	return mapper.ToDomainJob(&claimed[0]), true, nil
+}
+
+// CompleteJob marks a claimed job as succeeded, diabaikan kalau job sudah diambil worker lain
+func (r *JobRepository) CompleteJob(ctx context.Context, jobId string, workerId string) error {
+	now := time.Now()
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Job{}).
+		Where("id = ? AND locked_by = ?", jobId, workerId).
+		Updates(map[string]any{
+			"status":       domain.JobStatusSucceeded,
+			"locked_by":    nil,
+			"locked_until": nil,
+			"completed_at": now,
+			"updated_at":   now,
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// FailJob stores the error of a claimed job and either schedules the retry at runAt or moves it to the dead letter
+func (r *JobRepository) FailJob(ctx context.Context, jobId string, workerId string, lastError string, status domain.JobStatus, runAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.Job{}).
+		Where("id = ? AND locked_by = ?", jobId, workerId).
+		Updates(map[string]any{
+			"status":       status,
+			"run_at":       runAt,
+			"locked_by":    nil,
+			"locked_until": nil,
+			"last_error":   lastError,
+			"updated_at":   time.Now(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// RetryJob puts a job back in the queue with fresh attempts
+func (r *JobRepository) RetryJob(ctx context.Context, jobId string) (domain.Job, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.Job{}).
+		Where("id = ? AND status IN ?", jobId, []domain.JobStatus{domain.JobStatusPending, domain.JobStatusDead}).
+		Updates(map[string]any{
+			"status":     domain.JobStatusPending,
+			"attempts":   0,
+			"run_at":     time.Now(),
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return domain.Job{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.Job{}, domain.ErrNotFound("job")
+	}
+
+	return r.GetJobById(ctx, jobId)
+}
+
+// RetryDeadJobs requeues every dead job, optionally only of one type
+func (r *JobRepository) RetryDeadJobs(ctx context.Context, jobType *domain.JobType) (int64, error) {
+	db := r.db.WithContext(ctx).
+		Model(&model.Job{}).
+		Where("status = ?", domain.JobStatusDead)
+	if jobType != nil && *jobType != "" {
+		db = db.Where("type = ?", *jobType)
+	}
+
+	result := db.Updates(map[string]any{
+		"status":     domain.JobStatusPending,
+		"attempts":   0,
+		"run_at":     time.Now(),
+		"updated_at": time.Now(),
+	})
+	if result.Error != nil {
+		return 0, domain.ErrInternal(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// PurgeJobs deletes finished jobs with the given status, pending dan running tidak pernah dihapus di sini
+func (r *JobRepository) PurgeJobs(ctx context.Context, status domain.JobStatus, jobType *domain.JobType, olderThan *time.Time) (int64, error) {
+	db := r.db.WithContext(ctx).Where("status = ?", status)
+	if jobType != nil && *jobType != "" {
+		db = db.Where("type = ?", *jobType)
+	}
+	if olderThan != nil {
+		db = db.Where("updated_at < ?", *olderThan)
+	}
+
+	result := db.Delete(&model.Job{})
+	if result.Error != nil {
+		return 0, domain.ErrInternal(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// *===========================QUERY===========================*
+func (r *JobRepository) GetJobsCursor(ctx context.Context, params domain.JobParams) ([]domain.Job, error) {
+	var jobs []model.Job
+	db := r.db.WithContext(ctx)
+
+	db = r.applyJobFilters(db, params.Filters)
+	db = db.Order("id DESC")
+
+	// Apply cursor-based pagination
+	if params.Pagination != nil {
+		if params.Pagination.Cursor != "" {
+			db = db.Where("id < ?", params.Pagination.Cursor)
+		}
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+	}
+
+	if err := db.Find(&jobs).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainJobs(jobs), nil
+}
+
+func (r *JobRepository) GetJobById(ctx context.Context, jobId string) (domain.Job, error) {
+	var job model.Job
+
+	err := r.db.WithContext(ctx).
+		First(&job, "id = ?", jobId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Job{}, domain.ErrNotFound("job")
+		}
+		return domain.Job{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainJob(&job), nil
+}
+
+func (r *JobRepository) GetJobStatistics(ctx context.Context) (domain.JobStatistics, error) {
+	var stats domain.JobStatistics
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Job{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Order("status").
+		Scan(&stats.ByStatus).Error
+	if err != nil {
+		return domain.JobStatistics{}, domain.ErrInternal(err)
+	}
+
+	// * Per tipe hanya yang belum selesai, succeeded bisa sangat banyak dan tidak menarik
+	err = r.db.WithContext(ctx).
+		Model(&model.Job{}).
+		Select(`type,
+			COUNT(*) FILTER (WHERE status = ?) AS pending,
+			COUNT(*) FILTER (WHERE status = ?) AS running,
+			COUNT(*) FILTER (WHERE status = ?) AS dead`,
+			domain.JobStatusPending, domain.JobStatusRunning, domain.JobStatusDead).
+		Where("status <> ?", domain.JobStatusSucceeded).
+		Group("type").
+		Order("type").
+		Scan(&stats.ByType).Error
+	if err != nil {
+		return domain.JobStatistics{}, domain.ErrInternal(err)
+	}
+
+	return stats, nil
+}
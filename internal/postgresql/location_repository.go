@@ -323,6 +323,36 @@ func (r *LocationRepository) BulkDeleteLocations(ctx context.Context, locationId
 	return result, nil
 }
 
+func (r *LocationRepository) SetUserLocations(ctx context.Context, userId string, locationIds []string) error {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.ErrInternal(tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Where("user_id = ?", userId).Delete(&model.UserLocation{}).Error; err != nil {
+		tx.Rollback()
+		return domain.ErrInternal(err)
+	}
+
+	userLocations := mapper.ToModelUserLocations(userId, locationIds)
+	if len(userLocations) > 0 {
+		if err := tx.Create(&userLocations).Error; err != nil {
+			tx.Rollback()
+			return domain.ErrInternal(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
 // *===========================QUERY===========================*
 func (r *LocationRepository) GetLocationsPaginated(ctx context.Context, params domain.LocationParams, langCode string) ([]domain.Location, error) {
 	var locations []model.Location
@@ -498,6 +528,54 @@ func (r *LocationRepository) CountLocations(ctx context.Context, params domain.L
 	return count, nil
 }
 
+func (r *LocationRepository) GetLocationsByIds(ctx context.Context, locationIds []string) ([]domain.Location, error) {
+	var locations []model.Location
+
+	err := r.db.WithContext(ctx).
+		Preload("Translations").
+		Where("id IN ?", locationIds).
+		Order("location_code ASC").
+		Find(&locations).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainLocations(locations), nil
+}
+
+func (r *LocationRepository) GetUserLocations(ctx context.Context, userId string) ([]domain.Location, error) {
+	var locations []model.Location
+
+	err := r.db.WithContext(ctx).
+		Table("locations l").
+		Preload("Translations").
+		Joins("JOIN user_locations ul ON ul.location_id = l.id").
+		Where("ul.user_id = ?", userId).
+		Order("l.location_code ASC").
+		Find(&locations).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainLocations(locations), nil
+}
+
+// GetUserLocationIds returns the locations a user is restricted to, empty means no location access
+func (r *LocationRepository) GetUserLocationIds(ctx context.Context, userId string) ([]string, error) {
+	locationIds := []string{}
+
+	err := r.db.WithContext(ctx).
+		Model(&model.UserLocation{}).
+		Where("user_id = ?", userId).
+		Order("location_id ASC").
+		Pluck("location_id", &locationIds).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return locationIds, nil
+}
+
 func (r *LocationRepository) GetLocationStatistics(ctx context.Context) (domain.LocationStatistics, error) {
 	var stats domain.LocationStatistics
 
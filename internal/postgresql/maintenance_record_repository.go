@@ -8,6 +8,7 @@ import (
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/query"
 	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
 	"github.com/oklog/ulid/v2"
 	"gorm.io/gorm"
@@ -24,7 +25,15 @@ func NewMaintenanceRecordRepository(db *gorm.DB) *MaintenanceRecordRepository {
 
 // ===== Filters and Sorts =====
 
+// * scopedRecords query dasar tabel maintenance_records yang sudah dibatasi lokasi user yang request
+func (r *MaintenanceRecordRepository) scopedRecords(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).Scopes(query.LocationScope(query.AssetLocationCondition))
+}
+
 func (r *MaintenanceRecordRepository) applyRecordFilters(db *gorm.DB, filters *domain.MaintenanceRecordFilterOptions) *gorm.DB {
+	// * Scope lokasi selalu berlaku walaupun tanpa filter
+	db = db.Scopes(query.LocationScope(query.AssetLocationCondition))
+
 	if filters == nil {
 		return db
 	}
@@ -90,6 +99,21 @@ func (r *MaintenanceRecordRepository) CreateRecord(ctx context.Context, payload
 			return domain.MaintenanceRecord{}, domain.ErrInternal(err)
 		}
 	}
+
+	// * Consume parts from stock in the same transaction so the record never exists without its stock movement
+	recordId := m.ID.String()
+	stockUsages := make([]model.StockTransaction, 0, len(payload.StockUsages))
+	for _, usage := range payload.StockUsages {
+		usage.Type = domain.StockTransactionIssue
+		usage.MaintenanceRecordID = &recordId
+		mu, err := applyStockTransaction(tx, &usage)
+		if err != nil {
+			tx.Rollback()
+			return domain.MaintenanceRecord{}, err
+		}
+		stockUsages = append(stockUsages, mu)
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return domain.MaintenanceRecord{}, domain.ErrInternal(err)
 	}
@@ -105,6 +129,9 @@ func (r *MaintenanceRecordRepository) CreateRecord(ctx context.Context, payload
 			Notes:    translation.Notes,
 		})
 	}
+	if len(stockUsages) > 0 {
+		domainRecord.StockUsages = mapper.ToDomainStockTransactions(stockUsages)
+	}
 	return domainRecord, nil
 }
 
@@ -263,7 +290,7 @@ func (r *MaintenanceRecordRepository) BulkDeleteRecords(ctx context.Context, rec
 
 	// First, find which records actually exist
 	var existingRecords []model.MaintenanceRecord
-	if err := r.db.WithContext(ctx).Select("id").Where("id IN ?", recordIds).Find(&existingRecords).Error; err != nil {
+	if err := r.scopedRecords(ctx).Select("id").Where("id IN ?", recordIds).Find(&existingRecords).Error; err != nil {
 		return result, domain.ErrInternal(err)
 	}
 
@@ -404,6 +431,7 @@ func (r *MaintenanceRecordRepository) GetRecordsCursor(ctx context.Context, para
 func (r *MaintenanceRecordRepository) GetRecordById(ctx context.Context, recordId string) (domain.MaintenanceRecord, error) {
 	var m model.MaintenanceRecord
 	err := r.db.WithContext(ctx).
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Preload("Translations").
 		Preload("Schedule").
 		Preload("Schedule.Translations").
@@ -414,6 +442,10 @@ func (r *MaintenanceRecordRepository) GetRecordById(ctx context.Context, recordI
 		Preload("Asset.Location.Translations").
 		Preload("Asset.User").
 		Preload("User").
+		Preload("StockUsages").
+		Preload("StockUsages.Item").
+		Preload("StockUsages.FromLocation").
+		Preload("StockUsages.FromLocation.Translations").
 		First(&m, "id = ?", recordId).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -428,7 +460,7 @@ func (r *MaintenanceRecordRepository) GetRecordById(ctx context.Context, recordI
 
 func (r *MaintenanceRecordRepository) CheckRecordExist(ctx context.Context, recordId string) (bool, error) {
 	var count int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).Where("id = ?", recordId).Count(&count).Error; err != nil {
+	if err := r.scopedRecords(ctx).Where("id = ?", recordId).Count(&count).Error; err != nil {
 		return false, domain.ErrInternal(err)
 	}
 	return count > 0, nil
@@ -458,7 +490,7 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 
 	// Total records
 	var total int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).Count(&total).Error; err != nil {
+	if err := r.scopedRecords(ctx).Count(&total).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	stats.Total.Count = int(total)
@@ -474,6 +506,7 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 	}
 	if err := r.db.WithContext(ctx).
 		Table("maintenance_records mr").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Select("u.id as user_id, u.full_name as user_name, u.email as user_email, " +
 			"COUNT(*) as count, COALESCE(SUM(mr.actual_cost), 0) as total_cost, " +
 			"COALESCE(AVG(mr.actual_cost), 0) as average_cost").
@@ -503,6 +536,7 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 	}
 	if err := r.db.WithContext(ctx).
 		Table("maintenance_records").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Select("performed_by_vendor as vendor_name, COUNT(*) as count, " +
 			"COALESCE(SUM(actual_cost), 0) as total_cost, " +
 			"COALESCE(AVG(actual_cost), 0) as average_cost").
@@ -532,6 +566,7 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 	}
 	if err := r.db.WithContext(ctx).
 		Table("maintenance_records mr").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Select("a.id as asset_id, a.asset_name, a.asset_tag, COUNT(*) as record_count, " +
 			"MAX(mr.maintenance_date)::text as last_maintenance, " +
 			"COALESCE(SUM(mr.actual_cost), 0) as total_cost, " +
@@ -562,19 +597,19 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 	}
 	var recordsWithCost, recordsWithoutCost int64
 
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).
+	if err := r.scopedRecords(ctx).
 		Select("SUM(actual_cost) as total_cost, AVG(actual_cost) as average_cost, MIN(actual_cost) as min_cost, MAX(actual_cost) as max_cost").
 		Where("actual_cost IS NOT NULL").
 		Scan(&costStats).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).
+	if err := r.scopedRecords(ctx).
 		Where("actual_cost IS NOT NULL").Count(&recordsWithCost).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).
+	if err := r.scopedRecords(ctx).
 		Where("actual_cost IS NULL").Count(&recordsWithoutCost).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
@@ -593,7 +628,7 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 		Date  time.Time
 		Count int64
 	}
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).
+	if err := r.scopedRecords(ctx).
 		Select("DATE(created_at) as date, COUNT(*) as count").
 		Where("created_at >= NOW() - INTERVAL '30 days'").
 		Group("DATE(created_at)").
@@ -614,7 +649,7 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 		RecordCount int64
 		TotalCost   float64
 	}
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).
+	if err := r.scopedRecords(ctx).
 		Select("TO_CHAR(created_at, 'YYYY-MM') as month, COUNT(*) as record_count, " +
 			"COALESCE(SUM(actual_cost), 0) as total_cost").
 		Where("created_at >= NOW() - INTERVAL '12 months'").
@@ -640,7 +675,7 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 
 	// Unique vendors and performers
 	var uniqueVendors int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).
+	if err := r.scopedRecords(ctx).
 		Select("COUNT(DISTINCT performed_by_vendor)").
 		Where("performed_by_vendor IS NOT NULL AND performed_by_vendor != ''").
 		Scan(&uniqueVendors).Error; err == nil {
@@ -648,7 +683,7 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 	}
 
 	var uniquePerformers int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).
+	if err := r.scopedRecords(ctx).
 		Select("COUNT(DISTINCT performed_by_user)").
 		Where("performed_by_user IS NOT NULL").
 		Scan(&uniquePerformers).Error; err == nil {
@@ -657,10 +692,10 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 
 	// Date ranges and averages
 	var earliest, latest time.Time
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).Select("MIN(created_at)").Scan(&earliest).Error; err == nil {
+	if err := r.scopedRecords(ctx).Select("MIN(created_at)").Scan(&earliest).Error; err == nil {
 		stats.Summary.EarliestRecordDate = earliest.Format("2006-01-02")
 	}
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceRecord{}).Select("MAX(created_at)").Scan(&latest).Error; err == nil {
+	if err := r.scopedRecords(ctx).Select("MAX(created_at)").Scan(&latest).Error; err == nil {
 		stats.Summary.LatestRecordDate = latest.Format("2006-01-02")
 	}
 	if !earliest.IsZero() && !latest.IsZero() {
@@ -678,6 +713,7 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordStatistics(ctx context
 	var assetsWithMaintenance int64
 	if err := r.db.WithContext(ctx).
 		Table("assets a").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Joins("INNER JOIN maintenance_records mr ON a.id = mr.asset_id").
 		Select("COUNT(DISTINCT a.id)").
 		Scan(&assetsWithMaintenance).Error; err == nil {
@@ -719,7 +755,18 @@ func (r *MaintenanceRecordRepository) GetMaintenanceRecordsForExport(ctx context
 	// Apply sorting
 	db = r.applyRecordSorts(db, params.Sort)
 
-	// No pagination for export - get all matching maintenance records
+	// * Tanpa pagination ambil semua data, export job mengisi limit/offset untuk ambil per batch
+	// * dan ID sebagai tie-breaker supaya urutan antar batch stabil
+	if params.Pagination != nil {
+		db = db.Order("maintenance_records.id")
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
 	if err := db.Find(&records).Error; err != nil {
 		return nil, domain.ErrInternal(err)
 	}
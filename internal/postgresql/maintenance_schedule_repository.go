@@ -8,6 +8,7 @@ import (
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/query"
 	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
 	"github.com/oklog/ulid/v2"
 	"gorm.io/gorm"
@@ -24,7 +25,15 @@ func NewMaintenanceScheduleRepository(db *gorm.DB) *MaintenanceScheduleRepositor
 
 // ===== Filters and Sorts =====
 
+// * scopedSchedules query dasar tabel maintenance_schedules yang sudah dibatasi lokasi user yang request
+func (r *MaintenanceScheduleRepository) scopedSchedules(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).Scopes(query.LocationScope(query.AssetLocationCondition))
+}
+
 func (r *MaintenanceScheduleRepository) applyScheduleFilters(db *gorm.DB, filters *domain.MaintenanceScheduleFilterOptions) *gorm.DB {
+	// * Scope lokasi selalu berlaku walaupun tanpa filter
+	db = db.Scopes(query.LocationScope(query.AssetLocationCondition))
+
 	if filters == nil {
 		return db
 	}
@@ -265,7 +274,7 @@ func (r *MaintenanceScheduleRepository) BulkDeleteSchedules(ctx context.Context,
 
 	// First, find which schedules actually exist
 	var existingSchedules []model.MaintenanceSchedule
-	if err := r.db.WithContext(ctx).Select("id").Where("id IN ?", scheduleIds).Find(&existingSchedules).Error; err != nil {
+	if err := r.scopedSchedules(ctx).Select("id").Where("id IN ?", scheduleIds).Find(&existingSchedules).Error; err != nil {
 		return result, domain.ErrInternal(err)
 	}
 
@@ -402,6 +411,7 @@ func (r *MaintenanceScheduleRepository) GetSchedulesCursor(ctx context.Context,
 func (r *MaintenanceScheduleRepository) GetScheduleById(ctx context.Context, scheduleId string) (domain.MaintenanceSchedule, error) {
 	var m model.MaintenanceSchedule
 	err := r.db.WithContext(ctx).
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Preload("Translations").
 		Preload("Asset").
 		Preload("Asset.Category").
@@ -424,7 +434,7 @@ func (r *MaintenanceScheduleRepository) GetScheduleById(ctx context.Context, sch
 
 func (r *MaintenanceScheduleRepository) CheckScheduleExist(ctx context.Context, scheduleId string) (bool, error) {
 	var count int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).Where("id = ?", scheduleId).Count(&count).Error; err != nil {
+	if err := r.scopedSchedules(ctx).Where("id = ?", scheduleId).Count(&count).Error; err != nil {
 		return false, domain.ErrInternal(err)
 	}
 	return count > 0, nil
@@ -454,29 +464,29 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 
 	// Total schedules
 	var total int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).Count(&total).Error; err != nil {
+	if err := r.scopedSchedules(ctx).Count(&total).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	stats.Total.Count = int(total)
 
 	// By type
 	var preventiveCount int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).
+	if err := r.scopedSchedules(ctx).
 		Where("maintenance_type = ?", domain.ScheduleTypePreventive).Count(&preventiveCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	var correctiveCount int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).
+	if err := r.scopedSchedules(ctx).
 		Where("maintenance_type = ?", domain.ScheduleTypeCorrective).Count(&correctiveCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	var inspectionCount int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).
+	if err := r.scopedSchedules(ctx).
 		Where("maintenance_type = ?", domain.ScheduleTypeInspection).Count(&inspectionCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	var calibrationCount int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).
+	if err := r.scopedSchedules(ctx).
 		Where("maintenance_type = ?", domain.ScheduleTypeCalibration).Count(&calibrationCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
@@ -487,16 +497,16 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 
 	// By status
 	var activeCount, pausedCount, stoppedCount, completedCount int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).Where("state = ?", domain.StateActive).Count(&activeCount).Error; err != nil {
+	if err := r.scopedSchedules(ctx).Where("state = ?", domain.StateActive).Count(&activeCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).Where("state = ?", domain.StatePaused).Count(&pausedCount).Error; err != nil {
+	if err := r.scopedSchedules(ctx).Where("state = ?", domain.StatePaused).Count(&pausedCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).Where("state = ?", domain.StateStopped).Count(&stoppedCount).Error; err != nil {
+	if err := r.scopedSchedules(ctx).Where("state = ?", domain.StateStopped).Count(&stoppedCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).Where("state = ?", domain.StateCompleted).Count(&completedCount).Error; err != nil {
+	if err := r.scopedSchedules(ctx).Where("state = ?", domain.StateCompleted).Count(&completedCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	stats.ByStatus.Active = int(activeCount)
@@ -514,6 +524,7 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 	}
 	if err := r.db.WithContext(ctx).
 		Table("maintenance_schedules ms").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Select("a.id as asset_id, a.asset_name, a.asset_tag, COUNT(*) as schedule_count, " +
 			"MIN(CASE WHEN ms.next_scheduled_date > NOW() AND ms.state = 'Active' THEN ms.next_scheduled_date::text ELSE NULL END) as next_maintenance").
 		Joins("LEFT JOIN assets a ON ms.asset_id = a.id").
@@ -540,6 +551,7 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 	}
 	if err := r.db.WithContext(ctx).
 		Table("maintenance_schedules ms").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Select("u.id as user_id, u.full_name as user_name, u.email as user_email, COUNT(*) as count").
 		Joins("LEFT JOIN users u ON ms.created_by = u.id").
 		Group("u.id, u.full_name, u.email").
@@ -569,6 +581,7 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 	}
 	if err := r.db.WithContext(ctx).
 		Table("maintenance_schedules ms").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Select("ms.id, a.id as asset_id, a.asset_name, a.asset_tag, ms.maintenance_type, " +
 			"ms.next_scheduled_date::text, EXTRACT(DAY FROM ms.next_scheduled_date - NOW()) as days_until_due, " +
 			"mst.title, mst.description").
@@ -608,6 +621,7 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 	}
 	if err := r.db.WithContext(ctx).
 		Table("maintenance_schedules ms").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Select("ms.id, a.id as asset_id, a.asset_name, a.asset_tag, ms.maintenance_type, " +
 			"ms.next_scheduled_date::text, EXTRACT(DAY FROM NOW() - ms.next_scheduled_date) as days_overdue, " +
 			"mst.title, mst.description").
@@ -639,6 +653,7 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 	}
 	if err := r.db.WithContext(ctx).
 		Table("maintenance_schedules").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Select("CASE WHEN interval_unit = 'Months' THEN interval_value ELSE interval_value * 12 END as frequency_months, COUNT(*) as count").
 		Where("interval_value IS NOT NULL AND interval_unit IS NOT NULL").
 		Group("CASE WHEN interval_unit = 'Months' THEN interval_value ELSE interval_value * 12 END").
@@ -668,7 +683,7 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 
 	// Average schedule frequency
 	var avgFreq float64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).
+	if err := r.scopedSchedules(ctx).
 		Select("AVG(frequency_months)").Where("frequency_months IS NOT NULL").Scan(&avgFreq).Error; err == nil {
 		stats.Summary.AverageScheduleFrequency = avgFreq
 	}
@@ -680,6 +695,7 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 	var assetsWithMaintenance int64
 	if err := r.db.WithContext(ctx).
 		Table("assets a").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		Joins("INNER JOIN maintenance_schedules ms ON a.id = ms.asset_id").
 		Where("ms.status = 'Scheduled'").
 		Count(&assetsWithMaintenance).Error; err == nil {
@@ -687,16 +703,16 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 	}
 
 	var totalAssets int64
-	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Count(&totalAssets).Error; err == nil {
+	if err := r.db.WithContext(ctx).Model(&model.Asset{}).Scopes(query.LocationScope("location_id IN ?")).Count(&totalAssets).Error; err == nil {
 		stats.Summary.AssetsWithoutScheduledMaintenance = int(totalAssets - assetsWithMaintenance)
 	}
 
 	// Average schedules per day
 	var earliest, latest time.Time
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).Select("MIN(created_at)").Scan(&earliest).Error; err == nil {
+	if err := r.scopedSchedules(ctx).Select("MIN(created_at)").Scan(&earliest).Error; err == nil {
 		stats.Summary.EarliestScheduleDate = earliest
 	}
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).Select("MAX(created_at)").Scan(&latest).Error; err == nil {
+	if err := r.scopedSchedules(ctx).Select("MAX(created_at)").Scan(&latest).Error; err == nil {
 		stats.Summary.LatestScheduleDate = latest
 	}
 	if !earliest.IsZero() && !latest.IsZero() {
@@ -708,7 +724,7 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceScheduleStatistics(ctx con
 
 	// Total unique creators
 	var uniqueCreators int64
-	if err := r.db.WithContext(ctx).Model(&model.MaintenanceSchedule{}).
+	if err := r.scopedSchedules(ctx).
 		Select("COUNT(DISTINCT created_by)").Scan(&uniqueCreators).Error; err == nil {
 		stats.Summary.TotalUniqueCreators = int(uniqueCreators)
 	}
@@ -766,16 +782,17 @@ func (r *MaintenanceScheduleRepository) GetOverdueSchedules(ctx context.Context)
 	return schedules, nil
 }
 
-// GetRecurringSchedulesToUpdate retrieves recurring schedules that need next_scheduled_date update
-func (r *MaintenanceScheduleRepository) GetRecurringSchedulesToUpdate(ctx context.Context) ([]domain.MaintenanceSchedule, error) {
+// GetReachedSchedules retrieves active schedules whose next_scheduled_date has passed and
+// whose current occurrence has not been turned into a work order yet
+func (r *MaintenanceScheduleRepository) GetReachedSchedules(ctx context.Context) ([]domain.MaintenanceSchedule, error) {
 	var models []model.MaintenanceSchedule
 	now := time.Now().UTC()
 
 	err := r.db.WithContext(ctx).
 		Preload("Translations").
 		Preload("Asset").
-		Where("is_recurring = ? AND next_scheduled_date < ? AND state = ?",
-			true, now, domain.StateActive).
+		Where("next_scheduled_date <= ? AND state = ?", now, domain.StateActive).
+		Where("NOT EXISTS (SELECT 1 FROM work_orders wo WHERE wo.schedule_id = maintenance_schedules.id AND wo.due_date = maintenance_schedules.next_scheduled_date)").
 		Find(&models).Error
 
 	if err != nil {
@@ -834,7 +851,18 @@ func (r *MaintenanceScheduleRepository) GetMaintenanceSchedulesForExport(ctx con
 	// Apply sorting
 	db = r.applyScheduleSorts(db, params.Sort)
 
-	// No pagination for export - get all matching maintenance schedules
+	// * Tanpa pagination ambil semua data, export job mengisi limit/offset untuk ambil per batch
+	// * dan ID sebagai tie-breaker supaya urutan antar batch stabil
+	if params.Pagination != nil {
+		db = db.Order("maintenance_schedules.id")
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
 	if err := db.Find(&schedules).Error; err != nil {
 		return nil, domain.ErrInternal(err)
 	}
@@ -0,0 +1,138 @@
+package mapper
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelAPIKeyForCreate(d *domain.APIKey) model.APIKey {
+	modelKey := model.APIKey{
+		Name:        d.Name,
+		Description: d.Description,
+		KeyPrefix:   d.KeyPrefix,
+		KeyHash:     d.KeyHash,
+		Scopes:      toModelAPIKeyScopes(d.Scopes),
+		ExpiresAt:   d.ExpiresAt,
+	}
+
+	if d.CreatedBy != nil && *d.CreatedBy != "" {
+		if parsedCreatedBy, err := ulid.Parse(*d.CreatedBy); err == nil {
+			modelULID := model.SQLULID(parsedCreatedBy)
+			modelKey.CreatedBy = &modelULID
+		}
+	}
+
+	return modelKey
+}
+
+// *==================== Entity conversions ====================
+func ToDomainAPIKey(m *model.APIKey) domain.APIKey {
+	domainKey := domain.APIKey{
+		ID:          m.ID.String(),
+		Name:        m.Name,
+		Description: m.Description,
+		KeyPrefix:   m.KeyPrefix,
+		KeyHash:     m.KeyHash,
+		Scopes:      toDomainAPIKeyScopes(m.Scopes),
+		ExpiresAt:   m.ExpiresAt,
+		LastUsedAt:  m.LastUsedAt,
+		LastUsedIP:  m.LastUsedIP,
+		UsageCount:  m.UsageCount,
+		RevokedAt:   m.RevokedAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+
+	if m.CreatedBy != nil && !m.CreatedBy.IsZero() {
+		createdByStr := m.CreatedBy.String()
+		domainKey.CreatedBy = &createdByStr
+	}
+
+	return domainKey
+}
+
+func ToDomainAPIKeys(models []model.APIKey) []domain.APIKey {
+	keys := make([]domain.APIKey, len(models))
+	for i, m := range models {
+		keys[i] = ToDomainAPIKey(&m)
+	}
+	return keys
+}
+
+// *==================== Entity Response conversions ====================
+func APIKeyToResponse(d *domain.APIKey) domain.APIKeyResponse {
+	return domain.APIKeyResponse{
+		ID:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		KeyPrefix:   d.KeyPrefix,
+		Scopes:      d.Scopes,
+		IsActive:    d.RevokedAt == nil && !d.IsExpired(time.Now()),
+		ExpiresAt:   d.ExpiresAt,
+		LastUsedAt:  d.LastUsedAt,
+		LastUsedIP:  d.LastUsedIP,
+		UsageCount:  d.UsageCount,
+		RevokedAt:   d.RevokedAt,
+		CreatedBy:   d.CreatedBy,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+func APIKeysToResponses(keys []domain.APIKey) []domain.APIKeyResponse {
+	responses := make([]domain.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		responses[i] = APIKeyToResponse(&key)
+	}
+	return responses
+}
+
+// *==================== Update Map conversions (Harus snake case karena untuk database) ====================
+func ToModelAPIKeyUpdateMap(payload *domain.UpdateAPIKeyPayload) map[string]any {
+	updates := make(map[string]any)
+
+	if payload.Name != nil {
+		updates["name"] = *payload.Name
+	}
+	if payload.Description != nil {
+		if *payload.Description == "" {
+			updates["description"] = nil
+		} else {
+			updates["description"] = *payload.Description
+		}
+	}
+	if payload.Scopes != nil {
+		updates["scopes"] = toModelAPIKeyScopes(payload.Scopes)
+	}
+	if payload.ExpiresAt != nil {
+		updates["expires_at"] = *payload.ExpiresAt
+	}
+
+	return updates
+}
+
+func toModelAPIKeyScopes(scopes []domain.APIKeyScope) string {
+	if len(scopes) == 0 {
+		return "[]"
+	}
+	scopesJSON, err := json.Marshal(scopes)
+	if err != nil {
+		return "[]"
+	}
+	return string(scopesJSON)
+}
+
+func toDomainAPIKeyScopes(scopes string) []domain.APIKeyScope {
+	result := []domain.APIKeyScope{}
+	if scopes != "" {
+		if err := json.Unmarshal([]byte(scopes), &result); err != nil {
+			return []domain.APIKeyScope{}
+		}
+	}
+	return result
+}
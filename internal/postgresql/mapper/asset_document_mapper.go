@@ -0,0 +1,123 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelAssetDocumentForCreate(d *domain.AssetDocument) model.AssetDocument {
+	modelDocument := model.AssetDocument{
+		Type:           d.Type,
+		Title:          d.Title,
+		DocumentNumber: d.DocumentNumber,
+		IssuedAt:       d.IssuedAt,
+		ExpiresAt:      d.ExpiresAt,
+		Notes:          d.Notes,
+		FileURL:        d.FileURL,
+		PublicID:       d.PublicID,
+		FileName:       d.FileName,
+		MimeType:       d.MimeType,
+		FileSize:       d.FileSize,
+	}
+
+	if parsedAssetID, err := ulid.Parse(d.AssetID); err == nil {
+		modelDocument.AssetID = model.SQLULID(parsedAssetID)
+	}
+
+	if d.MaintenanceRecordID != nil && *d.MaintenanceRecordID != "" {
+		if parsedRecordID, err := ulid.Parse(*d.MaintenanceRecordID); err == nil {
+			modelULID := model.SQLULID(parsedRecordID)
+			modelDocument.MaintenanceRecordID = &modelULID
+		}
+	}
+
+	if d.UploadedBy != nil && *d.UploadedBy != "" {
+		if parsedUploadedBy, err := ulid.Parse(*d.UploadedBy); err == nil {
+			modelULID := model.SQLULID(parsedUploadedBy)
+			modelDocument.UploadedBy = &modelULID
+		}
+	}
+
+	return modelDocument
+}
+
+// *==================== Entity conversions ====================
+func ToDomainAssetDocument(m *model.AssetDocument) domain.AssetDocument {
+	domainDocument := domain.AssetDocument{
+		ID:             m.ID.String(),
+		AssetID:        m.AssetID.String(),
+		Type:           m.Type,
+		Title:          m.Title,
+		DocumentNumber: m.DocumentNumber,
+		IssuedAt:       m.IssuedAt,
+		ExpiresAt:      m.ExpiresAt,
+		Notes:          m.Notes,
+		FileURL:        m.FileURL,
+		PublicID:       m.PublicID,
+		FileName:       m.FileName,
+		MimeType:       m.MimeType,
+		FileSize:       m.FileSize,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+
+	if m.MaintenanceRecordID != nil && !m.MaintenanceRecordID.IsZero() {
+		recordIDStr := m.MaintenanceRecordID.String()
+		domainDocument.MaintenanceRecordID = &recordIDStr
+	}
+
+	if m.UploadedBy != nil && !m.UploadedBy.IsZero() {
+		uploadedByStr := m.UploadedBy.String()
+		domainDocument.UploadedBy = &uploadedByStr
+	}
+
+	return domainDocument
+}
+
+func ToDomainAssetDocuments(models []model.AssetDocument) []domain.AssetDocument {
+	documents := make([]domain.AssetDocument, len(models))
+	for i, m := range models {
+		documents[i] = ToDomainAssetDocument(&m)
+	}
+	return documents
+}
+
+// *==================== Entity Response conversions ====================
+func AssetDocumentToResponse(d *domain.AssetDocument) domain.AssetDocumentResponse {
+	isExpired := false
+	if d.ExpiresAt != nil {
+		today := time.Now().Truncate(24 * time.Hour)
+		isExpired = d.ExpiresAt.Before(today)
+	}
+
+	return domain.AssetDocumentResponse{
+		ID:                  d.ID,
+		AssetID:             d.AssetID,
+		MaintenanceRecordID: d.MaintenanceRecordID,
+		Type:                d.Type,
+		Title:               d.Title,
+		DocumentNumber:      d.DocumentNumber,
+		IssuedAt:            d.IssuedAt,
+		ExpiresAt:           d.ExpiresAt,
+		IsExpired:           isExpired,
+		Notes:               d.Notes,
+		FileName:            d.FileName,
+		MimeType:            d.MimeType,
+		FileSize:            d.FileSize,
+		UploadedBy:          d.UploadedBy,
+		CreatedAt:           d.CreatedAt,
+		UpdatedAt:           d.UpdatedAt,
+	}
+}
+
+func AssetDocumentsToResponses(documents []domain.AssetDocument) []domain.AssetDocumentResponse {
+	responses := make([]domain.AssetDocumentResponse, len(documents))
+	for i, document := range documents {
+		responses[i] = AssetDocumentToResponse(&document)
+	}
+	return responses
+}
@@ -0,0 +1,211 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelAssetLoanForCreate(d *domain.AssetLoan) model.AssetLoan {
+	modelLoan := model.AssetLoan{
+		CheckoutDate:       d.CheckoutDate,
+		ExpectedReturnDate: d.ExpectedReturnDate,
+		CheckoutCondition:  d.CheckoutCondition,
+		Status:             d.Status,
+		Notes:              d.Notes,
+	}
+
+	if d.AssetID != "" {
+		if parsedAssetID, err := ulid.Parse(d.AssetID); err == nil {
+			modelLoan.AssetID = model.SQLULID(parsedAssetID)
+		}
+	}
+
+	if d.BorrowerID != "" {
+		if parsedBorrowerID, err := ulid.Parse(d.BorrowerID); err == nil {
+			modelLoan.BorrowerID = model.SQLULID(parsedBorrowerID)
+		}
+	}
+
+	if d.CheckedOutBy != "" {
+		if parsedCheckedOutBy, err := ulid.Parse(d.CheckedOutBy); err == nil {
+			modelLoan.CheckedOutBy = model.SQLULID(parsedCheckedOutBy)
+		}
+	}
+
+	if d.CheckoutMovementID != nil && *d.CheckoutMovementID != "" {
+		if parsedMovementID, err := ulid.Parse(*d.CheckoutMovementID); err == nil {
+			modelULID := model.SQLULID(parsedMovementID)
+			modelLoan.CheckoutMovementID = &modelULID
+		}
+	}
+
+	return modelLoan
+}
+
+// *==================== Entity conversions ====================
+func ToDomainAssetLoan(m *model.AssetLoan) domain.AssetLoan {
+	domainLoan := domain.AssetLoan{
+		ID:                 m.ID.String(),
+		AssetID:            m.AssetID.String(),
+		BorrowerID:         m.BorrowerID.String(),
+		CheckedOutBy:       m.CheckedOutBy.String(),
+		CheckoutDate:       m.CheckoutDate,
+		ExpectedReturnDate: m.ExpectedReturnDate,
+		ReturnedDate:       m.ReturnedDate,
+		CheckoutCondition:  m.CheckoutCondition,
+		ReturnCondition:    m.ReturnCondition,
+		Status:             m.Status,
+		Notes:              m.Notes,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+
+	if m.CheckedInBy != nil && !m.CheckedInBy.IsZero() {
+		checkedInByStr := m.CheckedInBy.String()
+		domainLoan.CheckedInBy = &checkedInByStr
+	}
+
+	if m.CheckoutMovementID != nil && !m.CheckoutMovementID.IsZero() {
+		checkoutMovementIDStr := m.CheckoutMovementID.String()
+		domainLoan.CheckoutMovementID = &checkoutMovementIDStr
+	}
+
+	if m.ReturnMovementID != nil && !m.ReturnMovementID.IsZero() {
+		returnMovementIDStr := m.ReturnMovementID.String()
+		domainLoan.ReturnMovementID = &returnMovementIDStr
+	}
+
+	// Populate related entities if preloaded
+	if !m.Asset.ID.IsZero() {
+		asset := ToDomainAsset(&m.Asset)
+		domainLoan.Asset = &asset
+	}
+
+	if !m.Borrower.ID.IsZero() {
+		user := ToDomainUser(&m.Borrower)
+		domainLoan.Borrower = &user
+	}
+
+	if !m.CheckedOutByUser.ID.IsZero() {
+		user := ToDomainUser(&m.CheckedOutByUser)
+		domainLoan.CheckedOutByUser = &user
+	}
+
+	if m.CheckedInByUser != nil && !m.CheckedInByUser.ID.IsZero() {
+		user := ToDomainUser(m.CheckedInByUser)
+		domainLoan.CheckedInByUser = &user
+	}
+
+	return domainLoan
+}
+
+func ToDomainAssetLoans(models []model.AssetLoan) []domain.AssetLoan {
+	if len(models) == 0 {
+		return []domain.AssetLoan{}
+	}
+	loans := make([]domain.AssetLoan, len(models))
+	for i, m := range models {
+		loans[i] = ToDomainAssetLoan(&m)
+	}
+	return loans
+}
+
+// *==================== Entity Response conversions ====================
+func AssetLoanToResponse(d *domain.AssetLoan, langCode string) domain.AssetLoanResponse {
+	now := time.Now()
+	response := domain.AssetLoanResponse{
+		ID:                 d.ID,
+		AssetID:            d.AssetID,
+		BorrowerID:         d.BorrowerID,
+		CheckedOutByID:     d.CheckedOutBy,
+		CheckedInByID:      d.CheckedInBy,
+		CheckoutDate:       d.CheckoutDate,
+		ExpectedReturnDate: d.ExpectedReturnDate,
+		ReturnedDate:       d.ReturnedDate,
+		CheckoutCondition:  d.CheckoutCondition,
+		ReturnCondition:    d.ReturnCondition,
+		Status:             d.Status,
+		IsOverdue:          d.IsOverdue(now),
+		DaysOverdue:        d.DaysOverdue(now),
+		CheckoutMovementID: d.CheckoutMovementID,
+		ReturnMovementID:   d.ReturnMovementID,
+		Notes:              d.Notes,
+		CreatedAt:          d.CreatedAt,
+		UpdatedAt:          d.UpdatedAt,
+	}
+
+	// Populate Asset if available
+	if d.Asset != nil {
+		assetResponse := AssetToResponse(d.Asset, langCode)
+		response.Asset = &assetResponse
+	}
+
+	// Populate Borrower if available
+	if d.Borrower != nil {
+		userResponse := UserToResponse(d.Borrower)
+		response.Borrower = &userResponse
+	}
+
+	// Populate CheckedOutBy if available
+	if d.CheckedOutByUser != nil {
+		userResponse := UserToResponse(d.CheckedOutByUser)
+		response.CheckedOutBy = &userResponse
+	}
+
+	// Populate CheckedInBy if available
+	if d.CheckedInByUser != nil {
+		userResponse := UserToResponse(d.CheckedInByUser)
+		response.CheckedInBy = &userResponse
+	}
+
+	return response
+}
+
+func AssetLoansToResponses(loans []domain.AssetLoan, langCode string) []domain.AssetLoanResponse {
+	if len(loans) == 0 {
+		return []domain.AssetLoanResponse{}
+	}
+	responses := make([]domain.AssetLoanResponse, len(loans))
+	for i, loan := range loans {
+		responses[i] = AssetLoanToResponse(&loan, langCode)
+	}
+	return responses
+}
+
+// *==================== Update Map conversions (Harus snake case karena untuk database) ====================
+func ToModelAssetLoanCheckInMap(d *domain.AssetLoan) map[string]any {
+	updates := map[string]any{
+		"status":           d.Status,
+		"returned_date":    d.ReturnedDate,
+		"return_condition": d.ReturnCondition,
+		"checked_in_by":    d.CheckedInBy,
+	}
+
+	if d.ReturnMovementID != nil {
+		updates["return_movement_id"] = *d.ReturnMovementID
+	}
+	if d.Notes != nil {
+		updates["notes"] = *d.Notes
+	}
+
+	return updates
+}
+
+func MapAssetLoanSortFieldToColumn(field domain.AssetLoanSortField) string {
+	columnMap := map[domain.AssetLoanSortField]string{
+		domain.AssetLoanSortByCheckoutDate:       "al.checkout_date",
+		domain.AssetLoanSortByExpectedReturnDate: "al.expected_return_date",
+		domain.AssetLoanSortByReturnedDate:       "al.returned_date",
+		domain.AssetLoanSortByCreatedAt:          "al.created_at",
+		domain.AssetLoanSortByUpdatedAt:          "al.updated_at",
+	}
+
+	if column, exists := columnMap[field]; exists {
+		return column
+	}
+	return "al.checkout_date"
+}
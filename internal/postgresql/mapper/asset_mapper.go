@@ -1,6 +1,7 @@
 package mapper
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
@@ -23,6 +24,7 @@ func ToModelAsset(d *domain.Asset) model.Asset {
 		WarrantyEnd:        d.WarrantyEnd,
 		Status:             d.Status,
 		Condition:          d.Condition,
+		CustomAttributes:   toModelCustomAttributes(d.CustomAttributes),
 	}
 
 	if d.ID != "" {
@@ -68,6 +70,7 @@ func ToModelAssetForCreate(d *domain.Asset) model.Asset {
 		WarrantyEnd:        d.WarrantyEnd,
 		Status:             d.Status,
 		Condition:          d.Condition,
+		CustomAttributes:   toModelCustomAttributes(d.CustomAttributes),
 	}
 
 	if d.CategoryID != "" {
@@ -110,6 +113,7 @@ func ToDomainAsset(m *model.Asset) domain.Asset {
 		WarrantyEnd:        m.WarrantyEnd,
 		Status:             m.Status,
 		Condition:          m.Condition,
+		CustomAttributes:   toDomainCustomAttributes(m.CustomAttributes),
 		CreatedAt:          m.CreatedAt,
 		UpdatedAt:          m.UpdatedAt,
 	}
@@ -178,6 +182,7 @@ func AssetToResponse(d *domain.Asset, langCode string) domain.AssetResponse {
 		Condition:          d.Condition,
 		LocationID:         d.LocationID,
 		AssignedToID:       d.AssignedTo,
+		CustomAttributes:   d.CustomAttributes,
 		CreatedAt:          d.CreatedAt,
 		UpdatedAt:          d.UpdatedAt,
 	}
@@ -198,6 +203,15 @@ func AssetToResponse(d *domain.Asset, langCode string) domain.AssetResponse {
 		response.AssignedTo = &userResponse
 	}
 
+	// Calculate book value from category depreciation settings
+	if depreciation := d.Depreciation(time.Now()); depreciation != nil {
+		response.BookValue = domain.NewNullableDecimal2(&depreciation.BookValue)
+		response.AccumulatedDepreciation = domain.NewNullableDecimal2(&depreciation.AccumulatedDepreciation)
+	} else {
+		response.BookValue = domain.NewNullableDecimal2(nil)
+		response.AccumulatedDepreciation = domain.NewNullableDecimal2(nil)
+	}
+
 	// Convert images
 	if len(d.Images) > 0 {
 		response.Images = AssetImagesToResponses(d.Images)
@@ -235,6 +249,7 @@ func AssetToListResponse(d *domain.Asset, langCode string) domain.AssetListRespo
 		Condition:          d.Condition,
 		LocationID:         d.LocationID,
 		AssignedToID:       d.AssignedTo,
+		CustomAttributes:   d.CustomAttributes,
 		CreatedAt:          d.CreatedAt,
 		UpdatedAt:          d.UpdatedAt,
 	}
@@ -255,6 +270,15 @@ func AssetToListResponse(d *domain.Asset, langCode string) domain.AssetListRespo
 		response.AssignedTo = &userResponse
 	}
 
+	// Calculate book value from category depreciation settings
+	if depreciation := d.Depreciation(time.Now()); depreciation != nil {
+		response.BookValue = domain.NewNullableDecimal2(&depreciation.BookValue)
+		response.AccumulatedDepreciation = domain.NewNullableDecimal2(&depreciation.AccumulatedDepreciation)
+	} else {
+		response.BookValue = domain.NewNullableDecimal2(nil)
+		response.AccumulatedDepreciation = domain.NewNullableDecimal2(nil)
+	}
+
 	return response
 }
 
@@ -289,6 +313,7 @@ func AssetStatisticsToResponse(stats *domain.AssetStatistics) domain.AssetStatis
 		},
 		ValueStatistics: domain.AssetValueStatisticsResponse{
 			TotalValue:         domain.NewNullableDecimal2(stats.ValueStatistics.TotalValue),
+			TotalBookValue:     domain.NewNullableDecimal2(stats.ValueStatistics.TotalBookValue),
 			AverageValue:       domain.NewNullableDecimal2(stats.ValueStatistics.AverageValue),
 			MinValue:           domain.NewNullableDecimal2(stats.ValueStatistics.MinValue),
 			MaxValue:           domain.NewNullableDecimal2(stats.ValueStatistics.MaxValue),
@@ -364,6 +389,65 @@ func AssetStatisticsToResponse(stats *domain.AssetStatistics) domain.AssetStatis
 	return response
 }
 
+// *==================== Depreciation conversions ====================
+func AssetDepreciationToResponse(d *domain.Asset, depreciation *domain.AssetDepreciation, langCode string) domain.AssetDepreciationResponse {
+	response := domain.AssetDepreciationResponse{
+		AssetID:                 d.ID,
+		AssetTag:                d.AssetTag,
+		AssetName:               d.AssetName,
+		CategoryID:              d.CategoryID,
+		Status:                  d.Status,
+		DepreciationMethod:      depreciation.Method,
+		UsefulLifeYears:         depreciation.UsefulLifeYears,
+		PurchaseDate:            d.PurchaseDate,
+		PurchasePrice:           domain.NewDecimal2(depreciation.PurchasePrice),
+		SalvageValue:            domain.NewDecimal2(depreciation.SalvageValue),
+		AccumulatedDepreciation: domain.NewDecimal2(depreciation.AccumulatedDepreciation),
+		BookValue:               domain.NewDecimal2(depreciation.BookValue),
+		ElapsedMonths:           depreciation.ElapsedMonths,
+		IsFullyDepreciated:      depreciation.IsFullyDepreciated,
+	}
+
+	if d.Category != nil {
+		category := CategoryToListResponse(d.Category, langCode)
+		response.CategoryCode = category.CategoryCode
+		response.CategoryName = category.CategoryName
+	}
+
+	return response
+}
+
+// AssetsToDepreciationReport builds depreciation report, assets without purchase price are skipped
+func AssetsToDepreciationReport(assets []domain.Asset, asOf time.Time, langCode string) domain.AssetDepreciationReportResponse {
+	report := domain.AssetDepreciationReportResponse{
+		AsOfDate: asOf,
+		Assets:   []domain.AssetDepreciationResponse{},
+	}
+
+	var totalPurchaseValue, totalAccumulated, totalBookValue float64
+	for i := range assets {
+		depreciation := assets[i].Depreciation(asOf)
+		if depreciation == nil {
+			continue
+		}
+
+		report.Assets = append(report.Assets, AssetDepreciationToResponse(&assets[i], depreciation, langCode))
+		totalPurchaseValue += depreciation.PurchasePrice
+		totalAccumulated += depreciation.AccumulatedDepreciation
+		totalBookValue += depreciation.BookValue
+		if depreciation.IsFullyDepreciated {
+			report.Summary.FullyDepreciatedAssets++
+		}
+	}
+
+	report.Summary.TotalAssets = len(report.Assets)
+	report.Summary.TotalPurchaseValue = domain.NewDecimal2(totalPurchaseValue)
+	report.Summary.TotalAccumulatedDepreciation = domain.NewDecimal2(totalAccumulated)
+	report.Summary.TotalBookValue = domain.NewDecimal2(totalBookValue)
+
+	return report
+}
+
 // *==================== Update Map conversions (Harus snake case karena untuk database) ====================
 func ToModelAssetUpdateMap(payload *domain.UpdateAssetPayload) map[string]any {
 	updates := make(map[string]any)
@@ -433,6 +517,10 @@ func ToModelAssetUpdateMap(payload *domain.UpdateAssetPayload) map[string]any {
 			updates["assigned_to"] = *payload.AssignedTo
 		}
 	}
+	// Service sudah merge dan validasi value terhadap schema category
+	if payload.CustomAttributes != nil {
+		updates["custom_attributes"] = toModelCustomAttributes(payload.CustomAttributes)
+	}
 
 	return updates
 }
@@ -622,3 +710,26 @@ func AssetImagesToResponses(images []domain.AssetImage) []domain.AssetImageRespo
 	}
 	return responses
 }
+
+// *==================== Custom attribute conversions ====================
+func toModelCustomAttributes(values map[string]any) *string {
+	if len(values) == 0 {
+		return nil
+	}
+	valuesJSON, err := json.Marshal(values)
+	if err != nil {
+		return nil
+	}
+	valuesStr := string(valuesJSON)
+	return &valuesStr
+}
+
+func toDomainCustomAttributes(values *string) map[string]any {
+	result := map[string]any{}
+	if values != nil && *values != "" {
+		if err := json.Unmarshal([]byte(*values), &result); err != nil {
+			return map[string]any{}
+		}
+	}
+	return result
+}
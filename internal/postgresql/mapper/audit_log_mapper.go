@@ -0,0 +1,122 @@
+package mapper
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelAuditLogForCreate(d *domain.AuditLog) model.AuditLog {
+	modelLog := model.AuditLog{
+		EntityType: d.EntityType,
+		EntityID:   d.EntityID,
+		Action:     d.Action,
+	}
+
+	if d.ActorID != nil && *d.ActorID != "" {
+		if parsedActorID, err := ulid.Parse(*d.ActorID); err == nil {
+			modelULID := model.SQLULID(parsedActorID)
+			modelLog.ActorID = &modelULID
+		}
+	}
+
+	if len(d.Changes) > 0 {
+		if changesJSON, err := json.Marshal(d.Changes); err == nil {
+			changesStr := string(changesJSON)
+			modelLog.Changes = &changesStr
+		} else {
+			log.Printf("Failed to marshal audit log changes for %s %s: %v", d.EntityType, d.EntityID, err)
+		}
+	}
+
+	return modelLog
+}
+
+// *==================== Entity conversions ====================
+func ToDomainAuditLog(m *model.AuditLog) domain.AuditLog {
+	domainLog := domain.AuditLog{
+		ID:         m.ID.String(),
+		EntityType: m.EntityType,
+		EntityID:   m.EntityID,
+		Action:     m.Action,
+		Changes:    map[string]domain.AuditFieldChange{},
+		CreatedAt:  m.CreatedAt,
+	}
+
+	if m.ActorID != nil && !m.ActorID.IsZero() {
+		actorIDStr := m.ActorID.String()
+		domainLog.ActorID = &actorIDStr
+	}
+
+	if m.Changes != nil && *m.Changes != "" {
+		if err := json.Unmarshal([]byte(*m.Changes), &domainLog.Changes); err != nil {
+			log.Printf("Failed to unmarshal audit log changes for %s: %v", m.ID.String(), err)
+		}
+	}
+
+	// Populate related entities if preloaded
+	if m.Actor != nil && !m.Actor.ID.IsZero() {
+		user := ToDomainUser(m.Actor)
+		domainLog.Actor = &user
+	}
+
+	return domainLog
+}
+
+func ToDomainAuditLogs(models []model.AuditLog) []domain.AuditLog {
+	if len(models) == 0 {
+		return []domain.AuditLog{}
+	}
+	logs := make([]domain.AuditLog, len(models))
+	for i, m := range models {
+		logs[i] = ToDomainAuditLog(&m)
+	}
+	return logs
+}
+
+// *==================== Entity Response conversions ====================
+func AuditLogToResponse(d *domain.AuditLog) domain.AuditLogResponse {
+	response := domain.AuditLogResponse{
+		ID:         d.ID,
+		EntityType: d.EntityType,
+		EntityID:   d.EntityID,
+		Action:     d.Action,
+		ActorID:    d.ActorID,
+		Changes:    d.Changes,
+		CreatedAt:  d.CreatedAt,
+	}
+
+	// Populate Actor if available
+	if d.Actor != nil {
+		userResponse := UserToResponse(d.Actor)
+		response.Actor = &userResponse
+	}
+
+	return response
+}
+
+func AuditLogsToResponses(logs []domain.AuditLog) []domain.AuditLogResponse {
+	if len(logs) == 0 {
+		return []domain.AuditLogResponse{}
+	}
+	responses := make([]domain.AuditLogResponse, len(logs))
+	for i, l := range logs {
+		responses[i] = AuditLogToResponse(&l)
+	}
+	return responses
+}
+
+func MapAuditLogSortFieldToColumn(field domain.AuditLogSortField) string {
+	columnMap := map[domain.AuditLogSortField]string{
+		domain.AuditLogSortByCreatedAt: "aul.created_at",
+	}
+
+	if column, exists := columnMap[field]; exists {
+		return column
+	}
+	return "aul.created_at"
+}
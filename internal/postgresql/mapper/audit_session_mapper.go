@@ -0,0 +1,318 @@
+package mapper
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelAuditSessionForCreate(d *domain.AuditSession) model.AuditSession {
+	modelSession := model.AuditSession{
+		Title:     d.Title,
+		Status:    d.Status,
+		StartedAt: d.StartedAt,
+		Notes:     d.Notes,
+	}
+
+	if d.LocationID != nil && *d.LocationID != "" {
+		if parsedLocationID, err := ulid.Parse(*d.LocationID); err == nil {
+			modelULID := model.SQLULID(parsedLocationID)
+			modelSession.LocationID = &modelULID
+		}
+	}
+
+	if d.CategoryID != nil && *d.CategoryID != "" {
+		if parsedCategoryID, err := ulid.Parse(*d.CategoryID); err == nil {
+			modelULID := model.SQLULID(parsedCategoryID)
+			modelSession.CategoryID = &modelULID
+		}
+	}
+
+	if d.StartedBy != "" {
+		if parsedStartedBy, err := ulid.Parse(d.StartedBy); err == nil {
+			modelSession.StartedBy = model.SQLULID(parsedStartedBy)
+		}
+	}
+
+	return modelSession
+}
+
+func ToModelAuditSessionItemForCreate(d *domain.AuditSessionItem) model.AuditSessionItem {
+	modelItem := model.AuditSessionItem{
+		IsExpected: d.IsExpected,
+		Result:     d.Result,
+		ScannedAt:  d.ScannedAt,
+		MarkedLost: d.MarkedLost,
+	}
+
+	if d.SessionID != "" {
+		if parsedSessionID, err := ulid.Parse(d.SessionID); err == nil {
+			modelItem.SessionID = model.SQLULID(parsedSessionID)
+		}
+	}
+
+	if d.AssetID != "" {
+		if parsedAssetID, err := ulid.Parse(d.AssetID); err == nil {
+			modelItem.AssetID = model.SQLULID(parsedAssetID)
+		}
+	}
+
+	if d.ExpectedLocationID != nil && *d.ExpectedLocationID != "" {
+		if parsedLocationID, err := ulid.Parse(*d.ExpectedLocationID); err == nil {
+			modelULID := model.SQLULID(parsedLocationID)
+			modelItem.ExpectedLocationID = &modelULID
+		}
+	}
+
+	if d.ScanLogID != nil && *d.ScanLogID != "" {
+		if parsedScanLogID, err := ulid.Parse(*d.ScanLogID); err == nil {
+			modelULID := model.SQLULID(parsedScanLogID)
+			modelItem.ScanLogID = &modelULID
+		}
+	}
+
+	if d.ScannedBy != nil && *d.ScannedBy != "" {
+		if parsedScannedBy, err := ulid.Parse(*d.ScannedBy); err == nil {
+			modelULID := model.SQLULID(parsedScannedBy)
+			modelItem.ScannedBy = &modelULID
+		}
+	}
+
+	return modelItem
+}
+
+// *==================== Entity conversions ====================
+func ToDomainAuditSession(m *model.AuditSession) domain.AuditSession {
+	domainSession := domain.AuditSession{
+		ID:        m.ID.String(),
+		Title:     m.Title,
+		Status:    m.Status,
+		StartedBy: m.StartedBy.String(),
+		StartedAt: m.StartedAt,
+		ClosedAt:  m.ClosedAt,
+		Notes:     m.Notes,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+
+	if m.LocationID != nil && !m.LocationID.IsZero() {
+		locationIDStr := m.LocationID.String()
+		domainSession.LocationID = &locationIDStr
+	}
+
+	if m.CategoryID != nil && !m.CategoryID.IsZero() {
+		categoryIDStr := m.CategoryID.String()
+		domainSession.CategoryID = &categoryIDStr
+	}
+
+	if m.ClosedBy != nil && !m.ClosedBy.IsZero() {
+		closedByStr := m.ClosedBy.String()
+		domainSession.ClosedBy = &closedByStr
+	}
+
+	// Populate related entities if preloaded
+	if m.Location != nil && !m.Location.ID.IsZero() {
+		location := ToDomainLocation(m.Location)
+		domainSession.Location = &location
+	}
+
+	if m.Category != nil && !m.Category.ID.IsZero() {
+		category := ToDomainCategory(m.Category)
+		domainSession.Category = &category
+	}
+
+	if !m.StartedByUser.ID.IsZero() {
+		user := ToDomainUser(&m.StartedByUser)
+		domainSession.StartedByUser = &user
+	}
+
+	if m.ClosedByUser != nil && !m.ClosedByUser.ID.IsZero() {
+		user := ToDomainUser(m.ClosedByUser)
+		domainSession.ClosedByUser = &user
+	}
+
+	return domainSession
+}
+
+func ToDomainAuditSessions(models []model.AuditSession) []domain.AuditSession {
+	if len(models) == 0 {
+		return []domain.AuditSession{}
+	}
+	sessions := make([]domain.AuditSession, len(models))
+	for i, m := range models {
+		sessions[i] = ToDomainAuditSession(&m)
+	}
+	return sessions
+}
+
+func ToDomainAuditSessionItem(m *model.AuditSessionItem) domain.AuditSessionItem {
+	domainItem := domain.AuditSessionItem{
+		ID:         m.ID.String(),
+		SessionID:  m.SessionID.String(),
+		AssetID:    m.AssetID.String(),
+		IsExpected: m.IsExpected,
+		Result:     m.Result,
+		ScannedAt:  m.ScannedAt,
+		MarkedLost: m.MarkedLost,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+
+	if m.ExpectedLocationID != nil && !m.ExpectedLocationID.IsZero() {
+		expectedLocationIDStr := m.ExpectedLocationID.String()
+		domainItem.ExpectedLocationID = &expectedLocationIDStr
+	}
+
+	if m.ScanLogID != nil && !m.ScanLogID.IsZero() {
+		scanLogIDStr := m.ScanLogID.String()
+		domainItem.ScanLogID = &scanLogIDStr
+	}
+
+	if m.ScannedBy != nil && !m.ScannedBy.IsZero() {
+		scannedByStr := m.ScannedBy.String()
+		domainItem.ScannedBy = &scannedByStr
+	}
+
+	if m.CorrectionMovementID != nil && !m.CorrectionMovementID.IsZero() {
+		correctionMovementIDStr := m.CorrectionMovementID.String()
+		domainItem.CorrectionMovementID = &correctionMovementIDStr
+	}
+
+	// Populate related entities if preloaded
+	if !m.Asset.ID.IsZero() {
+		asset := ToDomainAsset(&m.Asset)
+		domainItem.Asset = &asset
+	}
+
+	if m.ExpectedLocation != nil && !m.ExpectedLocation.ID.IsZero() {
+		location := ToDomainLocation(m.ExpectedLocation)
+		domainItem.ExpectedLocation = &location
+	}
+
+	return domainItem
+}
+
+func ToDomainAuditSessionItems(models []model.AuditSessionItem) []domain.AuditSessionItem {
+	if len(models) == 0 {
+		return []domain.AuditSessionItem{}
+	}
+	items := make([]domain.AuditSessionItem, len(models))
+	for i, m := range models {
+		items[i] = ToDomainAuditSessionItem(&m)
+	}
+	return items
+}
+
+// *==================== Entity Response conversions ====================
+func AuditSessionToResponse(d *domain.AuditSession, langCode string) domain.AuditSessionResponse {
+	response := domain.AuditSessionResponse{
+		ID:          d.ID,
+		Title:       d.Title,
+		LocationID:  d.LocationID,
+		CategoryID:  d.CategoryID,
+		Status:      d.Status,
+		StartedByID: d.StartedBy,
+		StartedAt:   d.StartedAt,
+		ClosedByID:  d.ClosedBy,
+		ClosedAt:    d.ClosedAt,
+		Notes:       d.Notes,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+
+	// Populate Location if available
+	if d.Location != nil {
+		locationResponse := LocationToResponse(d.Location, langCode)
+		response.Location = &locationResponse
+	}
+
+	// Populate Category if available
+	if d.Category != nil {
+		categoryResponse := CategoryToResponse(d.Category, langCode)
+		response.Category = &categoryResponse
+	}
+
+	// Populate StartedBy if available
+	if d.StartedByUser != nil {
+		userResponse := UserToResponse(d.StartedByUser)
+		response.StartedBy = &userResponse
+	}
+
+	// Populate ClosedBy if available
+	if d.ClosedByUser != nil {
+		userResponse := UserToResponse(d.ClosedByUser)
+		response.ClosedBy = &userResponse
+	}
+
+	return response
+}
+
+func AuditSessionsToResponses(sessions []domain.AuditSession, langCode string) []domain.AuditSessionResponse {
+	if len(sessions) == 0 {
+		return []domain.AuditSessionResponse{}
+	}
+	responses := make([]domain.AuditSessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = AuditSessionToResponse(&session, langCode)
+	}
+	return responses
+}
+
+func AuditSessionItemToResponse(d *domain.AuditSessionItem, langCode string) domain.AuditSessionItemResponse {
+	response := domain.AuditSessionItemResponse{
+		ID:                   d.ID,
+		SessionID:            d.SessionID,
+		AssetID:              d.AssetID,
+		IsExpected:           d.IsExpected,
+		ExpectedLocationID:   d.ExpectedLocationID,
+		Result:               d.Result,
+		ScanLogID:            d.ScanLogID,
+		ScannedByID:          d.ScannedBy,
+		ScannedAt:            d.ScannedAt,
+		CorrectionMovementID: d.CorrectionMovementID,
+		MarkedLost:           d.MarkedLost,
+		CreatedAt:            d.CreatedAt,
+		UpdatedAt:            d.UpdatedAt,
+	}
+
+	// Populate Asset if available
+	if d.Asset != nil {
+		assetResponse := AssetToResponse(d.Asset, langCode)
+		response.Asset = &assetResponse
+	}
+
+	// Populate ExpectedLocation if available
+	if d.ExpectedLocation != nil {
+		locationResponse := LocationToResponse(d.ExpectedLocation, langCode)
+		response.ExpectedLocation = &locationResponse
+	}
+
+	return response
+}
+
+func AuditSessionItemsToResponses(items []domain.AuditSessionItem, langCode string) []domain.AuditSessionItemResponse {
+	if len(items) == 0 {
+		return []domain.AuditSessionItemResponse{}
+	}
+	responses := make([]domain.AuditSessionItemResponse, len(items))
+	for i, item := range items {
+		responses[i] = AuditSessionItemToResponse(&item, langCode)
+	}
+	return responses
+}
+
+func MapAuditSessionSortFieldToColumn(field domain.AuditSessionSortField) string {
+	columnMap := map[domain.AuditSessionSortField]string{
+		domain.AuditSessionSortByTitle:     "aus.title",
+		domain.AuditSessionSortByStartedAt: "aus.started_at",
+		domain.AuditSessionSortByClosedAt:  "aus.closed_at",
+		domain.AuditSessionSortByCreatedAt: "aus.created_at",
+		domain.AuditSessionSortByUpdatedAt: "aus.updated_at",
+	}
+
+	if column, exists := columnMap[field]; exists {
+		return column
+	}
+	return "aus.started_at"
+}
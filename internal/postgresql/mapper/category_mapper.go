@@ -1,6 +1,8 @@
 package mapper
 
 import (
+	"encoding/json"
+
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
 	"github.com/oklog/ulid/v2"
@@ -9,7 +11,11 @@ import (
 // *==================== Model conversions ====================
 func ToModelCategory(d *domain.Category) model.Category {
 	modelCategory := model.Category{
-		CategoryCode: d.CategoryCode,
+		CategoryCode:        d.CategoryCode,
+		DepreciationMethod:  d.DepreciationMethod,
+		UsefulLifeYears:     d.UsefulLifeYears,
+		SalvageValuePercent: d.SalvageValuePercent,
+		Attributes:          toModelCategoryAttributes(d.Attributes),
 	}
 
 	if d.ID != "" {
@@ -30,8 +36,12 @@ func ToModelCategory(d *domain.Category) model.Category {
 
 func ToModelCategoryForCreate(d *domain.Category) model.Category {
 	modelCategory := model.Category{
-		CategoryCode: d.CategoryCode,
-		ImageURL:     d.ImageURL,
+		CategoryCode:        d.CategoryCode,
+		ImageURL:            d.ImageURL,
+		DepreciationMethod:  d.DepreciationMethod,
+		UsefulLifeYears:     d.UsefulLifeYears,
+		SalvageValuePercent: d.SalvageValuePercent,
+		Attributes:          toModelCategoryAttributes(d.Attributes),
 	}
 
 	if d.ParentID != nil && *d.ParentID != "" {
@@ -85,11 +95,15 @@ func ToModelCategoryTranslationForCreate(categoryID string, d *domain.CategoryTr
 // *==================== Entity conversions ====================
 func ToDomainCategory(m *model.Category) domain.Category {
 	domainCategory := domain.Category{
-		ID:           m.ID.String(),
-		CategoryCode: m.CategoryCode,
-		ImageURL:     m.ImageURL,
-		CreatedAt:    m.CreatedAt,
-		UpdatedAt:    m.UpdatedAt,
+		ID:                  m.ID.String(),
+		CategoryCode:        m.CategoryCode,
+		ImageURL:            m.ImageURL,
+		DepreciationMethod:  m.DepreciationMethod,
+		UsefulLifeYears:     m.UsefulLifeYears,
+		SalvageValuePercent: m.SalvageValuePercent,
+		Attributes:          toDomainCategoryAttributes(m.Attributes),
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
 	}
 
 	if m.ParentID != nil && !m.ParentID.IsZero() {
@@ -136,13 +150,21 @@ func ToDomainCategoryTranslation(m *model.CategoryTranslation) domain.CategoryTr
 // *==================== Entity Response conversions ====================
 func CategoryToResponse(d *domain.Category, langCode string) domain.CategoryResponse {
 	response := domain.CategoryResponse{
-		ID:           d.ID,
-		ParentID:     d.ParentID,
-		CategoryCode: d.CategoryCode,
-		ImageURL:     d.ImageURL,
-		CreatedAt:    d.CreatedAt,
-		UpdatedAt:    d.UpdatedAt,
-		Translations: make([]domain.CategoryTranslationResponse, len(d.Translations)),
+		ID:                  d.ID,
+		ParentID:            d.ParentID,
+		CategoryCode:        d.CategoryCode,
+		ImageURL:            d.ImageURL,
+		DepreciationMethod:  d.DepreciationMethod,
+		UsefulLifeYears:     d.UsefulLifeYears,
+		SalvageValuePercent: domain.NewNullableDecimal2(d.SalvageValuePercent),
+		Attributes:          d.Attributes,
+		CreatedAt:           d.CreatedAt,
+		UpdatedAt:           d.UpdatedAt,
+		Translations:        make([]domain.CategoryTranslationResponse, len(d.Translations)),
+	}
+
+	if response.Attributes == nil {
+		response.Attributes = []domain.CategoryAttribute{}
 	}
 
 	// Populate translations
@@ -191,12 +213,15 @@ func CategoriesToResponses(categories []domain.Category, langCode string) []doma
 
 func CategoryToListResponse(d *domain.Category, langCode string) domain.CategoryListResponse {
 	response := domain.CategoryListResponse{
-		ID:           d.ID,
-		ParentID:     d.ParentID,
-		CategoryCode: d.CategoryCode,
-		ImageURL:     d.ImageURL,
-		CreatedAt:    d.CreatedAt,
-		UpdatedAt:    d.UpdatedAt,
+		ID:                  d.ID,
+		ParentID:            d.ParentID,
+		CategoryCode:        d.CategoryCode,
+		ImageURL:            d.ImageURL,
+		DepreciationMethod:  d.DepreciationMethod,
+		UsefulLifeYears:     d.UsefulLifeYears,
+		SalvageValuePercent: domain.NewNullableDecimal2(d.SalvageValuePercent),
+		CreatedAt:           d.CreatedAt,
+		UpdatedAt:           d.UpdatedAt,
 	}
 
 	// Find translation for the requested language
@@ -289,6 +314,18 @@ func ToModelCategoryUpdateMap(payload *domain.UpdateCategoryPayload) map[string]
 			updates["image_url"] = *payload.ImageURL
 		}
 	}
+	if payload.DepreciationMethod != nil {
+		updates["depreciation_method"] = *payload.DepreciationMethod
+	}
+	if payload.UsefulLifeYears != nil {
+		updates["useful_life_years"] = *payload.UsefulLifeYears
+	}
+	if payload.SalvageValuePercent != nil {
+		updates["salvage_value_percent"] = *payload.SalvageValuePercent
+	}
+	if payload.Attributes != nil {
+		updates["attributes"] = toModelCategoryAttributes(*payload.Attributes)
+	}
 
 	return updates
 }
@@ -319,3 +356,26 @@ func MapCategorySortFieldToColumn(field domain.CategorySortField) string {
 	}
 	return "c.created_at"
 }
+
+// *==================== Attribute schema conversions ====================
+func toModelCategoryAttributes(attributes []domain.CategoryAttribute) *string {
+	if len(attributes) == 0 {
+		return nil
+	}
+	attributesJSON, err := json.Marshal(attributes)
+	if err != nil {
+		return nil
+	}
+	attributesStr := string(attributesJSON)
+	return &attributesStr
+}
+
+func toDomainCategoryAttributes(attributes *string) []domain.CategoryAttribute {
+	result := []domain.CategoryAttribute{}
+	if attributes != nil && *attributes != "" {
+		if err := json.Unmarshal([]byte(*attributes), &result); err != nil {
+			return []domain.CategoryAttribute{}
+		}
+	}
+	return result
+}
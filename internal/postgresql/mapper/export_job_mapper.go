@@ -0,0 +1,108 @@
+package mapper
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelExportJobForCreate(d *domain.ExportJob) model.ExportJob {
+	modelJob := model.ExportJob{
+		EntityType:  string(d.EntityType),
+		Format:      string(d.Format),
+		SearchQuery: d.SearchQuery,
+		Filters:     toModelRawJSON(d.Filters),
+		Sort:        toModelRawJSON(d.Sort),
+		LangCode:    d.LangCode,
+		Status:      string(d.Status),
+	}
+
+	if parsedUserID, err := ulid.Parse(d.UserID); err == nil {
+		modelJob.UserID = model.SQLULID(parsedUserID)
+	}
+
+	return modelJob
+}
+
+// *==================== Entity conversions ====================
+func ToDomainExportJob(m *model.ExportJob) domain.ExportJob {
+	return domain.ExportJob{
+		ID:            m.ID.String(),
+		UserID:        m.UserID.String(),
+		EntityType:    domain.SavedFilterEntityType(m.EntityType),
+		Format:        domain.ExportFormat(m.Format),
+		SearchQuery:   m.SearchQuery,
+		Filters:       toRawJSON(m.Filters),
+		Sort:          toRawJSON(m.Sort),
+		LangCode:      m.LangCode,
+		Status:        domain.ExportJobStatus(m.Status),
+		TotalRows:     m.TotalRows,
+		ProcessedRows: m.ProcessedRows,
+		Attempts:      m.Attempts,
+		FileName:      m.FileName,
+		FileURL:       m.FileURL,
+		PublicID:      m.PublicID,
+		FileSize:      m.FileSize,
+		Error:         m.Error,
+		StartedAt:     m.StartedAt,
+		FinishedAt:    m.FinishedAt,
+		ExpiresAt:     m.ExpiresAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+func ToDomainExportJobs(models []model.ExportJob) []domain.ExportJob {
+	jobs := make([]domain.ExportJob, len(models))
+	for i, m := range models {
+		jobs[i] = ToDomainExportJob(&m)
+	}
+	return jobs
+}
+
+// *==================== Entity Response conversions ====================
+// ExportJobToResponse does not fill the download link, link ditandatangani di service
+func ExportJobToResponse(d *domain.ExportJob) domain.ExportJobResponse {
+	response := domain.ExportJobResponse{
+		ID:            d.ID,
+		EntityType:    d.EntityType,
+		Format:        d.Format,
+		SearchQuery:   d.SearchQuery,
+		Filters:       d.Filters,
+		Sort:          d.Sort,
+		LangCode:      d.LangCode,
+		Status:        d.Status,
+		TotalRows:     d.TotalRows,
+		ProcessedRows: d.ProcessedRows,
+		FileName:      d.FileName,
+		FileSize:      d.FileSize,
+		Error:         d.Error,
+		StartedAt:     d.StartedAt,
+		FinishedAt:    d.FinishedAt,
+		ExpiresAt:     d.ExpiresAt,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+
+	if d.Status == domain.ExportJobStatusSucceeded {
+		progress := 100
+		response.Progress = &progress
+	} else if d.TotalRows != nil {
+		progress := 100
+		if *d.TotalRows > 0 {
+			progress = min(d.ProcessedRows*100 / *d.TotalRows, 100)
+		}
+		response.Progress = &progress
+	}
+
+	return response
+}
+
+func ExportJobsToResponses(jobs []domain.ExportJob) []domain.ExportJobResponse {
+	responses := make([]domain.ExportJobResponse, len(jobs))
+	for i, job := range jobs {
+		responses[i] = ExportJobToResponse(&job)
+	}
+	return responses
+}
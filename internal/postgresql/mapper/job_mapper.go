@@ -0,0 +1,80 @@
+package mapper
+
+import (
+	"encoding/json"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+)
+
+// *==================== Model conversions ====================
+func ToModelJobForCreate(d *domain.Job) model.Job {
+	return model.Job{
+		Type:        string(d.Type),
+		Payload:     d.Payload,
+		Status:      string(d.Status),
+		MaxAttempts: d.MaxAttempts,
+		RunAt:       d.RunAt,
+	}
+}
+
+// *==================== Entity conversions ====================
+func ToDomainJob(m *model.Job) domain.Job {
+	return domain.Job{
+		ID:          m.ID.String(),
+		Type:        domain.JobType(m.Type),
+		Payload:     m.Payload,
+		Status:      domain.JobStatus(m.Status),
+		Attempts:    m.Attempts,
+		MaxAttempts: m.MaxAttempts,
+		RunAt:       m.RunAt,
+		LockedBy:    m.LockedBy,
+		LockedUntil: m.LockedUntil,
+		LastError:   m.LastError,
+		CompletedAt: m.CompletedAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func ToDomainJobs(models []model.Job) []domain.Job {
+	jobs := make([]domain.Job, len(models))
+	for i, m := range models {
+		jobs[i] = ToDomainJob(&m)
+	}
+	return jobs
+}
+
+// *==================== Entity Response conversions ====================
+func JobToResponse(d *domain.Job) domain.JobResponse {
+	return domain.JobResponse{
+		ID:          d.ID,
+		Type:        d.Type,
+		Payload:     json.RawMessage(d.Payload),
+		Status:      d.Status,
+		Attempts:    d.Attempts,
+		MaxAttempts: d.MaxAttempts,
+		RunAt:       d.RunAt,
+		LockedBy:    d.LockedBy,
+		LockedUntil: d.LockedUntil,
+		LastError:   d.LastError,
+		CompletedAt: d.CompletedAt,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+func JobsToResponses(jobs []domain.Job) []domain.JobResponse {
+	responses := make([]domain.JobResponse, len(jobs))
+	for i, job := range jobs {
+		responses[i] = JobToResponse(&job)
+	}
+	return responses
+}
+
+func JobStatisticsToResponse(stats *domain.JobStatistics) domain.JobStatisticsResponse {
+	return domain.JobStatisticsResponse{
+		ByStatus: stats.ByStatus,
+		ByType:   stats.ByType,
+	}
+}
@@ -73,6 +73,24 @@ func ToModelLocationTranslationForCreate(locationID string, d *domain.LocationTr
 	return modelTranslation
 }
 
+func ToModelUserLocations(userId string, locationIds []string) []model.UserLocation {
+	parsedUserID, err := ulid.Parse(userId)
+	if err != nil {
+		return []model.UserLocation{}
+	}
+
+	modelUserLocations := make([]model.UserLocation, 0, len(locationIds))
+	for _, locationId := range locationIds {
+		if parsedLocationID, err := ulid.Parse(locationId); err == nil {
+			modelUserLocations = append(modelUserLocations, model.UserLocation{
+				UserID:     model.SQLULID(parsedUserID),
+				LocationID: model.SQLULID(parsedLocationID),
+			})
+		}
+	}
+	return modelUserLocations
+}
+
 // *==================== Entity conversions ====================
 func ToDomainLocation(m *model.Location) domain.Location {
 	domainLocation := domain.Location{
@@ -169,6 +169,10 @@ func ToDomainMaintenanceRecord(m *model.MaintenanceRecord) domain.MaintenanceRec
 		}
 	}
 
+	if len(m.StockUsages) > 0 {
+		domainRecord.StockUsages = ToDomainStockTransactions(m.StockUsages)
+	}
+
 	return domainRecord
 }
 
@@ -209,6 +213,7 @@ func MaintenanceRecordToResponse(d *domain.MaintenanceRecord, langCode string) d
 		CreatedAt:         d.CreatedAt,
 		UpdatedAt:         d.UpdatedAt,
 		Translations:      make([]domain.MaintenanceRecordTranslationResponse, len(d.Translations)),
+		StockUsages:       StockTransactionsToResponses(d.StockUsages, langCode),
 	}
 
 	// Populate Schedule if available
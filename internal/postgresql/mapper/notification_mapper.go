@@ -329,6 +329,7 @@ func NotificationStatisticsToResponse(stats *domain.NotificationStatistics) doma
 			StatusChange:   stats.ByType.StatusChange,
 			LocationChange: stats.ByType.LocationChange,
 			CategoryChange: stats.ByType.CategoryChange,
+			LowStock:       stats.ByType.LowStock,
 		},
 		ByStatus: domain.NotificationStatusStatisticsResponse{
 			Read:   stats.ByStatus.Read,
@@ -0,0 +1,99 @@
+package mapper
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelNotificationPreference(d *domain.NotificationPreference) model.NotificationPreference {
+	modelPreference := model.NotificationPreference{
+		Type:  d.Type,
+		InApp: d.InApp,
+		Push:  d.Push,
+		Email: d.Email,
+	}
+
+	if parsedUserID, err := ulid.Parse(d.UserID); err == nil {
+		modelPreference.UserID = model.SQLULID(parsedUserID)
+	}
+
+	return modelPreference
+}
+
+func ToModelUserNotificationSettings(userId string, emailDigest bool) model.UserNotificationSettings {
+	modelSettings := model.UserNotificationSettings{
+		EmailDigest: emailDigest,
+	}
+
+	if parsedUserID, err := ulid.Parse(userId); err == nil {
+		modelSettings.UserID = model.SQLULID(parsedUserID)
+	}
+
+	return modelSettings
+}
+
+// *==================== Entity conversions ====================
+func ToDomainNotificationPreference(m *model.NotificationPreference) domain.NotificationPreference {
+	return domain.NotificationPreference{
+		UserID:    m.UserID.String(),
+		Type:      m.Type,
+		InApp:     m.InApp,
+		Push:      m.Push,
+		Email:     m.Email,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func ToDomainNotificationPreferences(models []model.NotificationPreference) []domain.NotificationPreference {
+	preferences := make([]domain.NotificationPreference, len(models))
+	for i := range models {
+		preferences[i] = ToDomainNotificationPreference(&models[i])
+	}
+	return preferences
+}
+
+func ToDomainUserNotificationSettings(m *model.UserNotificationSettings) domain.UserNotificationSettings {
+	return domain.UserNotificationSettings{
+		UserID:           m.UserID.String(),
+		EmailDigest:      m.EmailDigest,
+		LastDigestSentAt: m.LastDigestSentAt,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
+
+// *==================== Entity Response conversions ====================
+
+// NotificationPreferencesToResponse fills every notification type, tipe yang belum diatur memakai default
+func NotificationPreferencesToResponse(userId string, preferences []domain.NotificationPreference, settings *domain.UserNotificationSettings) domain.NotificationPreferencesResponse {
+	byType := make(map[domain.NotificationType]domain.NotificationPreference, len(preferences))
+	for _, preference := range preferences {
+		byType[preference.Type] = preference
+	}
+
+	response := domain.NotificationPreferencesResponse{
+		Preferences: make([]domain.NotificationPreferenceResponse, 0, len(domain.NotificationTypes)),
+	}
+	for _, notificationType := range domain.NotificationTypes {
+		preference, ok := byType[notificationType]
+		if !ok {
+			preference = domain.DefaultNotificationPreference(userId, notificationType)
+		}
+		response.Preferences = append(response.Preferences, domain.NotificationPreferenceResponse{
+			Type:  preference.Type,
+			InApp: preference.InApp,
+			Push:  preference.Push,
+			Email: preference.Email,
+		})
+	}
+
+	if settings != nil {
+		response.EmailDigest = settings.EmailDigest
+		response.LastDigestSentAt = settings.LastDigestSentAt
+	}
+
+	return response
+}
@@ -0,0 +1,79 @@
+package mapper
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelOIDCAuthRequestForCreate(d *domain.OIDCAuthRequest) model.OIDCAuthRequest {
+	return model.OIDCAuthRequest{
+		StateHash:    d.StateHash,
+		CodeVerifier: d.CodeVerifier,
+		Nonce:        d.Nonce,
+		DeviceName:   d.DeviceName,
+		RequestedIP:  d.RequestedIP,
+		ExpiresAt:    d.ExpiresAt,
+	}
+}
+
+func ToModelUserIdentityForCreate(d *domain.UserIdentity) model.UserIdentity {
+	modelIdentity := model.UserIdentity{
+		Issuer:      d.Issuer,
+		Subject:     d.Subject,
+		Email:       d.Email,
+		Provisioned: d.Provisioned,
+		SyncedRole:  d.SyncedRole,
+		LastLoginAt: d.LastLoginAt,
+	}
+
+	if parsedUserID, err := ulid.Parse(d.UserID); err == nil {
+		modelIdentity.UserID = model.SQLULID(parsedUserID)
+	}
+
+	return modelIdentity
+}
+
+// *==================== Entity conversions ====================
+func ToDomainOIDCAuthRequest(m *model.OIDCAuthRequest) domain.OIDCAuthRequest {
+	return domain.OIDCAuthRequest{
+		ID:           m.ID.String(),
+		StateHash:    m.StateHash,
+		CodeVerifier: m.CodeVerifier,
+		Nonce:        m.Nonce,
+		DeviceName:   m.DeviceName,
+		RequestedIP:  m.RequestedIP,
+		ExpiresAt:    m.ExpiresAt,
+		ConsumedAt:   m.ConsumedAt,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func ToDomainUserIdentity(m *model.UserIdentity) domain.UserIdentity {
+	return domain.UserIdentity{
+		ID:                    m.ID.String(),
+		UserID:                m.UserID.String(),
+		Issuer:                m.Issuer,
+		Subject:               m.Subject,
+		Email:                 m.Email,
+		Provisioned:           m.Provisioned,
+		SyncedRole:            m.SyncedRole,
+		SyncDeactivatedAt:     m.SyncDeactivatedAt,
+		SyncDeactivatedReason: m.SyncDeactivatedReason,
+		LastLoginAt:           m.LastLoginAt,
+		CreatedAt:             m.CreatedAt,
+		UpdatedAt:             m.UpdatedAt,
+	}
+}
+
+func ToDomainUserIdentities(models []model.UserIdentity) []domain.UserIdentity {
+	if len(models) == 0 {
+		return []domain.UserIdentity{}
+	}
+	identities := make([]domain.UserIdentity, len(models))
+	for i, m := range models {
+		identities[i] = ToDomainUserIdentity(&m)
+	}
+	return identities
+}
@@ -0,0 +1,48 @@
+package mapper
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelPasswordResetCodeForCreate(d *domain.PasswordResetCode) model.PasswordResetCode {
+	modelCode := model.PasswordResetCode{
+		Email:       d.Email,
+		CodeHash:    d.CodeHash,
+		RequestedIP: d.RequestedIP,
+		ExpiresAt:   d.ExpiresAt,
+		UsedAt:      d.UsedAt,
+	}
+
+	if d.UserID != nil && *d.UserID != "" {
+		if parsedUserID, err := ulid.Parse(*d.UserID); err == nil {
+			modelULID := model.SQLULID(parsedUserID)
+			modelCode.UserID = &modelULID
+		}
+	}
+
+	return modelCode
+}
+
+// *==================== Entity conversions ====================
+func ToDomainPasswordResetCode(m *model.PasswordResetCode) domain.PasswordResetCode {
+	domainCode := domain.PasswordResetCode{
+		ID:          m.ID.String(),
+		Email:       m.Email,
+		CodeHash:    m.CodeHash,
+		Attempts:    m.Attempts,
+		RequestedIP: m.RequestedIP,
+		ExpiresAt:   m.ExpiresAt,
+		UsedAt:      m.UsedAt,
+		CreatedAt:   m.CreatedAt,
+	}
+
+	if m.UserID != nil && !m.UserID.IsZero() {
+		userIDStr := m.UserID.String()
+		domainCode.UserID = &userIDStr
+	}
+
+	return domainCode
+}
@@ -0,0 +1,121 @@
+package mapper
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelRoleForCreate(d *domain.Role) model.Role {
+	return model.Role{
+		Name:        d.Name,
+		Description: d.Description,
+		IsSystem:    d.IsSystem,
+	}
+}
+
+func ToModelRolePermissions(roleId string, permissions []domain.Permission) []model.RolePermission {
+	parsedRoleID, err := ulid.Parse(roleId)
+	if err != nil {
+		return []model.RolePermission{}
+	}
+
+	modelPermissions := make([]model.RolePermission, 0, len(permissions))
+	seen := make(map[domain.Permission]struct{}, len(permissions))
+	for _, permission := range permissions {
+		if _, exists := seen[permission]; exists {
+			continue
+		}
+		seen[permission] = struct{}{}
+		modelPermissions = append(modelPermissions, model.RolePermission{
+			RoleID:     model.SQLULID(parsedRoleID),
+			Permission: string(permission),
+		})
+	}
+	return modelPermissions
+}
+
+func ToModelUserRoles(userId string, roleIds []string) []model.UserRole {
+	parsedUserID, err := ulid.Parse(userId)
+	if err != nil {
+		return []model.UserRole{}
+	}
+
+	modelUserRoles := make([]model.UserRole, 0, len(roleIds))
+	for _, roleId := range roleIds {
+		if parsedRoleID, err := ulid.Parse(roleId); err == nil {
+			modelUserRoles = append(modelUserRoles, model.UserRole{
+				UserID: model.SQLULID(parsedUserID),
+				RoleID: model.SQLULID(parsedRoleID),
+			})
+		}
+	}
+	return modelUserRoles
+}
+
+// *==================== Entity conversions ====================
+func ToDomainRole(m *model.Role) domain.Role {
+	domainRole := domain.Role{
+		ID:          m.ID.String(),
+		Name:        m.Name,
+		Description: m.Description,
+		IsSystem:    m.IsSystem,
+		Permissions: make([]domain.Permission, len(m.Permissions)),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+
+	for i, permission := range m.Permissions {
+		domainRole.Permissions[i] = domain.Permission(permission.Permission)
+	}
+
+	return domainRole
+}
+
+func ToDomainRoles(models []model.Role) []domain.Role {
+	roles := make([]domain.Role, len(models))
+	for i, m := range models {
+		roles[i] = ToDomainRole(&m)
+	}
+	return roles
+}
+
+// *==================== Entity Response conversions ====================
+func RoleToResponse(d *domain.Role) domain.RoleResponse {
+	return domain.RoleResponse{
+		ID:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		IsSystem:    d.IsSystem,
+		Permissions: d.Permissions,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+func RolesToResponses(roles []domain.Role) []domain.RoleResponse {
+	responses := make([]domain.RoleResponse, len(roles))
+	for i, role := range roles {
+		responses[i] = RoleToResponse(&role)
+	}
+	return responses
+}
+
+// *==================== Update Map conversions (Harus snake case karena untuk database) ====================
+func ToModelRoleUpdateMap(payload *domain.UpdateRolePayload) map[string]any {
+	updates := make(map[string]any)
+
+	if payload.Name != nil {
+		updates["name"] = *payload.Name
+	}
+	if payload.Description != nil {
+		if *payload.Description == "" {
+			updates["description"] = nil
+		} else {
+			updates["description"] = *payload.Description
+		}
+	}
+
+	return updates
+}
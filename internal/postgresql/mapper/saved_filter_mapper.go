@@ -0,0 +1,300 @@
+package mapper
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelSavedFilterForCreate(d *domain.SavedFilter) model.SavedFilter {
+	modelFilter := model.SavedFilter{
+		EntityType:  string(d.EntityType),
+		Name:        d.Name,
+		SearchQuery: d.SearchQuery,
+		Filters:     toModelRawJSON(d.Filters),
+		Sort:        toModelRawJSON(d.Sort),
+	}
+
+	if parsedUserID, err := ulid.Parse(d.UserID); err == nil {
+		modelFilter.UserID = model.SQLULID(parsedUserID)
+	}
+
+	return modelFilter
+}
+
+func ToModelReportScheduleForUpsert(d *domain.ReportSchedule) model.ReportSchedule {
+	modelSchedule := model.ReportSchedule{
+		CronExpression: d.CronExpression,
+		Timezone:       d.Timezone,
+		Format:         string(d.Format),
+		Recipients:     toModelRecipients(d.Recipients),
+		LangCode:       d.LangCode,
+		IsActive:       d.IsActive,
+		NextRunAt:      d.NextRunAt,
+	}
+
+	if parsedSavedFilterID, err := ulid.Parse(d.SavedFilterID); err == nil {
+		modelSchedule.SavedFilterID = model.SQLULID(parsedSavedFilterID)
+	}
+
+	return modelSchedule
+}
+
+func ToModelReportRunForCreate(d *domain.ReportRun) model.ReportRun {
+	modelRun := model.ReportRun{
+		TriggerType:         string(d.Trigger),
+		Status:              string(d.Status),
+		Format:              string(d.Format),
+		Recipients:          toModelRecipients(d.Recipients),
+		LangCode:            d.LangCode,
+		DeliveredRecipients: toModelRecipients(nil),
+	}
+
+	if parsedSavedFilterID, err := ulid.Parse(d.SavedFilterID); err == nil {
+		modelRun.SavedFilterID = model.SQLULID(parsedSavedFilterID)
+	}
+
+	if d.CreatedBy != nil && *d.CreatedBy != "" {
+		if parsedCreatedBy, err := ulid.Parse(*d.CreatedBy); err == nil {
+			modelULID := model.SQLULID(parsedCreatedBy)
+			modelRun.CreatedBy = &modelULID
+		}
+	}
+
+	return modelRun
+}
+
+// *==================== Entity conversions ====================
+func ToDomainSavedFilter(m *model.SavedFilter) domain.SavedFilter {
+	domainFilter := domain.SavedFilter{
+		ID:          m.ID.String(),
+		UserID:      m.UserID.String(),
+		EntityType:  domain.SavedFilterEntityType(m.EntityType),
+		Name:        m.Name,
+		SearchQuery: m.SearchQuery,
+		Filters:     toRawJSON(m.Filters),
+		Sort:        toRawJSON(m.Sort),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+
+	if m.Schedule != nil && !m.Schedule.ID.IsZero() {
+		schedule := ToDomainReportSchedule(m.Schedule)
+		domainFilter.Schedule = &schedule
+	}
+
+	return domainFilter
+}
+
+func ToDomainSavedFilters(models []model.SavedFilter) []domain.SavedFilter {
+	filters := make([]domain.SavedFilter, len(models))
+	for i, m := range models {
+		filters[i] = ToDomainSavedFilter(&m)
+	}
+	return filters
+}
+
+func ToDomainReportSchedule(m *model.ReportSchedule) domain.ReportSchedule {
+	return domain.ReportSchedule{
+		ID:             m.ID.String(),
+		SavedFilterID:  m.SavedFilterID.String(),
+		CronExpression: m.CronExpression,
+		Timezone:       m.Timezone,
+		Format:         domain.ExportFormat(m.Format),
+		Recipients:     toDomainRecipients(m.Recipients),
+		LangCode:       m.LangCode,
+		IsActive:       m.IsActive,
+		NextRunAt:      m.NextRunAt,
+		LastRunAt:      m.LastRunAt,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func ToDomainReportSchedules(models []model.ReportSchedule) []domain.ReportSchedule {
+	schedules := make([]domain.ReportSchedule, len(models))
+	for i, m := range models {
+		schedules[i] = ToDomainReportSchedule(&m)
+	}
+	return schedules
+}
+
+func ToDomainReportRun(m *model.ReportRun) domain.ReportRun {
+	domainRun := domain.ReportRun{
+		ID:                  m.ID.String(),
+		SavedFilterID:       m.SavedFilterID.String(),
+		Trigger:             domain.ReportRunTrigger(m.TriggerType),
+		Status:              domain.ReportRunStatus(m.Status),
+		Format:              domain.ExportFormat(m.Format),
+		Recipients:          toDomainRecipients(m.Recipients),
+		DeliveredRecipients: toDomainRecipients(m.DeliveredRecipients),
+		LangCode:            m.LangCode,
+		Attempts:            m.Attempts,
+		FileName:            m.FileName,
+		FileURL:             m.FileURL,
+		PublicID:            m.PublicID,
+		FileSize:            m.FileSize,
+		Error:               m.Error,
+		StartedAt:           m.StartedAt,
+		FinishedAt:          m.FinishedAt,
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
+	}
+
+	if m.CreatedBy != nil && !m.CreatedBy.IsZero() {
+		createdByStr := m.CreatedBy.String()
+		domainRun.CreatedBy = &createdByStr
+	}
+
+	return domainRun
+}
+
+func ToDomainReportRuns(models []model.ReportRun) []domain.ReportRun {
+	runs := make([]domain.ReportRun, len(models))
+	for i, m := range models {
+		runs[i] = ToDomainReportRun(&m)
+	}
+	return runs
+}
+
+// *==================== Entity Response conversions ====================
+func SavedFilterToResponse(d *domain.SavedFilter) domain.SavedFilterResponse {
+	response := domain.SavedFilterResponse{
+		ID:          d.ID,
+		UserID:      d.UserID,
+		EntityType:  d.EntityType,
+		Name:        d.Name,
+		SearchQuery: d.SearchQuery,
+		Filters:     d.Filters,
+		Sort:        d.Sort,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+
+	if d.Schedule != nil {
+		schedule := ReportScheduleToResponse(d.Schedule)
+		response.Schedule = &schedule
+	}
+
+	return response
+}
+
+func SavedFiltersToResponses(filters []domain.SavedFilter) []domain.SavedFilterResponse {
+	responses := make([]domain.SavedFilterResponse, len(filters))
+	for i, filter := range filters {
+		responses[i] = SavedFilterToResponse(&filter)
+	}
+	return responses
+}
+
+func ReportScheduleToResponse(d *domain.ReportSchedule) domain.ReportScheduleResponse {
+	return domain.ReportScheduleResponse{
+		ID:             d.ID,
+		CronExpression: d.CronExpression,
+		Timezone:       d.Timezone,
+		Format:         d.Format,
+		Recipients:     d.Recipients,
+		LangCode:       d.LangCode,
+		IsActive:       d.IsActive,
+		NextRunAt:      d.NextRunAt,
+		LastRunAt:      d.LastRunAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+func ReportRunToResponse(d *domain.ReportRun) domain.ReportRunResponse {
+	return domain.ReportRunResponse{
+		ID:                  d.ID,
+		SavedFilterID:       d.SavedFilterID,
+		Trigger:             d.Trigger,
+		Status:              d.Status,
+		Format:              d.Format,
+		Recipients:          d.Recipients,
+		DeliveredRecipients: d.DeliveredRecipients,
+		LangCode:            d.LangCode,
+		Attempts:            d.Attempts,
+		FileName:            d.FileName,
+		FileSize:            d.FileSize,
+		HasFile:             d.FileURL != nil && *d.FileURL != "",
+		Error:               d.Error,
+		StartedAt:           d.StartedAt,
+		FinishedAt:          d.FinishedAt,
+		CreatedBy:           d.CreatedBy,
+		CreatedAt:           d.CreatedAt,
+		UpdatedAt:           d.UpdatedAt,
+	}
+}
+
+func ReportRunsToResponses(runs []domain.ReportRun) []domain.ReportRunResponse {
+	responses := make([]domain.ReportRunResponse, len(runs))
+	for i, run := range runs {
+		responses[i] = ReportRunToResponse(&run)
+	}
+	return responses
+}
+
+// *==================== Update Map conversions (Harus snake case karena untuk database) ====================
+func ToModelSavedFilterUpdateMap(payload *domain.UpdateSavedFilterPayload) map[string]any {
+	updates := make(map[string]any)
+
+	if payload.Name != nil {
+		updates["name"] = *payload.Name
+	}
+	if payload.SearchQuery != nil {
+		if *payload.SearchQuery == "" {
+			updates["search_query"] = nil
+		} else {
+			updates["search_query"] = *payload.SearchQuery
+		}
+	}
+	if payload.Filters != nil {
+		updates["filters"] = toModelRawJSON(payload.Filters)
+	}
+	if payload.Sort != nil {
+		updates["sort"] = toModelRawJSON(payload.Sort)
+	}
+
+	return updates
+}
+
+// toModelRawJSON stores a JSON object as is, null dan object kosong disimpan sebagai NULL
+func toModelRawJSON(raw json.RawMessage) *string {
+	value := strings.TrimSpace(string(raw))
+	if value == "" || value == "null" || value == "{}" {
+		return nil
+	}
+	return &value
+}
+
+func toRawJSON(value *string) json.RawMessage {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return json.RawMessage(*value)
+}
+
+func toModelRecipients(recipients []string) string {
+	if len(recipients) == 0 {
+		return "[]"
+	}
+	recipientsJSON, err := json.Marshal(recipients)
+	if err != nil {
+		return "[]"
+	}
+	return string(recipientsJSON)
+}
+
+func toDomainRecipients(recipients string) []string {
+	result := []string{}
+	if recipients != "" {
+		if err := json.Unmarshal([]byte(recipients), &result); err != nil {
+			return []string{}
+		}
+	}
+	return result
+}
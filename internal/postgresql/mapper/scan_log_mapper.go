@@ -37,6 +37,13 @@ func ToModelScanLog(d *domain.ScanLog) model.ScanLog {
 		}
 	}
 
+	if d.AuditSessionID != nil && *d.AuditSessionID != "" {
+		if parsedAuditSessionID, err := ulid.Parse(*d.AuditSessionID); err == nil {
+			modelULID := model.SQLULID(parsedAuditSessionID)
+			modelScanLog.AuditSessionID = &modelULID
+		}
+	}
+
 	return modelScanLog
 }
 
@@ -63,6 +70,13 @@ func ToModelScanLogForCreate(d *domain.ScanLog) model.ScanLog {
 		}
 	}
 
+	if d.AuditSessionID != nil && *d.AuditSessionID != "" {
+		if parsedAuditSessionID, err := ulid.Parse(*d.AuditSessionID); err == nil {
+			modelULID := model.SQLULID(parsedAuditSessionID)
+			modelScanLog.AuditSessionID = &modelULID
+		}
+	}
+
 	return modelScanLog
 }
 
@@ -84,6 +98,11 @@ func ToDomainScanLog(m *model.ScanLog) domain.ScanLog {
 		scanLog.AssetID = &assetIDStr
 	}
 
+	if m.AuditSessionID != nil && !m.AuditSessionID.IsZero() {
+		auditSessionIDStr := m.AuditSessionID.String()
+		scanLog.AuditSessionID = &auditSessionIDStr
+	}
+
 	return scanLog
 }
 
@@ -110,6 +129,7 @@ func ScanLogToResponse(d *domain.ScanLog) domain.ScanLogResponse {
 		ScanLocationLat: d.ScanLocationLat,
 		ScanLocationLng: d.ScanLocationLng,
 		ScanResult:      d.ScanResult,
+		AuditSessionID:  d.AuditSessionID,
 	}
 }
 
@@ -135,6 +155,7 @@ func ScanLogToListResponse(d *domain.ScanLog) domain.ScanLogListResponse {
 		ScanLocationLat: d.ScanLocationLat,
 		ScanLocationLng: d.ScanLocationLng,
 		ScanResult:      d.ScanResult,
+		AuditSessionID:  d.AuditSessionID,
 	}
 }
 
@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+)
+
+// *==================== Entity Response conversions ====================
+func SearchHitToResponse(d *domain.SearchHit) domain.SearchHitResponse {
+	return domain.SearchHitResponse{
+		ID:       d.ID,
+		Title:    d.Title,
+		Subtitle: d.Subtitle,
+		Snippet:  d.Snippet,
+		Rank:     d.Rank,
+	}
+}
+
+func SearchHitsToResponses(hits []domain.SearchHit) []domain.SearchHitResponse {
+	responses := make([]domain.SearchHitResponse, len(hits))
+	for i, hit := range hits {
+		responses[i] = SearchHitToResponse(&hit)
+	}
+	return responses
+}
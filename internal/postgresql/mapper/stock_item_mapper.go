@@ -0,0 +1,375 @@
+package mapper
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelStockItemForCreate(d *domain.StockItem) model.StockItem {
+	return model.StockItem{
+		SKU:          d.SKU,
+		ItemName:     d.ItemName,
+		Description:  d.Description,
+		Unit:         d.Unit,
+		ReorderPoint: d.ReorderPoint,
+		UnitCost:     d.UnitCost,
+		IsActive:     d.IsActive,
+	}
+}
+
+func ToModelStockLevelForCreate(itemId string, locationId string, quantity int) model.StockLevel {
+	modelLevel := model.StockLevel{
+		Quantity: quantity,
+	}
+
+	if parsedItemID, err := ulid.Parse(itemId); err == nil {
+		modelLevel.ItemID = model.SQLULID(parsedItemID)
+	}
+
+	if parsedLocationID, err := ulid.Parse(locationId); err == nil {
+		modelLevel.LocationID = model.SQLULID(parsedLocationID)
+	}
+
+	return modelLevel
+}
+
+func ToModelStockTransactionForCreate(d *domain.StockTransaction) model.StockTransaction {
+	modelTransaction := model.StockTransaction{
+		Type:     d.Type,
+		Quantity: d.Quantity,
+		UnitCost: d.UnitCost,
+		Notes:    d.Notes,
+	}
+
+	if d.ItemID != "" {
+		if parsedItemID, err := ulid.Parse(d.ItemID); err == nil {
+			modelTransaction.ItemID = model.SQLULID(parsedItemID)
+		}
+	}
+
+	if d.FromLocationID != nil && *d.FromLocationID != "" {
+		if parsedLocationID, err := ulid.Parse(*d.FromLocationID); err == nil {
+			modelULID := model.SQLULID(parsedLocationID)
+			modelTransaction.FromLocationID = &modelULID
+		}
+	}
+
+	if d.ToLocationID != nil && *d.ToLocationID != "" {
+		if parsedLocationID, err := ulid.Parse(*d.ToLocationID); err == nil {
+			modelULID := model.SQLULID(parsedLocationID)
+			modelTransaction.ToLocationID = &modelULID
+		}
+	}
+
+	if d.MaintenanceRecordID != nil && *d.MaintenanceRecordID != "" {
+		if parsedRecordID, err := ulid.Parse(*d.MaintenanceRecordID); err == nil {
+			modelULID := model.SQLULID(parsedRecordID)
+			modelTransaction.MaintenanceRecordID = &modelULID
+		}
+	}
+
+	if d.PerformedBy != nil && *d.PerformedBy != "" {
+		if parsedPerformedBy, err := ulid.Parse(*d.PerformedBy); err == nil {
+			modelULID := model.SQLULID(parsedPerformedBy)
+			modelTransaction.PerformedBy = &modelULID
+		}
+	}
+
+	return modelTransaction
+}
+
+// *==================== Entity conversions ====================
+func ToDomainStockItem(m *model.StockItem) domain.StockItem {
+	domainItem := domain.StockItem{
+		ID:           m.ID.String(),
+		SKU:          m.SKU,
+		ItemName:     m.ItemName,
+		Description:  m.Description,
+		Unit:         m.Unit,
+		ReorderPoint: m.ReorderPoint,
+		UnitCost:     m.UnitCost,
+		IsActive:     m.IsActive,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+
+	if len(m.Levels) > 0 {
+		domainItem.Levels = make([]domain.StockLevel, len(m.Levels))
+		for i, level := range m.Levels {
+			domainItem.Levels[i] = ToDomainStockLevel(&level)
+		}
+	}
+
+	return domainItem
+}
+
+func ToDomainStockItems(models []model.StockItem) []domain.StockItem {
+	if len(models) == 0 {
+		return []domain.StockItem{}
+	}
+	items := make([]domain.StockItem, len(models))
+	for i, m := range models {
+		items[i] = ToDomainStockItem(&m)
+	}
+	return items
+}
+
+func ToDomainStockLevel(m *model.StockLevel) domain.StockLevel {
+	domainLevel := domain.StockLevel{
+		ID:         m.ID.String(),
+		ItemID:     m.ItemID.String(),
+		LocationID: m.LocationID.String(),
+		Quantity:   m.Quantity,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+
+	// Populate related entities if preloaded
+	if !m.Location.ID.IsZero() {
+		location := ToDomainLocation(&m.Location)
+		domainLevel.Location = &location
+	}
+
+	return domainLevel
+}
+
+func ToDomainStockTransaction(m *model.StockTransaction) domain.StockTransaction {
+	domainTransaction := domain.StockTransaction{
+		ID:        m.ID.String(),
+		ItemID:    m.ItemID.String(),
+		Type:      m.Type,
+		Quantity:  m.Quantity,
+		UnitCost:  m.UnitCost,
+		Notes:     m.Notes,
+		CreatedAt: m.CreatedAt,
+	}
+
+	if m.FromLocationID != nil && !m.FromLocationID.IsZero() {
+		fromLocationIDStr := m.FromLocationID.String()
+		domainTransaction.FromLocationID = &fromLocationIDStr
+	}
+
+	if m.ToLocationID != nil && !m.ToLocationID.IsZero() {
+		toLocationIDStr := m.ToLocationID.String()
+		domainTransaction.ToLocationID = &toLocationIDStr
+	}
+
+	if m.MaintenanceRecordID != nil && !m.MaintenanceRecordID.IsZero() {
+		maintenanceRecordIDStr := m.MaintenanceRecordID.String()
+		domainTransaction.MaintenanceRecordID = &maintenanceRecordIDStr
+	}
+
+	if m.PerformedBy != nil && !m.PerformedBy.IsZero() {
+		performedByStr := m.PerformedBy.String()
+		domainTransaction.PerformedBy = &performedByStr
+	}
+
+	// Populate related entities if preloaded
+	if !m.Item.ID.IsZero() {
+		item := ToDomainStockItem(&m.Item)
+		domainTransaction.Item = &item
+	}
+
+	if m.FromLocation != nil && !m.FromLocation.ID.IsZero() {
+		location := ToDomainLocation(m.FromLocation)
+		domainTransaction.FromLocation = &location
+	}
+
+	if m.ToLocation != nil && !m.ToLocation.ID.IsZero() {
+		location := ToDomainLocation(m.ToLocation)
+		domainTransaction.ToLocation = &location
+	}
+
+	if m.PerformedByUser != nil && !m.PerformedByUser.ID.IsZero() {
+		user := ToDomainUser(m.PerformedByUser)
+		domainTransaction.PerformedByUser = &user
+	}
+
+	return domainTransaction
+}
+
+func ToDomainStockTransactions(models []model.StockTransaction) []domain.StockTransaction {
+	if len(models) == 0 {
+		return []domain.StockTransaction{}
+	}
+	transactions := make([]domain.StockTransaction, len(models))
+	for i, m := range models {
+		transactions[i] = ToDomainStockTransaction(&m)
+	}
+	return transactions
+}
+
+// *==================== Entity Response conversions ====================
+func StockItemToResponse(d *domain.StockItem, langCode string) domain.StockItemResponse {
+	response := domain.StockItemResponse{
+		ID:            d.ID,
+		SKU:           d.SKU,
+		ItemName:      d.ItemName,
+		Description:   d.Description,
+		Unit:          d.Unit,
+		ReorderPoint:  d.ReorderPoint,
+		UnitCost:      domain.NewNullableDecimal2(d.UnitCost),
+		IsActive:      d.IsActive,
+		TotalQuantity: d.TotalQuantity(),
+		IsLowStock:    d.IsLowStock(),
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+		Levels:        make([]domain.StockLevelResponse, len(d.Levels)),
+	}
+
+	for i, level := range d.Levels {
+		response.Levels[i] = StockLevelToResponse(&level, langCode)
+	}
+
+	return response
+}
+
+func StockItemsToResponses(items []domain.StockItem, langCode string) []domain.StockItemResponse {
+	if len(items) == 0 {
+		return []domain.StockItemResponse{}
+	}
+	responses := make([]domain.StockItemResponse, len(items))
+	for i, item := range items {
+		responses[i] = StockItemToResponse(&item, langCode)
+	}
+	return responses
+}
+
+func StockLevelToResponse(d *domain.StockLevel, langCode string) domain.StockLevelResponse {
+	response := domain.StockLevelResponse{
+		ID:         d.ID,
+		ItemID:     d.ItemID,
+		LocationID: d.LocationID,
+		Quantity:   d.Quantity,
+		UpdatedAt:  d.UpdatedAt,
+	}
+
+	// Populate Location if available
+	if d.Location != nil {
+		locationResponse := LocationToResponse(d.Location, langCode)
+		response.Location = &locationResponse
+	}
+
+	return response
+}
+
+func StockTransactionToResponse(d *domain.StockTransaction, langCode string) domain.StockTransactionResponse {
+	response := domain.StockTransactionResponse{
+		ID:                  d.ID,
+		ItemID:              d.ItemID,
+		Type:                d.Type,
+		Quantity:            d.Quantity,
+		FromLocationID:      d.FromLocationID,
+		ToLocationID:        d.ToLocationID,
+		UnitCost:            domain.NewNullableDecimal2(d.UnitCost),
+		TotalCost:           domain.NewNullableDecimal2(StockTransactionCost(d)),
+		MaintenanceRecordID: d.MaintenanceRecordID,
+		PerformedByID:       d.PerformedBy,
+		Notes:               d.Notes,
+		CreatedAt:           d.CreatedAt,
+	}
+
+	// Populate Item summary if available
+	if d.Item != nil {
+		response.ItemSKU = d.Item.SKU
+		response.ItemName = d.Item.ItemName
+	}
+
+	// Populate locations if available
+	if d.FromLocation != nil {
+		locationResponse := LocationToResponse(d.FromLocation, langCode)
+		response.FromLocation = &locationResponse
+	}
+
+	if d.ToLocation != nil {
+		locationResponse := LocationToResponse(d.ToLocation, langCode)
+		response.ToLocation = &locationResponse
+	}
+
+	// Populate PerformedBy if available
+	if d.PerformedByUser != nil {
+		userResponse := UserToResponse(d.PerformedByUser)
+		response.PerformedBy = &userResponse
+	}
+
+	return response
+}
+
+func StockTransactionsToResponses(transactions []domain.StockTransaction, langCode string) []domain.StockTransactionResponse {
+	if len(transactions) == 0 {
+		return []domain.StockTransactionResponse{}
+	}
+	responses := make([]domain.StockTransactionResponse, len(transactions))
+	for i, transaction := range transactions {
+		responses[i] = StockTransactionToResponse(&transaction, langCode)
+	}
+	return responses
+}
+
+// StockTransactionCost returns quantity × unit cost, nil when the unit cost is unknown
+func StockTransactionCost(d *domain.StockTransaction) *float64 {
+	if d.UnitCost == nil {
+		return nil
+	}
+	total := float64(d.Quantity) * *d.UnitCost
+	return &total
+}
+
+func MapStockItemSortFieldToColumn(field domain.StockItemSortField) string {
+	columnMap := map[domain.StockItemSortField]string{
+		domain.StockItemSortBySKU:          "si.sku",
+		domain.StockItemSortByItemName:     "si.item_name",
+		domain.StockItemSortByReorderPoint: "si.reorder_point",
+		domain.StockItemSortByUnitCost:     "si.unit_cost",
+		domain.StockItemSortByCreatedAt:    "si.created_at",
+		domain.StockItemSortByUpdatedAt:    "si.updated_at",
+	}
+
+	if column, exists := columnMap[field]; exists {
+		return column
+	}
+	return "si.sku"
+}
+
+func MapStockTransactionSortFieldToColumn(field domain.StockTransactionSortField) string {
+	columnMap := map[domain.StockTransactionSortField]string{
+		domain.StockTransactionSortByQuantity:  "st.quantity",
+		domain.StockTransactionSortByCreatedAt: "st.created_at",
+	}
+
+	if column, exists := columnMap[field]; exists {
+		return column
+	}
+	return "st.created_at"
+}
+
+// *==================== Update Map conversions (Harus snake case karena untuk database) ====================
+func ToModelStockItemUpdateMap(payload *domain.UpdateStockItemPayload) map[string]any {
+	updates := make(map[string]any)
+
+	if payload.SKU != nil {
+		updates["sku"] = *payload.SKU
+	}
+	if payload.ItemName != nil {
+		updates["item_name"] = *payload.ItemName
+	}
+	if payload.Description != nil {
+		updates["description"] = payload.Description
+	}
+	if payload.Unit != nil {
+		updates["unit"] = *payload.Unit
+	}
+	if payload.ReorderPoint != nil {
+		updates["reorder_point"] = *payload.ReorderPoint
+	}
+	if payload.UnitCost != nil {
+		updates["unit_cost"] = payload.UnitCost
+	}
+	if payload.IsActive != nil {
+		updates["is_active"] = *payload.IsActive
+	}
+
+	return updates
+}
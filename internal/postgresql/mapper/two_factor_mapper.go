@@ -0,0 +1,52 @@
+package mapper
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelUserTwoFactorForCreate(userId string, secret string) model.UserTwoFactor {
+	modelTwoFactor := model.UserTwoFactor{
+		Secret: secret,
+	}
+
+	if parsedUserID, err := ulid.Parse(userId); err == nil {
+		modelTwoFactor.UserID = model.SQLULID(parsedUserID)
+	}
+
+	return modelTwoFactor
+}
+
+func ToModelUserRecoveryCodes(userId string, codeHashes []string) []model.UserRecoveryCode {
+	modelCodes := make([]model.UserRecoveryCode, 0, len(codeHashes))
+
+	var modelUserID model.SQLULID
+	if parsedUserID, err := ulid.Parse(userId); err == nil {
+		modelUserID = model.SQLULID(parsedUserID)
+	}
+
+	for _, codeHash := range codeHashes {
+		modelCodes = append(modelCodes, model.UserRecoveryCode{
+			UserID:   modelUserID,
+			CodeHash: codeHash,
+		})
+	}
+
+	return modelCodes
+}
+
+// *==================== Entity conversions ====================
+func ToDomainUserTwoFactor(m *model.UserTwoFactor) domain.UserTwoFactor {
+	return domain.UserTwoFactor{
+		UserID:         m.UserID.String(),
+		Secret:         m.Secret,
+		EnabledAt:      m.EnabledAt,
+		LastUsedStep:   m.LastUsedStep,
+		FailedAttempts: m.FailedAttempts,
+		LockedUntil:    m.LockedUntil,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
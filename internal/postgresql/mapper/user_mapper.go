@@ -1,6 +1,8 @@
 package mapper
 
 import (
+	"time"
+
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
 )
@@ -43,21 +45,23 @@ func ToModelUserForCreate(d *domain.User) model.User {
 // *==================== Entity conversions ====================
 func ToDomainUser(m *model.User) domain.User {
 	return domain.User{
-		ID:            m.ID.String(),
-		Name:          m.Name,
-		Email:         m.Email,
-		PasswordHash:  m.PasswordHash,
-		FullName:      m.FullName,
-		Role:          m.Role,
-		EmployeeID:    m.EmployeeID,
-		PreferredLang: m.PreferredLang,
-		IsActive:      m.IsActive,
-		AvatarURL:     m.AvatarURL,
-		PhoneNumber:   m.PhoneNumber,
-		FCMToken:      m.FCMToken,
-		LastLogin:     m.LastLogin,
-		CreatedAt:     m.CreatedAt,
-		UpdatedAt:     m.UpdatedAt,
+		ID:                  m.ID.String(),
+		Name:                m.Name,
+		Email:               m.Email,
+		PasswordHash:        m.PasswordHash,
+		FullName:            m.FullName,
+		Role:                m.Role,
+		EmployeeID:          m.EmployeeID,
+		PreferredLang:       m.PreferredLang,
+		IsActive:            m.IsActive,
+		AvatarURL:           m.AvatarURL,
+		PhoneNumber:         m.PhoneNumber,
+		FCMToken:            m.FCMToken,
+		LastLogin:           m.LastLogin,
+		FailedLoginAttempts: m.FailedLoginAttempts,
+		LockedUntil:         m.LockedUntil,
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
 	}
 }
 
@@ -75,20 +79,23 @@ func ToDomainUsers(models []model.User) []domain.User {
 // *==================== Entity Response conversions ====================
 func UserToResponse(u *domain.User) domain.UserResponse {
 	return domain.UserResponse{
-		ID:            u.ID,
-		Name:          u.Name,
-		Email:         u.Email,
-		FullName:      u.FullName,
-		Role:          u.Role,
-		EmployeeID:    u.EmployeeID,
-		PreferredLang: u.PreferredLang,
-		IsActive:      u.IsActive,
-		AvatarURL:     u.AvatarURL,
-		PhoneNumber:   u.PhoneNumber,
-		FCMToken:      u.FCMToken,
-		LastLogin:     u.LastLogin,
-		CreatedAt:     u.CreatedAt,
-		UpdatedAt:     u.UpdatedAt,
+		ID:                  u.ID,
+		Name:                u.Name,
+		Email:               u.Email,
+		FullName:            u.FullName,
+		Role:                u.Role,
+		EmployeeID:          u.EmployeeID,
+		PreferredLang:       u.PreferredLang,
+		IsActive:            u.IsActive,
+		AvatarURL:           u.AvatarURL,
+		PhoneNumber:         u.PhoneNumber,
+		FCMToken:            u.FCMToken,
+		LastLogin:           u.LastLogin,
+		IsLocked:            u.IsLocked(time.Now()),
+		FailedLoginAttempts: u.FailedLoginAttempts,
+		LockedUntil:         u.LockedUntil,
+		CreatedAt:           u.CreatedAt,
+		UpdatedAt:           u.UpdatedAt,
 	}
 }
 
@@ -276,6 +283,9 @@ func ToModelUserUpdateMap(payload *domain.UpdateUserPayload) map[string]any {
 	if payload.AvatarURL != nil {
 		updates["avatar_url"] = payload.AvatarURL
 	}
+	if payload.PhoneNumber != nil {
+		updates["phone_number"] = payload.PhoneNumber
+	}
 	if payload.FCMToken != nil {
 		updates["fcm_token"] = payload.FCMToken
 	}
@@ -0,0 +1,89 @@
+package mapper
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelUserSessionForCreate(d *domain.UserSession) model.UserSession {
+	modelSession := model.UserSession{
+		CurrentTokenID: d.CurrentTokenID,
+		DeviceName:     d.DeviceName,
+		UserAgent:      d.UserAgent,
+		IPAddress:      d.IPAddress,
+		ExpiresAt:      d.ExpiresAt,
+		LastUsedAt:     d.LastUsedAt,
+	}
+
+	// * ID session sudah dibuat di service karena ikut ditanam di refresh token
+	if d.ID != "" {
+		if parsedID, err := ulid.Parse(d.ID); err == nil {
+			modelSession.ID = model.SQLULID(parsedID)
+		}
+	}
+
+	if d.UserID != "" {
+		if parsedUserID, err := ulid.Parse(d.UserID); err == nil {
+			modelSession.UserID = model.SQLULID(parsedUserID)
+		}
+	}
+
+	return modelSession
+}
+
+// *==================== Entity conversions ====================
+func ToDomainUserSession(m *model.UserSession) domain.UserSession {
+	domainSession := domain.UserSession{
+		ID:             m.ID.String(),
+		UserID:         m.UserID.String(),
+		CurrentTokenID: m.CurrentTokenID,
+		DeviceName:     m.DeviceName,
+		UserAgent:      m.UserAgent,
+		IPAddress:      m.IPAddress,
+		ExpiresAt:      m.ExpiresAt,
+		LastUsedAt:     m.LastUsedAt,
+		RevokedAt:      m.RevokedAt,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+
+	if m.RevokedReason != nil {
+		reason := domain.SessionRevokedReason(*m.RevokedReason)
+		domainSession.RevokedReason = &reason
+	}
+
+	return domainSession
+}
+
+func ToDomainUserSessions(models []model.UserSession) []domain.UserSession {
+	sessions := make([]domain.UserSession, len(models))
+	for i, m := range models {
+		sessions[i] = ToDomainUserSession(&m)
+	}
+	return sessions
+}
+
+// *==================== Entity Response conversions ====================
+func UserSessionToResponse(d *domain.UserSession, currentSessionId string) domain.UserSessionResponse {
+	return domain.UserSessionResponse{
+		ID:         d.ID,
+		UserID:     d.UserID,
+		DeviceName: d.DeviceName,
+		UserAgent:  d.UserAgent,
+		IPAddress:  d.IPAddress,
+		IsCurrent:  currentSessionId != "" && d.ID == currentSessionId,
+		ExpiresAt:  d.ExpiresAt,
+		LastUsedAt: d.LastUsedAt,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func UserSessionsToResponses(sessions []domain.UserSession, currentSessionId string) []domain.UserSessionResponse {
+	responses := make([]domain.UserSessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = UserSessionToResponse(&session, currentSessionId)
+	}
+	return responses
+}
@@ -0,0 +1,220 @@
+package mapper
+
+import (
+	"encoding/json"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelWebhookEndpointForCreate(d *domain.WebhookEndpoint) model.WebhookEndpoint {
+	modelEndpoint := model.WebhookEndpoint{
+		Name:        d.Name,
+		URL:         d.URL,
+		Description: d.Description,
+		Secret:      d.Secret,
+		EventTypes:  toModelWebhookEventTypes(d.EventTypes),
+		IsActive:    d.IsActive,
+	}
+
+	if d.CreatedBy != nil && *d.CreatedBy != "" {
+		if parsedCreatedBy, err := ulid.Parse(*d.CreatedBy); err == nil {
+			modelULID := model.SQLULID(parsedCreatedBy)
+			modelEndpoint.CreatedBy = &modelULID
+		}
+	}
+
+	return modelEndpoint
+}
+
+func ToModelWebhookDeliveryForCreate(d *domain.WebhookDelivery) model.WebhookDelivery {
+	modelDelivery := model.WebhookDelivery{
+		EventID:       d.EventID,
+		EventType:     string(d.EventType),
+		Payload:       d.Payload,
+		Status:        string(d.Status),
+		NextAttemptAt: d.NextAttemptAt,
+	}
+
+	if parsedEndpointID, err := ulid.Parse(d.EndpointID); err == nil {
+		modelDelivery.EndpointID = model.SQLULID(parsedEndpointID)
+	}
+
+	if d.RedeliveryOf != nil && *d.RedeliveryOf != "" {
+		if parsedRedeliveryOf, err := ulid.Parse(*d.RedeliveryOf); err == nil {
+			modelULID := model.SQLULID(parsedRedeliveryOf)
+			modelDelivery.RedeliveryOf = &modelULID
+		}
+	}
+
+	return modelDelivery
+}
+
+// *==================== Entity conversions ====================
+func ToDomainWebhookEndpoint(m *model.WebhookEndpoint) domain.WebhookEndpoint {
+	domainEndpoint := domain.WebhookEndpoint{
+		ID:          m.ID.String(),
+		Name:        m.Name,
+		URL:         m.URL,
+		Description: m.Description,
+		Secret:      m.Secret,
+		EventTypes:  toDomainWebhookEventTypes(m.EventTypes),
+		IsActive:    m.IsActive,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+
+	if m.CreatedBy != nil && !m.CreatedBy.IsZero() {
+		createdByStr := m.CreatedBy.String()
+		domainEndpoint.CreatedBy = &createdByStr
+	}
+
+	return domainEndpoint
+}
+
+func ToDomainWebhookEndpoints(models []model.WebhookEndpoint) []domain.WebhookEndpoint {
+	endpoints := make([]domain.WebhookEndpoint, len(models))
+	for i, m := range models {
+		endpoints[i] = ToDomainWebhookEndpoint(&m)
+	}
+	return endpoints
+}
+
+func ToDomainWebhookDelivery(m *model.WebhookDelivery) domain.WebhookDelivery {
+	domainDelivery := domain.WebhookDelivery{
+		ID:             m.ID.String(),
+		EndpointID:     m.EndpointID.String(),
+		EventID:        m.EventID,
+		EventType:      domain.WebhookEventType(m.EventType),
+		Payload:        m.Payload,
+		Status:         domain.WebhookDeliveryStatus(m.Status),
+		Attempts:       m.Attempts,
+		NextAttemptAt:  m.NextAttemptAt,
+		LastAttemptAt:  m.LastAttemptAt,
+		ResponseStatus: m.ResponseStatus,
+		ResponseBody:   m.ResponseBody,
+		Error:          m.Error,
+		DurationMs:     m.DurationMs,
+		DeliveredAt:    m.DeliveredAt,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+
+	if m.RedeliveryOf != nil && !m.RedeliveryOf.IsZero() {
+		redeliveryOfStr := m.RedeliveryOf.String()
+		domainDelivery.RedeliveryOf = &redeliveryOfStr
+	}
+
+	return domainDelivery
+}
+
+func ToDomainWebhookDeliveries(models []model.WebhookDelivery) []domain.WebhookDelivery {
+	deliveries := make([]domain.WebhookDelivery, len(models))
+	for i, m := range models {
+		deliveries[i] = ToDomainWebhookDelivery(&m)
+	}
+	return deliveries
+}
+
+// *==================== Entity Response conversions ====================
+func WebhookEndpointToResponse(d *domain.WebhookEndpoint) domain.WebhookEndpointResponse {
+	return domain.WebhookEndpointResponse{
+		ID:          d.ID,
+		Name:        d.Name,
+		URL:         d.URL,
+		Description: d.Description,
+		EventTypes:  d.EventTypes,
+		IsActive:    d.IsActive,
+		CreatedBy:   d.CreatedBy,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
+
+func WebhookEndpointsToResponses(endpoints []domain.WebhookEndpoint) []domain.WebhookEndpointResponse {
+	responses := make([]domain.WebhookEndpointResponse, len(endpoints))
+	for i, endpoint := range endpoints {
+		responses[i] = WebhookEndpointToResponse(&endpoint)
+	}
+	return responses
+}
+
+func WebhookDeliveryToResponse(d *domain.WebhookDelivery) domain.WebhookDeliveryResponse {
+	return domain.WebhookDeliveryResponse{
+		ID:             d.ID,
+		EndpointID:     d.EndpointID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        json.RawMessage(d.Payload),
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		ResponseBody:   d.ResponseBody,
+		Error:          d.Error,
+		DurationMs:     d.DurationMs,
+		DeliveredAt:    d.DeliveredAt,
+		RedeliveryOf:   d.RedeliveryOf,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+func WebhookDeliveriesToResponses(deliveries []domain.WebhookDelivery) []domain.WebhookDeliveryResponse {
+	responses := make([]domain.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		responses[i] = WebhookDeliveryToResponse(&delivery)
+	}
+	return responses
+}
+
+// *==================== Update Map conversions (Harus snake case karena untuk database) ====================
+func ToModelWebhookEndpointUpdateMap(payload *domain.UpdateWebhookEndpointPayload) map[string]any {
+	updates := make(map[string]any)
+
+	if payload.Name != nil {
+		updates["name"] = *payload.Name
+	}
+	if payload.URL != nil {
+		updates["url"] = *payload.URL
+	}
+	if payload.Description != nil {
+		if *payload.Description == "" {
+			updates["description"] = nil
+		} else {
+			updates["description"] = *payload.Description
+		}
+	}
+	if payload.EventTypes != nil {
+		updates["event_types"] = toModelWebhookEventTypes(payload.EventTypes)
+	}
+	if payload.IsActive != nil {
+		updates["is_active"] = *payload.IsActive
+	}
+
+	return updates
+}
+
+func toModelWebhookEventTypes(eventTypes []domain.WebhookEventType) string {
+	if len(eventTypes) == 0 {
+		return "[]"
+	}
+	eventTypesJSON, err := json.Marshal(eventTypes)
+	if err != nil {
+		return "[]"
+	}
+	return string(eventTypesJSON)
+}
+
+func toDomainWebhookEventTypes(eventTypes string) []domain.WebhookEventType {
+	result := []domain.WebhookEventType{}
+	if eventTypes != "" {
+		if err := json.Unmarshal([]byte(eventTypes), &result); err != nil {
+			return []domain.WebhookEventType{}
+		}
+	}
+	return result
+}
@@ -0,0 +1,467 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/oklog/ulid/v2"
+)
+
+// *==================== Model conversions ====================
+func ToModelWorkOrderForCreate(d *domain.WorkOrder) model.WorkOrder {
+	modelWorkOrder := model.WorkOrder{
+		Status:      d.Status,
+		Priority:    d.Priority,
+		DueDate:     d.DueDate,
+		AssignedAt:  d.AssignedAt,
+		CompletedAt: d.CompletedAt,
+	}
+
+	if modelWorkOrder.Priority == "" {
+		modelWorkOrder.Priority = domain.WorkOrderPriorityMedium
+	}
+
+	if d.ScheduleID != nil && *d.ScheduleID != "" {
+		if parsedScheduleID, err := ulid.Parse(*d.ScheduleID); err == nil {
+			modelULID := model.SQLULID(parsedScheduleID)
+			modelWorkOrder.ScheduleID = &modelULID
+		}
+	}
+
+	if d.IssueReportID != nil && *d.IssueReportID != "" {
+		if parsedIssueReportID, err := ulid.Parse(*d.IssueReportID); err == nil {
+			modelULID := model.SQLULID(parsedIssueReportID)
+			modelWorkOrder.IssueReportID = &modelULID
+		}
+	}
+
+	if d.AssetID != "" {
+		if parsedAssetID, err := ulid.Parse(d.AssetID); err == nil {
+			modelWorkOrder.AssetID = model.SQLULID(parsedAssetID)
+		}
+	}
+
+	if d.AssignedTo != nil && *d.AssignedTo != "" {
+		if parsedAssignedTo, err := ulid.Parse(*d.AssignedTo); err == nil {
+			modelULID := model.SQLULID(parsedAssignedTo)
+			modelWorkOrder.AssignedTo = &modelULID
+		}
+	}
+
+	if d.CreatedBy != nil && *d.CreatedBy != "" {
+		if parsedCreatedBy, err := ulid.Parse(*d.CreatedBy); err == nil {
+			modelULID := model.SQLULID(parsedCreatedBy)
+			modelWorkOrder.CreatedBy = &modelULID
+		}
+	}
+
+	if d.CompletedBy != nil && *d.CompletedBy != "" {
+		if parsedCompletedBy, err := ulid.Parse(*d.CompletedBy); err == nil {
+			modelULID := model.SQLULID(parsedCompletedBy)
+			modelWorkOrder.CompletedBy = &modelULID
+		}
+	}
+
+	if d.MaintenanceRecordID != nil && *d.MaintenanceRecordID != "" {
+		if parsedRecordID, err := ulid.Parse(*d.MaintenanceRecordID); err == nil {
+			modelULID := model.SQLULID(parsedRecordID)
+			modelWorkOrder.MaintenanceRecordID = &modelULID
+		}
+	}
+
+	return modelWorkOrder
+}
+
+func ToModelWorkOrderTranslationForCreate(workOrderID string, d *domain.WorkOrderTranslation) model.WorkOrderTranslation {
+	modelTranslation := model.WorkOrderTranslation{
+		LangCode:    d.LangCode,
+		Title:       d.Title,
+		Description: d.Description,
+	}
+
+	if workOrderID != "" {
+		if parsedWorkOrderID, err := ulid.Parse(workOrderID); err == nil {
+			modelTranslation.WorkOrderID = model.SQLULID(parsedWorkOrderID)
+		}
+	}
+
+	return modelTranslation
+}
+
+func ToModelWorkOrderPartForCreate(workOrderID string, d *domain.WorkOrderPart) model.WorkOrderPart {
+	modelPart := model.WorkOrderPart{
+		PartName:   d.PartName,
+		PartNumber: d.PartNumber,
+		Quantity:   d.Quantity,
+		UnitCost:   d.UnitCost,
+	}
+
+	if workOrderID != "" {
+		if parsedWorkOrderID, err := ulid.Parse(workOrderID); err == nil {
+			modelPart.WorkOrderID = model.SQLULID(parsedWorkOrderID)
+		}
+	}
+
+	return modelPart
+}
+
+// *==================== Entity conversions ====================
+func ToDomainWorkOrder(m *model.WorkOrder) domain.WorkOrder {
+	domainWorkOrder := domain.WorkOrder{
+		ID:          m.ID.String(),
+		AssetID:     m.AssetID.String(),
+		Status:      m.Status,
+		Priority:    m.Priority,
+		DueDate:     m.DueDate,
+		AssignedAt:  m.AssignedAt,
+		StartedAt:   m.StartedAt,
+		CompletedAt: m.CompletedAt,
+		CancelledAt: m.CancelledAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+
+	if m.ScheduleID != nil && !m.ScheduleID.IsZero() {
+		scheduleIDStr := m.ScheduleID.String()
+		domainWorkOrder.ScheduleID = &scheduleIDStr
+	}
+
+	if m.IssueReportID != nil && !m.IssueReportID.IsZero() {
+		issueReportIDStr := m.IssueReportID.String()
+		domainWorkOrder.IssueReportID = &issueReportIDStr
+	}
+
+	if m.AssignedTo != nil && !m.AssignedTo.IsZero() {
+		assignedToStr := m.AssignedTo.String()
+		domainWorkOrder.AssignedTo = &assignedToStr
+	}
+
+	if m.CreatedBy != nil && !m.CreatedBy.IsZero() {
+		createdByStr := m.CreatedBy.String()
+		domainWorkOrder.CreatedBy = &createdByStr
+	}
+
+	if m.CompletedBy != nil && !m.CompletedBy.IsZero() {
+		completedByStr := m.CompletedBy.String()
+		domainWorkOrder.CompletedBy = &completedByStr
+	}
+
+	if m.MaintenanceRecordID != nil && !m.MaintenanceRecordID.IsZero() {
+		recordIDStr := m.MaintenanceRecordID.String()
+		domainWorkOrder.MaintenanceRecordID = &recordIDStr
+	}
+
+	// Populate related entities if preloaded
+	if m.Schedule != nil && !m.Schedule.ID.IsZero() {
+		schedule := ToDomainMaintenanceSchedule(m.Schedule)
+		domainWorkOrder.Schedule = &schedule
+	}
+
+	if m.IssueReport != nil && !m.IssueReport.ID.IsZero() {
+		issueReport := ToDomainIssueReport(m.IssueReport)
+		domainWorkOrder.IssueReport = &issueReport
+	}
+
+	if !m.Asset.ID.IsZero() {
+		asset := ToDomainAsset(&m.Asset)
+		domainWorkOrder.Asset = &asset
+	}
+
+	if m.AssignedToUser != nil && !m.AssignedToUser.ID.IsZero() {
+		user := ToDomainUser(m.AssignedToUser)
+		domainWorkOrder.AssignedToUser = &user
+	}
+
+	if m.CompletedByUser != nil && !m.CompletedByUser.ID.IsZero() {
+		user := ToDomainUser(m.CompletedByUser)
+		domainWorkOrder.CompletedByUser = &user
+	}
+
+	if m.CreatedByUser != nil && !m.CreatedByUser.ID.IsZero() {
+		user := ToDomainUser(m.CreatedByUser)
+		domainWorkOrder.CreatedByUser = &user
+	}
+
+	if len(m.Translations) > 0 {
+		domainWorkOrder.Translations = make([]domain.WorkOrderTranslation, len(m.Translations))
+		for i, translation := range m.Translations {
+			domainWorkOrder.Translations[i] = ToDomainWorkOrderTranslation(&translation)
+		}
+	}
+
+	if len(m.Parts) > 0 {
+		domainWorkOrder.Parts = make([]domain.WorkOrderPart, len(m.Parts))
+		for i, part := range m.Parts {
+			domainWorkOrder.Parts[i] = ToDomainWorkOrderPart(&part)
+		}
+	}
+
+	return domainWorkOrder
+}
+
+func ToDomainWorkOrderTranslation(m *model.WorkOrderTranslation) domain.WorkOrderTranslation {
+	return domain.WorkOrderTranslation{
+		ID:          m.ID.String(),
+		WorkOrderID: m.WorkOrderID.String(),
+		LangCode:    m.LangCode,
+		Title:       m.Title,
+		Description: m.Description,
+	}
+}
+
+func ToDomainWorkOrderPart(m *model.WorkOrderPart) domain.WorkOrderPart {
+	return domain.WorkOrderPart{
+		ID:          m.ID.String(),
+		WorkOrderID: m.WorkOrderID.String(),
+		PartName:    m.PartName,
+		PartNumber:  m.PartNumber,
+		Quantity:    m.Quantity,
+		UnitCost:    m.UnitCost,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func ToDomainWorkOrders(models []model.WorkOrder) []domain.WorkOrder {
+	if len(models) == 0 {
+		return []domain.WorkOrder{}
+	}
+	workOrders := make([]domain.WorkOrder, len(models))
+	for i, m := range models {
+		workOrders[i] = ToDomainWorkOrder(&m)
+	}
+	return workOrders
+}
+
+// *==================== Entity Response conversions ====================
+func WorkOrderToResponse(d *domain.WorkOrder, langCode string) domain.WorkOrderResponse {
+	response := domain.WorkOrderResponse{
+		ID:                  d.ID,
+		ScheduleID:          d.ScheduleID,
+		IssueReportID:       d.IssueReportID,
+		AssetID:             d.AssetID,
+		Status:              d.Status,
+		Priority:            d.Priority,
+		DueDate:             d.DueDate,
+		AssignedToID:        d.AssignedTo,
+		AssignedAt:          d.AssignedAt,
+		StartedAt:           d.StartedAt,
+		CompletedByID:       d.CompletedBy,
+		CompletedAt:         d.CompletedAt,
+		CancelledAt:         d.CancelledAt,
+		MaintenanceRecordID: d.MaintenanceRecordID,
+		CreatedByID:         d.CreatedBy,
+		IsOverdue:           !d.Status.IsTerminal() && d.DueDate.Before(time.Now()),
+		PartsCost:           domain.NewNullableDecimal2(WorkOrderPartsCost(d.Parts)),
+		CreatedAt:           d.CreatedAt,
+		UpdatedAt:           d.UpdatedAt,
+		Translations:        make([]domain.WorkOrderTranslationResponse, len(d.Translations)),
+		Parts:               make([]domain.WorkOrderPartResponse, len(d.Parts)),
+	}
+
+	// Populate Schedule if available
+	if d.Schedule != nil {
+		scheduleResponse := MaintenanceScheduleToListResponse(d.Schedule, langCode)
+		response.Schedule = &scheduleResponse
+	}
+
+	// Populate IssueReport if available
+	if d.IssueReport != nil {
+		issueReportResponse := IssueReportToListResponse(d.IssueReport, langCode)
+		response.IssueReport = &issueReportResponse
+	}
+
+	// Populate Asset if available
+	if d.Asset != nil {
+		response.Asset = AssetToResponse(d.Asset, langCode)
+	}
+
+	// Populate AssignedTo if available
+	if d.AssignedToUser != nil {
+		userResponse := UserToResponse(d.AssignedToUser)
+		response.AssignedTo = &userResponse
+	}
+
+	// Populate CompletedBy if available
+	if d.CompletedByUser != nil {
+		userResponse := UserToResponse(d.CompletedByUser)
+		response.CompletedBy = &userResponse
+	}
+
+	// Populate CreatedBy if available
+	if d.CreatedByUser != nil {
+		userResponse := UserToResponse(d.CreatedByUser)
+		response.CreatedBy = &userResponse
+	}
+
+	// Populate parts
+	for i, part := range d.Parts {
+		var totalCost *float64
+		if part.UnitCost != nil {
+			total := *part.UnitCost * float64(part.Quantity)
+			totalCost = &total
+		}
+		response.Parts[i] = domain.WorkOrderPartResponse{
+			ID:         part.ID,
+			PartName:   part.PartName,
+			PartNumber: part.PartNumber,
+			Quantity:   part.Quantity,
+			UnitCost:   domain.NewNullableDecimal2(part.UnitCost),
+			TotalCost:  domain.NewNullableDecimal2(totalCost),
+		}
+	}
+
+	// Populate translations
+	for i, translation := range d.Translations {
+		response.Translations[i] = domain.WorkOrderTranslationResponse{
+			LangCode:    translation.LangCode,
+			Title:       translation.Title,
+			Description: translation.Description,
+		}
+	}
+
+	// Find translation for the requested language
+	for _, translation := range d.Translations {
+		if translation.LangCode == langCode {
+			response.Title = translation.Title
+			response.Description = translation.Description
+			break
+		}
+	}
+
+	// If no translation found for requested language, use first available
+	if response.Title == "" && len(d.Translations) > 0 {
+		response.Title = d.Translations[0].Title
+		response.Description = d.Translations[0].Description
+	}
+
+	return response
+}
+
+func WorkOrdersToResponses(workOrders []domain.WorkOrder, langCode string) []domain.WorkOrderResponse {
+	if len(workOrders) == 0 {
+		return []domain.WorkOrderResponse{}
+	}
+	responses := make([]domain.WorkOrderResponse, len(workOrders))
+	for i, workOrder := range workOrders {
+		responses[i] = WorkOrderToResponse(&workOrder, langCode)
+	}
+	return responses
+}
+
+// WorkOrderPartsCost sums quantity * unit cost of the parts that have a cost, nil when none have
+func WorkOrderPartsCost(parts []domain.WorkOrderPart) *float64 {
+	var total float64
+	hasCost := false
+	for _, part := range parts {
+		if part.UnitCost != nil {
+			total += *part.UnitCost * float64(part.Quantity)
+			hasCost = true
+		}
+	}
+	if !hasCost {
+		return nil
+	}
+	return &total
+}
+
+func WorkOrderStatisticsToResponse(stats *domain.WorkOrderStatistics) domain.WorkOrderStatisticsResponse {
+	resp := domain.WorkOrderStatisticsResponse{
+		Total: domain.WorkOrderCountStatisticsResponse{Count: stats.Total.Count},
+		ByStatus: domain.WorkOrderStatusStatisticsResponse{
+			Open:       stats.ByStatus.Open,
+			Assigned:   stats.ByStatus.Assigned,
+			InProgress: stats.ByStatus.InProgress,
+			OnHold:     stats.ByStatus.OnHold,
+			Done:       stats.ByStatus.Done,
+			Cancelled:  stats.ByStatus.Cancelled,
+		},
+		ByPriority: domain.WorkOrderPriorityStatisticsResponse{
+			Low:      stats.ByPriority.Low,
+			Medium:   stats.ByPriority.Medium,
+			High:     stats.ByPriority.High,
+			Critical: stats.ByPriority.Critical,
+		},
+		Summary: domain.WorkOrderSummaryStatisticsResponse{
+			TotalWorkOrders:        stats.Summary.TotalWorkOrders,
+			ActiveWorkOrders:       stats.Summary.ActiveWorkOrders,
+			OverdueWorkOrders:      stats.Summary.OverdueWorkOrders,
+			UnassignedWorkOrders:   stats.Summary.UnassignedWorkOrders,
+			FromSchedules:          stats.Summary.FromSchedules,
+			FromIssueReports:       stats.Summary.FromIssueReports,
+			CompletionRate:         domain.NewDecimal2(stats.Summary.CompletionRate),
+			AverageCompletionHours: domain.NewDecimal2(stats.Summary.AverageCompletionHours),
+			TotalPartsCost:         domain.NewDecimal2(stats.Summary.TotalPartsCost),
+		},
+	}
+
+	// ByTechnician
+	resp.ByTechnician = make([]domain.TechnicianWorkOrderStatisticsResponse, len(stats.ByTechnician))
+	for i, t := range stats.ByTechnician {
+		resp.ByTechnician[i] = domain.TechnicianWorkOrderStatisticsResponse{
+			UserID:                 t.UserID,
+			UserName:               t.UserName,
+			UserEmail:              t.UserEmail,
+			AssignedCount:          t.AssignedCount,
+			ActiveCount:            t.ActiveCount,
+			CompletedCount:         t.CompletedCount,
+			AverageCompletionHours: domain.NewDecimal2(t.AverageCompletionHours),
+		}
+	}
+
+	// CompletionTrend
+	resp.CompletionTrend = make([]domain.WorkOrderCompletionTrendResponse, len(stats.CompletionTrend))
+	for i, ct := range stats.CompletionTrend {
+		resp.CompletionTrend[i] = domain.WorkOrderCompletionTrendResponse{
+			Date:  ct.Date,
+			Count: ct.Count,
+		}
+	}
+
+	return resp
+}
+
+func MapWorkOrderSortFieldToColumn(field domain.WorkOrderSortField) string {
+	columnMap := map[domain.WorkOrderSortField]string{
+		domain.WorkOrderSortByDueDate:     "wo.due_date",
+		domain.WorkOrderSortByStatus:      "wo.status",
+		domain.WorkOrderSortByPriority:    "wo.priority",
+		domain.WorkOrderSortByCompletedAt: "wo.completed_at",
+		domain.WorkOrderSortByCreatedAt:   "wo.created_at",
+		domain.WorkOrderSortByUpdatedAt:   "wo.updated_at",
+	}
+
+	if column, exists := columnMap[field]; exists {
+		return column
+	}
+	return "wo.due_date"
+}
+
+// *==================== Update Map conversions (Harus snake case karena untuk database) ====================
+func ToModelWorkOrderUpdateMap(payload *domain.UpdateWorkOrderPayload) map[string]any {
+	updates := make(map[string]any)
+
+	if payload.Priority != nil {
+		updates["priority"] = *payload.Priority
+	}
+	if payload.DueDate != nil {
+		if parsedDate, err := time.ParseInLocation("2006-01-02", *payload.DueDate, time.UTC); err == nil {
+			updates["due_date"] = parsedDate
+		}
+	}
+
+	return updates
+}
+
+func ToModelWorkOrderTranslationUpdateMap(payload *domain.UpdateWorkOrderTranslationPayload) map[string]any {
+	updates := make(map[string]any)
+
+	if payload.Title != nil {
+		updates["title"] = *payload.Title
+	}
+	if payload.Description != nil {
+		updates["description"] = payload.Description
+	}
+
+	return updates
+}
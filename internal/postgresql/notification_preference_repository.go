@@ -0,0 +1,173 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{
+		db: db,
+	}
+}
+
+// *===========================MUTATION===========================*
+
+// UpsertNotificationPreferences saves the preferences, baris yang sudah ada untuk user dan tipe yang sama ditimpa
+func (r *NotificationPreferenceRepository) UpsertNotificationPreferences(ctx context.Context, preferences []domain.NotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	modelPreferences := make([]model.NotificationPreference, len(preferences))
+	for i := range preferences {
+		modelPreferences[i] = mapper.ToModelNotificationPreference(&preferences[i])
+	}
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"in_app", "push", "email", "updated_at"}),
+		}).
+		Create(&modelPreferences).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+
+	return nil
+}
+
+// SetEmailDigest turns digest mode on or off, saat diaktifkan window digest pertama dimulai dari sekarang
+func (r *NotificationPreferenceRepository) SetEmailDigest(ctx context.Context, userId string, enabled bool) error {
+	now := time.Now()
+	settings := mapper.ToModelUserNotificationSettings(userId, enabled)
+	if enabled {
+		settings.LastDigestSentAt = &now
+	}
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"email_digest": enabled,
+				// * Notifikasi sebelum digest diaktifkan sudah dikirim lewat email biasa
+				"last_digest_sent_at": gorm.Expr("CASE WHEN NOT user_notification_settings.email_digest AND ? THEN ? ELSE user_notification_settings.last_digest_sent_at END", enabled, now),
+				"updated_at":          now,
+			}),
+		}).
+		Create(&settings).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+
+	return nil
+}
+
+// MarkDigestSent stores the end of the window covered by the last digest
+func (r *NotificationPreferenceRepository) MarkDigestSent(ctx context.Context, userId string, sentAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.UserNotificationSettings{}).
+		Where("user_id = ?", userId).
+		Updates(map[string]any{
+			"last_digest_sent_at": sentAt,
+			"updated_at":          time.Now(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (r *NotificationPreferenceRepository) GetNotificationPreferences(ctx context.Context, userId string) ([]domain.NotificationPreference, error) {
+	var preferences []model.NotificationPreference
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userId).
+		Find(&preferences).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainNotificationPreferences(preferences), nil
+}
+
+// GetNotificationPreference returns the preference of one type, default kalau user belum mengaturnya
+func (r *NotificationPreferenceRepository) GetNotificationPreference(ctx context.Context, userId string, notificationType domain.NotificationType) (domain.NotificationPreference, error) {
+	var preference model.NotificationPreference
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND type = ?", userId, notificationType).
+		First(&preference).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.DefaultNotificationPreference(userId, notificationType), nil
+		}
+		return domain.NotificationPreference{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainNotificationPreference(&preference), nil
+}
+
+// GetUserNotificationSettings returns the settings of the user, default (digest mati) kalau belum ada
+func (r *NotificationPreferenceRepository) GetUserNotificationSettings(ctx context.Context, userId string) (domain.UserNotificationSettings, error) {
+	var settings model.UserNotificationSettings
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userId).
+		First(&settings).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.UserNotificationSettings{UserID: userId}, nil
+		}
+		return domain.UserNotificationSettings{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserNotificationSettings(&settings), nil
+}
+
+// LockUserNotificationSettings reads the settings with FOR UPDATE, harus dipanggil di dalam transaksi
+func (r *NotificationPreferenceRepository) LockUserNotificationSettings(ctx context.Context, userId string) (domain.UserNotificationSettings, error) {
+	var settings model.UserNotificationSettings
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ?", userId).
+		First(&settings).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.UserNotificationSettings{}, domain.ErrNotFound("notification settings")
+		}
+		return domain.UserNotificationSettings{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserNotificationSettings(&settings), nil
+}
+
+// GetEmailDigestUserIds returns active users that receive notification emails as a daily digest
+func (r *NotificationPreferenceRepository) GetEmailDigestUserIds(ctx context.Context) ([]string, error) {
+	var userIds []string
+
+	err := r.db.WithContext(ctx).
+		Table("user_notification_settings s").
+		Joins("JOIN users u ON u.id = s.user_id").
+		Where("s.email_digest = ? AND u.is_active = ?", true, true).
+		Pluck("s.user_id", &userIds).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return userIds, nil
+}
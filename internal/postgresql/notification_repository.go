@@ -476,6 +476,41 @@ func (r *NotificationRepository) CountNotifications(ctx context.Context, params
 	return count, nil
 }
 
+// GetDigestNotifications returns unread, unexpired notifications created in (since, until], newest first, plus the total before limit
+func (r *NotificationRepository) GetDigestNotifications(ctx context.Context, userId string, since, until time.Time, excludeTypes []domain.NotificationType, limit int) ([]domain.Notification, int64, error) {
+	// * Query dibangun ulang untuk count dan find supaya statement gorm tidak saling bocor
+	query := func() *gorm.DB {
+		db := r.db.WithContext(ctx).
+			Table("notifications n").
+			Where("n.user_id = ? AND n.is_read = ? AND n.created_at > ? AND n.created_at <= ?", userId, false, since, until).
+			Where("n.expires_at IS NULL OR n.expires_at > ?", until)
+		if len(excludeTypes) > 0 {
+			db = db.Where("n.type NOT IN ?", excludeTypes)
+		}
+		return db
+	}
+
+	var total int64
+	if err := query().Count(&total).Error; err != nil {
+		return nil, 0, domain.ErrInternal(err)
+	}
+	if total == 0 {
+		return []domain.Notification{}, 0, nil
+	}
+
+	var notifications []model.Notification
+	err := query().
+		Preload("Translations").
+		Order("n.created_at DESC").
+		Limit(limit).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, 0, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainNotifications(notifications), total, nil
+}
+
 func (r *NotificationRepository) GetNotificationStatistics(ctx context.Context) (domain.NotificationStatistics, error) {
 	var stats domain.NotificationStatistics
 
@@ -514,6 +549,8 @@ func (r *NotificationRepository) GetNotificationStatistics(ctx context.Context)
 			stats.ByType.LocationChange = int(ts.Count)
 		case domain.NotificationTypeCategoryChange:
 			stats.ByType.CategoryChange = int(ts.Count)
+		case domain.NotificationTypeLowStock:
+			stats.ByType.LowStock = int(ts.Count)
 		}
 	}
 
@@ -587,8 +624,12 @@ func (r *NotificationRepository) GetNotificationStatistics(ctx context.Context)
 		mostCommonType = "LOCATION_CHANGE"
 	}
 	if stats.ByType.CategoryChange > mostCommonCount {
+		mostCommonCount = stats.ByType.CategoryChange
 		mostCommonType = "CATEGORY_CHANGE"
 	}
+	if stats.ByType.LowStock > mostCommonCount {
+		mostCommonType = "LOW_STOCK"
+	}
 	stats.Summary.MostCommonType = mostCommonType
 
 	// Get earliest and latest creation dates
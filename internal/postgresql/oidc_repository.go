@@ -0,0 +1,197 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// * Request SSO yang sudah kedaluwarsa selama ini dihapus saat request baru dibuat
+const oidcAuthRequestRetention = 24 * time.Hour
+
+type OIDCRepository struct {
+	db *gorm.DB
+}
+
+func NewOIDCRepository(db *gorm.DB) *OIDCRepository {
+	return &OIDCRepository{
+		db: db,
+	}
+}
+
+// *===========================MUTATION===========================*
+
+func (r *OIDCRepository) CreateOIDCAuthRequest(ctx context.Context, payload *domain.OIDCAuthRequest) (domain.OIDCAuthRequest, error) {
+	if err := r.db.WithContext(ctx).
+		Where("expires_at < ?", time.Now().Add(-oidcAuthRequestRetention)).
+		Delete(&model.OIDCAuthRequest{}).Error; err != nil {
+		return domain.OIDCAuthRequest{}, domain.ErrInternal(err)
+	}
+
+	modelRequest := mapper.ToModelOIDCAuthRequestForCreate(payload)
+	if err := r.db.WithContext(ctx).Create(&modelRequest).Error; err != nil {
+		return domain.OIDCAuthRequest{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainOIDCAuthRequest(&modelRequest), nil
+}
+
+// ConsumeOIDCAuthRequest marks the request as used and returns it, false means unknown or already consumed state
+func (r *OIDCRepository) ConsumeOIDCAuthRequest(ctx context.Context, stateHash string) (domain.OIDCAuthRequest, bool, error) {
+	var consumed model.OIDCAuthRequest
+
+	result := r.db.WithContext(ctx).
+		Model(&consumed).
+		Clauses(clause.Returning{}).
+		Where("state_hash = ? AND consumed_at IS NULL", stateHash).
+		Update("consumed_at", time.Now())
+	if result.Error != nil {
+		return domain.OIDCAuthRequest{}, false, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.OIDCAuthRequest{}, false, nil
+	}
+
+	return mapper.ToDomainOIDCAuthRequest(&consumed), true, nil
+}
+
+func (r *OIDCRepository) CreateUserIdentity(ctx context.Context, payload *domain.UserIdentity) (domain.UserIdentity, error) {
+	modelIdentity := mapper.ToModelUserIdentityForCreate(payload)
+
+	if err := r.db.WithContext(ctx).Create(&modelIdentity).Error; err != nil {
+		return domain.UserIdentity{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserIdentity(&modelIdentity), nil
+}
+
+// RecordUserIdentityLogin refreshes the email reported by the provider and the last SSO login time
+func (r *OIDCRepository) RecordUserIdentityLogin(ctx context.Context, identityId string, email *string) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.UserIdentity{}).
+		Where("id = ?", identityId).
+		Updates(map[string]any{
+			"email":         email,
+			"last_login_at": time.Now().UTC(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// UpdateUserIdentitySyncedRole records the role last applied from the provider groups
+func (r *OIDCRepository) UpdateUserIdentitySyncedRole(ctx context.Context, identityId string, role domain.UserRole) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.UserIdentity{}).
+		Where("id = ?", identityId).
+		Updates(map[string]any{
+			"synced_role": role,
+			"updated_at":  time.Now().UTC(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// MarkUserIdentitySyncDeactivated records that the directory sync deactivated the user of this identity
+func (r *OIDCRepository) MarkUserIdentitySyncDeactivated(ctx context.Context, identityId string, reason string, deactivatedAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.UserIdentity{}).
+		Where("id = ?", identityId).
+		Updates(map[string]any{
+			"sync_deactivated_at":     deactivatedAt,
+			"sync_deactivated_reason": reason,
+			"updated_at":              time.Now().UTC(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// ClearUserIdentitySyncDeactivated removes the sync deactivation mark after the user is reactivated
+func (r *OIDCRepository) ClearUserIdentitySyncDeactivated(ctx context.Context, identityId string) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.UserIdentity{}).
+		Where("id = ?", identityId).
+		Updates(map[string]any{
+			"sync_deactivated_at":     nil,
+			"sync_deactivated_reason": nil,
+			"updated_at":              time.Now().UTC(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// *===========================QUERY===========================*
+
+func (r *OIDCRepository) GetUserIdentity(ctx context.Context, issuer string, subject string) (domain.UserIdentity, bool, error) {
+	var identity model.UserIdentity
+
+	err := r.db.WithContext(ctx).
+		Where("issuer = ? AND subject = ?", issuer, subject).
+		First(&identity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.UserIdentity{}, false, nil
+		}
+		return domain.UserIdentity{}, false, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserIdentity(&identity), true, nil
+}
+
+// GetUserIdentityByUserId returns the identity of the user at the given issuer, false kalau user belum terhubung
+func (r *OIDCRepository) GetUserIdentityByUserId(ctx context.Context, userId string, issuer string) (domain.UserIdentity, bool, error) {
+	var identity model.UserIdentity
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND issuer = ?", userId, issuer).
+		First(&identity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.UserIdentity{}, false, nil
+		}
+		return domain.UserIdentity{}, false, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserIdentity(&identity), true, nil
+}
+
+func (r *OIDCRepository) GetUserIdentitiesByIssuer(ctx context.Context, issuer string) ([]domain.UserIdentity, error) {
+	var identities []model.UserIdentity
+
+	err := r.db.WithContext(ctx).
+		Where("issuer = ?", issuer).
+		Order("created_at ASC").
+		Find(&identities).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserIdentities(identities), nil
+}
+
+func (r *OIDCRepository) CheckUserHasIdentity(ctx context.Context, userId string, issuer string) (bool, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&model.UserIdentity{}).
+		Where("user_id = ? AND issuer = ?", userId, issuer).
+		Count(&count).Error
+	if err != nil {
+		return false, domain.ErrInternal(err)
+	}
+
+	return count > 0, nil
+}
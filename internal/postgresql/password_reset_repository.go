@@ -0,0 +1,116 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+)
+
+type PasswordResetRepository struct {
+	db *gorm.DB
+}
+
+func NewPasswordResetRepository(db *gorm.DB) *PasswordResetRepository {
+	return &PasswordResetRepository{
+		db: db,
+	}
+}
+
+// *===========================MUTATION===========================*
+
+// CreateResetCode stores a new code and invalidates every unused code of the same email
+func (r *PasswordResetRepository) CreateResetCode(ctx context.Context, payload *domain.PasswordResetCode) (domain.PasswordResetCode, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.PasswordResetCode{}, domain.ErrInternal(tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Model(&model.PasswordResetCode{}).
+		Where("email = ? AND used_at IS NULL", payload.Email).
+		Update("used_at", time.Now()).Error; err != nil {
+		tx.Rollback()
+		return domain.PasswordResetCode{}, domain.ErrInternal(err)
+	}
+
+	modelCode := mapper.ToModelPasswordResetCodeForCreate(payload)
+	if err := tx.Create(&modelCode).Error; err != nil {
+		tx.Rollback()
+		return domain.PasswordResetCode{}, domain.ErrInternal(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.PasswordResetCode{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainPasswordResetCode(&modelCode), nil
+}
+
+// ReserveResetCodeAttempt counts a check against the code before it is compared, false means attempts are exhausted
+func (r *PasswordResetRepository) ReserveResetCodeAttempt(ctx context.Context, codeId string, maxAttempts int) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.PasswordResetCode{}).
+		Where("id = ? AND attempts < ? AND used_at IS NULL", codeId, maxAttempts).
+		Update("attempts", gorm.Expr("attempts + 1"))
+	if result.Error != nil {
+		return false, domain.ErrInternal(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// MarkResetCodeUsed consumes the code, false means another request already used it
+func (r *PasswordResetRepository) MarkResetCodeUsed(ctx context.Context, codeId string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.PasswordResetCode{}).
+		Where("id = ? AND used_at IS NULL", codeId).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, domain.ErrInternal(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// *===========================QUERY===========================*
+
+// GetActiveResetCode returns the latest unused code of the email, expired codes are returned too so the caller can tell them apart
+func (r *PasswordResetRepository) GetActiveResetCode(ctx context.Context, email string) (domain.PasswordResetCode, bool, error) {
+	var code model.PasswordResetCode
+
+	err := r.db.WithContext(ctx).
+		Where("email = ? AND used_at IS NULL", email).
+		Order("created_at DESC").
+		First(&code).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.PasswordResetCode{}, false, nil
+		}
+		return domain.PasswordResetCode{}, false, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainPasswordResetCode(&code), true, nil
+}
+
+func (r *PasswordResetRepository) CountResetCodesByEmailSince(ctx context.Context, email string, since time.Time) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.PasswordResetCode{}).Where("email = ? AND created_at >= ?", email, since).Count(&count).Error; err != nil {
+		return 0, domain.ErrInternal(err)
+	}
+	return count, nil
+}
+
+func (r *PasswordResetRepository) CountResetCodesByIPSince(ctx context.Context, ip string, since time.Time) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.PasswordResetCode{}).Where("requested_ip = ? AND created_at >= ?", ip, since).Count(&count).Error; err != nil {
+		return 0, domain.ErrInternal(err)
+	}
+	return count, nil
+}
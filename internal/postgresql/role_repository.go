@@ -0,0 +1,241 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+)
+
+type RoleRepository struct {
+	db *gorm.DB
+}
+
+func NewRoleRepository(db *gorm.DB) *RoleRepository {
+	return &RoleRepository{
+		db: db,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (r *RoleRepository) CreateRole(ctx context.Context, payload *domain.Role) (domain.Role, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.Role{}, domain.ErrInternal(tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	modelRole := mapper.ToModelRoleForCreate(payload)
+	if err := tx.Create(&modelRole).Error; err != nil {
+		tx.Rollback()
+		return domain.Role{}, domain.ErrInternal(err)
+	}
+
+	permissions := mapper.ToModelRolePermissions(modelRole.ID.String(), payload.Permissions)
+	if len(permissions) > 0 {
+		if err := tx.Create(&permissions).Error; err != nil {
+			tx.Rollback()
+			return domain.Role{}, domain.ErrInternal(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.Role{}, domain.ErrInternal(err)
+	}
+
+	return r.GetRoleById(ctx, modelRole.ID.String())
+}
+
+func (r *RoleRepository) UpdateRole(ctx context.Context, roleId string, payload *domain.UpdateRolePayload) (domain.Role, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.Role{}, domain.ErrInternal(tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	updates := mapper.ToModelRoleUpdateMap(payload)
+	updates["updated_at"] = time.Now()
+
+	result := tx.Model(&model.Role{}).Where("id = ?", roleId).Updates(updates)
+	if result.Error != nil {
+		tx.Rollback()
+		return domain.Role{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return domain.Role{}, domain.ErrNotFound("role")
+	}
+
+	// * Permission set diganti total kalau dikirim
+	if payload.Permissions != nil {
+		if err := tx.Where("role_id = ?", roleId).Delete(&model.RolePermission{}).Error; err != nil {
+			tx.Rollback()
+			return domain.Role{}, domain.ErrInternal(err)
+		}
+
+		permissions := mapper.ToModelRolePermissions(roleId, payload.Permissions)
+		if len(permissions) > 0 {
+			if err := tx.Create(&permissions).Error; err != nil {
+				tx.Rollback()
+				return domain.Role{}, domain.ErrInternal(err)
+			}
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.Role{}, domain.ErrInternal(err)
+	}
+
+	return r.GetRoleById(ctx, roleId)
+}
+
+func (r *RoleRepository) DeleteRole(ctx context.Context, roleId string) error {
+	result := r.db.WithContext(ctx).Delete(&model.Role{}, "id = ?", roleId)
+	if result.Error != nil {
+		return domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound("role")
+	}
+	return nil
+}
+
+func (r *RoleRepository) SetUserRoles(ctx context.Context, userId string, roleIds []string) error {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.ErrInternal(tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Where("user_id = ?", userId).Delete(&model.UserRole{}).Error; err != nil {
+		tx.Rollback()
+		return domain.ErrInternal(err)
+	}
+
+	userRoles := mapper.ToModelUserRoles(userId, roleIds)
+	if len(userRoles) > 0 {
+		if err := tx.Omit("Role").Create(&userRoles).Error; err != nil {
+			tx.Rollback()
+			return domain.ErrInternal(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (r *RoleRepository) GetRoles(ctx context.Context) ([]domain.Role, error) {
+	var roles []model.Role
+
+	err := r.db.WithContext(ctx).
+		Preload("Permissions").
+		Order("is_system DESC, name ASC").
+		Find(&roles).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainRoles(roles), nil
+}
+
+func (r *RoleRepository) GetRoleById(ctx context.Context, roleId string) (domain.Role, error) {
+	var role model.Role
+
+	err := r.db.WithContext(ctx).
+		Preload("Permissions").
+		First(&role, "id = ?", roleId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Role{}, domain.ErrNotFound("role")
+		}
+		return domain.Role{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainRole(&role), nil
+}
+
+func (r *RoleRepository) GetRolesByIds(ctx context.Context, roleIds []string) ([]domain.Role, error) {
+	var roles []model.Role
+
+	err := r.db.WithContext(ctx).
+		Preload("Permissions").
+		Where("id IN ?", roleIds).
+		Order("name ASC").
+		Find(&roles).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainRoles(roles), nil
+}
+
+func (r *RoleRepository) GetUserRoles(ctx context.Context, userId string) ([]domain.Role, error) {
+	var roles []model.Role
+
+	err := r.db.WithContext(ctx).
+		Table("roles r").
+		Preload("Permissions").
+		Joins("JOIN user_roles ur ON ur.role_id = r.id").
+		Where("ur.user_id = ?", userId).
+		Order("r.name ASC").
+		Find(&roles).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainRoles(roles), nil
+}
+
+// GetUserPermissions resolves the effective permissions from the user's base role and assigned roles
+func (r *RoleRepository) GetUserPermissions(ctx context.Context, userId string) ([]string, error) {
+	permissions := []string{}
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT DISTINCT rp.permission
+		FROM role_permissions rp
+		JOIN roles r ON r.id = rp.role_id
+		WHERE r.name = (SELECT u.role::text FROM users u WHERE u.id = ?)
+			OR r.id IN (SELECT ur.role_id FROM user_roles ur WHERE ur.user_id = ?)
+		ORDER BY rp.permission
+	`, userId, userId).Scan(&permissions).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return permissions, nil
+}
+
+func (r *RoleRepository) CheckRoleNameExists(ctx context.Context, name string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.Role{}).Where("LOWER(name) = LOWER(?)", name).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *RoleRepository) CheckRoleNameExistsExcluding(ctx context.Context, name string, excludeRoleId string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.Role{}).Where("LOWER(name) = LOWER(?) AND id != ?", name, excludeRoleId).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,347 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SavedFilterRepository struct {
+	db *gorm.DB
+}
+
+func NewSavedFilterRepository(db *gorm.DB) *SavedFilterRepository {
+	return &SavedFilterRepository{
+		db: db,
+	}
+}
+
+func (r *SavedFilterRepository) applySavedFilterFilters(db *gorm.DB, filters *domain.SavedFilterFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.EntityType != nil && *filters.EntityType != "" {
+		db = db.Where("entity_type = ?", *filters.EntityType)
+	}
+
+	return db
+}
+
+func (r *SavedFilterRepository) applyReportRunFilters(db *gorm.DB, filters *domain.ReportRunFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.Status != nil && *filters.Status != "" {
+		db = db.Where("status = ?", *filters.Status)
+	}
+
+	return db
+}
+
+// *===========================MUTATION===========================*
+func (r *SavedFilterRepository) CreateSavedFilter(ctx context.Context, payload *domain.SavedFilter) (domain.SavedFilter, error) {
+	modelFilter := mapper.ToModelSavedFilterForCreate(payload)
+	if err := r.db.WithContext(ctx).Create(&modelFilter).Error; err != nil {
+		return domain.SavedFilter{}, domain.ErrInternal(err)
+	}
+
+	return r.GetSavedFilterById(ctx, modelFilter.ID.String())
+}
+
+func (r *SavedFilterRepository) UpdateSavedFilter(ctx context.Context, savedFilterId string, payload *domain.UpdateSavedFilterPayload) (domain.SavedFilter, error) {
+	updates := mapper.ToModelSavedFilterUpdateMap(payload)
+	updates["updated_at"] = time.Now()
+
+	result := r.db.WithContext(ctx).Model(&model.SavedFilter{}).Where("id = ?", savedFilterId).Updates(updates)
+	if result.Error != nil {
+		return domain.SavedFilter{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.SavedFilter{}, domain.ErrNotFound("saved filter")
+	}
+
+	return r.GetSavedFilterById(ctx, savedFilterId)
+}
+
+// DeleteSavedFilter removes the filter, schedule dan riwayat run ikut terhapus lewat foreign key cascade
+func (r *SavedFilterRepository) DeleteSavedFilter(ctx context.Context, savedFilterId string) error {
+	result := r.db.WithContext(ctx).Delete(&model.SavedFilter{}, "id = ?", savedFilterId)
+	if result.Error != nil {
+		return domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound("saved filter")
+	}
+	return nil
+}
+
+// UpsertReportSchedule creates the schedule of a saved filter or replaces the existing one
+func (r *SavedFilterRepository) UpsertReportSchedule(ctx context.Context, payload *domain.ReportSchedule) (domain.ReportSchedule, error) {
+	modelSchedule := mapper.ToModelReportScheduleForUpsert(payload)
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "saved_filter_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"cron_expression", "timezone", "format", "recipients", "lang_code", "is_active", "next_run_at", "updated_at"}),
+		}).
+		Create(&modelSchedule).Error
+	if err != nil {
+		return domain.ReportSchedule{}, domain.ErrInternal(err)
+	}
+
+	return r.GetReportScheduleBySavedFilterId(ctx, payload.SavedFilterID)
+}
+
+func (r *SavedFilterRepository) DeleteReportSchedule(ctx context.Context, savedFilterId string) error {
+	result := r.db.WithContext(ctx).Delete(&model.ReportSchedule{}, "saved_filter_id = ?", savedFilterId)
+	if result.Error != nil {
+		return domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound("report schedule")
+	}
+	return nil
+}
+
+// ClaimDueReportSchedules locks active schedules that are due, panggil di dalam transaksi dan majukan next_run_at
+// sebelum commit. SKIP LOCKED supaya beberapa instance tidak menjalankan jadwal yang sama
+func (r *SavedFilterRepository) ClaimDueReportSchedules(ctx context.Context, now time.Time, limit int) ([]domain.ReportSchedule, error) {
+	var schedules []model.ReportSchedule
+
+	err := r.db.WithContext(ctx).
+		Where("is_active = ? AND next_run_at <= ?", true, now).
+		Order("next_run_at ASC").
+		Limit(limit).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Find(&schedules).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainReportSchedules(schedules), nil
+}
+
+// AdvanceReportSchedule records that the schedule ran and sets the next run, nextRunAt nil menghentikan jadwal
+func (r *SavedFilterRepository) AdvanceReportSchedule(ctx context.Context, scheduleId string, nextRunAt *time.Time, lastRunAt time.Time) error {
+	updates := map[string]any{
+		"next_run_at": nextRunAt,
+		"last_run_at": lastRunAt,
+		"updated_at":  time.Now(),
+	}
+	if nextRunAt == nil {
+		updates["is_active"] = false
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&model.ReportSchedule{}).
+		Where("id = ?", scheduleId).
+		Updates(updates).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+func (r *SavedFilterRepository) CreateReportRun(ctx context.Context, payload *domain.ReportRun) (domain.ReportRun, error) {
+	modelRun := mapper.ToModelReportRunForCreate(payload)
+	if err := r.db.WithContext(ctx).Create(&modelRun).Error; err != nil {
+		return domain.ReportRun{}, domain.ErrInternal(err)
+	}
+
+	return r.GetReportRunById(ctx, modelRun.ID.String())
+}
+
+// StartReportRun marks an attempt as running, error dari attempt sebelumnya dibersihkan
+func (r *SavedFilterRepository) StartReportRun(ctx context.Context, runId string) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).
+		Model(&model.ReportRun{}).
+		Where("id = ?", runId).
+		Updates(map[string]any{
+			"status":      domain.ReportRunStatusRunning,
+			"attempts":    gorm.Expr("attempts + 1"),
+			"error":       nil,
+			"started_at":  now,
+			"finished_at": nil,
+			"updated_at":  now,
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// SetReportRunFile stores where the generated file lives, retry berikutnya memakai file ini tanpa export ulang
+func (r *SavedFilterRepository) SetReportRunFile(ctx context.Context, runId string, fileName string, fileURL string, publicID string, fileSize int64) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.ReportRun{}).
+		Where("id = ?", runId).
+		Updates(map[string]any{
+			"file_name":  fileName,
+			"file_url":   fileURL,
+			"public_id":  publicID,
+			"file_size":  fileSize,
+			"updated_at": time.Now(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// AddReportRunDeliveredRecipient marks a recipient as delivered, dipanggil setelah tiap email terkirim
+func (r *SavedFilterRepository) AddReportRunDeliveredRecipient(ctx context.Context, runId string, recipient string) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.ReportRun{}).
+		Where("id = ? AND NOT delivered_recipients @> jsonb_build_array(?::text)", runId, recipient).
+		Updates(map[string]any{
+			"delivered_recipients": gorm.Expr("delivered_recipients || jsonb_build_array(?::text)", recipient),
+			"updated_at":           time.Now(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+func (r *SavedFilterRepository) FinishReportRun(ctx context.Context, runId string, status domain.ReportRunStatus, errMsg *string) error {
+	now := time.Now()
+	err := r.db.WithContext(ctx).
+		Model(&model.ReportRun{}).
+		Where("id = ?", runId).
+		Updates(map[string]any{
+			"status":      status,
+			"error":       errMsg,
+			"finished_at": now,
+			"updated_at":  now,
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (r *SavedFilterRepository) GetSavedFilters(ctx context.Context, userId string, filters *domain.SavedFilterFilterOptions) ([]domain.SavedFilter, error) {
+	var savedFilters []model.SavedFilter
+	db := r.db.WithContext(ctx).
+		Preload("Schedule").
+		Where("user_id = ?", userId)
+
+	db = r.applySavedFilterFilters(db, filters)
+
+	if err := db.Order("entity_type ASC, name ASC").Find(&savedFilters).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainSavedFilters(savedFilters), nil
+}
+
+func (r *SavedFilterRepository) GetSavedFilterById(ctx context.Context, savedFilterId string) (domain.SavedFilter, error) {
+	var savedFilter model.SavedFilter
+
+	err := r.db.WithContext(ctx).
+		Preload("Schedule").
+		First(&savedFilter, "id = ?", savedFilterId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.SavedFilter{}, domain.ErrNotFound("saved filter")
+		}
+		return domain.SavedFilter{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainSavedFilter(&savedFilter), nil
+}
+
+func (r *SavedFilterRepository) CheckSavedFilterNameExists(ctx context.Context, userId string, entityType domain.SavedFilterEntityType, name string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.SavedFilter{}).Where("user_id = ? AND entity_type = ? AND LOWER(name) = LOWER(?)", userId, entityType, name).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *SavedFilterRepository) CheckSavedFilterNameExistsExcluding(ctx context.Context, userId string, entityType domain.SavedFilterEntityType, name string, excludeSavedFilterId string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.SavedFilter{}).Where("user_id = ? AND entity_type = ? AND LOWER(name) = LOWER(?) AND id != ?", userId, entityType, name, excludeSavedFilterId).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *SavedFilterRepository) GetReportScheduleBySavedFilterId(ctx context.Context, savedFilterId string) (domain.ReportSchedule, error) {
+	var schedule model.ReportSchedule
+
+	err := r.db.WithContext(ctx).
+		First(&schedule, "saved_filter_id = ?", savedFilterId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ReportSchedule{}, domain.ErrNotFound("report schedule")
+		}
+		return domain.ReportSchedule{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainReportSchedule(&schedule), nil
+}
+
+func (r *SavedFilterRepository) GetReportRunsCursor(ctx context.Context, savedFilterId string, params domain.ReportRunParams) ([]domain.ReportRun, error) {
+	var runs []model.ReportRun
+	db := r.db.WithContext(ctx).Where("saved_filter_id = ?", savedFilterId)
+
+	db = r.applyReportRunFilters(db, params.Filters)
+	db = db.Order("id DESC")
+
+	// Apply cursor-based pagination
+	if params.Pagination != nil {
+		if params.Pagination.Cursor != "" {
+			db = db.Where("id < ?", params.Pagination.Cursor)
+		}
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+	}
+
+	if err := db.Find(&runs).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainReportRuns(runs), nil
+}
+
+func (r *SavedFilterRepository) GetReportRunById(ctx context.Context, runId string) (domain.ReportRun, error) {
+	var run model.ReportRun
+
+	err := r.db.WithContext(ctx).
+		First(&run, "id = ?", runId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ReportRun{}, domain.ErrNotFound("report run")
+		}
+		return domain.ReportRun{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainReportRun(&run), nil
+}
+
+// GetReportRunPublicIds returns the stored files of a saved filter, dipakai untuk hapus file sebelum filter dihapus
+func (r *SavedFilterRepository) GetReportRunPublicIds(ctx context.Context, savedFilterId string) ([]string, error) {
+	var publicIds []string
+
+	err := r.db.WithContext(ctx).
+		Model(&model.ReportRun{}).
+		Where("saved_filter_id = ? AND public_id IS NOT NULL", savedFilterId).
+		Pluck("public_id", &publicIds).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return publicIds, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/query"
 	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -23,7 +24,15 @@ func NewScanLogRepository(db *gorm.DB) *ScanLogRepository {
 	}
 }
 
+// * scopedScanLogs query dasar tabel scan_logs yang sudah dibatasi lokasi user yang request
+func (r *ScanLogRepository) scopedScanLogs(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&model.ScanLog{}).Scopes(query.LocationScope(query.AssetLocationCondition))
+}
+
 func (r *ScanLogRepository) applyScanLogFilters(db *gorm.DB, filters *domain.ScanLogFilterOptions) *gorm.DB {
+	// * Scope lokasi selalu berlaku walaupun tanpa filter, termasuk export job dan report saved filter
+	db = db.Scopes(query.LocationScope(query.AssetLocationCondition))
+
 	if filters == nil {
 		return db
 	}
@@ -133,7 +142,7 @@ func (r *ScanLogRepository) BulkCreateScanLogs(ctx context.Context, scanLogs []d
 }
 
 func (r *ScanLogRepository) DeleteScanLog(ctx context.Context, scanLogId string) error {
-	if err := r.db.WithContext(ctx).Delete(&model.ScanLog{}, "id = ?", scanLogId).Error; err != nil {
+	if err := r.db.WithContext(ctx).Scopes(query.LocationScope(query.AssetLocationCondition)).Delete(&model.ScanLog{}, "id = ?", scanLogId).Error; err != nil {
 		return domain.ErrInternal(err)
 	}
 	return nil
@@ -151,7 +160,7 @@ func (r *ScanLogRepository) BulkDeleteScanLogs(ctx context.Context, scanLogIds [
 
 	// First, find which scan logs actually exist
 	var existingScanLogs []model.ScanLog
-	if err := r.db.WithContext(ctx).Select("id").Where("id IN ?", scanLogIds).Find(&existingScanLogs).Error; err != nil {
+	if err := r.scopedScanLogs(ctx).Select("id").Where("id IN ?", scanLogIds).Find(&existingScanLogs).Error; err != nil {
 		return result, domain.ErrInternal(err)
 	}
 
@@ -270,6 +279,7 @@ func (r *ScanLogRepository) GetScanLogById(ctx context.Context, scanLogId string
 	var scanLog model.ScanLog
 
 	err := r.db.WithContext(ctx).
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
 		First(&scanLog, "id = ?", scanLogId).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -341,7 +351,7 @@ func (r *ScanLogRepository) GetScanLogsByUserId(ctx context.Context, userId stri
 
 func (r *ScanLogRepository) CheckScanLogExist(ctx context.Context, scanLogId string) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&model.ScanLog{}).Where("id = ?", scanLogId).Count(&count).Error
+	err := r.scopedScanLogs(ctx).Where("id = ?", scanLogId).Count(&count).Error
 	if err != nil {
 		return false, domain.ErrInternal(err)
 	}
@@ -371,7 +381,7 @@ func (r *ScanLogRepository) GetScanLogStatistics(ctx context.Context) (domain.Sc
 
 	// Get total scan log count
 	var totalCount int64
-	if err := r.db.WithContext(ctx).Model(&model.ScanLog{}).Count(&totalCount).Error; err != nil {
+	if err := r.scopedScanLogs(ctx).Count(&totalCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 	stats.Total.Count = int(totalCount)
@@ -381,7 +391,7 @@ func (r *ScanLogRepository) GetScanLogStatistics(ctx context.Context) (domain.Sc
 		ScanMethod string `json:"scanMethod"`
 		Count      int64  `json:"count"`
 	}
-	if err := r.db.WithContext(ctx).Model(&model.ScanLog{}).
+	if err := r.scopedScanLogs(ctx).
 		Select("scan_method, COUNT(*) as count").
 		Group("scan_method").
 		Order("count DESC").
@@ -402,7 +412,7 @@ func (r *ScanLogRepository) GetScanLogStatistics(ctx context.Context) (domain.Sc
 		ScanResult string `json:"scanResult"`
 		Count      int64  `json:"count"`
 	}
-	if err := r.db.WithContext(ctx).Model(&model.ScanLog{}).
+	if err := r.scopedScanLogs(ctx).
 		Select("scan_result, COUNT(*) as count").
 		Group("scan_result").
 		Order("count DESC").
@@ -423,7 +433,7 @@ func (r *ScanLogRepository) GetScanLogStatistics(ctx context.Context) (domain.Sc
 		Date  time.Time `json:"date"`
 		Count int64     `json:"count"`
 	}
-	if err := r.db.WithContext(ctx).Model(&model.ScanLog{}).
+	if err := r.scopedScanLogs(ctx).
 		Select("DATE(scan_timestamp) as date, COUNT(*) as count").
 		Where("scan_timestamp >= NOW() - INTERVAL '30 days'").
 		Group("DATE(scan_timestamp)").
@@ -442,12 +452,12 @@ func (r *ScanLogRepository) GetScanLogStatistics(ctx context.Context) (domain.Sc
 
 	// Get geographic statistics
 	var withCoordinates, withoutCoordinates int64
-	if err := r.db.WithContext(ctx).Model(&model.ScanLog{}).
+	if err := r.scopedScanLogs(ctx).
 		Where("scan_location_lat IS NOT NULL AND scan_location_lng IS NOT NULL").
 		Count(&withCoordinates).Error; err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.ScanLog{}).
+	if err := r.scopedScanLogs(ctx).
 		Where("scan_location_lat IS NULL OR scan_location_lng IS NULL").
 		Count(&withoutCoordinates).Error; err != nil {
 		return stats, domain.ErrInternal(err)
@@ -461,7 +471,7 @@ func (r *ScanLogRepository) GetScanLogStatistics(ctx context.Context) (domain.Sc
 
 	// Get success rate
 	var successCount int64
-	if err := r.db.WithContext(ctx).Model(&model.ScanLog{}).
+	if err := r.scopedScanLogs(ctx).
 		Where("scan_result = ?", domain.ScanResultSuccess).
 		Count(&successCount).Error; err != nil {
 		return stats, domain.ErrInternal(err)
@@ -479,7 +489,7 @@ func (r *ScanLogRepository) GetScanLogStatistics(ctx context.Context) (domain.Sc
 		ScannedBy string `json:"scannedBy"`
 		Count     int64  `json:"count"`
 	}
-	if err := r.db.WithContext(ctx).Model(&model.ScanLog{}).
+	if err := r.scopedScanLogs(ctx).
 		Select("scanned_by, COUNT(*) as count").
 		Group("scanned_by").
 		Order("count DESC").
@@ -498,10 +508,10 @@ func (r *ScanLogRepository) GetScanLogStatistics(ctx context.Context) (domain.Sc
 
 	// Get earliest and latest scan dates
 	var earliestDate, latestDate *time.Time
-	if err := r.db.WithContext(ctx).Model(&model.ScanLog{}).Select("MIN(scan_timestamp)").Row().Scan(&earliestDate); err != nil {
+	if err := r.scopedScanLogs(ctx).Select("MIN(scan_timestamp)").Row().Scan(&earliestDate); err != nil {
 		return stats, domain.ErrInternal(err)
 	}
-	if err := r.db.WithContext(ctx).Model(&model.ScanLog{}).Select("MAX(scan_timestamp)").Row().Scan(&latestDate); err != nil {
+	if err := r.scopedScanLogs(ctx).Select("MAX(scan_timestamp)").Row().Scan(&latestDate); err != nil {
 		return stats, domain.ErrInternal(err)
 	}
 
@@ -535,7 +545,18 @@ func (r *ScanLogRepository) GetScanLogsForExport(ctx context.Context, params dom
 	// Apply sorting
 	db = r.applyScanLogSorts(db, params.Sort)
 
-	// No pagination for export - get all matching scan logs
+	// * Tanpa pagination ambil semua data, export job mengisi limit/offset untuk ambil per batch
+	// * dan ID sebagai tie-breaker supaya urutan antar batch stabil
+	if params.Pagination != nil {
+		db = db.Order("sl.id")
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
 	if err := db.Find(&scanLogs).Error; err != nil {
 		return nil, domain.ErrInternal(err)
 	}
@@ -0,0 +1,277 @@
+package postgresql
+
+import (
+	"context"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/query"
+	"gorm.io/gorm"
+)
+
+// * ts_headline menandai kata yang match pakai karakter kontrol, bukan langsung <mark>,
+// * supaya teks asli bisa di-escape dulu dan isi data tidak bisa menyisipkan HTML
+const (
+	searchHighlightStart = "\x02"
+	searchHighlightStop  = "\x03"
+)
+
+var searchHeadlineOptions = `StartSel="` + searchHighlightStart + `", StopSel="` + searchHighlightStop + `", MaxWords=20, MinWords=5, MaxFragments=2, FragmentDelimiter=" ... "`
+
+// * searchTerm input search yang sudah disiapkan untuk full-text, ILIKE dan trigram
+type searchTerm struct {
+	Text    string // * Input asli untuk trigram similarity dan exact match
+	TsQuery string // * Prefix tsquery, contoh "lap:* & 001:*", kosong kalau input tidak punya huruf/angka
+	Pattern string // * Pattern ILIKE "%input%"
+}
+
+// * Input dipecah per kata (huruf/angka) dan tiap kata jadi prefix match, jadi "lap 00" ketemu "LAP-001"
+// * dan karakter tsquery (&, |, !, :) dari user tidak bisa bikin query error
+func newSearchTerm(input string) searchTerm {
+	text := strings.TrimSpace(input)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+
+	return searchTerm{
+		Text:    text,
+		TsQuery: strings.Join(words, " & "),
+		Pattern: "%" + text + "%",
+	}
+}
+
+func formatSearchHighlight(headline string) string {
+	escaped := html.EscapeString(headline)
+	return strings.NewReplacer(searchHighlightStart, "<mark>", searchHighlightStop, "</mark>").Replace(escaped)
+}
+
+type searchHitRow struct {
+	ID       string
+	Title    string
+	Subtitle *string
+	Snippet  string
+	Rank     float64
+}
+
+func toSearchHits(rows []searchHitRow) []domain.SearchHit {
+	hits := make([]domain.SearchHit, len(rows))
+	for i, row := range rows {
+		hits[i] = domain.SearchHit{
+			ID:       row.ID,
+			Title:    row.Title,
+			Subtitle: row.Subtitle,
+			Snippet:  formatSearchHighlight(row.Snippet),
+			Rank:     row.Rank,
+		}
+	}
+	return hits
+}
+
+type SearchRepository struct {
+	db *gorm.DB
+}
+
+func NewSearchRepository(db *gorm.DB) *SearchRepository {
+	return &SearchRepository{
+		db: db,
+	}
+}
+
+// *===========================QUERY===========================*
+func (r *SearchRepository) SearchAssets(ctx context.Context, searchQuery string, limit int) ([]domain.SearchHit, error) {
+	term := newSearchTerm(searchQuery)
+	selectArgs := append([]any{term.TsQuery, searchHeadlineOptions}, assetSearchRankArgs(term)...)
+
+	var rows []searchHitRow
+	err := r.db.WithContext(ctx).
+		Table("assets a").
+		Select("a.id, a.asset_name AS title, a.asset_tag AS subtitle, ts_headline('simple', "+assetSearchDocument+", to_tsquery('simple', ?), ?) AS snippet, "+assetSearchRank+" AS rank", selectArgs...).
+		Where(assetSearchCondition, assetSearchConditionArgs(term)...).
+		Scopes(query.LocationScope("a.location_id IN ?")).
+		Order("rank DESC, a.id DESC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return toSearchHits(rows), nil
+}
+
+func (r *SearchRepository) SearchUsers(ctx context.Context, searchQuery string, limit int) ([]domain.SearchHit, error) {
+	term := newSearchTerm(searchQuery)
+
+	var rows []searchHitRow
+	err := r.db.WithContext(ctx).Raw(`
+		WITH sq AS (SELECT to_tsquery('simple', ?) AS query)
+		SELECT
+			u.id,
+			u.full_name AS title,
+			u.name AS subtitle,
+			ts_headline('simple', concat_ws(' ', u.full_name, u.name, u.employee_id), sq.query, ?) AS snippet,
+			ts_rank_cd(u.search_vector, sq.query) + similarity(u.full_name, ?) AS rank
+		FROM users u
+		CROSS JOIN sq
+		WHERE u.search_vector @@ sq.query OR u.name ILIKE ? OR u.employee_id ILIKE ?
+		ORDER BY rank DESC, u.id DESC
+		LIMIT ?
+	`, term.TsQuery, searchHeadlineOptions, term.Text, term.Pattern, term.Pattern, limit).Scan(&rows).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return toSearchHits(rows), nil
+}
+
+// * Entity yang punya translation: match dicari di semua bahasa, title dan snippet diambil dari translation yang match
+// * dengan prioritas bahasa user
+func (r *SearchRepository) SearchLocations(ctx context.Context, searchQuery string, langCode string, limit int) ([]domain.SearchHit, error) {
+	term := newSearchTerm(searchQuery)
+
+	var rows []searchHitRow
+	err := r.db.WithContext(ctx).Raw(`
+		WITH sq AS (SELECT to_tsquery('simple', ?) AS query)
+		SELECT
+			l.id,
+			COALESCE(best.location_name, l.location_code) AS title,
+			l.location_code AS subtitle,
+			ts_headline('simple', concat_ws(' ', best.location_name, l.building, l.floor), sq.query, ?) AS snippet,
+			COALESCE(best.score, 0) + CASE WHEN lower(l.location_code) = lower(?) THEN 1 ELSE 0 END AS rank
+		FROM locations l
+		CROSS JOIN sq
+		LEFT JOIN LATERAL (
+			SELECT lt.location_name, ts_rank_cd(lt.search_vector, sq.query) AS score
+			FROM location_translations lt
+			WHERE lt.location_id = l.id
+			ORDER BY lt.search_vector @@ sq.query DESC, lt.lang_code = ? DESC, ts_rank_cd(lt.search_vector, sq.query) DESC
+			LIMIT 1
+		) best ON TRUE
+		WHERE l.location_code ILIKE ?
+			OR EXISTS (SELECT 1 FROM location_translations lt WHERE lt.location_id = l.id AND lt.search_vector @@ sq.query)
+		ORDER BY rank DESC, l.id DESC
+		LIMIT ?
+	`, term.TsQuery, searchHeadlineOptions, term.Text, langCode, term.Pattern, limit).Scan(&rows).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return toSearchHits(rows), nil
+}
+
+func (r *SearchRepository) SearchCategories(ctx context.Context, searchQuery string, langCode string, limit int) ([]domain.SearchHit, error) {
+	term := newSearchTerm(searchQuery)
+
+	var rows []searchHitRow
+	err := r.db.WithContext(ctx).Raw(`
+		WITH sq AS (SELECT to_tsquery('simple', ?) AS query)
+		SELECT
+			c.id,
+			COALESCE(best.category_name, c.category_code) AS title,
+			c.category_code AS subtitle,
+			ts_headline('simple', concat_ws(' ', best.category_name, best.description), sq.query, ?) AS snippet,
+			COALESCE(best.score, 0) + CASE WHEN lower(c.category_code) = lower(?) THEN 1 ELSE 0 END AS rank
+		FROM categories c
+		CROSS JOIN sq
+		LEFT JOIN LATERAL (
+			SELECT ct.category_name, ct.description, ts_rank_cd(ct.search_vector, sq.query) AS score
+			FROM category_translations ct
+			WHERE ct.category_id = c.id
+			ORDER BY ct.search_vector @@ sq.query DESC, ct.lang_code = ? DESC, ts_rank_cd(ct.search_vector, sq.query) DESC
+			LIMIT 1
+		) best ON TRUE
+		WHERE c.category_code ILIKE ?
+			OR EXISTS (SELECT 1 FROM category_translations ct WHERE ct.category_id = c.id AND ct.search_vector @@ sq.query)
+		ORDER BY rank DESC, c.id DESC
+		LIMIT ?
+	`, term.TsQuery, searchHeadlineOptions, term.Text, langCode, term.Pattern, limit).Scan(&rows).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return toSearchHits(rows), nil
+}
+
+func (r *SearchRepository) SearchIssueReports(ctx context.Context, searchQuery string, langCode string, limit int) ([]domain.SearchHit, error) {
+	term := newSearchTerm(searchQuery)
+	scopeSQL, scopeArgs := query.LocationScopeSQL(ctx, "a.location_id IN ?")
+
+	args := []any{term.TsQuery, searchHeadlineOptions, langCode, term.Pattern}
+	args = append(args, scopeArgs...)
+	args = append(args, limit)
+
+	var rows []searchHitRow
+	err := r.db.WithContext(ctx).Raw(`
+		WITH sq AS (SELECT to_tsquery('simple', ?) AS query)
+		SELECT
+			ir.id,
+			COALESCE(best.title, ir.issue_type) AS title,
+			concat_ws(' - ', a.asset_tag, a.asset_name) AS subtitle,
+			ts_headline('simple', concat_ws(' ', best.title, best.description, best.resolution_notes), sq.query, ?) AS snippet,
+			COALESCE(best.score, 0) AS rank
+		FROM issue_reports ir
+		JOIN assets a ON a.id = ir.asset_id
+		CROSS JOIN sq
+		LEFT JOIN LATERAL (
+			SELECT irt.title, irt.description, irt.resolution_notes, ts_rank_cd(irt.search_vector, sq.query) AS score
+			FROM issue_report_translations irt
+			WHERE irt.report_id = ir.id
+			ORDER BY irt.search_vector @@ sq.query DESC, irt.lang_code = ? DESC, ts_rank_cd(irt.search_vector, sq.query) DESC
+			LIMIT 1
+		) best ON TRUE
+		WHERE (ir.issue_type ILIKE ?
+			OR EXISTS (SELECT 1 FROM issue_report_translations irt WHERE irt.report_id = ir.id AND irt.search_vector @@ sq.query))
+			AND `+scopeSQL+`
+		ORDER BY rank DESC, ir.id DESC
+		LIMIT ?
+	`, args...).Scan(&rows).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return toSearchHits(rows), nil
+}
+
+func (r *SearchRepository) SearchMaintenanceRecords(ctx context.Context, searchQuery string, langCode string, limit int) ([]domain.SearchHit, error) {
+	term := newSearchTerm(searchQuery)
+	scopeSQL, scopeArgs := query.LocationScopeSQL(ctx, "a.location_id IN ?")
+
+	args := []any{term.TsQuery, searchHeadlineOptions, langCode, term.Pattern}
+	args = append(args, scopeArgs...)
+	args = append(args, limit)
+
+	var rows []searchHitRow
+	err := r.db.WithContext(ctx).Raw(`
+		WITH sq AS (SELECT to_tsquery('simple', ?) AS query)
+		SELECT
+			mr.id,
+			COALESCE(best.title, a.asset_name) AS title,
+			concat_ws(' - ', a.asset_tag, a.asset_name) AS subtitle,
+			ts_headline('simple', concat_ws(' ', best.title, best.notes, mr.performed_by_vendor), sq.query, ?) AS snippet,
+			COALESCE(best.score, 0) AS rank
+		FROM maintenance_records mr
+		JOIN assets a ON a.id = mr.asset_id
+		CROSS JOIN sq
+		LEFT JOIN LATERAL (
+			SELECT mrt.title, mrt.notes, ts_rank_cd(mrt.search_vector, sq.query) AS score
+			FROM maintenance_record_translations mrt
+			WHERE mrt.record_id = mr.id
+			ORDER BY mrt.search_vector @@ sq.query DESC, mrt.lang_code = ? DESC, ts_rank_cd(mrt.search_vector, sq.query) DESC
+			LIMIT 1
+		) best ON TRUE
+		WHERE (mr.performed_by_vendor ILIKE ?
+			OR EXISTS (SELECT 1 FROM maintenance_record_translations mrt WHERE mrt.record_id = mr.id AND mrt.search_vector @@ sq.query))
+			AND `+scopeSQL+`
+		ORDER BY rank DESC, mr.id DESC
+		LIMIT ?
+	`, args...).Scan(&rows).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return toSearchHits(rows), nil
+}
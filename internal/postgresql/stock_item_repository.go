@@ -0,0 +1,480 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type StockItemRepository struct {
+	db *gorm.DB
+}
+
+func NewStockItemRepository(db *gorm.DB) *StockItemRepository {
+	return &StockItemRepository{
+		db: db,
+	}
+}
+
+// * Total quantity across all locations, dipakai untuk filter low stock
+const stockItemTotalQuantitySQL = "(SELECT COALESCE(SUM(sl.quantity), 0) FROM stock_levels sl WHERE sl.item_id = si.id)"
+
+func (r *StockItemRepository) applyStockItemFilters(db *gorm.DB, filters *domain.StockItemFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.IsActive != nil {
+		db = db.Where("si.is_active = ?", *filters.IsActive)
+	}
+
+	if filters.Unit != nil && *filters.Unit != "" {
+		db = db.Where("si.unit = ?", *filters.Unit)
+	}
+
+	if filters.LocationID != nil && *filters.LocationID != "" {
+		db = db.Where("EXISTS (SELECT 1 FROM stock_levels sl WHERE sl.item_id = si.id AND sl.location_id = ?)", *filters.LocationID)
+	}
+
+	if filters.IsLowStock != nil {
+		if *filters.IsLowStock {
+			db = db.Where(stockItemTotalQuantitySQL + " <= si.reorder_point")
+		} else {
+			db = db.Where(stockItemTotalQuantitySQL + " > si.reorder_point")
+		}
+	}
+
+	return db
+}
+
+func (r *StockItemRepository) applyStockItemSorts(db *gorm.DB, sort *domain.StockItemSortOptions) *gorm.DB {
+	if sort == nil || sort.Field == "" {
+		return db.Order("si.sku ASC")
+	}
+
+	// Map camelCase sort field to snake_case database column
+	columnName := mapper.MapStockItemSortFieldToColumn(sort.Field)
+
+	order := "DESC"
+	if sort.Order == domain.SortOrderAsc {
+		order = "ASC"
+	}
+	return db.Order(fmt.Sprintf("%s %s", columnName, order))
+}
+
+func (r *StockItemRepository) applyStockItemSearch(db *gorm.DB, searchQuery *string) *gorm.DB {
+	if searchQuery == nil || *searchQuery == "" {
+		return db
+	}
+
+	searchPattern := "%" + *searchQuery + "%"
+	return db.Where("si.sku ILIKE ? OR si.item_name ILIKE ?", searchPattern, searchPattern)
+}
+
+func (r *StockItemRepository) preloadStockItemRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Levels", func(db *gorm.DB) *gorm.DB {
+			return db.Order("stock_levels.created_at ASC")
+		}).
+		Preload("Levels.Location").
+		Preload("Levels.Location.Translations")
+}
+
+func (r *StockItemRepository) applyStockTransactionFilters(db *gorm.DB, filters *domain.StockTransactionFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.ItemID != nil && *filters.ItemID != "" {
+		db = db.Where("st.item_id = ?", *filters.ItemID)
+	}
+
+	if filters.Type != nil {
+		db = db.Where("st.type = ?", *filters.Type)
+	}
+
+	if filters.LocationID != nil && *filters.LocationID != "" {
+		db = db.Where("(st.from_location_id = ? OR st.to_location_id = ?)", *filters.LocationID, *filters.LocationID)
+	}
+
+	if filters.MaintenanceRecordID != nil && *filters.MaintenanceRecordID != "" {
+		db = db.Where("st.maintenance_record_id = ?", *filters.MaintenanceRecordID)
+	}
+
+	if filters.PerformedBy != nil && *filters.PerformedBy != "" {
+		db = db.Where("st.performed_by = ?", *filters.PerformedBy)
+	}
+
+	if filters.DateFrom != nil {
+		db = db.Where("st.created_at >= ?", *filters.DateFrom)
+	}
+
+	if filters.DateTo != nil {
+		db = db.Where("st.created_at <= ?", *filters.DateTo)
+	}
+
+	return db
+}
+
+func (r *StockItemRepository) applyStockTransactionSorts(db *gorm.DB, sort *domain.StockTransactionSortOptions) *gorm.DB {
+	if sort == nil || sort.Field == "" {
+		return db.Order("st.created_at DESC")
+	}
+
+	// Map camelCase sort field to snake_case database column
+	columnName := mapper.MapStockTransactionSortFieldToColumn(sort.Field)
+
+	order := "DESC"
+	if sort.Order == domain.SortOrderAsc {
+		order = "ASC"
+	}
+	return db.Order(fmt.Sprintf("%s %s", columnName, order))
+}
+
+func (r *StockItemRepository) preloadStockTransactionRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Item").
+		Preload("FromLocation").
+		Preload("FromLocation.Translations").
+		Preload("ToLocation").
+		Preload("ToLocation.Translations").
+		Preload("PerformedByUser")
+}
+
+// applyStockTransaction moves stock between location levels and writes the ledger entry.
+// Must be called inside a database transaction so the levels and the ledger stay consistent
+func applyStockTransaction(tx *gorm.DB, payload *domain.StockTransaction) (model.StockTransaction, error) {
+	now := time.Now()
+
+	// * Decrement source level, never below zero
+	if payload.FromLocationID != nil {
+		result := tx.Model(&model.StockLevel{}).
+			Where("item_id = ? AND location_id = ? AND quantity >= ?", payload.ItemID, *payload.FromLocationID, payload.Quantity).
+			Updates(map[string]any{
+				"quantity":   gorm.Expr("quantity - ?", payload.Quantity),
+				"updated_at": now,
+			})
+		if result.Error != nil {
+			return model.StockTransaction{}, domain.ErrInternal(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return model.StockTransaction{}, domain.ErrConflict("insufficient stock quantity")
+		}
+	}
+
+	// * Increment destination level, create it on first receipt
+	if payload.ToLocationID != nil {
+		level := mapper.ToModelStockLevelForCreate(payload.ItemID, *payload.ToLocationID, payload.Quantity)
+		err := tx.Omit(clause.Associations).
+			Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "item_id"}, {Name: "location_id"}},
+				DoUpdates: clause.Assignments(map[string]any{
+					"quantity":   gorm.Expr("stock_levels.quantity + EXCLUDED.quantity"),
+					"updated_at": now,
+				}),
+			}).
+			Create(&level).Error
+		if err != nil {
+			return model.StockTransaction{}, domain.ErrInternal(err)
+		}
+	}
+
+	// * Latest receipt price becomes the item unit cost
+	if payload.Type == domain.StockTransactionReceive && payload.UnitCost != nil {
+		err := tx.Model(&model.StockItem{}).
+			Where("id = ?", payload.ItemID).
+			Updates(map[string]any{
+				"unit_cost":  *payload.UnitCost,
+				"updated_at": now,
+			}).Error
+		if err != nil {
+			return model.StockTransaction{}, domain.ErrInternal(err)
+		}
+	}
+
+	modelTransaction := mapper.ToModelStockTransactionForCreate(payload)
+	if err := tx.Omit(clause.Associations).Create(&modelTransaction).Error; err != nil {
+		return model.StockTransaction{}, domain.ErrInternal(err)
+	}
+
+	return modelTransaction, nil
+}
+
+// *===========================MUTATION===========================*
+func (r *StockItemRepository) CreateStockItem(ctx context.Context, payload *domain.StockItem) (domain.StockItem, error) {
+	modelItem := mapper.ToModelStockItemForCreate(payload)
+
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).Create(&modelItem).Error; err != nil {
+		return domain.StockItem{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainStockItem(&modelItem), nil
+}
+
+func (r *StockItemRepository) UpdateStockItem(ctx context.Context, itemId string, payload *domain.UpdateStockItemPayload) (domain.StockItem, error) {
+	updates := mapper.ToModelStockItemUpdateMap(payload)
+	if len(updates) > 0 {
+		updates["updated_at"] = time.Now()
+
+		result := r.db.WithContext(ctx).Model(&model.StockItem{}).Where("id = ?", itemId).Updates(updates)
+		if result.Error != nil {
+			return domain.StockItem{}, domain.ErrInternal(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.StockItem{}, domain.ErrNotFound("stock item")
+		}
+	}
+
+	return r.GetStockItemById(ctx, itemId)
+}
+
+func (r *StockItemRepository) DeleteStockItem(ctx context.Context, itemId string) error {
+	result := r.db.WithContext(ctx).Delete(&model.StockItem{}, "id = ?", itemId)
+	if result.Error != nil {
+		return domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound("stock item")
+	}
+	return nil
+}
+
+func (r *StockItemRepository) CreateStockTransaction(ctx context.Context, payload *domain.StockTransaction) (domain.StockTransaction, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.StockTransaction{}, domain.ErrInternal(tx.Error)
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	modelTransaction, err := applyStockTransaction(tx, payload)
+	if err != nil {
+		tx.Rollback()
+		return domain.StockTransaction{}, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.StockTransaction{}, domain.ErrInternal(err)
+	}
+
+	return r.GetStockTransactionById(ctx, modelTransaction.ID.String())
+}
+
+// *===========================QUERY===========================*
+func (r *StockItemRepository) GetStockItemsPaginated(ctx context.Context, params domain.StockItemParams) ([]domain.StockItem, error) {
+	var items []model.StockItem
+	db := r.preloadStockItemRelations(r.db.WithContext(ctx).Table("stock_items si"))
+
+	db = r.applyStockItemSearch(db, params.SearchQuery)
+	db = r.applyStockItemFilters(db, params.Filters)
+	db = r.applyStockItemSorts(db, params.Sort)
+	if params.Pagination != nil {
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
+	if err := db.Find(&items).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainStockItems(items), nil
+}
+
+func (r *StockItemRepository) GetStockItemsCursor(ctx context.Context, params domain.StockItemParams) ([]domain.StockItem, error) {
+	var items []model.StockItem
+	db := r.preloadStockItemRelations(r.db.WithContext(ctx).Table("stock_items si"))
+
+	db = r.applyStockItemSearch(db, params.SearchQuery)
+	db = r.applyStockItemFilters(db, params.Filters)
+
+	// Apply sorting - for cursor pagination, we need consistent ordering by ID
+	if params.Sort != nil && params.Sort.Field != "" {
+		db = r.applyStockItemSorts(db, params.Sort)
+	}
+	db = db.Order("si.id DESC")
+
+	// Apply cursor-based pagination
+	if params.Pagination != nil {
+		if params.Pagination.Cursor != "" {
+			db = db.Where("si.id < ?", params.Pagination.Cursor)
+		}
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+	}
+
+	if err := db.Find(&items).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainStockItems(items), nil
+}
+
+func (r *StockItemRepository) GetStockItemById(ctx context.Context, itemId string) (domain.StockItem, error) {
+	var item model.StockItem
+
+	err := r.preloadStockItemRelations(r.db.WithContext(ctx).Table("stock_items si")).
+		First(&item, "si.id = ?", itemId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.StockItem{}, domain.ErrNotFound("stock item")
+		}
+		return domain.StockItem{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainStockItem(&item), nil
+}
+
+// GetLowStockItems returns active items whose total quantity is at or below their reorder point
+func (r *StockItemRepository) GetLowStockItems(ctx context.Context) ([]domain.StockItem, error) {
+	var items []model.StockItem
+
+	err := r.preloadStockItemRelations(r.db.WithContext(ctx).Table("stock_items si")).
+		Where("si.is_active = ?", true).
+		Where(stockItemTotalQuantitySQL + " <= si.reorder_point").
+		Order("si.sku ASC").
+		Find(&items).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainStockItems(items), nil
+}
+
+func (r *StockItemRepository) CheckStockItemExist(ctx context.Context, itemId string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.StockItem{}).Where("id = ?", itemId).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *StockItemRepository) CheckSKUExists(ctx context.Context, sku string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.StockItem{}).Where("sku = ?", sku).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *StockItemRepository) CheckSKUExistsExcluding(ctx context.Context, sku string, excludeItemId string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.StockItem{}).Where("sku = ? AND id != ?", sku, excludeItemId).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *StockItemRepository) CheckStockItemHasTransactions(ctx context.Context, itemId string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.StockTransaction{}).Where("item_id = ?", itemId).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *StockItemRepository) CountStockItems(ctx context.Context, params domain.StockItemParams) (int64, error) {
+	var count int64
+	db := r.db.WithContext(ctx).Table("stock_items si")
+
+	db = r.applyStockItemSearch(db, params.SearchQuery)
+	db = r.applyStockItemFilters(db, params.Filters)
+
+	if err := db.Count(&count).Error; err != nil {
+		return 0, domain.ErrInternal(err)
+	}
+	return count, nil
+}
+
+func (r *StockItemRepository) GetStockTransactionsPaginated(ctx context.Context, params domain.StockTransactionParams) ([]domain.StockTransaction, error) {
+	var transactions []model.StockTransaction
+	db := r.preloadStockTransactionRelations(r.db.WithContext(ctx).Table("stock_transactions st"))
+
+	db = r.applyStockTransactionFilters(db, params.Filters)
+	db = r.applyStockTransactionSorts(db, params.Sort)
+	if params.Pagination != nil {
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
+	if err := db.Find(&transactions).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainStockTransactions(transactions), nil
+}
+
+func (r *StockItemRepository) GetStockTransactionsCursor(ctx context.Context, params domain.StockTransactionParams) ([]domain.StockTransaction, error) {
+	var transactions []model.StockTransaction
+	db := r.preloadStockTransactionRelations(r.db.WithContext(ctx).Table("stock_transactions st"))
+
+	db = r.applyStockTransactionFilters(db, params.Filters)
+
+	// Apply sorting - for cursor pagination, we need consistent ordering by ID
+	if params.Sort != nil && params.Sort.Field != "" {
+		db = r.applyStockTransactionSorts(db, params.Sort)
+	}
+	db = db.Order("st.id DESC")
+
+	// Apply cursor-based pagination
+	if params.Pagination != nil {
+		if params.Pagination.Cursor != "" {
+			db = db.Where("st.id < ?", params.Pagination.Cursor)
+		}
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+	}
+
+	if err := db.Find(&transactions).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainStockTransactions(transactions), nil
+}
+
+func (r *StockItemRepository) GetStockTransactionById(ctx context.Context, transactionId string) (domain.StockTransaction, error) {
+	var transaction model.StockTransaction
+
+	err := r.preloadStockTransactionRelations(r.db.WithContext(ctx).Table("stock_transactions st")).
+		First(&transaction, "st.id = ?", transactionId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.StockTransaction{}, domain.ErrNotFound("stock transaction")
+		}
+		return domain.StockTransaction{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainStockTransaction(&transaction), nil
+}
+
+func (r *StockItemRepository) CountStockTransactions(ctx context.Context, params domain.StockTransactionParams) (int64, error) {
+	var count int64
+	db := r.db.WithContext(ctx).Table("stock_transactions st")
+
+	db = r.applyStockTransactionFilters(db, params.Filters)
+
+	if err := db.Count(&count).Error; err != nil {
+		return 0, domain.ErrInternal(err)
+	}
+	return count, nil
+}
@@ -0,0 +1,151 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"gorm.io/gorm"
+)
+
+type txContextKey struct{}
+
+// txState is the transaction carried in the context by Transactor.WithinTransaction
+type txState struct {
+	tx         *sql.Tx
+	savepoints int
+}
+
+// Transactor runs several repository calls in one database transaction.
+// Repository tidak perlu diubah: query yang context-nya membawa transaksi otomatis ikut transaksi tersebut,
+// dan Begin() di dalam repository menjadi SAVEPOINT.
+type Transactor struct {
+	pool *txConnPool
+}
+
+// NewTransactor installs the context aware connection pool on db, panggil sebelum repository dipakai
+func NewTransactor(db *gorm.DB) (*Transactor, error) {
+	pool, ok := db.ConnPool.(*txConnPool)
+	if !ok {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		pool = &txConnPool{db: sqlDB}
+		db.ConnPool = pool
+		db.Statement.ConnPool = pool
+	}
+
+	return &Transactor{pool: pool}, nil
+}
+
+// WithinTransaction commits everything fn does with the given ctx, or nothing when fn returns an error
+func (t *Transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// * Sudah di dalam transaksi, cukup ikut transaksi luar
+	if _, ok := ctx.Value(txContextKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.pool.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, &txState{tx: tx})); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// txConnPool routes every query to the transaction in the context, or to the pool when there is none
+type txConnPool struct {
+	db *sql.DB
+}
+
+func (p *txConnPool) conn(ctx context.Context) gorm.ConnPool {
+	if state, ok := ctx.Value(txContextKey{}).(*txState); ok {
+		return state.tx
+	}
+	return p.db
+}
+
+func (p *txConnPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return p.conn(ctx).PrepareContext(ctx, query)
+}
+
+func (p *txConnPool) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return p.conn(ctx).ExecContext(ctx, query, args...)
+}
+
+func (p *txConnPool) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return p.conn(ctx).QueryContext(ctx, query, args...)
+}
+
+func (p *txConnPool) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return p.conn(ctx).QueryRowContext(ctx, query, args...)
+}
+
+// BeginTx starts a transaction, inside WithinTransaction it starts a savepoint instead
+func (p *txConnPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	state, ok := ctx.Value(txContextKey{}).(*txState)
+	if !ok {
+		return p.db.BeginTx(ctx, opts)
+	}
+
+	state.savepoints++
+	sp := &savepointTx{tx: state.tx, name: fmt.Sprintf("sp_%d", state.savepoints)}
+	if _, err := state.tx.ExecContext(ctx, "SAVEPOINT "+sp.name); err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+// GetDBConn lets db.DB() keep returning the underlying *sql.DB
+func (p *txConnPool) GetDBConn() (*sql.DB, error) {
+	return p.db, nil
+}
+
+// savepointTx is what a repository gets from Begin() inside WithinTransaction,
+// Commit/Rollback hanya melepas atau membatalkan savepoint, transaksi luar tetap berjalan
+type savepointTx struct {
+	tx   *sql.Tx
+	name string
+}
+
+func (s *savepointTx) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return s.tx.PrepareContext(ctx, query)
+}
+
+func (s *savepointTx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return s.tx.ExecContext(ctx, query, args...)
+}
+
+func (s *savepointTx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return s.tx.QueryContext(ctx, query, args...)
+}
+
+func (s *savepointTx) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return s.tx.QueryRowContext(ctx, query, args...)
+}
+
+func (s *savepointTx) Commit() error {
+	_, err := s.tx.Exec("RELEASE SAVEPOINT " + s.name)
+	return err
+}
+
+func (s *savepointTx) Rollback() error {
+	_, err := s.tx.Exec("ROLLBACK TO SAVEPOINT " + s.name)
+	return err
+}
@@ -0,0 +1,253 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TwoFactorRepository struct {
+	db *gorm.DB
+}
+
+func NewTwoFactorRepository(db *gorm.DB) *TwoFactorRepository {
+	return &TwoFactorRepository{
+		db: db,
+	}
+}
+
+// *===========================MUTATION===========================*
+
+// SavePendingTwoFactor stores a new secret awaiting confirmation, an existing pending enrolment is replaced
+func (r *TwoFactorRepository) SavePendingTwoFactor(ctx context.Context, userId string, secret string) (domain.UserTwoFactor, error) {
+	modelTwoFactor := mapper.ToModelUserTwoFactorForCreate(userId, secret)
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"secret":          secret,
+				"last_used_step":  0,
+				"failed_attempts": 0,
+				"locked_until":    nil,
+				"updated_at":      time.Now(),
+			}),
+			// * Enrolment yang sudah aktif tidak boleh ditimpa lewat setup ulang
+			Where: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "user_two_factors.enabled_at IS NULL"}}},
+		}).
+		Create(&modelTwoFactor).Error
+	if err != nil {
+		return domain.UserTwoFactor{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserTwoFactor(&modelTwoFactor), nil
+}
+
+// EnableTwoFactor confirms a pending enrolment and stores its recovery codes, false means it was not pending anymore
+func (r *TwoFactorRepository) EnableTwoFactor(ctx context.Context, userId string, step int64, recoveryCodeHashes []string) (bool, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return false, domain.ErrInternal(tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	now := time.Now()
+	result := tx.Model(&model.UserTwoFactor{}).
+		Where("user_id = ? AND enabled_at IS NULL", userId).
+		Updates(map[string]any{
+			"enabled_at":      now,
+			"last_used_step":  step,
+			"failed_attempts": 0,
+			"locked_until":    nil,
+			"updated_at":      now,
+		})
+	if result.Error != nil {
+		tx.Rollback()
+		return false, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return false, nil
+	}
+
+	if err := replaceRecoveryCodes(tx, userId, recoveryCodeHashes); err != nil {
+		tx.Rollback()
+		return false, domain.ErrInternal(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+
+	return true, nil
+}
+
+// DisableTwoFactor removes the enrolment together with every recovery code
+func (r *TwoFactorRepository) DisableTwoFactor(ctx context.Context, userId string) error {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.ErrInternal(tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Where("user_id = ?", userId).Delete(&model.UserRecoveryCode{}).Error; err != nil {
+		tx.Rollback()
+		return domain.ErrInternal(err)
+	}
+
+	if err := tx.Where("user_id = ?", userId).Delete(&model.UserTwoFactor{}).Error; err != nil {
+		tx.Rollback()
+		return domain.ErrInternal(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.ErrInternal(err)
+	}
+
+	return nil
+}
+
+func (r *TwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userId string, recoveryCodeHashes []string) error {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.ErrInternal(tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := replaceRecoveryCodes(tx, userId, recoveryCodeHashes); err != nil {
+		tx.Rollback()
+		return domain.ErrInternal(err)
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.ErrInternal(err)
+	}
+
+	return nil
+}
+
+// MarkTwoFactorStepUsed accepts a TOTP time step once, false means the code was already used (replay)
+func (r *TwoFactorRepository) MarkTwoFactorStepUsed(ctx context.Context, userId string, step int64) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.UserTwoFactor{}).
+		Where("user_id = ? AND last_used_step < ?", userId, step).
+		Updates(map[string]any{
+			"last_used_step":  step,
+			"failed_attempts": 0,
+			"locked_until":    nil,
+			"updated_at":      time.Now(),
+		})
+	if result.Error != nil {
+		return false, domain.ErrInternal(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UseRecoveryCode consumes an unused recovery code, false means it does not exist or was already used
+func (r *TwoFactorRepository) UseRecoveryCode(ctx context.Context, userId string, codeHash string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userId, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, domain.ErrInternal(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ReserveTwoFactorAttempt counts a verification attempt in one statement before the code is checked,
+// false means verification is still locked. Counter mulai dari awal lagi setelah lockout sebelumnya habis
+func (r *TwoFactorRepository) ReserveTwoFactorAttempt(ctx context.Context, userId string, maxAttempts int, lockUntil time.Time) (bool, error) {
+	now := time.Now()
+	var attempts []int
+
+	result := r.db.WithContext(ctx).Raw(`
+		UPDATE user_two_factors SET
+			failed_attempts = CASE WHEN locked_until IS NOT NULL THEN 1 ELSE failed_attempts + 1 END,
+			locked_until = CASE WHEN (CASE WHEN locked_until IS NOT NULL THEN 1 ELSE failed_attempts + 1 END) >= ? THEN ?::timestamptz END,
+			updated_at = ?
+		WHERE user_id = ? AND (locked_until IS NULL OR locked_until <= ?)
+		RETURNING failed_attempts`,
+		maxAttempts, lockUntil, now, userId, now,
+	).Scan(&attempts)
+	if result.Error != nil {
+		return false, domain.ErrInternal(result.Error)
+	}
+	return len(attempts) > 0, nil
+}
+
+// ResetTwoFactorFailures clears the attempt counter and lockout after a successful verification
+func (r *TwoFactorRepository) ResetTwoFactorFailures(ctx context.Context, userId string) error {
+	err := r.db.WithContext(ctx).
+		Model(&model.UserTwoFactor{}).
+		Where("user_id = ?", userId).
+		Updates(map[string]any{
+			"failed_attempts": 0,
+			"locked_until":    nil,
+			"updated_at":      time.Now(),
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// *===========================QUERY===========================*
+
+func (r *TwoFactorRepository) GetTwoFactorByUserId(ctx context.Context, userId string) (domain.UserTwoFactor, bool, error) {
+	var twoFactor model.UserTwoFactor
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userId).
+		First(&twoFactor).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.UserTwoFactor{}, false, nil
+		}
+		return domain.UserTwoFactor{}, false, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserTwoFactor(&twoFactor), true, nil
+}
+
+func (r *TwoFactorRepository) CountUnusedRecoveryCodes(ctx context.Context, userId string) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.UserRecoveryCode{}).Where("user_id = ? AND used_at IS NULL", userId).Count(&count).Error; err != nil {
+		return 0, domain.ErrInternal(err)
+	}
+	return count, nil
+}
+
+// *===========================HELPER METHODS===========================*
+
+// replaceRecoveryCodes drops the old set so only the latest generated codes are valid
+func replaceRecoveryCodes(tx *gorm.DB, userId string, recoveryCodeHashes []string) error {
+	if err := tx.Where("user_id = ?", userId).Delete(&model.UserRecoveryCode{}).Error; err != nil {
+		return err
+	}
+
+	modelCodes := mapper.ToModelUserRecoveryCodes(userId, recoveryCodeHashes)
+	if len(modelCodes) == 0 {
+		return nil
+	}
+
+	return tx.Create(&modelCodes).Error
+}
@@ -37,6 +37,13 @@ func (r *UserRepository) applyUserFilters(db *gorm.DB, filters *domain.UserFilte
 	if filters.EmployeeID != nil {
 		db = db.Where("u.employee_id = ?", *filters.EmployeeID)
 	}
+	if filters.IsLocked != nil {
+		if *filters.IsLocked {
+			db = db.Where("u.locked_until > ?", time.Now().UTC())
+		} else {
+			db = db.Where("(u.locked_until IS NULL OR u.locked_until <= ?)", time.Now().UTC())
+		}
+	}
 	return db
 }
 
@@ -230,6 +237,46 @@ func (r *UserRepository) UpdatePassword(ctx context.Context, userId string, hash
 	return nil
 }
 
+// RecordFailedLogin increments the failed login counter atomically, reaching maxAttempts locks the account
+// until lockedUntil and restarts the counter. Returns true when this attempt caused the lock.
+func (r *UserRepository) RecordFailedLogin(ctx context.Context, userId string, maxAttempts int, lockedUntil time.Time) (bool, error) {
+	var updated model.User
+	result := r.db.WithContext(ctx).
+		Model(&updated).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "failed_login_attempts"}, {Name: "locked_until"}}}).
+		Where("id = ?", userId).
+		UpdateColumns(map[string]any{
+			"failed_login_attempts": gorm.Expr("CASE WHEN failed_login_attempts + 1 >= ? THEN 0 ELSE failed_login_attempts + 1 END", maxAttempts),
+			"locked_until":          gorm.Expr("CASE WHEN failed_login_attempts + 1 >= ? THEN ? ELSE locked_until END", maxAttempts, lockedUntil),
+		})
+	if result.Error != nil {
+		return false, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, domain.ErrNotFound("user")
+	}
+
+	return updated.FailedLoginAttempts == 0 && updated.LockedUntil != nil, nil
+}
+
+// ResetFailedLogins clears the failed login counter and any active lock
+func (r *UserRepository) ResetFailedLogins(ctx context.Context, userId string) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.User{}).
+		Where("id = ?", userId).
+		UpdateColumns(map[string]any{
+			"failed_login_attempts": 0,
+			"locked_until":          nil,
+		})
+	if result.Error != nil {
+		return domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound("user")
+	}
+	return nil
+}
+
 // *===========================QUERY===========================*
 func (r *UserRepository) GetUsersPaginated(ctx context.Context, params domain.UserParams) ([]domain.User, error) {
 	var users []model.User
@@ -520,7 +567,18 @@ func (r *UserRepository) GetUsersForExport(ctx context.Context, params domain.Us
 	// Apply sorting
 	db = r.applyUserSorts(db, params.Sort)
 
-	// No pagination for export - get all matching users
+	// * Tanpa pagination ambil semua data, export job mengisi limit/offset untuk ambil per batch
+	// * dan ID sebagai tie-breaker supaya urutan antar batch stabil
+	if params.Pagination != nil {
+		db = db.Order("u.id")
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
 	if err := db.Find(&users).Error; err != nil {
 		return nil, domain.ErrInternal(err)
 	}
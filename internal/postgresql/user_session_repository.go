@@ -0,0 +1,118 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+)
+
+type UserSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewUserSessionRepository(db *gorm.DB) *UserSessionRepository {
+	return &UserSessionRepository{
+		db: db,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (r *UserSessionRepository) CreateSession(ctx context.Context, payload *domain.UserSession) (domain.UserSession, error) {
+	modelSession := mapper.ToModelUserSessionForCreate(payload)
+
+	if err := r.db.WithContext(ctx).Create(&modelSession).Error; err != nil {
+		return domain.UserSession{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserSession(&modelSession), nil
+}
+
+// RotateSessionToken swaps the current refresh token id, false means previousTokenId is no longer current
+func (r *UserSessionRepository) RotateSessionToken(ctx context.Context, sessionId string, previousTokenId string, newTokenId string, expiresAt time.Time) (bool, error) {
+	now := time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.UserSession{}).
+		Where("id = ? AND current_token_id = ? AND revoked_at IS NULL", sessionId, previousTokenId).
+		Updates(map[string]any{
+			"current_token_id": newTokenId,
+			"expires_at":       expiresAt,
+			"last_used_at":     now,
+			"updated_at":       now,
+		})
+	if result.Error != nil {
+		return false, domain.ErrInternal(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *UserSessionRepository) RevokeSession(ctx context.Context, sessionId string, reason domain.SessionRevokedReason) error {
+	now := time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&model.UserSession{}).
+		Where("id = ? AND revoked_at IS NULL", sessionId).
+		Updates(map[string]any{
+			"revoked_at":     now,
+			"revoked_reason": string(reason),
+			"updated_at":     now,
+		})
+	if result.Error != nil {
+		return domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound("session")
+	}
+	return nil
+}
+
+func (r *UserSessionRepository) RevokeUserSessions(ctx context.Context, userId string, reason domain.SessionRevokedReason) error {
+	now := time.Now()
+
+	err := r.db.WithContext(ctx).
+		Model(&model.UserSession{}).
+		Where("user_id = ? AND revoked_at IS NULL", userId).
+		Updates(map[string]any{
+			"revoked_at":     now,
+			"revoked_reason": string(reason),
+			"updated_at":     now,
+		}).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (r *UserSessionRepository) GetSessionById(ctx context.Context, sessionId string) (domain.UserSession, error) {
+	var session model.UserSession
+
+	err := r.db.WithContext(ctx).First(&session, "id = ?", sessionId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.UserSession{}, domain.ErrNotFound("session")
+		}
+		return domain.UserSession{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserSession(&session), nil
+}
+
+func (r *UserSessionRepository) GetActiveUserSessions(ctx context.Context, userId string) ([]domain.UserSession, error) {
+	var sessions []model.UserSession
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userId, time.Now()).
+		Order("last_used_at DESC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainUserSessions(sessions), nil
+}
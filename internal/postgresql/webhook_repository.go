@@ -0,0 +1,286 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) *WebhookRepository {
+	return &WebhookRepository{
+		db: db,
+	}
+}
+
+func (r *WebhookRepository) applyWebhookDeliveryFilters(db *gorm.DB, filters *domain.WebhookDeliveryFilterOptions) *gorm.DB {
+	if filters == nil {
+		return db
+	}
+
+	if filters.Status != nil && *filters.Status != "" {
+		db = db.Where("status = ?", *filters.Status)
+	}
+
+	if filters.EventType != nil && *filters.EventType != "" {
+		db = db.Where("event_type = ?", *filters.EventType)
+	}
+
+	return db
+}
+
+// *===========================MUTATION===========================*
+func (r *WebhookRepository) CreateWebhookEndpoint(ctx context.Context, payload *domain.WebhookEndpoint) (domain.WebhookEndpoint, error) {
+	modelEndpoint := mapper.ToModelWebhookEndpointForCreate(payload)
+	if err := r.db.WithContext(ctx).Create(&modelEndpoint).Error; err != nil {
+		return domain.WebhookEndpoint{}, domain.ErrInternal(err)
+	}
+
+	return r.GetWebhookEndpointById(ctx, modelEndpoint.ID.String())
+}
+
+func (r *WebhookRepository) UpdateWebhookEndpoint(ctx context.Context, endpointId string, payload *domain.UpdateWebhookEndpointPayload) (domain.WebhookEndpoint, error) {
+	updates := mapper.ToModelWebhookEndpointUpdateMap(payload)
+	updates["updated_at"] = time.Now()
+
+	result := r.db.WithContext(ctx).Model(&model.WebhookEndpoint{}).Where("id = ?", endpointId).Updates(updates)
+	if result.Error != nil {
+		return domain.WebhookEndpoint{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.WebhookEndpoint{}, domain.ErrNotFound("webhook endpoint")
+	}
+
+	return r.GetWebhookEndpointById(ctx, endpointId)
+}
+
+// RotateWebhookEndpointSecret replaces the signing secret, deliveries sent afterwards are signed with the new one
+func (r *WebhookRepository) RotateWebhookEndpointSecret(ctx context.Context, endpointId string, secret string) (domain.WebhookEndpoint, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.WebhookEndpoint{}).
+		Where("id = ?", endpointId).
+		Updates(map[string]any{
+			"secret":     secret,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return domain.WebhookEndpoint{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.WebhookEndpoint{}, domain.ErrNotFound("webhook endpoint")
+	}
+
+	return r.GetWebhookEndpointById(ctx, endpointId)
+}
+
+// DeleteWebhookEndpoint removes the endpoint, its delivery log is removed by the foreign key cascade
+func (r *WebhookRepository) DeleteWebhookEndpoint(ctx context.Context, endpointId string) error {
+	result := r.db.WithContext(ctx).Delete(&model.WebhookEndpoint{}, "id = ?", endpointId)
+	if result.Error != nil {
+		return domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNotFound("webhook endpoint")
+	}
+	return nil
+}
+
+func (r *WebhookRepository) CreateWebhookDelivery(ctx context.Context, payload *domain.WebhookDelivery) (domain.WebhookDelivery, error) {
+	modelDelivery := mapper.ToModelWebhookDeliveryForCreate(payload)
+	if err := r.db.WithContext(ctx).Create(&modelDelivery).Error; err != nil {
+		return domain.WebhookDelivery{}, domain.ErrInternal(err)
+	}
+
+	return r.GetWebhookDeliveryById(ctx, modelDelivery.ID.String())
+}
+
+// CreateWebhookDeliveries queues one event for several endpoints in a single insert
+func (r *WebhookRepository) CreateWebhookDeliveries(ctx context.Context, payloads []domain.WebhookDelivery) error {
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	modelDeliveries := make([]model.WebhookDelivery, len(payloads))
+	for i := range payloads {
+		modelDeliveries[i] = mapper.ToModelWebhookDeliveryForCreate(&payloads[i])
+	}
+
+	if err := r.db.WithContext(ctx).Create(&modelDeliveries).Error; err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// ClaimDueWebhookDeliveries leases pending deliveries that are due, SKIP LOCKED dan lease supaya beberapa instance tidak mengirim baris yang sama
+func (r *WebhookRepository) ClaimDueWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookDelivery, error) {
+	now := time.Now()
+
+	dueIds := r.db.
+		Model(&model.WebhookDelivery{}).
+		Select("id").
+		Where("status = ? AND next_attempt_at <= ?", domain.WebhookDeliveryStatusPending, now).
+		Where("locked_until IS NULL OR locked_until < ?", now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+
+	var claimed []model.WebhookDelivery
+	err := r.db.WithContext(ctx).
+		Model(&claimed).
+		Clauses(clause.Returning{}).
+		Where("id IN (?)", dueIds).
+		Updates(map[string]any{
+			"locked_until": now.Add(lease),
+			"updated_at":   now,
+		}).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainWebhookDeliveries(claimed), nil
+}
+
+// RecordWebhookDeliveryAttempt stores the outcome of an attempt and releases the lease
+func (r *WebhookRepository) RecordWebhookDeliveryAttempt(ctx context.Context, deliveryId string, attempt *domain.WebhookDeliveryAttempt) error {
+	updates := map[string]any{
+		"status":          attempt.Status,
+		"attempts":        gorm.Expr("attempts + 1"),
+		"next_attempt_at": attempt.NextAttemptAt,
+		"locked_until":    nil,
+		"last_attempt_at": attempt.AttemptedAt,
+		"response_status": attempt.ResponseStatus,
+		"response_body":   attempt.ResponseBody,
+		"error":           attempt.Error,
+		"duration_ms":     attempt.DurationMs,
+		"updated_at":      time.Now(),
+	}
+	if attempt.Status == domain.WebhookDeliveryStatusSucceeded {
+		updates["delivered_at"] = attempt.AttemptedAt
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&model.WebhookDelivery{}).
+		Where("id = ?", deliveryId).
+		Updates(updates).Error
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+	return nil
+}
+
+// DeleteFinishedWebhookDeliveries prunes the delivery log, pending deliveries are always kept
+func (r *WebhookRepository) DeleteFinishedWebhookDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("status <> ? AND created_at < ?", domain.WebhookDeliveryStatusPending, before).
+		Delete(&model.WebhookDelivery{})
+	if result.Error != nil {
+		return 0, domain.ErrInternal(result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// *===========================QUERY===========================*
+func (r *WebhookRepository) GetWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpoint, error) {
+	var endpoints []model.WebhookEndpoint
+
+	err := r.db.WithContext(ctx).
+		Order("created_at DESC").
+		Find(&endpoints).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainWebhookEndpoints(endpoints), nil
+}
+
+func (r *WebhookRepository) GetActiveWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpoint, error) {
+	var endpoints []model.WebhookEndpoint
+
+	err := r.db.WithContext(ctx).
+		Where("is_active = ?", true).
+		Find(&endpoints).Error
+	if err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainWebhookEndpoints(endpoints), nil
+}
+
+func (r *WebhookRepository) GetWebhookEndpointById(ctx context.Context, endpointId string) (domain.WebhookEndpoint, error) {
+	var endpoint model.WebhookEndpoint
+
+	err := r.db.WithContext(ctx).
+		First(&endpoint, "id = ?", endpointId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.WebhookEndpoint{}, domain.ErrNotFound("webhook endpoint")
+		}
+		return domain.WebhookEndpoint{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainWebhookEndpoint(&endpoint), nil
+}
+
+func (r *WebhookRepository) CheckWebhookEndpointNameExists(ctx context.Context, name string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.WebhookEndpoint{}).Where("LOWER(name) = LOWER(?)", name).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *WebhookRepository) CheckWebhookEndpointNameExistsExcluding(ctx context.Context, name string, excludeEndpointId string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.WebhookEndpoint{}).Where("LOWER(name) = LOWER(?) AND id != ?", name, excludeEndpointId).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
+
+func (r *WebhookRepository) GetWebhookDeliveriesCursor(ctx context.Context, endpointId string, params domain.WebhookDeliveryParams) ([]domain.WebhookDelivery, error) {
+	var deliveries []model.WebhookDelivery
+	db := r.db.WithContext(ctx).Where("endpoint_id = ?", endpointId)
+
+	db = r.applyWebhookDeliveryFilters(db, params.Filters)
+	db = db.Order("id DESC")
+
+	// Apply cursor-based pagination
+	if params.Pagination != nil {
+		if params.Pagination.Cursor != "" {
+			db = db.Where("id < ?", params.Pagination.Cursor)
+		}
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+	}
+
+	if err := db.Find(&deliveries).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainWebhookDeliveries(deliveries), nil
+}
+
+func (r *WebhookRepository) GetWebhookDeliveryById(ctx context.Context, deliveryId string) (domain.WebhookDelivery, error) {
+	var delivery model.WebhookDelivery
+
+	err := r.db.WithContext(ctx).
+		First(&delivery, "id = ?", deliveryId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.WebhookDelivery{}, domain.ErrNotFound("webhook delivery")
+		}
+		return domain.WebhookDelivery{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainWebhookDelivery(&delivery), nil
+}
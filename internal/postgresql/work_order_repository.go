@@ -0,0 +1,757 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/model"
+	"github.com/Rizz404/inventory-api/internal/postgresql/gorm/query"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"gorm.io/gorm"
+)
+
+type WorkOrderRepository struct {
+	db *gorm.DB
+}
+
+func NewWorkOrderRepository(db *gorm.DB) *WorkOrderRepository {
+	return &WorkOrderRepository{
+		db: db,
+	}
+}
+
+var (
+	terminalWorkOrderStatuses = []domain.WorkOrderStatus{domain.WorkOrderStatusDone, domain.WorkOrderStatusCancelled}
+	startedWorkOrderStatuses  = []domain.WorkOrderStatus{domain.WorkOrderStatusInProgress, domain.WorkOrderStatusOnHold}
+)
+
+// * Part ikut scope lokasi dari asset work order induknya
+const workOrderPartLocationScope = "work_order_id IN (SELECT swo.id FROM work_orders swo WHERE swo.asset_id IN (SELECT sa.id FROM assets sa WHERE sa.location_id IN ?))"
+
+// * scopedWorkOrders query dasar tabel work_orders yang sudah dibatasi lokasi user yang request
+func (r *WorkOrderRepository) scopedWorkOrders(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&model.WorkOrder{}).Scopes(query.LocationScope(query.AssetLocationCondition))
+}
+
+func (r *WorkOrderRepository) applyWorkOrderFilters(db *gorm.DB, filters *domain.WorkOrderFilterOptions) *gorm.DB {
+	// * Scope lokasi selalu berlaku walaupun tanpa filter
+	db = db.Scopes(query.LocationScope(query.AssetLocationCondition))
+
+	if filters == nil {
+		return db
+	}
+
+	if filters.Status != nil {
+		db = db.Where("wo.status = ?", *filters.Status)
+	}
+	if filters.Priority != nil {
+		db = db.Where("wo.priority = ?", *filters.Priority)
+	}
+	if filters.AssetID != nil && *filters.AssetID != "" {
+		db = db.Where("wo.asset_id = ?", *filters.AssetID)
+	}
+	if filters.ScheduleID != nil && *filters.ScheduleID != "" {
+		db = db.Where("wo.schedule_id = ?", *filters.ScheduleID)
+	}
+	if filters.IssueReportID != nil && *filters.IssueReportID != "" {
+		db = db.Where("wo.issue_report_id = ?", *filters.IssueReportID)
+	}
+	if filters.AssignedTo != nil && *filters.AssignedTo != "" {
+		db = db.Where("wo.assigned_to = ?", *filters.AssignedTo)
+	}
+	if filters.IsOverdue != nil {
+		if *filters.IsOverdue {
+			db = db.Where("wo.status NOT IN ? AND wo.due_date < ?", terminalWorkOrderStatuses, time.Now())
+		} else {
+			db = db.Where("wo.status IN ? OR wo.due_date >= ?", terminalWorkOrderStatuses, time.Now())
+		}
+	}
+	if filters.FromDate != nil && *filters.FromDate != "" {
+		db = db.Where("wo.due_date >= ?", *filters.FromDate)
+	}
+	if filters.ToDate != nil && *filters.ToDate != "" {
+		db = db.Where("wo.due_date <= ?", *filters.ToDate)
+	}
+
+	return db
+}
+
+func (r *WorkOrderRepository) applyWorkOrderSorts(db *gorm.DB, sort *domain.WorkOrderSortOptions) *gorm.DB {
+	if sort == nil || sort.Field == "" {
+		return db.Order("wo.due_date ASC")
+	}
+
+	// Map camelCase sort field to snake_case database column
+	columnName := mapper.MapWorkOrderSortFieldToColumn(sort.Field)
+
+	order := "DESC"
+	if sort.Order == domain.SortOrderAsc {
+		order = "ASC"
+	}
+	return db.Order(fmt.Sprintf("%s %s", columnName, order))
+}
+
+func (r *WorkOrderRepository) applyWorkOrderSearch(db *gorm.DB, searchQuery *string) *gorm.DB {
+	if searchQuery == nil || *searchQuery == "" {
+		return db
+	}
+
+	sq := "%" + *searchQuery + "%"
+	return db.Joins("LEFT JOIN work_order_translations wot ON wo.id = wot.work_order_id").
+		Joins("LEFT JOIN assets a ON wo.asset_id = a.id").
+		Where("wot.title ILIKE ? OR a.asset_tag ILIKE ? OR a.asset_name ILIKE ?", sq, sq, sq).
+		Group("wo.id")
+}
+
+func (r *WorkOrderRepository) preloadWorkOrderRelations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Translations").
+		Preload("Schedule").
+		Preload("Schedule.Translations").
+		Preload("IssueReport").
+		Preload("IssueReport.Translations").
+		Preload("IssueReport.ReportedByUser").
+		Preload("Asset").
+		Preload("Asset.Category").
+		Preload("Asset.Category.Translations").
+		Preload("Asset.Location").
+		Preload("Asset.Location.Translations").
+		Preload("Asset.User").
+		Preload("AssignedToUser").
+		Preload("CompletedByUser").
+		Preload("CreatedByUser").
+		Preload("Parts", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		})
+}
+
+// markAssetInMaintenance flags an active asset as under maintenance once work on it starts
+func (r *WorkOrderRepository) markAssetInMaintenance(tx *gorm.DB, assetId string) error {
+	return tx.Table("assets").
+		Where("id = ? AND status = ?", assetId, domain.StatusActive).
+		Updates(map[string]any{
+			"status":     domain.StatusMaintenance,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// releaseAssetFromMaintenance puts the asset back to active unless another started work order still holds it
+func (r *WorkOrderRepository) releaseAssetFromMaintenance(tx *gorm.DB, assetId string, workOrderId string) error {
+	return tx.Table("assets").
+		Where("id = ? AND status = ?", assetId, domain.StatusMaintenance).
+		Where("NOT EXISTS (SELECT 1 FROM work_orders wo WHERE wo.asset_id = assets.id AND wo.id <> ? AND wo.status IN ?)", workOrderId, startedWorkOrderStatuses).
+		Updates(map[string]any{
+			"status":     domain.StatusActive,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// *===========================MUTATION===========================*
+func (r *WorkOrderRepository) CreateWorkOrder(ctx context.Context, payload *domain.WorkOrder) (domain.WorkOrder, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.WorkOrder{}, domain.ErrInternal(tx.Error)
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	m := mapper.ToModelWorkOrderForCreate(payload)
+	if err := tx.Create(&m).Error; err != nil {
+		tx.Rollback()
+		return domain.WorkOrder{}, domain.ErrInternal(err)
+	}
+	for _, t := range payload.Translations {
+		mt := mapper.ToModelWorkOrderTranslationForCreate(m.ID.String(), &t)
+		if err := tx.Create(&mt).Error; err != nil {
+			tx.Rollback()
+			return domain.WorkOrder{}, domain.ErrInternal(err)
+		}
+	}
+	for _, p := range payload.Parts {
+		mp := mapper.ToModelWorkOrderPartForCreate(m.ID.String(), &p)
+		if err := tx.Create(&mp).Error; err != nil {
+			tx.Rollback()
+			return domain.WorkOrder{}, domain.ErrInternal(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.WorkOrder{}, domain.ErrInternal(err)
+	}
+
+	domainWorkOrder := mapper.ToDomainWorkOrder(&m)
+	domainWorkOrder.Translations = payload.Translations
+	domainWorkOrder.Parts = payload.Parts
+	return domainWorkOrder, nil
+}
+
+func (r *WorkOrderRepository) UpdateWorkOrder(ctx context.Context, workOrderId string, payload *domain.UpdateWorkOrderPayload) (domain.WorkOrder, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.WorkOrder{}, domain.ErrInternal(tx.Error)
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	updates := mapper.ToModelWorkOrderUpdateMap(payload)
+	if len(updates) > 0 {
+		updates["updated_at"] = time.Now()
+		if err := tx.Table("work_orders").Where("id = ?", workOrderId).Updates(updates).Error; err != nil {
+			tx.Rollback()
+			return domain.WorkOrder{}, domain.ErrInternal(err)
+		}
+	}
+
+	// * Update translations per language, create the ones that do not exist yet
+	for _, translationPayload := range payload.Translations {
+		translationUpdates := mapper.ToModelWorkOrderTranslationUpdateMap(&translationPayload)
+		if len(translationUpdates) == 0 {
+			continue
+		}
+
+		result := tx.Table("work_order_translations").
+			Where("work_order_id = ? AND lang_code = ?", workOrderId, translationPayload.LangCode).
+			Updates(translationUpdates)
+		if result.Error != nil {
+			tx.Rollback()
+			return domain.WorkOrder{}, domain.ErrInternal(result.Error)
+		}
+		if result.RowsAffected == 0 && translationPayload.Title != nil {
+			translation := domain.WorkOrderTranslation{
+				LangCode:    translationPayload.LangCode,
+				Title:       *translationPayload.Title,
+				Description: translationPayload.Description,
+			}
+			mt := mapper.ToModelWorkOrderTranslationForCreate(workOrderId, &translation)
+			if err := tx.Create(&mt).Error; err != nil {
+				tx.Rollback()
+				return domain.WorkOrder{}, domain.ErrInternal(err)
+			}
+		}
+	}
+
+	// * Parts list is replaced as a whole when provided
+	if payload.Parts != nil {
+		if err := tx.Where("work_order_id = ?", workOrderId).Delete(&model.WorkOrderPart{}).Error; err != nil {
+			tx.Rollback()
+			return domain.WorkOrder{}, domain.ErrInternal(err)
+		}
+		for _, partPayload := range payload.Parts {
+			part := domain.WorkOrderPart{
+				PartName:   partPayload.PartName,
+				PartNumber: partPayload.PartNumber,
+				Quantity:   partPayload.Quantity,
+				UnitCost:   partPayload.UnitCost,
+			}
+			mp := mapper.ToModelWorkOrderPartForCreate(workOrderId, &part)
+			if err := tx.Create(&mp).Error; err != nil {
+				tx.Rollback()
+				return domain.WorkOrder{}, domain.ErrInternal(err)
+			}
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.WorkOrder{}, domain.ErrInternal(err)
+	}
+
+	return r.GetWorkOrderById(ctx, workOrderId)
+}
+
+func (r *WorkOrderRepository) DeleteWorkOrder(ctx context.Context, workOrder *domain.WorkOrder) error {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.ErrInternal(tx.Error)
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// * Translations and parts are removed by ON DELETE CASCADE
+	if err := tx.Delete(&model.WorkOrder{}, "id = ?", workOrder.ID).Error; err != nil {
+		tx.Rollback()
+		return domain.ErrInternal(err)
+	}
+
+	if workOrder.Status == domain.WorkOrderStatusInProgress || workOrder.Status == domain.WorkOrderStatusOnHold {
+		if err := r.releaseAssetFromMaintenance(tx, workOrder.AssetID, workOrder.ID); err != nil {
+			tx.Rollback()
+			return domain.ErrInternal(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.ErrInternal(err)
+	}
+
+	return nil
+}
+
+// AssignWorkOrder sets the technician, an open work order moves to assigned while others keep their status
+func (r *WorkOrderRepository) AssignWorkOrder(ctx context.Context, workOrderId string, assignedTo string) (domain.WorkOrder, error) {
+	now := time.Now()
+	result := r.db.WithContext(ctx).Table("work_orders").
+		Where("id = ? AND status NOT IN ?", workOrderId, terminalWorkOrderStatuses).
+		Updates(map[string]any{
+			"assigned_to": assignedTo,
+			"assigned_at": now,
+			"status":      gorm.Expr("CASE WHEN status = ? THEN ?::work_order_status ELSE status END", domain.WorkOrderStatusOpen, domain.WorkOrderStatusAssigned),
+			"updated_at":  now,
+		})
+	if result.Error != nil {
+		return domain.WorkOrder{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.WorkOrder{}, domain.ErrNotFound("active work order")
+	}
+
+	return r.GetWorkOrderById(ctx, workOrderId)
+}
+
+// UpdateWorkOrderStatus moves the work order along its lifecycle and keeps the asset and linked issue report in sync
+func (r *WorkOrderRepository) UpdateWorkOrderStatus(ctx context.Context, workOrder *domain.WorkOrder, status domain.WorkOrderStatus) (domain.WorkOrder, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.WorkOrder{}, domain.ErrInternal(tx.Error)
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	now := time.Now()
+	updates := map[string]any{
+		"status":     status,
+		"updated_at": now,
+	}
+	switch status {
+	case domain.WorkOrderStatusInProgress:
+		updates["started_at"] = gorm.Expr("COALESCE(started_at, ?)", now)
+	case domain.WorkOrderStatusCancelled:
+		updates["cancelled_at"] = now
+	}
+
+	// * Only update from the status the caller saw to avoid racing transitions
+	result := tx.Table("work_orders").
+		Where("id = ? AND status = ?", workOrder.ID, workOrder.Status).
+		Updates(updates)
+	if result.Error != nil {
+		tx.Rollback()
+		return domain.WorkOrder{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return domain.WorkOrder{}, domain.ErrNotFound("work order")
+	}
+
+	switch status {
+	case domain.WorkOrderStatusInProgress:
+		if err := r.markAssetInMaintenance(tx, workOrder.AssetID); err != nil {
+			tx.Rollback()
+			return domain.WorkOrder{}, domain.ErrInternal(err)
+		}
+		if workOrder.IssueReportID != nil {
+			if err := tx.Table("issue_reports").
+				Where("id = ? AND status = ?", *workOrder.IssueReportID, domain.IssueStatusOpen).
+				Update("status", domain.IssueStatusInProgress).Error; err != nil {
+				tx.Rollback()
+				return domain.WorkOrder{}, domain.ErrInternal(err)
+			}
+		}
+	case domain.WorkOrderStatusCancelled:
+		if err := r.releaseAssetFromMaintenance(tx, workOrder.AssetID, workOrder.ID); err != nil {
+			tx.Rollback()
+			return domain.WorkOrder{}, domain.ErrInternal(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.WorkOrder{}, domain.ErrInternal(err)
+	}
+
+	return r.GetWorkOrderById(ctx, workOrder.ID)
+}
+
+// CompleteWorkOrder records the performed maintenance, closes the work order, releases the asset,
+// resolves the linked issue report and marks its schedule occurrence as executed
+func (r *WorkOrderRepository) CompleteWorkOrder(ctx context.Context, workOrder *domain.WorkOrder, record *domain.MaintenanceRecord) (domain.WorkOrder, error) {
+	tx := r.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return domain.WorkOrder{}, domain.ErrInternal(tx.Error)
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Create maintenance record
+	modelRecord := mapper.ToModelMaintenanceRecordForCreate(record)
+	if err := tx.Create(&modelRecord).Error; err != nil {
+		tx.Rollback()
+		return domain.WorkOrder{}, domain.ErrInternal(err)
+	}
+	for _, t := range record.Translations {
+		mt := mapper.ToModelMaintenanceRecordTranslationForCreate(modelRecord.ID.String(), &t)
+		if err := tx.Create(&mt).Error; err != nil {
+			tx.Rollback()
+			return domain.WorkOrder{}, domain.ErrInternal(err)
+		}
+	}
+
+	// Close work order, only from the status the caller saw to prevent double completion
+	result := tx.Table("work_orders").
+		Where("id = ? AND status = ?", workOrder.ID, workOrder.Status).
+		Updates(map[string]any{
+			"status":                domain.WorkOrderStatusDone,
+			"completed_by":          workOrder.CompletedBy,
+			"completed_at":          workOrder.CompletedAt,
+			"maintenance_record_id": modelRecord.ID.String(),
+			"updated_at":            time.Now(),
+		})
+	if result.Error != nil {
+		tx.Rollback()
+		return domain.WorkOrder{}, domain.ErrInternal(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return domain.WorkOrder{}, domain.ErrNotFound("work order")
+	}
+
+	if err := r.releaseAssetFromMaintenance(tx, workOrder.AssetID, workOrder.ID); err != nil {
+		tx.Rollback()
+		return domain.WorkOrder{}, domain.ErrInternal(err)
+	}
+
+	if workOrder.IssueReportID != nil {
+		if err := tx.Table("issue_reports").
+			Where("id = ? AND status IN ?", *workOrder.IssueReportID, []domain.IssueStatus{domain.IssueStatusOpen, domain.IssueStatusInProgress}).
+			Updates(map[string]any{
+				"status":        domain.IssueStatusResolved,
+				"resolved_by":   workOrder.CompletedBy,
+				"resolved_date": workOrder.CompletedAt,
+			}).Error; err != nil {
+			tx.Rollback()
+			return domain.WorkOrder{}, domain.ErrInternal(err)
+		}
+	}
+
+	// * Mark schedule occurrence as executed, one-off schedules are finished once their work order is done
+	if workOrder.ScheduleID != nil {
+		scheduleUpdates := map[string]any{
+			"last_executed_date": workOrder.DueDate,
+		}
+		if workOrder.Schedule != nil && !workOrder.Schedule.IsRecurring {
+			scheduleUpdates["state"] = domain.StateCompleted
+		}
+		if err := tx.Table("maintenance_schedules").Where("id = ?", *workOrder.ScheduleID).Updates(scheduleUpdates).Error; err != nil {
+			tx.Rollback()
+			return domain.WorkOrder{}, domain.ErrInternal(err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return domain.WorkOrder{}, domain.ErrInternal(err)
+	}
+
+	return r.GetWorkOrderById(ctx, workOrder.ID)
+}
+
+// *===========================QUERY===========================*
+func (r *WorkOrderRepository) GetWorkOrdersPaginated(ctx context.Context, params domain.WorkOrderParams, langCode string) ([]domain.WorkOrder, error) {
+	var workOrders []model.WorkOrder
+	db := r.preloadWorkOrderRelations(r.db.WithContext(ctx).Table("work_orders wo"))
+
+	db = r.applyWorkOrderSearch(db, params.SearchQuery)
+	db = r.applyWorkOrderFilters(db, params.Filters)
+	db = r.applyWorkOrderSorts(db, params.Sort)
+	if params.Pagination != nil {
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+		if params.Pagination.Offset > 0 {
+			db = db.Offset(params.Pagination.Offset)
+		}
+	}
+
+	if err := db.Find(&workOrders).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainWorkOrders(workOrders), nil
+}
+
+func (r *WorkOrderRepository) GetWorkOrdersCursor(ctx context.Context, params domain.WorkOrderParams, langCode string) ([]domain.WorkOrder, error) {
+	var workOrders []model.WorkOrder
+	db := r.preloadWorkOrderRelations(r.db.WithContext(ctx).Table("work_orders wo"))
+
+	db = r.applyWorkOrderSearch(db, params.SearchQuery)
+	db = r.applyWorkOrderFilters(db, params.Filters)
+
+	// Apply sorting - for cursor pagination, we need consistent ordering by ID
+	if params.Sort != nil && params.Sort.Field != "" {
+		db = r.applyWorkOrderSorts(db, params.Sort)
+	}
+	db = db.Order("wo.id DESC")
+
+	// Apply cursor-based pagination
+	if params.Pagination != nil {
+		if params.Pagination.Cursor != "" {
+			db = db.Where("wo.id < ?", params.Pagination.Cursor)
+		}
+		if params.Pagination.Limit > 0 {
+			db = db.Limit(params.Pagination.Limit)
+		}
+	}
+
+	if err := db.Find(&workOrders).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainWorkOrders(workOrders), nil
+}
+
+func (r *WorkOrderRepository) GetWorkOrderById(ctx context.Context, workOrderId string) (domain.WorkOrder, error) {
+	var workOrder model.WorkOrder
+
+	err := r.preloadWorkOrderRelations(r.db.WithContext(ctx).Table("work_orders wo")).
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
+		First(&workOrder, "wo.id = ?", workOrderId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.WorkOrder{}, domain.ErrNotFound("work order")
+		}
+		return domain.WorkOrder{}, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainWorkOrder(&workOrder), nil
+}
+
+func (r *WorkOrderRepository) CountWorkOrders(ctx context.Context, params domain.WorkOrderParams) (int64, error) {
+	var count int64
+	db := r.db.WithContext(ctx).Table("work_orders wo")
+
+	db = r.applyWorkOrderSearch(db, params.SearchQuery)
+	db = r.applyWorkOrderFilters(db, params.Filters)
+
+	if err := db.Count(&count).Error; err != nil {
+		return 0, domain.ErrInternal(err)
+	}
+	return count, nil
+}
+
+func (r *WorkOrderRepository) GetWorkOrderStatistics(ctx context.Context) (domain.WorkOrderStatistics, error) {
+	var stats domain.WorkOrderStatistics
+
+	// Total work orders
+	var total int64
+	if err := r.scopedWorkOrders(ctx).Count(&total).Error; err != nil {
+		return stats, domain.ErrInternal(err)
+	}
+	stats.Total.Count = int(total)
+
+	// By status
+	var statusCounts []struct {
+		Status domain.WorkOrderStatus
+		Count  int64
+	}
+	if err := r.scopedWorkOrders(ctx).
+		Select("status, COUNT(*) as count").
+		Group("status").
+		Scan(&statusCounts).Error; err != nil {
+		return stats, domain.ErrInternal(err)
+	}
+	for _, sc := range statusCounts {
+		switch sc.Status {
+		case domain.WorkOrderStatusOpen:
+			stats.ByStatus.Open = int(sc.Count)
+		case domain.WorkOrderStatusAssigned:
+			stats.ByStatus.Assigned = int(sc.Count)
+		case domain.WorkOrderStatusInProgress:
+			stats.ByStatus.InProgress = int(sc.Count)
+		case domain.WorkOrderStatusOnHold:
+			stats.ByStatus.OnHold = int(sc.Count)
+		case domain.WorkOrderStatusDone:
+			stats.ByStatus.Done = int(sc.Count)
+		case domain.WorkOrderStatusCancelled:
+			stats.ByStatus.Cancelled = int(sc.Count)
+		}
+	}
+
+	// By priority
+	var priorityCounts []struct {
+		Priority domain.WorkOrderPriority
+		Count    int64
+	}
+	if err := r.scopedWorkOrders(ctx).
+		Select("priority, COUNT(*) as count").
+		Group("priority").
+		Scan(&priorityCounts).Error; err != nil {
+		return stats, domain.ErrInternal(err)
+	}
+	for _, pc := range priorityCounts {
+		switch pc.Priority {
+		case domain.WorkOrderPriorityLow:
+			stats.ByPriority.Low = int(pc.Count)
+		case domain.WorkOrderPriorityMedium:
+			stats.ByPriority.Medium = int(pc.Count)
+		case domain.WorkOrderPriorityHigh:
+			stats.ByPriority.High = int(pc.Count)
+		case domain.WorkOrderPriorityCritical:
+			stats.ByPriority.Critical = int(pc.Count)
+		}
+	}
+
+	// By technician
+	var byTechnicianResults []struct {
+		UserID                 string
+		UserName               string
+		UserEmail              string
+		AssignedCount          int64
+		ActiveCount            int64
+		CompletedCount         int64
+		AverageCompletionHours float64
+	}
+	if err := r.db.WithContext(ctx).
+		Table("work_orders wo").
+		Scopes(query.LocationScope(query.AssetLocationCondition)).
+		Select("u.id as user_id, u.full_name as user_name, u.email as user_email, "+
+			"COUNT(*) as assigned_count, "+
+			"COUNT(*) FILTER (WHERE wo.status IN ?) as active_count, "+
+			"COUNT(*) FILTER (WHERE wo.status = ?) as completed_count, "+
+			"COALESCE(AVG(EXTRACT(EPOCH FROM (wo.completed_at - COALESCE(wo.started_at, wo.assigned_at))) / 3600) "+
+			"FILTER (WHERE wo.status = ? AND wo.completed_at IS NOT NULL), 0) as average_completion_hours",
+			[]domain.WorkOrderStatus{domain.WorkOrderStatusAssigned, domain.WorkOrderStatusInProgress, domain.WorkOrderStatusOnHold},
+			domain.WorkOrderStatusDone, domain.WorkOrderStatusDone).
+		Joins("INNER JOIN users u ON wo.assigned_to = u.id").
+		Group("u.id, u.full_name, u.email").
+		Order("assigned_count DESC").
+		Scan(&byTechnicianResults).Error; err != nil {
+		return stats, domain.ErrInternal(err)
+	}
+	for _, result := range byTechnicianResults {
+		stats.ByTechnician = append(stats.ByTechnician, domain.TechnicianWorkOrderStatistics{
+			UserID:                 result.UserID,
+			UserName:               result.UserName,
+			UserEmail:              result.UserEmail,
+			AssignedCount:          int(result.AssignedCount),
+			ActiveCount:            int(result.ActiveCount),
+			CompletedCount:         int(result.CompletedCount),
+			AverageCompletionHours: result.AverageCompletionHours,
+		})
+	}
+
+	// Completion trend (last 30 days)
+	var completionTrends []struct {
+		Date  time.Time
+		Count int64
+	}
+	if err := r.scopedWorkOrders(ctx).
+		Select("DATE(completed_at) as date, COUNT(*) as count").
+		Where("status = ? AND completed_at >= NOW() - INTERVAL '30 days'", domain.WorkOrderStatusDone).
+		Group("DATE(completed_at)").
+		Order("date ASC").
+		Scan(&completionTrends).Error; err != nil {
+		return stats, domain.ErrInternal(err)
+	}
+	for _, ct := range completionTrends {
+		stats.CompletionTrend = append(stats.CompletionTrend, domain.WorkOrderCompletionTrend{
+			Date:  ct.Date,
+			Count: int(ct.Count),
+		})
+	}
+
+	// Summary statistics
+	stats.Summary.TotalWorkOrders = int(total)
+	stats.Summary.ActiveWorkOrders = stats.ByStatus.Open + stats.ByStatus.Assigned + stats.ByStatus.InProgress + stats.ByStatus.OnHold
+
+	var overdue, unassigned, fromSchedules, fromIssueReports int64
+	if err := r.scopedWorkOrders(ctx).
+		Where("status NOT IN ? AND due_date < ?", terminalWorkOrderStatuses, time.Now()).
+		Count(&overdue).Error; err != nil {
+		return stats, domain.ErrInternal(err)
+	}
+	if err := r.scopedWorkOrders(ctx).
+		Where("status NOT IN ? AND assigned_to IS NULL", terminalWorkOrderStatuses).
+		Count(&unassigned).Error; err != nil {
+		return stats, domain.ErrInternal(err)
+	}
+	if err := r.scopedWorkOrders(ctx).
+		Where("schedule_id IS NOT NULL").
+		Count(&fromSchedules).Error; err != nil {
+		return stats, domain.ErrInternal(err)
+	}
+	if err := r.scopedWorkOrders(ctx).
+		Where("issue_report_id IS NOT NULL").
+		Count(&fromIssueReports).Error; err != nil {
+		return stats, domain.ErrInternal(err)
+	}
+	stats.Summary.OverdueWorkOrders = int(overdue)
+	stats.Summary.UnassignedWorkOrders = int(unassigned)
+	stats.Summary.FromSchedules = int(fromSchedules)
+	stats.Summary.FromIssueReports = int(fromIssueReports)
+
+	// Completion rate only counts work orders that reached an end state
+	if finished := stats.ByStatus.Done + stats.ByStatus.Cancelled; finished > 0 {
+		stats.Summary.CompletionRate = float64(stats.ByStatus.Done) / float64(finished) * 100
+	}
+
+	var averageCompletionHours float64
+	if err := r.scopedWorkOrders(ctx).
+		Select("COALESCE(AVG(EXTRACT(EPOCH FROM (completed_at - created_at)) / 3600), 0)").
+		Where("status = ? AND completed_at IS NOT NULL", domain.WorkOrderStatusDone).
+		Scan(&averageCompletionHours).Error; err == nil {
+		stats.Summary.AverageCompletionHours = averageCompletionHours
+	}
+
+	var totalPartsCost float64
+	if err := r.db.WithContext(ctx).Model(&model.WorkOrderPart{}).Scopes(query.LocationScope(workOrderPartLocationScope)).
+		Select("COALESCE(SUM(quantity * unit_cost), 0)").
+		Where("unit_cost IS NOT NULL").
+		Scan(&totalPartsCost).Error; err == nil {
+		stats.Summary.TotalPartsCost = totalPartsCost
+	}
+
+	return stats, nil
+}
+
+func (r *WorkOrderRepository) GetWorkOrdersForExport(ctx context.Context, params domain.WorkOrderParams, langCode string) ([]domain.WorkOrder, error) {
+	var workOrders []model.WorkOrder
+	db := r.preloadWorkOrderRelations(r.db.WithContext(ctx).Table("work_orders wo"))
+
+	db = r.applyWorkOrderSearch(db, params.SearchQuery)
+	db = r.applyWorkOrderFilters(db, params.Filters)
+	db = r.applyWorkOrderSorts(db, params.Sort)
+
+	// No pagination for export - get all matching work orders
+	if err := db.Find(&workOrders).Error; err != nil {
+		return nil, domain.ErrInternal(err)
+	}
+
+	return mapper.ToDomainWorkOrders(workOrders), nil
+}
+
+func (r *WorkOrderRepository) CheckWorkOrderExist(ctx context.Context, workOrderId string) (bool, error) {
+	var count int64
+	if err := r.scopedWorkOrders(ctx).Where("id = ?", workOrderId).Count(&count).Error; err != nil {
+		return false, domain.ErrInternal(err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,140 @@
+package rest
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/api_key"
+	"github.com/gofiber/fiber/v2"
+)
+
+type APIKeyHandler struct {
+	Service api_key.APIKeyService
+}
+
+func NewAPIKeyHandler(app fiber.Router, s api_key.APIKeyService) {
+	handler := &APIKeyHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	apiKeys := app.Group("/api-keys")
+
+	apiKeys.Post("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAPIKeyManage),
+		handler.CreateAPIKey,
+	)
+	apiKeys.Get("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAPIKeyManage),
+		handler.GetAPIKeys,
+	)
+	apiKeys.Get("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAPIKeyManage),
+		handler.GetAPIKeyById,
+	)
+	apiKeys.Patch("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAPIKeyManage),
+		handler.UpdateAPIKey,
+	)
+	apiKeys.Post("/:id/rotate",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAPIKeyManage),
+		handler.RotateAPIKey,
+	)
+	apiKeys.Delete("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAPIKeyManage),
+		handler.RevokeAPIKey,
+	)
+}
+
+// *===========================MUTATION===========================*
+func (h *APIKeyHandler) CreateAPIKey(c *fiber.Ctx) error {
+	var payload domain.CreateAPIKeyPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	apiKey, err := h.Service.CreateAPIKey(c.Context(), &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessAPIKeyCreatedKey, apiKey)
+}
+
+func (h *APIKeyHandler) UpdateAPIKey(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAPIKeyIDRequiredKey))
+	}
+
+	var payload domain.UpdateAPIKeyPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	apiKey, err := h.Service.UpdateAPIKey(c.Context(), id, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAPIKeyUpdatedKey, apiKey)
+}
+
+func (h *APIKeyHandler) RotateAPIKey(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAPIKeyIDRequiredKey))
+	}
+
+	apiKey, err := h.Service.RotateAPIKey(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAPIKeyRotatedKey, apiKey)
+}
+
+func (h *APIKeyHandler) RevokeAPIKey(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAPIKeyIDRequiredKey))
+	}
+
+	if err := h.Service.RevokeAPIKey(c.Context(), id); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAPIKeyRevokedKey, nil)
+}
+
+// *===========================QUERY===========================*
+func (h *APIKeyHandler) GetAPIKeys(c *fiber.Ctx) error {
+	apiKeys, err := h.Service.GetAPIKeys(c.Context())
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAPIKeyRetrievedKey, apiKeys)
+}
+
+func (h *APIKeyHandler) GetAPIKeyById(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAPIKeyIDRequiredKey))
+	}
+
+	apiKey, err := h.Service.GetAPIKeyById(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAPIKeyRetrievedKey, apiKey)
+}
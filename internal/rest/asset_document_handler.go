@@ -0,0 +1,242 @@
+package rest
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/asset_document"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AssetDocumentHandler struct {
+	Service asset_document.AssetDocumentService
+}
+
+func NewAssetDocumentHandler(app fiber.Router, s asset_document.AssetDocumentService) {
+	handler := &AssetDocumentHandler{
+		Service: s,
+	}
+
+	// * Nested di bawah asset, location scope asset ikut berlaku
+	documents := app.Group("/assets/:id/documents")
+
+	documents.Get("/",
+		middleware.AuthMiddleware(),
+		handler.GetAssetDocuments,
+	)
+	documents.Post("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAssetUpdate),
+		handler.UploadAssetDocument,
+	)
+
+	// * Route statis harus sebelum /:documentId
+	documents.Get("/zip",
+		middleware.AuthMiddleware(),
+		handler.DownloadAssetDocumentsZip,
+	)
+
+	documents.Get("/:documentId",
+		middleware.AuthMiddleware(),
+		handler.GetAssetDocumentById,
+	)
+	documents.Get("/:documentId/download",
+		middleware.AuthMiddleware(),
+		handler.DownloadAssetDocument,
+	)
+	documents.Delete("/:documentId",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAssetUpdate),
+		handler.DeleteAssetDocument,
+	)
+
+	app.Get("/maintenance/records/:id/documents",
+		middleware.AuthMiddleware(),
+		handler.GetMaintenanceRecordDocuments,
+	)
+}
+
+func (h *AssetDocumentHandler) parseAssetDocumentFilters(c *fiber.Ctx) (*domain.AssetDocumentFilterOptions, error) {
+	filters := &domain.AssetDocumentFilterOptions{}
+
+	if documentType := c.Query("type"); documentType != "" {
+		docType := domain.AssetDocumentType(documentType)
+		if !docType.IsValid() {
+			return nil, domain.ErrBadRequestWithKey(utils.ErrAssetDocumentTypeInvalidKey)
+		}
+		filters.Type = &docType
+	}
+
+	if recordId := c.Query("maintenanceRecordId"); recordId != "" {
+		filters.MaintenanceRecordID = &recordId
+	}
+
+	if expiresBefore := c.Query("expiresBefore"); expiresBefore != "" {
+		if _, err := time.Parse("2006-01-02", expiresBefore); err != nil {
+			return nil, domain.ErrBadRequest("invalid expiresBefore format, expected YYYY-MM-DD")
+		}
+		filters.ExpiresBefore = &expiresBefore
+	}
+
+	return filters, nil
+}
+
+// *===========================MUTATION===========================*
+func (h *AssetDocumentHandler) UploadAssetDocument(c *fiber.Ctx) error {
+	assetId := c.Params("id")
+	if assetId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetIDRequiredKey))
+	}
+
+	var payload domain.CreateAssetDocumentPayload
+	if err := web.ParseFormAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetDocumentFileRequiredKey))
+	}
+
+	document, err := h.Service.UploadAssetDocument(c.Context(), assetId, &payload, file)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessAssetDocumentUploadedKey, document)
+}
+
+func (h *AssetDocumentHandler) DeleteAssetDocument(c *fiber.Ctx) error {
+	assetId := c.Params("id")
+	if assetId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetIDRequiredKey))
+	}
+
+	documentId := c.Params("documentId")
+	if documentId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetDocumentIDRequiredKey))
+	}
+
+	if err := h.Service.DeleteAssetDocument(c.Context(), assetId, documentId); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetDocumentDeletedKey, nil)
+}
+
+// *===========================QUERY===========================*
+func (h *AssetDocumentHandler) GetAssetDocuments(c *fiber.Ctx) error {
+	assetId := c.Params("id")
+	if assetId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetIDRequiredKey))
+	}
+
+	filters, err := h.parseAssetDocumentFilters(c)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	documents, err := h.Service.GetAssetDocuments(c.Context(), assetId, filters)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetDocumentsRetrievedKey, documents)
+}
+
+func (h *AssetDocumentHandler) GetAssetDocumentById(c *fiber.Ctx) error {
+	assetId := c.Params("id")
+	if assetId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetIDRequiredKey))
+	}
+
+	documentId := c.Params("documentId")
+	if documentId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetDocumentIDRequiredKey))
+	}
+
+	document, err := h.Service.GetAssetDocumentById(c.Context(), assetId, documentId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetDocumentRetrievedKey, document)
+}
+
+func (h *AssetDocumentHandler) DownloadAssetDocument(c *fiber.Ctx) error {
+	assetId := c.Params("id")
+	if assetId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetIDRequiredKey))
+	}
+
+	documentId := c.Params("documentId")
+	if documentId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetDocumentIDRequiredKey))
+	}
+
+	file, err := h.Service.DownloadAssetDocument(c.Context(), assetId, documentId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	c.Set("Content-Type", file.ContentType)
+	c.Set("Content-Disposition", attachmentDisposition(file.FileName))
+
+	return c.Send(file.Data)
+}
+
+func (h *AssetDocumentHandler) DownloadAssetDocumentsZip(c *fiber.Ctx) error {
+	assetId := c.Params("id")
+	if assetId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetIDRequiredKey))
+	}
+
+	filters, err := h.parseAssetDocumentFilters(c)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	bundle, err := h.Service.DownloadAssetDocumentsZip(c.Context(), assetId, filters)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	c.Set("Content-Type", "application/zip")
+	c.Set("Content-Disposition", attachmentDisposition(bundle.FileName))
+
+	// * Status dan header sudah terkirim saat stream berjalan, error di tengah hanya bisa di-log dan memutus response
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := bundle.Write(w); err != nil {
+			log.Printf("Warning: Failed to stream documents zip %s: %v", bundle.FileName, err)
+		}
+	})
+
+	return nil
+}
+
+func (h *AssetDocumentHandler) GetMaintenanceRecordDocuments(c *fiber.Ctx) error {
+	recordId := c.Params("id")
+	if recordId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrMaintenanceRecordIDRequiredKey))
+	}
+
+	documents, err := h.Service.GetMaintenanceRecordDocuments(c.Context(), recordId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetDocumentsRetrievedKey, documents)
+}
+
+// attachmentDisposition quotes the file name, nama file upload bisa berisi spasi
+func attachmentDisposition(filename string) string {
+	filename = strings.NewReplacer(`"`, "", "\r", "", "\n", "").Replace(filename)
+	return fmt.Sprintf(`attachment; filename="%s"`, filename)
+}
@@ -5,6 +5,7 @@ import (
 	"mime/multipart"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/rest/middleware"
@@ -29,87 +30,118 @@ func NewAssetHandler(app fiber.Router, s asset.AssetService) {
 	// * Create
 	assets.Post("/",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetCreate),
 		handler.CreateAsset,
 	)
 	assets.Post("/bulk",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetCreate),
 		handler.BulkCreateAssets,
 	)
 
-	assets.Get("/", handler.GetAssetsPaginated)
-	assets.Get("/statistics", handler.GetAssetStatistics)
-	assets.Get("/cursor", handler.GetAssetsCursor)
-	assets.Get("/count", handler.CountAssets)
-	assets.Get("/tag/:tag", handler.GetAssetByAssetTag)
+	assets.Get("/",
+		middleware.AuthMiddleware(),
+		handler.GetAssetsPaginated,
+	)
+	assets.Get("/statistics",
+		middleware.AuthMiddleware(),
+		handler.GetAssetStatistics,
+	)
+	assets.Get("/depreciation",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAssetExport),
+		handler.GetAssetDepreciationReport,
+	)
+	assets.Get("/cursor",
+		middleware.AuthMiddleware(),
+		handler.GetAssetsCursor,
+	)
+	assets.Get("/count",
+		middleware.AuthMiddleware(),
+		handler.CountAssets,
+	)
+	assets.Get("/tag/:tag",
+		middleware.AuthMiddleware(),
+		handler.GetAssetByAssetTag,
+	)
 	assets.Get("/check/tag/:tag", handler.CheckAssetTagExists)
 	assets.Get("/check/serial/:serial", handler.CheckSerialNumberExists)
-	assets.Get("/check/:id", handler.CheckAssetExists)
+	assets.Get("/check/:id",
+		middleware.AuthMiddleware(),
+		handler.CheckAssetExists,
+	)
 	assets.Post("/generate-tag", handler.GenerateAssetTagSuggestion)
 	assets.Post("/generate-bulk-tags", handler.GenerateBulkAssetTags)
 	assets.Get("/images", handler.GetAvailableAssetImages)
 	assets.Post("/upload/template-images",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetCreate),
 		handler.UploadTemplateImages,
 	)
 	assets.Post("/upload/bulk-datamatrix",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetUpdate),
 		handler.UploadBulkDataMatrixImages,
 	)
 	assets.Post("/delete/bulk-datamatrix",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetUpdate),
 		handler.DeleteBulkDataMatrixImages,
 	)
 
 	// * Asset Images (Independent Operations)
 	assets.Post("/upload/bulk-images",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetUpdate),
 		handler.UploadBulkAssetImages,
 	)
 	assets.Post("/delete/bulk-images",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetUpdate),
 		handler.DeleteBulkAssetImages,
 	)
 
-	assets.Get("/:id", handler.GetAssetById)
+	assets.Get("/:id",
+		middleware.AuthMiddleware(),
+		handler.GetAssetById,
+	)
 	assets.Patch("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetUpdate),
 		handler.UpdateAsset,
 	)
 	assets.Delete("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetDelete),
 		handler.DeleteAsset,
 	)
 	assets.Post("/bulk-delete",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetDelete),
 		handler.BulkDeleteAssets,
 	)
 
 	// * Export endpoints
 	assets.Post("/export/list",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetExport),
 		handler.ExportAssetList,
 	)
 	assets.Get("/export/statistics",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetExport),
 		handler.ExportAssetStatistics,
 	)
 	assets.Post("/export/datamatrix",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetExport),
 		handler.ExportAssetDataMatrix,
 	)
+	assets.Post("/export/depreciation",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAssetExport),
+		handler.ExportAssetDepreciation,
+	)
 }
 
 func (h *AssetHandler) parseAssetFiltersAndSort(c *fiber.Ctx) (domain.AssetParams, error) {
@@ -171,6 +203,18 @@ func (h *AssetHandler) parseAssetFiltersAndSort(c *fiber.Ctx) (domain.AssetParam
 		filters.Model = &model
 	}
 
+	// * Custom attribute filters use attr.<key>=value, e.g. attr.ram=16
+	for key, value := range c.Queries() {
+		attributeKey, found := strings.CutPrefix(key, "attr.")
+		if !found || attributeKey == "" || value == "" {
+			continue
+		}
+		if filters.CustomAttributes == nil {
+			filters.CustomAttributes = make(map[string]string)
+		}
+		filters.CustomAttributes[attributeKey] = value
+	}
+
 	params.Filters = filters
 
 	return params, nil
@@ -451,6 +495,31 @@ func (h *AssetHandler) GetAssetStatistics(c *fiber.Ctx) error {
 	return web.Success(c, fiber.StatusOK, utils.SuccessAssetStatisticsRetrievedKey, stats)
 }
 
+func (h *AssetHandler) GetAssetDepreciationReport(c *fiber.Ctx) error {
+	params, err := h.parseAssetFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	asOf := time.Now()
+	if asOfStr := c.Query("asOf"); asOfStr != "" {
+		parsedDate, err := time.ParseInLocation("2006-01-02", asOfStr, time.UTC)
+		if err != nil {
+			return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetDepreciationAsOfInvalidKey, asOfStr))
+		}
+		asOf = parsedDate
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+
+	report, err := h.Service.GetAssetDepreciationReport(c.Context(), params, asOf, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetDepreciationRetrievedKey, report)
+}
+
 func (h *AssetHandler) GenerateAssetTagSuggestion(c *fiber.Ctx) error {
 	var payload domain.GenerateAssetTagPayload
 
@@ -607,6 +676,37 @@ func (h *AssetHandler) ExportAssetDataMatrix(c *fiber.Ctx) error {
 	return c.Send(data)
 }
 
+func (h *AssetHandler) ExportAssetDepreciation(c *fiber.Ctx) error {
+	var payload domain.ExportAssetDepreciationPayload
+
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	// Export asset depreciation report
+	data, filename, err := h.Service.ExportAssetDepreciation(c.Context(), &payload, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// Set appropriate content type and headers
+	var contentType string
+	switch payload.Format {
+	case domain.ExportFormatPDF:
+		contentType = "application/pdf"
+	case domain.ExportFormatExcel:
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	c.Set("Content-Type", contentType)
+	c.Set("Content-Disposition", "attachment; filename="+filename)
+
+	return c.Send(data)
+}
+
 // *===========================TEMPLATE IMAGES (FOR BULK CREATE)===========================*
 
 func (h *AssetHandler) GetAvailableAssetImages(c *fiber.Ctx) error {
@@ -0,0 +1,295 @@
+package rest
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/asset_loan"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AssetLoanHandler struct {
+	Service asset_loan.AssetLoanService
+}
+
+func NewAssetLoanHandler(app fiber.Router, s asset_loan.AssetLoanService) {
+	handler := &AssetLoanHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	loans := app.Group("/asset-loans")
+
+	// * Check-out / check-in
+	loans.Post("/check-out",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAssetLoanManage),
+		handler.CheckOutAsset,
+	)
+	loans.Post("/:id/check-in",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAssetLoanManage),
+		handler.CheckInAsset,
+	)
+
+	loans.Get("/", handler.GetAssetLoansPaginated)
+	loans.Get("/cursor", handler.GetAssetLoansCursor)
+	loans.Get("/count", handler.CountAssetLoans)
+	loans.Get("/me",
+		middleware.AuthMiddleware(),
+		handler.GetCurrentUserLoans,
+	)
+	loans.Get("/user/:userId", handler.GetCurrentLoansByUserId)
+	loans.Get("/asset/:assetId/active", handler.GetActiveAssetLoanByAssetId)
+	loans.Get("/:id", handler.GetAssetLoanById)
+}
+
+func (h *AssetLoanHandler) parseAssetLoanFiltersAndSort(c *fiber.Ctx) (domain.AssetLoanParams, error) {
+	params := domain.AssetLoanParams{}
+
+	// * Parse search query
+	search := c.Query("search")
+	if search != "" {
+		params.SearchQuery = &search
+	}
+
+	// * Parse sorting options
+	sortBy := c.Query("sortBy")
+	if sortBy != "" {
+		sortOrder := c.Query("sortOrder", "desc")
+		params.Sort = &domain.AssetLoanSortOptions{
+			Field: domain.AssetLoanSortField(sortBy),
+			Order: domain.SortOrder(sortOrder),
+		}
+	}
+
+	// * Parse filtering options
+	filters := &domain.AssetLoanFilterOptions{}
+
+	if assetID := c.Query("assetId"); assetID != "" {
+		filters.AssetID = &assetID
+	}
+
+	if borrowerID := c.Query("borrowerId"); borrowerID != "" {
+		filters.BorrowerID = &borrowerID
+	}
+
+	if checkedOutBy := c.Query("checkedOutBy"); checkedOutBy != "" {
+		filters.CheckedOutBy = &checkedOutBy
+	}
+
+	if status := c.Query("status"); status != "" {
+		loanStatus := domain.AssetLoanStatus(status)
+		filters.Status = &loanStatus
+	}
+
+	if isOverdueStr := c.Query("isOverdue"); isOverdueStr != "" {
+		if isOverdue, err := strconv.ParseBool(isOverdueStr); err == nil {
+			filters.IsOverdue = &isOverdue
+		}
+	}
+
+	// * Parse date range filters
+	if dateFrom := c.Query("dateFrom"); dateFrom != "" {
+		if parsedDate, err := time.ParseInLocation("2006-01-02", dateFrom, time.UTC); err == nil {
+			filters.DateFrom = &parsedDate
+		}
+	}
+
+	if dateTo := c.Query("dateTo"); dateTo != "" {
+		if parsedDate, err := time.ParseInLocation("2006-01-02", dateTo, time.UTC); err == nil {
+			filters.DateTo = &parsedDate
+		}
+	}
+
+	params.Filters = filters
+
+	return params, nil
+}
+
+// *===========================MUTATION===========================*
+func (h *AssetLoanHandler) CheckOutAsset(c *fiber.Ctx) error {
+	var payload domain.CheckOutAssetPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get user ID from context (set by auth middleware)
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+
+	loan, err := h.Service.CheckOutAsset(c.Context(), &payload, userID, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessAssetCheckedOutKey, loan)
+}
+
+func (h *AssetLoanHandler) CheckInAsset(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetLoanIDRequiredKey))
+	}
+
+	var payload domain.CheckInAssetPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get user ID from context (set by auth middleware)
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+
+	loan, err := h.Service.CheckInAsset(c.Context(), id, &payload, userID, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetCheckedInKey, loan)
+}
+
+// *===========================QUERY===========================*
+func (h *AssetLoanHandler) GetAssetLoansPaginated(c *fiber.Ctx) error {
+	params, err := h.parseAssetLoanFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Offset: offset}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	loans, total, err := h.Service.GetAssetLoansPaginated(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.SuccessWithOffsetInfo(c, fiber.StatusOK, utils.SuccessAssetLoanRetrievedKey, loans, int(total), limit, (offset/limit)+1)
+}
+
+func (h *AssetLoanHandler) GetAssetLoansCursor(c *fiber.Ctx) error {
+	params, err := h.parseAssetLoanFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	cursor := c.Query("cursor")
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Cursor: cursor}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	loans, err := h.Service.GetAssetLoansCursor(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	var nextCursor string
+	hasNextPage := len(loans) == limit
+	if hasNextPage {
+		nextCursor = loans[len(loans)-1].ID
+	}
+
+	return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessAssetLoanRetrievedKey, loans, nextCursor, hasNextPage, limit)
+}
+
+func (h *AssetLoanHandler) GetAssetLoanById(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetLoanIDRequiredKey))
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	loan, err := h.Service.GetAssetLoanById(c.Context(), id, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetLoanRetrievedKey, loan)
+}
+
+func (h *AssetLoanHandler) GetActiveAssetLoanByAssetId(c *fiber.Ctx) error {
+	assetId := c.Params("assetId")
+	if assetId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAssetIDRequiredKey))
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	loan, err := h.Service.GetActiveAssetLoanByAssetId(c.Context(), assetId, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetLoanRetrievedKey, loan)
+}
+
+func (h *AssetLoanHandler) GetCurrentLoansByUserId(c *fiber.Ctx) error {
+	userId := c.Params("userId")
+	if userId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	loans, err := h.Service.GetCurrentLoansByUserId(c.Context(), userId, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetLoanRetrievedKey, loans)
+}
+
+func (h *AssetLoanHandler) GetCurrentUserLoans(c *fiber.Ctx) error {
+	// * Get user ID from context (set by auth middleware)
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	loans, err := h.Service.GetCurrentLoansByUserId(c.Context(), userID, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetLoanRetrievedKey, loans)
+}
+
+func (h *AssetLoanHandler) CountAssetLoans(c *fiber.Ctx) error {
+	params, err := h.parseAssetLoanFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	count, err := h.Service.CountAssetLoans(c.Context(), params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAssetLoanCountedKey, count)
+}
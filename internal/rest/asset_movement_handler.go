@@ -28,42 +28,63 @@ func NewAssetMovementHandler(app fiber.Router, s asset_movement.AssetMovementSer
 	// * Create
 	movements.Post("/",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetMovementCreate),
 		handler.CreateAssetMovement,
 	)
 	movements.Post("/bulk",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetMovementCreate),
 		handler.BulkCreateAssetMovements,
 	)
 
-	movements.Get("/", handler.GetAssetMovementsPaginated)
-	movements.Get("/statistics", handler.GetAssetMovementStatistics)
-	movements.Get("/cursor", handler.GetAssetMovementsCursor)
-	movements.Get("/count", handler.CountAssetMovements)
-	movements.Get("/check/:id", handler.CheckAssetMovementExists)
-	movements.Get("/asset/:assetId", handler.GetAssetMovementsByAssetId)
-	movements.Get("/:id", handler.GetAssetMovementById)
+	movements.Get("/",
+		middleware.AuthMiddleware(),
+		handler.GetAssetMovementsPaginated,
+	)
+	movements.Get("/statistics",
+		middleware.AuthMiddleware(),
+		handler.GetAssetMovementStatistics,
+	)
+	movements.Get("/cursor",
+		middleware.AuthMiddleware(),
+		handler.GetAssetMovementsCursor,
+	)
+	movements.Get("/count",
+		middleware.AuthMiddleware(),
+		handler.CountAssetMovements,
+	)
+	movements.Get("/check/:id",
+		middleware.AuthMiddleware(),
+		handler.CheckAssetMovementExists,
+	)
+	movements.Get("/asset/:assetId",
+		middleware.AuthMiddleware(),
+		handler.GetAssetMovementsByAssetId,
+	)
+	movements.Get("/:id",
+		middleware.AuthMiddleware(),
+		handler.GetAssetMovementById,
+	)
 	movements.Patch("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetMovementUpdate),
 		handler.UpdateAssetMovement,
 	)
 	movements.Delete("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetMovementDelete),
 		handler.DeleteAssetMovement,
 	)
 	movements.Post("/bulk-delete",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetMovementDelete),
 		handler.BulkDeleteAssetMovements,
 	)
 
 	// * Export endpoints
 	movements.Post("/export/list",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionAssetMovementExport),
 		handler.ExportAssetMovementList,
 	)
 }
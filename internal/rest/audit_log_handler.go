@@ -0,0 +1,187 @@
+package rest
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/audit_log"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AuditLogHandler struct {
+	Service audit_log.AuditLogService
+}
+
+// * Resource path prefix yang punya endpoint history, harus sama dengan group di handler masing-masing
+var auditHistoryResources = map[string]domain.AuditEntityType{
+	"/assets":                  domain.AuditEntityAsset,
+	"/categories":              domain.AuditEntityCategory,
+	"/locations":               domain.AuditEntityLocation,
+	"/users":                   domain.AuditEntityUser,
+	"/maintenance/schedules":   domain.AuditEntityMaintenanceSchedule,
+	"/maintenance/records":     domain.AuditEntityMaintenanceRecord,
+	"/maintenance/work-orders": domain.AuditEntityWorkOrder,
+	"/issue-reports":           domain.AuditEntityIssueReport,
+	"/stock-items":             domain.AuditEntityStockItem,
+	"/roles":                   domain.AuditEntityRole,
+	"/api-keys":                domain.AuditEntityAPIKey,
+	"/webhooks":                domain.AuditEntityWebhookEndpoint,
+}
+
+func NewAuditLogHandler(app fiber.Router, s audit_log.AuditLogService) {
+	handler := &AuditLogHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	auditLogs := app.Group("/audit-logs")
+
+	auditLogs.Get("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAuditLogRead),
+		handler.GetAuditLogsCursor,
+	)
+	auditLogs.Get("/count",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAuditLogRead),
+		handler.CountAuditLogs,
+	)
+
+	// * Per-entity history, e.g. /assets/:id/history
+	for prefix, entityType := range auditHistoryResources {
+		app.Get(prefix+"/:id/history",
+			middleware.AuthMiddleware(),
+			middleware.RequirePermission(domain.PermissionAuditLogHistory),
+			handler.getEntityHistory(entityType),
+		)
+	}
+}
+
+func (h *AuditLogHandler) parseAuditLogFiltersAndSort(c *fiber.Ctx) (domain.AuditLogParams, error) {
+	params := domain.AuditLogParams{}
+
+	// * Parse sorting options
+	sortBy := c.Query("sortBy")
+	if sortBy != "" {
+		sortOrder := c.Query("sortOrder", "desc")
+		params.Sort = &domain.AuditLogSortOptions{
+			Field: domain.AuditLogSortField(sortBy),
+			Order: domain.SortOrder(sortOrder),
+		}
+	}
+
+	// * Parse filtering options
+	filters := &domain.AuditLogFilterOptions{}
+
+	if entityType := c.Query("entityType"); entityType != "" {
+		auditEntityType := domain.AuditEntityType(entityType)
+		filters.EntityType = &auditEntityType
+	}
+
+	if entityID := c.Query("entityId"); entityID != "" {
+		filters.EntityID = &entityID
+	}
+
+	if actorID := c.Query("actorId"); actorID != "" {
+		filters.ActorID = &actorID
+	}
+
+	if action := c.Query("action"); action != "" {
+		auditAction := domain.AuditAction(action)
+		filters.Action = &auditAction
+	}
+
+	// * Parse date range filters
+	if dateFrom := c.Query("dateFrom"); dateFrom != "" {
+		if parsedDate, err := time.ParseInLocation("2006-01-02", dateFrom, time.UTC); err == nil {
+			filters.DateFrom = &parsedDate
+		}
+	}
+
+	if dateTo := c.Query("dateTo"); dateTo != "" {
+		if parsedDate, err := time.ParseInLocation("2006-01-02", dateTo, time.UTC); err == nil {
+			// * Include the whole day
+			endOfDay := parsedDate.Add(24*time.Hour - time.Nanosecond)
+			filters.DateTo = &endOfDay
+		}
+	}
+
+	params.Filters = filters
+
+	return params, nil
+}
+
+// *===========================QUERY===========================*
+func (h *AuditLogHandler) GetAuditLogsCursor(c *fiber.Ctx) error {
+	params, err := h.parseAuditLogFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	cursor := c.Query("cursor")
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Cursor: cursor}
+
+	logs, err := h.Service.GetAuditLogsCursor(c.Context(), params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	var nextCursor string
+	hasNextPage := len(logs) == limit
+	if hasNextPage {
+		nextCursor = logs[len(logs)-1].ID
+	}
+
+	return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessAuditLogRetrievedKey, logs, nextCursor, hasNextPage, limit)
+}
+
+func (h *AuditLogHandler) getEntityHistory(entityType domain.AuditEntityType) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id := c.Params("id")
+		if id == "" {
+			return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAuditLogEntityIDRequiredKey))
+		}
+
+		params, err := h.parseAuditLogFiltersAndSort(c)
+		if err != nil {
+			return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+		}
+
+		limit, _ := strconv.Atoi(c.Query("limit", "10"))
+		cursor := c.Query("cursor")
+		params.Pagination = &domain.PaginationOptions{Limit: limit, Cursor: cursor}
+
+		logs, err := h.Service.GetEntityHistory(c.Context(), entityType, id, params)
+		if err != nil {
+			return web.HandleError(c, err)
+		}
+
+		var nextCursor string
+		hasNextPage := len(logs) == limit
+		if hasNextPage {
+			nextCursor = logs[len(logs)-1].ID
+		}
+
+		return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessAuditLogRetrievedKey, logs, nextCursor, hasNextPage, limit)
+	}
+}
+
+func (h *AuditLogHandler) CountAuditLogs(c *fiber.Ctx) error {
+	params, err := h.parseAuditLogFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	count, err := h.Service.CountAuditLogs(c.Context(), params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAuditLogCountedKey, count)
+}
@@ -0,0 +1,312 @@
+package rest
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/audit_session"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AuditSessionHandler struct {
+	Service audit_session.AuditSessionService
+}
+
+func NewAuditSessionHandler(app fiber.Router, s audit_session.AuditSessionService) {
+	handler := &AuditSessionHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	sessions := app.Group("/audit-sessions")
+
+	sessions.Post("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAuditSessionManage),
+		handler.CreateAuditSession,
+	)
+	sessions.Post("/:id/close",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAuditSessionManage),
+		handler.CloseAuditSession,
+	)
+	sessions.Post("/:id/export/report",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionAuditSessionExport),
+		handler.ExportAuditSessionReport,
+	)
+
+	sessions.Get("/", handler.GetAuditSessionsPaginated)
+	sessions.Get("/cursor", handler.GetAuditSessionsCursor)
+	sessions.Get("/count", handler.CountAuditSessions)
+	sessions.Get("/:id/items", handler.GetAuditSessionItems)
+	sessions.Get("/:id/report", handler.GetAuditSessionReport)
+	sessions.Get("/:id", handler.GetAuditSessionById)
+}
+
+func (h *AuditSessionHandler) parseAuditSessionFiltersAndSort(c *fiber.Ctx) (domain.AuditSessionParams, error) {
+	params := domain.AuditSessionParams{}
+
+	// * Parse search query
+	search := c.Query("search")
+	if search != "" {
+		params.SearchQuery = &search
+	}
+
+	// * Parse sorting options
+	sortBy := c.Query("sortBy")
+	if sortBy != "" {
+		sortOrder := c.Query("sortOrder", "desc")
+		params.Sort = &domain.AuditSessionSortOptions{
+			Field: domain.AuditSessionSortField(sortBy),
+			Order: domain.SortOrder(sortOrder),
+		}
+	}
+
+	// * Parse filtering options
+	filters := &domain.AuditSessionFilterOptions{}
+
+	if status := c.Query("status"); status != "" {
+		sessionStatus := domain.AuditSessionStatus(status)
+		filters.Status = &sessionStatus
+	}
+
+	if locationID := c.Query("locationId"); locationID != "" {
+		filters.LocationID = &locationID
+	}
+
+	if categoryID := c.Query("categoryId"); categoryID != "" {
+		filters.CategoryID = &categoryID
+	}
+
+	if startedBy := c.Query("startedBy"); startedBy != "" {
+		filters.StartedBy = &startedBy
+	}
+
+	// * Parse date range filters
+	if dateFrom := c.Query("dateFrom"); dateFrom != "" {
+		if parsedDate, err := time.ParseInLocation("2006-01-02", dateFrom, time.UTC); err == nil {
+			filters.DateFrom = &parsedDate
+		}
+	}
+
+	if dateTo := c.Query("dateTo"); dateTo != "" {
+		if parsedDate, err := time.ParseInLocation("2006-01-02", dateTo, time.UTC); err == nil {
+			filters.DateTo = &parsedDate
+		}
+	}
+
+	params.Filters = filters
+
+	return params, nil
+}
+
+// *===========================MUTATION===========================*
+func (h *AuditSessionHandler) CreateAuditSession(c *fiber.Ctx) error {
+	var payload domain.CreateAuditSessionPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get user ID from context (set by auth middleware)
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+
+	session, err := h.Service.CreateAuditSession(c.Context(), &payload, userID, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessAuditSessionCreatedKey, session)
+}
+
+func (h *AuditSessionHandler) CloseAuditSession(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAuditSessionIDRequiredKey))
+	}
+
+	var payload domain.CloseAuditSessionPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get user ID from context (set by auth middleware)
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+
+	report, err := h.Service.CloseAuditSession(c.Context(), id, &payload, userID, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAuditSessionClosedKey, report)
+}
+
+func (h *AuditSessionHandler) ExportAuditSessionReport(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAuditSessionIDRequiredKey))
+	}
+
+	var payload domain.ExportAuditSessionReportPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	// Export reconciliation report
+	data, filename, err := h.Service.ExportAuditSessionReport(c.Context(), id, &payload, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// Set appropriate content type and headers
+	var contentType string
+	switch payload.Format {
+	case domain.ExportFormatPDF:
+		contentType = "application/pdf"
+	case domain.ExportFormatExcel:
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	c.Set("Content-Type", contentType)
+	c.Set("Content-Disposition", "attachment; filename="+filename)
+
+	return c.Send(data)
+}
+
+// *===========================QUERY===========================*
+func (h *AuditSessionHandler) GetAuditSessionsPaginated(c *fiber.Ctx) error {
+	params, err := h.parseAuditSessionFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Offset: offset}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	sessions, total, err := h.Service.GetAuditSessionsPaginated(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.SuccessWithOffsetInfo(c, fiber.StatusOK, utils.SuccessAuditSessionRetrievedKey, sessions, int(total), limit, (offset/limit)+1)
+}
+
+func (h *AuditSessionHandler) GetAuditSessionsCursor(c *fiber.Ctx) error {
+	params, err := h.parseAuditSessionFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	cursor := c.Query("cursor")
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Cursor: cursor}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	sessions, err := h.Service.GetAuditSessionsCursor(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	var nextCursor string
+	hasNextPage := len(sessions) == limit
+	if hasNextPage {
+		nextCursor = sessions[len(sessions)-1].ID
+	}
+
+	return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessAuditSessionRetrievedKey, sessions, nextCursor, hasNextPage, limit)
+}
+
+func (h *AuditSessionHandler) GetAuditSessionById(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAuditSessionIDRequiredKey))
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	session, err := h.Service.GetAuditSessionById(c.Context(), id, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAuditSessionRetrievedKey, session)
+}
+
+func (h *AuditSessionHandler) GetAuditSessionItems(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAuditSessionIDRequiredKey))
+	}
+
+	var result *domain.AuditItemResult
+	if resultStr := c.Query("result"); resultStr != "" {
+		itemResult := domain.AuditItemResult(resultStr)
+		result = &itemResult
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	items, err := h.Service.GetAuditSessionItems(c.Context(), id, result, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAuditSessionRetrievedKey, items)
+}
+
+func (h *AuditSessionHandler) GetAuditSessionReport(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrAuditSessionIDRequiredKey))
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	report, err := h.Service.GetAuditSessionReport(c.Context(), id, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAuditSessionReportRetrievedKey, report)
+}
+
+func (h *AuditSessionHandler) CountAuditSessions(c *fiber.Ctx) error {
+	params, err := h.parseAuditSessionFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	count, err := h.Service.CountAuditSessions(c.Context(), params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessAuditSessionCountedKey, count)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
 	"github.com/Rizz404/inventory-api/internal/utils"
 	"github.com/Rizz404/inventory-api/internal/web"
 	"github.com/gofiber/fiber/v2"
@@ -13,13 +14,28 @@ import (
 type AuthService interface {
 	// * MUTATION
 	Register(ctx context.Context, payload *domain.RegisterPayload) (domain.User, error)
-	Login(ctx context.Context, payload *domain.LoginPayload) (domain.AuthResponse, error)
+	Login(ctx context.Context, payload *domain.LoginPayload, client domain.SessionClientInfo) (domain.LoginResponse, error)
 	RefreshToken(ctx context.Context, payload *domain.RefreshTokenPayload) (domain.AuthResponse, error)
-	ForgotPassword(ctx context.Context, payload *domain.ForgotPasswordPayload) (string, error)
+	ForgotPassword(ctx context.Context, payload *domain.ForgotPasswordPayload, clientIP string) (string, error)
 	VerifyResetCode(ctx context.Context, payload *domain.VerifyResetCodePayload) (domain.VerifyResetCodeResponse, error)
 	ResetPassword(ctx context.Context, payload *domain.ResetPasswordPayload) (string, error)
+	Logout(ctx context.Context, payload *domain.LogoutPayload) error
+	LogoutAll(ctx context.Context, userId string) error
+	RevokeUserSession(ctx context.Context, userId string, sessionId string, reason domain.SessionRevokedReason) error
+	RevokeAllUserSessions(ctx context.Context, userId string) error
+	SetupTwoFactorChallenge(ctx context.Context, payload *domain.TwoFactorChallengeSetupPayload) (domain.TwoFactorSetupResponse, error)
+	VerifyTwoFactorChallenge(ctx context.Context, payload *domain.TwoFactorChallengeVerifyPayload, client domain.SessionClientInfo) (domain.LoginResponse, error)
+	BeginTwoFactorSetup(ctx context.Context, userId string) (domain.TwoFactorSetupResponse, error)
+	EnableTwoFactor(ctx context.Context, userId string, payload *domain.TwoFactorCodePayload) (domain.TwoFactorRecoveryCodesResponse, error)
+	DisableTwoFactor(ctx context.Context, userId string, payload *domain.DisableTwoFactorPayload) error
+	RegenerateRecoveryCodes(ctx context.Context, userId string, payload *domain.TwoFactorCodePayload) (domain.TwoFactorRecoveryCodesResponse, error)
+	ResetUserTwoFactor(ctx context.Context, userId string) error
+	BeginOIDCLogin(ctx context.Context, deviceName string, clientIP string) (domain.OIDCAuthorizationResponse, error)
+	CompleteOIDCLogin(ctx context.Context, payload *domain.OIDCCallbackPayload, client domain.SessionClientInfo) (domain.LoginResponse, error)
 
 	// * QUERY
+	GetUserSessions(ctx context.Context, userId string, currentSessionId string) ([]domain.UserSessionResponse, error)
+	GetTwoFactorStatus(ctx context.Context, userId string) (domain.TwoFactorStatusResponse, error)
 }
 
 type AuthHandler struct {
@@ -37,12 +53,82 @@ func NewAuthHandler(app fiber.Router, s AuthService) {
 
 	// * Create
 	users.Post("/register", handler.Register)
-	users.Post("/login", handler.Login)
+	// * Endpoint yang bisa di-brute force dapat limit per IP yang lebih ketat
+	users.Post("/login", middleware.AuthRateLimitMiddleware(), handler.Login)
 	users.Post("/refresh", handler.RefreshToken)
-	users.Post("/forgot-password", handler.ForgotPassword)
-	users.Post("/verify-reset-code", handler.VerifyResetCode)
-	users.Post("/reset-password", handler.ResetPassword)
+	users.Post("/forgot-password", middleware.AuthRateLimitMiddleware(), handler.ForgotPassword)
+	users.Post("/verify-reset-code", middleware.AuthRateLimitMiddleware(), handler.VerifyResetCode)
+	users.Post("/reset-password", middleware.AuthRateLimitMiddleware(), handler.ResetPassword)
+	users.Post("/logout", handler.Logout)
+	users.Post("/logout-all",
+		middleware.AuthMiddleware(),
+		handler.LogoutAll,
+	)
 
+	// * Session milik user yang sedang login
+	users.Get("/sessions",
+		middleware.AuthMiddleware(),
+		handler.GetCurrentUserSessions,
+	)
+	users.Delete("/sessions/:id",
+		middleware.AuthMiddleware(),
+		handler.RevokeCurrentUserSession,
+	)
+
+	// * Session user lain untuk admin
+	users.Get("/users/:userId/sessions",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionSessionManage),
+		handler.GetUserSessions,
+	)
+	users.Delete("/users/:userId/sessions",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionSessionManage),
+		handler.RevokeAllUserSessions,
+	)
+	users.Delete("/users/:userId/sessions/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionSessionManage),
+		handler.RevokeUserSession,
+	)
+
+	// * Langkah kedua login, pakai challenge token dari /login
+	users.Post("/2fa/challenge/setup", handler.SetupTwoFactorChallenge)
+	users.Post("/2fa/challenge/verify", middleware.AuthRateLimitMiddleware(), handler.VerifyTwoFactorChallenge)
+
+	// * Enrolment 2FA milik user yang sedang login
+	users.Get("/2fa",
+		middleware.AuthMiddleware(),
+		handler.GetTwoFactorStatus,
+	)
+	users.Post("/2fa/setup",
+		middleware.AuthMiddleware(),
+		handler.BeginTwoFactorSetup,
+	)
+	users.Post("/2fa/enable",
+		middleware.AuthMiddleware(),
+		handler.EnableTwoFactor,
+	)
+	users.Post("/2fa/disable",
+		middleware.AuthMiddleware(),
+		handler.DisableTwoFactor,
+	)
+	users.Post("/2fa/recovery-codes",
+		middleware.AuthMiddleware(),
+		handler.RegenerateRecoveryCodes,
+	)
+
+	// * Reset 2FA user lain yang kehilangan device
+	users.Delete("/users/:userId/2fa",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserUpdate),
+		handler.ResetUserTwoFactor,
+	)
+
+	// * Single sign-on OIDC, callback bisa langsung dari redirect IdP (GET) atau diteruskan frontend (POST)
+	users.Get("/oidc/authorize", middleware.AuthRateLimitMiddleware(), handler.BeginOIDCLogin)
+	users.Get("/oidc/callback", middleware.AuthRateLimitMiddleware(), handler.CompleteOIDCLoginRedirect)
+	users.Post("/oidc/callback", middleware.AuthRateLimitMiddleware(), handler.CompleteOIDCLogin)
 }
 
 // *===========================MUTATION===========================*
@@ -78,14 +164,15 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 // Login godoc
 //
 //	@Summary		User login
-//	@Description	Authenticate user with email and password, returns JWT tokens
+//	@Description	Authenticate user with email and password, returns JWT tokens or a challenge token when two-factor authentication is required
 //	@Tags			Authentication
 //	@Accept			json
 //	@Produce		json
 //	@Param			loginPayload	body		domain.LoginPayload	true	"User login credentials"
-//	@Success		200				{object}	web.SuccessResponse{data=domain.AuthResponse}	"Login successful"
+//	@Success		200				{object}	web.SuccessResponse{data=domain.LoginResponse}	"Login successful or two-factor challenge issued"
 //	@Failure		400				{object}	web.ErrorResponse{error=web.ValidationErrors}	"Validation failed"
 //	@Failure		401				{object}	web.ErrorResponse	"Invalid credentials"
+//	@Failure		429				{object}	web.ErrorResponse	"Account temporarily locked or rate limit exceeded"
 //	@Failure		500				{object}	web.ErrorResponse	"Internal server error"
 //	@Router			/auth/login [post]
 func (h *AuthHandler) Login(c *fiber.Ctx) error {
@@ -94,11 +181,20 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		return web.HandleError(c, err)
 	}
 
-	user, err := h.Service.Login(c.Context(), &payload)
+	client := domain.SessionClientInfo{
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+		IPAddress: c.IP(),
+	}
+
+	user, err := h.Service.Login(c.Context(), &payload, client)
 	if err != nil {
 		return web.HandleError(c, err)
 	}
 
+	if user.TwoFactorRequired {
+		return web.Success(c, fiber.StatusOK, utils.SuccessTwoFactorChallengeIssuedKey, user)
+	}
+
 	return web.Success(c, fiber.StatusOK, utils.SuccessLoginKey, user)
 }
 
@@ -139,6 +235,7 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 //	@Param			forgotPasswordPayload	body		domain.ForgotPasswordPayload	true	"Email for password reset"
 //	@Success		200						{object}	web.SuccessResponse{data=object}	"Reset code sent"
 //	@Failure		400						{object}	web.ErrorResponse{error=web.ValidationErrors}	"Validation failed"
+//	@Failure		429						{object}	web.ErrorResponse	"Too many reset code requests"
 //	@Failure		500						{object}	web.ErrorResponse	"Internal server error"
 //	@Router			/auth/forgot-password [post]
 func (h *AuthHandler) ForgotPassword(c *fiber.Ctx) error {
@@ -147,7 +244,7 @@ func (h *AuthHandler) ForgotPassword(c *fiber.Ctx) error {
 		return web.HandleError(c, err)
 	}
 
-	_, err := h.Service.ForgotPassword(c.Context(), &payload)
+	_, err := h.Service.ForgotPassword(c.Context(), &payload, c.IP())
 	if err != nil {
 		return web.HandleError(c, err)
 	}
@@ -165,6 +262,7 @@ func (h *AuthHandler) ForgotPassword(c *fiber.Ctx) error {
 //	@Param			verifyResetCodePayload	body		domain.VerifyResetCodePayload	true	"Email and reset code"
 //	@Success		200						{object}	web.SuccessResponse{data=domain.VerifyResetCodeResponse}	"Code verification result"
 //	@Failure		400						{object}	web.ErrorResponse{error=web.ValidationErrors}	"Validation failed"
+//	@Failure		429						{object}	web.ErrorResponse	"Rate limit exceeded"
 //	@Failure		500						{object}	web.ErrorResponse	"Internal server error"
 //	@Router			/auth/verify-reset-code [post]
 func (h *AuthHandler) VerifyResetCode(c *fiber.Ctx) error {
@@ -192,6 +290,7 @@ func (h *AuthHandler) VerifyResetCode(c *fiber.Ctx) error {
 //	@Success		200						{object}	web.SuccessResponse{data=object}	"Password reset successfully"
 //	@Failure		400						{object}	web.ErrorResponse{error=web.ValidationErrors}	"Validation failed or invalid code"
 //	@Failure		404						{object}	web.ErrorResponse	"User not found"
+//	@Failure		429						{object}	web.ErrorResponse	"Rate limit exceeded"
 //	@Failure		500						{object}	web.ErrorResponse	"Internal server error"
 //	@Router			/auth/reset-password [post]
 func (h *AuthHandler) ResetPassword(c *fiber.Ctx) error {
@@ -208,4 +307,393 @@ func (h *AuthHandler) ResetPassword(c *fiber.Ctx) error {
 	return web.Success(c, fiber.StatusOK, utils.SuccessPasswordResetKey, nil)
 }
 
+// Logout godoc
+//
+//	@Summary		Logout
+//	@Description	Revoke the session the refresh token belongs to
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			logoutPayload	body		domain.LogoutPayload	true	"Refresh token of the session"
+//	@Success		200				{object}	web.SuccessResponse{data=object}	"Logged out successfully"
+//	@Failure		400				{object}	web.ErrorResponse{error=web.ValidationErrors}	"Validation failed"
+//	@Failure		401				{object}	web.ErrorResponse	"Invalid refresh token or session already revoked"
+//	@Failure		500				{object}	web.ErrorResponse	"Internal server error"
+//	@Router			/auth/logout [post]
+func (h *AuthHandler) Logout(c *fiber.Ctx) error {
+	var payload domain.LogoutPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	if err := h.Service.Logout(c.Context(), &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessLogoutKey, nil)
+}
+
+// LogoutAll godoc
+//
+//	@Summary		Logout from all devices
+//	@Description	Revoke every session of the current user
+//	@Tags			Authentication
+//	@Produce		json
+//	@Success		200	{object}	web.SuccessResponse{data=object}	"Logged out from all devices"
+//	@Failure		401	{object}	web.ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	web.ErrorResponse	"Internal server error"
+//	@Router			/auth/logout-all [post]
+func (h *AuthHandler) LogoutAll(c *fiber.Ctx) error {
+	userId, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrUnauthorizedKey))
+	}
+
+	if err := h.Service.LogoutAll(c.Context(), userId); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessLogoutAllKey, nil)
+}
+
+func (h *AuthHandler) RevokeCurrentUserSession(c *fiber.Ctx) error {
+	userId, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrUnauthorizedKey))
+	}
+
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSessionIDRequiredKey))
+	}
+
+	if err := h.Service.RevokeUserSession(c.Context(), userId, id, domain.SessionRevokedLogout); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessSessionRevokedKey, nil)
+}
+
+func (h *AuthHandler) RevokeUserSession(c *fiber.Ctx) error {
+	userId := c.Params("userId")
+	if userId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSessionIDRequiredKey))
+	}
+
+	if err := h.Service.RevokeUserSession(c.Context(), userId, id, domain.SessionRevokedByAdmin); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessSessionRevokedKey, nil)
+}
+
+func (h *AuthHandler) RevokeAllUserSessions(c *fiber.Ctx) error {
+	userId := c.Params("userId")
+	if userId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	if err := h.Service.RevokeAllUserSessions(c.Context(), userId); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessSessionsRevokedKey, nil)
+}
+
+// SetupTwoFactorChallenge godoc
+//
+//	@Summary		Start required two-factor enrolment during login
+//	@Description	Generate a TOTP secret and QR code for a user that must use two-factor authentication but has not enrolled yet
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			challengePayload	body		domain.TwoFactorChallengeSetupPayload	true	"Challenge token from login"
+//	@Success		200					{object}	web.SuccessResponse{data=domain.TwoFactorSetupResponse}	"Enrolment started"
+//	@Failure		400					{object}	web.ErrorResponse{error=web.ValidationErrors}	"Validation failed"
+//	@Failure		401					{object}	web.ErrorResponse	"Invalid or expired challenge token"
+//	@Failure		409					{object}	web.ErrorResponse	"Two-factor authentication already enabled"
+//	@Failure		500					{object}	web.ErrorResponse	"Internal server error"
+//	@Router			/auth/2fa/challenge/setup [post]
+func (h *AuthHandler) SetupTwoFactorChallenge(c *fiber.Ctx) error {
+	var payload domain.TwoFactorChallengeSetupPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	setup, err := h.Service.SetupTwoFactorChallenge(c.Context(), &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessTwoFactorSetupStartedKey, setup)
+}
+
+// VerifyTwoFactorChallenge godoc
+//
+//	@Summary		Complete login with the second factor
+//	@Description	Exchange a challenge token and a TOTP or recovery code for JWT tokens, also confirms a required enrolment
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			challengePayload	body		domain.TwoFactorChallengeVerifyPayload	true	"Challenge token and code"
+//	@Success		200					{object}	web.SuccessResponse{data=domain.LoginResponse}	"Login successful"
+//	@Failure		400					{object}	web.ErrorResponse{error=web.ValidationErrors}	"Validation failed"
+//	@Failure		401					{object}	web.ErrorResponse	"Invalid challenge token or code"
+//	@Failure		429					{object}	web.ErrorResponse	"Too many wrong codes"
+//	@Failure		500					{object}	web.ErrorResponse	"Internal server error"
+//	@Router			/auth/2fa/challenge/verify [post]
+func (h *AuthHandler) VerifyTwoFactorChallenge(c *fiber.Ctx) error {
+	var payload domain.TwoFactorChallengeVerifyPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	client := domain.SessionClientInfo{
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+		IPAddress: c.IP(),
+	}
+
+	user, err := h.Service.VerifyTwoFactorChallenge(c.Context(), &payload, client)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessLoginKey, user)
+}
+
+// BeginOIDCLogin godoc
+//
+//	@Summary		Start single sign-on login
+//	@Description	Create an OIDC authorization request (authorization code with PKCE) and return the identity provider URL
+//	@Tags			Authentication
+//	@Produce		json
+//	@Param			deviceName	query		string	false	"Device name stored on the session"
+//	@Param			redirect	query		bool	false	"Redirect to the identity provider instead of returning JSON"
+//	@Success		200			{object}	web.SuccessResponse{data=domain.OIDCAuthorizationResponse}	"Authorization URL created"
+//	@Success		302			"Redirect to the identity provider"
+//	@Failure		404			{object}	web.ErrorResponse	"Single sign-on not enabled"
+//	@Failure		500			{object}	web.ErrorResponse	"Internal server error"
+//	@Router			/auth/oidc/authorize [get]
+func (h *AuthHandler) BeginOIDCLogin(c *fiber.Ctx) error {
+	authorization, err := h.Service.BeginOIDCLogin(c.Context(), c.Query("deviceName"), c.IP())
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	if c.QueryBool("redirect") {
+		return c.Redirect(authorization.AuthorizationURL, fiber.StatusFound)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessOIDCAuthorizationCreatedKey, authorization)
+}
+
+// CompleteOIDCLoginRedirect godoc
+//
+//	@Summary		Single sign-on redirect callback
+//	@Description	Redirect URI of the identity provider, exchanges the authorization code for JWT tokens
+//	@Tags			Authentication
+//	@Produce		json
+//	@Param			code	query		string	true	"Authorization code"
+//	@Param			state	query		string	true	"State returned by /auth/oidc/authorize"
+//	@Success		200		{object}	web.SuccessResponse{data=domain.LoginResponse}	"Login successful or 2FA challenge issued"
+//	@Failure		400		{object}	web.ErrorResponse{error=web.ValidationErrors}	"Validation failed"
+//	@Failure		401		{object}	web.ErrorResponse	"Invalid state or login rejected by the identity provider"
+//	@Failure		403		{object}	web.ErrorResponse	"Email not verified or user not provisioned"
+//	@Failure		500		{object}	web.ErrorResponse	"Internal server error"
+//	@Router			/auth/oidc/callback [get]
+func (h *AuthHandler) CompleteOIDCLoginRedirect(c *fiber.Ctx) error {
+	// * IdP mengirim error (mis. access_denied) lewat query kalau user membatalkan login
+	if c.Query("error") != "" {
+		return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrOIDCLoginFailedKey))
+	}
+
+	payload := domain.OIDCCallbackPayload{
+		Code:  c.Query("code"),
+		State: c.Query("state"),
+	}
+	if err := web.Validate(&payload); err != nil {
+		if validationErrors, ok := err.(web.ValidationErrors); ok {
+			return web.HandleError(c, &web.FiberValidationError{Errors: validationErrors})
+		}
+		return web.HandleError(c, err)
+	}
+
+	return h.completeOIDCLogin(c, &payload)
+}
+
+// CompleteOIDCLogin godoc
+//
+//	@Summary		Complete single sign-on login
+//	@Description	Exchange the authorization code forwarded by the frontend for JWT tokens
+//	@Tags			Authentication
+//	@Accept			json
+//	@Produce		json
+//	@Param			oidcCallbackPayload	body		domain.OIDCCallbackPayload	true	"Authorization code and state"
+//	@Success		200					{object}	web.SuccessResponse{data=domain.LoginResponse}	"Login successful or 2FA challenge issued"
+//	@Failure		400					{object}	web.ErrorResponse{error=web.ValidationErrors}	"Validation failed"
+//	@Failure		401					{object}	web.ErrorResponse	"Invalid state or login rejected by the identity provider"
+//	@Failure		403					{object}	web.ErrorResponse	"Email not verified or user not provisioned"
+//	@Failure		500					{object}	web.ErrorResponse	"Internal server error"
+//	@Router			/auth/oidc/callback [post]
+func (h *AuthHandler) CompleteOIDCLogin(c *fiber.Ctx) error {
+	var payload domain.OIDCCallbackPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return h.completeOIDCLogin(c, &payload)
+}
+
+func (h *AuthHandler) completeOIDCLogin(c *fiber.Ctx, payload *domain.OIDCCallbackPayload) error {
+	client := domain.SessionClientInfo{
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+		IPAddress: c.IP(),
+	}
+
+	user, err := h.Service.CompleteOIDCLogin(c.Context(), payload, client)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * 2FA lokal tetap berlaku, token diambil lewat endpoint 2FA challenge seperti login password
+	if user.TwoFactorRequired {
+		return web.Success(c, fiber.StatusOK, utils.SuccessTwoFactorChallengeIssuedKey, user)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessLoginKey, user)
+}
+
+func (h *AuthHandler) BeginTwoFactorSetup(c *fiber.Ctx) error {
+	userId, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrUnauthorizedKey))
+	}
+
+	setup, err := h.Service.BeginTwoFactorSetup(c.Context(), userId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessTwoFactorSetupStartedKey, setup)
+}
+
+func (h *AuthHandler) EnableTwoFactor(c *fiber.Ctx) error {
+	userId, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrUnauthorizedKey))
+	}
+
+	var payload domain.TwoFactorCodePayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	recoveryCodes, err := h.Service.EnableTwoFactor(c.Context(), userId, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessTwoFactorEnabledKey, recoveryCodes)
+}
+
+func (h *AuthHandler) DisableTwoFactor(c *fiber.Ctx) error {
+	userId, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrUnauthorizedKey))
+	}
+
+	var payload domain.DisableTwoFactorPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	if err := h.Service.DisableTwoFactor(c.Context(), userId, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessTwoFactorDisabledKey, nil)
+}
+
+func (h *AuthHandler) RegenerateRecoveryCodes(c *fiber.Ctx) error {
+	userId, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrUnauthorizedKey))
+	}
+
+	var payload domain.TwoFactorCodePayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	recoveryCodes, err := h.Service.RegenerateRecoveryCodes(c.Context(), userId, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessTwoFactorRecoveryCodesRegeneratedKey, recoveryCodes)
+}
+
+func (h *AuthHandler) ResetUserTwoFactor(c *fiber.Ctx) error {
+	userId := c.Params("userId")
+	if userId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	if err := h.Service.ResetUserTwoFactor(c.Context(), userId); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessTwoFactorResetKey, nil)
+}
+
 // *===========================QUERY===========================*
+func (h *AuthHandler) GetCurrentUserSessions(c *fiber.Ctx) error {
+	userId, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrUnauthorizedKey))
+	}
+
+	currentSessionId, _ := web.GetSessionIDFromContext(c)
+
+	sessions, err := h.Service.GetUserSessions(c.Context(), userId, currentSessionId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessSessionsRetrievedKey, sessions)
+}
+
+func (h *AuthHandler) GetUserSessions(c *fiber.Ctx) error {
+	userId := c.Params("userId")
+	if userId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	currentSessionId, _ := web.GetSessionIDFromContext(c)
+
+	sessions, err := h.Service.GetUserSessions(c.Context(), userId, currentSessionId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessSessionsRetrievedKey, sessions)
+}
+
+func (h *AuthHandler) GetTwoFactorStatus(c *fiber.Ctx) error {
+	userId, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrUnauthorizedKey))
+	}
+
+	status, err := h.Service.GetTwoFactorStatus(c.Context(), userId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessTwoFactorStatusRetrievedKey, status)
+}
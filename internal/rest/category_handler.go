@@ -29,12 +29,12 @@ func NewCategoryHandler(app fiber.Router, s category.CategoryService) {
 	// * Create
 	categories.Post("/",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionCategoryCreate),
 		handler.CreateCategory,
 	)
 	categories.Post("/bulk",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionCategoryCreate),
 		handler.BulkCreateCategories,
 	)
 
@@ -48,17 +48,17 @@ func NewCategoryHandler(app fiber.Router, s category.CategoryService) {
 	categories.Get("/:id", handler.GetCategoryById)
 	categories.Patch("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionCategoryUpdate),
 		handler.UpdateCategory,
 	)
 	categories.Delete("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionCategoryDelete),
 		handler.DeleteCategory,
 	)
 	categories.Post("/bulk-delete",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionCategoryDelete),
 		handler.BulkDeleteCategories,
 	)
 }
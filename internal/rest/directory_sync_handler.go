@@ -0,0 +1,63 @@
+package rest
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/directory_sync"
+	"github.com/gofiber/fiber/v2"
+)
+
+type DirectorySyncHandler struct {
+	Runner directory_sync.SyncRunner
+}
+
+func NewDirectorySyncHandler(app fiber.Router, r directory_sync.SyncRunner) {
+	handler := &DirectorySyncHandler{Runner: r}
+
+	directorySync := app.Group("/directory-sync")
+	directorySync.Post("/run",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionDirectorySyncRun),
+		handler.RunDirectorySync,
+	)
+	directorySync.Get("/last-run",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionDirectorySyncRun),
+		handler.GetLastDirectorySync,
+	)
+}
+
+// *===========================MUTATION===========================*
+func (h *DirectorySyncHandler) RunDirectorySync(c *fiber.Ctx) error {
+	var payload domain.RunDirectorySyncPayload
+	if len(c.Body()) > 0 {
+		if err := web.ParseAndValidate(c, &payload); err != nil {
+			return web.HandleError(c, err)
+		}
+	}
+
+	// * Tanpa body berarti dry run, perubahan harus diminta eksplisit
+	dryRun := payload.DryRun == nil || *payload.DryRun
+
+	result, err := h.Runner.RunSync(c.Context(), dryRun, domain.DirectorySyncTriggerManual)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	if dryRun {
+		return web.Success(c, fiber.StatusOK, utils.SuccessDirectorySyncDryRunKey, result)
+	}
+	return web.Success(c, fiber.StatusOK, utils.SuccessDirectorySyncRunKey, result)
+}
+
+// *===========================QUERY===========================*
+func (h *DirectorySyncHandler) GetLastDirectorySync(c *fiber.Ctx) error {
+	result, err := h.Runner.GetLastRun(c.Context())
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessDirectorySyncRetrievedKey, result)
+}
@@ -0,0 +1,163 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/export_job"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ExportJobHandler struct {
+	Service export_job.ExportJobService
+}
+
+func NewExportJobHandler(app fiber.Router, s export_job.ExportJobService) {
+	handler := &ExportJobHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	// * Job hanya bisa diakses pemiliknya, permission export dicek saat job dibuat dan saat dijalankan
+	exportJobs := app.Group("/export-jobs")
+
+	exportJobs.Post("/",
+		middleware.AuthMiddleware(),
+		handler.CreateExportJob,
+	)
+	exportJobs.Get("/",
+		middleware.AuthMiddleware(),
+		handler.GetExportJobsCursor,
+	)
+	// * Tanpa auth, akses dijaga signature dan masa berlaku link
+	exportJobs.Get("/:id/download",
+		handler.DownloadExportJob,
+	)
+	exportJobs.Get("/:id",
+		middleware.AuthMiddleware(),
+		handler.GetExportJobById,
+	)
+	exportJobs.Delete("/:id",
+		middleware.AuthMiddleware(),
+		handler.DeleteExportJob,
+	)
+}
+
+// *===========================MUTATION===========================*
+func (h *ExportJobHandler) CreateExportJob(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	var payload domain.CreateExportJobPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Bahasa file mengikuti bahasa request saat job dibuat
+	langCode := web.GetLanguageFromContext(c)
+
+	exportJob, err := h.Service.CreateExportJob(c.Context(), userID, &payload, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusAccepted, utils.SuccessExportJobQueuedKey, exportJob)
+}
+
+func (h *ExportJobHandler) DeleteExportJob(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrExportJobIDRequiredKey))
+	}
+
+	if err := h.Service.DeleteExportJob(c.Context(), userID, id); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessExportJobDeletedKey, nil)
+}
+
+// *===========================QUERY===========================*
+func (h *ExportJobHandler) GetExportJobsCursor(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	// * Parse filtering options
+	filters := &domain.ExportJobFilterOptions{}
+	if status := c.Query("status"); status != "" {
+		exportJobStatus := domain.ExportJobStatus(status)
+		filters.Status = &exportJobStatus
+	}
+	if entityType := c.Query("entityType"); entityType != "" {
+		exportJobEntityType := domain.SavedFilterEntityType(entityType)
+		filters.EntityType = &exportJobEntityType
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	cursor := c.Query("cursor")
+	params := domain.ExportJobParams{
+		Filters:    filters,
+		Pagination: &domain.PaginationOptions{Limit: limit, Cursor: cursor},
+	}
+
+	exportJobs, err := h.Service.GetExportJobsCursor(c.Context(), userID, params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	var nextCursor string
+	hasNextPage := len(exportJobs) == limit
+	if hasNextPage {
+		nextCursor = exportJobs[len(exportJobs)-1].ID
+	}
+
+	return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessExportJobsRetrievedKey, exportJobs, nextCursor, hasNextPage, limit)
+}
+
+func (h *ExportJobHandler) GetExportJobById(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrExportJobIDRequiredKey))
+	}
+
+	exportJob, err := h.Service.GetExportJobById(c.Context(), userID, id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessExportJobRetrievedKey, exportJob)
+}
+
+func (h *ExportJobHandler) DownloadExportJob(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrExportJobIDRequiredKey))
+	}
+
+	file, err := h.Service.DownloadExportJob(c.Context(), id, c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	c.Set("Content-Type", file.ContentType)
+	c.Set("Content-Disposition", attachmentDisposition(file.FileName))
+
+	// * Fiber menutup Content setelah body selesai dikirim
+	return c.SendStream(file.Content, int(file.Size))
+}
@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/localstorage"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/gofiber/fiber/v2"
+)
+
+type FileHandler struct {
+	Storage *localstorage.Client
+}
+
+// NewFileHandler serves files of the local storage backend, dipasang di luar /api/v1 karena URL-nya
+// dipakai langsung oleh <img> tanpa API key. Akses dijaga oleh signature di query sig.
+// Dokumen, report dan export tidak disajikan di sini, file tersebut hanya bisa diunduh lewat endpoint yang butuh auth
+func NewFileHandler(app fiber.Router, storage *localstorage.Client) {
+	handler := &FileHandler{
+		Storage: storage,
+	}
+
+	app.Get(strings.TrimSuffix(localstorage.RoutePrefix, "/")+"/*", handler.GetFile)
+}
+
+func (h *FileHandler) GetFile(c *fiber.Ctx) error {
+	name, err := url.PathUnescape(c.Params("*"))
+	if err != nil || storage.IsPrivateFile(name) || !h.Storage.Verify(name, c.Query("sig")) {
+		return web.HandleError(c, domain.ErrNotFound("file"))
+	}
+
+	filePath, err := h.Storage.FilePath(name)
+	if err != nil {
+		return web.HandleError(c, domain.ErrNotFound("file"))
+	}
+
+	// * Helmet set Cross-Origin-Resource-Policy same-origin, file harus bisa dipakai frontend di origin lain
+	c.Set("Cross-Origin-Resource-Policy", "cross-origin")
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	// * File selain raster image (SVG lama, PDF) tidak boleh dirender sebagai halaman di origin API
+	if !storage.IsRasterImage(name) {
+		c.Set(fiber.HeaderContentSecurityPolicy, "sandbox")
+		c.Attachment(path.Base(name))
+	}
+	// * URL berubah tiap file ditimpa (?v=), jadi aman di-cache lama
+	c.Set(fiber.HeaderCacheControl, "public, max-age=31536000, immutable")
+
+	return c.SendFile(filePath)
+}
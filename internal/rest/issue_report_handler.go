@@ -42,20 +42,29 @@ func NewIssueReportHandler(app fiber.Router, s issue_report.IssueReportService)
 	)
 
 	issueReports.Get("/",
-		middleware.OptionalAuth(), // Optional auth to filter by reporter
+		middleware.AuthMiddleware(),
 		handler.GetIssueReportsPaginated,
 	)
-	issueReports.Get("/statistics", handler.GetIssueReportStatistics)
+	issueReports.Get("/statistics",
+		middleware.AuthMiddleware(),
+		handler.GetIssueReportStatistics,
+	)
 	issueReports.Get("/cursor",
-		middleware.OptionalAuth(), // Optional auth to filter by reporter
+		middleware.AuthMiddleware(),
 		handler.GetIssueReportsCursor,
 	)
 	issueReports.Get("/count",
-		middleware.OptionalAuth(), // Optional auth to filter by reporter
+		middleware.AuthMiddleware(),
 		handler.CountIssueReports,
 	)
-	issueReports.Get("/check/:id", handler.CheckIssueReportExists)
-	issueReports.Get("/:id", handler.GetIssueReportById)
+	issueReports.Get("/check/:id",
+		middleware.AuthMiddleware(),
+		handler.CheckIssueReportExists,
+	)
+	issueReports.Get("/:id",
+		middleware.AuthMiddleware(),
+		handler.GetIssueReportById,
+	)
 
 	issueReports.Patch("/:id",
 		middleware.AuthMiddleware(),
@@ -63,12 +72,12 @@ func NewIssueReportHandler(app fiber.Router, s issue_report.IssueReportService)
 	)
 	issueReports.Delete("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionIssueReportDelete),
 		handler.DeleteIssueReport,
 	)
 	issueReports.Post("/bulk-delete",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionIssueReportDelete),
 		handler.BulkDeleteIssueReports,
 	)
 }
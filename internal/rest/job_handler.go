@@ -0,0 +1,161 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/job"
+	"github.com/gofiber/fiber/v2"
+)
+
+type JobHandler struct {
+	Service job.JobService
+}
+
+func NewJobHandler(app fiber.Router, s job.JobService) {
+	handler := &JobHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	jobs := app.Group("/jobs")
+
+	// * Route statis harus sebelum /:id
+	jobs.Get("/statistics",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionJobManage),
+		handler.GetJobStatistics,
+	)
+	jobs.Post("/retry",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionJobManage),
+		handler.RetryDeadJobs,
+	)
+	jobs.Post("/purge",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionJobManage),
+		handler.PurgeJobs,
+	)
+
+	jobs.Get("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionJobManage),
+		handler.GetJobsCursor,
+	)
+	jobs.Get("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionJobManage),
+		handler.GetJobById,
+	)
+	jobs.Post("/:id/retry",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionJobManage),
+		handler.RetryJob,
+	)
+}
+
+// *===========================MUTATION===========================*
+func (h *JobHandler) RetryJob(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrJobIDRequiredKey))
+	}
+
+	retriedJob, err := h.Service.RetryJob(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessJobRetriedKey, retriedJob)
+}
+
+func (h *JobHandler) RetryDeadJobs(c *fiber.Ctx) error {
+	var payload domain.RetryJobsPayload
+	if len(c.Body()) > 0 {
+		if err := web.ParseAndValidate(c, &payload); err != nil {
+			return web.HandleError(c, err)
+		}
+	}
+
+	result, err := h.Service.RetryDeadJobs(c.Context(), &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessJobsRetriedKey, result)
+}
+
+func (h *JobHandler) PurgeJobs(c *fiber.Ctx) error {
+	var payload domain.PurgeJobsPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	result, err := h.Service.PurgeJobs(c.Context(), &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessJobsPurgedKey, result)
+}
+
+// *===========================QUERY===========================*
+func (h *JobHandler) GetJobsCursor(c *fiber.Ctx) error {
+	// * Parse filtering options
+	filters := &domain.JobFilterOptions{}
+	if status := c.Query("status"); status != "" {
+		jobStatus := domain.JobStatus(status)
+		filters.Status = &jobStatus
+	}
+	if jobType := c.Query("type"); jobType != "" {
+		filterType := domain.JobType(jobType)
+		filters.Type = &filterType
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	cursor := c.Query("cursor")
+	params := domain.JobParams{
+		Filters:    filters,
+		Pagination: &domain.PaginationOptions{Limit: limit, Cursor: cursor},
+	}
+
+	jobs, err := h.Service.GetJobsCursor(c.Context(), params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	var nextCursor string
+	hasNextPage := len(jobs) == limit
+	if hasNextPage {
+		nextCursor = jobs[len(jobs)-1].ID
+	}
+
+	return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessJobRetrievedKey, jobs, nextCursor, hasNextPage, limit)
+}
+
+func (h *JobHandler) GetJobById(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrJobIDRequiredKey))
+	}
+
+	jobDetail, err := h.Service.GetJobById(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessJobRetrievedKey, jobDetail)
+}
+
+func (h *JobHandler) GetJobStatistics(c *fiber.Ctx) error {
+	stats, err := h.Service.GetJobStatistics(c.Context())
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessJobStatisticsRetrievedKey, stats)
+}
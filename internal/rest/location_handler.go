@@ -27,12 +27,12 @@ func NewLocationHandler(app fiber.Router, s location.LocationService) {
 	// * Create
 	locations.Post("/",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionLocationCreate),
 		handler.CreateLocation,
 	)
 	locations.Post("/bulk",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionLocationCreate),
 		handler.BulkCreateLocations,
 	)
 
@@ -40,23 +40,33 @@ func NewLocationHandler(app fiber.Router, s location.LocationService) {
 	locations.Get("/statistics", handler.GetLocationStatistics)
 	locations.Get("/cursor", handler.GetLocationsCursor)
 	locations.Get("/count", handler.CountLocations)
+	locations.Get("/users/:userId",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionLocationAssign),
+		handler.GetUserLocations,
+	)
+	locations.Patch("/users/:userId",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionLocationAssign),
+		handler.AssignUserLocations,
+	)
 	locations.Get("/code/:code", handler.GetLocationByCode)
 	locations.Get("/check/code/:code", handler.CheckLocationCodeExists)
 	locations.Get("/check/:id", handler.CheckLocationExists)
 	locations.Get("/:id", handler.GetLocationById)
 	locations.Patch("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionLocationUpdate),
 		handler.UpdateLocation,
 	)
 	locations.Delete("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionLocationDelete),
 		handler.DeleteLocation,
 	)
 	locations.Post("/bulk-delete",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionLocationDelete),
 		handler.BulkDeleteLocations,
 	)
 }
@@ -168,6 +178,28 @@ func (h *LocationHandler) BulkDeleteLocations(c *fiber.Ctx) error {
 	return web.Success(c, fiber.StatusOK, utils.SuccessLocationsBulkDeletedKey, result)
 }
 
+func (h *LocationHandler) AssignUserLocations(c *fiber.Ctx) error {
+	userId := c.Params("userId")
+	if userId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	var payload domain.AssignUserLocationsPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	userLocations, err := h.Service.AssignUserLocations(c.Context(), userId, &payload, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessUserLocationsAssignedKey, userLocations)
+}
+
 // *===========================QUERY===========================*
 func (h *LocationHandler) GetLocationsPaginated(c *fiber.Ctx) error {
 	params, err := h.parseLocationFiltersAndSort(c)
@@ -301,3 +333,20 @@ func (h *LocationHandler) GetLocationStatistics(c *fiber.Ctx) error {
 
 	return web.Success(c, fiber.StatusOK, utils.SuccessLocationStatisticsRetrievedKey, stats)
 }
+
+func (h *LocationHandler) GetUserLocations(c *fiber.Ctx) error {
+	userId := c.Params("userId")
+	if userId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	userLocations, err := h.Service.GetUserLocations(c.Context(), userId, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessUserLocationsRetrievedKey, userLocations)
+}
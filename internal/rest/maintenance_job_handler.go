@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/maintenance_schedule"
+	"github.com/gofiber/fiber/v2"
+)
+
+type MaintenanceJobHandler struct {
+	Runner maintenance_schedule.JobRunner
+}
+
+func NewMaintenanceJobHandler(app fiber.Router, r maintenance_schedule.JobRunner) {
+	handler := &MaintenanceJobHandler{Runner: r}
+
+	jobs := app.Group("/maintenance/jobs")
+	jobs.Post("/:job/run",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionMaintenanceJobRun),
+		handler.RunMaintenanceJob,
+	)
+}
+
+// *===========================MUTATION===========================*
+func (h *MaintenanceJobHandler) RunMaintenanceJob(c *fiber.Ctx) error {
+	job := c.Params("job")
+	if job == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrMaintenanceJobInvalidKey))
+	}
+
+	result, err := h.Runner.RunJob(c.Context(), domain.MaintenanceJob(job))
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessMaintenanceJobRunKey, result)
+}
@@ -25,33 +25,51 @@ func NewMaintenanceRecordHandler(app fiber.Router, s maintenance_record.Maintena
 	)
 	records.Post("/",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionMaintenanceRecordCreate),
 		handler.CreateMaintenanceRecord,
 	)
 	records.Post("/bulk",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionMaintenanceRecordCreate),
 		handler.BulkCreateMaintenanceRecords,
 	)
-	records.Get("/", handler.GetMaintenanceRecordsPaginated)
-	records.Get("/cursor", handler.GetMaintenanceRecordsCursor)
-	records.Get("/count", handler.CountMaintenanceRecords)
-	records.Get("/check/:id", handler.CheckMaintenanceRecordExists)
-	records.Get("/statistics", handler.GetMaintenanceRecordStatistics)
-	records.Get("/:id", handler.GetMaintenanceRecordById)
+	records.Get("/",
+		middleware.AuthMiddleware(),
+		handler.GetMaintenanceRecordsPaginated,
+	)
+	records.Get("/cursor",
+		middleware.AuthMiddleware(),
+		handler.GetMaintenanceRecordsCursor,
+	)
+	records.Get("/count",
+		middleware.AuthMiddleware(),
+		handler.CountMaintenanceRecords,
+	)
+	records.Get("/check/:id",
+		middleware.AuthMiddleware(),
+		handler.CheckMaintenanceRecordExists,
+	)
+	records.Get("/statistics",
+		middleware.AuthMiddleware(),
+		handler.GetMaintenanceRecordStatistics,
+	)
+	records.Get("/:id",
+		middleware.AuthMiddleware(),
+		handler.GetMaintenanceRecordById,
+	)
 	records.Patch("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionMaintenanceRecordUpdate),
 		handler.UpdateMaintenanceRecord,
 	)
 	records.Delete("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionMaintenanceRecordDelete),
 		handler.DeleteMaintenanceRecord,
 	)
 	records.Post("/bulk-delete",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionMaintenanceRecordDelete),
 		handler.BulkDeleteMaintenanceRecords,
 	)
 }
@@ -25,33 +25,51 @@ func NewMaintenanceScheduleHandler(app fiber.Router, s maintenance_schedule.Main
 	)
 	schedules.Post("/",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionMaintenanceScheduleCreate),
 		handler.CreateMaintenanceSchedule,
 	)
 	schedules.Post("/bulk",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionMaintenanceScheduleCreate),
 		handler.BulkCreateMaintenanceSchedules,
 	)
-	schedules.Get("/", handler.GetMaintenanceSchedulesPaginated)
-	schedules.Get("/cursor", handler.GetMaintenanceSchedulesCursor)
-	schedules.Get("/count", handler.CountMaintenanceSchedules)
-	schedules.Get("/check/:id", handler.CheckMaintenanceScheduleExists)
-	schedules.Get("/statistics", handler.GetMaintenanceScheduleStatistics)
-	schedules.Get("/:id", handler.GetMaintenanceScheduleById)
+	schedules.Get("/",
+		middleware.AuthMiddleware(),
+		handler.GetMaintenanceSchedulesPaginated,
+	)
+	schedules.Get("/cursor",
+		middleware.AuthMiddleware(),
+		handler.GetMaintenanceSchedulesCursor,
+	)
+	schedules.Get("/count",
+		middleware.AuthMiddleware(),
+		handler.CountMaintenanceSchedules,
+	)
+	schedules.Get("/check/:id",
+		middleware.AuthMiddleware(),
+		handler.CheckMaintenanceScheduleExists,
+	)
+	schedules.Get("/statistics",
+		middleware.AuthMiddleware(),
+		handler.GetMaintenanceScheduleStatistics,
+	)
+	schedules.Get("/:id",
+		middleware.AuthMiddleware(),
+		handler.GetMaintenanceScheduleById,
+	)
 	schedules.Patch("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionMaintenanceScheduleUpdate),
 		handler.UpdateMaintenanceSchedule,
 	)
 	schedules.Delete("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionMaintenanceScheduleDelete),
 		handler.DeleteMaintenanceSchedule,
 	)
 	schedules.Post("/bulk-delete",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin, domain.RoleStaff),
+		middleware.RequirePermission(domain.PermissionMaintenanceScheduleDelete),
 		handler.BulkDeleteMaintenanceSchedules,
 	)
 }
@@ -1,7 +1,11 @@
 package middleware
 
 import (
+	"context"
+	"crypto/subtle"
+	"log"
 	"os"
+	"strings"
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/utils"
@@ -13,32 +17,129 @@ const (
 	APIKeyHeader = "X-API-Key"
 )
 
-// APIKeyMiddleware validates API key for mobile client access
-func APIKeyMiddleware() fiber.Handler {
-	environment := os.Getenv("ENVIRONMENT")
-	// Skip API key validation in development mode
-	if environment == "development" {
-		return func(c *fiber.Ctx) error {
-			return c.Next()
-		}
-	}
+// APIKeyAuthenticator resolves per-client API keys stored in the database
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, rawKey string) (domain.APIKey, error)
+	RecordAPIKeyUsage(keyId string, ipAddress string)
+}
 
-	apiKey := os.Getenv("API_KEY")
-	if apiKey == "" {
-		panic("API_KEY environment variable not set")
+// APIKeyMiddleware validates the client API key and its scope for the request
+func APIKeyMiddleware(authenticator APIKeyAuthenticator) fiber.Handler {
+	// ! Shared API_KEY lama hanya diterima kalau API_KEY_LEGACY_ENABLED=true dan cuma dapat scope read,
+	// ! sampai semua client pindah ke key masing-masing
+	legacyAPIKey := ""
+	if apiKey := os.Getenv("API_KEY"); apiKey != "" {
+		if os.Getenv("API_KEY_LEGACY_ENABLED") == "true" {
+			legacyAPIKey = apiKey
+			log.Printf("⚠️ Legacy API_KEY is deprecated and limited to the read scope, issue per-client keys via /api/v1/api-keys")
+		} else {
+			log.Printf("⚠️ API_KEY is ignored, set API_KEY_LEGACY_ENABLED=true to accept it temporarily or issue per-client keys via /api/v1/api-keys")
+		}
 	}
 
 	return func(c *fiber.Ctx) error {
 		clientKey := c.Get(APIKeyHeader)
 
 		if clientKey == "" {
+			if isBrowserRedirectRoute(c) {
+				return c.Next()
+			}
 			return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrAPIKeyMissingKey))
 		}
 
-		if clientKey != apiKey {
-			return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrAPIKeyInvalidKey))
+		if legacyAPIKey != "" && subtle.ConstantTimeCompare([]byte(clientKey), []byte(legacyAPIKey)) == 1 {
+			if scope, required := requiredAPIKeyScope(c); required && scope != domain.APIKeyScopeRead {
+				return web.HandleError(c, domain.ErrForbiddenWithKey(utils.ErrAPIKeyScopeDeniedKey))
+			}
+			return c.Next()
+		}
+
+		apiKey, err := authenticator.AuthenticateAPIKey(c.Context(), clientKey)
+		if err != nil {
+			return web.HandleError(c, err)
+		}
+
+		if scope, required := requiredAPIKeyScope(c); required && !apiKey.HasScope(scope) {
+			return web.HandleError(c, domain.ErrForbiddenWithKey(utils.ErrAPIKeyScopeDeniedKey))
 		}
 
+		authenticator.RecordAPIKeyUsage(apiKey.ID, c.IP())
+
+		c.Locals("api_key_id", apiKey.ID)
+		c.Locals("api_key_name", apiKey.Name)
+
 		return c.Next()
 	}
 }
+
+// isBrowserRedirectRoute reports routes that are opened directly by the browser and cannot send the API key header.
+// SSO dilindungi state sekali pakai dan PKCE, download export job dilindungi signature dan masa berlaku link.
+func isBrowserRedirectRoute(c *fiber.Ctx) bool {
+	if c.Method() != fiber.MethodGet {
+		return false
+	}
+
+	segments := routeSegments(c.Path())
+	switch {
+	case len(segments) == 3 && segments[0] == "auth" && segments[1] == "oidc":
+		return segments[2] == "authorize" || segments[2] == "callback"
+	case len(segments) == 3 && segments[0] == "export-jobs" && segments[2] == "download":
+		return c.Query("signature") != ""
+	default:
+		return false
+	}
+}
+
+// requiredAPIKeyScope maps the request to the scope a key needs, auth endpoints are open to every key
+func requiredAPIKeyScope(c *fiber.Ctx) (domain.APIKeyScope, bool) {
+	segments := routeSegments(c.Path())
+
+	if len(segments) > 0 && segments[0] == "auth" {
+		return "", false
+	}
+
+	if isExportRoute(segments) {
+		return domain.APIKeyScopeExport, true
+	}
+
+	switch c.Method() {
+	case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+		return domain.APIKeyScopeRead, true
+	default:
+		return domain.APIKeyScopeWrite, true
+	}
+}
+
+// isExportRoute reports routes that produce export files, apapun method-nya butuh scope export
+func isExportRoute(segments []string) bool {
+	if len(segments) == 0 {
+		return false
+	}
+
+	switch segments[0] {
+	case "export-jobs":
+		// * Membuat, memantau dan download export async
+		return true
+	case "saved-filters":
+		// * /saved-filters/:id/run dan /saved-filters/:id/runs/... menghasilkan file report
+		return len(segments) >= 3 && (segments[2] == "run" || segments[2] == "runs")
+	}
+
+	// * /<resource>/export/<type> termasuk resource bersarang seperti /maintenance/records/export/list
+	// * dan /audit-sessions/:id/export/report
+	for i := 1; i < len(segments)-1; i++ {
+		if segments[i] == "export" {
+			return true
+		}
+	}
+	return false
+}
+
+// routeSegments splits the path after /api/v1 into segments, contoh /api/v1/assets/export/list jadi [assets export list]
+func routeSegments(path string) []string {
+	path = strings.Trim(strings.TrimPrefix(path, "/api/v1"), "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
@@ -40,6 +40,20 @@ func AuthMiddleware() fiber.Handler {
 				return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrTokenInvalidKey))
 			}
 
+			// * Token dari session yang sudah dicabut (logout, ganti password, user dinonaktifkan) langsung ditolak
+			if sessionCheck != nil {
+				if claims.SessionID == "" {
+					return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrTokenInvalidKey))
+				}
+				active, err := sessionCheck.isActive(c.Context(), claims.SessionID, claims.IDUser)
+				if err != nil {
+					return web.HandleError(c, err)
+				}
+				if !active {
+					return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrSessionRevokedKey))
+				}
+			}
+
 			// Set user info ke context untuk digunakan di handler selanjutnya
 			c.Locals("id_user", claims.IDUser)
 			if claims.Name != nil {
@@ -58,6 +72,19 @@ func AuthMiddleware() fiber.Handler {
 				c.Locals("is_active", *claims.IsActive)
 			}
 
+			if claims.Permissions != nil {
+				c.Locals("permissions", claims.Permissions)
+			}
+
+			// * Token tanpa permission location:all selalu dibatasi, lokasi kosong berarti tidak ada akses
+			if locationIds, scoped := web.ResolveLocationScope(claims.Permissions, claims.LocationIDs); scoped {
+				c.Locals("location_ids", locationIds)
+			}
+
+			if claims.SessionID != "" {
+				c.Locals("session_id", claims.SessionID)
+			}
+
 			return c.Next()
 		},
 	})
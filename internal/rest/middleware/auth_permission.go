@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequirePermission allows the request when the token grants at least one of the given permissions.
+// Token yang dibuat sebelum ada permission claim ditolak sebagai invalid supaya client refresh token.
+func RequirePermission(requiredPermissions ...domain.Permission) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		permissions, ok := c.Locals("permissions").([]string)
+		if !ok {
+			return web.HandleError(c, domain.ErrUnauthorizedWithKey(utils.ErrTokenInvalidKey))
+		}
+
+		for _, required := range requiredPermissions {
+			if domain.HasPermission(permissions, required) {
+				return c.Next()
+			}
+		}
+
+		return web.HandleError(c, domain.ErrForbiddenWithKey(utils.ErrForbiddenKey))
+	}
+}
+
+// RequirePermissionOrSelf is RequirePermission that also allows the caller to act on their own account,
+// yaitu saat route param idParam sama dengan user id di token
+func RequirePermissionOrSelf(idParam string, requiredPermissions ...domain.Permission) fiber.Handler {
+	requirePermission := RequirePermission(requiredPermissions...)
+
+	return func(c *fiber.Ctx) error {
+		if userID, ok := web.GetUserIDFromContext(c); ok && userID == c.Params(idParam) {
+			return c.Next()
+		}
+
+		return requirePermission(c)
+	}
+}
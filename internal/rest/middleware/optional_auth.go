@@ -6,6 +6,7 @@ import (
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -29,6 +30,16 @@ func OptionalAuth() fiber.Handler {
 			return c.Next()
 		}
 
+		// * Session yang sudah dicabut diperlakukan seperti tanpa token
+		if sessionCheck != nil {
+			if claims.SessionID == "" {
+				return c.Next()
+			}
+			if active, err := sessionCheck.isActive(c.Context(), claims.SessionID, claims.IDUser); err != nil || !active {
+				return c.Next()
+			}
+		}
+
 		// * Set user info jika token valid
 		c.Locals("id_user", claims.IDUser)
 		if claims.Name != nil {
@@ -43,6 +54,15 @@ func OptionalAuth() fiber.Handler {
 		if claims.IsActive != nil {
 			c.Locals("is_active", *claims.IsActive)
 		}
+		if claims.Permissions != nil {
+			c.Locals("permissions", claims.Permissions)
+		}
+		if locationIds, scoped := web.ResolveLocationScope(claims.Permissions, claims.LocationIDs); scoped {
+			c.Locals("location_ids", locationIds)
+		}
+		if claims.SessionID != "" {
+			c.Locals("session_id", claims.SessionID)
+		}
 
 		return c.Next()
 	}
@@ -0,0 +1,227 @@
+package middleware
+
+import (
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	rateLimitSweepInterval = time.Minute
+)
+
+// RateLimitRule allows Max requests per Window, Max <= 0 disables the rule
+type RateLimitRule struct {
+	Max    int
+	Window time.Duration
+}
+
+func (r RateLimitRule) enabled() bool {
+	return r.Max > 0 && r.Window > 0
+}
+
+// RateLimitConfig holds the limits applied to every request under /api/v1
+type RateLimitConfig struct {
+	Enabled   bool
+	PerIP     RateLimitRule
+	PerUser   RateLimitRule
+	PerAPIKey RateLimitRule
+}
+
+// LoadRateLimitConfig reads RATE_LIMIT_* from the environment, dipanggil saat route didaftarkan supaya .env sudah ter-load
+func LoadRateLimitConfig() RateLimitConfig {
+	window := utils.EnvDuration("RATE_LIMIT_WINDOW", time.Minute)
+
+	return RateLimitConfig{
+		Enabled:   os.Getenv("RATE_LIMIT_ENABLED") != "false",
+		PerIP:     RateLimitRule{Max: utils.EnvInt("RATE_LIMIT_IP_MAX", 300), Window: window},
+		PerUser:   RateLimitRule{Max: utils.EnvInt("RATE_LIMIT_USER_MAX", 600), Window: window},
+		PerAPIKey: RateLimitRule{Max: utils.EnvInt("RATE_LIMIT_API_KEY_MAX", 1200), Window: window},
+	}
+}
+
+// LoadAuthRateLimitRule reads the stricter per-IP limit for sensitive auth endpoints
+func LoadAuthRateLimitRule() RateLimitRule {
+	return RateLimitRule{
+		Max:    utils.EnvInt("RATE_LIMIT_AUTH_MAX", 10),
+		Window: utils.EnvDuration("RATE_LIMIT_AUTH_WINDOW", 15*time.Minute),
+	}
+}
+
+// RateLimitMiddleware limits requests per IP, per authenticated user and per API key.
+// Harus dipasang setelah APIKeyMiddleware supaya api_key_id sudah ada di Locals.
+// ! Counter disimpan di memory, kalau jalan lebih dari satu instance limit berlaku per instance
+func RateLimitMiddleware(config RateLimitConfig) fiber.Handler {
+	if !config.Enabled {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	store := newRateLimitStore()
+
+	return func(c *fiber.Ctx) error {
+		now := time.Now()
+		results := make([]rateLimitResult, 0, 3)
+
+		if config.PerIP.enabled() {
+			results = append(results, store.hit("ip:"+c.IP(), config.PerIP, now))
+		}
+
+		if config.PerUser.enabled() {
+			if userId := rateLimitUserID(c); userId != "" {
+				results = append(results, store.hit("user:"+userId, config.PerUser, now))
+			}
+		}
+
+		if config.PerAPIKey.enabled() {
+			if keyId, ok := c.Locals("api_key_id").(string); ok && keyId != "" {
+				results = append(results, store.hit("api_key:"+keyId, config.PerAPIKey, now))
+			}
+		}
+
+		return applyRateLimitResults(c, results, now)
+	}
+}
+
+// AuthRateLimitMiddleware is a stricter per-IP limit for a single auth endpoint (login, forgot password, dll)
+func AuthRateLimitMiddleware() fiber.Handler {
+	rule := LoadAuthRateLimitRule()
+	if os.Getenv("RATE_LIMIT_ENABLED") == "false" || !rule.enabled() {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	// * Store per route, jadi tiap endpoint auth punya kuota sendiri
+	store := newRateLimitStore()
+
+	return func(c *fiber.Ctx) error {
+		now := time.Now()
+		result := store.hit("ip:"+c.IP(), rule, now)
+
+		return applyRateLimitResults(c, []rateLimitResult{result}, now)
+	}
+}
+
+// applyRateLimitResults writes RateLimit-* headers for the most restrictive bucket and rejects when any bucket is exhausted
+func applyRateLimitResults(c *fiber.Ctx, results []rateLimitResult, now time.Time) error {
+	if len(results) == 0 {
+		return c.Next()
+	}
+
+	// * Bucket yang ditolak diutamakan, selain itu yang sisa kuotanya paling sedikit
+	tightest := results[0]
+	for _, result := range results[1:] {
+		if !result.allowed && tightest.allowed {
+			tightest = result
+			continue
+		}
+		if result.allowed == tightest.allowed && result.remaining < tightest.remaining {
+			tightest = result
+		}
+	}
+
+	resetSeconds := int(math.Ceil(tightest.resetAt.Sub(now).Seconds()))
+	if resetSeconds < 1 {
+		resetSeconds = 1
+	}
+
+	c.Set("RateLimit-Limit", strconv.Itoa(tightest.limit))
+	c.Set("RateLimit-Remaining", strconv.Itoa(tightest.remaining))
+	c.Set("RateLimit-Reset", strconv.Itoa(resetSeconds))
+	c.Set("RateLimit-Policy", strconv.Itoa(tightest.limit)+";w="+strconv.Itoa(int(tightest.window.Seconds())))
+
+	if !tightest.allowed {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(resetSeconds))
+		return web.HandleError(c, domain.ErrTooManyRequestsWithKey(utils.ErrRateLimitExceededKey))
+	}
+
+	return c.Next()
+}
+
+// rateLimitUserID reads the user from a valid bearer token, token invalid dibiarkan supaya ditolak AuthMiddleware
+func rateLimitUserID(c *fiber.Ctx) string {
+	if userId, ok := c.Locals("id_user").(string); ok && userId != "" {
+		return userId
+	}
+
+	auth := c.Get("Authorization")
+	tokenString := strings.TrimPrefix(auth, "Bearer ")
+	if auth == "" || tokenString == auth {
+		return ""
+	}
+
+	claims, err := utils.ValidateToken(tokenString, []byte(os.Getenv("JWT_ACCESS_SECRET")))
+	if err != nil {
+		return ""
+	}
+
+	return claims.IDUser
+}
+
+// *===========================STORE===========================*
+
+type rateLimitResult struct {
+	allowed   bool
+	limit     int
+	remaining int
+	window    time.Duration
+	resetAt   time.Time
+}
+
+type rateLimitWindow struct {
+	count   int
+	resetAt time.Time
+}
+
+// rateLimitStore is a fixed window counter, window yang sudah lewat dibersihkan secara berkala
+type rateLimitStore struct {
+	mu        sync.Mutex
+	windows   map[string]*rateLimitWindow
+	lastSweep time.Time
+}
+
+func newRateLimitStore() *rateLimitStore {
+	return &rateLimitStore{
+		windows:   make(map[string]*rateLimitWindow),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *rateLimitStore) hit(key string, rule RateLimitRule, now time.Time) rateLimitResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= rateLimitSweepInterval {
+		for k, w := range s.windows {
+			if !now.Before(w.resetAt) {
+				delete(s.windows, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	w, ok := s.windows[key]
+	if !ok || !now.Before(w.resetAt) {
+		w = &rateLimitWindow{resetAt: now.Add(rule.Window)}
+		s.windows[key] = w
+	}
+
+	// * Request yang ditolak tidak ikut dihitung
+	if w.count >= rule.Max {
+		return rateLimitResult{allowed: false, limit: rule.Max, remaining: 0, window: rule.Window, resetAt: w.resetAt}
+	}
+
+	w.count++
+
+	return rateLimitResult{allowed: true, limit: rule.Max, remaining: rule.Max - w.count, window: rule.Window, resetAt: w.resetAt}
+}
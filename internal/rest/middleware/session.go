@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+const (
+	sessionCacheSweepInterval = time.Minute
+)
+
+// SessionGetter loads the session behind an access token, dipakai untuk cek session yang sudah dicabut
+type SessionGetter interface {
+	GetSessionById(ctx context.Context, sessionId string) (domain.UserSession, error)
+}
+
+// sessionCheck is set once at startup by ConfigureSessionCheck, nil berarti session tidak dicek (seeder, tool)
+var sessionCheck *sessionChecker
+
+// ConfigureSessionCheck makes AuthMiddleware reject access tokens whose session was revoked or expired.
+// Harus dipanggil sebelum route didaftarkan. Status session di-cache SESSION_CHECK_CACHE_TTL supaya tidak query tiap request,
+// jadi session yang dicabut paling lambat ditolak setelah TTL itu
+func ConfigureSessionCheck(getter SessionGetter) {
+	sessionCheck = &sessionChecker{
+		getter:    getter,
+		ttl:       utils.EnvDuration("SESSION_CHECK_CACHE_TTL", 30*time.Second),
+		entries:   make(map[string]sessionCacheEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+type sessionCacheEntry struct {
+	active    bool
+	expiresAt time.Time
+}
+
+// sessionChecker caches the active state per session, entry yang sudah lewat dibersihkan secara berkala
+type sessionChecker struct {
+	getter    SessionGetter
+	ttl       time.Duration
+	mu        sync.Mutex
+	entries   map[string]sessionCacheEntry
+	lastSweep time.Time
+}
+
+// isActive reports whether the session still belongs to the user and can be used, error database tidak di-cache
+func (s *sessionChecker) isActive(ctx context.Context, sessionId string, userId string) (bool, error) {
+	key := userId + ":" + sessionId
+	now := time.Now()
+
+	if s.ttl > 0 {
+		s.mu.Lock()
+		entry, ok := s.entries[key]
+		s.mu.Unlock()
+		if ok && now.Before(entry.expiresAt) {
+			return entry.active, nil
+		}
+	}
+
+	session, err := s.getter.GetSessionById(ctx, sessionId)
+	active := false
+	switch {
+	case err == nil:
+		active = session.UserID == userId && session.IsActive(now)
+	case domain.IsNotFound(err):
+		active = false
+	default:
+		return false, err
+	}
+
+	if s.ttl > 0 {
+		s.store(key, active, now)
+	}
+	return active, nil
+}
+
+func (s *sessionChecker) store(key string, active bool, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= sessionCacheSweepInterval {
+		for k, entry := range s.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(s.entries, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	s.entries[key] = sessionCacheEntry{active: active, expiresAt: now.Add(s.ttl)}
+}
@@ -27,12 +27,12 @@ func NewNotificationHandler(app fiber.Router, s notification.NotificationService
 	// * Create
 	notifications.Post("/",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionNotificationCreate),
 		handler.CreateNotification,
 	)
 	notifications.Post("/bulk",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionNotificationCreate),
 		handler.BulkCreateNotifications,
 	)
 
@@ -50,6 +50,17 @@ func NewNotificationHandler(app fiber.Router, s notification.NotificationService
 		handler.CountNotifications,
 	)
 	notifications.Get("/check/:id", handler.CheckNotificationExists)
+
+	// * Preferensi channel milik user yang login, harus sebelum /:id
+	notifications.Get("/preferences",
+		middleware.AuthMiddleware(),
+		handler.GetNotificationPreferences,
+	)
+	notifications.Patch("/preferences",
+		middleware.AuthMiddleware(),
+		handler.UpdateNotificationPreferences,
+	)
+
 	notifications.Get("/:id", handler.GetNotificationById)
 
 	// * Mark operations (batch update)
@@ -64,17 +75,17 @@ func NewNotificationHandler(app fiber.Router, s notification.NotificationService
 
 	notifications.Patch("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionNotificationUpdate),
 		handler.UpdateNotification,
 	)
 	notifications.Delete("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionNotificationDelete),
 		handler.DeleteNotification,
 	)
 	notifications.Post("/bulk-delete",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionNotificationDelete),
 		handler.BulkDeleteNotifications,
 	)
 }
@@ -388,3 +399,37 @@ func (h *NotificationHandler) GetNotificationStatistics(c *fiber.Ctx) error {
 
 	return web.Success(c, fiber.StatusOK, utils.SuccessNotificationStatisticsRetrievedKey, stats)
 }
+
+// *===========================PREFERENCES===========================*
+func (h *NotificationHandler) GetNotificationPreferences(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	preferences, err := h.Service.GetNotificationPreferences(c.Context(), userID)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessNotificationPreferencesRetrievedKey, preferences)
+}
+
+func (h *NotificationHandler) UpdateNotificationPreferences(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	var payload domain.UpdateNotificationPreferencesPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	preferences, err := h.Service.UpdateNotificationPreferences(c.Context(), userID, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessNotificationPreferencesUpdatedKey, preferences)
+}
@@ -0,0 +1,175 @@
+package rest
+
+import (
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/role"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RoleHandler struct {
+	Service role.RoleService
+}
+
+func NewRoleHandler(app fiber.Router, s role.RoleService) {
+	handler := &RoleHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	roles := app.Group("/roles")
+
+	roles.Post("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionRoleManage),
+		handler.CreateRole,
+	)
+
+	roles.Get("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionRoleRead, domain.PermissionRoleManage),
+		handler.GetRoles,
+	)
+	roles.Get("/permissions",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionRoleRead, domain.PermissionRoleManage),
+		handler.GetPermissions,
+	)
+	roles.Get("/users/:userId",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionRoleRead, domain.PermissionRoleManage),
+		handler.GetUserRoles,
+	)
+	roles.Patch("/users/:userId",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionRoleManage),
+		handler.AssignUserRoles,
+	)
+	roles.Get("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionRoleRead, domain.PermissionRoleManage),
+		handler.GetRoleById,
+	)
+	roles.Patch("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionRoleManage),
+		handler.UpdateRole,
+	)
+	roles.Delete("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionRoleManage),
+		handler.DeleteRole,
+	)
+}
+
+// *===========================MUTATION===========================*
+func (h *RoleHandler) CreateRole(c *fiber.Ctx) error {
+	var payload domain.CreateRolePayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	role, err := h.Service.CreateRole(c.Context(), &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessRoleCreatedKey, role)
+}
+
+func (h *RoleHandler) UpdateRole(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrRoleIDRequiredKey))
+	}
+
+	var payload domain.UpdateRolePayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	role, err := h.Service.UpdateRole(c.Context(), id, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessRoleUpdatedKey, role)
+}
+
+func (h *RoleHandler) DeleteRole(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrRoleIDRequiredKey))
+	}
+
+	if err := h.Service.DeleteRole(c.Context(), id); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessRoleDeletedKey, nil)
+}
+
+func (h *RoleHandler) AssignUserRoles(c *fiber.Ctx) error {
+	userId := c.Params("userId")
+	if userId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	var payload domain.AssignUserRolesPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	userRoles, err := h.Service.AssignUserRoles(c.Context(), userId, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessUserRolesAssignedKey, userRoles)
+}
+
+// *===========================QUERY===========================*
+func (h *RoleHandler) GetRoles(c *fiber.Ctx) error {
+	roles, err := h.Service.GetRoles(c.Context())
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessRoleRetrievedKey, roles)
+}
+
+func (h *RoleHandler) GetRoleById(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrRoleIDRequiredKey))
+	}
+
+	role, err := h.Service.GetRoleById(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessRoleRetrievedKey, role)
+}
+
+func (h *RoleHandler) GetPermissions(c *fiber.Ctx) error {
+	permissions := h.Service.GetPermissions(c.Context())
+	return web.Success(c, fiber.StatusOK, utils.SuccessPermissionsRetrievedKey, permissions)
+}
+
+func (h *RoleHandler) GetUserRoles(c *fiber.Ctx) error {
+	userId := c.Params("userId")
+	if userId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	userRoles, err := h.Service.GetUserRoles(c.Context(), userId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessUserRolesRetrievedKey, userRoles)
+}
@@ -0,0 +1,323 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/saved_filter"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SavedFilterHandler struct {
+	Service saved_filter.SavedFilterService
+}
+
+func NewSavedFilterHandler(app fiber.Router, s saved_filter.SavedFilterService) {
+	handler := &SavedFilterHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	// * Filter hanya bisa diakses pemiliknya, permission export dicek saat jadwal disimpan dan saat report dijalankan
+	savedFilters := app.Group("/saved-filters")
+
+	savedFilters.Post("/",
+		middleware.AuthMiddleware(),
+		handler.CreateSavedFilter,
+	)
+	savedFilters.Get("/",
+		middleware.AuthMiddleware(),
+		handler.GetSavedFilters,
+	)
+	savedFilters.Get("/:id",
+		middleware.AuthMiddleware(),
+		handler.GetSavedFilterById,
+	)
+	savedFilters.Patch("/:id",
+		middleware.AuthMiddleware(),
+		handler.UpdateSavedFilter,
+	)
+	savedFilters.Delete("/:id",
+		middleware.AuthMiddleware(),
+		handler.DeleteSavedFilter,
+	)
+	savedFilters.Put("/:id/schedule",
+		middleware.AuthMiddleware(),
+		handler.UpsertReportSchedule,
+	)
+	savedFilters.Delete("/:id/schedule",
+		middleware.AuthMiddleware(),
+		handler.DeleteReportSchedule,
+	)
+	savedFilters.Post("/:id/run",
+		middleware.AuthMiddleware(),
+		handler.RunSavedFilter,
+	)
+	savedFilters.Get("/:id/runs",
+		middleware.AuthMiddleware(),
+		handler.GetReportRunsCursor,
+	)
+	savedFilters.Get("/:id/runs/:runId",
+		middleware.AuthMiddleware(),
+		handler.GetReportRunById,
+	)
+	savedFilters.Get("/:id/runs/:runId/download",
+		middleware.AuthMiddleware(),
+		handler.DownloadReportRun,
+	)
+}
+
+// *===========================MUTATION===========================*
+func (h *SavedFilterHandler) CreateSavedFilter(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	var payload domain.CreateSavedFilterPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	savedFilter, err := h.Service.CreateSavedFilter(c.Context(), userID, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessSavedFilterCreatedKey, savedFilter)
+}
+
+func (h *SavedFilterHandler) UpdateSavedFilter(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSavedFilterIDRequiredKey))
+	}
+
+	var payload domain.UpdateSavedFilterPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	savedFilter, err := h.Service.UpdateSavedFilter(c.Context(), userID, id, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessSavedFilterUpdatedKey, savedFilter)
+}
+
+func (h *SavedFilterHandler) DeleteSavedFilter(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSavedFilterIDRequiredKey))
+	}
+
+	if err := h.Service.DeleteSavedFilter(c.Context(), userID, id); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessSavedFilterDeletedKey, nil)
+}
+
+func (h *SavedFilterHandler) UpsertReportSchedule(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSavedFilterIDRequiredKey))
+	}
+
+	var payload domain.UpsertReportSchedulePayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	savedFilter, err := h.Service.UpsertReportSchedule(c.Context(), userID, id, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessReportScheduleSavedKey, savedFilter)
+}
+
+func (h *SavedFilterHandler) DeleteReportSchedule(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSavedFilterIDRequiredKey))
+	}
+
+	if err := h.Service.DeleteReportSchedule(c.Context(), userID, id); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessReportScheduleDeletedKey, nil)
+}
+
+func (h *SavedFilterHandler) RunSavedFilter(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSavedFilterIDRequiredKey))
+	}
+
+	// * Body opsional, tanpa body pakai format dan penerima dari jadwal
+	var payload domain.RunSavedFilterPayload
+	if len(c.Body()) > 0 {
+		if err := web.ParseAndValidate(c, &payload); err != nil {
+			return web.HandleError(c, err)
+		}
+	}
+
+	run, err := h.Service.RunSavedFilter(c.Context(), userID, id, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusAccepted, utils.SuccessReportRunQueuedKey, run)
+}
+
+// *===========================QUERY===========================*
+func (h *SavedFilterHandler) GetSavedFilters(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	// * Parse filtering options
+	filters := &domain.SavedFilterFilterOptions{}
+	if entityType := c.Query("entityType"); entityType != "" {
+		savedFilterEntityType := domain.SavedFilterEntityType(entityType)
+		filters.EntityType = &savedFilterEntityType
+	}
+
+	savedFilters, err := h.Service.GetSavedFilters(c.Context(), userID, filters)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessSavedFiltersRetrievedKey, savedFilters)
+}
+
+func (h *SavedFilterHandler) GetSavedFilterById(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSavedFilterIDRequiredKey))
+	}
+
+	savedFilter, err := h.Service.GetSavedFilterById(c.Context(), userID, id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessSavedFilterRetrievedKey, savedFilter)
+}
+
+func (h *SavedFilterHandler) GetReportRunsCursor(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSavedFilterIDRequiredKey))
+	}
+
+	// * Parse filtering options
+	filters := &domain.ReportRunFilterOptions{}
+	if status := c.Query("status"); status != "" {
+		runStatus := domain.ReportRunStatus(status)
+		filters.Status = &runStatus
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	cursor := c.Query("cursor")
+	params := domain.ReportRunParams{
+		Filters:    filters,
+		Pagination: &domain.PaginationOptions{Limit: limit, Cursor: cursor},
+	}
+
+	runs, err := h.Service.GetReportRunsCursor(c.Context(), userID, id, params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	var nextCursor string
+	hasNextPage := len(runs) == limit
+	if hasNextPage {
+		nextCursor = runs[len(runs)-1].ID
+	}
+
+	return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessReportRunsRetrievedKey, runs, nextCursor, hasNextPage, limit)
+}
+
+func (h *SavedFilterHandler) GetReportRunById(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSavedFilterIDRequiredKey))
+	}
+	runId := c.Params("runId")
+	if runId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrReportRunIDRequiredKey))
+	}
+
+	run, err := h.Service.GetReportRunById(c.Context(), userID, id, runId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessReportRunRetrievedKey, run)
+}
+
+func (h *SavedFilterHandler) DownloadReportRun(c *fiber.Ctx) error {
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrSavedFilterIDRequiredKey))
+	}
+	runId := c.Params("runId")
+	if runId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrReportRunIDRequiredKey))
+	}
+
+	file, err := h.Service.DownloadReportRun(c.Context(), userID, id, runId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	c.Set("Content-Type", file.ContentType)
+	c.Set("Content-Disposition", attachmentDisposition(file.FileName))
+
+	return c.Send(file.Data)
+}
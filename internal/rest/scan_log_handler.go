@@ -39,22 +39,46 @@ func NewScanLogHandler(app fiber.Router, s scan_log.ScanLogService) {
 		handler.BulkCreateScanLogs,
 	)
 
-	scanLogs.Get("/", handler.GetScanLogsPaginated)
-	scanLogs.Get("/statistics", handler.GetScanLogStatistics)
-	scanLogs.Get("/cursor", handler.GetScanLogsCursor)
-	scanLogs.Get("/count", handler.CountScanLogs)
-	scanLogs.Get("/user/:userId", handler.GetScanLogsByUserId)
-	scanLogs.Get("/asset/:assetId", handler.GetScanLogsByAssetId)
-	scanLogs.Get("/check/:id", handler.CheckScanLogExists)
-	scanLogs.Get("/:id", handler.GetScanLogById)
+	scanLogs.Get("/",
+		middleware.AuthMiddleware(),
+		handler.GetScanLogsPaginated,
+	)
+	scanLogs.Get("/statistics",
+		middleware.AuthMiddleware(),
+		handler.GetScanLogStatistics,
+	)
+	scanLogs.Get("/cursor",
+		middleware.AuthMiddleware(),
+		handler.GetScanLogsCursor,
+	)
+	scanLogs.Get("/count",
+		middleware.AuthMiddleware(),
+		handler.CountScanLogs,
+	)
+	scanLogs.Get("/user/:userId",
+		middleware.AuthMiddleware(),
+		handler.GetScanLogsByUserId,
+	)
+	scanLogs.Get("/asset/:assetId",
+		middleware.AuthMiddleware(),
+		handler.GetScanLogsByAssetId,
+	)
+	scanLogs.Get("/check/:id",
+		middleware.AuthMiddleware(),
+		handler.CheckScanLogExists,
+	)
+	scanLogs.Get("/:id",
+		middleware.AuthMiddleware(),
+		handler.GetScanLogById,
+	)
 	scanLogs.Delete("/:id",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionScanLogDelete),
 		handler.DeleteScanLog,
 	)
 	scanLogs.Post("/bulk-delete",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionScanLogDelete),
 		handler.BulkDeleteScanLogs,
 	)
 }
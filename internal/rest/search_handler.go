@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/search"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SearchHandler struct {
+	Service search.SearchService
+}
+
+func NewSearchHandler(app fiber.Router, s search.SearchService) {
+	handler := &SearchHandler{
+		Service: s,
+	}
+
+	// * Location scope user berlaku untuk asset, issue report dan maintenance record
+	app.Get("/search",
+		middleware.AuthMiddleware(),
+		handler.GlobalSearch,
+	)
+}
+
+// *===========================QUERY===========================*
+func (h *SearchHandler) GlobalSearch(c *fiber.Ctx) error {
+	params := domain.GlobalSearchParams{
+		Query: c.Query("q"),
+	}
+
+	// * types=asset,user untuk membatasi group yang dicari
+	if types := c.Query("types"); types != "" {
+		for _, entityType := range strings.Split(types, ",") {
+			if entityType = strings.TrimSpace(entityType); entityType != "" {
+				params.Types = append(params.Types, domain.SearchEntityType(entityType))
+			}
+		}
+	}
+
+	params.Limit, _ = strconv.Atoi(c.Query("limit", strconv.Itoa(domain.DefaultSearchLimit)))
+
+	result, err := h.Service.GlobalSearch(c.Context(), params, web.GetLanguageFromContext(c))
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessSearchCompletedKey, result)
+}
@@ -0,0 +1,434 @@
+package rest
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/stock_item"
+	"github.com/gofiber/fiber/v2"
+)
+
+type StockItemHandler struct {
+	Service stock_item.StockItemService
+}
+
+func NewStockItemHandler(app fiber.Router, s stock_item.StockItemService) {
+	handler := &StockItemHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	stockItems := app.Group("/stock-items")
+
+	stockItems.Post("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionStockItemCreate),
+		handler.CreateStockItem,
+	)
+	stockItems.Post("/:id/transactions",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionStockItemTransact),
+		handler.CreateStockTransaction,
+	)
+
+	stockItems.Get("/", handler.GetStockItemsPaginated)
+	stockItems.Get("/cursor", handler.GetStockItemsCursor)
+	stockItems.Get("/count", handler.CountStockItems)
+	stockItems.Get("/low-stock", handler.GetLowStockItems)
+	stockItems.Get("/check/:id", handler.CheckStockItemExists)
+	stockItems.Get("/transactions", handler.GetStockTransactionsPaginated)
+	stockItems.Get("/transactions/cursor", handler.GetStockTransactionsCursor)
+	stockItems.Get("/transactions/count", handler.CountStockTransactions)
+	stockItems.Get("/:id/transactions", handler.GetStockItemTransactions)
+	stockItems.Get("/:id", handler.GetStockItemById)
+	stockItems.Patch("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionStockItemUpdate),
+		handler.UpdateStockItem,
+	)
+	stockItems.Delete("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionStockItemDelete),
+		handler.DeleteStockItem,
+	)
+}
+
+func (h *StockItemHandler) parseStockItemFiltersAndSort(c *fiber.Ctx) (domain.StockItemParams, error) {
+	params := domain.StockItemParams{}
+
+	// * Parse search query
+	search := c.Query("search")
+	if search != "" {
+		params.SearchQuery = &search
+	}
+
+	// * Parse sorting options
+	sortBy := c.Query("sortBy")
+	if sortBy != "" {
+		sortOrder := c.Query("sortOrder", "asc")
+		params.Sort = &domain.StockItemSortOptions{
+			Field: domain.StockItemSortField(sortBy),
+			Order: domain.SortOrder(sortOrder),
+		}
+	}
+
+	// * Parse filtering options
+	filters := &domain.StockItemFilterOptions{}
+
+	if isActiveStr := c.Query("isActive"); isActiveStr != "" {
+		if isActive, err := strconv.ParseBool(isActiveStr); err == nil {
+			filters.IsActive = &isActive
+		}
+	}
+
+	if unit := c.Query("unit"); unit != "" {
+		filters.Unit = &unit
+	}
+
+	if locationID := c.Query("locationId"); locationID != "" {
+		filters.LocationID = &locationID
+	}
+
+	if isLowStockStr := c.Query("isLowStock"); isLowStockStr != "" {
+		if isLowStock, err := strconv.ParseBool(isLowStockStr); err == nil {
+			filters.IsLowStock = &isLowStock
+		}
+	}
+
+	params.Filters = filters
+
+	return params, nil
+}
+
+func (h *StockItemHandler) parseStockTransactionFiltersAndSort(c *fiber.Ctx) (domain.StockTransactionParams, error) {
+	params := domain.StockTransactionParams{}
+
+	// * Parse sorting options
+	sortBy := c.Query("sortBy")
+	if sortBy != "" {
+		sortOrder := c.Query("sortOrder", "desc")
+		params.Sort = &domain.StockTransactionSortOptions{
+			Field: domain.StockTransactionSortField(sortBy),
+			Order: domain.SortOrder(sortOrder),
+		}
+	}
+
+	// * Parse filtering options
+	filters := &domain.StockTransactionFilterOptions{}
+
+	if itemID := c.Query("itemId"); itemID != "" {
+		filters.ItemID = &itemID
+	}
+
+	if transactionType := c.Query("type"); transactionType != "" {
+		stockTransactionType := domain.StockTransactionType(transactionType)
+		filters.Type = &stockTransactionType
+	}
+
+	if locationID := c.Query("locationId"); locationID != "" {
+		filters.LocationID = &locationID
+	}
+
+	if maintenanceRecordID := c.Query("maintenanceRecordId"); maintenanceRecordID != "" {
+		filters.MaintenanceRecordID = &maintenanceRecordID
+	}
+
+	if performedBy := c.Query("performedBy"); performedBy != "" {
+		filters.PerformedBy = &performedBy
+	}
+
+	// * Parse date range filters
+	if dateFrom := c.Query("dateFrom"); dateFrom != "" {
+		if parsedDate, err := time.ParseInLocation("2006-01-02", dateFrom, time.UTC); err == nil {
+			filters.DateFrom = &parsedDate
+		}
+	}
+
+	if dateTo := c.Query("dateTo"); dateTo != "" {
+		if parsedDate, err := time.ParseInLocation("2006-01-02", dateTo, time.UTC); err == nil {
+			filters.DateTo = &parsedDate
+		}
+	}
+
+	params.Filters = filters
+
+	return params, nil
+}
+
+// *===========================MUTATION===========================*
+func (h *StockItemHandler) CreateStockItem(c *fiber.Ctx) error {
+	var payload domain.CreateStockItemPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+
+	item, err := h.Service.CreateStockItem(c.Context(), &payload, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessStockItemCreatedKey, item)
+}
+
+func (h *StockItemHandler) UpdateStockItem(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrStockItemIDRequiredKey))
+	}
+
+	var payload domain.UpdateStockItemPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+
+	item, err := h.Service.UpdateStockItem(c.Context(), id, &payload, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessStockItemUpdatedKey, item)
+}
+
+func (h *StockItemHandler) DeleteStockItem(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrStockItemIDRequiredKey))
+	}
+
+	if err := h.Service.DeleteStockItem(c.Context(), id); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessStockItemDeletedKey, nil)
+}
+
+func (h *StockItemHandler) CreateStockTransaction(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrStockItemIDRequiredKey))
+	}
+
+	var payload domain.CreateStockTransactionPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get user ID from context (set by auth middleware)
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+
+	transaction, err := h.Service.CreateStockTransaction(c.Context(), id, &payload, userID, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessStockTransactionCreatedKey, transaction)
+}
+
+// *===========================QUERY===========================*
+func (h *StockItemHandler) GetStockItemsPaginated(c *fiber.Ctx) error {
+	params, err := h.parseStockItemFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Offset: offset}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	items, total, err := h.Service.GetStockItemsPaginated(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.SuccessWithOffsetInfo(c, fiber.StatusOK, utils.SuccessStockItemRetrievedKey, items, int(total), limit, (offset/limit)+1)
+}
+
+func (h *StockItemHandler) GetStockItemsCursor(c *fiber.Ctx) error {
+	params, err := h.parseStockItemFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	cursor := c.Query("cursor")
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Cursor: cursor}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	items, err := h.Service.GetStockItemsCursor(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	var nextCursor string
+	hasNextPage := len(items) == limit
+	if hasNextPage {
+		nextCursor = items[len(items)-1].ID
+	}
+
+	return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessStockItemRetrievedKey, items, nextCursor, hasNextPage, limit)
+}
+
+func (h *StockItemHandler) GetStockItemById(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrStockItemIDRequiredKey))
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	item, err := h.Service.GetStockItemById(c.Context(), id, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessStockItemRetrievedKey, item)
+}
+
+func (h *StockItemHandler) GetLowStockItems(c *fiber.Ctx) error {
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	items, err := h.Service.GetLowStockItems(c.Context(), langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessStockItemLowStockRetrievedKey, items)
+}
+
+func (h *StockItemHandler) CountStockItems(c *fiber.Ctx) error {
+	params, err := h.parseStockItemFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	count, err := h.Service.CountStockItems(c.Context(), params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessStockItemCountedKey, count)
+}
+
+func (h *StockItemHandler) CheckStockItemExists(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrStockItemIDRequiredKey))
+	}
+
+	exists, err := h.Service.CheckStockItemExists(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessStockItemCountedKey, map[string]bool{"exists": exists})
+}
+
+func (h *StockItemHandler) GetStockTransactionsPaginated(c *fiber.Ctx) error {
+	params, err := h.parseStockTransactionFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Offset: offset}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	transactions, total, err := h.Service.GetStockTransactionsPaginated(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.SuccessWithOffsetInfo(c, fiber.StatusOK, utils.SuccessStockTransactionRetrievedKey, transactions, int(total), limit, (offset/limit)+1)
+}
+
+func (h *StockItemHandler) GetStockTransactionsCursor(c *fiber.Ctx) error {
+	params, err := h.parseStockTransactionFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	cursor := c.Query("cursor")
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Cursor: cursor}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	transactions, err := h.Service.GetStockTransactionsCursor(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	var nextCursor string
+	hasNextPage := len(transactions) == limit
+	if hasNextPage {
+		nextCursor = transactions[len(transactions)-1].ID
+	}
+
+	return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessStockTransactionRetrievedKey, transactions, nextCursor, hasNextPage, limit)
+}
+
+func (h *StockItemHandler) GetStockItemTransactions(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrStockItemIDRequiredKey))
+	}
+
+	params, err := h.parseStockTransactionFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+	params.Filters.ItemID = &id
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Offset: offset}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	transactions, total, err := h.Service.GetStockTransactionsPaginated(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.SuccessWithOffsetInfo(c, fiber.StatusOK, utils.SuccessStockTransactionRetrievedKey, transactions, int(total), limit, (offset/limit)+1)
+}
+
+func (h *StockItemHandler) CountStockTransactions(c *fiber.Ctx) error {
+	params, err := h.parseStockTransactionFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	count, err := h.Service.CountStockTransactions(c.Context(), params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessStockTransactionCountedKey, count)
+}
@@ -29,39 +29,85 @@ func NewUserHandler(app fiber.Router, s user.UserService) {
 	// * Create
 	users.Post("/export/list",
 		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserExport),
 		handler.ExportUserList,
 	)
 	users.Post("/",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionUserCreate),
 		handler.CreateUser,
 	)
 	users.Post("/bulk",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionUserCreate),
 		handler.BulkCreateUsers,
 	)
 
-	users.Get("/", handler.GetUsersPaginated)
-	users.Get("/statistics", handler.GetUserStatistics)
-	users.Get("/cursor", handler.GetUsersCursor)
-	users.Get("/count", handler.CountUsers)
+	users.Get("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserRead),
+		handler.GetUsersPaginated,
+	)
+	users.Get("/statistics",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserRead),
+		handler.GetUserStatistics,
+	)
+	users.Get("/cursor",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserRead),
+		handler.GetUsersCursor,
+	)
+	users.Get("/count",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserRead),
+		handler.CountUsers,
+	)
 	users.Get("/profile", middleware.AuthMiddleware(), handler.GetCurrentUser)
 	users.Get("/profile/statistics", middleware.AuthMiddleware(), handler.GetCurrentUserPersonalStatistics)
 	users.Patch("/profile", middleware.AuthMiddleware(), handler.UpdateCurrentUser)
 	users.Patch("/profile/password", middleware.AuthMiddleware(), handler.ChangeCurrentUserPassword)
-	users.Get("/name/:name", handler.GetUserByName)
-	users.Get("/email/:email", handler.GetUserByEmail)
+	users.Get("/name/:name",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserRead),
+		handler.GetUserByName,
+	)
+	users.Get("/email/:email",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserRead),
+		handler.GetUserByEmail,
+	)
 	users.Get("/check/name/:name", handler.CheckNameExists)
 	users.Get("/check/email/:email", handler.CheckEmailExists)
 	users.Get("/check/:id", handler.CheckUserExists)
-	users.Get("/:id", handler.GetUserById)
-	users.Patch("/:id", handler.UpdateUser)
-	users.Patch("/:id/password", middleware.AuthMiddleware(), handler.ChangePassword)
-	users.Delete("/:id", handler.DeleteUser)
+	users.Get("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermissionOrSelf("id", domain.PermissionUserRead),
+		handler.GetUserById,
+	)
+	users.Patch("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserUpdate),
+		handler.UpdateUser,
+	)
+	users.Patch("/:id/password",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermissionOrSelf("id", domain.PermissionUserUpdate),
+		handler.ChangePassword,
+	)
+	users.Post("/:id/unlock",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserUpdate),
+		handler.UnlockUser,
+	)
+	users.Delete("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionUserDelete),
+		handler.DeleteUser,
+	)
 	users.Post("/bulk-delete",
 		middleware.AuthMiddleware(),
-		middleware.AuthorizeRole(domain.RoleAdmin),
+		middleware.RequirePermission(domain.PermissionUserDelete),
 		handler.BulkDeleteUsers,
 	)
 }
@@ -106,6 +152,13 @@ func (h *UserHandler) parseUserFiltersAndSort(c *fiber.Ctx) (domain.UserParams,
 		filters.EmployeeID = &employeeID
 	}
 
+	if isLockedStr := c.Query("isLocked"); isLockedStr != "" {
+		isLocked, err := strconv.ParseBool(isLockedStr)
+		if err == nil {
+			filters.IsLocked = &isLocked
+		}
+	}
+
 	params.Filters = filters
 
 	return params, nil
@@ -280,6 +333,15 @@ func (h *UserHandler) ChangePassword(c *fiber.Ctx) error {
 		return web.HandleError(c, err)
 	}
 
+	// * Ganti password sendiri tetap wajib old password, sama dengan /profile/password
+	if userID, ok := web.GetUserIDFromContext(c); ok && userID == id {
+		if err := h.Service.ChangeCurrentUserPassword(c.Context(), id, &payload); err != nil {
+			return web.HandleError(c, err)
+		}
+
+		return web.Success(c, fiber.StatusOK, utils.SuccessUpdatedKey, nil)
+	}
+
 	// For admin changing another user's password we ignore OldPassword, but service.ChangePassword expects payload.NewPassword
 	if err := h.Service.ChangePassword(c.Context(), id, &payload); err != nil {
 		return web.HandleError(c, err)
@@ -288,6 +350,20 @@ func (h *UserHandler) ChangePassword(c *fiber.Ctx) error {
 	return web.Success(c, fiber.StatusOK, utils.SuccessUpdatedKey, nil)
 }
 
+func (h *UserHandler) UnlockUser(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	user, err := h.Service.UnlockUser(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessUserUnlockedKey, user)
+}
+
 func (h *UserHandler) DeleteUser(c *fiber.Ctx) error {
 	id := c.Params("id")
 	if id == "" {
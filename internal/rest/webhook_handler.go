@@ -0,0 +1,261 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/webhook"
+	"github.com/gofiber/fiber/v2"
+)
+
+type WebhookHandler struct {
+	Service webhook.WebhookService
+}
+
+func NewWebhookHandler(app fiber.Router, s webhook.WebhookService) {
+	handler := &WebhookHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	webhooks := app.Group("/webhooks")
+
+	// * Route statis harus sebelum /:id
+	webhooks.Get("/event-types",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.GetWebhookEventTypes,
+	)
+
+	webhooks.Post("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.CreateWebhookEndpoint,
+	)
+	webhooks.Get("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.GetWebhookEndpoints,
+	)
+	webhooks.Get("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.GetWebhookEndpointById,
+	)
+	webhooks.Patch("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.UpdateWebhookEndpoint,
+	)
+	webhooks.Delete("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.DeleteWebhookEndpoint,
+	)
+	webhooks.Post("/:id/rotate-secret",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.RotateWebhookEndpointSecret,
+	)
+	webhooks.Post("/:id/ping",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.PingWebhookEndpoint,
+	)
+	webhooks.Get("/:id/deliveries",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.GetWebhookDeliveriesCursor,
+	)
+	webhooks.Get("/:id/deliveries/:deliveryId",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.GetWebhookDeliveryById,
+	)
+	webhooks.Post("/:id/deliveries/:deliveryId/redeliver",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWebhookManage),
+		handler.RedeliverWebhookDelivery,
+	)
+}
+
+// *===========================MUTATION===========================*
+func (h *WebhookHandler) CreateWebhookEndpoint(c *fiber.Ctx) error {
+	var payload domain.CreateWebhookEndpointPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	endpoint, err := h.Service.CreateWebhookEndpoint(c.Context(), &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessWebhookEndpointCreatedKey, endpoint)
+}
+
+func (h *WebhookHandler) UpdateWebhookEndpoint(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWebhookEndpointIDRequiredKey))
+	}
+
+	var payload domain.UpdateWebhookEndpointPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	endpoint, err := h.Service.UpdateWebhookEndpoint(c.Context(), id, &payload)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWebhookEndpointUpdatedKey, endpoint)
+}
+
+func (h *WebhookHandler) DeleteWebhookEndpoint(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWebhookEndpointIDRequiredKey))
+	}
+
+	if err := h.Service.DeleteWebhookEndpoint(c.Context(), id); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWebhookEndpointDeletedKey, nil)
+}
+
+func (h *WebhookHandler) RotateWebhookEndpointSecret(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWebhookEndpointIDRequiredKey))
+	}
+
+	endpoint, err := h.Service.RotateWebhookEndpointSecret(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWebhookSecretRotatedKey, endpoint)
+}
+
+func (h *WebhookHandler) PingWebhookEndpoint(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWebhookEndpointIDRequiredKey))
+	}
+
+	delivery, err := h.Service.PingWebhookEndpoint(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessWebhookPingQueuedKey, delivery)
+}
+
+func (h *WebhookHandler) RedeliverWebhookDelivery(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWebhookEndpointIDRequiredKey))
+	}
+	deliveryId := c.Params("deliveryId")
+	if deliveryId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWebhookDeliveryIDRequiredKey))
+	}
+
+	delivery, err := h.Service.RedeliverWebhookDelivery(c.Context(), id, deliveryId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessWebhookRedeliveryQueuedKey, delivery)
+}
+
+// *===========================QUERY===========================*
+func (h *WebhookHandler) GetWebhookEventTypes(c *fiber.Ctx) error {
+	return web.Success(c, fiber.StatusOK, utils.SuccessWebhookEventTypesRetrievedKey, domain.AllWebhookEventTypes)
+}
+
+func (h *WebhookHandler) GetWebhookEndpoints(c *fiber.Ctx) error {
+	endpoints, err := h.Service.GetWebhookEndpoints(c.Context())
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWebhookEndpointRetrievedKey, endpoints)
+}
+
+func (h *WebhookHandler) GetWebhookEndpointById(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWebhookEndpointIDRequiredKey))
+	}
+
+	endpoint, err := h.Service.GetWebhookEndpointById(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWebhookEndpointRetrievedKey, endpoint)
+}
+
+func (h *WebhookHandler) GetWebhookDeliveriesCursor(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWebhookEndpointIDRequiredKey))
+	}
+
+	// * Parse filtering options
+	filters := &domain.WebhookDeliveryFilterOptions{}
+	if status := c.Query("status"); status != "" {
+		deliveryStatus := domain.WebhookDeliveryStatus(status)
+		filters.Status = &deliveryStatus
+	}
+	if eventType := c.Query("eventType"); eventType != "" {
+		webhookEventType := domain.WebhookEventType(eventType)
+		filters.EventType = &webhookEventType
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	cursor := c.Query("cursor")
+	params := domain.WebhookDeliveryParams{
+		Filters:    filters,
+		Pagination: &domain.PaginationOptions{Limit: limit, Cursor: cursor},
+	}
+
+	deliveries, err := h.Service.GetWebhookDeliveriesCursor(c.Context(), id, params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	var nextCursor string
+	hasNextPage := len(deliveries) == limit
+	if hasNextPage {
+		nextCursor = deliveries[len(deliveries)-1].ID
+	}
+
+	return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessWebhookDeliveryRetrievedKey, deliveries, nextCursor, hasNextPage, limit)
+}
+
+func (h *WebhookHandler) GetWebhookDeliveryById(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWebhookEndpointIDRequiredKey))
+	}
+	deliveryId := c.Params("deliveryId")
+	if deliveryId == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWebhookDeliveryIDRequiredKey))
+	}
+
+	delivery, err := h.Service.GetWebhookDeliveryById(c.Context(), id, deliveryId)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWebhookDeliveryRetrievedKey, delivery)
+}
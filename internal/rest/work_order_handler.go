@@ -0,0 +1,419 @@
+package rest
+
+import (
+	"strconv"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/rest/middleware"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/Rizz404/inventory-api/services/work_order"
+	"github.com/gofiber/fiber/v2"
+)
+
+type WorkOrderHandler struct {
+	Service work_order.WorkOrderService
+}
+
+func NewWorkOrderHandler(app fiber.Router, s work_order.WorkOrderService) {
+	handler := &WorkOrderHandler{
+		Service: s,
+	}
+
+	// * Bisa di group
+	// ! routenya bisa tabrakan hati-hati
+	workOrders := app.Group("/maintenance/work-orders")
+
+	workOrders.Post("/export/list",
+		middleware.AuthMiddleware(),
+		handler.ExportWorkOrderList,
+	)
+	workOrders.Post("/",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWorkOrderCreate),
+		handler.CreateWorkOrder,
+	)
+	workOrders.Post("/:id/assign",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWorkOrderAssign),
+		handler.AssignWorkOrder,
+	)
+	workOrders.Post("/:id/status",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWorkOrderUpdate),
+		handler.UpdateWorkOrderStatus,
+	)
+	workOrders.Post("/:id/complete",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWorkOrderUpdate),
+		handler.CompleteWorkOrder,
+	)
+
+	workOrders.Get("/",
+		middleware.AuthMiddleware(),
+		handler.GetWorkOrdersPaginated,
+	)
+	workOrders.Get("/cursor",
+		middleware.AuthMiddleware(),
+		handler.GetWorkOrdersCursor,
+	)
+	workOrders.Get("/count",
+		middleware.AuthMiddleware(),
+		handler.CountWorkOrders,
+	)
+	workOrders.Get("/check/:id",
+		middleware.AuthMiddleware(),
+		handler.CheckWorkOrderExists,
+	)
+	workOrders.Get("/statistics",
+		middleware.AuthMiddleware(),
+		handler.GetWorkOrderStatistics,
+	)
+	workOrders.Get("/:id",
+		middleware.AuthMiddleware(),
+		handler.GetWorkOrderById,
+	)
+	workOrders.Patch("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWorkOrderUpdate),
+		handler.UpdateWorkOrder,
+	)
+	workOrders.Delete("/:id",
+		middleware.AuthMiddleware(),
+		middleware.RequirePermission(domain.PermissionWorkOrderDelete),
+		handler.DeleteWorkOrder,
+	)
+}
+
+func (h *WorkOrderHandler) parseWorkOrderFiltersAndSort(c *fiber.Ctx) (domain.WorkOrderParams, error) {
+	params := domain.WorkOrderParams{}
+
+	// * Parse search query
+	search := c.Query("search")
+	if search != "" {
+		params.SearchQuery = &search
+	}
+
+	// * Parse sorting options
+	sortBy := c.Query("sortBy")
+	if sortBy != "" {
+		sortOrder := c.Query("sortOrder", "desc")
+		params.Sort = &domain.WorkOrderSortOptions{
+			Field: domain.WorkOrderSortField(sortBy),
+			Order: domain.SortOrder(sortOrder),
+		}
+	}
+
+	// * Parse filtering options
+	filters := &domain.WorkOrderFilterOptions{}
+
+	if status := c.Query("status"); status != "" {
+		workOrderStatus := domain.WorkOrderStatus(status)
+		filters.Status = &workOrderStatus
+	}
+
+	if priority := c.Query("priority"); priority != "" {
+		workOrderPriority := domain.WorkOrderPriority(priority)
+		filters.Priority = &workOrderPriority
+	}
+
+	if assetID := c.Query("assetId"); assetID != "" {
+		filters.AssetID = &assetID
+	}
+
+	if scheduleID := c.Query("scheduleId"); scheduleID != "" {
+		filters.ScheduleID = &scheduleID
+	}
+
+	if issueReportID := c.Query("issueReportId"); issueReportID != "" {
+		filters.IssueReportID = &issueReportID
+	}
+
+	if assignedTo := c.Query("assignedTo"); assignedTo != "" {
+		filters.AssignedTo = &assignedTo
+	}
+
+	if isOverdue := c.Query("isOverdue"); isOverdue != "" {
+		if overdue, err := strconv.ParseBool(isOverdue); err == nil {
+			filters.IsOverdue = &overdue
+		}
+	}
+
+	if fromDate := c.Query("fromDate"); fromDate != "" {
+		filters.FromDate = &fromDate
+	}
+
+	if toDate := c.Query("toDate"); toDate != "" {
+		filters.ToDate = &toDate
+	}
+
+	params.Filters = filters
+
+	return params, nil
+}
+
+// *===========================MUTATION===========================*
+func (h *WorkOrderHandler) CreateWorkOrder(c *fiber.Ctx) error {
+	var payload domain.CreateWorkOrderPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get user ID from context (set by auth middleware)
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+
+	workOrder, err := h.Service.CreateWorkOrder(c.Context(), &payload, userID, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusCreated, utils.SuccessWorkOrderCreatedKey, workOrder)
+}
+
+func (h *WorkOrderHandler) UpdateWorkOrder(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWorkOrderIDRequiredKey))
+	}
+
+	var payload domain.UpdateWorkOrderPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	workOrder, err := h.Service.UpdateWorkOrder(c.Context(), id, &payload, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWorkOrderUpdatedKey, workOrder)
+}
+
+func (h *WorkOrderHandler) DeleteWorkOrder(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWorkOrderIDRequiredKey))
+	}
+
+	if err := h.Service.DeleteWorkOrder(c.Context(), id); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWorkOrderDeletedKey, nil)
+}
+
+func (h *WorkOrderHandler) AssignWorkOrder(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWorkOrderIDRequiredKey))
+	}
+
+	var payload domain.AssignWorkOrderPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	workOrder, err := h.Service.AssignWorkOrder(c.Context(), id, &payload, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWorkOrderAssignedKey, workOrder)
+}
+
+func (h *WorkOrderHandler) UpdateWorkOrderStatus(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWorkOrderIDRequiredKey))
+	}
+
+	var payload domain.UpdateWorkOrderStatusPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	workOrder, err := h.Service.UpdateWorkOrderStatus(c.Context(), id, &payload, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWorkOrderStatusUpdatedKey, workOrder)
+}
+
+func (h *WorkOrderHandler) CompleteWorkOrder(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWorkOrderIDRequiredKey))
+	}
+
+	var payload domain.CompleteWorkOrderPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// * Get user ID from context (set by auth middleware)
+	userID, ok := web.GetUserIDFromContext(c)
+	if !ok {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrUserIDRequiredKey))
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+
+	workOrder, err := h.Service.CompleteWorkOrder(c.Context(), id, &payload, userID, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWorkOrderCompletedKey, workOrder)
+}
+
+// *===========================QUERY===========================*
+func (h *WorkOrderHandler) GetWorkOrdersPaginated(c *fiber.Ctx) error {
+	params, err := h.parseWorkOrderFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	offset, _ := strconv.Atoi(c.Query("offset", "0"))
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Offset: offset}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	workOrders, total, err := h.Service.GetWorkOrdersPaginated(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.SuccessWithOffsetInfo(c, fiber.StatusOK, utils.SuccessWorkOrderRetrievedKey, workOrders, int(total), limit, (offset/limit)+1)
+}
+
+func (h *WorkOrderHandler) GetWorkOrdersCursor(c *fiber.Ctx) error {
+	params, err := h.parseWorkOrderFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	cursor := c.Query("cursor")
+	params.Pagination = &domain.PaginationOptions{Limit: limit, Cursor: cursor}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	workOrders, err := h.Service.GetWorkOrdersCursor(c.Context(), params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	var nextCursor string
+	hasNextPage := len(workOrders) == limit
+	if hasNextPage {
+		nextCursor = workOrders[len(workOrders)-1].ID
+	}
+
+	return web.SuccessWithCursor(c, fiber.StatusOK, utils.SuccessWorkOrderRetrievedKey, workOrders, nextCursor, hasNextPage, limit)
+}
+
+func (h *WorkOrderHandler) GetWorkOrderById(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWorkOrderIDRequiredKey))
+	}
+
+	// * Get language from headers
+	langCode := web.GetLanguageFromContext(c)
+
+	workOrder, err := h.Service.GetWorkOrderById(c.Context(), id, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWorkOrderRetrievedKey, workOrder)
+}
+
+func (h *WorkOrderHandler) CountWorkOrders(c *fiber.Ctx) error {
+	params, err := h.parseWorkOrderFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	count, err := h.Service.CountWorkOrders(c.Context(), params)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWorkOrderCountedKey, count)
+}
+
+func (h *WorkOrderHandler) CheckWorkOrderExists(c *fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return web.HandleError(c, domain.ErrBadRequestWithKey(utils.ErrWorkOrderIDRequiredKey))
+	}
+
+	exists, err := h.Service.CheckWorkOrderExists(c.Context(), id)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWorkOrderCountedKey, map[string]bool{"exists": exists})
+}
+
+// ============== STATISTICS ==============
+func (h *WorkOrderHandler) GetWorkOrderStatistics(c *fiber.Ctx) error {
+	stats, err := h.Service.GetWorkOrderStatistics(c.Context())
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	return web.Success(c, fiber.StatusOK, utils.SuccessWorkOrderStatisticsRetrievedKey, stats)
+}
+
+// *===========================EXPORT===========================*
+func (h *WorkOrderHandler) ExportWorkOrderList(c *fiber.Ctx) error {
+	var payload domain.ExportWorkOrderListPayload
+	if err := web.ParseAndValidate(c, &payload); err != nil {
+		return web.HandleError(c, err)
+	}
+
+	params, err := h.parseWorkOrderFiltersAndSort(c)
+	if err != nil {
+		return web.HandleError(c, domain.ErrBadRequest(err.Error()))
+	}
+
+	langCode := web.GetLanguageFromContext(c)
+	fileBytes, filename, err := h.Service.ExportWorkOrderList(c.Context(), payload, params, langCode)
+	if err != nil {
+		return web.HandleError(c, err)
+	}
+
+	// Set appropriate content type and headers
+	var contentType string
+	switch payload.Format {
+	case domain.ExportFormatPDF:
+		contentType = "application/pdf"
+	case domain.ExportFormatExcel:
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	c.Set("Content-Type", contentType)
+	c.Set("Content-Disposition", "attachment; filename="+filename)
+
+	return c.Send(fileBytes)
+}
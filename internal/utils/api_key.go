@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	APIKeyPrefix = "inv_"
+	// Panjang prefix yang disimpan apa adanya untuk identifikasi key di dashboard
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+	apiKeySecretSize    = 25
+)
+
+var apiKeyEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateAPIKey creates a new client key and returns it with its display prefix and hash
+func GenerateAPIKey() (key string, keyPrefix string, keyHash string, err error) {
+	secret := make([]byte, apiKeySecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", err
+	}
+
+	key = APIKeyPrefix + strings.ToLower(apiKeyEncoding.EncodeToString(secret))
+	return key, key[:apiKeyDisplayLength], HashAPIKey(key), nil
+}
+
+// HashAPIKey hashes a client key for lookup, keys are random enough that a fast hash is safe
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(key)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+// EnvInt reads an integer env var, kosong atau tidak valid berarti pakai fallback
+func EnvInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("⚠️ Invalid %s value %q, using default %d", key, value, fallback)
+		return fallback
+	}
+
+	return parsed
+}
+
+// EnvDuration reads a duration env var (mis. 30s, 15m), kosong atau tidak valid berarti pakai fallback
+func EnvDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("⚠️ Invalid %s value %q, using default %s", key, value, fallback)
+		return fallback
+	}
+
+	return parsed
+}
+
+// EnvPositiveInt is EnvInt for settings where zero or negative makes no sense (batch size, concurrency)
+func EnvPositiveInt(key string, fallback int) int {
+	if value := EnvInt(key, fallback); value > 0 {
+		return value
+	}
+	return fallback
+}
+
+// EnvPositiveDuration is EnvDuration for settings where zero or negative makes no sense (interval, timeout)
+func EnvPositiveDuration(key string, fallback time.Duration) time.Duration {
+	if value := EnvDuration(key, fallback); value > 0 {
+		return value
+	}
+	return fallback
+}
@@ -41,6 +41,7 @@ const (
 	ErrLocationIDRequiredKey   MessageKey = "error.location.id_required"
 	ErrLocationCodeRequiredKey MessageKey = "error.location.code_required"
 	ErrLocationNameRequiredKey MessageKey = "error.location.name_required"
+	ErrLocationOutOfScopeKey   MessageKey = "error.location.out_of_scope"
 
 	// * Asset-specific error keys
 	ErrAssetNotFoundKey             MessageKey = "error.asset.not_found"
@@ -54,6 +55,19 @@ const (
 	ErrAssetTagRequiredWhenCategoryChangesKey      MessageKey = "error.asset.tag_required_when_category_changes"
 	ErrDataMatrixRequiredWhenCategoryChangesKey    MessageKey = "error.asset.datamatrix_required_when_category_changes"
 
+	// * Custom attribute error keys
+	ErrCategoryAttributeKeyInvalidKey      MessageKey = "error.category.attribute_key_invalid"
+	ErrCategoryAttributeDuplicateKey       MessageKey = "error.category.attribute_duplicate"
+	ErrCategoryAttributeOptionsRequiredKey MessageKey = "error.category.attribute_options_required"
+	ErrCategoryAttributeRuleInvalidKey     MessageKey = "error.category.attribute_rule_invalid"
+	ErrAssetAttributeUnknownKey            MessageKey = "error.asset.attribute_unknown"
+	ErrAssetAttributeRequiredKey           MessageKey = "error.asset.attribute_required"
+	ErrAssetAttributeInvalidKey            MessageKey = "error.asset.attribute_invalid"
+	ErrAssetAttributeOutOfRangeKey         MessageKey = "error.asset.attribute_out_of_range"
+
+	// * Depreciation error keys
+	ErrAssetDepreciationAsOfInvalidKey MessageKey = "error.asset.depreciation_as_of_invalid"
+
 	// * Scan log-specific error keys
 	ErrScanLogNotFoundKey   MessageKey = "error.scan_log.not_found"
 	ErrScanLogIDRequiredKey MessageKey = "error.scan_log.id_required"
@@ -66,6 +80,7 @@ const (
 	ErrNotificationPriorityRequiredKey MessageKey = "error.notification.priority_required"
 	ErrNotificationTitleRequiredKey    MessageKey = "error.notification.title_required"
 	ErrNotificationMessageRequiredKey  MessageKey = "error.notification.message_required"
+	ErrNotificationPreferenceDuplicateTypeKey MessageKey = "error.notification.preference_duplicate_type"
 
 	// * Issue report-specific error keys
 	ErrIssueReportNotFoundKey         MessageKey = "error.issue_report.not_found"
@@ -86,6 +101,25 @@ const (
 	ErrAssetMovementNoChangeKey        MessageKey = "error.asset_movement.no_change"
 	ErrAssetMovementSameLocationKey    MessageKey = "error.asset_movement.same_location"
 
+	// * Asset loan-specific error keys
+	ErrAssetLoanNotFoundKey          MessageKey = "error.asset_loan.not_found"
+	ErrAssetLoanIDRequiredKey        MessageKey = "error.asset_loan.id_required"
+	ErrAssetLoanAlreadyActiveKey     MessageKey = "error.asset_loan.already_active"
+	ErrAssetLoanAlreadyReturnedKey   MessageKey = "error.asset_loan.already_returned"
+	ErrAssetLoanAssetUnavailableKey  MessageKey = "error.asset_loan.asset_unavailable"
+	ErrAssetLoanInvalidReturnDateKey MessageKey = "error.asset_loan.invalid_return_date"
+	ErrAssetLoanBorrowerAssignedKey  MessageKey = "error.asset_loan.borrower_already_assigned"
+
+	// * Audit log-specific error keys
+	ErrAuditLogEntityIDRequiredKey MessageKey = "error.audit_log.entity_id_required"
+
+	// * Audit session-specific error keys
+	ErrAuditSessionNotFoundKey      MessageKey = "error.audit_session.not_found"
+	ErrAuditSessionIDRequiredKey    MessageKey = "error.audit_session.id_required"
+	ErrAuditSessionScopeRequiredKey MessageKey = "error.audit_session.scope_required"
+	ErrAuditSessionAlreadyOpenKey   MessageKey = "error.audit_session.already_open"
+	ErrAuditSessionNotOpenKey       MessageKey = "error.audit_session.not_open"
+
 	// * Maintenance-specific error keys
 	ErrMaintenanceScheduleNotFoundKey      MessageKey = "error.maintenance.schedule_not_found"
 	ErrMaintenanceRecordNotFoundKey        MessageKey = "error.maintenance.record_not_found"
@@ -96,6 +130,36 @@ const (
 	ErrMaintenanceRecordDateRequiredKey    MessageKey = "error.maintenance.record_date_required"
 	ErrMaintenanceScheduleTitleRequiredKey MessageKey = "error.maintenance.schedule_title_required"
 	ErrMaintenanceRecordTitleRequiredKey   MessageKey = "error.maintenance.record_title_required"
+	ErrMaintenanceJobInvalidKey            MessageKey = "error.maintenance.job_invalid"
+	ErrMaintenanceJobAlreadyRunningKey     MessageKey = "error.maintenance.job_already_running"
+
+	// * Work order-specific error keys
+	ErrWorkOrderNotFoundKey              MessageKey = "error.work_order.not_found"
+	ErrWorkOrderIDRequiredKey            MessageKey = "error.work_order.id_required"
+	ErrWorkOrderInvalidTransitionKey     MessageKey = "error.work_order.invalid_transition"
+	ErrWorkOrderClosedKey                MessageKey = "error.work_order.closed"
+	ErrWorkOrderIssueAssetMismatchKey    MessageKey = "error.work_order.issue_asset_mismatch"
+	ErrWorkOrderAssigneeNotFoundKey      MessageKey = "error.work_order.assignee_not_found"
+	ErrWorkOrderScheduleAssetMismatchKey MessageKey = "error.work_order.schedule_asset_mismatch"
+
+	// * Stock item-specific error keys
+	ErrStockItemNotFoundKey               MessageKey = "error.stock_item.not_found"
+	ErrStockItemIDRequiredKey             MessageKey = "error.stock_item.id_required"
+	ErrStockItemSKUExistsKey              MessageKey = "error.stock_item.sku_exists"
+	ErrStockItemInUseKey                  MessageKey = "error.stock_item.in_use"
+	ErrStockItemInactiveKey               MessageKey = "error.stock_item.inactive"
+	ErrStockInsufficientKey               MessageKey = "error.stock_item.insufficient"
+	ErrStockTransactionLocationInvalidKey MessageKey = "error.stock_item.transaction_location_invalid"
+	ErrStockTransactionSameLocationKey    MessageKey = "error.stock_item.transaction_same_location"
+	ErrStockUsageBulkNotSupportedKey      MessageKey = "error.stock_item.bulk_usage_not_supported"
+
+	// * Role-specific error keys
+	ErrRoleNotFoundKey          MessageKey = "error.role.not_found"
+	ErrRoleIDRequiredKey        MessageKey = "error.role.id_required"
+	ErrRoleNameExistsKey        MessageKey = "error.role.name_exists"
+	ErrRoleSystemProtectedKey   MessageKey = "error.role.system_protected"
+	ErrRoleAdminImmutableKey    MessageKey = "error.role.admin_immutable"
+	ErrRolePermissionInvalidKey MessageKey = "error.role.permission_invalid"
 
 	// * Auth-specific error keys
 	ErrInvalidCredentialsKey MessageKey = "error.auth.invalid_credentials"
@@ -107,6 +171,99 @@ const (
 	ErrResetCodeExpiredKey   MessageKey = "error.auth.reset_code_expired"
 	ErrEmailSendFailedKey    MessageKey = "error.auth.email_send_failed"
 	ErrResetCodeNotFoundKey  MessageKey = "error.auth.reset_code_not_found"
+	ErrSessionNotFoundKey    MessageKey = "error.auth.session_not_found"
+	ErrSessionRevokedKey     MessageKey = "error.auth.session_revoked"
+	ErrSessionIDRequiredKey  MessageKey = "error.auth.session_id_required"
+	ErrRefreshTokenReusedKey MessageKey = "error.auth.refresh_token_reused"
+
+	ErrResetCodeAttemptsExceededKey MessageKey = "error.auth.reset_code_attempts_exceeded"
+	ErrResetCodeTooManyRequestsKey  MessageKey = "error.auth.reset_code_too_many_requests"
+	ErrRateLimitExceededKey         MessageKey = "error.auth.rate_limit_exceeded"
+	ErrAccountLockedKey             MessageKey = "error.auth.account_locked"
+
+	// * Two-factor authentication error keys
+	ErrTwoFactorNotEnabledKey       MessageKey = "error.two_factor.not_enabled"
+	ErrTwoFactorAlreadyEnabledKey   MessageKey = "error.two_factor.already_enabled"
+	ErrTwoFactorSetupNotStartedKey  MessageKey = "error.two_factor.setup_not_started"
+	ErrTwoFactorCodeInvalidKey      MessageKey = "error.two_factor.code_invalid"
+	ErrTwoFactorLockedKey           MessageKey = "error.two_factor.locked"
+	ErrTwoFactorChallengeInvalidKey MessageKey = "error.two_factor.challenge_invalid"
+	ErrTwoFactorRequiredKey         MessageKey = "error.two_factor.required"
+
+	// * API key error keys
+	ErrAPIKeyIDRequiredKey    MessageKey = "error.api_key.id_required"
+	ErrAPIKeyNameExistsKey    MessageKey = "error.api_key.name_exists"
+	ErrAPIKeyExpiredKey       MessageKey = "error.api_key.expired"
+	ErrAPIKeyRevokedKey       MessageKey = "error.api_key.revoked"
+	ErrAPIKeyExpiryInvalidKey MessageKey = "error.api_key.expiry_invalid"
+	ErrAPIKeyScopeDeniedKey   MessageKey = "error.api_key.scope_denied"
+
+	// * OIDC single sign-on error keys
+	ErrOIDCDisabledKey           MessageKey = "error.oidc.disabled"
+	ErrOIDCStateInvalidKey       MessageKey = "error.oidc.state_invalid"
+	ErrOIDCLoginFailedKey        MessageKey = "error.oidc.login_failed"
+	ErrOIDCEmailUnverifiedKey    MessageKey = "error.oidc.email_unverified"
+	ErrOIDCIdentityConflictKey   MessageKey = "error.oidc.identity_conflict"
+	ErrOIDCUserNotProvisionedKey MessageKey = "error.oidc.user_not_provisioned"
+
+	// * Directory sync error keys
+	ErrDirectorySyncDisabledKey       MessageKey = "error.directory_sync.disabled"
+	ErrDirectorySyncAlreadyRunningKey MessageKey = "error.directory_sync.already_running"
+	ErrDirectorySyncFailedKey         MessageKey = "error.directory_sync.failed"
+	ErrDirectorySyncEmptyDirectoryKey MessageKey = "error.directory_sync.empty_directory"
+	ErrDirectorySyncNoRunKey          MessageKey = "error.directory_sync.no_run"
+
+	// * Webhook error keys
+	ErrWebhookEndpointIDRequiredKey MessageKey = "error.webhook.endpoint_id_required"
+	ErrWebhookDeliveryIDRequiredKey MessageKey = "error.webhook.delivery_id_required"
+	ErrWebhookNameExistsKey         MessageKey = "error.webhook.name_exists"
+	ErrWebhookURLInvalidKey         MessageKey = "error.webhook.url_invalid"
+	ErrWebhookURLPrivateKey         MessageKey = "error.webhook.url_private"
+	ErrWebhookEventTypeInvalidKey   MessageKey = "error.webhook.event_type_invalid"
+	ErrWebhookEndpointInactiveKey   MessageKey = "error.webhook.endpoint_inactive"
+	ErrWebhookDeliveryPendingKey    MessageKey = "error.webhook.delivery_pending"
+
+	// * Job error keys
+	ErrJobIDRequiredKey         MessageKey = "error.job.id_required"
+	ErrJobNotRetryableKey       MessageKey = "error.job.not_retryable"
+	ErrJobPurgeStatusInvalidKey MessageKey = "error.job.purge_status_invalid"
+
+	// * Asset document error keys
+	ErrAssetDocumentIDRequiredKey      MessageKey = "error.asset_document.id_required"
+	ErrAssetDocumentFileRequiredKey    MessageKey = "error.asset_document.file_required"
+	ErrAssetDocumentTypeInvalidKey     MessageKey = "error.asset_document.type_invalid"
+	ErrAssetDocumentExpiryInvalidKey   MessageKey = "error.asset_document.expiry_invalid"
+	ErrAssetDocumentRecordMismatchKey  MessageKey = "error.asset_document.record_mismatch"
+	ErrAssetDocumentNothingToBundleKey MessageKey = "error.asset_document.nothing_to_bundle"
+	ErrAssetDocumentBundleTooLargeKey  MessageKey = "error.asset_document.bundle_too_large"
+
+	// * Search error keys
+	ErrSearchQueryRequiredKey MessageKey = "error.search.query_required"
+	ErrSearchQueryTooShortKey MessageKey = "error.search.query_too_short"
+	ErrSearchTypeInvalidKey   MessageKey = "error.search.type_invalid"
+
+	// * Saved filter error keys
+	ErrSavedFilterIDRequiredKey         MessageKey = "error.saved_filter.id_required"
+	ErrSavedFilterNameExistsKey         MessageKey = "error.saved_filter.name_exists"
+	ErrSavedFilterEntityTypeInvalidKey  MessageKey = "error.saved_filter.entity_type_invalid"
+	ErrSavedFilterParamsInvalidKey      MessageKey = "error.saved_filter.params_invalid"
+	ErrReportScheduleCronInvalidKey     MessageKey = "error.report_schedule.cron_invalid"
+	ErrReportScheduleTooFrequentKey     MessageKey = "error.report_schedule.too_frequent"
+	ErrReportScheduleTimezoneInvalidKey MessageKey = "error.report_schedule.timezone_invalid"
+	ErrReportEmailNotConfiguredKey      MessageKey = "error.report.email_not_configured"
+	ErrReportRunIDRequiredKey           MessageKey = "error.report_run.id_required"
+	ErrReportRunFormatRequiredKey       MessageKey = "error.report_run.format_required"
+	ErrReportRunFileNotFoundKey         MessageKey = "error.report_run.file_not_found"
+
+	// * Export job error keys
+	ErrExportJobIDRequiredKey          MessageKey = "error.export_job.id_required"
+	ErrExportJobEntityTypeInvalidKey   MessageKey = "error.export_job.entity_type_invalid"
+	ErrExportJobParamsInvalidKey       MessageKey = "error.export_job.params_invalid"
+	ErrExportJobTooManyActiveKey       MessageKey = "error.export_job.too_many_active"
+	ErrExportJobRunningKey             MessageKey = "error.export_job.running"
+	ErrExportJobFileNotAvailableKey    MessageKey = "error.export_job.file_not_available"
+	ErrExportJobDownloadLinkInvalidKey MessageKey = "error.export_job.download_link_invalid"
+	ErrExportJobDownloadLinkExpiredKey MessageKey = "error.export_job.download_link_expired"
 
 	// * File upload error keys
 	ErrFileRequiredKey       MessageKey = "error.file.required"
@@ -115,7 +272,7 @@ const (
 	ErrTooManyFilesKey       MessageKey = "error.file.too_many_files"
 	ErrFileUploadFailedKey   MessageKey = "error.file.upload_failed"
 	ErrFileDeleteFailedKey   MessageKey = "error.file.delete_failed"
-	ErrCloudinaryConfigKey   MessageKey = "error.file.cloudinary_config"
+	ErrFileStorageConfigKey  MessageKey = "error.file.storage_config"
 )
 
 // * Success message keys
@@ -132,6 +289,7 @@ const (
 	SuccessUserCreatedKey               MessageKey = "success.user.created"
 	SuccessUserUpdatedKey               MessageKey = "success.user.updated"
 	SuccessUserDeletedKey               MessageKey = "success.user.deleted"
+	SuccessUserUnlockedKey              MessageKey = "success.user.unlocked"
 	SuccessUsersBulkCreatedKey          MessageKey = "success.users.bulk_created"
 	SuccessUsersBulkDeletedKey          MessageKey = "success.users.bulk_deleted"
 	SuccessUserRetrievedKey             MessageKey = "success.user.retrieved"
@@ -169,6 +327,8 @@ const (
 	SuccessLocationStatisticsRetrievedKey  MessageKey = "success.location.statistics_retrieved"
 	SuccessLocationExistenceCheckedKey     MessageKey = "success.location.existence_checked"
 	SuccessLocationCodeExistenceCheckedKey MessageKey = "success.location.code_existence_checked"
+	SuccessUserLocationsRetrievedKey       MessageKey = "success.location.user_locations_retrieved"
+	SuccessUserLocationsAssignedKey        MessageKey = "success.location.user_locations_assigned"
 
 	// * Asset-specific success keys
 	SuccessAssetCreatedKey                      MessageKey = "success.asset.created"
@@ -193,6 +353,7 @@ const (
 	SuccessBulkDataMatrixDeletedKey             MessageKey = "success.asset.bulk_datamatrix_deleted"
 	SuccessBulkAssetImagesUploadedKey           MessageKey = "success.asset.bulk_images_uploaded"
 	SuccessBulkAssetImagesDeletedKey            MessageKey = "success.asset.bulk_images_deleted"
+	SuccessAssetDepreciationRetrievedKey        MessageKey = "success.asset.depreciation_retrieved"
 
 	// * Scan log-specific success keys
 	SuccessScanLogCreatedKey             MessageKey = "success.scan_log.created"
@@ -216,6 +377,8 @@ const (
 	SuccessNotificationExistenceCheckedKey    MessageKey = "success.notification.existence_checked"
 	SuccessNotificationMarkedAsReadKey        MessageKey = "success.notification.marked_as_read"
 	SuccessNotificationMarkedAsUnreadKey      MessageKey = "success.notification.marked_as_unread"
+	SuccessNotificationPreferencesRetrievedKey MessageKey = "success.notification.preferences_retrieved"
+	SuccessNotificationPreferencesUpdatedKey   MessageKey = "success.notification.preferences_updated"
 
 	// * Issue report-specific success keys
 	SuccessIssueReportCreatedKey             MessageKey = "success.issue_report.created"
@@ -241,6 +404,23 @@ const (
 	SuccessAssetMovementStatisticsRetrievedKey MessageKey = "success.asset_movement.statistics_retrieved"
 	SuccessAssetMovementExistenceCheckedKey    MessageKey = "success.asset_movement.existence_checked"
 
+	// * Asset loan-specific success keys
+	SuccessAssetCheckedOutKey    MessageKey = "success.asset_loan.checked_out"
+	SuccessAssetCheckedInKey     MessageKey = "success.asset_loan.checked_in"
+	SuccessAssetLoanRetrievedKey MessageKey = "success.asset_loan.retrieved"
+	SuccessAssetLoanCountedKey   MessageKey = "success.asset_loan.counted"
+
+	// * Audit log-specific success keys
+	SuccessAuditLogRetrievedKey MessageKey = "success.audit_log.retrieved"
+	SuccessAuditLogCountedKey   MessageKey = "success.audit_log.counted"
+
+	// * Audit session-specific success keys
+	SuccessAuditSessionCreatedKey         MessageKey = "success.audit_session.created"
+	SuccessAuditSessionClosedKey          MessageKey = "success.audit_session.closed"
+	SuccessAuditSessionRetrievedKey       MessageKey = "success.audit_session.retrieved"
+	SuccessAuditSessionCountedKey         MessageKey = "success.audit_session.counted"
+	SuccessAuditSessionReportRetrievedKey MessageKey = "success.audit_session.report_retrieved"
+
 	// * Maintenance-specific success keys
 	SuccessMaintenanceScheduleCreatedKey             MessageKey = "success.maintenance.schedule_created"
 	SuccessMaintenanceScheduleUpdatedKey             MessageKey = "success.maintenance.schedule_updated"
@@ -258,6 +438,38 @@ const (
 	SuccessMaintenanceRecordRetrievedKey             MessageKey = "success.maintenance.record_retrieved"
 	SuccessMaintenanceRecordCountedKey               MessageKey = "success.maintenance.record_counted"
 	SuccessMaintenanceRecordStatisticsRetrievedKey   MessageKey = "success.maintenance.record_statistics_retrieved"
+	SuccessMaintenanceJobRunKey                      MessageKey = "success.maintenance.job_run"
+
+	// * Work order-specific success keys
+	SuccessWorkOrderCreatedKey             MessageKey = "success.work_order.created"
+	SuccessWorkOrderUpdatedKey             MessageKey = "success.work_order.updated"
+	SuccessWorkOrderDeletedKey             MessageKey = "success.work_order.deleted"
+	SuccessWorkOrderAssignedKey            MessageKey = "success.work_order.assigned"
+	SuccessWorkOrderStatusUpdatedKey       MessageKey = "success.work_order.status_updated"
+	SuccessWorkOrderCompletedKey           MessageKey = "success.work_order.completed"
+	SuccessWorkOrderRetrievedKey           MessageKey = "success.work_order.retrieved"
+	SuccessWorkOrderCountedKey             MessageKey = "success.work_order.counted"
+	SuccessWorkOrderStatisticsRetrievedKey MessageKey = "success.work_order.statistics_retrieved"
+
+	// * Stock item-specific success keys
+	SuccessStockItemCreatedKey           MessageKey = "success.stock_item.created"
+	SuccessStockItemUpdatedKey           MessageKey = "success.stock_item.updated"
+	SuccessStockItemDeletedKey           MessageKey = "success.stock_item.deleted"
+	SuccessStockItemRetrievedKey         MessageKey = "success.stock_item.retrieved"
+	SuccessStockItemCountedKey           MessageKey = "success.stock_item.counted"
+	SuccessStockItemLowStockRetrievedKey MessageKey = "success.stock_item.low_stock_retrieved"
+	SuccessStockTransactionCreatedKey    MessageKey = "success.stock_transaction.created"
+	SuccessStockTransactionRetrievedKey  MessageKey = "success.stock_transaction.retrieved"
+	SuccessStockTransactionCountedKey    MessageKey = "success.stock_transaction.counted"
+
+	// * Role-specific success keys
+	SuccessRoleCreatedKey          MessageKey = "success.role.created"
+	SuccessRoleUpdatedKey          MessageKey = "success.role.updated"
+	SuccessRoleDeletedKey          MessageKey = "success.role.deleted"
+	SuccessRoleRetrievedKey        MessageKey = "success.role.retrieved"
+	SuccessPermissionsRetrievedKey MessageKey = "success.role.permissions_retrieved"
+	SuccessUserRolesRetrievedKey   MessageKey = "success.role.user_roles_retrieved"
+	SuccessUserRolesAssignedKey    MessageKey = "success.role.user_roles_assigned"
 
 	// * Auth-specific success keys
 	SuccessLoginKey             MessageKey = "success.auth.login"
@@ -267,6 +479,79 @@ const (
 	SuccessResetCodeSentKey     MessageKey = "success.auth.reset_code_sent"
 	SuccessResetCodeVerifiedKey MessageKey = "success.auth.reset_code_verified"
 	SuccessPasswordResetKey     MessageKey = "success.auth.password_reset"
+	SuccessLogoutAllKey         MessageKey = "success.auth.logout_all"
+	SuccessSessionsRetrievedKey MessageKey = "success.auth.sessions_retrieved"
+	SuccessSessionRevokedKey    MessageKey = "success.auth.session_revoked"
+	SuccessSessionsRevokedKey   MessageKey = "success.auth.sessions_revoked"
+
+	// * Two-factor authentication success keys
+	SuccessTwoFactorChallengeIssuedKey          MessageKey = "success.two_factor.challenge_issued"
+	SuccessTwoFactorStatusRetrievedKey          MessageKey = "success.two_factor.status_retrieved"
+	SuccessTwoFactorSetupStartedKey             MessageKey = "success.two_factor.setup_started"
+	SuccessTwoFactorEnabledKey                  MessageKey = "success.two_factor.enabled"
+	SuccessTwoFactorDisabledKey                 MessageKey = "success.two_factor.disabled"
+	SuccessTwoFactorRecoveryCodesRegeneratedKey MessageKey = "success.two_factor.recovery_codes_regenerated"
+	SuccessTwoFactorResetKey                    MessageKey = "success.two_factor.reset"
+
+	// * API key success keys
+	SuccessAPIKeyCreatedKey   MessageKey = "success.api_key.created"
+	SuccessAPIKeyUpdatedKey   MessageKey = "success.api_key.updated"
+	SuccessAPIKeyRotatedKey   MessageKey = "success.api_key.rotated"
+	SuccessAPIKeyRevokedKey   MessageKey = "success.api_key.revoked"
+	SuccessAPIKeyRetrievedKey MessageKey = "success.api_key.retrieved"
+
+	// * OIDC single sign-on success keys
+	SuccessOIDCAuthorizationCreatedKey MessageKey = "success.oidc.authorization_created"
+
+	// * Directory sync success keys
+	SuccessDirectorySyncRunKey       MessageKey = "success.directory_sync.run"
+	SuccessDirectorySyncDryRunKey    MessageKey = "success.directory_sync.dry_run"
+	SuccessDirectorySyncRetrievedKey MessageKey = "success.directory_sync.retrieved"
+
+	// * Webhook success keys
+	SuccessWebhookEndpointCreatedKey     MessageKey = "success.webhook.endpoint_created"
+	SuccessWebhookEndpointUpdatedKey     MessageKey = "success.webhook.endpoint_updated"
+	SuccessWebhookEndpointDeletedKey     MessageKey = "success.webhook.endpoint_deleted"
+	SuccessWebhookEndpointRetrievedKey   MessageKey = "success.webhook.endpoint_retrieved"
+	SuccessWebhookSecretRotatedKey       MessageKey = "success.webhook.secret_rotated"
+	SuccessWebhookPingQueuedKey          MessageKey = "success.webhook.ping_queued"
+	SuccessWebhookDeliveryRetrievedKey   MessageKey = "success.webhook.delivery_retrieved"
+	SuccessWebhookRedeliveryQueuedKey    MessageKey = "success.webhook.redelivery_queued"
+	SuccessWebhookEventTypesRetrievedKey MessageKey = "success.webhook.event_types_retrieved"
+
+	// * Job success keys
+	SuccessJobRetrievedKey           MessageKey = "success.job.retrieved"
+	SuccessJobStatisticsRetrievedKey MessageKey = "success.job.statistics_retrieved"
+	SuccessJobRetriedKey             MessageKey = "success.job.retried"
+	SuccessJobsRetriedKey            MessageKey = "success.job.bulk_retried"
+	SuccessJobsPurgedKey             MessageKey = "success.job.purged"
+
+	// * Asset document success keys
+	SuccessAssetDocumentUploadedKey   MessageKey = "success.asset_document.uploaded"
+	SuccessAssetDocumentRetrievedKey  MessageKey = "success.asset_document.retrieved"
+	SuccessAssetDocumentsRetrievedKey MessageKey = "success.asset_document.list_retrieved"
+	SuccessAssetDocumentDeletedKey    MessageKey = "success.asset_document.deleted"
+
+	// * Search success keys
+	SuccessSearchCompletedKey MessageKey = "success.search.completed"
+
+	// * Saved filter success keys
+	SuccessSavedFilterCreatedKey    MessageKey = "success.saved_filter.created"
+	SuccessSavedFilterUpdatedKey    MessageKey = "success.saved_filter.updated"
+	SuccessSavedFilterDeletedKey    MessageKey = "success.saved_filter.deleted"
+	SuccessSavedFilterRetrievedKey  MessageKey = "success.saved_filter.retrieved"
+	SuccessSavedFiltersRetrievedKey MessageKey = "success.saved_filter.list_retrieved"
+	SuccessReportScheduleSavedKey   MessageKey = "success.report_schedule.saved"
+	SuccessReportScheduleDeletedKey MessageKey = "success.report_schedule.deleted"
+	SuccessReportRunQueuedKey       MessageKey = "success.report_run.queued"
+	SuccessReportRunRetrievedKey    MessageKey = "success.report_run.retrieved"
+	SuccessReportRunsRetrievedKey   MessageKey = "success.report_run.list_retrieved"
+
+	// * Export job success keys
+	SuccessExportJobQueuedKey     MessageKey = "success.export_job.queued"
+	SuccessExportJobRetrievedKey  MessageKey = "success.export_job.retrieved"
+	SuccessExportJobsRetrievedKey MessageKey = "success.export_job.list_retrieved"
+	SuccessExportJobDeletedKey    MessageKey = "success.export_job.deleted"
 
 	// * File upload success keys
 	SuccessFileUploadedKey          MessageKey = "success.file.uploaded"
@@ -295,6 +580,14 @@ const (
 	PDFAssetStatisticsReportKey MessageKey = "pdf.asset_statistics_report"
 	PDFAssetDataMatrixReportKey MessageKey = "pdf.asset_datamatrix_report"
 
+	// * Asset Depreciation PDF Export labels
+	PDFAssetDepreciationReportKey      MessageKey = "pdf.asset_depreciation_report"
+	PDFAssetDepreciationAsOfKey        MessageKey = "pdf.as_of_date"
+	PDFAssetDepreciationMethodKey      MessageKey = "pdf.depreciation_method"
+	PDFAssetUsefulLifeKey              MessageKey = "pdf.useful_life"
+	PDFAssetAccumulatedDepreciationKey MessageKey = "pdf.accumulated_depreciation"
+	PDFAssetBookValueKey               MessageKey = "pdf.book_value"
+
 	// * Asset Movement PDF Export labels
 	PDFAssetMovementReportKey       MessageKey = "pdf.asset_movement_report"
 	PDFAssetMovementTotalKey        MessageKey = "pdf.total_movements"
@@ -454,6 +747,36 @@ const (
 	PDFAssetMovementTotalMovementsKey MessageKey = "pdf.asset_movement.total_movements"
 	PDFAssetMovementPageKey           MessageKey = "pdf.page"
 	PDFAssetMovementOfKey             MessageKey = "pdf.of"
+
+	// * Audit Session Reconciliation PDF Export labels
+	PDFAuditSessionReportKey           MessageKey = "pdf.audit_session_report"
+	PDFAuditSessionStartedAtKey        MessageKey = "pdf.audit_session.started_at"
+	PDFAuditSessionClosedAtKey         MessageKey = "pdf.audit_session.closed_at"
+	PDFAuditSessionExpectedKey         MessageKey = "pdf.audit_session.expected"
+	PDFAuditSessionScannedKey          MessageKey = "pdf.audit_session.scanned"
+	PDFAuditSessionFoundKey            MessageKey = "pdf.audit_session.found"
+	PDFAuditSessionMissingKey          MessageKey = "pdf.audit_session.missing"
+	PDFAuditSessionUnexpectedKey       MessageKey = "pdf.audit_session.unexpected"
+	PDFAuditSessionWrongLocationKey    MessageKey = "pdf.audit_session.wrong_location"
+	PDFAuditSessionAccuracyKey         MessageKey = "pdf.audit_session.accuracy"
+	PDFAuditSessionResultKey           MessageKey = "pdf.audit_session.result"
+	PDFAuditSessionExpectedLocationKey MessageKey = "pdf.audit_session.expected_location"
+	PDFAuditSessionActualLocationKey   MessageKey = "pdf.audit_session.actual_location"
+	PDFAuditSessionScannedAtKey        MessageKey = "pdf.audit_session.scanned_at"
+	PDFAuditSessionActionKey           MessageKey = "pdf.audit_session.action"
+	PDFAuditSessionCorrectedKey        MessageKey = "pdf.audit_session.corrected"
+	PDFAuditSessionMarkedLostKey       MessageKey = "pdf.audit_session.marked_lost"
+
+	// * Work Order PDF Export labels
+	PDFWorkOrderListReportKey  MessageKey = "pdf.work_order_list_report"
+	PDFWorkOrderTitleKey       MessageKey = "pdf.work_order.title"
+	PDFWorkOrderStatusKey      MessageKey = "pdf.work_order.status"
+	PDFWorkOrderPriorityKey    MessageKey = "pdf.work_order.priority"
+	PDFWorkOrderDueDateKey     MessageKey = "pdf.work_order.due_date"
+	PDFWorkOrderAssignedToKey  MessageKey = "pdf.work_order.assigned_to"
+	PDFWorkOrderCompletedAtKey MessageKey = "pdf.work_order.completed_at"
+	PDFWorkOrderPartsCostKey   MessageKey = "pdf.work_order.parts_cost"
+	PDFWorkOrderTotalKey       MessageKey = "pdf.total_work_orders"
 )
 
 // * messageTranslations contains all message translations
@@ -585,6 +908,11 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Nama lokasi diperlukan",
 		"ja-JP": "ロケーション名が必要です",
 	},
+	ErrLocationOutOfScopeKey: {
+		"en-US": "You do not have access to this location",
+		"id-ID": "Anda tidak memiliki akses ke lokasi ini",
+		"ja-JP": "このロケーションへのアクセス権がありません",
+	},
 
 	// * Asset-specific error messages
 	ErrAssetNotFoundKey: {
@@ -638,6 +966,55 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"ja-JP": "カテゴリを変更する際はデータマトリックス画像を提供する必要があります",
 	},
 
+	// * Custom attribute error messages
+	ErrCategoryAttributeKeyInvalidKey: {
+		"en-US": "Attribute key \"{0}\" is invalid, it must start with a letter and contain only letters, numbers and underscores",
+		"id-ID": "Kunci atribut \"{0}\" tidak valid, harus diawali huruf dan hanya berisi huruf, angka, dan garis bawah",
+		"ja-JP": "属性キー \"{0}\" は無効です。英字で始まり、英数字とアンダースコアのみを使用してください",
+	},
+	ErrCategoryAttributeDuplicateKey: {
+		"en-US": "Attribute key \"{0}\" is defined more than once",
+		"id-ID": "Kunci atribut \"{0}\" didefinisikan lebih dari sekali",
+		"ja-JP": "属性キー \"{0}\" が重複して定義されています",
+	},
+	ErrCategoryAttributeOptionsRequiredKey: {
+		"en-US": "Enum attribute \"{0}\" must define at least one option",
+		"id-ID": "Atribut enum \"{0}\" harus memiliki minimal satu opsi",
+		"ja-JP": "列挙型属性 \"{0}\" には少なくとも1つの選択肢が必要です",
+	},
+	ErrCategoryAttributeRuleInvalidKey: {
+		"en-US": "Validation rules of attribute \"{0}\" do not match its type",
+		"id-ID": "Aturan validasi atribut \"{0}\" tidak sesuai dengan tipenya",
+		"ja-JP": "属性 \"{0}\" の検証ルールが型と一致しません",
+	},
+	ErrAssetAttributeUnknownKey: {
+		"en-US": "Attribute \"{0}\" is not defined for the asset category",
+		"id-ID": "Atribut \"{0}\" tidak didefinisikan untuk kategori aset",
+		"ja-JP": "属性 \"{0}\" は資産カテゴリに定義されていません",
+	},
+	ErrAssetAttributeRequiredKey: {
+		"en-US": "Attribute \"{0}\" is required",
+		"id-ID": "Atribut \"{0}\" wajib diisi",
+		"ja-JP": "属性 \"{0}\" は必須です",
+	},
+	ErrAssetAttributeInvalidKey: {
+		"en-US": "Attribute \"{0}\" has an invalid value",
+		"id-ID": "Atribut \"{0}\" memiliki nilai yang tidak valid",
+		"ja-JP": "属性 \"{0}\" の値が無効です",
+	},
+	ErrAssetAttributeOutOfRangeKey: {
+		"en-US": "Attribute \"{0}\" is outside the allowed range",
+		"id-ID": "Atribut \"{0}\" berada di luar rentang yang diizinkan",
+		"ja-JP": "属性 \"{0}\" が許容範囲外です",
+	},
+
+	// * Depreciation error messages
+	ErrAssetDepreciationAsOfInvalidKey: {
+		"en-US": "As-of date \"{0}\" must use the YYYY-MM-DD format",
+		"id-ID": "Tanggal acuan \"{0}\" harus berformat YYYY-MM-DD",
+		"ja-JP": "基準日 \"{0}\" は YYYY-MM-DD 形式で指定してください",
+	},
+
 	// * Scan log-specific error messages
 	ErrScanLogNotFoundKey: {
 		"en-US": "Scan log not found",
@@ -696,6 +1073,386 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Kode reset tidak ditemukan",
 		"ja-JP": "リセットコードが見つかりません",
 	},
+	ErrSessionNotFoundKey: {
+		"en-US": "Session not found",
+		"id-ID": "Sesi tidak ditemukan",
+		"ja-JP": "セッションが見つかりません",
+	},
+	ErrSessionRevokedKey: {
+		"en-US": "Session has been revoked or expired, please log in again",
+		"id-ID": "Sesi telah dicabut atau kedaluwarsa, silakan login kembali",
+		"ja-JP": "セッションは取り消されたか期限切れです。再度ログインしてください",
+	},
+	ErrSessionIDRequiredKey: {
+		"en-US": "Session ID is required",
+		"id-ID": "ID sesi diperlukan",
+		"ja-JP": "セッションIDが必要です",
+	},
+	ErrRefreshTokenReusedKey: {
+		"en-US": "Refresh token has already been used, the session has been revoked",
+		"id-ID": "Refresh token sudah pernah digunakan, sesi telah dicabut",
+		"ja-JP": "リフレッシュトークンは既に使用されています。セッションは取り消されました",
+	},
+	ErrResetCodeAttemptsExceededKey: {
+		"en-US": "Too many wrong attempts, please request a new reset code",
+		"id-ID": "Terlalu banyak percobaan yang salah, silakan minta kode reset baru",
+		"ja-JP": "誤った試行が多すぎます。新しいリセットコードをリクエストしてください",
+	},
+	ErrResetCodeTooManyRequestsKey: {
+		"en-US": "Too many reset code requests, please try again later",
+		"id-ID": "Terlalu banyak permintaan kode reset, silakan coba lagi nanti",
+		"ja-JP": "リセットコードのリクエストが多すぎます。しばらくしてから再試行してください",
+	},
+	ErrRateLimitExceededKey: {
+		"en-US": "Too many requests, please slow down and try again later",
+		"id-ID": "Terlalu banyak permintaan, silakan coba lagi nanti",
+		"ja-JP": "リクエストが多すぎます。しばらくしてから再試行してください",
+	},
+	ErrAccountLockedKey: {
+		"en-US": "Account is temporarily locked due to too many failed login attempts, please try again later",
+		"id-ID": "Akun dikunci sementara karena terlalu banyak percobaan login yang gagal, silakan coba lagi nanti",
+		"ja-JP": "ログイン失敗が多すぎるため、アカウントは一時的にロックされています。しばらくしてから再試行してください",
+	},
+
+	// * Two-factor authentication error messages
+	ErrTwoFactorNotEnabledKey: {
+		"en-US": "Two-factor authentication is not enabled",
+		"id-ID": "Autentikasi dua faktor belum diaktifkan",
+		"ja-JP": "二要素認証が有効になっていません",
+	},
+	ErrTwoFactorAlreadyEnabledKey: {
+		"en-US": "Two-factor authentication is already enabled",
+		"id-ID": "Autentikasi dua faktor sudah diaktifkan",
+		"ja-JP": "二要素認証は既に有効です",
+	},
+	ErrTwoFactorSetupNotStartedKey: {
+		"en-US": "Two-factor setup has not been started",
+		"id-ID": "Pengaturan autentikasi dua faktor belum dimulai",
+		"ja-JP": "二要素認証の設定が開始されていません",
+	},
+	ErrTwoFactorCodeInvalidKey: {
+		"en-US": "Invalid authentication code",
+		"id-ID": "Kode autentikasi tidak valid",
+		"ja-JP": "認証コードが無効です",
+	},
+	ErrTwoFactorLockedKey: {
+		"en-US": "Too many wrong authentication codes, please try again later",
+		"id-ID": "Terlalu banyak kode autentikasi yang salah, silakan coba lagi nanti",
+		"ja-JP": "誤った認証コードが多すぎます。しばらくしてから再試行してください",
+	},
+	ErrTwoFactorChallengeInvalidKey: {
+		"en-US": "Login challenge is invalid or expired, please log in again",
+		"id-ID": "Challenge login tidak valid atau kedaluwarsa, silakan login kembali",
+		"ja-JP": "ログインチャレンジが無効または期限切れです。再度ログインしてください",
+	},
+	ErrTwoFactorRequiredKey: {
+		"en-US": "Two-factor authentication is required for this account",
+		"id-ID": "Autentikasi dua faktor wajib untuk akun ini",
+		"ja-JP": "このアカウントには二要素認証が必要です",
+	},
+
+	// * API key error messages
+	ErrAPIKeyIDRequiredKey: {
+		"en-US": "API key ID is required",
+		"id-ID": "ID API key diperlukan",
+		"ja-JP": "APIキーIDが必要です",
+	},
+	ErrAPIKeyNameExistsKey: {
+		"en-US": "API key name already exists",
+		"id-ID": "Nama API key sudah ada",
+		"ja-JP": "APIキー名は既に存在します",
+	},
+	ErrAPIKeyExpiredKey: {
+		"en-US": "API key has expired",
+		"id-ID": "API key sudah kedaluwarsa",
+		"ja-JP": "APIキーの有効期限が切れています",
+	},
+	ErrAPIKeyRevokedKey: {
+		"en-US": "API key has been revoked",
+		"id-ID": "API key sudah dicabut",
+		"ja-JP": "APIキーは取り消されています",
+	},
+	ErrAPIKeyExpiryInvalidKey: {
+		"en-US": "API key expiry must be in the future",
+		"id-ID": "Masa berlaku API key harus di masa depan",
+		"ja-JP": "APIキーの有効期限は未来の日時である必要があります",
+	},
+	ErrAPIKeyScopeDeniedKey: {
+		"en-US": "API key is not allowed to perform this request",
+		"id-ID": "API key tidak diizinkan untuk request ini",
+		"ja-JP": "このAPIキーではこのリクエストを実行できません",
+	},
+
+	// * OIDC single sign-on error messages
+	ErrOIDCDisabledKey: {
+		"en-US": "Single sign-on is not enabled",
+		"id-ID": "Single sign-on tidak diaktifkan",
+		"ja-JP": "シングルサインオンは有効になっていません",
+	},
+	ErrOIDCStateInvalidKey: {
+		"en-US": "Single sign-on request is invalid or expired, please start again",
+		"id-ID": "Permintaan single sign-on tidak valid atau kedaluwarsa, silakan mulai lagi",
+		"ja-JP": "シングルサインオンのリクエストが無効または期限切れです。もう一度やり直してください",
+	},
+	ErrOIDCLoginFailedKey: {
+		"en-US": "Single sign-on login failed",
+		"id-ID": "Login single sign-on gagal",
+		"ja-JP": "シングルサインオンでのログインに失敗しました",
+	},
+	ErrOIDCEmailUnverifiedKey: {
+		"en-US": "Identity provider did not return a verified email address",
+		"id-ID": "Identity provider tidak mengirim alamat email yang terverifikasi",
+		"ja-JP": "IDプロバイダーから確認済みのメールアドレスが返されませんでした",
+	},
+	ErrOIDCIdentityConflictKey: {
+		"en-US": "This account is already linked to another single sign-on identity",
+		"id-ID": "Akun ini sudah terhubung ke identitas single sign-on lain",
+		"ja-JP": "このアカウントは既に別のシングルサインオンIDに関連付けられています",
+	},
+	ErrOIDCUserNotProvisionedKey: {
+		"en-US": "No account exists for this identity, please contact an administrator",
+		"id-ID": "Belum ada akun untuk identitas ini, silakan hubungi administrator",
+		"ja-JP": "このIDに対応するアカウントがありません。管理者に連絡してください",
+	},
+
+	// * Directory sync error messages
+	ErrDirectorySyncDisabledKey: {
+		"en-US": "Directory synchronization is not enabled",
+		"id-ID": "Sinkronisasi direktori tidak diaktifkan",
+		"ja-JP": "ディレクトリ同期は有効になっていません",
+	},
+	ErrDirectorySyncAlreadyRunningKey: {
+		"en-US": "Directory synchronization is already running",
+		"id-ID": "Sinkronisasi direktori sedang berjalan",
+		"ja-JP": "ディレクトリ同期は既に実行中です",
+	},
+	ErrDirectorySyncFailedKey: {
+		"en-US": "Failed to read users from the directory",
+		"id-ID": "Gagal membaca user dari direktori",
+		"ja-JP": "ディレクトリからユーザーを取得できませんでした",
+	},
+	ErrDirectorySyncEmptyDirectoryKey: {
+		"en-US": "Directory returned no users, synchronization was aborted",
+		"id-ID": "Direktori tidak mengembalikan user, sinkronisasi dibatalkan",
+		"ja-JP": "ディレクトリにユーザーが存在しないため、同期を中止しました",
+	},
+	ErrDirectorySyncNoRunKey: {
+		"en-US": "Directory synchronization has not run yet",
+		"id-ID": "Sinkronisasi direktori belum pernah dijalankan",
+		"ja-JP": "ディレクトリ同期はまだ実行されていません",
+	},
+
+	// * Webhook error messages
+	ErrWebhookEndpointIDRequiredKey: {
+		"en-US": "Webhook endpoint ID is required",
+		"id-ID": "ID endpoint webhook diperlukan",
+		"ja-JP": "Webhook エンドポイントIDが必要です",
+	},
+	ErrWebhookDeliveryIDRequiredKey: {
+		"en-US": "Webhook delivery ID is required",
+		"id-ID": "ID pengiriman webhook diperlukan",
+		"ja-JP": "Webhook 配信IDが必要です",
+	},
+	ErrWebhookNameExistsKey: {
+		"en-US": "Webhook endpoint name already exists",
+		"id-ID": "Nama endpoint webhook sudah ada",
+		"ja-JP": "Webhook エンドポイント名は既に存在します",
+	},
+	ErrWebhookURLInvalidKey: {
+		"en-US": "Webhook URL must be an absolute http or https URL",
+		"id-ID": "URL webhook harus berupa URL http atau https yang lengkap",
+		"ja-JP": "Webhook URL は http または https の絶対URLである必要があります",
+	},
+	ErrWebhookURLPrivateKey: {
+		"en-US": "Webhook URL must not point to a private or internal address",
+		"id-ID": "URL webhook tidak boleh mengarah ke alamat private atau internal",
+		"ja-JP": "Webhook URL はプライベートまたは内部アドレスを指定できません",
+	},
+	ErrWebhookEventTypeInvalidKey: {
+		"en-US": "One or more webhook event types are not supported",
+		"id-ID": "Satu atau lebih tipe event webhook tidak didukung",
+		"ja-JP": "サポートされていない Webhook イベントタイプが含まれています",
+	},
+	ErrWebhookEndpointInactiveKey: {
+		"en-US": "Webhook endpoint is inactive",
+		"id-ID": "Endpoint webhook tidak aktif",
+		"ja-JP": "Webhook エンドポイントは無効になっています",
+	},
+	ErrWebhookDeliveryPendingKey: {
+		"en-US": "Webhook delivery is still pending and will be retried automatically",
+		"id-ID": "Pengiriman webhook masih dalam antrean dan akan dicoba ulang otomatis",
+		"ja-JP": "Webhook 配信は保留中のため、自動的に再試行されます",
+	},
+
+	// * Job error messages
+	ErrJobIDRequiredKey: {
+		"en-US": "Job ID is required",
+		"id-ID": "ID job wajib diisi",
+		"ja-JP": "ジョブIDが必要です",
+	},
+	ErrJobNotRetryableKey: {
+		"en-US": "Only pending or dead jobs can be retried",
+		"id-ID": "Hanya job pending atau dead yang bisa dicoba ulang",
+		"ja-JP": "再試行できるのは保留中またはデッドのジョブのみです",
+	},
+	ErrJobPurgeStatusInvalidKey: {
+		"en-US": "Only succeeded or dead jobs can be purged",
+		"id-ID": "Hanya job succeeded atau dead yang bisa dihapus",
+		"ja-JP": "削除できるのは成功またはデッドのジョブのみです",
+	},
+
+	// * Asset document error messages
+	ErrAssetDocumentIDRequiredKey: {
+		"en-US": "Document ID is required",
+		"id-ID": "ID dokumen wajib diisi",
+		"ja-JP": "ドキュメントIDが必要です",
+	},
+	ErrAssetDocumentFileRequiredKey: {
+		"en-US": "Document file is required",
+		"id-ID": "File dokumen wajib diunggah",
+		"ja-JP": "ドキュメントファイルが必要です",
+	},
+	ErrAssetDocumentTypeInvalidKey: {
+		"en-US": "Invalid document type",
+		"id-ID": "Tipe dokumen tidak valid",
+		"ja-JP": "無効なドキュメントタイプです",
+	},
+	ErrAssetDocumentExpiryInvalidKey: {
+		"en-US": "Expiry date must be on or after the issue date",
+		"id-ID": "Tanggal kedaluwarsa tidak boleh sebelum tanggal terbit",
+		"ja-JP": "有効期限は発行日以降である必要があります",
+	},
+	ErrAssetDocumentRecordMismatchKey: {
+		"en-US": "Maintenance record does not belong to this asset",
+		"id-ID": "Maintenance record bukan milik asset ini",
+		"ja-JP": "メンテナンス記録はこの資産に属していません",
+	},
+	ErrAssetDocumentNothingToBundleKey: {
+		"en-US": "Asset has no documents to download",
+		"id-ID": "Asset tidak memiliki dokumen untuk diunduh",
+		"ja-JP": "ダウンロードできるドキュメントがありません",
+	},
+	ErrAssetDocumentBundleTooLargeKey: {
+		"en-US": "Documents are too large to download as one ZIP, narrow the filter",
+		"id-ID": "Dokumen terlalu besar untuk diunduh dalam satu ZIP, persempit filter",
+		"ja-JP": "ドキュメントが大きすぎるため1つのZIPでダウンロードできません。フィルターを絞り込んでください",
+	},
+
+	// * Search error messages
+	ErrSearchQueryRequiredKey: {
+		"en-US": "Search query is required",
+		"id-ID": "Kata kunci pencarian wajib diisi",
+		"ja-JP": "検索キーワードが必要です",
+	},
+	ErrSearchQueryTooShortKey: {
+		"en-US": "Search query must be at least {0} characters",
+		"id-ID": "Kata kunci pencarian minimal {0} karakter",
+		"ja-JP": "検索キーワードは{0}文字以上で入力してください",
+	},
+	ErrSearchTypeInvalidKey: {
+		"en-US": "Search type \"{0}\" is invalid",
+		"id-ID": "Tipe pencarian \"{0}\" tidak valid",
+		"ja-JP": "検索タイプ \"{0}\" は無効です",
+	},
+
+	// * Saved filter error messages
+	ErrSavedFilterIDRequiredKey: {
+		"en-US": "Saved filter ID is required",
+		"id-ID": "ID filter tersimpan diperlukan",
+		"ja-JP": "保存済みフィルターIDが必要です",
+	},
+	ErrSavedFilterNameExistsKey: {
+		"en-US": "A saved filter with this name already exists for this data",
+		"id-ID": "Filter tersimpan dengan nama ini sudah ada untuk data ini",
+		"ja-JP": "このデータには同じ名前の保存済みフィルターが既に存在します",
+	},
+	ErrSavedFilterEntityTypeInvalidKey: {
+		"en-US": "Entity type \"{0}\" is invalid",
+		"id-ID": "Tipe entitas \"{0}\" tidak valid",
+		"ja-JP": "エンティティタイプ \"{0}\" は無効です",
+	},
+	ErrSavedFilterParamsInvalidKey: {
+		"en-US": "Saved filter parameters are invalid: {0}",
+		"id-ID": "Parameter filter tersimpan tidak valid: {0}",
+		"ja-JP": "保存済みフィルターのパラメータが無効です：{0}",
+	},
+	ErrReportScheduleCronInvalidKey: {
+		"en-US": "Cron expression is invalid, use the 5-field format (minute hour day month weekday)",
+		"id-ID": "Cron expression tidak valid, gunakan format 5 field (menit jam tanggal bulan hari)",
+		"ja-JP": "cron式が無効です。5フィールド形式（分 時 日 月 曜日）を使用してください",
+	},
+	ErrReportScheduleTooFrequentKey: {
+		"en-US": "Report schedule cannot run more often than once an hour",
+		"id-ID": "Jadwal laporan tidak boleh berjalan lebih sering dari sekali per jam",
+		"ja-JP": "レポートのスケジュールは1時間に1回より頻繁に実行できません",
+	},
+	ErrReportScheduleTimezoneInvalidKey: {
+		"en-US": "Timezone \"{0}\" is invalid",
+		"id-ID": "Zona waktu \"{0}\" tidak valid",
+		"ja-JP": "タイムゾーン \"{0}\" は無効です",
+	},
+	ErrReportEmailNotConfiguredKey: {
+		"en-US": "Email delivery is not configured on this server",
+		"id-ID": "Pengiriman email belum dikonfigurasi di server ini",
+		"ja-JP": "このサーバーではメール送信が設定されていません",
+	},
+	ErrReportRunIDRequiredKey: {
+		"en-US": "Report run ID is required",
+		"id-ID": "ID riwayat laporan diperlukan",
+		"ja-JP": "レポート実行IDが必要です",
+	},
+	ErrReportRunFormatRequiredKey: {
+		"en-US": "Format is required when the saved filter has no schedule",
+		"id-ID": "Format wajib diisi jika filter tersimpan belum memiliki jadwal",
+		"ja-JP": "保存済みフィルターにスケジュールがない場合は形式が必要です",
+	},
+	ErrReportRunFileNotFoundKey: {
+		"en-US": "Report run has no stored file",
+		"id-ID": "Riwayat laporan tidak memiliki file tersimpan",
+		"ja-JP": "このレポート実行には保存されたファイルがありません",
+	},
+
+	// * Export job error messages
+	ErrExportJobIDRequiredKey: {
+		"en-US": "Export job ID is required",
+		"id-ID": "ID export job wajib diisi",
+		"ja-JP": "エクスポートジョブIDは必須です",
+	},
+	ErrExportJobEntityTypeInvalidKey: {
+		"en-US": "Export entity type \"{0}\" is invalid",
+		"id-ID": "Tipe entitas export \"{0}\" tidak valid",
+		"ja-JP": "エクスポートのエンティティタイプ \"{0}\" は無効です",
+	},
+	ErrExportJobParamsInvalidKey: {
+		"en-US": "Export parameters are invalid: {0}",
+		"id-ID": "Parameter export tidak valid: {0}",
+		"ja-JP": "エクスポートのパラメータが無効です：{0}",
+	},
+	ErrExportJobTooManyActiveKey: {
+		"en-US": "You already have {0} exports in progress, please wait until one finishes",
+		"id-ID": "Anda sudah memiliki {0} export yang sedang diproses, tunggu hingga salah satunya selesai",
+		"ja-JP": "処理中のエクスポートがすでに{0}件あります。完了するまでお待ちください",
+	},
+	ErrExportJobRunningKey: {
+		"en-US": "Export job is still running and cannot be deleted",
+		"id-ID": "Export job masih diproses dan tidak bisa dihapus",
+		"ja-JP": "エクスポートジョブは処理中のため削除できません",
+	},
+	ErrExportJobFileNotAvailableKey: {
+		"en-US": "Export file is not available",
+		"id-ID": "File export tidak tersedia",
+		"ja-JP": "エクスポートファイルは利用できません",
+	},
+	ErrExportJobDownloadLinkInvalidKey: {
+		"en-US": "Download link is invalid",
+		"id-ID": "Link download tidak valid",
+		"ja-JP": "ダウンロードリンクが無効です",
+	},
+	ErrExportJobDownloadLinkExpiredKey: {
+		"en-US": "Download link has expired, please request a new one",
+		"id-ID": "Link download sudah kedaluwarsa, silakan minta link baru",
+		"ja-JP": "ダウンロードリンクの有効期限が切れました。新しいリンクを取得してください",
+	},
 
 	// * File upload error messages
 	ErrFileRequiredKey: {
@@ -728,10 +1485,10 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Hapus file gagal",
 		"ja-JP": "ファイル削除に失敗しました",
 	},
-	ErrCloudinaryConfigKey: {
-		"en-US": "Cloudinary configuration error",
-		"id-ID": "Kesalahan konfigurasi Cloudinary",
-		"ja-JP": "Cloudinary設定エラー",
+	ErrFileStorageConfigKey: {
+		"en-US": "File storage is not configured",
+		"id-ID": "Penyimpanan file belum dikonfigurasi",
+		"ja-JP": "ファイルストレージが設定されていません",
 	},
 
 	// * Success messages
@@ -782,6 +1539,11 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Pengguna berhasil dihapus",
 		"ja-JP": "ユーザーが正常に削除されました",
 	},
+	SuccessUserUnlockedKey: {
+		"en-US": "User unlocked successfully",
+		"id-ID": "Pengguna berhasil dibuka kuncinya",
+		"ja-JP": "ユーザーのロックが正常に解除されました",
+	},
 	SuccessUsersBulkCreatedKey: {
 		"en-US": "Users created successfully",
 		"id-ID": "Pengguna berhasil dibuat secara massal",
@@ -951,6 +1713,16 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Statistik lokasi berhasil diambil",
 		"ja-JP": "ロケーション統計が正常に取得されました",
 	},
+	SuccessUserLocationsRetrievedKey: {
+		"en-US": "User locations retrieved successfully",
+		"id-ID": "Lokasi pengguna berhasil diambil",
+		"ja-JP": "ユーザーのロケーションが正常に取得されました",
+	},
+	SuccessUserLocationsAssignedKey: {
+		"en-US": "User locations assigned successfully",
+		"id-ID": "Lokasi pengguna berhasil ditetapkan",
+		"ja-JP": "ユーザーのロケーションが正常に割り当てられました",
+	},
 
 	// * Asset-specific success messages
 	SuccessAssetCreatedKey: {
@@ -1063,6 +1835,11 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Gambar aset massal berhasil dihapus",
 		"ja-JP": "一括アセット画像が正常に削除されました",
 	},
+	SuccessAssetDepreciationRetrievedKey: {
+		"en-US": "Asset depreciation report retrieved successfully",
+		"id-ID": "Laporan depresiasi aset berhasil diambil",
+		"ja-JP": "資産減価償却レポートが正常に取得されました",
+	},
 
 	// * Scan log-specific success messages
 	SuccessScanLogCreatedKey: {
@@ -1142,6 +1919,291 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Kata sandi berhasil direset",
 		"ja-JP": "パスワードが正常にリセットされました",
 	},
+	SuccessLogoutAllKey: {
+		"en-US": "Logged out from all devices successfully",
+		"id-ID": "Berhasil logout dari semua perangkat",
+		"ja-JP": "すべてのデバイスから正常にログアウトしました",
+	},
+	SuccessSessionsRetrievedKey: {
+		"en-US": "Sessions retrieved successfully",
+		"id-ID": "Sesi berhasil diambil",
+		"ja-JP": "セッションが正常に取得されました",
+	},
+	SuccessSessionRevokedKey: {
+		"en-US": "Session revoked successfully",
+		"id-ID": "Sesi berhasil dicabut",
+		"ja-JP": "セッションが正常に取り消されました",
+	},
+	SuccessSessionsRevokedKey: {
+		"en-US": "Sessions revoked successfully",
+		"id-ID": "Semua sesi berhasil dicabut",
+		"ja-JP": "セッションが正常に取り消されました",
+	},
+
+	// * Two-factor authentication success messages
+	SuccessTwoFactorChallengeIssuedKey: {
+		"en-US": "Two-factor authentication required",
+		"id-ID": "Autentikasi dua faktor diperlukan",
+		"ja-JP": "二要素認証が必要です",
+	},
+	SuccessTwoFactorStatusRetrievedKey: {
+		"en-US": "Two-factor status retrieved successfully",
+		"id-ID": "Status autentikasi dua faktor berhasil diambil",
+		"ja-JP": "二要素認証の状態が正常に取得されました",
+	},
+	SuccessTwoFactorSetupStartedKey: {
+		"en-US": "Scan the QR code and confirm with a code from your authenticator app",
+		"id-ID": "Pindai kode QR dan konfirmasi dengan kode dari aplikasi autentikator",
+		"ja-JP": "QRコードをスキャンし、認証アプリのコードで確認してください",
+	},
+	SuccessTwoFactorEnabledKey: {
+		"en-US": "Two-factor authentication enabled successfully",
+		"id-ID": "Autentikasi dua faktor berhasil diaktifkan",
+		"ja-JP": "二要素認証が正常に有効化されました",
+	},
+	SuccessTwoFactorDisabledKey: {
+		"en-US": "Two-factor authentication disabled successfully",
+		"id-ID": "Autentikasi dua faktor berhasil dinonaktifkan",
+		"ja-JP": "二要素認証が正常に無効化されました",
+	},
+	SuccessTwoFactorRecoveryCodesRegeneratedKey: {
+		"en-US": "Recovery codes regenerated successfully",
+		"id-ID": "Kode pemulihan berhasil dibuat ulang",
+		"ja-JP": "リカバリーコードが正常に再生成されました",
+	},
+	SuccessTwoFactorResetKey: {
+		"en-US": "Two-factor authentication reset successfully",
+		"id-ID": "Autentikasi dua faktor berhasil direset",
+		"ja-JP": "二要素認証が正常にリセットされました",
+	},
+
+	// * API key success messages
+	SuccessAPIKeyCreatedKey: {
+		"en-US": "API key created successfully, store the key now as it will not be shown again",
+		"id-ID": "API key berhasil dibuat, simpan key sekarang karena tidak akan ditampilkan lagi",
+		"ja-JP": "APIキーが正常に作成されました。再表示されないため、今すぐキーを保存してください",
+	},
+	SuccessAPIKeyUpdatedKey: {
+		"en-US": "API key updated successfully",
+		"id-ID": "API key berhasil diperbarui",
+		"ja-JP": "APIキーが正常に更新されました",
+	},
+	SuccessAPIKeyRotatedKey: {
+		"en-US": "API key rotated successfully, the previous key no longer works",
+		"id-ID": "API key berhasil dirotasi, key sebelumnya tidak berlaku lagi",
+		"ja-JP": "APIキーが正常にローテーションされました。以前のキーは使用できません",
+	},
+	SuccessAPIKeyRevokedKey: {
+		"en-US": "API key revoked successfully",
+		"id-ID": "API key berhasil dicabut",
+		"ja-JP": "APIキーが正常に取り消されました",
+	},
+	SuccessAPIKeyRetrievedKey: {
+		"en-US": "API keys retrieved successfully",
+		"id-ID": "API key berhasil diambil",
+		"ja-JP": "APIキーが正常に取得されました",
+	},
+
+	// * OIDC single sign-on success messages
+	SuccessOIDCAuthorizationCreatedKey: {
+		"en-US": "Single sign-on started, continue at the authorization URL",
+		"id-ID": "Single sign-on dimulai, lanjutkan di URL otorisasi",
+		"ja-JP": "シングルサインオンを開始しました。認可URLで続行してください",
+	},
+
+	// * Directory sync success messages
+	SuccessDirectorySyncRunKey: {
+		"en-US": "Directory synchronization completed",
+		"id-ID": "Sinkronisasi direktori selesai",
+		"ja-JP": "ディレクトリ同期が完了しました",
+	},
+	SuccessDirectorySyncDryRunKey: {
+		"en-US": "Directory synchronization preview generated, no changes were applied",
+		"id-ID": "Pratinjau sinkronisasi direktori dibuat, tidak ada perubahan yang diterapkan",
+		"ja-JP": "ディレクトリ同期のプレビューを作成しました。変更は適用されていません",
+	},
+	SuccessDirectorySyncRetrievedKey: {
+		"en-US": "Directory synchronization result retrieved successfully",
+		"id-ID": "Hasil sinkronisasi direktori berhasil diambil",
+		"ja-JP": "ディレクトリ同期の結果を取得しました",
+	},
+
+	// * Webhook success messages
+	SuccessWebhookEndpointCreatedKey: {
+		"en-US": "Webhook endpoint created successfully, store the secret now as it will not be shown again",
+		"id-ID": "Endpoint webhook berhasil dibuat, simpan secret sekarang karena tidak akan ditampilkan lagi",
+		"ja-JP": "Webhook エンドポイントを作成しました。シークレットは再表示されないため、今すぐ保存してください",
+	},
+	SuccessWebhookEndpointUpdatedKey: {
+		"en-US": "Webhook endpoint updated successfully",
+		"id-ID": "Endpoint webhook berhasil diperbarui",
+		"ja-JP": "Webhook エンドポイントを更新しました",
+	},
+	SuccessWebhookEndpointDeletedKey: {
+		"en-US": "Webhook endpoint deleted successfully",
+		"id-ID": "Endpoint webhook berhasil dihapus",
+		"ja-JP": "Webhook エンドポイントを削除しました",
+	},
+	SuccessWebhookEndpointRetrievedKey: {
+		"en-US": "Webhook endpoints retrieved successfully",
+		"id-ID": "Endpoint webhook berhasil diambil",
+		"ja-JP": "Webhook エンドポイントを取得しました",
+	},
+	SuccessWebhookSecretRotatedKey: {
+		"en-US": "Webhook secret rotated successfully, store the new secret now as it will not be shown again",
+		"id-ID": "Secret webhook berhasil dirotasi, simpan secret baru sekarang karena tidak akan ditampilkan lagi",
+		"ja-JP": "Webhook シークレットを再発行しました。新しいシークレットは再表示されないため、今すぐ保存してください",
+	},
+	SuccessWebhookPingQueuedKey: {
+		"en-US": "Ping event queued for delivery",
+		"id-ID": "Event ping masuk antrean pengiriman",
+		"ja-JP": "Ping イベントを配信キューに追加しました",
+	},
+	SuccessWebhookDeliveryRetrievedKey: {
+		"en-US": "Webhook deliveries retrieved successfully",
+		"id-ID": "Pengiriman webhook berhasil diambil",
+		"ja-JP": "Webhook 配信履歴を取得しました",
+	},
+	SuccessWebhookRedeliveryQueuedKey: {
+		"en-US": "Webhook redelivery queued",
+		"id-ID": "Pengiriman ulang webhook masuk antrean",
+		"ja-JP": "Webhook の再配信をキューに追加しました",
+	},
+	SuccessWebhookEventTypesRetrievedKey: {
+		"en-US": "Webhook event types retrieved successfully",
+		"id-ID": "Tipe event webhook berhasil diambil",
+		"ja-JP": "Webhook イベントタイプを取得しました",
+	},
+
+	// * Job success messages
+	SuccessJobRetrievedKey: {
+		"en-US": "Jobs retrieved successfully",
+		"id-ID": "Job berhasil diambil",
+		"ja-JP": "ジョブを取得しました",
+	},
+	SuccessJobStatisticsRetrievedKey: {
+		"en-US": "Job statistics retrieved successfully",
+		"id-ID": "Statistik job berhasil diambil",
+		"ja-JP": "ジョブ統計を取得しました",
+	},
+	SuccessJobRetriedKey: {
+		"en-US": "Job queued for retry",
+		"id-ID": "Job dimasukkan kembali ke antrean",
+		"ja-JP": "ジョブを再試行キューに追加しました",
+	},
+	SuccessJobsRetriedKey: {
+		"en-US": "Dead jobs queued for retry",
+		"id-ID": "Job dead dimasukkan kembali ke antrean",
+		"ja-JP": "デッドジョブを再試行キューに追加しました",
+	},
+	SuccessJobsPurgedKey: {
+		"en-US": "Jobs purged successfully",
+		"id-ID": "Job berhasil dihapus",
+		"ja-JP": "ジョブを削除しました",
+	},
+
+	// * Asset document success messages
+	SuccessAssetDocumentUploadedKey: {
+		"en-US": "Document uploaded successfully",
+		"id-ID": "Dokumen berhasil diunggah",
+		"ja-JP": "ドキュメントをアップロードしました",
+	},
+	SuccessAssetDocumentRetrievedKey: {
+		"en-US": "Document retrieved successfully",
+		"id-ID": "Dokumen berhasil diambil",
+		"ja-JP": "ドキュメントを取得しました",
+	},
+	SuccessAssetDocumentsRetrievedKey: {
+		"en-US": "Documents retrieved successfully",
+		"id-ID": "Daftar dokumen berhasil diambil",
+		"ja-JP": "ドキュメント一覧を取得しました",
+	},
+	SuccessAssetDocumentDeletedKey: {
+		"en-US": "Document deleted successfully",
+		"id-ID": "Dokumen berhasil dihapus",
+		"ja-JP": "ドキュメントを削除しました",
+	},
+
+	// * Search success messages
+	SuccessSearchCompletedKey: {
+		"en-US": "Search completed successfully",
+		"id-ID": "Pencarian berhasil",
+		"ja-JP": "検索が完了しました",
+	},
+
+	// * Saved filter success messages
+	SuccessSavedFilterCreatedKey: {
+		"en-US": "Saved filter created successfully",
+		"id-ID": "Filter tersimpan berhasil dibuat",
+		"ja-JP": "保存済みフィルターを作成しました",
+	},
+	SuccessSavedFilterUpdatedKey: {
+		"en-US": "Saved filter updated successfully",
+		"id-ID": "Filter tersimpan berhasil diperbarui",
+		"ja-JP": "保存済みフィルターを更新しました",
+	},
+	SuccessSavedFilterDeletedKey: {
+		"en-US": "Saved filter deleted successfully",
+		"id-ID": "Filter tersimpan berhasil dihapus",
+		"ja-JP": "保存済みフィルターを削除しました",
+	},
+	SuccessSavedFilterRetrievedKey: {
+		"en-US": "Saved filter retrieved successfully",
+		"id-ID": "Filter tersimpan berhasil diambil",
+		"ja-JP": "保存済みフィルターを取得しました",
+	},
+	SuccessSavedFiltersRetrievedKey: {
+		"en-US": "Saved filters retrieved successfully",
+		"id-ID": "Daftar filter tersimpan berhasil diambil",
+		"ja-JP": "保存済みフィルター一覧を取得しました",
+	},
+	SuccessReportScheduleSavedKey: {
+		"en-US": "Report schedule saved successfully",
+		"id-ID": "Jadwal laporan berhasil disimpan",
+		"ja-JP": "レポートのスケジュールを保存しました",
+	},
+	SuccessReportScheduleDeletedKey: {
+		"en-US": "Report schedule deleted successfully",
+		"id-ID": "Jadwal laporan berhasil dihapus",
+		"ja-JP": "レポートのスケジュールを削除しました",
+	},
+	SuccessReportRunQueuedKey: {
+		"en-US": "Report queued successfully",
+		"id-ID": "Laporan berhasil dimasukkan ke antrean",
+		"ja-JP": "レポートをキューに追加しました",
+	},
+	SuccessReportRunRetrievedKey: {
+		"en-US": "Report run retrieved successfully",
+		"id-ID": "Riwayat laporan berhasil diambil",
+		"ja-JP": "レポート実行を取得しました",
+	},
+	SuccessReportRunsRetrievedKey: {
+		"en-US": "Report runs retrieved successfully",
+		"id-ID": "Daftar riwayat laporan berhasil diambil",
+		"ja-JP": "レポート実行一覧を取得しました",
+	},
+
+	// * Export job success messages
+	SuccessExportJobQueuedKey: {
+		"en-US": "Export queued successfully",
+		"id-ID": "Export berhasil dimasukkan ke antrean",
+		"ja-JP": "エクスポートをキューに追加しました",
+	},
+	SuccessExportJobRetrievedKey: {
+		"en-US": "Export job retrieved successfully",
+		"id-ID": "Export job berhasil diambil",
+		"ja-JP": "エクスポートジョブを取得しました",
+	},
+	SuccessExportJobsRetrievedKey: {
+		"en-US": "Export jobs retrieved successfully",
+		"id-ID": "Daftar export job berhasil diambil",
+		"ja-JP": "エクスポートジョブ一覧を取得しました",
+	},
+	SuccessExportJobDeletedKey: {
+		"en-US": "Export job deleted successfully",
+		"id-ID": "Export job berhasil dihapus",
+		"ja-JP": "エクスポートジョブを削除しました",
+	},
 
 	// * File upload success messages
 	SuccessFileUploadedKey: {
@@ -1262,6 +2324,172 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"ja-JP": "資産データマトリックスコード",
 	},
 
+	// * Asset Depreciation PDF Export labels
+	PDFAssetDepreciationReportKey: {
+		"en-US": "Asset Depreciation Report",
+		"id-ID": "Laporan Depresiasi Aset",
+		"ja-JP": "資産減価償却レポート",
+	},
+	PDFAssetDepreciationAsOfKey: {
+		"en-US": "As of",
+		"id-ID": "Per Tanggal",
+		"ja-JP": "基準日",
+	},
+	PDFAssetDepreciationMethodKey: {
+		"en-US": "Method",
+		"id-ID": "Metode",
+		"ja-JP": "償却方法",
+	},
+	PDFAssetUsefulLifeKey: {
+		"en-US": "Life (Yrs)",
+		"id-ID": "Umur (Thn)",
+		"ja-JP": "耐用年数",
+	},
+	PDFAssetAccumulatedDepreciationKey: {
+		"en-US": "Accum. Depreciation",
+		"id-ID": "Akum. Penyusutan",
+		"ja-JP": "減価償却累計額",
+	},
+	PDFAssetBookValueKey: {
+		"en-US": "Book Value",
+		"id-ID": "Nilai Buku",
+		"ja-JP": "帳簿価額",
+	},
+
+	// * Audit Session Reconciliation PDF Export labels
+	PDFAuditSessionReportKey: {
+		"en-US": "Stocktake Reconciliation Report",
+		"id-ID": "Laporan Rekonsiliasi Stock Opname",
+		"ja-JP": "棚卸照合レポート",
+	},
+	PDFAuditSessionStartedAtKey: {
+		"en-US": "Started",
+		"id-ID": "Dimulai",
+		"ja-JP": "開始",
+	},
+	PDFAuditSessionClosedAtKey: {
+		"en-US": "Closed",
+		"id-ID": "Ditutup",
+		"ja-JP": "終了",
+	},
+	PDFAuditSessionExpectedKey: {
+		"en-US": "Expected",
+		"id-ID": "Diharapkan",
+		"ja-JP": "予定数",
+	},
+	PDFAuditSessionScannedKey: {
+		"en-US": "Scanned",
+		"id-ID": "Dipindai",
+		"ja-JP": "スキャン数",
+	},
+	PDFAuditSessionFoundKey: {
+		"en-US": "Found",
+		"id-ID": "Ditemukan",
+		"ja-JP": "確認済み",
+	},
+	PDFAuditSessionMissingKey: {
+		"en-US": "Missing",
+		"id-ID": "Hilang",
+		"ja-JP": "不明",
+	},
+	PDFAuditSessionUnexpectedKey: {
+		"en-US": "Unexpected",
+		"id-ID": "Tidak Terduga",
+		"ja-JP": "予定外",
+	},
+	PDFAuditSessionWrongLocationKey: {
+		"en-US": "Wrong Location",
+		"id-ID": "Lokasi Salah",
+		"ja-JP": "場所違い",
+	},
+	PDFAuditSessionAccuracyKey: {
+		"en-US": "Accuracy",
+		"id-ID": "Akurasi",
+		"ja-JP": "精度",
+	},
+	PDFAuditSessionResultKey: {
+		"en-US": "Result",
+		"id-ID": "Hasil",
+		"ja-JP": "結果",
+	},
+	PDFAuditSessionExpectedLocationKey: {
+		"en-US": "Expected Location",
+		"id-ID": "Lokasi Seharusnya",
+		"ja-JP": "予定場所",
+	},
+	PDFAuditSessionActualLocationKey: {
+		"en-US": "Recorded Location",
+		"id-ID": "Lokasi Tercatat",
+		"ja-JP": "登録場所",
+	},
+	PDFAuditSessionScannedAtKey: {
+		"en-US": "Scanned At",
+		"id-ID": "Waktu Pindai",
+		"ja-JP": "スキャン日時",
+	},
+	PDFAuditSessionActionKey: {
+		"en-US": "Action",
+		"id-ID": "Tindakan",
+		"ja-JP": "対応",
+	},
+	PDFAuditSessionCorrectedKey: {
+		"en-US": "Moved",
+		"id-ID": "Dipindahkan",
+		"ja-JP": "移動済み",
+	},
+	PDFAuditSessionMarkedLostKey: {
+		"en-US": "Marked Lost",
+		"id-ID": "Ditandai Hilang",
+		"ja-JP": "紛失登録",
+	},
+
+	// * Work Order PDF Export labels
+	PDFWorkOrderListReportKey: {
+		"en-US": "Work Order Report",
+		"id-ID": "Laporan Perintah Kerja",
+		"ja-JP": "作業指示レポート",
+	},
+	PDFWorkOrderTitleKey: {
+		"en-US": "Title",
+		"id-ID": "Judul",
+		"ja-JP": "タイトル",
+	},
+	PDFWorkOrderStatusKey: {
+		"en-US": "Status",
+		"id-ID": "Status",
+		"ja-JP": "ステータス",
+	},
+	PDFWorkOrderPriorityKey: {
+		"en-US": "Priority",
+		"id-ID": "Prioritas",
+		"ja-JP": "優先度",
+	},
+	PDFWorkOrderDueDateKey: {
+		"en-US": "Due Date",
+		"id-ID": "Jatuh Tempo",
+		"ja-JP": "期限",
+	},
+	PDFWorkOrderAssignedToKey: {
+		"en-US": "Assigned To",
+		"id-ID": "Ditugaskan Kepada",
+		"ja-JP": "担当者",
+	},
+	PDFWorkOrderCompletedAtKey: {
+		"en-US": "Completed At",
+		"id-ID": "Selesai Pada",
+		"ja-JP": "完了日",
+	},
+	PDFWorkOrderPartsCostKey: {
+		"en-US": "Parts Cost",
+		"id-ID": "Biaya Suku Cadang",
+		"ja-JP": "部品費用",
+	},
+	PDFWorkOrderTotalKey: {
+		"en-US": "Total Work Orders",
+		"id-ID": "Total Perintah Kerja",
+		"ja-JP": "作業指示合計",
+	},
+
 	// * Asset Movement PDF Export labels
 	PDFAssetMovementReportKey: {
 		"en-US": "Asset Movement Report",
@@ -1940,6 +3168,11 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Pesan notifikasi diperlukan",
 		"ja-JP": "通知メッセージが必要です",
 	},
+	ErrNotificationPreferenceDuplicateTypeKey: {
+		"en-US": "Each notification type can only appear once in preferences",
+		"id-ID": "Setiap tipe notifikasi hanya boleh muncul sekali dalam preferensi",
+		"ja-JP": "各通知タイプは設定内で一度だけ指定できます",
+	},
 
 	// * Notification success messages
 	SuccessNotificationCreatedKey: {
@@ -1997,6 +3230,16 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Notifikasi berhasil ditandai sebagai belum dibaca",
 		"ja-JP": "通知が未読として正常にマークされました",
 	},
+	SuccessNotificationPreferencesRetrievedKey: {
+		"en-US": "Notification preferences retrieved successfully",
+		"id-ID": "Preferensi notifikasi berhasil diambil",
+		"ja-JP": "通知設定が正常に取得されました",
+	},
+	SuccessNotificationPreferencesUpdatedKey: {
+		"en-US": "Notification preferences updated successfully",
+		"id-ID": "Preferensi notifikasi berhasil diperbarui",
+		"ja-JP": "通知設定が正常に更新されました",
+	},
 
 	// * Issue report error messages
 	ErrIssueReportNotFoundKey: {
@@ -2181,6 +3424,138 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"ja-JP": "アセット移動の存在が正常に確認されました",
 	},
 
+	// * Asset loan error messages
+	ErrAssetLoanNotFoundKey: {
+		"en-US": "Asset loan not found",
+		"id-ID": "Peminjaman aset tidak ditemukan",
+		"ja-JP": "アセット貸出が見つかりません",
+	},
+	ErrAssetLoanIDRequiredKey: {
+		"en-US": "Asset loan ID is required",
+		"id-ID": "ID peminjaman aset diperlukan",
+		"ja-JP": "アセット貸出IDが必要です",
+	},
+	ErrAssetLoanAlreadyActiveKey: {
+		"en-US": "Asset is already checked out",
+		"id-ID": "Aset sedang dipinjam",
+		"ja-JP": "アセットは既に貸し出されています",
+	},
+	ErrAssetLoanAlreadyReturnedKey: {
+		"en-US": "Asset loan has already been returned",
+		"id-ID": "Peminjaman aset sudah dikembalikan",
+		"ja-JP": "アセット貸出は既に返却されています",
+	},
+	ErrAssetLoanAssetUnavailableKey: {
+		"en-US": "Asset is not available for check-out",
+		"id-ID": "Aset tidak tersedia untuk dipinjam",
+		"ja-JP": "アセットは貸出できません",
+	},
+	ErrAssetLoanInvalidReturnDateKey: {
+		"en-US": "Expected return date must be today or later",
+		"id-ID": "Tanggal pengembalian harus hari ini atau setelahnya",
+		"ja-JP": "返却予定日は今日以降である必要があります",
+	},
+	ErrAssetLoanBorrowerAssignedKey: {
+		"en-US": "Asset is already assigned to the borrower",
+		"id-ID": "Aset sudah ditugaskan kepada peminjam",
+		"ja-JP": "アセットは既に借り手に割り当てられています",
+	},
+
+	// * Asset loan success messages
+	SuccessAssetCheckedOutKey: {
+		"en-US": "Asset checked out successfully",
+		"id-ID": "Aset berhasil dipinjamkan",
+		"ja-JP": "アセットが正常に貸し出されました",
+	},
+	SuccessAssetCheckedInKey: {
+		"en-US": "Asset checked in successfully",
+		"id-ID": "Aset berhasil dikembalikan",
+		"ja-JP": "アセットが正常に返却されました",
+	},
+	SuccessAssetLoanRetrievedKey: {
+		"en-US": "Asset loan retrieved successfully",
+		"id-ID": "Peminjaman aset berhasil diambil",
+		"ja-JP": "アセット貸出が正常に取得されました",
+	},
+	SuccessAssetLoanCountedKey: {
+		"en-US": "Asset loan counted successfully",
+		"id-ID": "Peminjaman aset berhasil dihitung",
+		"ja-JP": "アセット貸出が正常にカウントされました",
+	},
+
+	// * Audit log error messages
+	ErrAuditLogEntityIDRequiredKey: {
+		"en-US": "Entity ID is required",
+		"id-ID": "ID entitas wajib diisi",
+		"ja-JP": "エンティティIDは必須です",
+	},
+
+	// * Audit log success messages
+	SuccessAuditLogRetrievedKey: {
+		"en-US": "Audit logs retrieved successfully",
+		"id-ID": "Log audit berhasil diambil",
+		"ja-JP": "監査ログが正常に取得されました",
+	},
+	SuccessAuditLogCountedKey: {
+		"en-US": "Audit logs counted successfully",
+		"id-ID": "Log audit berhasil dihitung",
+		"ja-JP": "監査ログが正常にカウントされました",
+	},
+
+	// * Audit session error messages
+	ErrAuditSessionNotFoundKey: {
+		"en-US": "Audit session not found",
+		"id-ID": "Sesi audit tidak ditemukan",
+		"ja-JP": "棚卸セッションが見つかりません",
+	},
+	ErrAuditSessionIDRequiredKey: {
+		"en-US": "Audit session ID is required",
+		"id-ID": "ID sesi audit diperlukan",
+		"ja-JP": "棚卸セッションIDが必要です",
+	},
+	ErrAuditSessionScopeRequiredKey: {
+		"en-US": "Audit session must be scoped to a location or category",
+		"id-ID": "Sesi audit harus dibatasi pada lokasi atau kategori",
+		"ja-JP": "棚卸セッションには場所またはカテゴリの指定が必要です",
+	},
+	ErrAuditSessionAlreadyOpenKey: {
+		"en-US": "An open audit session already exists for this scope",
+		"id-ID": "Sesi audit yang terbuka sudah ada untuk cakupan ini",
+		"ja-JP": "この範囲の棚卸セッションは既に開始されています",
+	},
+	ErrAuditSessionNotOpenKey: {
+		"en-US": "Audit session is not open",
+		"id-ID": "Sesi audit tidak sedang terbuka",
+		"ja-JP": "棚卸セッションは開始されていません",
+	},
+
+	// * Audit session success messages
+	SuccessAuditSessionCreatedKey: {
+		"en-US": "Audit session started successfully",
+		"id-ID": "Sesi audit berhasil dimulai",
+		"ja-JP": "棚卸セッションが正常に開始されました",
+	},
+	SuccessAuditSessionClosedKey: {
+		"en-US": "Audit session closed successfully",
+		"id-ID": "Sesi audit berhasil ditutup",
+		"ja-JP": "棚卸セッションが正常に終了しました",
+	},
+	SuccessAuditSessionRetrievedKey: {
+		"en-US": "Audit sessions retrieved successfully",
+		"id-ID": "Sesi audit berhasil diambil",
+		"ja-JP": "棚卸セッションが正常に取得されました",
+	},
+	SuccessAuditSessionCountedKey: {
+		"en-US": "Audit sessions counted successfully",
+		"id-ID": "Sesi audit berhasil dihitung",
+		"ja-JP": "棚卸セッションが正常にカウントされました",
+	},
+	SuccessAuditSessionReportRetrievedKey: {
+		"en-US": "Audit session reconciliation report retrieved successfully",
+		"id-ID": "Laporan rekonsiliasi sesi audit berhasil diambil",
+		"ja-JP": "棚卸照合レポートが正常に取得されました",
+	},
+
 	// * Maintenance error messages
 	ErrMaintenanceScheduleNotFoundKey: {
 		"en-US": "Maintenance schedule not found",
@@ -2227,6 +3602,132 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Judul catatan diperlukan",
 		"ja-JP": "レコードタイトルが必要です",
 	},
+	ErrMaintenanceJobInvalidKey: {
+		"en-US": "Unknown maintenance job",
+		"id-ID": "Job pemeliharaan tidak dikenal",
+		"ja-JP": "不明な保守ジョブです",
+	},
+	ErrMaintenanceJobAlreadyRunningKey: {
+		"en-US": "Maintenance job is already running",
+		"id-ID": "Job pemeliharaan sedang berjalan",
+		"ja-JP": "保守ジョブはすでに実行中です",
+	},
+
+	// * Work order error messages
+	ErrWorkOrderNotFoundKey: {
+		"en-US": "Work order not found",
+		"id-ID": "Perintah kerja tidak ditemukan",
+		"ja-JP": "作業指示が見つかりません",
+	},
+	ErrWorkOrderIDRequiredKey: {
+		"en-US": "Work order ID is required",
+		"id-ID": "ID perintah kerja diperlukan",
+		"ja-JP": "作業指示IDが必要です",
+	},
+	ErrWorkOrderInvalidTransitionKey: {
+		"en-US": "Work order cannot move from its current status to the requested status",
+		"id-ID": "Perintah kerja tidak dapat berpindah dari status saat ini ke status yang diminta",
+		"ja-JP": "作業指示を現在のステータスから要求されたステータスに変更できません",
+	},
+	ErrWorkOrderClosedKey: {
+		"en-US": "Work order is already done or cancelled",
+		"id-ID": "Perintah kerja sudah selesai atau dibatalkan",
+		"ja-JP": "作業指示はすでに完了またはキャンセルされています",
+	},
+	ErrWorkOrderIssueAssetMismatchKey: {
+		"en-US": "Issue report does not belong to the work order asset",
+		"id-ID": "Laporan masalah bukan milik aset perintah kerja",
+		"ja-JP": "問題報告は作業指示の資産に属していません",
+	},
+	ErrWorkOrderAssigneeNotFoundKey: {
+		"en-US": "Assigned technician not found",
+		"id-ID": "Teknisi yang ditugaskan tidak ditemukan",
+		"ja-JP": "割り当てられた技術者が見つかりません",
+	},
+	ErrWorkOrderScheduleAssetMismatchKey: {
+		"en-US": "Maintenance schedule does not belong to the work order asset",
+		"id-ID": "Jadwal pemeliharaan bukan milik aset perintah kerja",
+		"ja-JP": "保守スケジュールは作業指示の資産に属していません",
+	},
+
+	// * Stock item error messages
+	ErrStockItemNotFoundKey: {
+		"en-US": "Stock item not found",
+		"id-ID": "Item stok tidak ditemukan",
+		"ja-JP": "在庫品目が見つかりません",
+	},
+	ErrStockItemIDRequiredKey: {
+		"en-US": "Stock item ID is required",
+		"id-ID": "ID item stok diperlukan",
+		"ja-JP": "在庫品目IDが必要です",
+	},
+	ErrStockItemSKUExistsKey: {
+		"en-US": "Stock item SKU already exists",
+		"id-ID": "SKU item stok sudah ada",
+		"ja-JP": "在庫品目のSKUはすでに存在します",
+	},
+	ErrStockItemInUseKey: {
+		"en-US": "Stock item has transactions and cannot be deleted, deactivate it instead",
+		"id-ID": "Item stok memiliki transaksi dan tidak dapat dihapus, nonaktifkan saja",
+		"ja-JP": "在庫品目には取引があるため削除できません。代わりに無効化してください",
+	},
+	ErrStockItemInactiveKey: {
+		"en-US": "Stock item is inactive",
+		"id-ID": "Item stok tidak aktif",
+		"ja-JP": "在庫品目は無効です",
+	},
+	ErrStockInsufficientKey: {
+		"en-US": "Insufficient stock at the selected location",
+		"id-ID": "Stok di lokasi yang dipilih tidak mencukupi",
+		"ja-JP": "選択した場所の在庫が不足しています",
+	},
+	ErrStockTransactionLocationInvalidKey: {
+		"en-US": "Locations do not match the stock transaction type",
+		"id-ID": "Lokasi tidak sesuai dengan tipe transaksi stok",
+		"ja-JP": "場所が在庫取引の種類と一致しません",
+	},
+	ErrStockTransactionSameLocationKey: {
+		"en-US": "Transfer source and destination must be different",
+		"id-ID": "Lokasi asal dan tujuan transfer harus berbeda",
+		"ja-JP": "移動元と移動先は異なる必要があります",
+	},
+	ErrStockUsageBulkNotSupportedKey: {
+		"en-US": "Stock usage is not supported for bulk maintenance records",
+		"id-ID": "Pemakaian stok tidak didukung untuk pembuatan catatan pemeliharaan massal",
+		"ja-JP": "一括保守記録では在庫の使用はサポートされていません",
+	},
+
+	// * Role error messages
+	ErrRoleNotFoundKey: {
+		"en-US": "Role not found",
+		"id-ID": "Role tidak ditemukan",
+		"ja-JP": "ロールが見つかりません",
+	},
+	ErrRoleIDRequiredKey: {
+		"en-US": "Role ID is required",
+		"id-ID": "ID role diperlukan",
+		"ja-JP": "ロールIDが必要です",
+	},
+	ErrRoleNameExistsKey: {
+		"en-US": "Role name already exists",
+		"id-ID": "Nama role sudah ada",
+		"ja-JP": "ロール名はすでに存在します",
+	},
+	ErrRoleSystemProtectedKey: {
+		"en-US": "System roles cannot be renamed or deleted",
+		"id-ID": "Role sistem tidak dapat diganti nama atau dihapus",
+		"ja-JP": "システムロールは名前の変更や削除ができません",
+	},
+	ErrRoleAdminImmutableKey: {
+		"en-US": "Admin role permissions cannot be changed",
+		"id-ID": "Permission role Admin tidak dapat diubah",
+		"ja-JP": "Adminロールの権限は変更できません",
+	},
+	ErrRolePermissionInvalidKey: {
+		"en-US": "Invalid permission: {0}",
+		"id-ID": "Permission tidak valid: {0}",
+		"ja-JP": "無効な権限です: {0}",
+	},
 
 	// * Maintenance success messages
 	SuccessMaintenanceScheduleCreatedKey: {
@@ -2309,6 +3810,142 @@ var messageTranslations = map[MessageKey]map[string]string{
 		"id-ID": "Statistik catatan pemeliharaan berhasil diambil",
 		"ja-JP": "保守レコード統計が正常に取得されました",
 	},
+	SuccessMaintenanceJobRunKey: {
+		"en-US": "Maintenance job executed successfully",
+		"id-ID": "Job pemeliharaan berhasil dijalankan",
+		"ja-JP": "保守ジョブが正常に実行されました",
+	},
+
+	// * Work order success messages
+	SuccessWorkOrderCreatedKey: {
+		"en-US": "Work order created successfully",
+		"id-ID": "Perintah kerja berhasil dibuat",
+		"ja-JP": "作業指示が正常に作成されました",
+	},
+	SuccessWorkOrderUpdatedKey: {
+		"en-US": "Work order updated successfully",
+		"id-ID": "Perintah kerja berhasil diperbarui",
+		"ja-JP": "作業指示が正常に更新されました",
+	},
+	SuccessWorkOrderDeletedKey: {
+		"en-US": "Work order deleted successfully",
+		"id-ID": "Perintah kerja berhasil dihapus",
+		"ja-JP": "作業指示が正常に削除されました",
+	},
+	SuccessWorkOrderAssignedKey: {
+		"en-US": "Work order assigned successfully",
+		"id-ID": "Perintah kerja berhasil ditugaskan",
+		"ja-JP": "作業指示が正常に割り当てられました",
+	},
+	SuccessWorkOrderStatusUpdatedKey: {
+		"en-US": "Work order status updated successfully",
+		"id-ID": "Status perintah kerja berhasil diperbarui",
+		"ja-JP": "作業指示のステータスが正常に更新されました",
+	},
+	SuccessWorkOrderCompletedKey: {
+		"en-US": "Work order completed successfully",
+		"id-ID": "Perintah kerja berhasil diselesaikan",
+		"ja-JP": "作業指示が正常に完了しました",
+	},
+	SuccessWorkOrderRetrievedKey: {
+		"en-US": "Work orders retrieved successfully",
+		"id-ID": "Perintah kerja berhasil diambil",
+		"ja-JP": "作業指示が正常に取得されました",
+	},
+	SuccessWorkOrderCountedKey: {
+		"en-US": "Work orders counted successfully",
+		"id-ID": "Perintah kerja berhasil dihitung",
+		"ja-JP": "作業指示が正常にカウントされました",
+	},
+	SuccessWorkOrderStatisticsRetrievedKey: {
+		"en-US": "Work order statistics retrieved successfully",
+		"id-ID": "Statistik perintah kerja berhasil diambil",
+		"ja-JP": "作業指示の統計が正常に取得されました",
+	},
+
+	// * Stock item success messages
+	SuccessStockItemCreatedKey: {
+		"en-US": "Stock item created successfully",
+		"id-ID": "Item stok berhasil dibuat",
+		"ja-JP": "在庫品目が正常に作成されました",
+	},
+	SuccessStockItemUpdatedKey: {
+		"en-US": "Stock item updated successfully",
+		"id-ID": "Item stok berhasil diperbarui",
+		"ja-JP": "在庫品目が正常に更新されました",
+	},
+	SuccessStockItemDeletedKey: {
+		"en-US": "Stock item deleted successfully",
+		"id-ID": "Item stok berhasil dihapus",
+		"ja-JP": "在庫品目が正常に削除されました",
+	},
+	SuccessStockItemRetrievedKey: {
+		"en-US": "Stock items retrieved successfully",
+		"id-ID": "Item stok berhasil diambil",
+		"ja-JP": "在庫品目が正常に取得されました",
+	},
+	SuccessStockItemCountedKey: {
+		"en-US": "Stock items counted successfully",
+		"id-ID": "Item stok berhasil dihitung",
+		"ja-JP": "在庫品目が正常にカウントされました",
+	},
+	SuccessStockItemLowStockRetrievedKey: {
+		"en-US": "Low stock items retrieved successfully",
+		"id-ID": "Item stok menipis berhasil diambil",
+		"ja-JP": "在庫不足の品目が正常に取得されました",
+	},
+	SuccessStockTransactionCreatedKey: {
+		"en-US": "Stock transaction recorded successfully",
+		"id-ID": "Transaksi stok berhasil dicatat",
+		"ja-JP": "在庫取引が正常に記録されました",
+	},
+	SuccessStockTransactionRetrievedKey: {
+		"en-US": "Stock transactions retrieved successfully",
+		"id-ID": "Transaksi stok berhasil diambil",
+		"ja-JP": "在庫取引が正常に取得されました",
+	},
+	SuccessStockTransactionCountedKey: {
+		"en-US": "Stock transactions counted successfully",
+		"id-ID": "Transaksi stok berhasil dihitung",
+		"ja-JP": "在庫取引が正常にカウントされました",
+	},
+
+	// * Role success messages
+	SuccessRoleCreatedKey: {
+		"en-US": "Role created successfully",
+		"id-ID": "Role berhasil dibuat",
+		"ja-JP": "ロールが正常に作成されました",
+	},
+	SuccessRoleUpdatedKey: {
+		"en-US": "Role updated successfully",
+		"id-ID": "Role berhasil diperbarui",
+		"ja-JP": "ロールが正常に更新されました",
+	},
+	SuccessRoleDeletedKey: {
+		"en-US": "Role deleted successfully",
+		"id-ID": "Role berhasil dihapus",
+		"ja-JP": "ロールが正常に削除されました",
+	},
+	SuccessRoleRetrievedKey: {
+		"en-US": "Roles retrieved successfully",
+		"id-ID": "Role berhasil diambil",
+		"ja-JP": "ロールが正常に取得されました",
+	},
+	SuccessPermissionsRetrievedKey: {
+		"en-US": "Permissions retrieved successfully",
+		"id-ID": "Permission berhasil diambil",
+		"ja-JP": "権限が正常に取得されました",
+	},
+	SuccessUserRolesRetrievedKey: {
+		"en-US": "User roles retrieved successfully",
+		"id-ID": "Role pengguna berhasil diambil",
+		"ja-JP": "ユーザーのロールが正常に取得されました",
+	},
+	SuccessUserRolesAssignedKey: {
+		"en-US": "User roles assigned successfully",
+		"id-ID": "Role pengguna berhasil ditetapkan",
+		"ja-JP": "ユーザーのロールが正常に割り当てられました",
+	},
 }
 
 // * GetLocalizedMessage returns the localized message for the given key and language
@@ -11,25 +11,50 @@ import (
 var accessTokenSecret = []byte(os.Getenv("JWT_ACCESS_SECRET"))
 var refreshTokenSecret = []byte(os.Getenv("JWT_REFRESH_SECRET"))
 
+const (
+	// Access token dibuat pendek, session-nya dicek AuthMiddleware lewat cache singkat jadi pencabutan berlaku tanpa menunggu refresh
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+	// Challenge login 2FA, hanya berlaku untuk menukar kode TOTP dengan token
+	TwoFactorChallengeTTL = 5 * time.Minute
+)
+
+type TwoFactorChallengePurpose string
+
+const (
+	TwoFactorChallengeVerify TwoFactorChallengePurpose = "2fa_verify"
+	// User wajib 2FA tapi belum enrol, challenge dipakai untuk enrolment sebelum login selesai
+	TwoFactorChallengeSetup TwoFactorChallengePurpose = "2fa_setup"
+)
+
 type JWTClaims struct {
 	IDUser   string  `json:"id_user"`
 	Name     *string `json:"name,omitempty"`
 	Email    *string `json:"email,omitempty"`
 	IsActive *bool   `json:"is_active,omitempty"`
 	Role     *string `json:"role,omitempty"`
+	// Effective permissions resolved at login/refresh, token lama tanpa claim ini harus refresh dulu
+	Permissions []string `json:"permissions"`
+	// Lokasi yang boleh diakses user, diabaikan kalau punya permission location:all. Kosong berarti tidak ada akses
+	LocationIDs []string `json:"location_ids,omitempty"`
+	// Session server-side tempat token ini dikeluarkan
+	SessionID string `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
 type CreateJWTPayload struct {
-	IDUser   string
-	Name     string
-	Email    string
-	Role     string
-	IsActive bool
+	IDUser      string
+	Name        string
+	Email       string
+	Role        string
+	IsActive    bool
+	Permissions []string
+	LocationIDs []string
+	SessionID   string
 }
 
 func CreateAccessToken(payload *CreateJWTPayload) (string, error) {
-	expirationTime := time.Now().UTC().Add(7 * 24 * time.Hour)
+	expirationTime := time.Now().UTC().Add(AccessTokenTTL)
 
 	claims := &JWTClaims{
 		IDUser:   payload.IDUser,
@@ -37,6 +62,10 @@ func CreateAccessToken(payload *CreateJWTPayload) (string, error) {
 		Email:    &payload.Email,
 		Role:     &payload.Role,
 		IsActive: &payload.IsActive,
+		// Selalu non-nil supaya claim tetap ter-encode walaupun user tidak punya permission
+		Permissions: append([]string{}, payload.Permissions...),
+		LocationIDs: payload.LocationIDs,
+		SessionID:   payload.SessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
@@ -60,13 +89,14 @@ func CreateAccessToken(payload *CreateJWTPayload) (string, error) {
 	return tokenString, nil
 }
 
-func CreateRefreshToken(idUser string) (string, error) {
-	expirationTime := time.Now().UTC().Add(30 * 24 * time.Hour)
-
+// CreateRefreshToken issues a refresh token bound to a session, tokenId is rotated on every refresh
+func CreateRefreshToken(idUser string, sessionId string, tokenId string, expiresAt time.Time) (string, error) {
 	claims := &JWTClaims{
-		IDUser: idUser,
+		IDUser:    idUser,
+		SessionID: sessionId,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			ID:        tokenId,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
 			Issuer:    "rizz-app",
 		},
@@ -77,6 +107,55 @@ func CreateRefreshToken(idUser string) (string, error) {
 	return token.SignedString(refreshTokenSecret)
 }
 
+// TwoFactorChallengeClaims is signed with the refresh secret, it has no session so it can never be refreshed
+type TwoFactorChallengeClaims struct {
+	IDUser     string                    `json:"id_user"`
+	Purpose    TwoFactorChallengePurpose `json:"purpose"`
+	DeviceName *string                   `json:"device_name,omitempty"`
+	jwt.RegisteredClaims
+}
+
+func CreateTwoFactorChallengeToken(idUser string, purpose TwoFactorChallengePurpose, deviceName *string, expiresAt time.Time) (string, error) {
+	claims := &TwoFactorChallengeClaims{
+		IDUser:     idUser,
+		Purpose:    purpose,
+		DeviceName: deviceName,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+			Issuer:    "rizz-app",
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString(refreshTokenSecret)
+}
+
+func ValidateTwoFactorChallengeToken(tokenString string) (*TwoFactorChallengeClaims, error) {
+	claims := &TwoFactorChallengeClaims{}
+
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return refreshTokenSecret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Valid || claims.IDUser == "" {
+		return nil, fmt.Errorf("token is not valid")
+	}
+
+	if claims.Purpose != TwoFactorChallengeVerify && claims.Purpose != TwoFactorChallengeSetup {
+		return nil, fmt.Errorf("token is not a two factor challenge")
+	}
+
+	return claims, nil
+}
+
 func ValidateToken(tokenString string, secretKey []byte) (*JWTClaims, error) {
 
 	claims := &JWTClaims{}
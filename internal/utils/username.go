@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+)
+
+const (
+	generatedUsernameMaxLength = 40
+	generatedUsernameAttempts  = 5
+)
+
+var usernameInvalidChars = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// UsernameBase derives a username for provisioned users (SSO, directory sync), local part email dipakai kalau preferred kosong
+func UsernameBase(preferred string, email string) string {
+	base := preferred
+	if base == "" || strings.Contains(base, "@") {
+		base, _, _ = strings.Cut(email, "@")
+	}
+
+	base = usernameInvalidChars.ReplaceAllString(strings.ToLower(base), "")
+	if len(base) > generatedUsernameMaxLength {
+		base = base[:generatedUsernameMaxLength]
+	}
+	if len(base) < 3 {
+		base = "user" + base
+	}
+
+	return base
+}
+
+// UniqueUsername returns base, or base with a short random suffix when the name is already taken
+func UniqueUsername(ctx context.Context, base string, nameExists func(ctx context.Context, name string) (bool, error)) (string, error) {
+	candidate := base
+	for range generatedUsernameAttempts {
+		exists, err := nameExists(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+
+		// * Suffix acak pendek dari ULID, tetap di bawah batas 50 karakter kolom name
+		suffix := strings.ToLower(ulid.Make().String())
+		candidate = base + "_" + suffix[len(suffix)-6:]
+	}
+
+	return base + "_" + strings.ToLower(ulid.Make().String()[:8]), nil
+}
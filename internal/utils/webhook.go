@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+const (
+	WebhookSecretPrefix = "whsec_"
+	webhookSecretSize   = 24
+)
+
+// GenerateWebhookSecret creates the secret an endpoint uses to verify delivery signatures
+func GenerateWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return WebhookSecretPrefix + hex.EncodeToString(secret), nil
+}
+
+// SignWebhookPayload returns the X-Webhook-Signature value, HMAC-SHA256 dari "<timestamp>.<body>"
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
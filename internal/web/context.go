@@ -1,6 +1,8 @@
 package web
 
 import (
+	"context"
+	"slices"
 	"strings"
 
 	"github.com/Rizz404/inventory-api/domain"
@@ -24,6 +26,88 @@ func GetUserIDFromContext(c *fiber.Ctx) (string, bool) {
 	return idUser, ok
 }
 
+// * GetUserIDFromRequestContext helper function untuk ambil user ID dari context.Context yang diteruskan ke service (c.Context())
+func GetUserIDFromRequestContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	idUser, ok := ctx.Value("id_user").(string)
+	return idUser, ok && idUser != ""
+}
+
+// * GetSessionIDFromContext helper function untuk ambil session ID dari access token
+func GetSessionIDFromContext(c *fiber.Ctx) (string, bool) {
+	sessionId, ok := c.Locals("session_id").(string)
+	return sessionId, ok && sessionId != ""
+}
+
+// * GetLocationScopeFromRequestContext helper function untuk ambil lokasi yang boleh diakses user, false berarti tidak dibatasi.
+// * Scope kosong berarti user tidak punya akses ke lokasi manapun
+func GetLocationScopeFromRequestContext(ctx context.Context) ([]string, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	locationIds, ok := ctx.Value("location_ids").([]string)
+	return locationIds, ok
+}
+
+// * ResolveLocationScope helper function untuk menentukan scope dari permission dan lokasi yang di-assign ke user.
+// * Hanya permission location:all yang membuat user tidak dibatasi, user tanpa lokasi tidak bisa akses data lokasi manapun
+func ResolveLocationScope(permissions []string, locationIds []string) ([]string, bool) {
+	if domain.HasPermission(permissions, domain.PermissionLocationAll) {
+		return nil, false
+	}
+	if locationIds == nil {
+		locationIds = []string{}
+	}
+	return locationIds, true
+}
+
+// * WithRequestUser helper function untuk job background yang jalan atas nama user, key sama dengan yang diisi AuthMiddleware
+// * supaya GetUserIDFromRequestContext dan location scope di repository tetap berlaku. scoped false berarti tidak dibatasi
+func WithRequestUser(ctx context.Context, userId string, locationIds []string, scoped bool) context.Context {
+	ctx = context.WithValue(ctx, "id_user", userId)
+	if scoped {
+		if locationIds == nil {
+			locationIds = []string{}
+		}
+		ctx = context.WithValue(ctx, "location_ids", locationIds)
+	}
+	return ctx
+}
+
+// * WithRequestUserScope helper function seperti WithRequestUser, tapi permission dan lokasi user dimuat sendiri.
+// * Dipakai job background yang jalan atas nama pemilik job (export, report) supaya scope-nya sama dengan request biasa
+func WithRequestUserScope(
+	ctx context.Context,
+	userId string,
+	getPermissions func(ctx context.Context, userId string) ([]string, error),
+	getLocationIds func(ctx context.Context, userId string) ([]string, error),
+) (context.Context, error) {
+	permissions, err := getPermissions(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if domain.HasPermission(permissions, domain.PermissionLocationAll) {
+		return WithRequestUser(ctx, userId, nil, false), nil
+	}
+
+	locationIds, err := getLocationIds(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return WithRequestUser(ctx, userId, locationIds, true), nil
+}
+
+// * IsLocationInRequestScope helper function untuk cek apakah lokasi boleh diakses user yang sedang request
+func IsLocationInRequestScope(ctx context.Context, locationId string) bool {
+	locationIds, scoped := GetLocationScopeFromRequestContext(ctx)
+	if !scoped {
+		return true
+	}
+	return slices.Contains(locationIds, locationId)
+}
+
 // * GetLanguageFromContext helper function untuk ambil bahasa dari header Accept-Language
 func GetLanguageFromContext(c *fiber.Ctx) string {
 	// * Check Accept-Language header
@@ -0,0 +1,290 @@
+package api_key
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+)
+
+// * Repository interface defines the contract for api key data operations
+type Repository interface {
+	// * MUTATION
+	CreateAPIKey(ctx context.Context, payload *domain.APIKey) (domain.APIKey, error)
+	UpdateAPIKey(ctx context.Context, keyId string, payload *domain.UpdateAPIKeyPayload) (domain.APIKey, error)
+	RotateAPIKey(ctx context.Context, keyId string, keyPrefix string, keyHash string) (domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, keyId string) error
+	RecordAPIKeyUsage(ctx context.Context, keyId string, ipAddress *string, usedAt time.Time) error
+
+	// * QUERY
+	GetAPIKeys(ctx context.Context) ([]domain.APIKey, error)
+	GetAPIKeyById(ctx context.Context, keyId string) (domain.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (domain.APIKey, bool, error)
+	CheckAPIKeyNameExists(ctx context.Context, name string) (bool, error)
+	CheckAPIKeyNameExistsExcluding(ctx context.Context, name string, excludeKeyId string) (bool, error)
+}
+
+// * APIKeyService interface defines the contract for api key business operations
+type APIKeyService interface {
+	// * MUTATION
+	CreateAPIKey(ctx context.Context, payload *domain.CreateAPIKeyPayload) (domain.APIKeySecretResponse, error)
+	UpdateAPIKey(ctx context.Context, keyId string, payload *domain.UpdateAPIKeyPayload) (domain.APIKeyResponse, error)
+	RotateAPIKey(ctx context.Context, keyId string) (domain.APIKeySecretResponse, error)
+	RevokeAPIKey(ctx context.Context, keyId string) error
+
+	// * QUERY
+	GetAPIKeys(ctx context.Context) ([]domain.APIKeyResponse, error)
+	GetAPIKeyById(ctx context.Context, keyId string) (domain.APIKeyResponse, error)
+	AuthenticateAPIKey(ctx context.Context, rawKey string) (domain.APIKey, error)
+	RecordAPIKeyUsage(keyId string, ipAddress string)
+}
+
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// * Transactor interface for writing a mutation and its audit entry in one transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Service struct {
+	Repo            Repository
+	AuditLogService AuditLogService
+	Transactor      Transactor
+}
+
+// * Ensure Service implements APIKeyService interface
+var _ APIKeyService = (*Service)(nil)
+
+func NewService(r Repository, auditLogService AuditLogService, transactor Transactor) APIKeyService {
+	return &Service{
+		Repo:            r,
+		AuditLogService: auditLogService,
+		Transactor:      transactor,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (s *Service) CreateAPIKey(ctx context.Context, payload *domain.CreateAPIKeyPayload) (domain.APIKeySecretResponse, error) {
+	name := strings.TrimSpace(payload.Name)
+	if nameExists, err := s.Repo.CheckAPIKeyNameExists(ctx, name); err != nil {
+		return domain.APIKeySecretResponse{}, err
+	} else if nameExists {
+		return domain.APIKeySecretResponse{}, domain.ErrConflictWithKey(utils.ErrAPIKeyNameExistsKey)
+	}
+
+	if payload.ExpiresAt != nil && !payload.ExpiresAt.After(time.Now()) {
+		return domain.APIKeySecretResponse{}, domain.ErrBadRequestWithKey(utils.ErrAPIKeyExpiryInvalidKey)
+	}
+
+	key, keyPrefix, keyHash, err := utils.GenerateAPIKey()
+	if err != nil {
+		return domain.APIKeySecretResponse{}, domain.ErrInternal(err)
+	}
+
+	newKey := domain.APIKey{
+		Name:        name,
+		Description: payload.Description,
+		KeyPrefix:   keyPrefix,
+		KeyHash:     keyHash,
+		Scopes:      uniqueScopes(payload.Scopes),
+		ExpiresAt:   payload.ExpiresAt,
+	}
+	if actorId, ok := web.GetUserIDFromRequestContext(ctx); ok {
+		newKey.CreatedBy = &actorId
+	}
+
+	var createdKey domain.APIKey
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdKey, err = s.Repo.CreateAPIKey(ctx, &newKey)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordCreate(ctx, domain.AuditEntityAPIKey, createdKey.ID, createdKey)
+	})
+	if err != nil {
+		return domain.APIKeySecretResponse{}, err
+	}
+
+	return domain.APIKeySecretResponse{
+		APIKeyResponse: mapper.APIKeyToResponse(&createdKey),
+		Key:            key,
+	}, nil
+}
+
+func (s *Service) UpdateAPIKey(ctx context.Context, keyId string, payload *domain.UpdateAPIKeyPayload) (domain.APIKeyResponse, error) {
+	existingKey, err := s.Repo.GetAPIKeyById(ctx, keyId)
+	if err != nil {
+		return domain.APIKeyResponse{}, err
+	}
+
+	if existingKey.RevokedAt != nil {
+		return domain.APIKeyResponse{}, domain.ErrBadRequestWithKey(utils.ErrAPIKeyRevokedKey)
+	}
+
+	if payload.Name != nil {
+		name := strings.TrimSpace(*payload.Name)
+		payload.Name = &name
+
+		if name != existingKey.Name {
+			if nameExists, err := s.Repo.CheckAPIKeyNameExistsExcluding(ctx, name, keyId); err != nil {
+				return domain.APIKeyResponse{}, err
+			} else if nameExists {
+				return domain.APIKeyResponse{}, domain.ErrConflictWithKey(utils.ErrAPIKeyNameExistsKey)
+			}
+		}
+	}
+
+	if payload.Scopes != nil {
+		payload.Scopes = uniqueScopes(payload.Scopes)
+	}
+
+	if payload.ExpiresAt != nil && !payload.ExpiresAt.After(time.Now()) {
+		return domain.APIKeyResponse{}, domain.ErrBadRequestWithKey(utils.ErrAPIKeyExpiryInvalidKey)
+	}
+
+	var updatedKey domain.APIKey
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedKey, err = s.Repo.UpdateAPIKey(ctx, keyId, payload)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityAPIKey, keyId, existingKey, updatedKey)
+	})
+	if err != nil {
+		return domain.APIKeyResponse{}, err
+	}
+
+	return mapper.APIKeyToResponse(&updatedKey), nil
+}
+
+// RotateAPIKey issues a new secret for the same client, scopes and usage history are kept
+func (s *Service) RotateAPIKey(ctx context.Context, keyId string) (domain.APIKeySecretResponse, error) {
+	existingKey, err := s.Repo.GetAPIKeyById(ctx, keyId)
+	if err != nil {
+		return domain.APIKeySecretResponse{}, err
+	}
+
+	if existingKey.RevokedAt != nil {
+		return domain.APIKeySecretResponse{}, domain.ErrBadRequestWithKey(utils.ErrAPIKeyRevokedKey)
+	}
+
+	key, keyPrefix, keyHash, err := utils.GenerateAPIKey()
+	if err != nil {
+		return domain.APIKeySecretResponse{}, domain.ErrInternal(err)
+	}
+
+	var rotatedKey domain.APIKey
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		rotatedKey, err = s.Repo.RotateAPIKey(ctx, keyId, keyPrefix, keyHash)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityAPIKey, keyId, existingKey, rotatedKey)
+	})
+	if err != nil {
+		return domain.APIKeySecretResponse{}, err
+	}
+
+	return domain.APIKeySecretResponse{
+		APIKeyResponse: mapper.APIKeyToResponse(&rotatedKey),
+		Key:            key,
+	}, nil
+}
+
+func (s *Service) RevokeAPIKey(ctx context.Context, keyId string) error {
+	existingKey, err := s.Repo.GetAPIKeyById(ctx, keyId)
+	if err != nil {
+		return err
+	}
+
+	if existingKey.RevokedAt != nil {
+		return nil
+	}
+
+	return s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.RevokeAPIKey(ctx, keyId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityAPIKey, keyId, existingKey)
+	})
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetAPIKeys(ctx context.Context) ([]domain.APIKeyResponse, error) {
+	keys, err := s.Repo.GetAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.APIKeysToResponses(keys), nil
+}
+
+func (s *Service) GetAPIKeyById(ctx context.Context, keyId string) (domain.APIKeyResponse, error) {
+	key, err := s.Repo.GetAPIKeyById(ctx, keyId)
+	if err != nil {
+		return domain.APIKeyResponse{}, err
+	}
+
+	return mapper.APIKeyToResponse(&key), nil
+}
+
+// AuthenticateAPIKey resolves the key sent in X-API-Key, revoked and expired keys are rejected
+func (s *Service) AuthenticateAPIKey(ctx context.Context, rawKey string) (domain.APIKey, error) {
+	key, found, err := s.Repo.GetAPIKeyByHash(ctx, utils.HashAPIKey(rawKey))
+	if err != nil {
+		return domain.APIKey{}, err
+	}
+	if !found || key.RevokedAt != nil {
+		return domain.APIKey{}, domain.ErrUnauthorizedWithKey(utils.ErrAPIKeyInvalidKey)
+	}
+	if key.IsExpired(time.Now()) {
+		return domain.APIKey{}, domain.ErrUnauthorizedWithKey(utils.ErrAPIKeyExpiredKey)
+	}
+
+	return key, nil
+}
+
+// RecordAPIKeyUsage updates the counters in the background so requests are not slowed down
+func (s *Service) RecordAPIKeyUsage(keyId string, ipAddress string) {
+	var ip *string
+	if ipAddress != "" {
+		ip = &ipAddress
+	}
+
+	go func() {
+		if err := s.Repo.RecordAPIKeyUsage(context.Background(), keyId, ip, time.Now()); err != nil {
+			log.Printf("Failed to record API key usage for key ID %s: %v", keyId, err)
+		}
+	}()
+}
+
+// *===========================HELPER METHODS===========================*
+func uniqueScopes(scopes []domain.APIKeyScope) []domain.APIKeyScope {
+	result := make([]domain.APIKeyScope, 0, len(scopes))
+	seen := make(map[domain.APIKeyScope]struct{}, len(scopes))
+	for _, scope := range scopes {
+		if _, exists := seen[scope]; exists {
+			continue
+		}
+		seen[scope] = struct{}{}
+		result = append(result, scope)
+	}
+	return result
+}
@@ -9,6 +9,7 @@ import (
 	"image/png"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
@@ -459,6 +460,13 @@ func (s *Service) exportAssetListToExcel(assets []domain.AssetResponse) ([]byte,
 		"Warranty End", "Status", "Condition", "Location", "Assigned To",
 	}
 
+	// * Custom attribute columns follow the fixed columns
+	attributeColumns := collectAssetAttributeColumns(assets)
+	baseColumns := len(headers)
+	for _, column := range attributeColumns {
+		headers = append(headers, column.label)
+	}
+
 	for col, header := range headers {
 		cell, _ := excelize.CoordinatesToCellName(col+1, 1)
 		f.SetCellValue(sheetName, cell, header)
@@ -535,6 +543,11 @@ func (s *Service) exportAssetListToExcel(assets []domain.AssetResponse) ([]byte,
 		f.SetCellValue(sheetName, fmt.Sprintf("L%d", rowNum), string(asset.Condition))
 		f.SetCellValue(sheetName, fmt.Sprintf("M%d", rowNum), locationName)
 		f.SetCellValue(sheetName, fmt.Sprintf("N%d", rowNum), assignedToName)
+
+		for i, column := range attributeColumns {
+			cell, _ := excelize.CoordinatesToCellName(baseColumns+i+1, rowNum)
+			f.SetCellValue(sheetName, cell, domain.FormatAttributeValue(asset.CustomAttributes[column.key]))
+		}
 	}
 
 	// Auto-fit columns
@@ -552,6 +565,48 @@ func (s *Service) exportAssetListToExcel(assets []domain.AssetResponse) ([]byte,
 	return buffer.Bytes(), nil
 }
 
+type assetAttributeColumn struct {
+	key   string
+	label string
+}
+
+// collectAssetAttributeColumns returns the union of custom attributes across the exported assets,
+// in category schema order, followed by any stored keys no longer defined by a schema
+func collectAssetAttributeColumns(assets []domain.AssetResponse) []assetAttributeColumn {
+	columns := []assetAttributeColumn{}
+	seen := make(map[string]struct{})
+
+	for _, asset := range assets {
+		if asset.Category == nil {
+			continue
+		}
+		for _, attribute := range asset.Category.Attributes {
+			if _, exists := seen[attribute.Key]; exists {
+				continue
+			}
+			seen[attribute.Key] = struct{}{}
+			columns = append(columns, assetAttributeColumn{key: attribute.Key, label: attribute.Label})
+		}
+	}
+
+	orphanKeys := []string{}
+	for _, asset := range assets {
+		for key := range asset.CustomAttributes {
+			if _, exists := seen[key]; exists {
+				continue
+			}
+			seen[key] = struct{}{}
+			orphanKeys = append(orphanKeys, key)
+		}
+	}
+	sort.Strings(orphanKeys)
+	for _, key := range orphanKeys {
+		columns = append(columns, assetAttributeColumn{key: key, label: key})
+	}
+
+	return columns
+}
+
 // ExportAssetStatistics exports asset statistics to PDF with charts
 func (s *Service) ExportAssetStatistics(ctx context.Context, langCode string) ([]byte, string, error) {
 	// Get statistics
@@ -1033,3 +1088,359 @@ func (s *Service) exportAssetDataMatrixToPDF(assets []domain.AssetResponse, lang
 
 	return buffer.Bytes(), nil
 }
+
+// ExportAssetDepreciation exports asset depreciation report to PDF or Excel format
+func (s *Service) ExportAssetDepreciation(ctx context.Context, payload *domain.ExportAssetDepreciationPayload, langCode string) ([]byte, string, error) {
+	// Build params from payload
+	params := domain.AssetParams{
+		SearchQuery: payload.SearchQuery,
+		Filters:     payload.Filters,
+		Sort:        payload.Sort,
+	}
+
+	asOf := time.Now()
+	if payload.AsOfDate != nil && *payload.AsOfDate != "" {
+		parsedDate, err := time.ParseInLocation("2006-01-02", *payload.AsOfDate, time.UTC)
+		if err != nil {
+			return nil, "", domain.ErrBadRequestWithKey(utils.ErrAssetDepreciationAsOfInvalidKey, *payload.AsOfDate)
+		}
+		asOf = parsedDate
+	}
+
+	report, err := s.GetAssetDepreciationReport(ctx, params, asOf, langCode)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch payload.Format {
+	case domain.ExportFormatPDF:
+		data, err := s.exportAssetDepreciationToPDF(report, langCode)
+		if err != nil {
+			return nil, "", domain.ErrInternal(err)
+		}
+		timestamp := time.Now().Format("2006-01-02_15-04-05")
+		filename := fmt.Sprintf("asset_depreciation_%s.pdf", timestamp)
+		return data, filename, nil
+
+	case domain.ExportFormatExcel:
+		data, err := s.exportAssetDepreciationToExcel(report)
+		if err != nil {
+			return nil, "", domain.ErrInternal(err)
+		}
+		timestamp := time.Now().Format("2006-01-02_15-04-05")
+		filename := fmt.Sprintf("asset_depreciation_%s.xlsx", timestamp)
+		return data, filename, nil
+
+	default:
+		return nil, "", domain.ErrBadRequest("Invalid export format")
+	}
+}
+
+// exportAssetDepreciationToPDF generates PDF file for asset depreciation report using gopdf
+func (s *Service) exportAssetDepreciationToPDF(report domain.AssetDepreciationReportResponse, langCode string) ([]byte, error) {
+	// Get absolute path for fonts and logo
+	workDir, _ := os.Getwd()
+	fontRegularPath := filepath.Join(workDir, "assets", "fonts", "NotoSansJP-Regular.ttf")
+	fontBoldPath := filepath.Join(workDir, "assets", "fonts", "NotoSansJP-Bold.ttf")
+	logoPath := filepath.Join(workDir, "assets", "images", "fts-logo.png")
+
+	// Initialize gopdf
+	pdf := gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{
+		PageSize: *gopdf.PageSizeA4Landscape, // A4 Landscape: 842 x 595
+		Unit:     gopdf.Unit_PT,
+	})
+	pdf.AddPage()
+
+	if err := pdf.AddTTFFont("noto-regular", fontRegularPath); err != nil {
+		return nil, fmt.Errorf("failed to load regular font: %w", err)
+	}
+	if err := pdf.AddTTFFont("noto-bold", fontBoldPath); err != nil {
+		return nil, fmt.Errorf("failed to load bold font: %w", err)
+	}
+
+	pdf.SetFont("noto-regular", "", 10)
+
+	// Get localized text
+	reportTitle := utils.GetLocalizedMessage(utils.PDFAssetDepreciationReportKey, langCode)
+	generatedOnText := utils.GetLocalizedMessage(utils.PDFAssetGeneratedOnKey, langCode)
+	asOfText := utils.GetLocalizedMessage(utils.PDFAssetDepreciationAsOfKey, langCode)
+	totalAssetsText := utils.GetLocalizedMessage(utils.PDFAssetTotalAssetsKey, langCode)
+	assetTagText := utils.GetLocalizedMessage(utils.PDFAssetAssetTagKey, langCode)
+	assetNameText := utils.GetLocalizedMessage(utils.PDFAssetAssetNameKey, langCode)
+	categoryText := utils.GetLocalizedMessage(utils.PDFAssetCategoryKey, langCode)
+	methodText := utils.GetLocalizedMessage(utils.PDFAssetDepreciationMethodKey, langCode)
+	usefulLifeText := utils.GetLocalizedMessage(utils.PDFAssetUsefulLifeKey, langCode)
+	purchaseDateText := utils.GetLocalizedMessage(utils.PDFAssetPurchaseDateKey, langCode)
+	purchasePriceText := utils.GetLocalizedMessage(utils.PDFAssetPurchasePriceKey, langCode)
+	accumulatedText := utils.GetLocalizedMessage(utils.PDFAssetAccumulatedDepreciationKey, langCode)
+	bookValueText := utils.GetLocalizedMessage(utils.PDFAssetBookValueKey, langCode)
+
+	// Page setup (A4 Landscape: 842 x 595 points)
+	marginLeft := 30.0
+	marginTop := 50.0
+	pageWidth := 842.0
+	pageHeight := 595.0
+	contentWidth := pageWidth - (marginLeft * 2)
+
+	// Add company logo if exists
+	currentY := marginTop
+	if _, err := os.Stat(logoPath); err == nil {
+		rect := &gopdf.Rect{W: 60, H: 60}
+		pdf.Image(logoPath, marginLeft, currentY-10, rect)
+
+		pdf.SetFont("noto-bold", "", 16)
+		pdf.SetX(marginLeft + 70)
+		pdf.SetY(currentY + 15)
+		pdf.Cell(nil, reportTitle)
+
+		currentY += 50
+	} else {
+		pdf.SetFont("noto-bold", "", 16)
+		titleWidth, _ := pdf.MeasureTextWidth(reportTitle)
+		pdf.SetX((pageWidth - titleWidth) / 2)
+		pdf.SetY(currentY)
+		pdf.Cell(nil, reportTitle)
+
+		currentY += 30
+	}
+
+	// Subtitle with generated and as-of date
+	pdf.SetFont("noto-regular", "", 10)
+	dateText := fmt.Sprintf("%s: %s  |  %s: %s", generatedOnText, time.Now().Format("2006-01-02 15:04:05"), asOfText, report.AsOfDate.Format("2006-01-02"))
+	dateWidth, _ := pdf.MeasureTextWidth(dateText)
+	pdf.SetX((pageWidth - dateWidth) / 2)
+	pdf.SetY(currentY)
+	pdf.Cell(nil, dateText)
+
+	currentY += 25
+
+	// Column widths (A4 landscape: 782 total usable width)
+	colWidths := []float64{70, 130, 95, 95, 55, 70, 90, 90, 87} // Total: 782
+	headers := []string{assetTagText, assetNameText, categoryText, methodText, usefulLifeText, purchaseDateText, purchasePriceText, accumulatedText, bookValueText}
+
+	drawHeader := func(y float64) {
+		pdf.SetFillColor(68, 114, 196) // Blue background
+		pdf.RectFromUpperLeftWithStyle(marginLeft, y, contentWidth, 25, "F")
+		pdf.SetTextColor(255, 255, 255) // White text
+		pdf.SetFont("noto-bold", "", 9)
+
+		x := marginLeft
+		for i, header := range headers {
+			pdf.SetX(x + 3)
+			pdf.SetY(y + 8)
+			pdf.Cell(nil, header)
+			x += colWidths[i]
+		}
+
+		pdf.SetTextColor(0, 0, 0)
+		pdf.SetFont("noto-regular", "", 8)
+	}
+
+	// Truncate text to fit column width
+	fitText := func(text string, maxWidth float64) string {
+		runes := []rune(text)
+		for len(runes) > 0 {
+			width, _ := pdf.MeasureTextWidth(string(runes))
+			if width <= maxWidth-6 {
+				break
+			}
+			runes = runes[:len(runes)-1]
+		}
+		return string(runes)
+	}
+
+	y := currentY
+	drawHeader(y)
+	y += 25
+
+	rowHeight := 18.0
+	for i, item := range report.Assets {
+		// Check if need new page
+		if y+rowHeight > pageHeight-40 {
+			pdf.AddPage()
+			y = marginTop
+			drawHeader(y)
+			y += 25
+		}
+
+		// Zebra striping
+		if i%2 == 1 {
+			pdf.SetFillColor(242, 242, 242)
+			pdf.RectFromUpperLeftWithStyle(marginLeft, y, contentWidth, rowHeight, "F")
+		}
+
+		method := "-"
+		if item.DepreciationMethod != nil {
+			method = string(*item.DepreciationMethod)
+		}
+
+		usefulLife := "-"
+		if item.UsefulLifeYears != nil {
+			usefulLife = fmt.Sprintf("%d", *item.UsefulLifeYears)
+		}
+
+		purchaseDate := "-"
+		if item.PurchaseDate != nil {
+			purchaseDate = item.PurchaseDate.Format("2006-01-02")
+		}
+
+		values := []string{
+			item.AssetTag,
+			item.AssetName,
+			item.CategoryName,
+			method,
+			usefulLife,
+			purchaseDate,
+			formatRupiah(item.PurchasePrice.Float64()),
+			formatRupiah(item.AccumulatedDepreciation.Float64()),
+			formatRupiah(item.BookValue.Float64()),
+		}
+
+		x := marginLeft
+		for j, value := range values {
+			pdf.SetX(x + 3)
+			pdf.SetY(y + 5)
+			pdf.Cell(nil, fitText(value, colWidths[j]))
+			x += colWidths[j]
+		}
+
+		y += rowHeight
+	}
+
+	// Footer - Summary
+	y += 15
+	if y+60 > pageHeight-40 {
+		pdf.AddPage()
+		y = marginTop
+	}
+	pdf.SetFont("noto-bold", "", 11)
+	summaryLines := []string{
+		fmt.Sprintf("%s: %d", totalAssetsText, report.Summary.TotalAssets),
+		fmt.Sprintf("%s: %s", purchasePriceText, formatRupiah(report.Summary.TotalPurchaseValue.Float64())),
+		fmt.Sprintf("%s: %s", accumulatedText, formatRupiah(report.Summary.TotalAccumulatedDepreciation.Float64())),
+		fmt.Sprintf("%s: %s", bookValueText, formatRupiah(report.Summary.TotalBookValue.Float64())),
+	}
+	for _, line := range summaryLines {
+		pdf.SetX(marginLeft)
+		pdf.SetY(y)
+		pdf.Cell(nil, line)
+		y += 16
+	}
+
+	// Get PDF bytes
+	var buf bytes.Buffer
+	if err := pdf.Write(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// exportAssetDepreciationToExcel generates Excel file for asset depreciation report
+func (s *Service) exportAssetDepreciationToExcel(report domain.AssetDepreciationReportResponse) ([]byte, error) {
+	f := excelize.NewFile()
+	defer func() {
+		if err := f.Close(); err != nil {
+			fmt.Println("Error closing Excel file:", err)
+		}
+	}()
+
+	sheetName := "Depreciation"
+	index, err := f.NewSheet(sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set active sheet
+	f.SetActiveSheet(index)
+
+	// Create header style
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 12,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#4472C4"},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Set headers
+	headers := []string{
+		"Asset Tag", "Asset Name", "Category", "Status", "Depreciation Method",
+		"Useful Life (Years)", "Purchase Date", "Purchase Price", "Salvage Value",
+		"Accumulated Depreciation", "Book Value", "Elapsed Months", "Fully Depreciated",
+	}
+
+	for col, header := range headers {
+		cell, _ := excelize.CoordinatesToCellName(col+1, 1)
+		f.SetCellValue(sheetName, cell, header)
+		f.SetCellStyle(sheetName, cell, cell, headerStyle)
+	}
+
+	// Add data
+	for row, item := range report.Assets {
+		rowNum := row + 2 // Start from row 2 (after header)
+
+		method := ""
+		if item.DepreciationMethod != nil {
+			method = string(*item.DepreciationMethod)
+		}
+
+		usefulLife := ""
+		if item.UsefulLifeYears != nil {
+			usefulLife = fmt.Sprintf("%d", *item.UsefulLifeYears)
+		}
+
+		purchaseDate := ""
+		if item.PurchaseDate != nil {
+			purchaseDate = item.PurchaseDate.Format("2006-01-02")
+		}
+
+		f.SetCellValue(sheetName, fmt.Sprintf("A%d", rowNum), item.AssetTag)
+		f.SetCellValue(sheetName, fmt.Sprintf("B%d", rowNum), item.AssetName)
+		f.SetCellValue(sheetName, fmt.Sprintf("C%d", rowNum), item.CategoryName)
+		f.SetCellValue(sheetName, fmt.Sprintf("D%d", rowNum), string(item.Status))
+		f.SetCellValue(sheetName, fmt.Sprintf("E%d", rowNum), method)
+		f.SetCellValue(sheetName, fmt.Sprintf("F%d", rowNum), usefulLife)
+		f.SetCellValue(sheetName, fmt.Sprintf("G%d", rowNum), purchaseDate)
+		f.SetCellValue(sheetName, fmt.Sprintf("H%d", rowNum), fmt.Sprintf("%.2f", item.PurchasePrice.Float64()))
+		f.SetCellValue(sheetName, fmt.Sprintf("I%d", rowNum), fmt.Sprintf("%.2f", item.SalvageValue.Float64()))
+		f.SetCellValue(sheetName, fmt.Sprintf("J%d", rowNum), fmt.Sprintf("%.2f", item.AccumulatedDepreciation.Float64()))
+		f.SetCellValue(sheetName, fmt.Sprintf("K%d", rowNum), fmt.Sprintf("%.2f", item.BookValue.Float64()))
+		f.SetCellValue(sheetName, fmt.Sprintf("L%d", rowNum), item.ElapsedMonths)
+		f.SetCellValue(sheetName, fmt.Sprintf("M%d", rowNum), item.IsFullyDepreciated)
+	}
+
+	// Summary row
+	summaryRow := len(report.Assets) + 3
+	f.SetCellValue(sheetName, fmt.Sprintf("A%d", summaryRow), "Total")
+	f.SetCellValue(sheetName, fmt.Sprintf("B%d", summaryRow), report.Summary.TotalAssets)
+	f.SetCellValue(sheetName, fmt.Sprintf("H%d", summaryRow), fmt.Sprintf("%.2f", report.Summary.TotalPurchaseValue.Float64()))
+	f.SetCellValue(sheetName, fmt.Sprintf("J%d", summaryRow), fmt.Sprintf("%.2f", report.Summary.TotalAccumulatedDepreciation.Float64()))
+	f.SetCellValue(sheetName, fmt.Sprintf("K%d", summaryRow), fmt.Sprintf("%.2f", report.Summary.TotalBookValue.Float64()))
+
+	// Auto-fit columns
+	for col := 1; col <= len(headers); col++ {
+		colName, _ := excelize.ColumnNumberToName(col)
+		f.SetColWidth(sheetName, colName, colName, 18)
+	}
+
+	// Save to buffer
+	buffer, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
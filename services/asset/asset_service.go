@@ -9,10 +9,11 @@ import (
 	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
-	"github.com/Rizz404/inventory-api/internal/client/cloudinary"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
 	"github.com/Rizz404/inventory-api/internal/notification/messages"
 	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
 	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
 	"github.com/oklog/ulid/v2"
 )
 
@@ -28,6 +29,7 @@ type Repository interface {
 	// * QUERY
 	GetAssetsPaginated(ctx context.Context, params domain.AssetParams, langCode string) ([]domain.Asset, error)
 	GetAssetsCursor(ctx context.Context, params domain.AssetParams, langCode string) ([]domain.Asset, error)
+	GetAssetSearchHighlights(ctx context.Context, assetIds []string, searchQuery string) (map[string]string, error)
 	GetAssetById(ctx context.Context, assetId string) (domain.Asset, error)
 	GetAssetByAssetTag(ctx context.Context, assetTag string) (domain.Asset, error)
 	CheckAssetExists(ctx context.Context, assetId string) (bool, error)
@@ -74,6 +76,7 @@ type AssetService interface {
 	CheckSerialNumberExists(ctx context.Context, serialNumber string) (bool, error)
 	CountAssets(ctx context.Context, params domain.AssetParams) (int64, error)
 	GetAssetStatistics(ctx context.Context) (domain.AssetStatisticsResponse, error)
+	GetAssetDepreciationReport(ctx context.Context, params domain.AssetParams, asOf time.Time, langCode string) (domain.AssetDepreciationReportResponse, error)
 	GenerateAssetTagSuggestion(ctx context.Context, payload *domain.GenerateAssetTagPayload) (domain.GenerateAssetTagResponse, error)
 	GenerateBulkAssetTags(ctx context.Context, payload *domain.GenerateBulkAssetTagsPayload) (domain.GenerateBulkAssetTagsResponse, error)
 	UploadBulkDataMatrixImages(ctx context.Context, assetTags []string, files []*multipart.FileHeader) (domain.UploadBulkDataMatrixResponse, error)
@@ -91,6 +94,10 @@ type AssetService interface {
 	ExportAssetList(ctx context.Context, payload *domain.ExportAssetListPayload, langCode string) ([]byte, string, error)
 	ExportAssetStatistics(ctx context.Context, langCode string) ([]byte, string, error)
 	ExportAssetDataMatrix(ctx context.Context, payload *domain.ExportAssetDataMatrixPayload, langCode string) ([]byte, string, error)
+	ExportAssetDepreciation(ctx context.Context, payload *domain.ExportAssetDepreciationPayload, langCode string) ([]byte, string, error)
+
+	// * JOB HANDLERS
+	HandleNotificationJob(ctx context.Context, job *domain.Job) error
 }
 
 // * NotificationService interface for creating notifications
@@ -108,29 +115,72 @@ type UserRepository interface {
 	GetUsersPaginated(ctx context.Context, params domain.UserParams) ([]domain.User, error)
 }
 
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// * WebhookService interface for emitting outbound webhook events
+type WebhookService interface {
+	Emit(ctx context.Context, eventType domain.WebhookEventType, data any) error
+}
+
+// * JobQueue interface for enqueueing background jobs in the same transaction as the mutation
+type JobQueue interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+}
+
+const (
+	assetAssignedEvent  = "assigned"
+	assetHighValueEvent = "high_value"
+	assetUpdatedEvent   = "updated"
+)
+
+// notificationJob is the payload of domain.JobTypeAssetNotification, OldAsset dan Payload hanya terisi untuk event updated
+type notificationJob struct {
+	Event      string                     `json:"event"`
+	Asset      domain.Asset               `json:"asset"`
+	AssignedTo string                     `json:"assignedTo,omitempty"`
+	OldAsset   *domain.Asset              `json:"oldAsset,omitempty"`
+	Payload    *domain.UpdateAssetPayload `json:"payload,omitempty"`
+}
+
 type Service struct {
 	Repo                Repository
-	CloudinaryClient    *cloudinary.Client
+	FileStorage         storage.Storage
 	NotificationService NotificationService
 	CategoryService     CategoryService
 	UserRepo            UserRepository
+	AuditLogService     AuditLogService
+	WebhookService      WebhookService
+	JobQueue            JobQueue
 }
 
 // * Ensure Service implements AssetService interface
 var _ AssetService = (*Service)(nil)
 
-func NewService(r Repository, cloudinaryClient *cloudinary.Client, notificationService NotificationService, categoryService CategoryService, userRepo UserRepository) AssetService {
+func NewService(r Repository, fileStorage storage.Storage, notificationService NotificationService, categoryService CategoryService, userRepo UserRepository, auditLogService AuditLogService, webhookService WebhookService, jobQueue JobQueue) AssetService {
 	return &Service{
 		Repo:                r,
-		CloudinaryClient:    cloudinaryClient,
+		FileStorage:         fileStorage,
 		NotificationService: notificationService,
 		CategoryService:     categoryService,
 		UserRepo:            userRepo,
+		AuditLogService:     auditLogService,
+		WebhookService:      webhookService,
+		JobQueue:            jobQueue,
 	}
 }
 
 // *===========================MUTATION===========================*
 func (s *Service) CreateAsset(ctx context.Context, payload *domain.CreateAssetPayload, dataMatrixImageFile *multipart.FileHeader, langCode string) (domain.AssetResponse, error) {
+	if err := checkLocationInScope(ctx, payload.LocationID); err != nil {
+		return domain.AssetResponse{}, err
+	}
+
 	// * Check if asset tag already exists
 	if tagExists, err := s.Repo.CheckAssetTagExists(ctx, payload.AssetTag); err != nil {
 		return domain.AssetResponse{}, err
@@ -147,6 +197,16 @@ func (s *Service) CreateAsset(ctx context.Context, payload *domain.CreateAssetPa
 		}
 	}
 
+	// * Validate custom attributes against the category schema
+	attributeSchema, err := s.getCategoryAttributes(ctx, payload.CategoryID)
+	if err != nil {
+		return domain.AssetResponse{}, err
+	}
+	customAttributes, err := domain.NormalizeAttributeValues(attributeSchema, payload.CustomAttributes)
+	if err != nil {
+		return domain.AssetResponse{}, err
+	}
+
 	status := domain.StatusActive
 	if payload.Status != "" {
 		status = payload.Status
@@ -160,16 +220,16 @@ func (s *Service) CreateAsset(ctx context.Context, payload *domain.CreateAssetPa
 	// * Handle data matrix image upload if file is provided
 	var dataMatrixImageURL string = ""
 	if dataMatrixImageFile != nil {
-		// Upload file to Cloudinary if client is available
-		if s.CloudinaryClient != nil {
+		// Upload file to file storage if client is available
+		if s.FileStorage != nil {
 			// Generate ULID for unique filename
 			ulidStr := ulid.Make().String()
-			uploadConfig := cloudinary.GetDataMatrixImageUploadConfig()
+			uploadConfig := storage.GetDataMatrixImageUploadConfig()
 			// Naming pattern: {assetTag}_{ulid}
 			publicID := fmt.Sprintf("%s_%s", payload.AssetTag, ulidStr)
 			uploadConfig.PublicID = &publicID
 
-			uploadResult, err := s.CloudinaryClient.UploadSingleFile(ctx, dataMatrixImageFile, uploadConfig)
+			uploadResult, err := s.FileStorage.UploadSingleFile(ctx, dataMatrixImageFile, uploadConfig)
 			if err != nil {
 				// Provide detailed error message
 				errorMsg := "Failed to upload data matrix image: " + err.Error()
@@ -177,7 +237,7 @@ func (s *Service) CreateAsset(ctx context.Context, payload *domain.CreateAssetPa
 			}
 			dataMatrixImageURL = uploadResult.SecureURL
 		} else {
-			return domain.AssetResponse{}, domain.ErrBadRequestWithKey(utils.ErrCloudinaryConfigKey)
+			return domain.AssetResponse{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
 		}
 	} else if payload.DataMatrixImageUrl != nil {
 		// Use provided data matrix image URL from JSON/form data
@@ -216,9 +276,27 @@ func (s *Service) CreateAsset(ctx context.Context, payload *domain.CreateAssetPa
 		Condition:          condition,
 		LocationID:         payload.LocationID,
 		AssignedTo:         payload.AssignedTo,
+		CustomAttributes:   customAttributes,
 	}
 
-	createdAsset, err := s.Repo.CreateAsset(ctx, &newAsset)
+	var createdAsset domain.Asset
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdAsset, err = s.Repo.CreateAsset(ctx, &newAsset)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityAsset, createdAsset.ID, createdAsset); err != nil {
+			return err
+		}
+
+		if err := s.enqueueCreateNotifications(ctx, &createdAsset, payload.AssignedTo, payload.PurchasePrice); err != nil {
+			return err
+		}
+
+		return s.WebhookService.Emit(ctx, domain.WebhookEventAssetCreated, mapper.AssetToResponse(&createdAsset, mapper.DefaultLangCode))
+	})
 	if err != nil {
 		// * Repository already handles error translation, so return directly
 		return domain.AssetResponse{}, err
@@ -232,16 +310,6 @@ func (s *Service) CreateAsset(ctx context.Context, payload *domain.CreateAssetPa
 		}
 	}
 
-	// * Send notification if asset is assigned to a user
-	if payload.AssignedTo != nil && *payload.AssignedTo != "" {
-		go s.sendAssetAssignmentNotification(context.Background(), &createdAsset, *payload.AssignedTo, true)
-	}
-
-	// * Send notification if asset is high-value (> 10 million IDR)
-	if payload.PurchasePrice != nil && *payload.PurchasePrice > 10000000 {
-		go s.sendHighValueAssetNotificationToAdmins(context.Background(), &createdAsset)
-	}
-
 	// * Convert to AssetResponse using mapper
 	return mapper.AssetToResponse(&createdAsset, langCode), nil
 }
@@ -254,6 +322,9 @@ func (s *Service) BulkCreateAssets(ctx context.Context, payload *domain.BulkCrea
 	assetTagSeen := make(map[string]struct{})
 	serialSeen := make(map[string]struct{})
 	for _, assetPayload := range payload.Assets {
+		if err := checkLocationInScope(ctx, assetPayload.LocationID); err != nil {
+			return domain.BulkCreateAssetsResponse{}, err
+		}
 		if _, exists := assetTagSeen[assetPayload.AssetTag]; exists {
 			return domain.BulkCreateAssetsResponse{}, domain.ErrConflictWithKey(utils.ErrAssetTagExistsKey)
 		}
@@ -287,6 +358,27 @@ func (s *Service) BulkCreateAssets(ctx context.Context, payload *domain.BulkCrea
 		}
 	}
 
+	// * Validate custom attributes, fetching each category schema once
+	attributeSchemas := make(map[string][]domain.CategoryAttribute)
+	customAttributes := make([]map[string]any, len(payload.Assets))
+	for i, assetPayload := range payload.Assets {
+		schema, exists := attributeSchemas[assetPayload.CategoryID]
+		if !exists {
+			var err error
+			schema, err = s.getCategoryAttributes(ctx, assetPayload.CategoryID)
+			if err != nil {
+				return domain.BulkCreateAssetsResponse{}, err
+			}
+			attributeSchemas[assetPayload.CategoryID] = schema
+		}
+
+		values, err := domain.NormalizeAttributeValues(schema, assetPayload.CustomAttributes)
+		if err != nil {
+			return domain.BulkCreateAssetsResponse{}, err
+		}
+		customAttributes[i] = values
+	}
+
 	assets := make([]domain.Asset, len(payload.Assets))
 	for i, assetPayload := range payload.Assets {
 		status := domain.StatusActive
@@ -334,10 +426,31 @@ func (s *Service) BulkCreateAssets(ctx context.Context, payload *domain.BulkCrea
 			Condition:          condition,
 			LocationID:         assetPayload.LocationID,
 			AssignedTo:         assetPayload.AssignedTo,
+			CustomAttributes:   customAttributes[i],
 		}
 	}
 
-	createdAssets, err := s.Repo.BulkCreateAssets(ctx, assets)
+	var createdAssets []domain.Asset
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdAssets, err = s.Repo.BulkCreateAssets(ctx, assets)
+		if err != nil {
+			return err
+		}
+
+		for i := range createdAssets {
+			if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityAsset, createdAssets[i].ID, createdAssets[i]); err != nil {
+				return err
+			}
+			if err := s.enqueueCreateNotifications(ctx, &createdAssets[i], payload.Assets[i].AssignedTo, payload.Assets[i].PurchasePrice); err != nil {
+				return err
+			}
+			if err := s.WebhookService.Emit(ctx, domain.WebhookEventAssetCreated, mapper.AssetToResponse(&createdAssets[i], mapper.DefaultLangCode)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkCreateAssetsResponse{}, err
 	}
@@ -350,14 +463,6 @@ func (s *Service) BulkCreateAssets(ctx context.Context, payload *domain.BulkCrea
 				// Continue with other assets, don't fail entire bulk operation
 			}
 		}
-
-		if payload.Assets[i].AssignedTo != nil && *payload.Assets[i].AssignedTo != "" {
-			go s.sendAssetAssignmentNotification(context.Background(), &createdAssets[i], *payload.Assets[i].AssignedTo, true)
-		}
-
-		if payload.Assets[i].PurchasePrice != nil && *payload.Assets[i].PurchasePrice > 10000000 {
-			go s.sendHighValueAssetNotificationToAdmins(context.Background(), &createdAssets[i])
-		}
 	}
 
 	response := domain.BulkCreateAssetsResponse{
@@ -374,6 +479,13 @@ func (s *Service) UpdateAsset(ctx context.Context, assetId string, payload *doma
 		return domain.AssetResponse{}, err
 	}
 
+	// * User yang dibatasi lokasi tidak boleh memindahkan asset keluar dari lokasinya
+	if payload.LocationID != nil {
+		if err := checkLocationInScope(ctx, payload.LocationID); err != nil {
+			return domain.AssetResponse{}, err
+		}
+	}
+
 	// * Validate: if category changes, asset tag and data matrix must be provided
 	if payload.CategoryID != nil && *payload.CategoryID != existingAsset.CategoryID {
 		if payload.AssetTag == nil || *payload.AssetTag == "" {
@@ -402,33 +514,64 @@ func (s *Service) UpdateAsset(ctx context.Context, assetId string, payload *doma
 		}
 	}
 
+	// * Merge and validate custom attributes when they or the category change
+	categoryChanged := payload.CategoryID != nil && *payload.CategoryID != existingAsset.CategoryID
+	if payload.CustomAttributes != nil || categoryChanged {
+		categoryId := existingAsset.CategoryID
+		if categoryChanged {
+			categoryId = *payload.CategoryID
+		}
+
+		attributeSchema, err := s.getCategoryAttributes(ctx, categoryId)
+		if err != nil {
+			return domain.AssetResponse{}, err
+		}
+
+		// Values not defined by the new category are dropped when the category changes
+		schemaKeys := make(map[string]struct{}, len(attributeSchema))
+		for _, attribute := range attributeSchema {
+			schemaKeys[attribute.Key] = struct{}{}
+		}
+
+		merged := make(map[string]any)
+		for key, value := range existingAsset.CustomAttributes {
+			if _, defined := schemaKeys[key]; defined || !categoryChanged {
+				merged[key] = value
+			}
+		}
+		for key, value := range payload.CustomAttributes {
+			if value == nil {
+				delete(merged, key)
+				continue
+			}
+			merged[key] = value
+		}
+
+		customAttributes, err := domain.NormalizeAttributeValues(attributeSchema, merged)
+		if err != nil {
+			return domain.AssetResponse{}, err
+		}
+		payload.CustomAttributes = customAttributes
+	}
+
 	// * Handle data matrix image update
 	var shouldDeleteOldImage bool
 	// Extract old public ID from URL if exists
 	oldImagePublicID := ""
-	if existingAsset.DataMatrixImageUrl != "" && strings.Contains(existingAsset.DataMatrixImageUrl, "sigma-asset/datamatrix/") {
-		// Extract public ID from Cloudinary URL
-		// Format: https://res.cloudinary.com/.../sigma-asset/datamatrix/{assetTag}_{ulid}.ext
-		parts := strings.Split(existingAsset.DataMatrixImageUrl, "/")
-		for i, part := range parts {
-			if part == "datamatrix" && i+1 < len(parts) {
-				// Get filename with extension, remove extension
-				fileWithExt := parts[i+1]
-				lastDot := strings.LastIndex(fileWithExt, ".")
-				if lastDot > 0 {
-					oldImagePublicID = "sigma-asset/datamatrix/" + fileWithExt[:lastDot]
-				}
-				break
-			}
+	if existingAsset.DataMatrixImageUrl != "" && s.FileStorage != nil {
+		// Public ID format: sigma-asset/datamatrix/{assetTag}_{ulid}, URL dari luar storage menghasilkan string kosong
+		oldImagePublicID = s.FileStorage.ExtractPublicIDFromURL(existingAsset.DataMatrixImageUrl)
+		if !strings.HasPrefix(oldImagePublicID, "sigma-asset/datamatrix/") {
+			oldImagePublicID = ""
 		}
 	}
 
 	if dataMatrixImageFile != nil {
 		// Upload new data matrix image file
-		if s.CloudinaryClient != nil {
+		if s.FileStorage != nil {
 			// Generate ULID for unique filename
 			ulidStr := ulid.Make().String()
-			uploadConfig := cloudinary.GetDataMatrixImageUploadConfig()
+			uploadConfig := storage.GetDataMatrixImageUploadConfig()
 			// Use asset tag from existing asset or payload
 			assetTag := existingAsset.AssetTag
 			if payload.AssetTag != nil {
@@ -438,7 +581,7 @@ func (s *Service) UpdateAsset(ctx context.Context, assetId string, payload *doma
 			publicID := fmt.Sprintf("%s_%s", assetTag, ulidStr)
 			uploadConfig.PublicID = &publicID
 
-			uploadResult, err := s.CloudinaryClient.UploadSingleFile(ctx, dataMatrixImageFile, uploadConfig)
+			uploadResult, err := s.FileStorage.UploadSingleFile(ctx, dataMatrixImageFile, uploadConfig)
 			if err != nil {
 				return domain.AssetResponse{}, domain.ErrBadRequest("Failed to upload data matrix image: " + err.Error())
 			}
@@ -447,7 +590,7 @@ func (s *Service) UpdateAsset(ctx context.Context, assetId string, payload *doma
 			payload.DataMatrixImageUrl = &uploadResult.SecureURL
 			shouldDeleteOldImage = true
 		} else {
-			return domain.AssetResponse{}, domain.ErrBadRequestWithKey(utils.ErrCloudinaryConfigKey)
+			return domain.AssetResponse{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
 		}
 	} else if payload.DataMatrixImageUrl != nil {
 		// Handle data matrix image URL changes from JSON/form data
@@ -459,24 +602,44 @@ func (s *Service) UpdateAsset(ctx context.Context, assetId string, payload *doma
 		// If payload.DataMatrixImageUrl has a valid URL, it will be used as-is
 	}
 
-	// * Update asset and convert to AssetResponse using mapper
-	updatedAsset, err := s.Repo.UpdateAsset(ctx, assetId, payload)
+	// * Update asset and queue the notifications for changes
+	var updatedAsset domain.Asset
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedAsset, err = s.Repo.UpdateAsset(ctx, assetId, payload)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityAsset, assetId, existingAsset, updatedAsset); err != nil {
+			return err
+		}
+
+		err = s.JobQueue.Enqueue(ctx, domain.JobTypeAssetNotification, notificationJob{
+			Event:    assetUpdatedEvent,
+			Asset:    updatedAsset,
+			OldAsset: &existingAsset,
+			Payload:  payload,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.emitUpdateWebhooks(ctx, &existingAsset, &updatedAsset, payload)
+	})
 	if err != nil {
 		return domain.AssetResponse{}, err
 	}
 
-	// * Delete old data matrix image from Cloudinary if needed
-	if shouldDeleteOldImage && s.CloudinaryClient != nil && oldImagePublicID != "" {
-		err = s.CloudinaryClient.DeleteFile(ctx, oldImagePublicID)
+	// * Delete old data matrix image from file storage if needed
+	if shouldDeleteOldImage && s.FileStorage != nil && oldImagePublicID != "" {
+		err = s.FileStorage.DeleteFile(ctx, oldImagePublicID)
 		if err != nil {
 			log.Printf("Failed to delete old data matrix image: %v", err)
 		}
 		// Note: We don't return error here to avoid failing asset update if image deletion fails
 	}
 
-	// * Send notifications for changes
-	go s.sendUpdateNotifications(context.Background(), &existingAsset, &updatedAsset, payload)
-
 	return mapper.AssetToResponse(&updatedAsset, langCode), nil
 }
 
@@ -487,23 +650,25 @@ func (s *Service) DeleteAsset(ctx context.Context, assetId string) error {
 		return err
 	}
 
-	// * Delete data matrix image from Cloudinary if exists
-	if asset.DataMatrixImageUrl != "" && s.CloudinaryClient != nil {
-		publicID := cloudinary.ExtractPublicIDFromURL(asset.DataMatrixImageUrl)
+	// * Delete data matrix image from file storage if exists
+	if asset.DataMatrixImageUrl != "" && s.FileStorage != nil {
+		publicID := s.FileStorage.ExtractPublicIDFromURL(asset.DataMatrixImageUrl)
 		if publicID != "" {
-			if err := s.CloudinaryClient.DeleteFile(ctx, publicID); err != nil {
-				log.Printf("Warning: Failed to delete data matrix image from Cloudinary for asset %s: %v", assetId, err)
-				// * Continue with asset deletion even if Cloudinary deletion fails
+			if err := s.FileStorage.DeleteFile(ctx, publicID); err != nil {
+				log.Printf("Warning: Failed to delete data matrix image from file storage for asset %s: %v", assetId, err)
+				// * Continue with asset deletion even if file storage deletion fails
 			}
 		}
 	}
 
 	// * Delete asset from database
-	err = s.Repo.DeleteAsset(ctx, assetId)
-	if err != nil {
-		return err
-	}
-	return nil
+	return s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteAsset(ctx, assetId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityAsset, assetId, asset)
+	})
 }
 
 func (s *Service) BulkDeleteAssets(ctx context.Context, payload *domain.BulkDeleteAssetsPayload) (domain.BulkDeleteAssetsResponse, error) {
@@ -513,7 +678,7 @@ func (s *Service) BulkDeleteAssets(ctx context.Context, payload *domain.BulkDele
 	}
 
 	// * Get assets data to retrieve data matrix image URLs before deletion
-	if s.CloudinaryClient != nil {
+	if s.FileStorage != nil {
 		publicIDsToDelete := []string{}
 		for _, assetId := range payload.IDS {
 			asset, err := s.Repo.GetAssetById(ctx, assetId)
@@ -522,29 +687,43 @@ func (s *Service) BulkDeleteAssets(ctx context.Context, payload *domain.BulkDele
 				continue
 			}
 			if asset.DataMatrixImageUrl != "" {
-				publicID := cloudinary.ExtractPublicIDFromURL(asset.DataMatrixImageUrl)
+				publicID := s.FileStorage.ExtractPublicIDFromURL(asset.DataMatrixImageUrl)
 				if publicID != "" {
 					publicIDsToDelete = append(publicIDsToDelete, publicID)
 				}
 			}
 		}
 
-		// * Delete data matrix images from Cloudinary in batch
+		// * Delete data matrix images from file storage in batch
 		if len(publicIDsToDelete) > 0 {
-			deletedCount, failedIDs, err := s.CloudinaryClient.DeleteMultipleFiles(ctx, publicIDsToDelete)
+			deletedCount, failedIDs, err := s.FileStorage.DeleteMultipleFiles(ctx, publicIDsToDelete)
 			if err != nil {
-				log.Printf("Warning: Failed to delete some data matrix images from Cloudinary: %v", err)
+				log.Printf("Warning: Failed to delete some data matrix images from file storage: %v", err)
 			}
 			if len(failedIDs) > 0 {
-				log.Printf("Warning: Failed to delete %d data matrix images from Cloudinary: %v", len(failedIDs), failedIDs)
+				log.Printf("Warning: Failed to delete %d data matrix images from file storage: %v", len(failedIDs), failedIDs)
 			}
-			log.Printf("Successfully deleted %d data matrix images from Cloudinary", deletedCount)
-			// * Continue with asset deletion even if some Cloudinary deletions fail
+			log.Printf("Successfully deleted %d data matrix images from file storage", deletedCount)
+			// * Continue with asset deletion even if some file storage deletions fail
 		}
 	}
 
 	// * Perform bulk delete operation
-	result, err := s.Repo.BulkDeleteAssets(ctx, payload.IDS)
+	var result domain.BulkDeleteAssets
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.Repo.BulkDeleteAssets(ctx, payload.IDS)
+		if err != nil {
+			return err
+		}
+
+		for _, deletedId := range result.DeletedIDS {
+			if err := s.AuditLogService.RecordDelete(ctx, domain.AuditEntityAsset, deletedId, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkDeleteAssetsResponse{}, err
 	}
@@ -571,7 +750,12 @@ func (s *Service) GetAssetsPaginated(ctx context.Context, params domain.AssetPar
 		return nil, 0, err
 	}
 
-	return mapper.AssetsToResponses(assets, langCode), count, nil
+	responses, err := s.withSearchHighlights(ctx, mapper.AssetsToResponses(assets, langCode), params.SearchQuery)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return responses, count, nil
 }
 
 func (s *Service) GetAssetsCursor(ctx context.Context, params domain.AssetParams, langCode string) ([]domain.AssetResponse, error) {
@@ -580,7 +764,7 @@ func (s *Service) GetAssetsCursor(ctx context.Context, params domain.AssetParams
 		return nil, err
 	}
 
-	return mapper.AssetsToResponses(assets, langCode), nil
+	return s.withSearchHighlights(ctx, mapper.AssetsToResponses(assets, langCode), params.SearchQuery)
 }
 
 func (s *Service) GetAssetById(ctx context.Context, assetId string, langCode string) (domain.AssetResponse, error) {
@@ -641,6 +825,15 @@ func (s *Service) GetAssetStatistics(ctx context.Context) (domain.AssetStatistic
 	return mapper.AssetStatisticsToResponse(&stats), nil
 }
 
+func (s *Service) GetAssetDepreciationReport(ctx context.Context, params domain.AssetParams, asOf time.Time, langCode string) (domain.AssetDepreciationReportResponse, error) {
+	assets, err := s.Repo.GetAssetsForExport(ctx, params, langCode)
+	if err != nil {
+		return domain.AssetDepreciationReportResponse{}, err
+	}
+
+	return mapper.AssetsToDepreciationReport(assets, asOf, langCode), nil
+}
+
 // GenerateAssetTagSuggestion generates a suggested asset tag based on category code
 func (s *Service) GenerateAssetTagSuggestion(ctx context.Context, payload *domain.GenerateAssetTagPayload) (domain.GenerateAssetTagResponse, error) {
 	// * Get category to retrieve CategoryCode
@@ -737,7 +930,7 @@ func (s *Service) GenerateBulkAssetTags(ctx context.Context, payload *domain.Gen
 	}, nil
 }
 
-// UploadBulkDataMatrixImages uploads multiple data matrix images to Cloudinary
+// UploadBulkDataMatrixImages uploads multiple data matrix images to file storage
 func (s *Service) UploadBulkDataMatrixImages(ctx context.Context, assetTags []string, files []*multipart.FileHeader) (domain.UploadBulkDataMatrixResponse, error) {
 	if len(files) == 0 {
 		return domain.UploadBulkDataMatrixResponse{}, domain.ErrBadRequest("at least one file is required")
@@ -751,8 +944,8 @@ func (s *Service) UploadBulkDataMatrixImages(ctx context.Context, assetTags []st
 		return domain.UploadBulkDataMatrixResponse{}, domain.ErrBadRequest("number of asset tags must match number of files")
 	}
 
-	if s.CloudinaryClient == nil {
-		return domain.UploadBulkDataMatrixResponse{}, domain.ErrInternal(fmt.Errorf("cloudinary client not configured"))
+	if s.FileStorage == nil {
+		return domain.UploadBulkDataMatrixResponse{}, domain.ErrInternal(fmt.Errorf("file storage not configured"))
 	}
 
 	// Prepare public IDs for each file
@@ -764,18 +957,18 @@ func (s *Service) UploadBulkDataMatrixImages(ctx context.Context, assetTags []st
 	}
 
 	// Get bulk upload config for data matrix images
-	baseConfig := cloudinary.GetBulkDataMatrixImageUploadConfig()
+	baseConfig := storage.GetBulkDataMatrixImageUploadConfig()
 
-	log.Printf("Starting bulk upload of %d files to Cloudinary", len(files))
+	log.Printf("Starting bulk upload of %d files to file storage", len(files))
 
 	// Upload all files using efficient bulk upload method
-	uploadResult, err := s.CloudinaryClient.UploadMultipleFilesWithPublicIDs(ctx, files, publicIDs, baseConfig)
+	uploadResult, err := s.FileStorage.UploadMultipleFilesWithPublicIDs(ctx, files, publicIDs, baseConfig)
 	if err != nil {
-		log.Printf("ERROR: Bulk upload to Cloudinary failed: %v", err)
+		log.Printf("ERROR: Bulk upload to file storage failed: %v", err)
 		return domain.UploadBulkDataMatrixResponse{}, domain.ErrInternal(err)
 	}
 
-	log.Printf("Cloudinary upload completed: %d succeeded, %d failed", len(uploadResult.Results), len(uploadResult.Failed))
+	log.Printf("File storage upload completed: %d succeeded, %d failed", len(uploadResult.Results), len(uploadResult.Failed))
 
 	// Log detailed failures
 	for _, failure := range uploadResult.Failed {
@@ -814,7 +1007,7 @@ func (s *Service) UploadBulkDataMatrixImages(ctx context.Context, assetTags []st
 	}, nil
 }
 
-// DeleteBulkDataMatrixImages deletes data matrix images from Cloudinary and nullifies DB field
+// DeleteBulkDataMatrixImages deletes data matrix images from file storage and nullifies DB field
 func (s *Service) DeleteBulkDataMatrixImages(ctx context.Context, payload *domain.DeleteBulkDataMatrixPayload) (domain.DeleteBulkDataMatrixResponse, error) {
 	if len(payload.AssetTags) == 0 {
 		return domain.DeleteBulkDataMatrixResponse{}, domain.ErrBadRequest("at least one asset tag is required")
@@ -824,8 +1017,8 @@ func (s *Service) DeleteBulkDataMatrixImages(ctx context.Context, payload *domai
 		return domain.DeleteBulkDataMatrixResponse{}, domain.ErrBadRequest("maximum 100 asset tags allowed")
 	}
 
-	if s.CloudinaryClient == nil {
-		return domain.DeleteBulkDataMatrixResponse{}, domain.ErrInternal(fmt.Errorf("cloudinary client not configured"))
+	if s.FileStorage == nil {
+		return domain.DeleteBulkDataMatrixResponse{}, domain.ErrInternal(fmt.Errorf("file storage not configured"))
 	}
 
 	deletedCount := 0
@@ -848,17 +1041,17 @@ func (s *Service) DeleteBulkDataMatrixImages(ctx context.Context, payload *domai
 			continue
 		}
 
-		// Extract public ID from Cloudinary URL
-		publicID := cloudinary.ExtractPublicIDFromURL(asset.DataMatrixImageUrl)
+		// Extract public ID from file storage URL
+		publicID := s.FileStorage.ExtractPublicIDFromURL(asset.DataMatrixImageUrl)
 		if publicID == "" {
 			failedTags = append(failedTags, tag)
 			log.Printf("Failed to extract public ID from URL for tag %s", tag)
 			continue
 		}
 
-		// Delete from Cloudinary
-		if err := s.CloudinaryClient.DeleteFile(ctx, publicID); err != nil {
-			log.Printf("Failed to delete file from Cloudinary for tag %s: %v", tag, err)
+		// Delete from file storage
+		if err := s.FileStorage.DeleteFile(ctx, publicID); err != nil {
+			log.Printf("Failed to delete file from file storage for tag %s: %v", tag, err)
 			// Continue anyway to nullify DB field
 		}
 
@@ -887,30 +1080,55 @@ func (s *Service) DeleteBulkDataMatrixImages(ctx context.Context, payload *domai
 
 // *===========================HELPER METHODS===========================*
 
-// uploadAndAttachAssetImages uploads images to Cloudinary and attaches them to an asset
+// withSearchHighlights fills the highlighted snippet of each asset when the list comes from a search
+func (s *Service) withSearchHighlights(ctx context.Context, responses []domain.AssetResponse, searchQuery *string) ([]domain.AssetResponse, error) {
+	if searchQuery == nil || *searchQuery == "" || len(responses) == 0 {
+		return responses, nil
+	}
+
+	assetIds := make([]string, len(responses))
+	for i, response := range responses {
+		assetIds[i] = response.ID
+	}
+
+	highlights, err := s.Repo.GetAssetSearchHighlights(ctx, assetIds, *searchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range responses {
+		if highlight, ok := highlights[responses[i].ID]; ok {
+			responses[i].Highlight = &highlight
+		}
+	}
+
+	return responses, nil
+}
+
+// uploadAndAttachAssetImages uploads images to file storage and attaches them to an asset
 // Uses many-to-many relationship with image reusability via public_id deduplication
 func (s *Service) uploadAndAttachAssetImages(ctx context.Context, assetID string, imageFiles []*multipart.FileHeader) error {
 	if len(imageFiles) == 0 {
 		return nil
 	}
 
-	if s.CloudinaryClient == nil {
-		return fmt.Errorf("cloudinary client not configured")
+	if s.FileStorage == nil {
+		return fmt.Errorf("file storage not configured")
 	}
 
 	// Get upload config for asset images
-	uploadConfig := cloudinary.GetAssetImageUploadConfig()
+	uploadConfig := storage.GetAssetImageUploadConfig()
 
-	log.Printf("Starting upload of %d asset images to Cloudinary", len(imageFiles))
+	log.Printf("Starting upload of %d asset images to file storage", len(imageFiles))
 
-	// Upload all files to Cloudinary (auto-generated publicIDs for potential reuse)
-	uploadResult, err := s.CloudinaryClient.UploadMultipleFiles(ctx, imageFiles, uploadConfig)
+	// Upload all files to file storage (auto-generated publicIDs for potential reuse)
+	uploadResult, err := s.FileStorage.UploadMultipleFiles(ctx, imageFiles, uploadConfig)
 	if err != nil {
-		log.Printf("ERROR: Asset images upload to Cloudinary failed: %v", err)
-		return fmt.Errorf("failed to upload images to cloudinary: %w", err)
+		log.Printf("ERROR: Asset images upload to file storage failed: %v", err)
+		return fmt.Errorf("failed to upload images to file storage: %w", err)
 	}
 
-	log.Printf("Cloudinary upload completed: %d succeeded, %d failed", len(uploadResult.Results), len(uploadResult.Failed))
+	log.Printf("File storage upload completed: %d succeeded, %d failed", len(uploadResult.Results), len(uploadResult.Failed))
 
 	// Log detailed failures
 	for _, failure := range uploadResult.Failed {
@@ -967,7 +1185,7 @@ func (s *Service) uploadAndAttachAssetImages(ctx context.Context, assetID string
 
 // *===========================TEMPLATE IMAGES (FOR BULK CREATE)===========================*
 
-// UploadTemplateImages uploads images to Cloudinary for later reuse in bulk asset creation
+// UploadTemplateImages uploads images to file storage for later reuse in bulk asset creation
 // These images are not attached to any asset yet, just stored in images table
 func (s *Service) UploadTemplateImages(ctx context.Context, files []*multipart.FileHeader) (domain.UploadTemplateImagesResponse, error) {
 	if len(files) == 0 {
@@ -978,23 +1196,23 @@ func (s *Service) UploadTemplateImages(ctx context.Context, files []*multipart.F
 		return domain.UploadTemplateImagesResponse{}, domain.ErrBadRequest("maximum 10 template images per request")
 	}
 
-	if s.CloudinaryClient == nil {
-		return domain.UploadTemplateImagesResponse{}, domain.ErrInternalWithMessage("cloudinary client not configured")
+	if s.FileStorage == nil {
+		return domain.UploadTemplateImagesResponse{}, domain.ErrInternalWithMessage("file storage not configured")
 	}
 
 	// Get upload config for template images (same folder as regular asset images)
-	uploadConfig := cloudinary.GetAssetImageUploadConfig()
+	uploadConfig := storage.GetAssetImageUploadConfig()
 	// No need to override folder, use default sigma-asset/assets
 
-	log.Printf("Starting upload of %d template images to Cloudinary", len(files))
+	log.Printf("Starting upload of %d template images to file storage", len(files))
 
-	// Upload all files to Cloudinary (auto-generated publicIDs for reusability)
-	uploadResult, err := s.CloudinaryClient.UploadMultipleFiles(ctx, files, uploadConfig)
+	// Upload all files to file storage (auto-generated publicIDs for reusability)
+	uploadResult, err := s.FileStorage.UploadMultipleFiles(ctx, files, uploadConfig)
 	if err != nil {
 		return domain.UploadTemplateImagesResponse{}, domain.ErrInternalWithMessage(fmt.Sprintf("failed to upload template images: %v", err))
 	}
 
-	log.Printf("Cloudinary upload completed: %d succeeded, %d failed", len(uploadResult.Results), len(uploadResult.Failed))
+	log.Printf("File storage upload completed: %d succeeded, %d failed", len(uploadResult.Results), len(uploadResult.Failed))
 
 	// Log detailed failures
 	for _, failure := range uploadResult.Failed {
@@ -1050,8 +1268,8 @@ func (s *Service) attachImageUrlsToAsset(ctx context.Context, assetID string, im
 	displayOrders := make([]int, 0, len(imageUrls))
 
 	for i, imageUrl := range imageUrls {
-		// Extract public_id from Cloudinary URL for lookup
-		publicID := cloudinary.ExtractPublicIDFromURL(imageUrl)
+		// Extract public_id from file storage URL for lookup
+		publicID := s.FileStorage.ExtractPublicIDFromURL(imageUrl)
 		if publicID == "" {
 			log.Printf("Failed to extract public_id from URL: %s", imageUrl)
 			continue
@@ -1105,7 +1323,7 @@ func (s *Service) GetAvailableAssetImages(ctx context.Context, limit int, cursor
 	return responses, nil
 }
 
-// UploadBulkAssetImages uploads images to Cloudinary and attaches them to their respective assets
+// UploadBulkAssetImages uploads images to file storage and attaches them to their respective assets
 // This is an independent operation that can be called separately from asset creation/update
 func (s *Service) UploadBulkAssetImages(ctx context.Context, assetIds []string, files []*multipart.FileHeader) (domain.UploadBulkAssetImagesResponse, error) {
 	// Validate inputs
@@ -1121,9 +1339,9 @@ func (s *Service) UploadBulkAssetImages(ctx context.Context, assetIds []string,
 		return domain.UploadBulkAssetImagesResponse{}, domain.ErrBadRequest("maximum 100 images per request")
 	}
 
-	// Check Cloudinary client availability
-	if s.CloudinaryClient == nil {
-		return domain.UploadBulkAssetImagesResponse{}, domain.ErrInternalWithMessage("cloudinary client not configured")
+	// Check file storage availability
+	if s.FileStorage == nil {
+		return domain.UploadBulkAssetImagesResponse{}, domain.ErrInternalWithMessage("file storage not configured")
 	}
 
 	// Verify all assets exist before uploading
@@ -1138,13 +1356,13 @@ func (s *Service) UploadBulkAssetImages(ctx context.Context, assetIds []string,
 	}
 
 	// Get upload config
-	uploadConfig := cloudinary.GetAssetImageUploadConfig()
+	uploadConfig := storage.GetAssetImageUploadConfig()
 
-	// Upload all files to Cloudinary
-	uploadResult, err := s.CloudinaryClient.UploadMultipleFiles(ctx, files, uploadConfig)
+	// Upload all files to file storage
+	uploadResult, err := s.FileStorage.UploadMultipleFiles(ctx, files, uploadConfig)
 	if err != nil {
 		log.Printf("ERROR: Bulk asset images upload failed: %v", err)
-		return domain.UploadBulkAssetImagesResponse{}, fmt.Errorf("failed to upload images to cloudinary: %w", err)
+		return domain.UploadBulkAssetImagesResponse{}, fmt.Errorf("failed to upload images to file storage: %w", err)
 	}
 
 	// Process results
@@ -1265,6 +1483,73 @@ func (s *Service) DeleteBulkAssetImages(ctx context.Context, payload *domain.Del
 	}, nil
 }
 
+// * checkLocationInScope rejects locations outside the requesting user's location scope
+func checkLocationInScope(ctx context.Context, locationId *string) error {
+	if _, scoped := web.GetLocationScopeFromRequestContext(ctx); !scoped {
+		return nil
+	}
+	if locationId == nil || !web.IsLocationInRequestScope(ctx, *locationId) {
+		return domain.ErrForbiddenWithKey(utils.ErrLocationOutOfScopeKey)
+	}
+	return nil
+}
+
+// * getCategoryAttributes returns the custom attribute schema of a category
+func (s *Service) getCategoryAttributes(ctx context.Context, categoryId string) ([]domain.CategoryAttribute, error) {
+	category, err := s.CategoryService.GetCategoryById(ctx, categoryId, mapper.DefaultLangCode)
+	if err != nil {
+		return nil, err
+	}
+	return category.Attributes, nil
+}
+
+// HandleNotificationJob sends the asset notifications queued by create and update
+func (s *Service) HandleNotificationJob(ctx context.Context, job *domain.Job) error {
+	var payload notificationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	switch payload.Event {
+	case assetAssignedEvent:
+		s.sendAssetAssignmentNotification(ctx, &payload.Asset, payload.AssignedTo, true)
+	case assetHighValueEvent:
+		s.sendHighValueAssetNotificationToAdmins(ctx, &payload.Asset)
+	case assetUpdatedEvent:
+		if payload.OldAsset == nil || payload.Payload == nil {
+			return fmt.Errorf("asset update notification job %s is missing the previous asset or payload", job.ID)
+		}
+		s.sendUpdateNotifications(ctx, payload.OldAsset, &payload.Asset, payload.Payload)
+	default:
+		log.Printf("Unknown asset notification event %q in job %s", payload.Event, job.ID)
+	}
+	return nil
+}
+
+// enqueueCreateNotifications queues the assignment and high-value notifications of a new asset
+func (s *Service) enqueueCreateNotifications(ctx context.Context, asset *domain.Asset, assignedTo *string, purchasePrice *float64) error {
+	// * Send notification if asset is assigned to a user
+	if assignedTo != nil && *assignedTo != "" {
+		err := s.JobQueue.Enqueue(ctx, domain.JobTypeAssetNotification, notificationJob{
+			Event:      assetAssignedEvent,
+			Asset:      *asset,
+			AssignedTo: *assignedTo,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// * Send notification if asset is high-value (> 10 million IDR)
+	if purchasePrice != nil && *purchasePrice > 10000000 {
+		return s.JobQueue.Enqueue(ctx, domain.JobTypeAssetNotification, notificationJob{
+			Event: assetHighValueEvent,
+			Asset: *asset,
+		})
+	}
+	return nil
+}
+
 // sendUpdateNotifications sends all relevant notifications when asset is updated
 func (s *Service) sendUpdateNotifications(ctx context.Context, oldAsset, newAsset *domain.Asset, payload *domain.UpdateAssetPayload) {
 	// Skip if notification service is not available
@@ -1297,6 +1582,52 @@ func (s *Service) sendUpdateNotifications(ctx context.Context, oldAsset, newAsse
 	// This would require fetching location names, so skipping for now
 }
 
+// emitUpdateWebhooks queues webhook events for the same changes that trigger update notifications
+func (s *Service) emitUpdateWebhooks(ctx context.Context, oldAsset, newAsset *domain.Asset, payload *domain.UpdateAssetPayload) error {
+	assetResponse := mapper.AssetToResponse(newAsset, mapper.DefaultLangCode)
+
+	if payload.AssignedTo != nil {
+		if *payload.AssignedTo != "" && (oldAsset.AssignedTo == nil || *oldAsset.AssignedTo != *payload.AssignedTo) {
+			err := s.WebhookService.Emit(ctx, domain.WebhookEventAssetAssigned, domain.WebhookAssetAssignmentData{
+				Asset:                assetResponse,
+				AssignedToID:         payload.AssignedTo,
+				PreviousAssignedToID: oldAsset.AssignedTo,
+			})
+			if err != nil {
+				return err
+			}
+		} else if *payload.AssignedTo == "" && oldAsset.AssignedTo != nil && *oldAsset.AssignedTo != "" {
+			err := s.WebhookService.Emit(ctx, domain.WebhookEventAssetUnassigned, domain.WebhookAssetAssignmentData{
+				Asset:                assetResponse,
+				PreviousAssignedToID: oldAsset.AssignedTo,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if payload.Status != nil && *payload.Status != oldAsset.Status {
+		err := s.WebhookService.Emit(ctx, domain.WebhookEventAssetStatusChanged, domain.WebhookAssetStatusChangedData{
+			Asset:          assetResponse,
+			PreviousStatus: oldAsset.Status,
+			Status:         *payload.Status,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if payload.Condition != nil && *payload.Condition != oldAsset.Condition {
+		return s.WebhookService.Emit(ctx, domain.WebhookEventAssetConditionChanged, domain.WebhookAssetConditionChangedData{
+			Asset:             assetResponse,
+			PreviousCondition: oldAsset.Condition,
+			Condition:         *payload.Condition,
+		})
+	}
+	return nil
+}
+
 // sendAssetAssignmentNotification sends notification when asset is assigned to a user
 func (s *Service) sendAssetAssignmentNotification(ctx context.Context, asset *domain.Asset, userId string, isNewAsset bool) {
 	// Skip if notification service is not available
@@ -3,27 +3,37 @@ package asset
 import (
 	"context"
 	"log"
+	"strconv"
+	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/notification/messages"
 	"github.com/robfig/cron/v3"
 )
 
+// LoanRepository defines the asset loan queries used by scheduled tasks
+type LoanRepository interface {
+	GetAssetLoansDueSoon(ctx context.Context, daysFromNow int) ([]domain.AssetLoan, error)
+	GetOverdueAssetLoans(ctx context.Context) ([]domain.AssetLoan, error)
+}
+
 // CronService manages scheduled tasks for assets
 type CronService struct {
 	cron                *cron.Cron
 	assetRepo           Repository
+	loanRepo            LoanRepository
 	notificationService NotificationService
 }
 
 // NewCronService creates a new cron service instance
-func NewCronService(assetRepo Repository, notificationService NotificationService) *CronService {
+func NewCronService(assetRepo Repository, loanRepo LoanRepository, notificationService NotificationService) *CronService {
 	// Create cron instance with seconds field support
 	c := cron.New(cron.WithSeconds())
 
 	return &CronService{
 		cron:                c,
 		assetRepo:           assetRepo,
+		loanRepo:            loanRepo,
 		notificationService: notificationService,
 	}
 }
@@ -42,6 +52,18 @@ func (cs *CronService) Start() error {
 		return err
 	}
 
+	// Check asset loans due soon daily at 8:00 AM
+	_, err = cs.cron.AddFunc("0 0 8 * * *", cs.checkLoansDueSoon)
+	if err != nil {
+		return err
+	}
+
+	// Check overdue asset loans daily at 8:30 AM
+	_, err = cs.cron.AddFunc("0 30 8 * * *", cs.checkOverdueLoans)
+	if err != nil {
+		return err
+	}
+
 	cs.cron.Start()
 	log.Println("Asset cron service started successfully")
 	return nil
@@ -98,6 +120,47 @@ func (cs *CronService) checkExpiredWarranties() {
 	log.Printf("Expired warranty check completed. Found %d assets with expired warranties", len(assets))
 }
 
+// checkLoansDueSoon checks for active asset loans due within 2 days
+func (cs *CronService) checkLoansDueSoon() {
+	ctx := context.Background()
+	log.Println("Running asset loan due soon check...")
+
+	loans, err := cs.loanRepo.GetAssetLoansDueSoon(ctx, 2)
+	if err != nil {
+		log.Printf("Failed to fetch asset loans due soon: %v", err)
+		return
+	}
+
+	// Remind each borrower to return the asset
+	for _, loan := range loans {
+		cs.sendLoanDueSoonNotification(ctx, &loan)
+	}
+
+	log.Printf("Asset loan due soon check completed. Found %d loans due within 2 days", len(loans))
+}
+
+// checkOverdueLoans checks for active asset loans past their expected return date
+func (cs *CronService) checkOverdueLoans() {
+	ctx := context.Background()
+	log.Println("Running overdue asset loan check...")
+
+	loans, err := cs.loanRepo.GetOverdueAssetLoans(ctx)
+	if err != nil {
+		log.Printf("Failed to fetch overdue asset loans: %v", err)
+		return
+	}
+
+	// Notify the borrower and the user who checked the asset out
+	for _, loan := range loans {
+		cs.sendLoanOverdueNotification(ctx, &loan, loan.BorrowerID)
+		if loan.CheckedOutBy != loan.BorrowerID {
+			cs.sendLoanOverdueNotification(ctx, &loan, loan.CheckedOutBy)
+		}
+	}
+
+	log.Printf("Overdue asset loan check completed. Found %d overdue loans", len(loans))
+}
+
 // sendWarrantyExpiringNotification sends notification for warranty expiring soon
 func (cs *CronService) sendWarrantyExpiringNotification(ctx context.Context, asset *domain.Asset) {
 	if cs.notificationService == nil {
@@ -188,3 +251,99 @@ func (cs *CronService) sendWarrantyExpiredNotification(ctx context.Context, asse
 		log.Printf("Successfully created warranty expired notification for asset ID: %s, user ID: %s", asset.ID, *asset.AssignedTo)
 	}
 }
+
+// sendLoanDueSoonNotification sends notification for asset loan due soon
+func (cs *CronService) sendLoanDueSoonNotification(ctx context.Context, loan *domain.AssetLoan) {
+	if cs.notificationService == nil {
+		log.Printf("Notification service not available, skipping loan due soon notification for loan ID: %s", loan.ID)
+		return
+	}
+
+	assetName, assetTag := "", ""
+	if loan.Asset != nil {
+		assetName, assetTag = loan.Asset.AssetName, loan.Asset.AssetTag
+	}
+
+	titleKey, messageKey, params := messages.AssetLoanDueSoonNotification(assetName, assetTag, loan.ExpectedReturnDate.Format("2006-01-02"))
+	utilTranslations := messages.GetAssetLoanNotificationTranslations(titleKey, messageKey, params)
+
+	// Convert to domain translations
+	translations := make([]domain.CreateNotificationTranslationPayload, len(utilTranslations))
+	for i, t := range utilTranslations {
+		translations[i] = domain.CreateNotificationTranslationPayload{
+			LangCode: t.LangCode,
+			Title:    t.Title,
+			Message:  t.Message,
+		}
+	}
+
+	entityType := "asset_loan"
+	priority := domain.NotificationPriorityNormal
+
+	notificationPayload := &domain.CreateNotificationPayload{
+		UserID:            loan.BorrowerID,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &loan.ID,
+		RelatedAssetID:    &loan.AssetID,
+		Type:              domain.NotificationTypeMovement,
+		Priority:          priority,
+		Translations:      translations,
+	}
+
+	_, err := cs.notificationService.CreateNotification(ctx, notificationPayload)
+	if err != nil {
+		log.Printf("Failed to create loan due soon notification for loan ID: %s: %v", loan.ID, err)
+	} else {
+		log.Printf("Successfully created loan due soon notification for loan ID: %s, user ID: %s", loan.ID, loan.BorrowerID)
+	}
+}
+
+// sendLoanOverdueNotification sends notification for overdue asset loan
+func (cs *CronService) sendLoanOverdueNotification(ctx context.Context, loan *domain.AssetLoan, userId string) {
+	if cs.notificationService == nil {
+		log.Printf("Notification service not available, skipping loan overdue notification for loan ID: %s", loan.ID)
+		return
+	}
+
+	assetName, assetTag, borrowerName := "", "", ""
+	if loan.Asset != nil {
+		assetName, assetTag = loan.Asset.AssetName, loan.Asset.AssetTag
+	}
+	if loan.Borrower != nil {
+		borrowerName = loan.Borrower.FullName
+	}
+
+	daysOverdue := strconv.Itoa(loan.DaysOverdue(time.Now().UTC()))
+	titleKey, messageKey, params := messages.AssetLoanOverdueNotification(assetName, assetTag, borrowerName, loan.ExpectedReturnDate.Format("2006-01-02"), daysOverdue)
+	utilTranslations := messages.GetAssetLoanNotificationTranslations(titleKey, messageKey, params)
+
+	// Convert to domain translations
+	translations := make([]domain.CreateNotificationTranslationPayload, len(utilTranslations))
+	for i, t := range utilTranslations {
+		translations[i] = domain.CreateNotificationTranslationPayload{
+			LangCode: t.LangCode,
+			Title:    t.Title,
+			Message:  t.Message,
+		}
+	}
+
+	entityType := "asset_loan"
+	priority := domain.NotificationPriorityHigh
+
+	notificationPayload := &domain.CreateNotificationPayload{
+		UserID:            userId,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &loan.ID,
+		RelatedAssetID:    &loan.AssetID,
+		Type:              domain.NotificationTypeMovement,
+		Priority:          priority,
+		Translations:      translations,
+	}
+
+	_, err := cs.notificationService.CreateNotification(ctx, notificationPayload)
+	if err != nil {
+		log.Printf("Failed to create loan overdue notification for loan ID: %s: %v", loan.ID, err)
+	} else {
+		log.Printf("Successfully created loan overdue notification for loan ID: %s, user ID: %s", loan.ID, userId)
+	}
+}
@@ -0,0 +1,399 @@
+package asset_document
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/oklog/ulid/v2"
+)
+
+// * Repository interface defines the contract for asset document data operations
+type Repository interface {
+	// * MUTATION
+	CreateAssetDocument(ctx context.Context, payload *domain.AssetDocument) (domain.AssetDocument, error)
+	DeleteAssetDocument(ctx context.Context, assetId string, documentId string) error
+
+	// * QUERY
+	GetAssetDocuments(ctx context.Context, assetId string, filters *domain.AssetDocumentFilterOptions) ([]domain.AssetDocument, error)
+	GetAssetDocumentById(ctx context.Context, assetId string, documentId string) (domain.AssetDocument, error)
+	GetMaintenanceRecordDocuments(ctx context.Context, recordId string) ([]domain.AssetDocument, error)
+}
+
+// defaultZipMaxSizeMB caps the total size of one documents ZIP, bisa diubah lewat ASSET_DOCUMENT_ZIP_MAX_SIZE_MB
+const defaultZipMaxSizeMB = 500
+
+// * AssetDocumentService interface defines the contract for asset document business operations
+type AssetDocumentService interface {
+	// * MUTATION
+	UploadAssetDocument(ctx context.Context, assetId string, payload *domain.CreateAssetDocumentPayload, file *multipart.FileHeader) (domain.AssetDocumentResponse, error)
+	DeleteAssetDocument(ctx context.Context, assetId string, documentId string) error
+
+	// * QUERY
+	GetAssetDocuments(ctx context.Context, assetId string, filters *domain.AssetDocumentFilterOptions) ([]domain.AssetDocumentResponse, error)
+	GetAssetDocumentById(ctx context.Context, assetId string, documentId string) (domain.AssetDocumentResponse, error)
+	DownloadAssetDocument(ctx context.Context, assetId string, documentId string) (domain.AssetDocumentFile, error)
+	DownloadAssetDocumentsZip(ctx context.Context, assetId string, filters *domain.AssetDocumentFilterOptions) (domain.AssetDocumentZip, error)
+	GetMaintenanceRecordDocuments(ctx context.Context, recordId string) ([]domain.AssetDocumentResponse, error)
+}
+
+// * AssetRepository interface for checking the asset a document belongs to (location scope ikut berlaku)
+type AssetRepository interface {
+	GetAssetById(ctx context.Context, assetId string) (domain.Asset, error)
+}
+
+// * MaintenanceRecordRepository interface for checking the maintenance record a document is linked to
+type MaintenanceRecordRepository interface {
+	GetRecordById(ctx context.Context, recordId string) (domain.MaintenanceRecord, error)
+}
+
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// * Transactor interface for writing a mutation and its audit entry in one transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Service struct {
+	Repo                  Repository
+	AssetRepo             AssetRepository
+	MaintenanceRecordRepo MaintenanceRecordRepository
+	FileStorage           storage.Storage
+	AuditLogService       AuditLogService
+	Transactor            Transactor
+}
+
+// * Ensure Service implements AssetDocumentService interface
+var _ AssetDocumentService = (*Service)(nil)
+
+func NewService(r Repository, assetRepo AssetRepository, maintenanceRecordRepo MaintenanceRecordRepository, fileStorage storage.Storage, auditLogService AuditLogService, transactor Transactor) AssetDocumentService {
+	return &Service{
+		Repo:                  r,
+		AssetRepo:             assetRepo,
+		MaintenanceRecordRepo: maintenanceRecordRepo,
+		FileStorage:           fileStorage,
+		AuditLogService:       auditLogService,
+		Transactor:            transactor,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (s *Service) UploadAssetDocument(ctx context.Context, assetId string, payload *domain.CreateAssetDocumentPayload, file *multipart.FileHeader) (domain.AssetDocumentResponse, error) {
+	if _, err := s.AssetRepo.GetAssetById(ctx, assetId); err != nil {
+		return domain.AssetDocumentResponse{}, err
+	}
+
+	// * Dokumen maintenance harus milik asset yang sama supaya ikut muncul di daftar dokumen asset
+	if payload.MaintenanceRecordID != nil && *payload.MaintenanceRecordID != "" {
+		record, err := s.MaintenanceRecordRepo.GetRecordById(ctx, *payload.MaintenanceRecordID)
+		if err != nil {
+			return domain.AssetDocumentResponse{}, err
+		}
+		if record.AssetID != assetId {
+			return domain.AssetDocumentResponse{}, domain.ErrBadRequestWithKey(utils.ErrAssetDocumentRecordMismatchKey)
+		}
+	}
+
+	issuedAt, err := parseDate(payload.IssuedAt)
+	if err != nil {
+		return domain.AssetDocumentResponse{}, err
+	}
+	expiresAt, err := parseDate(payload.ExpiresAt)
+	if err != nil {
+		return domain.AssetDocumentResponse{}, err
+	}
+	if issuedAt != nil && expiresAt != nil && expiresAt.Before(*issuedAt) {
+		return domain.AssetDocumentResponse{}, domain.ErrBadRequestWithKey(utils.ErrAssetDocumentExpiryInvalidKey)
+	}
+
+	if s.FileStorage == nil {
+		return domain.AssetDocumentResponse{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
+	}
+
+	// * Satu folder per asset, nama file asli disimpan di database untuk download
+	uploadConfig := storage.GetDocumentUploadConfig()
+	uploadConfig.FolderName = uploadConfig.FolderName + "/" + assetId
+	publicID := strings.ToLower(ulid.Make().String())
+	uploadConfig.PublicID = &publicID
+
+	uploadResult, err := s.FileStorage.UploadSingleFile(ctx, file, uploadConfig)
+	if err != nil {
+		return domain.AssetDocumentResponse{}, domain.ErrBadRequest("Failed to upload document: " + err.Error())
+	}
+
+	var mimeType *string
+	if contentType := mime.TypeByExtension(strings.ToLower(filepath.Ext(file.Filename))); contentType != "" {
+		mimeType = &contentType
+	} else if contentType := file.Header.Get("Content-Type"); contentType != "" {
+		mimeType = &contentType
+	}
+
+	newDocument := domain.AssetDocument{
+		AssetID:             assetId,
+		MaintenanceRecordID: payload.MaintenanceRecordID,
+		Type:                payload.Type,
+		Title:               payload.Title,
+		DocumentNumber:      payload.DocumentNumber,
+		IssuedAt:            issuedAt,
+		ExpiresAt:           expiresAt,
+		Notes:               payload.Notes,
+		FileURL:             uploadResult.SecureURL,
+		PublicID:            uploadResult.PublicID,
+		FileName:            file.Filename,
+		MimeType:            mimeType,
+		FileSize:            file.Size,
+	}
+	if uploaderId, ok := web.GetUserIDFromRequestContext(ctx); ok {
+		newDocument.UploadedBy = &uploaderId
+	}
+
+	var createdDocument domain.AssetDocument
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdDocument, err = s.Repo.CreateAssetDocument(ctx, &newDocument)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordCreate(ctx, domain.AuditEntityAssetDocument, createdDocument.ID, createdDocument)
+	})
+	if err != nil {
+		// * File yang sudah terupload tidak punya baris di database, hapus supaya tidak jadi sampah
+		if deleteErr := s.FileStorage.DeleteFile(ctx, uploadResult.PublicID); deleteErr != nil {
+			log.Printf("Warning: Failed to delete orphaned document file %s: %v", uploadResult.PublicID, deleteErr)
+		}
+		return domain.AssetDocumentResponse{}, err
+	}
+
+	return mapper.AssetDocumentToResponse(&createdDocument), nil
+}
+
+func (s *Service) DeleteAssetDocument(ctx context.Context, assetId string, documentId string) error {
+	if _, err := s.AssetRepo.GetAssetById(ctx, assetId); err != nil {
+		return err
+	}
+
+	existingDocument, err := s.Repo.GetAssetDocumentById(ctx, assetId, documentId)
+	if err != nil {
+		return err
+	}
+
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteAssetDocument(ctx, assetId, documentId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityAssetDocument, documentId, existingDocument)
+	})
+	if err != nil {
+		return err
+	}
+
+	// * Hapus file setelah baris terhapus, file yang gagal dihapus cukup dicatat
+	if s.FileStorage != nil {
+		if err := s.FileStorage.DeleteFile(ctx, existingDocument.PublicID); err != nil {
+			log.Printf("Warning: Failed to delete document file %s for asset %s: %v", existingDocument.PublicID, assetId, err)
+		}
+	}
+
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetAssetDocuments(ctx context.Context, assetId string, filters *domain.AssetDocumentFilterOptions) ([]domain.AssetDocumentResponse, error) {
+	if _, err := s.AssetRepo.GetAssetById(ctx, assetId); err != nil {
+		return nil, err
+	}
+
+	documents, err := s.Repo.GetAssetDocuments(ctx, assetId, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.AssetDocumentsToResponses(documents), nil
+}
+
+func (s *Service) GetAssetDocumentById(ctx context.Context, assetId string, documentId string) (domain.AssetDocumentResponse, error) {
+	if _, err := s.AssetRepo.GetAssetById(ctx, assetId); err != nil {
+		return domain.AssetDocumentResponse{}, err
+	}
+
+	document, err := s.Repo.GetAssetDocumentById(ctx, assetId, documentId)
+	if err != nil {
+		return domain.AssetDocumentResponse{}, err
+	}
+
+	return mapper.AssetDocumentToResponse(&document), nil
+}
+
+func (s *Service) DownloadAssetDocument(ctx context.Context, assetId string, documentId string) (domain.AssetDocumentFile, error) {
+	if _, err := s.AssetRepo.GetAssetById(ctx, assetId); err != nil {
+		return domain.AssetDocumentFile{}, err
+	}
+
+	document, err := s.Repo.GetAssetDocumentById(ctx, assetId, documentId)
+	if err != nil {
+		return domain.AssetDocumentFile{}, err
+	}
+
+	if s.FileStorage == nil {
+		return domain.AssetDocumentFile{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
+	}
+
+	data, err := storage.ReadFile(ctx, s.FileStorage, document.FileURL)
+	if err != nil {
+		return domain.AssetDocumentFile{}, domain.ErrInternal(err)
+	}
+
+	contentType := "application/octet-stream"
+	if document.MimeType != nil && *document.MimeType != "" {
+		contentType = *document.MimeType
+	}
+
+	return domain.AssetDocumentFile{
+		FileName:    document.FileName,
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
+
+// DownloadAssetDocumentsZip prepares a ZIP of the asset documents, satu folder per tipe dokumen.
+// File dibaca dari storage satu per satu saat ZIP ditulis ke response, jadi ZIP tidak pernah ditampung utuh di memory
+func (s *Service) DownloadAssetDocumentsZip(ctx context.Context, assetId string, filters *domain.AssetDocumentFilterOptions) (domain.AssetDocumentZip, error) {
+	asset, err := s.AssetRepo.GetAssetById(ctx, assetId)
+	if err != nil {
+		return domain.AssetDocumentZip{}, err
+	}
+
+	documents, err := s.Repo.GetAssetDocuments(ctx, assetId, filters)
+	if err != nil {
+		return domain.AssetDocumentZip{}, err
+	}
+	if len(documents) == 0 {
+		return domain.AssetDocumentZip{}, domain.ErrNotFoundWithKey(utils.ErrAssetDocumentNothingToBundleKey)
+	}
+
+	if s.FileStorage == nil {
+		return domain.AssetDocumentZip{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
+	}
+
+	// * Dicek sebelum response dimulai supaya client masih dapat error JSON
+	maxSize := int64(utils.EnvPositiveInt("ASSET_DOCUMENT_ZIP_MAX_SIZE_MB", defaultZipMaxSizeMB)) * 1024 * 1024
+	var totalSize int64
+	for _, document := range documents {
+		totalSize += document.FileSize
+	}
+	if totalSize > maxSize {
+		return domain.AssetDocumentZip{}, domain.ErrBadRequestWithKey(utils.ErrAssetDocumentBundleTooLargeKey)
+	}
+
+	return domain.AssetDocumentZip{
+		FileName: fmt.Sprintf("asset_%s_documents_%s.zip", asset.AssetTag, time.Now().Format("20060102_150405")),
+		Write: func(w io.Writer) error {
+			return s.writeDocumentsZip(ctx, w, documents, maxSize)
+		},
+	}, nil
+}
+
+func (s *Service) GetMaintenanceRecordDocuments(ctx context.Context, recordId string) ([]domain.AssetDocumentResponse, error) {
+	if _, err := s.MaintenanceRecordRepo.GetRecordById(ctx, recordId); err != nil {
+		return nil, err
+	}
+
+	documents, err := s.Repo.GetMaintenanceRecordDocuments(ctx, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.AssetDocumentsToResponses(documents), nil
+}
+
+// *===========================HELPER METHODS===========================*
+
+// writeDocumentsZip streams every document into a ZIP written to w, batas ukuran dicek lagi saat copy
+// karena FileSize hanya metadata saat upload
+func (s *Service) writeDocumentsZip(ctx context.Context, w io.Writer, documents []domain.AssetDocument, maxSize int64) error {
+	zipWriter := zip.NewWriter(w)
+	usedNames := make(map[string]int)
+	remaining := maxSize
+
+	for _, document := range documents {
+		written, err := s.writeZipEntry(ctx, zipWriter, zipEntryName(usedNames, string(document.Type), document.FileName), document, remaining)
+		if err != nil {
+			return fmt.Errorf("failed to write document %s: %w", document.ID, err)
+		}
+		remaining -= written
+	}
+
+	return zipWriter.Close()
+}
+
+func (s *Service) writeZipEntry(ctx context.Context, zipWriter *zip.Writer, name string, document domain.AssetDocument, limit int64) (int64, error) {
+	content, _, err := storage.OpenFile(ctx, s.FileStorage, document.FileURL)
+	if err != nil {
+		return 0, err
+	}
+	defer content.Close()
+
+	entry, err := zipWriter.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: document.CreatedAt,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	written, err := io.Copy(entry, io.LimitReader(content, limit+1))
+	if err != nil {
+		return written, err
+	}
+	if written > limit {
+		return written, fmt.Errorf("zip exceeds the %d bytes limit", limit)
+	}
+
+	return written, nil
+}
+
+func parseDate(value *string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		return nil, domain.ErrBadRequest("invalid date format, expected YYYY-MM-DD")
+	}
+
+	return &parsed, nil
+}
+
+// zipEntryName returns a unique entry name inside the type folder, nama yang sama diberi suffix (2), (3), dst
+func zipEntryName(usedNames map[string]int, folder string, fileName string) string {
+	fileName = filepath.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	name := folder + "/" + fileName
+
+	usedNames[name]++
+	if count := usedNames[name]; count > 1 {
+		ext := filepath.Ext(fileName)
+		name = fmt.Sprintf("%s/%s (%d)%s", folder, strings.TrimSuffix(fileName, ext), count, ext)
+	}
+
+	return name
+}
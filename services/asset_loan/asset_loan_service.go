@@ -0,0 +1,436 @@
+package asset_loan
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/notification/messages"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+// * Repository interface defines the contract for asset loan data operations
+type Repository interface {
+	// * MUTATION
+	CheckOutAsset(ctx context.Context, loan *domain.AssetLoan, movement *domain.AssetMovement) (domain.AssetLoan, error)
+	CheckInAsset(ctx context.Context, loan *domain.AssetLoan, movement *domain.AssetMovement) (domain.AssetLoan, error)
+
+	// * QUERY
+	GetAssetLoansPaginated(ctx context.Context, params domain.AssetLoanParams, langCode string) ([]domain.AssetLoan, error)
+	GetAssetLoansCursor(ctx context.Context, params domain.AssetLoanParams, langCode string) ([]domain.AssetLoan, error)
+	GetAssetLoanById(ctx context.Context, loanId string) (domain.AssetLoan, error)
+	GetActiveAssetLoanByAssetId(ctx context.Context, assetId string) (domain.AssetLoan, error)
+	CheckActiveAssetLoanExist(ctx context.Context, assetId string) (bool, error)
+	CountAssetLoans(ctx context.Context, params domain.AssetLoanParams) (int64, error)
+}
+
+// * AssetService interface for checking asset existence and current state
+type AssetService interface {
+	GetAssetById(ctx context.Context, assetId string, langCode string) (domain.AssetResponse, error)
+}
+
+// * LocationService interface for checking location existence
+type LocationService interface {
+	CheckLocationExists(ctx context.Context, locationId string) (bool, error)
+}
+
+// * UserService interface for checking user existence
+type UserService interface {
+	CheckUserExists(ctx context.Context, userId string) (bool, error)
+}
+
+// * NotificationService interface for creating notifications
+type NotificationService interface {
+	CreateNotification(ctx context.Context, payload *domain.CreateNotificationPayload) (domain.NotificationResponse, error)
+}
+
+// * WebhookService interface for emitting outbound webhook events
+type WebhookService interface {
+	Emit(ctx context.Context, eventType domain.WebhookEventType, data any) error
+}
+
+type AssetLoanService interface {
+	// * MUTATION
+	CheckOutAsset(ctx context.Context, payload *domain.CheckOutAssetPayload, checkedOutBy string, langCode string) (domain.AssetLoanResponse, error)
+	CheckInAsset(ctx context.Context, loanId string, payload *domain.CheckInAssetPayload, checkedInBy string, langCode string) (domain.AssetLoanResponse, error)
+
+	// * QUERY
+	GetAssetLoansPaginated(ctx context.Context, params domain.AssetLoanParams, langCode string) ([]domain.AssetLoanResponse, int64, error)
+	GetAssetLoansCursor(ctx context.Context, params domain.AssetLoanParams, langCode string) ([]domain.AssetLoanResponse, error)
+	GetAssetLoanById(ctx context.Context, loanId string, langCode string) (domain.AssetLoanResponse, error)
+	GetActiveAssetLoanByAssetId(ctx context.Context, assetId string, langCode string) (domain.AssetLoanResponse, error)
+	GetCurrentLoansByUserId(ctx context.Context, userId string, langCode string) ([]domain.AssetLoanResponse, error)
+	CountAssetLoans(ctx context.Context, params domain.AssetLoanParams) (int64, error)
+
+	// * JOB HANDLERS
+	HandleNotificationJob(ctx context.Context, job *domain.Job) error
+}
+
+// * JobQueue interface for enqueueing background jobs in the same transaction as the mutation
+type JobQueue interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+}
+
+const (
+	loanCheckedOutEvent = "checked_out"
+	loanCheckedInEvent  = "checked_in"
+)
+
+// notificationJob is the payload of domain.JobTypeAssetLoanNotification, Loan dan Asset adalah snapshot saat mutasi
+type notificationJob struct {
+	Event string               `json:"event"`
+	Loan  domain.AssetLoan     `json:"loan"`
+	Asset domain.AssetResponse `json:"asset"`
+}
+
+type Service struct {
+	Repo                Repository
+	AssetService        AssetService
+	LocationService     LocationService
+	UserService         UserService
+	NotificationService NotificationService
+	WebhookService      WebhookService
+	JobQueue            JobQueue
+}
+
+// * Ensure Service implements AssetLoanService interface
+var _ AssetLoanService = (*Service)(nil)
+
+func NewService(r Repository, assetService AssetService, locationService LocationService, userService UserService, notificationService NotificationService, webhookService WebhookService, jobQueue JobQueue) AssetLoanService {
+	return &Service{
+		Repo:                r,
+		AssetService:        assetService,
+		LocationService:     locationService,
+		UserService:         userService,
+		NotificationService: notificationService,
+		WebhookService:      webhookService,
+		JobQueue:            jobQueue,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (s *Service) CheckOutAsset(ctx context.Context, payload *domain.CheckOutAssetPayload, checkedOutBy string, langCode string) (domain.AssetLoanResponse, error) {
+	// * Get current asset information (also validates existence)
+	asset, err := s.AssetService.GetAssetById(ctx, payload.AssetID, mapper.DefaultLangCode)
+	if err != nil {
+		return domain.AssetLoanResponse{}, err
+	}
+
+	// * Only active assets can be lent out
+	if asset.Status != domain.StatusActive {
+		return domain.AssetLoanResponse{}, domain.ErrBadRequestWithKey(utils.ErrAssetLoanAssetUnavailableKey)
+	}
+
+	// * Asset can only have one active loan
+	if onLoan, err := s.Repo.CheckActiveAssetLoanExist(ctx, payload.AssetID); err != nil {
+		return domain.AssetLoanResponse{}, err
+	} else if onLoan {
+		return domain.AssetLoanResponse{}, domain.ErrConflictWithKey(utils.ErrAssetLoanAlreadyActiveKey)
+	}
+
+	// * Check if borrower exists
+	if borrowerExists, err := s.UserService.CheckUserExists(ctx, payload.BorrowerID); err != nil {
+		return domain.AssetLoanResponse{}, err
+	} else if !borrowerExists {
+		return domain.AssetLoanResponse{}, domain.ErrNotFoundWithKey(utils.ErrUserNotFoundKey)
+	}
+
+	if asset.AssignedToID != nil && *asset.AssignedToID == payload.BorrowerID {
+		return domain.AssetLoanResponse{}, domain.ErrBadRequestWithKey(utils.ErrAssetLoanBorrowerAssignedKey)
+	}
+
+	// * Parse expected return date in UTC, loan is due at the end of that day
+	returnDate, err := time.ParseInLocation("2006-01-02", payload.ExpectedReturnDate, time.UTC)
+	if err != nil {
+		return domain.AssetLoanResponse{}, domain.ErrBadRequestWithKey(utils.ErrAssetLoanInvalidReturnDateKey)
+	}
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if returnDate.Before(today) {
+		return domain.AssetLoanResponse{}, domain.ErrBadRequestWithKey(utils.ErrAssetLoanInvalidReturnDateKey)
+	}
+	expectedReturnDate := returnDate.Add(24*time.Hour - time.Second)
+
+	newLoan := domain.AssetLoan{
+		AssetID:            payload.AssetID,
+		BorrowerID:         payload.BorrowerID,
+		CheckedOutBy:       checkedOutBy,
+		CheckoutDate:       now,
+		ExpectedReturnDate: expectedReturnDate,
+		CheckoutCondition:  asset.Condition,
+		Status:             domain.AssetLoanStatusActive,
+		Notes:              payload.Notes,
+	}
+
+	// * Checkout is recorded as a movement from the current holder to the borrower
+	movement := domain.AssetMovement{
+		AssetID:        payload.AssetID,
+		FromLocationID: asset.LocationID,
+		FromUserID:     asset.AssignedToID,
+		ToUserID:       &payload.BorrowerID,
+		MovementDate:   now,
+		MovedBy:        checkedOutBy,
+	}
+
+	var createdLoan domain.AssetLoan
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdLoan, err = s.Repo.CheckOutAsset(ctx, &newLoan, &movement)
+		if err != nil {
+			return err
+		}
+
+		// * Send notification asynchronously
+		err = s.JobQueue.Enqueue(ctx, domain.JobTypeAssetLoanNotification, notificationJob{
+			Event: loanCheckedOutEvent,
+			Loan:  createdLoan,
+			Asset: asset,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.WebhookService.Emit(ctx, domain.WebhookEventLoanCheckedOut, mapper.AssetLoanToResponse(&createdLoan, mapper.DefaultLangCode))
+	})
+	if err != nil {
+		return domain.AssetLoanResponse{}, err
+	}
+
+	return mapper.AssetLoanToResponse(&createdLoan, langCode), nil
+}
+
+func (s *Service) CheckInAsset(ctx context.Context, loanId string, payload *domain.CheckInAssetPayload, checkedInBy string, langCode string) (domain.AssetLoanResponse, error) {
+	loan, err := s.Repo.GetAssetLoanById(ctx, loanId)
+	if err != nil {
+		return domain.AssetLoanResponse{}, err
+	}
+
+	if loan.Status != domain.AssetLoanStatusActive {
+		return domain.AssetLoanResponse{}, domain.ErrBadRequestWithKey(utils.ErrAssetLoanAlreadyReturnedKey)
+	}
+
+	// * Check if return location exists
+	if payload.ReturnLocationID != nil && *payload.ReturnLocationID != "" {
+		if locationExists, err := s.LocationService.CheckLocationExists(ctx, *payload.ReturnLocationID); err != nil {
+			return domain.AssetLoanResponse{}, err
+		} else if !locationExists {
+			return domain.AssetLoanResponse{}, domain.ErrNotFoundWithKey(utils.ErrLocationNotFoundKey)
+		}
+	}
+
+	asset, err := s.AssetService.GetAssetById(ctx, loan.AssetID, mapper.DefaultLangCode)
+	if err != nil {
+		return domain.AssetLoanResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	returnCondition := payload.ReturnCondition
+	loan.Status = domain.AssetLoanStatusReturned
+	loan.ReturnedDate = &now
+	loan.ReturnCondition = &returnCondition
+	loan.CheckedInBy = &checkedInBy
+	if payload.Notes != nil {
+		loan.Notes = payload.Notes
+	}
+
+	// * Check-in is recorded as a movement from the borrower back to storage
+	movement := domain.AssetMovement{
+		AssetID:        loan.AssetID,
+		FromLocationID: asset.LocationID,
+		FromUserID:     &loan.BorrowerID,
+		MovementDate:   now,
+		MovedBy:        checkedInBy,
+	}
+	if payload.ReturnLocationID != nil && *payload.ReturnLocationID != "" {
+		movement.ToLocationID = payload.ReturnLocationID
+	}
+
+	var updatedLoan domain.AssetLoan
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedLoan, err = s.Repo.CheckInAsset(ctx, &loan, &movement)
+		if err != nil {
+			return err
+		}
+
+		// * Send notification asynchronously
+		err = s.JobQueue.Enqueue(ctx, domain.JobTypeAssetLoanNotification, notificationJob{
+			Event: loanCheckedInEvent,
+			Loan:  updatedLoan,
+			Asset: asset,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.WebhookService.Emit(ctx, domain.WebhookEventLoanCheckedIn, mapper.AssetLoanToResponse(&updatedLoan, mapper.DefaultLangCode))
+	})
+	if err != nil {
+		return domain.AssetLoanResponse{}, err
+	}
+
+	return mapper.AssetLoanToResponse(&updatedLoan, langCode), nil
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetAssetLoansPaginated(ctx context.Context, params domain.AssetLoanParams, langCode string) ([]domain.AssetLoanResponse, int64, error) {
+	loans, err := s.Repo.GetAssetLoansPaginated(ctx, params, langCode)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.Repo.CountAssetLoans(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return mapper.AssetLoansToResponses(loans, langCode), count, nil
+}
+
+func (s *Service) GetAssetLoansCursor(ctx context.Context, params domain.AssetLoanParams, langCode string) ([]domain.AssetLoanResponse, error) {
+	loans, err := s.Repo.GetAssetLoansCursor(ctx, params, langCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.AssetLoansToResponses(loans, langCode), nil
+}
+
+func (s *Service) GetAssetLoanById(ctx context.Context, loanId string, langCode string) (domain.AssetLoanResponse, error) {
+	loan, err := s.Repo.GetAssetLoanById(ctx, loanId)
+	if err != nil {
+		return domain.AssetLoanResponse{}, err
+	}
+
+	return mapper.AssetLoanToResponse(&loan, langCode), nil
+}
+
+func (s *Service) GetActiveAssetLoanByAssetId(ctx context.Context, assetId string, langCode string) (domain.AssetLoanResponse, error) {
+	loan, err := s.Repo.GetActiveAssetLoanByAssetId(ctx, assetId)
+	if err != nil {
+		return domain.AssetLoanResponse{}, err
+	}
+
+	return mapper.AssetLoanToResponse(&loan, langCode), nil
+}
+
+func (s *Service) GetCurrentLoansByUserId(ctx context.Context, userId string, langCode string) ([]domain.AssetLoanResponse, error) {
+	// * Check if user exists
+	if userExists, err := s.UserService.CheckUserExists(ctx, userId); err != nil {
+		return nil, err
+	} else if !userExists {
+		return nil, domain.ErrNotFoundWithKey(utils.ErrUserNotFoundKey)
+	}
+
+	activeStatus := domain.AssetLoanStatusActive
+	params := domain.AssetLoanParams{
+		Filters: &domain.AssetLoanFilterOptions{
+			BorrowerID: &userId,
+			Status:     &activeStatus,
+		},
+		Sort: &domain.AssetLoanSortOptions{
+			Field: domain.AssetLoanSortByExpectedReturnDate,
+			Order: domain.SortOrderAsc,
+		},
+	}
+
+	loans, err := s.Repo.GetAssetLoansPaginated(ctx, params, langCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.AssetLoansToResponses(loans, langCode), nil
+}
+
+func (s *Service) CountAssetLoans(ctx context.Context, params domain.AssetLoanParams) (int64, error) {
+	count, err := s.Repo.CountAssetLoans(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// *===========================JOB HANDLERS===========================*
+
+// HandleNotificationJob sends the check-out/check-in notifications queued by the mutations
+func (s *Service) HandleNotificationJob(ctx context.Context, job *domain.Job) error {
+	var payload notificationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	switch payload.Event {
+	case loanCheckedOutEvent:
+		s.sendCheckedOutNotification(ctx, &payload.Loan, &payload.Asset)
+	case loanCheckedInEvent:
+		s.sendCheckedInNotification(ctx, &payload.Loan, &payload.Asset)
+	default:
+		log.Printf("Unknown asset loan notification event %q in job %s", payload.Event, job.ID)
+	}
+	return nil
+}
+
+// *===========================HELPER METHODS===========================*
+
+// sendCheckedOutNotification notifies the borrower that an asset has been checked out to them
+func (s *Service) sendCheckedOutNotification(ctx context.Context, loan *domain.AssetLoan, asset *domain.AssetResponse) {
+	if s.NotificationService == nil {
+		log.Printf("Notification service not available, skipping check-out notification for loan ID: %s", loan.ID)
+		return
+	}
+
+	titleKey, messageKey, params := messages.AssetLoanCheckedOutNotification(asset.AssetName, asset.AssetTag, loan.ExpectedReturnDate.Format("2006-01-02"))
+	s.createLoanNotification(ctx, loan, loan.BorrowerID, domain.NotificationPriorityNormal, titleKey, messageKey, params)
+}
+
+// sendCheckedInNotification notifies the borrower that the asset return has been recorded
+func (s *Service) sendCheckedInNotification(ctx context.Context, loan *domain.AssetLoan, asset *domain.AssetResponse) {
+	if s.NotificationService == nil {
+		log.Printf("Notification service not available, skipping check-in notification for loan ID: %s", loan.ID)
+		return
+	}
+
+	returnCondition := ""
+	if loan.ReturnCondition != nil {
+		returnCondition = string(*loan.ReturnCondition)
+	}
+
+	titleKey, messageKey, params := messages.AssetLoanCheckedInNotification(asset.AssetName, asset.AssetTag, returnCondition)
+	s.createLoanNotification(ctx, loan, loan.BorrowerID, domain.NotificationPriorityLow, titleKey, messageKey, params)
+}
+
+func (s *Service) createLoanNotification(ctx context.Context, loan *domain.AssetLoan, userId string, priority domain.NotificationPriority, titleKey, messageKey messages.NotificationMessageKey, params map[string]string) {
+	utilTranslations := messages.GetAssetLoanNotificationTranslations(titleKey, messageKey, params)
+
+	// Convert to domain translations
+	translations := make([]domain.CreateNotificationTranslationPayload, len(utilTranslations))
+	for i, t := range utilTranslations {
+		translations[i] = domain.CreateNotificationTranslationPayload{
+			LangCode: t.LangCode,
+			Title:    t.Title,
+			Message:  t.Message,
+		}
+	}
+
+	entityType := "asset_loan"
+
+	notificationPayload := &domain.CreateNotificationPayload{
+		UserID:            userId,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &loan.ID,
+		RelatedAssetID:    &loan.AssetID,
+		Type:              domain.NotificationTypeMovement,
+		Priority:          priority,
+		Translations:      translations,
+	}
+
+	_, err := s.NotificationService.CreateNotification(ctx, notificationPayload)
+	if err != nil {
+		log.Printf("Failed to create asset loan notification for loan ID: %s: %v", loan.ID, err)
+	} else {
+		log.Printf("Successfully created asset loan notification for loan ID: %s, user ID: %s", loan.ID, userId)
+	}
+}
@@ -53,6 +53,11 @@ type UserService interface {
 type NotificationService interface {
 	CreateNotification(ctx context.Context, payload *domain.CreateNotificationPayload) (domain.NotificationResponse, error)
 }
+
+// * WebhookService interface for emitting outbound webhook events
+type WebhookService interface {
+	Emit(ctx context.Context, eventType domain.WebhookEventType, data any) error
+}
 type AssetMovementService interface {
 	// * MUTATION
 	CreateAssetMovement(ctx context.Context, payload *domain.CreateAssetMovementPayload, movedBy string) (domain.AssetMovementResponse, error)
@@ -72,6 +77,27 @@ type AssetMovementService interface {
 
 	// * EXPORT
 	ExportAssetMovementList(ctx context.Context, payload domain.ExportAssetMovementListPayload, params domain.AssetMovementParams, langCode string) ([]byte, string, error)
+
+	// * JOB HANDLERS
+	HandleNotificationJob(ctx context.Context, job *domain.Job) error
+}
+
+// * JobQueue interface for enqueueing background jobs in the same transaction as the mutation
+type JobQueue interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+}
+
+const (
+	movementLocationChangedEvent = "location_changed"
+	movementUserAssignedEvent    = "user_assigned"
+)
+
+// notificationJob is the payload of domain.JobTypeAssetMovementNotification, Asset adalah snapshot sebelum dipindah
+type notificationJob struct {
+	Event    string               `json:"event"`
+	Movement domain.AssetMovement `json:"movement"`
+	Asset    domain.AssetResponse `json:"asset"`
 }
 
 type Service struct {
@@ -80,18 +106,22 @@ type Service struct {
 	LocationService     LocationService
 	UserService         UserService
 	NotificationService NotificationService
+	WebhookService      WebhookService
+	JobQueue            JobQueue
 }
 
 // * Ensure Service implements AssetMovementService interface
 var _ AssetMovementService = (*Service)(nil)
 
-func NewService(r Repository, assetService AssetService, locationService LocationService, userService UserService, notificationService NotificationService) AssetMovementService {
+func NewService(r Repository, assetService AssetService, locationService LocationService, userService UserService, notificationService NotificationService, webhookService WebhookService, jobQueue JobQueue) AssetMovementService {
 	return &Service{
 		Repo:                r,
 		AssetService:        assetService,
 		LocationService:     locationService,
 		UserService:         userService,
 		NotificationService: notificationService,
+		WebhookService:      webhookService,
+		JobQueue:            jobQueue,
 	}
 }
 
@@ -170,23 +200,23 @@ func (s *Service) CreateAssetMovement(ctx context.Context, payload *domain.Creat
 		}
 	}
 
-	createdMovement, err := s.Repo.CreateAssetMovement(ctx, &newMovement)
-	if err != nil {
-		return domain.AssetMovementResponse{}, err
-	}
-
-	// * Send notifications asynchronously based on what changed
-	locationChanged := payload.ToLocationID != nil && *payload.ToLocationID != "" &&
-		(asset.LocationID == nil || *asset.LocationID != *payload.ToLocationID)
-	userChanged := payload.ToUserID != nil && *payload.ToUserID != "" &&
-		(asset.AssignedToID == nil || *asset.AssignedToID != *payload.ToUserID)
+	var createdMovement domain.AssetMovement
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdMovement, err = s.Repo.CreateAssetMovement(ctx, &newMovement)
+		if err != nil {
+			return err
+		}
 
-	if locationChanged {
-		go s.sendLocationChangeNotification(context.Background(), &createdMovement, &asset)
-	}
+		// * Send notifications asynchronously based on what changed
+		if err := s.enqueueMovementNotifications(ctx, &createdMovement, &asset); err != nil {
+			return err
+		}
 
-	if userChanged {
-		go s.sendUserAssignmentNotification(context.Background(), &createdMovement, &asset)
+		return s.WebhookService.Emit(ctx, domain.WebhookEventMovementCreated, mapper.AssetMovementToResponse(&createdMovement, mapper.DefaultLangCode))
+	})
+	if err != nil {
+		return domain.AssetMovementResponse{}, err
 	}
 
 	// * Convert to AssetMovementResponse using mapper
@@ -329,25 +359,27 @@ func (s *Service) BulkCreateAssetMovements(ctx context.Context, payload *domain.
 	}
 
 	// * Call repository bulk create
-	created, err := s.Repo.BulkCreateAssetMovements(ctx, movements)
-	if err != nil {
-		return domain.BulkCreateAssetMovementsResponse{}, err
-	}
-
-	// * Send notifications asynchronously for each movement
-	for i := range created {
-		asset := assetMap[created[i].AssetID]
-		locationChanged := created[i].ToLocationID != nil && *created[i].ToLocationID != "" &&
-			(asset.LocationID == nil || *asset.LocationID != *created[i].ToLocationID)
-		userChanged := created[i].ToUserID != nil && *created[i].ToUserID != "" &&
-			(asset.AssignedToID == nil || *asset.AssignedToID != *created[i].ToUserID)
-
-		if locationChanged {
-			go s.sendLocationChangeNotification(context.Background(), &created[i], asset)
+	var created []domain.AssetMovement
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.Repo.BulkCreateAssetMovements(ctx, movements)
+		if err != nil {
+			return err
 		}
-		if userChanged {
-			go s.sendUserAssignmentNotification(context.Background(), &created[i], asset)
+
+		// * Send notifications asynchronously for each movement
+		for i := range created {
+			if err := s.enqueueMovementNotifications(ctx, &created[i], assetMap[created[i].AssetID]); err != nil {
+				return err
+			}
+			if err := s.WebhookService.Emit(ctx, domain.WebhookEventMovementCreated, mapper.AssetMovementToResponse(&created[i], mapper.DefaultLangCode)); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return domain.BulkCreateAssetMovementsResponse{}, err
 	}
 
 	// * Convert to responses
@@ -464,8 +496,56 @@ func (s *Service) GetAssetMovementStatistics(ctx context.Context) (domain.AssetM
 	return mapper.AssetMovementStatisticsToResponse(&stats), nil
 }
 
+// *===========================JOB HANDLERS===========================*
+
+// HandleNotificationJob sends the location change/user assignment notifications queued by the mutations
+func (s *Service) HandleNotificationJob(ctx context.Context, job *domain.Job) error {
+	var payload notificationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	switch payload.Event {
+	case movementLocationChangedEvent:
+		s.sendLocationChangeNotification(ctx, &payload.Movement, &payload.Asset)
+	case movementUserAssignedEvent:
+		s.sendUserAssignmentNotification(ctx, &payload.Movement, &payload.Asset)
+	default:
+		log.Printf("Unknown asset movement notification event %q in job %s", payload.Event, job.ID)
+	}
+	return nil
+}
+
 // *===========================HELPER METHODS===========================*
 
+// enqueueMovementNotifications queues the notifications for what the movement changed, asset adalah kondisi sebelum dipindah
+func (s *Service) enqueueMovementNotifications(ctx context.Context, movement *domain.AssetMovement, asset *domain.AssetResponse) error {
+	locationChanged := movement.ToLocationID != nil && *movement.ToLocationID != "" &&
+		(asset.LocationID == nil || *asset.LocationID != *movement.ToLocationID)
+	userChanged := movement.ToUserID != nil && *movement.ToUserID != "" &&
+		(asset.AssignedToID == nil || *asset.AssignedToID != *movement.ToUserID)
+
+	if locationChanged {
+		err := s.JobQueue.Enqueue(ctx, domain.JobTypeAssetMovementNotification, notificationJob{
+			Event:    movementLocationChangedEvent,
+			Movement: *movement,
+			Asset:    *asset,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if userChanged {
+		return s.JobQueue.Enqueue(ctx, domain.JobTypeAssetMovementNotification, notificationJob{
+			Event:    movementUserAssignedEvent,
+			Movement: *movement,
+			Asset:    *asset,
+		})
+	}
+	return nil
+}
+
 // sendLocationChangeNotification sends notification when asset location changes
 func (s *Service) sendLocationChangeNotification(ctx context.Context, movement *domain.AssetMovement, asset *domain.AssetResponse) {
 	if s.NotificationService == nil {
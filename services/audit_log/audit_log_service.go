@@ -0,0 +1,173 @@
+package audit_log
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"reflect"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/web"
+)
+
+// * Fields that are never written to the audit trail
+var ignoredAuditFields = map[string]bool{
+	"passwordHash": true,
+	"updatedAt":    true,
+}
+
+// * Repository interface defines the contract for audit log data operations
+type Repository interface {
+	// * MUTATION
+	CreateAuditLog(ctx context.Context, payload *domain.AuditLog) (domain.AuditLog, error)
+
+	// * QUERY
+	GetAuditLogsCursor(ctx context.Context, params domain.AuditLogParams) ([]domain.AuditLog, error)
+	CountAuditLogs(ctx context.Context, params domain.AuditLogParams) (int64, error)
+}
+
+type AuditLogService interface {
+	// * MUTATION
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+
+	// * QUERY
+	GetAuditLogsCursor(ctx context.Context, params domain.AuditLogParams) ([]domain.AuditLogResponse, error)
+	GetEntityHistory(ctx context.Context, entityType domain.AuditEntityType, entityId string, params domain.AuditLogParams) ([]domain.AuditLogResponse, error)
+	CountAuditLogs(ctx context.Context, params domain.AuditLogParams) (int64, error)
+}
+
+type Service struct {
+	Repo Repository
+}
+
+// * Ensure Service implements AuditLogService interface
+var _ AuditLogService = (*Service)(nil)
+
+func NewService(r Repository) AuditLogService {
+	return &Service{
+		Repo: r,
+	}
+}
+
+// *===========================MUTATION===========================*
+// RecordCreate writes the audit entry of a created entity, panggil dengan ctx dari WithinTransaction
+// supaya entry ikut commit/rollback bersama mutasinya
+func (s *Service) RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error {
+	return s.record(ctx, entityType, entityId, domain.AuditActionCreate, diffAuditFields(nil, toAuditFields(after)))
+}
+
+func (s *Service) RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error {
+	changes := diffAuditFields(toAuditFields(before), toAuditFields(after))
+	if len(changes) == 0 {
+		// * Nothing actually changed, no need to record
+		return nil
+	}
+	return s.record(ctx, entityType, entityId, domain.AuditActionUpdate, changes)
+}
+
+func (s *Service) RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error {
+	return s.record(ctx, entityType, entityId, domain.AuditActionDelete, diffAuditFields(toAuditFields(before), nil))
+}
+
+// * record writes a single audit entry, error dikembalikan supaya mutasi ikut di-rollback dan tidak ada perubahan tanpa jejak
+func (s *Service) record(ctx context.Context, entityType domain.AuditEntityType, entityId string, action domain.AuditAction, changes map[string]domain.AuditFieldChange) error {
+	auditLog := domain.AuditLog{
+		EntityType: entityType,
+		EntityID:   entityId,
+		Action:     action,
+		Changes:    changes,
+	}
+
+	if actorId, ok := web.GetUserIDFromRequestContext(ctx); ok {
+		auditLog.ActorID = &actorId
+	}
+
+	if _, err := s.Repo.CreateAuditLog(ctx, &auditLog); err != nil {
+		return err
+	}
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetAuditLogsCursor(ctx context.Context, params domain.AuditLogParams) ([]domain.AuditLogResponse, error) {
+	logs, err := s.Repo.GetAuditLogsCursor(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.AuditLogsToResponses(logs), nil
+}
+
+func (s *Service) GetEntityHistory(ctx context.Context, entityType domain.AuditEntityType, entityId string, params domain.AuditLogParams) ([]domain.AuditLogResponse, error) {
+	if params.Filters == nil {
+		params.Filters = &domain.AuditLogFilterOptions{}
+	}
+	params.Filters.EntityType = &entityType
+	params.Filters.EntityID = &entityId
+
+	logs, err := s.Repo.GetAuditLogsCursor(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.AuditLogsToResponses(logs), nil
+}
+
+func (s *Service) CountAuditLogs(ctx context.Context, params domain.AuditLogParams) (int64, error) {
+	count, err := s.Repo.CountAuditLogs(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// *===========================HELPER METHODS===========================*
+
+// * toAuditFields flattens an entity into its top level JSON fields
+func toAuditFields(v any) map[string]any {
+	if v == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("Failed to marshal audit entity: %v", err)
+		return nil
+	}
+
+	fields := map[string]any{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		log.Printf("Failed to unmarshal audit entity: %v", err)
+		return nil
+	}
+
+	for key := range ignoredAuditFields {
+		delete(fields, key)
+	}
+
+	return fields
+}
+
+// * diffAuditFields returns only the fields whose value differs between before and after
+func diffAuditFields(before, after map[string]any) map[string]domain.AuditFieldChange {
+	changes := map[string]domain.AuditFieldChange{}
+
+	for key, oldValue := range before {
+		newValue, exists := after[key]
+		if !exists || !reflect.DeepEqual(oldValue, newValue) {
+			changes[key] = domain.AuditFieldChange{Old: oldValue, New: newValue}
+		}
+	}
+
+	for key, newValue := range after {
+		if _, exists := before[key]; !exists {
+			changes[key] = domain.AuditFieldChange{Old: nil, New: newValue}
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,449 @@
+package audit_session
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/utils"
+
+	"github.com/signintech/gopdf"
+	"github.com/xuri/excelize/v2"
+)
+
+// ExportAuditSessionReport exports the reconciliation report of an audit session to PDF or Excel format
+func (s *Service) ExportAuditSessionReport(ctx context.Context, sessionId string, payload *domain.ExportAuditSessionReportPayload, langCode string) ([]byte, string, error) {
+	report, err := s.GetAuditSessionReport(ctx, sessionId, langCode)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch payload.Format {
+	case domain.ExportFormatPDF:
+		data, err := s.exportAuditSessionReportToPDF(report, langCode)
+		if err != nil {
+			return nil, "", domain.ErrInternal(err)
+		}
+		timestamp := time.Now().Format("2006-01-02_15-04-05")
+		filename := fmt.Sprintf("audit_session_report_%s.pdf", timestamp)
+		return data, filename, nil
+
+	case domain.ExportFormatExcel:
+		data, err := s.exportAuditSessionReportToExcel(report)
+		if err != nil {
+			return nil, "", domain.ErrInternal(err)
+		}
+		timestamp := time.Now().Format("2006-01-02_15-04-05")
+		filename := fmt.Sprintf("audit_session_report_%s.xlsx", timestamp)
+		return data, filename, nil
+
+	default:
+		return nil, "", domain.ErrBadRequest("Invalid export format")
+	}
+}
+
+// reportItemsInOrder flattens report sections in the order they are printed
+func reportItemsInOrder(report domain.AuditSessionReportResponse) []domain.AuditSessionItemResponse {
+	items := make([]domain.AuditSessionItemResponse, 0, len(report.Missing)+len(report.WrongLocation)+len(report.Unexpected)+len(report.Found))
+	items = append(items, report.Missing...)
+	items = append(items, report.WrongLocation...)
+	items = append(items, report.Unexpected...)
+	items = append(items, report.Found...)
+	return items
+}
+
+// exportAuditSessionReportToPDF generates PDF file for audit session reconciliation report using gopdf
+func (s *Service) exportAuditSessionReportToPDF(report domain.AuditSessionReportResponse, langCode string) ([]byte, error) {
+	// Get absolute path for fonts and logo
+	workDir, _ := os.Getwd()
+	fontRegularPath := filepath.Join(workDir, "assets", "fonts", "NotoSansJP-Regular.ttf")
+	fontBoldPath := filepath.Join(workDir, "assets", "fonts", "NotoSansJP-Bold.ttf")
+	logoPath := filepath.Join(workDir, "assets", "images", "fts-logo.png")
+
+	// Initialize gopdf
+	pdf := gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{
+		PageSize: *gopdf.PageSizeA4Landscape, // A4 Landscape: 842 x 595
+		Unit:     gopdf.Unit_PT,
+	})
+	pdf.AddPage()
+
+	if err := pdf.AddTTFFont("noto-regular", fontRegularPath); err != nil {
+		return nil, fmt.Errorf("failed to load regular font: %w", err)
+	}
+	if err := pdf.AddTTFFont("noto-bold", fontBoldPath); err != nil {
+		return nil, fmt.Errorf("failed to load bold font: %w", err)
+	}
+
+	pdf.SetFont("noto-regular", "", 10)
+
+	// Get localized text
+	reportTitle := utils.GetLocalizedMessage(utils.PDFAuditSessionReportKey, langCode)
+	generatedOnText := utils.GetLocalizedMessage(utils.PDFAssetGeneratedOnKey, langCode)
+	startedAtText := utils.GetLocalizedMessage(utils.PDFAuditSessionStartedAtKey, langCode)
+	closedAtText := utils.GetLocalizedMessage(utils.PDFAuditSessionClosedAtKey, langCode)
+	expectedText := utils.GetLocalizedMessage(utils.PDFAuditSessionExpectedKey, langCode)
+	scannedText := utils.GetLocalizedMessage(utils.PDFAuditSessionScannedKey, langCode)
+	foundText := utils.GetLocalizedMessage(utils.PDFAuditSessionFoundKey, langCode)
+	missingText := utils.GetLocalizedMessage(utils.PDFAuditSessionMissingKey, langCode)
+	unexpectedText := utils.GetLocalizedMessage(utils.PDFAuditSessionUnexpectedKey, langCode)
+	wrongLocationText := utils.GetLocalizedMessage(utils.PDFAuditSessionWrongLocationKey, langCode)
+	accuracyText := utils.GetLocalizedMessage(utils.PDFAuditSessionAccuracyKey, langCode)
+	assetTagText := utils.GetLocalizedMessage(utils.PDFAssetAssetTagKey, langCode)
+	assetNameText := utils.GetLocalizedMessage(utils.PDFAssetAssetNameKey, langCode)
+	categoryText := utils.GetLocalizedMessage(utils.PDFAssetCategoryKey, langCode)
+	resultText := utils.GetLocalizedMessage(utils.PDFAuditSessionResultKey, langCode)
+	expectedLocationText := utils.GetLocalizedMessage(utils.PDFAuditSessionExpectedLocationKey, langCode)
+	actualLocationText := utils.GetLocalizedMessage(utils.PDFAuditSessionActualLocationKey, langCode)
+	scannedAtText := utils.GetLocalizedMessage(utils.PDFAuditSessionScannedAtKey, langCode)
+	actionText := utils.GetLocalizedMessage(utils.PDFAuditSessionActionKey, langCode)
+	correctedText := utils.GetLocalizedMessage(utils.PDFAuditSessionCorrectedKey, langCode)
+	markedLostText := utils.GetLocalizedMessage(utils.PDFAuditSessionMarkedLostKey, langCode)
+
+	resultLabels := map[domain.AuditItemResult]string{
+		domain.AuditItemResultPending:       missingText,
+		domain.AuditItemResultMissing:       missingText,
+		domain.AuditItemResultFound:         foundText,
+		domain.AuditItemResultUnexpected:    unexpectedText,
+		domain.AuditItemResultWrongLocation: wrongLocationText,
+	}
+
+	// Page setup (A4 Landscape: 842 x 595 points)
+	marginLeft := 30.0
+	marginTop := 50.0
+	pageWidth := 842.0
+	pageHeight := 595.0
+	contentWidth := pageWidth - (marginLeft * 2)
+
+	// Add company logo if exists
+	currentY := marginTop
+	if _, err := os.Stat(logoPath); err == nil {
+		rect := &gopdf.Rect{W: 60, H: 60}
+		pdf.Image(logoPath, marginLeft, currentY-10, rect)
+
+		pdf.SetFont("noto-bold", "", 16)
+		pdf.SetX(marginLeft + 70)
+		pdf.SetY(currentY + 15)
+		pdf.Cell(nil, reportTitle)
+
+		currentY += 50
+	} else {
+		pdf.SetFont("noto-bold", "", 16)
+		titleWidth, _ := pdf.MeasureTextWidth(reportTitle)
+		pdf.SetX((pageWidth - titleWidth) / 2)
+		pdf.SetY(currentY)
+		pdf.Cell(nil, reportTitle)
+
+		currentY += 30
+	}
+
+	// Session title
+	pdf.SetFont("noto-bold", "", 12)
+	sessionTitleWidth, _ := pdf.MeasureTextWidth(report.Session.Title)
+	pdf.SetX((pageWidth - sessionTitleWidth) / 2)
+	pdf.SetY(currentY)
+	pdf.Cell(nil, report.Session.Title)
+
+	currentY += 20
+
+	// Subtitle with generated, started and closed date
+	pdf.SetFont("noto-regular", "", 10)
+	dateText := fmt.Sprintf("%s: %s  |  %s: %s", generatedOnText, time.Now().Format("2006-01-02 15:04:05"), startedAtText, report.Session.StartedAt.Format("2006-01-02 15:04"))
+	if report.Session.ClosedAt != nil {
+		dateText += fmt.Sprintf("  |  %s: %s", closedAtText, report.Session.ClosedAt.Format("2006-01-02 15:04"))
+	}
+	dateWidth, _ := pdf.MeasureTextWidth(dateText)
+	pdf.SetX((pageWidth - dateWidth) / 2)
+	pdf.SetY(currentY)
+	pdf.Cell(nil, dateText)
+
+	currentY += 20
+
+	// Summary counts
+	pdf.SetFont("noto-bold", "", 10)
+	summaryText := fmt.Sprintf("%s: %d  |  %s: %d  |  %s: %d  |  %s: %d  |  %s: %d  |  %s: %d  |  %s: %.2f%%",
+		expectedText, report.Summary.ExpectedCount,
+		scannedText, report.Summary.ScannedCount,
+		foundText, report.Summary.FoundCount,
+		missingText, report.Summary.MissingCount,
+		wrongLocationText, report.Summary.WrongLocationCount,
+		unexpectedText, report.Summary.UnexpectedCount,
+		accuracyText, report.Summary.AccuracyPercentage.Float64(),
+	)
+	summaryWidth, _ := pdf.MeasureTextWidth(summaryText)
+	pdf.SetX((pageWidth - summaryWidth) / 2)
+	pdf.SetY(currentY)
+	pdf.Cell(nil, summaryText)
+
+	currentY += 25
+
+	// Column widths (A4 landscape: 782 total usable width)
+	colWidths := []float64{80, 140, 95, 80, 110, 110, 85, 82} // Total: 782
+	headers := []string{assetTagText, assetNameText, categoryText, resultText, expectedLocationText, actualLocationText, scannedAtText, actionText}
+
+	drawHeader := func(y float64) {
+		pdf.SetFillColor(68, 114, 196) // Blue background
+		pdf.RectFromUpperLeftWithStyle(marginLeft, y, contentWidth, 25, "F")
+		pdf.SetTextColor(255, 255, 255) // White text
+		pdf.SetFont("noto-bold", "", 9)
+
+		x := marginLeft
+		for i, header := range headers {
+			pdf.SetX(x + 3)
+			pdf.SetY(y + 8)
+			pdf.Cell(nil, header)
+			x += colWidths[i]
+		}
+
+		pdf.SetTextColor(0, 0, 0)
+		pdf.SetFont("noto-regular", "", 8)
+	}
+
+	// Truncate text to fit column width
+	fitText := func(text string, maxWidth float64) string {
+		runes := []rune(text)
+		for len(runes) > 0 {
+			width, _ := pdf.MeasureTextWidth(string(runes))
+			if width <= maxWidth-6 {
+				break
+			}
+			runes = runes[:len(runes)-1]
+		}
+		return string(runes)
+	}
+
+	y := currentY
+	drawHeader(y)
+	y += 25
+
+	rowHeight := 18.0
+	for i, item := range reportItemsInOrder(report) {
+		// Check if need new page
+		if y+rowHeight > pageHeight-40 {
+			pdf.AddPage()
+			y = marginTop
+			drawHeader(y)
+			y += 25
+		}
+
+		// Zebra striping
+		if i%2 == 1 {
+			pdf.SetFillColor(242, 242, 242)
+			pdf.RectFromUpperLeftWithStyle(marginLeft, y, contentWidth, rowHeight, "F")
+		}
+
+		assetTag, assetName, category, actualLocation := "-", "-", "-", "-"
+		if item.Asset != nil {
+			assetTag = item.Asset.AssetTag
+			assetName = item.Asset.AssetName
+			if item.Asset.Category != nil {
+				category = item.Asset.Category.CategoryName
+			}
+			if item.Asset.Location != nil {
+				actualLocation = item.Asset.Location.LocationName
+			}
+		}
+
+		expectedLocation := "-"
+		if item.ExpectedLocation != nil {
+			expectedLocation = item.ExpectedLocation.LocationName
+		}
+
+		scannedAt := "-"
+		if item.ScannedAt != nil {
+			scannedAt = item.ScannedAt.Format("2006-01-02 15:04")
+		}
+
+		action := "-"
+		if item.CorrectionMovementID != nil {
+			action = correctedText
+		} else if item.MarkedLost {
+			action = markedLostText
+		}
+
+		values := []string{
+			assetTag,
+			assetName,
+			category,
+			resultLabels[item.Result],
+			expectedLocation,
+			actualLocation,
+			scannedAt,
+			action,
+		}
+
+		x := marginLeft
+		for j, value := range values {
+			pdf.SetX(x + 3)
+			pdf.SetY(y + 5)
+			pdf.Cell(nil, fitText(value, colWidths[j]))
+			x += colWidths[j]
+		}
+
+		y += rowHeight
+	}
+
+	// Get PDF bytes
+	var buf bytes.Buffer
+	if err := pdf.Write(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// exportAuditSessionReportToExcel generates Excel file for audit session reconciliation report
+func (s *Service) exportAuditSessionReportToExcel(report domain.AuditSessionReportResponse) ([]byte, error) {
+	f := excelize.NewFile()
+	defer func() {
+		if err := f.Close(); err != nil {
+			fmt.Println("Error closing Excel file:", err)
+		}
+	}()
+
+	// Create header style
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 12,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#4472C4"},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// * Summary sheet
+	summarySheet := "Summary"
+	summaryIndex, err := f.NewSheet(summarySheet)
+	if err != nil {
+		return nil, err
+	}
+	f.SetActiveSheet(summaryIndex)
+
+	closedAt := ""
+	if report.Session.ClosedAt != nil {
+		closedAt = report.Session.ClosedAt.Format("2006-01-02 15:04:05")
+	}
+
+	summaryRows := [][]any{
+		{"Title", report.Session.Title},
+		{"Status", string(report.Session.Status)},
+		{"Started At", report.Session.StartedAt.Format("2006-01-02 15:04:05")},
+		{"Closed At", closedAt},
+		{"Expected", report.Summary.ExpectedCount},
+		{"Scanned", report.Summary.ScannedCount},
+		{"Found", report.Summary.FoundCount},
+		{"Missing", report.Summary.MissingCount},
+		{"Wrong Location", report.Summary.WrongLocationCount},
+		{"Unexpected", report.Summary.UnexpectedCount},
+		{"Moved", report.Summary.CorrectedCount},
+		{"Marked Lost", report.Summary.MarkedLostCount},
+		{"Accuracy (%)", fmt.Sprintf("%.2f", report.Summary.AccuracyPercentage.Float64())},
+	}
+
+	for row, values := range summaryRows {
+		rowNum := row + 1
+		labelCell := fmt.Sprintf("A%d", rowNum)
+		f.SetCellValue(summarySheet, labelCell, values[0])
+		f.SetCellStyle(summarySheet, labelCell, labelCell, headerStyle)
+		f.SetCellValue(summarySheet, fmt.Sprintf("B%d", rowNum), values[1])
+	}
+	f.SetColWidth(summarySheet, "A", "B", 24)
+
+	// * Items sheet
+	itemsSheet := "Items"
+	if _, err := f.NewSheet(itemsSheet); err != nil {
+		return nil, err
+	}
+
+	headers := []string{
+		"Asset Tag", "Asset Name", "Category", "Result", "Expected",
+		"Expected Location", "Recorded Location", "Scanned At", "Scanned By ID",
+		"Moved", "Marked Lost",
+	}
+
+	for col, header := range headers {
+		cell, _ := excelize.CoordinatesToCellName(col+1, 1)
+		f.SetCellValue(itemsSheet, cell, header)
+		f.SetCellStyle(itemsSheet, cell, cell, headerStyle)
+	}
+
+	// Add data
+	for row, item := range reportItemsInOrder(report) {
+		rowNum := row + 2 // Start from row 2 (after header)
+
+		assetTag, assetName, category, actualLocation := "", "", "", ""
+		if item.Asset != nil {
+			assetTag = item.Asset.AssetTag
+			assetName = item.Asset.AssetName
+			if item.Asset.Category != nil {
+				category = item.Asset.Category.CategoryName
+			}
+			if item.Asset.Location != nil {
+				actualLocation = item.Asset.Location.LocationName
+			}
+		}
+
+		expectedLocation := ""
+		if item.ExpectedLocation != nil {
+			expectedLocation = item.ExpectedLocation.LocationName
+		}
+
+		scannedAt := ""
+		if item.ScannedAt != nil {
+			scannedAt = item.ScannedAt.Format("2006-01-02 15:04:05")
+		}
+
+		scannedBy := ""
+		if item.ScannedByID != nil {
+			scannedBy = *item.ScannedByID
+		}
+
+		result := string(item.Result)
+		if item.Result == domain.AuditItemResultPending {
+			result = string(domain.AuditItemResultMissing)
+		}
+
+		f.SetCellValue(itemsSheet, fmt.Sprintf("A%d", rowNum), assetTag)
+		f.SetCellValue(itemsSheet, fmt.Sprintf("B%d", rowNum), assetName)
+		f.SetCellValue(itemsSheet, fmt.Sprintf("C%d", rowNum), category)
+		f.SetCellValue(itemsSheet, fmt.Sprintf("D%d", rowNum), result)
+		f.SetCellValue(itemsSheet, fmt.Sprintf("E%d", rowNum), item.IsExpected)
+		f.SetCellValue(itemsSheet, fmt.Sprintf("F%d", rowNum), expectedLocation)
+		f.SetCellValue(itemsSheet, fmt.Sprintf("G%d", rowNum), actualLocation)
+		f.SetCellValue(itemsSheet, fmt.Sprintf("H%d", rowNum), scannedAt)
+		f.SetCellValue(itemsSheet, fmt.Sprintf("I%d", rowNum), scannedBy)
+		f.SetCellValue(itemsSheet, fmt.Sprintf("J%d", rowNum), item.CorrectionMovementID != nil)
+		f.SetCellValue(itemsSheet, fmt.Sprintf("K%d", rowNum), item.MarkedLost)
+	}
+
+	// Auto-fit columns
+	for col := 1; col <= len(headers); col++ {
+		colName, _ := excelize.ColumnNumberToName(col)
+		f.SetColWidth(itemsSheet, colName, colName, 20)
+	}
+
+	// Remove default sheet
+	f.DeleteSheet("Sheet1")
+
+	// Save to buffer
+	buffer, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,418 @@
+package audit_session
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+// * Repository interface defines the contract for audit session data operations
+type Repository interface {
+	// * MUTATION
+	CreateAuditSession(ctx context.Context, payload *domain.AuditSession) (domain.AuditSession, error)
+	CreateAuditSessionItem(ctx context.Context, payload *domain.AuditSessionItem) (domain.AuditSessionItem, error)
+	UpdateAuditSessionItemScan(ctx context.Context, item *domain.AuditSessionItem) error
+	CloseAuditSession(ctx context.Context, session *domain.AuditSession, corrections []domain.AssetMovement, lostAssetIds []string) (domain.AuditSession, error)
+
+	// * QUERY
+	GetAuditSessionsPaginated(ctx context.Context, params domain.AuditSessionParams) ([]domain.AuditSession, error)
+	GetAuditSessionsCursor(ctx context.Context, params domain.AuditSessionParams) ([]domain.AuditSession, error)
+	GetAuditSessionById(ctx context.Context, sessionId string) (domain.AuditSession, error)
+	CheckOpenAuditSessionExists(ctx context.Context, locationId *string, categoryId *string) (bool, error)
+	GetOpenAuditSessionIdForAsset(ctx context.Context, assetId string) (*string, error)
+	GetAuditSessionItems(ctx context.Context, sessionId string, result *domain.AuditItemResult) ([]domain.AuditSessionItem, error)
+	GetAuditSessionItemByAssetId(ctx context.Context, sessionId string, assetId string) (domain.AuditSessionItem, error)
+	CountAuditSessions(ctx context.Context, params domain.AuditSessionParams) (int64, error)
+}
+
+// * AssetService interface for reading the recorded state of scanned assets
+type AssetService interface {
+	GetAssetById(ctx context.Context, assetId string, langCode string) (domain.AssetResponse, error)
+}
+
+// * LocationService interface for checking location existence
+type LocationService interface {
+	CheckLocationExists(ctx context.Context, locationId string) (bool, error)
+}
+
+// * CategoryService interface for checking category existence
+type CategoryService interface {
+	CheckCategoryExists(ctx context.Context, categoryId string) (bool, error)
+}
+
+type AuditSessionService interface {
+	// * MUTATION
+	CreateAuditSession(ctx context.Context, payload *domain.CreateAuditSessionPayload, startedBy string, langCode string) (domain.AuditSessionResponse, error)
+	CloseAuditSession(ctx context.Context, sessionId string, payload *domain.CloseAuditSessionPayload, closedBy string, langCode string) (domain.AuditSessionReportResponse, error)
+	ResolveOpenAuditSession(ctx context.Context, auditSessionId *string, assetId *string) (*string, error)
+	RecordAuditSessionScans(ctx context.Context, scanLogs []domain.ScanLog) error
+
+	// * QUERY
+	GetAuditSessionsPaginated(ctx context.Context, params domain.AuditSessionParams, langCode string) ([]domain.AuditSessionResponse, int64, error)
+	GetAuditSessionsCursor(ctx context.Context, params domain.AuditSessionParams, langCode string) ([]domain.AuditSessionResponse, error)
+	GetAuditSessionById(ctx context.Context, sessionId string, langCode string) (domain.AuditSessionResponse, error)
+	GetAuditSessionItems(ctx context.Context, sessionId string, result *domain.AuditItemResult, langCode string) ([]domain.AuditSessionItemResponse, error)
+	GetAuditSessionReport(ctx context.Context, sessionId string, langCode string) (domain.AuditSessionReportResponse, error)
+	CountAuditSessions(ctx context.Context, params domain.AuditSessionParams) (int64, error)
+	ExportAuditSessionReport(ctx context.Context, sessionId string, payload *domain.ExportAuditSessionReportPayload, langCode string) ([]byte, string, error)
+}
+
+type Service struct {
+	Repo            Repository
+	AssetService    AssetService
+	LocationService LocationService
+	CategoryService CategoryService
+}
+
+// * Ensure Service implements AuditSessionService interface
+var _ AuditSessionService = (*Service)(nil)
+
+func NewService(r Repository, assetService AssetService, locationService LocationService, categoryService CategoryService) AuditSessionService {
+	return &Service{
+		Repo:            r,
+		AssetService:    assetService,
+		LocationService: locationService,
+		CategoryService: categoryService,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (s *Service) CreateAuditSession(ctx context.Context, payload *domain.CreateAuditSessionPayload, startedBy string, langCode string) (domain.AuditSessionResponse, error) {
+	if payload.LocationID != nil && *payload.LocationID == "" {
+		payload.LocationID = nil
+	}
+	if payload.CategoryID != nil && *payload.CategoryID == "" {
+		payload.CategoryID = nil
+	}
+
+	// * Session must be scoped, otherwise every asset would be expected
+	if payload.LocationID == nil && payload.CategoryID == nil {
+		return domain.AuditSessionResponse{}, domain.ErrBadRequestWithKey(utils.ErrAuditSessionScopeRequiredKey)
+	}
+
+	if payload.LocationID != nil {
+		if locationExists, err := s.LocationService.CheckLocationExists(ctx, *payload.LocationID); err != nil {
+			return domain.AuditSessionResponse{}, err
+		} else if !locationExists {
+			return domain.AuditSessionResponse{}, domain.ErrNotFoundWithKey(utils.ErrLocationNotFoundKey)
+		}
+	}
+
+	if payload.CategoryID != nil {
+		if categoryExists, err := s.CategoryService.CheckCategoryExists(ctx, *payload.CategoryID); err != nil {
+			return domain.AuditSessionResponse{}, err
+		} else if !categoryExists {
+			return domain.AuditSessionResponse{}, domain.ErrNotFoundWithKey(utils.ErrCategoryNotFoundKey)
+		}
+	}
+
+	// * Only one open session per scope, scans would be split otherwise
+	if openExists, err := s.Repo.CheckOpenAuditSessionExists(ctx, payload.LocationID, payload.CategoryID); err != nil {
+		return domain.AuditSessionResponse{}, err
+	} else if openExists {
+		return domain.AuditSessionResponse{}, domain.ErrConflictWithKey(utils.ErrAuditSessionAlreadyOpenKey)
+	}
+
+	newSession := domain.AuditSession{
+		Title:      payload.Title,
+		LocationID: payload.LocationID,
+		CategoryID: payload.CategoryID,
+		Status:     domain.AuditSessionStatusOpen,
+		StartedBy:  startedBy,
+		StartedAt:  time.Now().UTC(),
+		Notes:      payload.Notes,
+	}
+
+	createdSession, err := s.Repo.CreateAuditSession(ctx, &newSession)
+	if err != nil {
+		return domain.AuditSessionResponse{}, err
+	}
+
+	return mapper.AuditSessionToResponse(&createdSession, langCode), nil
+}
+
+func (s *Service) CloseAuditSession(ctx context.Context, sessionId string, payload *domain.CloseAuditSessionPayload, closedBy string, langCode string) (domain.AuditSessionReportResponse, error) {
+	session, err := s.Repo.GetAuditSessionById(ctx, sessionId)
+	if err != nil {
+		return domain.AuditSessionReportResponse{}, err
+	}
+
+	if session.Status != domain.AuditSessionStatusOpen {
+		return domain.AuditSessionReportResponse{}, domain.ErrBadRequestWithKey(utils.ErrAuditSessionNotOpenKey)
+	}
+
+	items, err := s.Repo.GetAuditSessionItems(ctx, sessionId, nil)
+	if err != nil {
+		return domain.AuditSessionReportResponse{}, err
+	}
+
+	now := time.Now().UTC()
+
+	// * Wrong-location assets are moved to the audited location, where they were physically found
+	corrections := []domain.AssetMovement{}
+	if payload.CreateMovementCorrections && session.LocationID != nil {
+		for _, item := range items {
+			if item.Result != domain.AuditItemResultWrongLocation {
+				continue
+			}
+			corrections = append(corrections, domain.AssetMovement{
+				AssetID:        item.AssetID,
+				FromLocationID: item.ExpectedLocationID,
+				ToLocationID:   session.LocationID,
+				MovementDate:   now,
+				MovedBy:        closedBy,
+			})
+		}
+	}
+
+	// * Expected assets never scanned are the ones to mark as lost
+	lostAssetIds := []string{}
+	if payload.MarkMissingAsLost {
+		for _, item := range items {
+			if item.IsExpected && item.Result == domain.AuditItemResultPending {
+				lostAssetIds = append(lostAssetIds, item.AssetID)
+			}
+		}
+	}
+
+	session.ClosedBy = &closedBy
+	session.ClosedAt = &now
+	if payload.Notes != nil {
+		session.Notes = payload.Notes
+	}
+
+	if _, err := s.Repo.CloseAuditSession(ctx, &session, corrections, lostAssetIds); err != nil {
+		return domain.AuditSessionReportResponse{}, err
+	}
+
+	return s.GetAuditSessionReport(ctx, sessionId, langCode)
+}
+
+// ResolveOpenAuditSession returns the session a scan belongs to, explicit sessions must be open
+func (s *Service) ResolveOpenAuditSession(ctx context.Context, auditSessionId *string, assetId *string) (*string, error) {
+	if auditSessionId != nil && *auditSessionId != "" {
+		session, err := s.Repo.GetAuditSessionById(ctx, *auditSessionId)
+		if err != nil {
+			return nil, err
+		}
+		if session.Status != domain.AuditSessionStatusOpen {
+			return nil, domain.ErrBadRequestWithKey(utils.ErrAuditSessionNotOpenKey)
+		}
+		return &session.ID, nil
+	}
+
+	if assetId == nil || *assetId == "" {
+		return nil, nil
+	}
+
+	// * Fall back to the latest open session expecting this asset
+	return s.Repo.GetOpenAuditSessionIdForAsset(ctx, *assetId)
+}
+
+// RecordAuditSessionScans reconciles successful scans against their session items
+func (s *Service) RecordAuditSessionScans(ctx context.Context, scanLogs []domain.ScanLog) error {
+	sessions := map[string]domain.AuditSession{}
+
+	for _, scanLog := range scanLogs {
+		if scanLog.AuditSessionID == nil || scanLog.AssetID == nil || scanLog.ScanResult != domain.ScanResultSuccess {
+			continue
+		}
+
+		session, cached := sessions[*scanLog.AuditSessionID]
+		if !cached {
+			fetched, err := s.Repo.GetAuditSessionById(ctx, *scanLog.AuditSessionID)
+			if err != nil {
+				return err
+			}
+			session = fetched
+			sessions[session.ID] = session
+		}
+		if session.Status != domain.AuditSessionStatusOpen {
+			continue
+		}
+
+		scanLogId := scanLog.ID
+		scannedBy := scanLog.ScannedBy
+		scannedAt := scanLog.ScanTimestamp
+
+		item, err := s.Repo.GetAuditSessionItemByAssetId(ctx, session.ID, *scanLog.AssetID)
+		if err == nil {
+			// * Already reconciled by an earlier scan
+			if item.ScannedAt != nil {
+				continue
+			}
+
+			item.Result = domain.AuditItemResultFound
+			item.ScanLogID = &scanLogId
+			item.ScannedBy = &scannedBy
+			item.ScannedAt = &scannedAt
+			if err := s.Repo.UpdateAuditSessionItemScan(ctx, &item); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// * Asset was not expected in this session, classify it against its recorded state
+		asset, err := s.AssetService.GetAssetById(ctx, *scanLog.AssetID, mapper.DefaultLangCode)
+		if err != nil {
+			log.Printf("Failed to load scanned asset %s for audit session %s: %v", *scanLog.AssetID, session.ID, err)
+			continue
+		}
+
+		newItem := domain.AuditSessionItem{
+			SessionID:          session.ID,
+			AssetID:            asset.ID,
+			IsExpected:         false,
+			ExpectedLocationID: asset.LocationID,
+			Result:             classifyUnexpectedAsset(session, asset),
+			ScanLogID:          &scanLogId,
+			ScannedBy:          &scannedBy,
+			ScannedAt:          &scannedAt,
+		}
+		if _, err := s.Repo.CreateAuditSessionItem(ctx, &newItem); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetAuditSessionsPaginated(ctx context.Context, params domain.AuditSessionParams, langCode string) ([]domain.AuditSessionResponse, int64, error) {
+	sessions, err := s.Repo.GetAuditSessionsPaginated(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// * Count total for pagination
+	count, err := s.Repo.CountAuditSessions(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return mapper.AuditSessionsToResponses(sessions, langCode), count, nil
+}
+
+func (s *Service) GetAuditSessionsCursor(ctx context.Context, params domain.AuditSessionParams, langCode string) ([]domain.AuditSessionResponse, error) {
+	sessions, err := s.Repo.GetAuditSessionsCursor(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.AuditSessionsToResponses(sessions, langCode), nil
+}
+
+func (s *Service) GetAuditSessionById(ctx context.Context, sessionId string, langCode string) (domain.AuditSessionResponse, error) {
+	session, err := s.Repo.GetAuditSessionById(ctx, sessionId)
+	if err != nil {
+		return domain.AuditSessionResponse{}, err
+	}
+
+	return mapper.AuditSessionToResponse(&session, langCode), nil
+}
+
+func (s *Service) GetAuditSessionItems(ctx context.Context, sessionId string, result *domain.AuditItemResult, langCode string) ([]domain.AuditSessionItemResponse, error) {
+	if _, err := s.Repo.GetAuditSessionById(ctx, sessionId); err != nil {
+		return nil, err
+	}
+
+	items, err := s.Repo.GetAuditSessionItems(ctx, sessionId, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.AuditSessionItemsToResponses(items, langCode), nil
+}
+
+func (s *Service) GetAuditSessionReport(ctx context.Context, sessionId string, langCode string) (domain.AuditSessionReportResponse, error) {
+	session, err := s.Repo.GetAuditSessionById(ctx, sessionId)
+	if err != nil {
+		return domain.AuditSessionReportResponse{}, err
+	}
+
+	items, err := s.Repo.GetAuditSessionItems(ctx, sessionId, nil)
+	if err != nil {
+		return domain.AuditSessionReportResponse{}, err
+	}
+
+	report := domain.AuditSessionReportResponse{
+		Session:       mapper.AuditSessionToResponse(&session, langCode),
+		Found:         []domain.AuditSessionItemResponse{},
+		Missing:       []domain.AuditSessionItemResponse{},
+		Unexpected:    []domain.AuditSessionItemResponse{},
+		WrongLocation: []domain.AuditSessionItemResponse{},
+	}
+
+	for _, item := range items {
+		itemResponse := mapper.AuditSessionItemToResponse(&item, langCode)
+
+		if item.IsExpected {
+			report.Summary.ExpectedCount++
+		}
+		if item.ScannedAt != nil {
+			report.Summary.ScannedCount++
+		}
+		if item.CorrectionMovementID != nil {
+			report.Summary.CorrectedCount++
+		}
+		if item.MarkedLost {
+			report.Summary.MarkedLostCount++
+		}
+
+		switch item.Result {
+		case domain.AuditItemResultFound:
+			report.Found = append(report.Found, itemResponse)
+		// * While the session is open, not yet scanned assets are reported as missing
+		case domain.AuditItemResultMissing, domain.AuditItemResultPending:
+			report.Missing = append(report.Missing, itemResponse)
+		case domain.AuditItemResultUnexpected:
+			report.Unexpected = append(report.Unexpected, itemResponse)
+		case domain.AuditItemResultWrongLocation:
+			report.WrongLocation = append(report.WrongLocation, itemResponse)
+		}
+	}
+
+	report.Summary.FoundCount = len(report.Found)
+	report.Summary.MissingCount = len(report.Missing)
+	report.Summary.UnexpectedCount = len(report.Unexpected)
+	report.Summary.WrongLocationCount = len(report.WrongLocation)
+
+	if report.Summary.ExpectedCount > 0 {
+		accuracy := float64(report.Summary.FoundCount) / float64(report.Summary.ExpectedCount) * 100
+		report.Summary.AccuracyPercentage = domain.NewDecimal2(accuracy)
+	}
+
+	return report, nil
+}
+
+func (s *Service) CountAuditSessions(ctx context.Context, params domain.AuditSessionParams) (int64, error) {
+	count, err := s.Repo.CountAuditSessions(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// *===========================HELPER METHODS===========================*
+
+// * classifyUnexpectedAsset decides whether an unexpected asset is simply out of scope or recorded at another location
+func classifyUnexpectedAsset(session domain.AuditSession, asset domain.AssetResponse) domain.AuditItemResult {
+	if session.LocationID == nil {
+		return domain.AuditItemResultUnexpected
+	}
+
+	if session.CategoryID != nil && asset.CategoryID != *session.CategoryID {
+		return domain.AuditItemResultUnexpected
+	}
+
+	if asset.LocationID == nil || *asset.LocationID != *session.LocationID {
+		return domain.AuditItemResultWrongLocation
+	}
+
+	return domain.AuditItemResultUnexpected
+}
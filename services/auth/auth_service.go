@@ -4,19 +4,26 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
-	"sync"
+	"strings"
 	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/ldap"
+	"github.com/Rizz404/inventory-api/internal/client/oidc"
 	"github.com/Rizz404/inventory-api/internal/client/smtp"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
 	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/oklog/ulid/v2"
 )
 
 type Repository interface {
 	// * MUTATION
 	CreateUser(ctx context.Context, payload *domain.User) (domain.User, error)
 	UpdateUserPassword(ctx context.Context, email, passwordHash string) error
+	UpdateUser(ctx context.Context, userId string, payload *domain.UpdateUserPayload) (domain.User, error)
 	UpdateLastLogin(ctx context.Context, userId string) error
+	RecordFailedLogin(ctx context.Context, userId string, maxAttempts int, lockedUntil time.Time) (bool, error)
+	ResetFailedLogins(ctx context.Context, userId string) error
 
 	// * QUERY
 	GetUserById(ctx context.Context, userId string) (domain.User, error)
@@ -26,23 +33,108 @@ type Repository interface {
 	CheckEmailExists(ctx context.Context, email string) (bool, error)
 }
 
-// resetCodeEntry stores reset code with expiration
-type resetCodeEntry struct {
-	Code      string
-	Email     string
-	ExpiresAt time.Time
+const (
+	resetCodeTTL         = 15 * time.Minute
+	resetCodeMaxAttempts = 5
+	// * Throttling request kode reset, dihitung dari tabel password_reset_codes
+	resetCodeEmailLimit  = 3
+	resetCodeEmailWindow = 15 * time.Minute
+	resetCodeIPLimit     = 10
+	resetCodeIPWindow    = time.Hour
+)
+
+// * PasswordResetRepository interface for persisting hashed password reset codes
+type PasswordResetRepository interface {
+	// * MUTATION
+	CreateResetCode(ctx context.Context, payload *domain.PasswordResetCode) (domain.PasswordResetCode, error)
+	ReserveResetCodeAttempt(ctx context.Context, codeId string, maxAttempts int) (bool, error)
+	MarkResetCodeUsed(ctx context.Context, codeId string) (bool, error)
+
+	// * QUERY
+	GetActiveResetCode(ctx context.Context, email string) (domain.PasswordResetCode, bool, error)
+	CountResetCodesByEmailSince(ctx context.Context, email string, since time.Time) (int64, error)
+	CountResetCodesByIPSince(ctx context.Context, ip string, since time.Time) (int64, error)
+}
+
+// * TwoFactorRepository interface for TOTP enrolments and recovery codes
+type TwoFactorRepository interface {
+	// * MUTATION
+	SavePendingTwoFactor(ctx context.Context, userId string, secret string) (domain.UserTwoFactor, error)
+	EnableTwoFactor(ctx context.Context, userId string, step int64, recoveryCodeHashes []string) (bool, error)
+	DisableTwoFactor(ctx context.Context, userId string) error
+	ReplaceRecoveryCodes(ctx context.Context, userId string, recoveryCodeHashes []string) error
+	MarkTwoFactorStepUsed(ctx context.Context, userId string, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, userId string, codeHash string) (bool, error)
+	ReserveTwoFactorAttempt(ctx context.Context, userId string, maxAttempts int, lockUntil time.Time) (bool, error)
+	ResetTwoFactorFailures(ctx context.Context, userId string) error
+
+	// * QUERY
+	GetTwoFactorByUserId(ctx context.Context, userId string) (domain.UserTwoFactor, bool, error)
+	CountUnusedRecoveryCodes(ctx context.Context, userId string) (int64, error)
+}
+
+// * SessionRepository interface for persisting refresh token sessions
+type SessionRepository interface {
+	// * MUTATION
+	CreateSession(ctx context.Context, payload *domain.UserSession) (domain.UserSession, error)
+	RotateSessionToken(ctx context.Context, sessionId string, previousTokenId string, newTokenId string, expiresAt time.Time) (bool, error)
+	RevokeSession(ctx context.Context, sessionId string, reason domain.SessionRevokedReason) error
+	RevokeUserSessions(ctx context.Context, userId string, reason domain.SessionRevokedReason) error
+
+	// * QUERY
+	GetSessionById(ctx context.Context, sessionId string) (domain.UserSession, error)
+	GetActiveUserSessions(ctx context.Context, userId string) ([]domain.UserSession, error)
+}
+
+// * RoleService interface for resolving the permissions embedded in access tokens
+type RoleService interface {
+	GetUserPermissions(ctx context.Context, userId string) ([]string, error)
+}
+
+// * LocationService interface for resolving the location scope embedded in access tokens
+type LocationService interface {
+	GetUserLocationIds(ctx context.Context, userId string) ([]string, error)
+}
+
+// * AuditLogService interface for recording role changes made by SSO login
+type AuditLogService interface {
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+}
+
+// * Transactor interface for writing a role change and its audit entry in one transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Service struct {
-	Repo       Repository
-	SMTPClient *smtp.Client
-	resetCodes sync.Map // email -> resetCodeEntry
+	Repo              Repository
+	SessionRepo       SessionRepository
+	PasswordResetRepo PasswordResetRepository
+	TwoFactorRepo     TwoFactorRepository
+	OIDCRepo          OIDCRepository
+	SMTPClient        *smtp.Client
+	OIDCClient        *oidc.Client // * Nil kalau SSO tidak diaktifkan
+	LDAPClient        *ldap.Client // * Nil kalau LDAP tidak diaktifkan
+	RoleService       RoleService
+	LocationService   LocationService
+	AuditLogService   AuditLogService
+	Transactor        Transactor
 }
 
-func NewService(r Repository, smtpClient *smtp.Client) *Service {
+func NewService(r Repository, sessionRepo SessionRepository, passwordResetRepo PasswordResetRepository, twoFactorRepo TwoFactorRepository, oidcRepo OIDCRepository, smtpClient *smtp.Client, oidcClient *oidc.Client, ldapClient *ldap.Client, roleService RoleService, locationService LocationService, auditLogService AuditLogService, transactor Transactor) *Service {
 	return &Service{
-		Repo:       r,
-		SMTPClient: smtpClient,
+		Repo:              r,
+		SessionRepo:       sessionRepo,
+		PasswordResetRepo: passwordResetRepo,
+		TwoFactorRepo:     twoFactorRepo,
+		OIDCRepo:          oidcRepo,
+		SMTPClient:        smtpClient,
+		OIDCClient:        oidcClient,
+		LDAPClient:        ldapClient,
+		RoleService:       roleService,
+		LocationService:   locationService,
+		AuditLogService:   auditLogService,
+		Transactor:        transactor,
 	}
 }
 
@@ -83,76 +175,38 @@ func (s *Service) Register(ctx context.Context, payload *domain.RegisterPayload)
 	return createdUser, nil
 }
 
-func (s *Service) Login(ctx context.Context, payload *domain.LoginPayload) (domain.AuthResponse, error) {
+func (s *Service) Login(ctx context.Context, payload *domain.LoginPayload, client domain.SessionClientInfo) (domain.LoginResponse, error) {
 	// Search by email
 	user, err := s.Repo.GetUserByEmail(ctx, payload.Email)
 	if err != nil {
-		return domain.AuthResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrInvalidCredentialsKey)
+		return domain.LoginResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrInvalidCredentialsKey)
 	}
 
 	// Check if user is active
 	if !user.IsActive {
-		return domain.AuthResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrUserNotFoundKey) // * Use generic message for security
-	}
-
-	// Verify password
-	passwordIsValid := utils.CheckPasswordHash(payload.Password, user.PasswordHash)
-	if !passwordIsValid {
-		return domain.AuthResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrInvalidCredentialsKey)
+		return domain.LoginResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrUserNotFoundKey) // * Use generic message for security
 	}
 
-	// Create JWT payload with available fields
-	jwtPayload := &utils.CreateJWTPayload{
-		IDUser:   user.ID,
-		Name:     user.Name,
-		Email:    user.Email,
-		Role:     string(user.Role), // Convert UserRole to string
-		IsActive: user.IsActive,
+	// * Akun terkunci, password tidak dicek sama sekali sampai lock berakhir
+	now := time.Now().UTC()
+	if user.IsLocked(now) {
+		return domain.LoginResponse{}, domain.ErrTooManyRequestsWithKey(utils.ErrAccountLockedKey)
 	}
 
-	accessToken, err := utils.CreateAccessToken(jwtPayload)
+	// Verify password, user hasil sinkronisasi LDAP diverifikasi lewat bind ke direktori
+	passwordIsValid, err := s.verifyPassword(ctx, user, payload.Password)
 	if err != nil {
-		return domain.AuthResponse{}, domain.ErrInternal(err)
+		return domain.LoginResponse{}, err
 	}
-
-	refreshToken, err := utils.CreateRefreshToken(jwtPayload.IDUser)
-	if err != nil {
-		return domain.AuthResponse{}, domain.ErrInternal(err)
+	if !passwordIsValid {
+		return domain.LoginResponse{}, s.recordFailedLogin(ctx, user, now)
 	}
 
-	// Update last login timestamp (fire and forget, don't block login on failure)
-	go func() {
-		_ = s.Repo.UpdateLastLogin(context.Background(), user.ID)
-	}()
-
-	// Get current time for last login in response
-	now := time.Now().UTC()
-
-	// Create user response
-	userResponse := domain.UserResponse{
-		ID:            user.ID,
-		Name:          user.Name,
-		Email:         user.Email,
-		FullName:      user.FullName,
-		Role:          user.Role,
-		EmployeeID:    user.EmployeeID,
-		PreferredLang: user.PreferredLang,
-		IsActive:      user.IsActive,
-		AvatarURL:     user.AvatarURL,
-		PhoneNumber:   user.PhoneNumber,
-		FCMToken:      user.FCMToken,
-		LastLogin:     &now,
-		CreatedAt:     user.CreatedAt,
-		UpdatedAt:     user.UpdatedAt,
+	if err := s.clearFailedLogins(ctx, user); err != nil {
+		return domain.LoginResponse{}, err
 	}
 
-	authResponse := domain.AuthResponse{
-		User:         userResponse,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}
-
-	return authResponse, nil
+	return s.completeLogin(ctx, user, payload.DeviceName, client)
 }
 
 func (s *Service) RefreshToken(ctx context.Context, payload *domain.RefreshTokenPayload) (domain.AuthResponse, error) {
@@ -162,6 +216,17 @@ func (s *Service) RefreshToken(ctx context.Context, payload *domain.RefreshToken
 		return domain.AuthResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrTokenInvalidKey)
 	}
 
+	session, err := s.getTokenSession(ctx, claims)
+	if err != nil {
+		return domain.AuthResponse{}, err
+	}
+
+	// * Token lama dipakai lagi berarti bocor, matikan seluruh session supaya pemegang token manapun harus login ulang
+	if session.CurrentTokenID != claims.ID {
+		_ = s.SessionRepo.RevokeSession(ctx, session.ID, domain.SessionRevokedTokenReuse)
+		return domain.AuthResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrRefreshTokenReusedKey)
+	}
+
 	// Check if user still exists and is active
 	exists, err := s.Repo.CheckUserExists(ctx, claims.IDUser)
 	if err != nil {
@@ -182,13 +247,39 @@ func (s *Service) RefreshToken(ctx context.Context, payload *domain.RefreshToken
 		return domain.AuthResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrUserNotFoundKey)
 	}
 
+	// Permission di-resolve ulang supaya perubahan role langsung berlaku setelah refresh
+	permissions, err := s.RoleService.GetUserPermissions(ctx, user.ID)
+	if err != nil {
+		return domain.AuthResponse{}, err
+	}
+
+	locationIds, err := s.resolveLocationScope(ctx, user.ID, permissions)
+	if err != nil {
+		return domain.AuthResponse{}, err
+	}
+
+	// * Rotasi refresh token, gagal berarti token ini sudah dipakai request lain duluan
+	newTokenId := ulid.Make().String()
+	expiresAt := time.Now().UTC().Add(utils.RefreshTokenTTL)
+	rotated, err := s.SessionRepo.RotateSessionToken(ctx, session.ID, claims.ID, newTokenId, expiresAt)
+	if err != nil {
+		return domain.AuthResponse{}, err
+	}
+	if !rotated {
+		_ = s.SessionRepo.RevokeSession(ctx, session.ID, domain.SessionRevokedTokenReuse)
+		return domain.AuthResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrRefreshTokenReusedKey)
+	}
+
 	// Create new tokens with fresh user data
 	jwtPayload := &utils.CreateJWTPayload{
-		IDUser:   user.ID,
-		Name:     user.Name,
-		Email:    user.Email,
-		Role:     string(user.Role),
-		IsActive: user.IsActive,
+		IDUser:      user.ID,
+		Name:        user.Name,
+		Email:       user.Email,
+		Role:        string(user.Role),
+		IsActive:    user.IsActive,
+		Permissions: permissions,
+		LocationIDs: locationIds,
+		SessionID:   session.ID,
 	}
 
 	accessToken, err := utils.CreateAccessToken(jwtPayload)
@@ -196,7 +287,7 @@ func (s *Service) RefreshToken(ctx context.Context, payload *domain.RefreshToken
 		return domain.AuthResponse{}, domain.ErrInternal(err)
 	}
 
-	refreshToken, err := utils.CreateRefreshToken(user.ID)
+	refreshToken, err := utils.CreateRefreshToken(user.ID, session.ID, newTokenId, expiresAt)
 	if err != nil {
 		return domain.AuthResponse{}, domain.ErrInternal(err)
 	}
@@ -226,15 +317,47 @@ func (s *Service) RefreshToken(ctx context.Context, payload *domain.RefreshToken
 }
 
 // ForgotPassword generates and sends a password reset code to the user's email
-func (s *Service) ForgotPassword(ctx context.Context, payload *domain.ForgotPasswordPayload) (string, error) {
+func (s *Service) ForgotPassword(ctx context.Context, payload *domain.ForgotPasswordPayload, clientIP string) (string, error) {
 	// Check if SMTP is enabled
 	if s.SMTPClient == nil || !s.SMTPClient.IsEnabled() {
 		return "", domain.ErrInternalWithMessage("Email service is not available")
 	}
 
+	email := normalizeEmail(payload.Email)
+	now := time.Now().UTC()
+
+	// * Throttle per IP dan per email, email tidak terdaftar ikut dihitung supaya tidak bocor
+	if clientIP != "" {
+		ipCount, err := s.PasswordResetRepo.CountResetCodesByIPSince(ctx, clientIP, now.Add(-resetCodeIPWindow))
+		if err != nil {
+			return "", err
+		}
+		if ipCount >= resetCodeIPLimit {
+			return "", domain.ErrTooManyRequestsWithKey(utils.ErrResetCodeTooManyRequestsKey)
+		}
+	}
+
+	emailCount, err := s.PasswordResetRepo.CountResetCodesByEmailSince(ctx, email, now.Add(-resetCodeEmailWindow))
+	if err != nil {
+		return "", err
+	}
+	if emailCount >= resetCodeEmailLimit {
+		return "", domain.ErrTooManyRequestsWithKey(utils.ErrResetCodeTooManyRequestsKey)
+	}
+
 	// Check if user exists
 	user, err := s.Repo.GetUserByEmail(ctx, payload.Email)
 	if err != nil {
+		// * Request tetap dicatat sebagai kode yang langsung terpakai untuk hitungan throttling
+		if _, err := s.PasswordResetRepo.CreateResetCode(ctx, &domain.PasswordResetCode{
+			Email:       email,
+			RequestedIP: optionalString(clientIP, 45),
+			ExpiresAt:   now,
+			UsedAt:      &now,
+		}); err != nil {
+			return "", err
+		}
+
 		// Return success even if email doesn't exist (security: don't reveal if email exists)
 		return "If the email exists, a reset code will be sent", nil
 	}
@@ -245,12 +368,21 @@ func (s *Service) ForgotPassword(ctx context.Context, payload *domain.ForgotPass
 		return "", domain.ErrInternal(err)
 	}
 
-	// Store code with 15 minutes expiration
-	s.resetCodes.Store(payload.Email, resetCodeEntry{
-		Code:      code,
-		Email:     payload.Email,
-		ExpiresAt: time.Now().UTC().Add(15 * time.Minute),
-	})
+	codeHash, err := utils.HashPassword(code)
+	if err != nil {
+		return "", domain.ErrInternal(err)
+	}
+
+	// Store hashed code with expiration, older unused codes are invalidated
+	if _, err := s.PasswordResetRepo.CreateResetCode(ctx, &domain.PasswordResetCode{
+		UserID:      &user.ID,
+		Email:       email,
+		CodeHash:    codeHash,
+		RequestedIP: optionalString(clientIP, 45),
+		ExpiresAt:   now.Add(resetCodeTTL),
+	}); err != nil {
+		return "", err
+	}
 
 	// Send email
 	userName := user.Name
@@ -267,54 +399,50 @@ func (s *Service) ForgotPassword(ctx context.Context, payload *domain.ForgotPass
 
 // VerifyResetCode verifies the password reset code
 func (s *Service) VerifyResetCode(ctx context.Context, payload *domain.VerifyResetCodePayload) (domain.VerifyResetCodeResponse, error) {
-	entry, ok := s.resetCodes.Load(payload.Email)
-	if !ok {
-		return domain.VerifyResetCodeResponse{Valid: false}, nil
-	}
-
-	resetEntry := entry.(resetCodeEntry)
-
-	// Check if code expired
-	if time.Now().UTC().After(resetEntry.ExpiresAt) {
-		s.resetCodes.Delete(payload.Email)
-		return domain.VerifyResetCodeResponse{Valid: false}, nil
+	_, rejectedKey, err := s.checkResetCode(ctx, payload.Email, payload.Code)
+	if err != nil {
+		return domain.VerifyResetCodeResponse{}, err
 	}
 
-	// Check if code matches
-	if resetEntry.Code != payload.Code {
+	switch rejectedKey {
+	case "":
+		return domain.VerifyResetCodeResponse{Valid: true}, nil
+	case utils.ErrResetCodeAttemptsExceededKey:
+		return domain.VerifyResetCodeResponse{}, domain.ErrTooManyRequestsWithKey(rejectedKey)
+	default:
 		return domain.VerifyResetCodeResponse{Valid: false}, nil
 	}
-
-	return domain.VerifyResetCodeResponse{Valid: true}, nil
 }
 
 // ResetPassword resets the user's password using the verification code
 func (s *Service) ResetPassword(ctx context.Context, payload *domain.ResetPasswordPayload) (string, error) {
 	// Verify code first
-	entry, ok := s.resetCodes.Load(payload.Email)
-	if !ok {
-		return "", domain.ErrBadRequestWithKey(utils.ErrResetCodeNotFoundKey)
+	resetCode, rejectedKey, err := s.checkResetCode(ctx, payload.Email, payload.Code)
+	if err != nil {
+		return "", err
 	}
-
-	resetEntry := entry.(resetCodeEntry)
-
-	// Check if code expired
-	if time.Now().UTC().After(resetEntry.ExpiresAt) {
-		s.resetCodes.Delete(payload.Email)
-		return "", domain.ErrBadRequestWithKey(utils.ErrResetCodeExpiredKey)
+	if rejectedKey == utils.ErrResetCodeAttemptsExceededKey {
+		return "", domain.ErrTooManyRequestsWithKey(rejectedKey)
 	}
-
-	// Check if code matches
-	if resetEntry.Code != payload.Code {
-		return "", domain.ErrBadRequestWithKey(utils.ErrResetCodeInvalidKey)
+	if rejectedKey != "" {
+		return "", domain.ErrBadRequestWithKey(rejectedKey)
 	}
 
 	// Check if user exists
-	_, err := s.Repo.GetUserByEmail(ctx, payload.Email)
+	user, err := s.Repo.GetUserByEmail(ctx, payload.Email)
 	if err != nil {
 		return "", domain.ErrNotFoundWithKey(utils.ErrUserNotFoundKey)
 	}
 
+	// * Kode hanya bisa dipakai sekali, request paralel dengan kode yang sama akan gagal di sini
+	consumed, err := s.PasswordResetRepo.MarkResetCodeUsed(ctx, resetCode.ID)
+	if err != nil {
+		return "", err
+	}
+	if !consumed {
+		return "", domain.ErrBadRequestWithKey(utils.ErrResetCodeNotFoundKey)
+	}
+
 	// Hash new password
 	hashedPassword, err := utils.HashPassword(payload.NewPassword)
 	if err != nil {
@@ -322,16 +450,63 @@ func (s *Service) ResetPassword(ctx context.Context, payload *domain.ResetPasswo
 	}
 
 	// Update password
-	if err := s.Repo.UpdateUserPassword(ctx, payload.Email, hashedPassword); err != nil {
+	if err := s.Repo.UpdateUserPassword(ctx, user.Email, hashedPassword); err != nil {
 		return "", err
 	}
 
-	// Delete used reset code
-	s.resetCodes.Delete(payload.Email)
+	// * Session lama dicabut supaya pemegang password lama tidak bisa refresh lagi
+	if err := s.SessionRepo.RevokeUserSessions(ctx, user.ID, domain.SessionRevokedPasswordChanged); err != nil {
+		return "", err
+	}
 
 	return "Password reset successfully", nil
 }
 
+// Logout revokes the session the refresh token belongs to
+func (s *Service) Logout(ctx context.Context, payload *domain.LogoutPayload) error {
+	claims, err := utils.ValidateRefreshToken(payload.RefreshToken)
+	if err != nil {
+		return domain.ErrUnauthorizedWithKey(utils.ErrTokenInvalidKey)
+	}
+
+	session, err := s.getTokenSession(ctx, claims)
+	if err != nil {
+		return err
+	}
+
+	reason := domain.SessionRevokedLogout
+	if session.CurrentTokenID != claims.ID {
+		reason = domain.SessionRevokedTokenReuse
+	}
+	return s.SessionRepo.RevokeSession(ctx, session.ID, reason)
+}
+
+// LogoutAll revokes every session of the user, including the current one
+func (s *Service) LogoutAll(ctx context.Context, userId string) error {
+	return s.SessionRepo.RevokeUserSessions(ctx, userId, domain.SessionRevokedLogoutAll)
+}
+
+// RevokeUserSession revokes one session, it must belong to the given user
+func (s *Service) RevokeUserSession(ctx context.Context, userId string, sessionId string, reason domain.SessionRevokedReason) error {
+	session, err := s.SessionRepo.GetSessionById(ctx, sessionId)
+	if err != nil {
+		return err
+	}
+	if session.UserID != userId || session.RevokedAt != nil {
+		return domain.ErrNotFoundWithKey(utils.ErrSessionNotFoundKey)
+	}
+
+	return s.SessionRepo.RevokeSession(ctx, sessionId, reason)
+}
+
+// RevokeAllUserSessions is the admin variant of LogoutAll
+func (s *Service) RevokeAllUserSessions(ctx context.Context, userId string) error {
+	if _, err := s.Repo.GetUserById(ctx, userId); err != nil {
+		return err
+	}
+	return s.SessionRepo.RevokeUserSessions(ctx, userId, domain.SessionRevokedByAdmin)
+}
+
 // generateResetCode generates a random 6-digit code
 func (s *Service) generateResetCode() (string, error) {
 	b := make([]byte, 3)
@@ -344,4 +519,209 @@ func (s *Service) generateResetCode() (string, error) {
 	return fmt.Sprintf("%06d", code), nil
 }
 
+// checkResetCode validates a code against the latest active one, rejectedKey is empty when the code is valid
+func (s *Service) checkResetCode(ctx context.Context, email string, code string) (domain.PasswordResetCode, utils.MessageKey, error) {
+	resetCode, found, err := s.PasswordResetRepo.GetActiveResetCode(ctx, normalizeEmail(email))
+	if err != nil {
+		return domain.PasswordResetCode{}, "", err
+	}
+	if !found {
+		return domain.PasswordResetCode{}, utils.ErrResetCodeNotFoundKey, nil
+	}
+
+	if time.Now().UTC().After(resetCode.ExpiresAt) {
+		return domain.PasswordResetCode{}, utils.ErrResetCodeExpiredKey, nil
+	}
+
+	// * Attempt dipesan secara atomik sebelum bcrypt, request paralel tidak bisa menebak lebih dari batas
+	reserved, err := s.PasswordResetRepo.ReserveResetCodeAttempt(ctx, resetCode.ID, resetCodeMaxAttempts)
+	if err != nil {
+		return domain.PasswordResetCode{}, "", err
+	}
+	if !reserved {
+		return domain.PasswordResetCode{}, utils.ErrResetCodeAttemptsExceededKey, nil
+	}
+
+	if !utils.CheckPasswordHash(code, resetCode.CodeHash) {
+		return domain.PasswordResetCode{}, utils.ErrResetCodeInvalidKey, nil
+	}
+
+	return resetCode, "", nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// getTokenSession loads the active session a refresh token was issued for
+func (s *Service) getTokenSession(ctx context.Context, claims *utils.JWTClaims) (domain.UserSession, error) {
+	// * Refresh token lama tanpa session harus login ulang
+	if claims.SessionID == "" || claims.ID == "" {
+		return domain.UserSession{}, domain.ErrUnauthorizedWithKey(utils.ErrSessionRevokedKey)
+	}
+
+	session, err := s.SessionRepo.GetSessionById(ctx, claims.SessionID)
+	if err != nil {
+		return domain.UserSession{}, domain.ErrUnauthorizedWithKey(utils.ErrSessionRevokedKey)
+	}
+	if session.UserID != claims.IDUser || !session.IsActive(time.Now()) {
+		return domain.UserSession{}, domain.ErrUnauthorizedWithKey(utils.ErrSessionRevokedKey)
+	}
+
+	return session, nil
+}
+
+// issueAuthResponse creates a new session for the user and signs its token pair
+func (s *Service) issueAuthResponse(ctx context.Context, user domain.User, deviceName *string, client domain.SessionClientInfo) (domain.AuthResponse, error) {
+	// Resolve permissions from base role and assigned roles
+	permissions, err := s.RoleService.GetUserPermissions(ctx, user.ID)
+	if err != nil {
+		return domain.AuthResponse{}, err
+	}
+
+	locationIds, err := s.resolveLocationScope(ctx, user.ID, permissions)
+	if err != nil {
+		return domain.AuthResponse{}, err
+	}
+
+	// * Setiap login membuat session baru yang terikat ke device
+	tokenId := ulid.Make().String()
+	session, err := s.SessionRepo.CreateSession(ctx, &domain.UserSession{
+		ID:             ulid.Make().String(),
+		UserID:         user.ID,
+		CurrentTokenID: tokenId,
+		DeviceName:     deviceName,
+		UserAgent:      optionalString(client.UserAgent, 255),
+		IPAddress:      optionalString(client.IPAddress, 45),
+		ExpiresAt:      time.Now().UTC().Add(utils.RefreshTokenTTL),
+		LastUsedAt:     time.Now().UTC(),
+	})
+	if err != nil {
+		return domain.AuthResponse{}, err
+	}
+
+	// Create JWT payload with available fields
+	jwtPayload := &utils.CreateJWTPayload{
+		IDUser:      user.ID,
+		Name:        user.Name,
+		Email:       user.Email,
+		Role:        string(user.Role), // Convert UserRole to string
+		IsActive:    user.IsActive,
+		Permissions: permissions,
+		LocationIDs: locationIds,
+		SessionID:   session.ID,
+	}
+
+	accessToken, err := utils.CreateAccessToken(jwtPayload)
+	if err != nil {
+		return domain.AuthResponse{}, domain.ErrInternal(err)
+	}
+
+	refreshToken, err := utils.CreateRefreshToken(user.ID, session.ID, tokenId, session.ExpiresAt)
+	if err != nil {
+		return domain.AuthResponse{}, domain.ErrInternal(err)
+	}
+
+	// Update last login timestamp (fire and forget, don't block login on failure)
+	go func() {
+		_ = s.Repo.UpdateLastLogin(context.Background(), user.ID)
+	}()
+
+	// Get current time for last login in response
+	now := time.Now().UTC()
+
+	// Create user response
+	userResponse := domain.UserResponse{
+		ID:            user.ID,
+		Name:          user.Name,
+		Email:         user.Email,
+		FullName:      user.FullName,
+		Role:          user.Role,
+		EmployeeID:    user.EmployeeID,
+		PreferredLang: user.PreferredLang,
+		IsActive:      user.IsActive,
+		AvatarURL:     user.AvatarURL,
+		PhoneNumber:   user.PhoneNumber,
+		FCMToken:      user.FCMToken,
+		LastLogin:     &now,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
+	}
+
+	authResponse := domain.AuthResponse{
+		User:         userResponse,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+
+	return authResponse, nil
+}
+
+// optionalString trims client supplied metadata to the column size, empty becomes nil
+func optionalString(value string, maxLength int) *string {
+	if value == "" {
+		return nil
+	}
+	if len(value) > maxLength {
+		value = value[:maxLength]
+	}
+	return &value
+}
+
+// completeLogin issues the tokens once the first factor is verified.
+// 2FA aktif, atau wajib untuk role ini, berarti token baru dikeluarkan setelah kode diverifikasi
+func (s *Service) completeLogin(ctx context.Context, user domain.User, deviceName *string, client domain.SessionClientInfo) (domain.LoginResponse, error) {
+	twoFactor, hasTwoFactor, err := s.TwoFactorRepo.GetTwoFactorByUserId(ctx, user.ID)
+	if err != nil {
+		return domain.LoginResponse{}, err
+	}
+	twoFactorEnabled := hasTwoFactor && twoFactor.IsEnabled()
+	if twoFactorEnabled || isTwoFactorRequired(user) {
+		purpose := utils.TwoFactorChallengeVerify
+		if !twoFactorEnabled {
+			purpose = utils.TwoFactorChallengeSetup
+		}
+
+		expiresAt := time.Now().UTC().Add(utils.TwoFactorChallengeTTL)
+		challengeToken, err := utils.CreateTwoFactorChallengeToken(user.ID, purpose, deviceName, expiresAt)
+		if err != nil {
+			return domain.LoginResponse{}, domain.ErrInternal(err)
+		}
+
+		return domain.LoginResponse{
+			TwoFactorRequired:      true,
+			TwoFactorSetupRequired: !twoFactorEnabled,
+			ChallengeToken:         &challengeToken,
+			ChallengeExpiresAt:     &expiresAt,
+		}, nil
+	}
+
+	authResponse, err := s.issueAuthResponse(ctx, user, deviceName, client)
+	if err != nil {
+		return domain.LoginResponse{}, err
+	}
+
+	return domain.LoginResponse{AuthResponse: &authResponse}, nil
+}
+
+// resolveLocationScope returns the locations embedded in the token, user dengan permission location:all tidak dibatasi
+func (s *Service) resolveLocationScope(ctx context.Context, userId string, permissions []string) ([]string, error) {
+	if domain.HasPermission(permissions, domain.PermissionLocationAll) {
+		return nil, nil
+	}
+	return s.LocationService.GetUserLocationIds(ctx, userId)
+}
+
 // *===========================QUERY===========================*
+func (s *Service) GetUserSessions(ctx context.Context, userId string, currentSessionId string) ([]domain.UserSessionResponse, error) {
+	if _, err := s.Repo.GetUserById(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.SessionRepo.GetActiveUserSessions(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.UserSessionsToResponses(sessions, currentSessionId), nil
+}
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+// ldapAuthEnabled reads LDAP_AUTH_ENABLED, dibaca tiap login supaya tidak perlu restart
+func ldapAuthEnabled() bool {
+	return os.Getenv("LDAP_AUTH_ENABLED") == "true"
+}
+
+// verifyPassword checks the password against the directory for users synced from LDAP, user lain tetap pakai password lokal
+func (s *Service) verifyPassword(ctx context.Context, user domain.User, password string) (bool, error) {
+	if s.LDAPClient == nil || !ldapAuthEnabled() {
+		return utils.CheckPasswordHash(password, user.PasswordHash), nil
+	}
+
+	identity, found, err := s.OIDCRepo.GetUserIdentityByUserId(ctx, user.ID, domain.LDAPIdentityIssuer)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		return utils.CheckPasswordHash(password, user.PasswordHash), nil
+	}
+
+	valid, err := s.LDAPClient.Authenticate(ctx, identity.Subject, password)
+	if err != nil {
+		log.Printf("Failed to authenticate user %s against LDAP: %v", user.ID, err)
+		return false, domain.ErrInternal(err)
+	}
+
+	return valid, nil
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+const (
+	defaultLoginMaxFailedAttempts = 5
+	defaultLoginLockoutDuration   = 15 * time.Minute
+)
+
+// loginMaxFailedAttempts reads LOGIN_MAX_FAILED_ATTEMPTS, dibaca tiap login supaya tidak perlu restart
+func loginMaxFailedAttempts() int {
+	return utils.EnvPositiveInt("LOGIN_MAX_FAILED_ATTEMPTS", defaultLoginMaxFailedAttempts)
+}
+
+func loginLockoutDuration() time.Duration {
+	return utils.EnvPositiveDuration("LOGIN_LOCKOUT_DURATION", defaultLoginLockoutDuration)
+}
+
+// recordFailedLogin counts a wrong password, the attempt that reaches the limit gets the locked error directly
+func (s *Service) recordFailedLogin(ctx context.Context, user domain.User, now time.Time) error {
+	locked, err := s.Repo.RecordFailedLogin(ctx, user.ID, loginMaxFailedAttempts(), now.Add(loginLockoutDuration()))
+	if err != nil {
+		return err
+	}
+	if locked {
+		return domain.ErrTooManyRequestsWithKey(utils.ErrAccountLockedKey)
+	}
+
+	return domain.ErrUnauthorizedWithKey(utils.ErrInvalidCredentialsKey)
+}
+
+// clearFailedLogins resets the counter after a correct password, skip write kalau memang belum pernah gagal
+func (s *Service) clearFailedLogins(ctx context.Context, user domain.User) error {
+	if user.FailedLoginAttempts == 0 && user.LockedUntil == nil {
+		return nil
+	}
+
+	return s.Repo.ResetFailedLogins(ctx, user.ID)
+}
@@ -0,0 +1,339 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/oidc"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+const oidcAuthRequestTTL = 10 * time.Minute
+
+// * OIDCRepository interface for SSO login state and linked provider identities (OIDC dan LDAP)
+type OIDCRepository interface {
+	// * MUTATION
+	CreateOIDCAuthRequest(ctx context.Context, payload *domain.OIDCAuthRequest) (domain.OIDCAuthRequest, error)
+	ConsumeOIDCAuthRequest(ctx context.Context, stateHash string) (domain.OIDCAuthRequest, bool, error)
+	CreateUserIdentity(ctx context.Context, payload *domain.UserIdentity) (domain.UserIdentity, error)
+	RecordUserIdentityLogin(ctx context.Context, identityId string, email *string) error
+	UpdateUserIdentitySyncedRole(ctx context.Context, identityId string, role domain.UserRole) error
+
+	// * QUERY
+	GetUserIdentity(ctx context.Context, issuer string, subject string) (domain.UserIdentity, bool, error)
+	GetUserIdentityByUserId(ctx context.Context, userId string, issuer string) (domain.UserIdentity, bool, error)
+	CheckUserHasIdentity(ctx context.Context, userId string, issuer string) (bool, error)
+}
+
+// oidcRoleMapping parses OIDC_ROLE_MAPPING ("group=Role,group=Role"), dibaca tiap login supaya tidak perlu restart
+func oidcRoleMapping() map[string]domain.UserRole {
+	mapping := map[string]domain.UserRole{}
+
+	for _, entry := range strings.Split(os.Getenv("OIDC_ROLE_MAPPING"), ",") {
+		group, role, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			continue
+		}
+
+		switch userRole := domain.UserRole(strings.TrimSpace(role)); userRole {
+		case domain.RoleAdmin, domain.RoleStaff, domain.RoleEmployee:
+			mapping[strings.TrimSpace(group)] = userRole
+		default:
+			log.Printf("⚠️ Ignoring OIDC_ROLE_MAPPING entry %q, unknown role", entry)
+		}
+	}
+
+	return mapping
+}
+
+func oidcDefaultRole() domain.UserRole {
+	switch role := domain.UserRole(os.Getenv("OIDC_DEFAULT_ROLE")); role {
+	case domain.RoleAdmin, domain.RoleStaff, domain.RoleEmployee:
+		return role
+	default:
+		return domain.RoleEmployee
+	}
+}
+
+func oidcAutoProvisionEnabled() bool {
+	return os.Getenv("OIDC_AUTO_PROVISION") != "false"
+}
+
+// oidcSkipLocalTwoFactor trusts the MFA of the identity provider, harus diaktifkan eksplisit
+func oidcSkipLocalTwoFactor() bool {
+	return os.Getenv("OIDC_SKIP_LOCAL_2FA") == "true"
+}
+
+// oidcRoleSyncOverridesManual allows group sync to demote a role that an admin changed by hand
+func oidcRoleSyncOverridesManual() bool {
+	return os.Getenv("OIDC_ROLE_SYNC_OVERRIDE_MANUAL") == "true"
+}
+
+func oidcRoleRank(role domain.UserRole) int {
+	switch role {
+	case domain.RoleAdmin:
+		return 3
+	case domain.RoleStaff:
+		return 2
+	case domain.RoleEmployee:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// resolveOIDCRole picks the highest role among the user's groups, false kalau tidak ada group yang cocok
+func resolveOIDCRole(groups []string, mapping map[string]domain.UserRole) (domain.UserRole, bool) {
+	var resolved domain.UserRole
+	for _, group := range groups {
+		if role, ok := mapping[group]; ok && oidcRoleRank(role) > oidcRoleRank(resolved) {
+			resolved = role
+		}
+	}
+
+	return resolved, resolved != ""
+}
+
+// *===========================MUTATION===========================*
+
+// BeginOIDCLogin stores a new SSO login request and returns the provider URL the user has to visit
+func (s *Service) BeginOIDCLogin(ctx context.Context, deviceName string, clientIP string) (domain.OIDCAuthorizationResponse, error) {
+	if s.OIDCClient == nil {
+		return domain.OIDCAuthorizationResponse{}, domain.ErrNotFoundWithKey(utils.ErrOIDCDisabledKey)
+	}
+
+	state, nonce, codeVerifier, err := utils.GenerateOIDCParams()
+	if err != nil {
+		return domain.OIDCAuthorizationResponse{}, domain.ErrInternal(err)
+	}
+
+	authorizationURL, err := s.OIDCClient.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		log.Printf("Failed to build OIDC authorization URL: %v", err)
+		return domain.OIDCAuthorizationResponse{}, domain.ErrInternal(err)
+	}
+
+	authRequest, err := s.OIDCRepo.CreateOIDCAuthRequest(ctx, &domain.OIDCAuthRequest{
+		StateHash:    utils.HashOIDCState(state),
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		DeviceName:   optionalString(deviceName, 100),
+		RequestedIP:  optionalString(clientIP, 45),
+		ExpiresAt:    time.Now().UTC().Add(oidcAuthRequestTTL),
+	})
+	if err != nil {
+		return domain.OIDCAuthorizationResponse{}, err
+	}
+
+	return domain.OIDCAuthorizationResponse{
+		AuthorizationURL: authorizationURL,
+		State:            state,
+		ExpiresAt:        authRequest.ExpiresAt,
+	}, nil
+}
+
+// CompleteOIDCLogin exchanges the authorization code, resolves (or provisions) the user and issues the usual tokens.
+// 2FA lokal tetap diminta seperti login password, kecuali OIDC_SKIP_LOCAL_2FA=true karena MFA sudah ditangani identity provider.
+func (s *Service) CompleteOIDCLogin(ctx context.Context, payload *domain.OIDCCallbackPayload, client domain.SessionClientInfo) (domain.LoginResponse, error) {
+	if s.OIDCClient == nil {
+		return domain.LoginResponse{}, domain.ErrNotFoundWithKey(utils.ErrOIDCDisabledKey)
+	}
+
+	// * State hanya bisa dipakai sekali, callback yang diulang langsung ditolak
+	authRequest, found, err := s.OIDCRepo.ConsumeOIDCAuthRequest(ctx, utils.HashOIDCState(payload.State))
+	if err != nil {
+		return domain.LoginResponse{}, err
+	}
+	if !found || time.Now().After(authRequest.ExpiresAt) {
+		return domain.LoginResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrOIDCStateInvalidKey)
+	}
+
+	identity, err := s.OIDCClient.ExchangeCode(ctx, payload.Code, authRequest.CodeVerifier, authRequest.Nonce)
+	if err != nil {
+		log.Printf("Failed to complete OIDC login: %v", err)
+		return domain.LoginResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrOIDCLoginFailedKey)
+	}
+
+	user, linkedIdentity, err := s.resolveOIDCUser(ctx, identity)
+	if err != nil {
+		return domain.LoginResponse{}, err
+	}
+
+	if !user.IsActive {
+		return domain.LoginResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrUserNotFoundKey) // * Use generic message for security
+	}
+
+	user, err = s.syncOIDCRole(ctx, user, linkedIdentity, identity.Groups)
+	if err != nil {
+		return domain.LoginResponse{}, err
+	}
+
+	var email *string
+	if identity.Email != "" {
+		email = &identity.Email
+	}
+	if err := s.OIDCRepo.RecordUserIdentityLogin(ctx, linkedIdentity.ID, email); err != nil {
+		return domain.LoginResponse{}, err
+	}
+
+	if oidcSkipLocalTwoFactor() {
+		authResponse, err := s.issueAuthResponse(ctx, user, authRequest.DeviceName, client)
+		if err != nil {
+			return domain.LoginResponse{}, err
+		}
+		return domain.LoginResponse{AuthResponse: &authResponse}, nil
+	}
+
+	return s.completeLogin(ctx, user, authRequest.DeviceName, client)
+}
+
+// *===========================HELPER===========================*
+
+// resolveOIDCUser finds the user linked to the provider subject, otherwise links by verified email or provisions a new user
+func (s *Service) resolveOIDCUser(ctx context.Context, identity oidc.Identity) (domain.User, domain.UserIdentity, error) {
+	linkedIdentity, found, err := s.OIDCRepo.GetUserIdentity(ctx, identity.Issuer, identity.Subject)
+	if err != nil {
+		return domain.User{}, domain.UserIdentity{}, err
+	}
+	if found {
+		user, err := s.Repo.GetUserById(ctx, linkedIdentity.UserID)
+		if err != nil {
+			return domain.User{}, domain.UserIdentity{}, err
+		}
+		return user, linkedIdentity, nil
+	}
+
+	// ! Link berdasarkan email hanya aman kalau IdP menjamin email tersebut milik user,
+	// ! claim email_verified yang tidak dikirim dianggap belum terverifikasi
+	email := normalizeEmail(identity.Email)
+	if email == "" || identity.EmailVerified == nil || !*identity.EmailVerified {
+		return domain.User{}, domain.UserIdentity{}, domain.ErrForbiddenWithKey(utils.ErrOIDCEmailUnverifiedKey)
+	}
+
+	emailExists, err := s.Repo.CheckEmailExists(ctx, email)
+	if err != nil {
+		return domain.User{}, domain.UserIdentity{}, err
+	}
+
+	var user domain.User
+	if emailExists {
+		user, err = s.Repo.GetUserByEmail(ctx, email)
+		if err != nil {
+			return domain.User{}, domain.UserIdentity{}, err
+		}
+
+		// * User sudah terhubung ke subject lain di issuer yang sama, jangan diambil alih
+		hasIdentity, err := s.OIDCRepo.CheckUserHasIdentity(ctx, user.ID, identity.Issuer)
+		if err != nil {
+			return domain.User{}, domain.UserIdentity{}, err
+		}
+		if hasIdentity {
+			return domain.User{}, domain.UserIdentity{}, domain.ErrConflictWithKey(utils.ErrOIDCIdentityConflictKey)
+		}
+	} else {
+		if !oidcAutoProvisionEnabled() {
+			return domain.User{}, domain.UserIdentity{}, domain.ErrForbiddenWithKey(utils.ErrOIDCUserNotProvisionedKey)
+		}
+
+		user, err = s.provisionOIDCUser(ctx, identity, email)
+		if err != nil {
+			return domain.User{}, domain.UserIdentity{}, err
+		}
+	}
+
+	newIdentity := domain.UserIdentity{
+		UserID:      user.ID,
+		Issuer:      identity.Issuer,
+		Subject:     identity.Subject,
+		Email:       &email,
+		Provisioned: !emailExists,
+	}
+	if newIdentity.Provisioned {
+		newIdentity.SyncedRole = &user.Role
+	}
+
+	linkedIdentity, err = s.OIDCRepo.CreateUserIdentity(ctx, &newIdentity)
+	if err != nil {
+		return domain.User{}, domain.UserIdentity{}, err
+	}
+
+	return user, linkedIdentity, nil
+}
+
+// provisionOIDCUser creates the user on first SSO login, password kosong jadi user hanya bisa login lewat SSO
+func (s *Service) provisionOIDCUser(ctx context.Context, identity oidc.Identity, email string) (domain.User, error) {
+	name, err := utils.UniqueUsername(ctx, utils.UsernameBase(identity.PreferredUsername, email), s.Repo.CheckNameExists)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	fullName := strings.TrimSpace(identity.Name)
+	if fullName == "" {
+		fullName = name
+	}
+	if len(fullName) > 100 {
+		fullName = fullName[:100]
+	}
+
+	role, ok := resolveOIDCRole(identity.Groups, oidcRoleMapping())
+	if !ok {
+		role = oidcDefaultRole()
+	}
+
+	return s.Repo.CreateUser(ctx, &domain.User{
+		Name:     name,
+		Email:    email,
+		FullName: fullName,
+		Role:     role,
+		IsActive: true,
+	})
+}
+
+// syncOIDCRole keeps the role in line with the provider groups when OIDC_ROLE_MAPPING is configured.
+// Hanya untuk user yang dibuat lewat SSO, akun lokal yang di-link lewat email tetap diatur admin.
+// Role yang diubah manual oleh admin tidak diturunkan kecuali OIDC_ROLE_SYNC_OVERRIDE_MANUAL=true
+func (s *Service) syncOIDCRole(ctx context.Context, user domain.User, identity domain.UserIdentity, groups []string) (domain.User, error) {
+	mapping := oidcRoleMapping()
+	if len(mapping) == 0 || !identity.Provisioned {
+		return user, nil
+	}
+
+	role, ok := resolveOIDCRole(groups, mapping)
+	if !ok {
+		role = oidcDefaultRole()
+	}
+	if role == user.Role {
+		return user, nil
+	}
+
+	setManually := identity.SyncedRole == nil || *identity.SyncedRole != user.Role
+	if setManually && oidcRoleRank(role) < oidcRoleRank(user.Role) && !oidcRoleSyncOverridesManual() {
+		log.Printf("OIDC login kept role %s of user %s, role was set manually and groups map to %s", user.Role, user.ID, role)
+		return user, nil
+	}
+
+	var updatedUser domain.User
+	err := s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedUser, err = s.Repo.UpdateUser(ctx, user.ID, &domain.UpdateUserPayload{Role: &role})
+		if err != nil {
+			return err
+		}
+
+		if err := s.OIDCRepo.UpdateUserIdentitySyncedRole(ctx, identity.ID, role); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityUser, user.ID, user, updatedUser)
+	})
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	log.Printf("OIDC login changed role of user %s from %s to %s", user.ID, user.Role, role)
+	return updatedUser, nil
+}
@@ -0,0 +1,342 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+const (
+	recoveryCodeCount        = 10
+	twoFactorQRCodeSize      = 256
+	twoFactorMaxAttempts     = 5
+	twoFactorLockoutDuration = 15 * time.Minute
+)
+
+// isTwoFactorRequired applies the REQUIRE_ADMIN_2FA policy, dibaca tiap login supaya tidak perlu restart
+func isTwoFactorRequired(user domain.User) bool {
+	return user.Role == domain.RoleAdmin && os.Getenv("REQUIRE_ADMIN_2FA") == "true"
+}
+
+func totpIssuer() string {
+	if issuer := os.Getenv("TOTP_ISSUER"); issuer != "" {
+		return issuer
+	}
+	return "Inventory API"
+}
+
+// *===========================MUTATION===========================*
+
+// BeginTwoFactorSetup generates a new secret for the current user, it is only active after EnableTwoFactor
+func (s *Service) BeginTwoFactorSetup(ctx context.Context, userId string) (domain.TwoFactorSetupResponse, error) {
+	user, err := s.Repo.GetUserById(ctx, userId)
+	if err != nil {
+		return domain.TwoFactorSetupResponse{}, err
+	}
+
+	return s.startTwoFactorSetup(ctx, user)
+}
+
+// EnableTwoFactor confirms the pending enrolment with the first code and returns the recovery codes
+func (s *Service) EnableTwoFactor(ctx context.Context, userId string, payload *domain.TwoFactorCodePayload) (domain.TwoFactorRecoveryCodesResponse, error) {
+	recoveryCodes, err := s.confirmTwoFactorSetup(ctx, userId, payload.Code)
+	if err != nil {
+		return domain.TwoFactorRecoveryCodesResponse{}, err
+	}
+
+	return domain.TwoFactorRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *Service) DisableTwoFactor(ctx context.Context, userId string, payload *domain.DisableTwoFactorPayload) error {
+	user, err := s.Repo.GetUserById(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if isTwoFactorRequired(user) {
+		return domain.ErrForbiddenWithKey(utils.ErrTwoFactorRequiredKey)
+	}
+
+	if !utils.CheckPasswordHash(payload.Password, user.PasswordHash) {
+		return domain.ErrUnauthorizedWithKey(utils.ErrInvalidCredentialsKey)
+	}
+
+	twoFactor, err := s.getEnabledTwoFactor(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifySecondFactor(ctx, twoFactor, payload.Code, ""); err != nil {
+		return err
+	}
+
+	return s.TwoFactorRepo.DisableTwoFactor(ctx, userId)
+}
+
+// RegenerateRecoveryCodes replaces every recovery code, the old ones stop working immediately
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, userId string, payload *domain.TwoFactorCodePayload) (domain.TwoFactorRecoveryCodesResponse, error) {
+	twoFactor, err := s.getEnabledTwoFactor(ctx, userId)
+	if err != nil {
+		return domain.TwoFactorRecoveryCodesResponse{}, err
+	}
+
+	if err := s.verifySecondFactor(ctx, twoFactor, payload.Code, ""); err != nil {
+		return domain.TwoFactorRecoveryCodesResponse{}, err
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes()
+	if err != nil {
+		return domain.TwoFactorRecoveryCodesResponse{}, err
+	}
+
+	if err := s.TwoFactorRepo.ReplaceRecoveryCodes(ctx, userId, recoveryCodeHashes); err != nil {
+		return domain.TwoFactorRecoveryCodesResponse{}, err
+	}
+
+	return domain.TwoFactorRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// ResetUserTwoFactor lets an admin remove the enrolment of a user who lost the device
+func (s *Service) ResetUserTwoFactor(ctx context.Context, userId string) error {
+	if _, err := s.Repo.GetUserById(ctx, userId); err != nil {
+		return err
+	}
+
+	if err := s.TwoFactorRepo.DisableTwoFactor(ctx, userId); err != nil {
+		return err
+	}
+
+	// * Session lama dicabut karena faktor kedua yang melindunginya sudah tidak ada
+	return s.SessionRepo.RevokeUserSessions(ctx, userId, domain.SessionRevokedByAdmin)
+}
+
+// SetupTwoFactorChallenge starts enrolment for a user that must use 2FA but has not enrolled yet
+func (s *Service) SetupTwoFactorChallenge(ctx context.Context, payload *domain.TwoFactorChallengeSetupPayload) (domain.TwoFactorSetupResponse, error) {
+	claims, user, err := s.getChallengeUser(ctx, payload.ChallengeToken)
+	if err != nil {
+		return domain.TwoFactorSetupResponse{}, err
+	}
+
+	if claims.Purpose != utils.TwoFactorChallengeSetup {
+		return domain.TwoFactorSetupResponse{}, domain.ErrUnauthorizedWithKey(utils.ErrTwoFactorChallengeInvalidKey)
+	}
+
+	return s.startTwoFactorSetup(ctx, user)
+}
+
+// VerifyTwoFactorChallenge completes a login that was paused for the second factor
+func (s *Service) VerifyTwoFactorChallenge(ctx context.Context, payload *domain.TwoFactorChallengeVerifyPayload, client domain.SessionClientInfo) (domain.LoginResponse, error) {
+	claims, user, err := s.getChallengeUser(ctx, payload.ChallengeToken)
+	if err != nil {
+		return domain.LoginResponse{}, err
+	}
+
+	var recoveryCodes []string
+	switch claims.Purpose {
+	case utils.TwoFactorChallengeSetup:
+		// * Enrolment wajib dikonfirmasi dengan kode TOTP, recovery code belum ada di tahap ini
+		if payload.Code == "" {
+			return domain.LoginResponse{}, domain.ErrBadRequestWithKey(utils.ErrTwoFactorCodeInvalidKey)
+		}
+		recoveryCodes, err = s.confirmTwoFactorSetup(ctx, user.ID, payload.Code)
+		if err != nil {
+			return domain.LoginResponse{}, err
+		}
+	default:
+		twoFactor, err := s.getEnabledTwoFactor(ctx, user.ID)
+		if err != nil {
+			return domain.LoginResponse{}, err
+		}
+		if err := s.verifySecondFactor(ctx, twoFactor, payload.Code, payload.RecoveryCode); err != nil {
+			return domain.LoginResponse{}, err
+		}
+	}
+
+	authResponse, err := s.issueAuthResponse(ctx, user, claims.DeviceName, client)
+	if err != nil {
+		return domain.LoginResponse{}, err
+	}
+
+	return domain.LoginResponse{
+		AuthResponse:  &authResponse,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// *===========================QUERY===========================*
+
+func (s *Service) GetTwoFactorStatus(ctx context.Context, userId string) (domain.TwoFactorStatusResponse, error) {
+	user, err := s.Repo.GetUserById(ctx, userId)
+	if err != nil {
+		return domain.TwoFactorStatusResponse{}, err
+	}
+
+	status := domain.TwoFactorStatusResponse{
+		Required: isTwoFactorRequired(user),
+	}
+
+	twoFactor, found, err := s.TwoFactorRepo.GetTwoFactorByUserId(ctx, userId)
+	if err != nil {
+		return domain.TwoFactorStatusResponse{}, err
+	}
+	if !found || !twoFactor.IsEnabled() {
+		return status, nil
+	}
+
+	remaining, err := s.TwoFactorRepo.CountUnusedRecoveryCodes(ctx, userId)
+	if err != nil {
+		return domain.TwoFactorStatusResponse{}, err
+	}
+
+	status.Enabled = true
+	status.EnabledAt = twoFactor.EnabledAt
+	status.RecoveryCodesRemaining = remaining
+
+	return status, nil
+}
+
+// *===========================HELPER METHODS===========================*
+
+func (s *Service) startTwoFactorSetup(ctx context.Context, user domain.User) (domain.TwoFactorSetupResponse, error) {
+	existing, found, err := s.TwoFactorRepo.GetTwoFactorByUserId(ctx, user.ID)
+	if err != nil {
+		return domain.TwoFactorSetupResponse{}, err
+	}
+	if found && existing.IsEnabled() {
+		return domain.TwoFactorSetupResponse{}, domain.ErrConflictWithKey(utils.ErrTwoFactorAlreadyEnabledKey)
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return domain.TwoFactorSetupResponse{}, domain.ErrInternal(err)
+	}
+
+	if _, err := s.TwoFactorRepo.SavePendingTwoFactor(ctx, user.ID, secret); err != nil {
+		return domain.TwoFactorSetupResponse{}, err
+	}
+
+	otpauthURI := utils.TOTPAuthURI(totpIssuer(), user.Email, secret)
+	qrCode, err := utils.TOTPQRCodeDataURI(otpauthURI, twoFactorQRCodeSize)
+	if err != nil {
+		return domain.TwoFactorSetupResponse{}, domain.ErrInternal(err)
+	}
+
+	return domain.TwoFactorSetupResponse{
+		Secret:     secret,
+		OtpauthURI: otpauthURI,
+		QRCode:     qrCode,
+	}, nil
+}
+
+func (s *Service) confirmTwoFactorSetup(ctx context.Context, userId string, code string) ([]string, error) {
+	twoFactor, found, err := s.TwoFactorRepo.GetTwoFactorByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, domain.ErrBadRequestWithKey(utils.ErrTwoFactorSetupNotStartedKey)
+	}
+	if twoFactor.IsEnabled() {
+		return nil, domain.ErrConflictWithKey(utils.ErrTwoFactorAlreadyEnabledKey)
+	}
+
+	step, ok := utils.ValidateTOTP(twoFactor.Secret, code, time.Now())
+	if !ok {
+		return nil, domain.ErrBadRequestWithKey(utils.ErrTwoFactorCodeInvalidKey)
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := s.TwoFactorRepo.EnableTwoFactor(ctx, userId, step, recoveryCodeHashes)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, domain.ErrConflictWithKey(utils.ErrTwoFactorAlreadyEnabledKey)
+	}
+
+	return recoveryCodes, nil
+}
+
+// verifySecondFactor accepts either a TOTP code or a recovery code, every attempt counts towards a temporary lockout
+// until a code is accepted
+func (s *Service) verifySecondFactor(ctx context.Context, twoFactor domain.UserTwoFactor, code string, recoveryCode string) error {
+	now := time.Now().UTC()
+
+	// * Attempt dipesan dulu secara atomik sebelum kode dicek, request paralel tidak bisa melewati batas percobaan
+	reserved, err := s.TwoFactorRepo.ReserveTwoFactorAttempt(ctx, twoFactor.UserID, twoFactorMaxAttempts, now.Add(twoFactorLockoutDuration))
+	if err != nil {
+		return err
+	}
+	if !reserved {
+		return domain.ErrTooManyRequestsWithKey(utils.ErrTwoFactorLockedKey)
+	}
+
+	if code != "" {
+		if step, ok := utils.ValidateTOTP(twoFactor.Secret, code, now); ok {
+			// * Step yang sama tidak bisa dipakai dua kali, kode yang disadap tidak bisa di-replay
+			accepted, err := s.TwoFactorRepo.MarkTwoFactorStepUsed(ctx, twoFactor.UserID, step)
+			if err != nil {
+				return err
+			}
+			if accepted {
+				return nil
+			}
+		}
+	} else if recoveryCode != "" {
+		used, err := s.TwoFactorRepo.UseRecoveryCode(ctx, twoFactor.UserID, utils.HashRecoveryCode(recoveryCode))
+		if err != nil {
+			return err
+		}
+		if used {
+			return s.TwoFactorRepo.ResetTwoFactorFailures(ctx, twoFactor.UserID)
+		}
+	}
+
+	return domain.ErrUnauthorizedWithKey(utils.ErrTwoFactorCodeInvalidKey)
+}
+
+func (s *Service) getEnabledTwoFactor(ctx context.Context, userId string) (domain.UserTwoFactor, error) {
+	twoFactor, found, err := s.TwoFactorRepo.GetTwoFactorByUserId(ctx, userId)
+	if err != nil {
+		return domain.UserTwoFactor{}, err
+	}
+	if !found || !twoFactor.IsEnabled() {
+		return domain.UserTwoFactor{}, domain.ErrBadRequestWithKey(utils.ErrTwoFactorNotEnabledKey)
+	}
+	return twoFactor, nil
+}
+
+func (s *Service) getChallengeUser(ctx context.Context, challengeToken string) (*utils.TwoFactorChallengeClaims, domain.User, error) {
+	claims, err := utils.ValidateTwoFactorChallengeToken(challengeToken)
+	if err != nil {
+		return nil, domain.User{}, domain.ErrUnauthorizedWithKey(utils.ErrTwoFactorChallengeInvalidKey)
+	}
+
+	user, err := s.Repo.GetUserById(ctx, claims.IDUser)
+	if err != nil || !user.IsActive {
+		return nil, domain.User{}, domain.ErrUnauthorizedWithKey(utils.ErrTwoFactorChallengeInvalidKey)
+	}
+
+	return claims, user, nil
+}
+
+func generateRecoveryCodes() ([]string, []string, error) {
+	recoveryCodes, err := utils.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, nil, domain.ErrInternal(err)
+	}
+
+	recoveryCodeHashes := make([]string, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		recoveryCodeHashes = append(recoveryCodeHashes, utils.HashRecoveryCode(code))
+	}
+
+	return recoveryCodes, recoveryCodeHashes, nil
+}
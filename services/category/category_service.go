@@ -4,11 +4,10 @@ import (
 	"context"
 	"log"
 	"mime/multipart"
-	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
-	"github.com/Rizz404/inventory-api/internal/client/cloudinary"
 	"github.com/Rizz404/inventory-api/internal/client/gtranslate"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
 	"github.com/Rizz404/inventory-api/internal/notification/messages"
 	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
 	"github.com/Rizz404/inventory-api/internal/utils"
@@ -55,6 +54,9 @@ type CategoryService interface {
 	CheckCategoryCodeExists(ctx context.Context, categoryCode string) (bool, error)
 	CountCategories(ctx context.Context, params domain.CategoryParams) (int64, error)
 	GetCategoryStatistics(ctx context.Context) (domain.CategoryStatisticsResponse, error)
+
+	// * JOB HANDLERS
+	HandleTranslationJob(ctx context.Context, job *domain.Job) error
 }
 
 // * NotificationService interface for creating notifications
@@ -67,24 +69,49 @@ type UserRepository interface {
 	GetUsersPaginated(ctx context.Context, params domain.UserParams) ([]domain.User, error)
 }
 
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// * JobQueue interface for enqueueing background jobs in the same transaction as the mutation
+type JobQueue interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+}
+
+// translationJob is the payload of domain.JobTypeCategoryTranslation, Updated terisi untuk translate setelah update
+type translationJob struct {
+	CategoryID string                                    `json:"categoryId"`
+	Created    []domain.CreateCategoryTranslationPayload `json:"created,omitempty"`
+	Updated    []domain.UpdateCategoryTranslationPayload `json:"updated,omitempty"`
+	Existing   []domain.CategoryTranslation              `json:"existing,omitempty"`
+}
+
 type Service struct {
 	Repo                Repository
 	NotificationService NotificationService
 	UserRepo            UserRepository
-	CloudinaryClient    *cloudinary.Client
+	FileStorage         storage.Storage
 	Translator          *gtranslate.Client
+	AuditLogService     AuditLogService
+	JobQueue            JobQueue
 }
 
 // * Ensure Service implements CategoryService interface
 var _ CategoryService = (*Service)(nil)
 
-func NewService(r Repository, notificationService NotificationService, userRepo UserRepository, cloudinaryClient *cloudinary.Client, translator *gtranslate.Client) CategoryService {
+func NewService(r Repository, notificationService NotificationService, userRepo UserRepository, fileStorage storage.Storage, translator *gtranslate.Client, auditLogService AuditLogService, jobQueue JobQueue) CategoryService {
 	return &Service{
 		Repo:                r,
 		NotificationService: notificationService,
 		UserRepo:            userRepo,
-		CloudinaryClient:    cloudinaryClient,
+		FileStorage:         fileStorage,
 		Translator:          translator,
+		AuditLogService:     auditLogService,
+		JobQueue:            jobQueue,
 	}
 }
 
@@ -106,18 +133,23 @@ func (s *Service) CreateCategory(ctx context.Context, payload *domain.CreateCate
 		}
 	}
 
+	// * Validate custom attribute schema
+	if err := domain.ValidateCategoryAttributes(payload.Attributes); err != nil {
+		return domain.CategoryResponse{}, err
+	}
+
 	// * Handle image upload if file is provided
 	var imageURL *string
 	if imageFile != nil {
-		// Upload file to Cloudinary if client is available
-		if s.CloudinaryClient != nil {
+		// Upload file to file storage if client is available
+		if s.FileStorage != nil {
 			// Generate temporary category ID for image naming
 			tempCategoryID := "temp-" + ulid.Make().String()
-			uploadConfig := cloudinary.GetCategoryImageUploadConfig()
+			uploadConfig := storage.GetCategoryImageUploadConfig()
 			publicID := "category-" + tempCategoryID + "-image"
 			uploadConfig.PublicID = &publicID
 
-			uploadResult, err := s.CloudinaryClient.UploadSingleFile(ctx, imageFile, uploadConfig)
+			uploadResult, err := s.FileStorage.UploadSingleFile(ctx, imageFile, uploadConfig)
 			if err != nil {
 				// Provide detailed error message
 				errorMsg := "Failed to upload category image: " + err.Error()
@@ -125,7 +157,7 @@ func (s *Service) CreateCategory(ctx context.Context, payload *domain.CreateCate
 			}
 			imageURL = &uploadResult.SecureURL
 		} else {
-			return domain.CategoryResponse{}, domain.ErrBadRequestWithKey(utils.ErrCloudinaryConfigKey)
+			return domain.CategoryResponse{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
 		}
 	} else if payload.ImageURL != nil {
 		// Use provided image URL from JSON/form data
@@ -134,10 +166,14 @@ func (s *Service) CreateCategory(ctx context.Context, payload *domain.CreateCate
 
 	// * Prepare domain category with user's input translations only
 	newCategory := domain.Category{
-		ParentID:     payload.ParentID,
-		CategoryCode: payload.CategoryCode,
-		ImageURL:     imageURL,
-		Translations: make([]domain.CategoryTranslation, len(payload.Translations)),
+		ParentID:            payload.ParentID,
+		CategoryCode:        payload.CategoryCode,
+		ImageURL:            imageURL,
+		DepreciationMethod:  payload.DepreciationMethod,
+		UsefulLifeYears:     payload.UsefulLifeYears,
+		SalvageValuePercent: payload.SalvageValuePercent,
+		Attributes:          payload.Attributes,
+		Translations:        make([]domain.CategoryTranslation, len(payload.Translations)),
 	}
 
 	// * Convert translation payloads to domain translations (only user input)
@@ -149,16 +185,31 @@ func (s *Service) CreateCategory(ctx context.Context, payload *domain.CreateCate
 		}
 	}
 
-	createdCategory, err := s.Repo.CreateCategory(ctx, &newCategory)
+	var createdCategory domain.Category
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdCategory, err = s.Repo.CreateCategory(ctx, &newCategory)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityCategory, createdCategory.ID, createdCategory); err != nil {
+			return err
+		}
+
+		// * Auto-translate missing languages in background if needed
+		if len(payload.Translations) < 3 {
+			return s.JobQueue.Enqueue(ctx, domain.JobTypeCategoryTranslation, translationJob{
+				CategoryID: createdCategory.ID,
+				Created:    payload.Translations,
+			})
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.CategoryResponse{}, err
 	}
 
-	// * Auto-translate missing languages in background if needed
-	if len(payload.Translations) < 3 {
-		go s.autoTranslateCategoryAsync(createdCategory.ID, payload.Translations)
-	}
-
 	// * Convert to CategoryResponse using mapper
 	return mapper.CategoryToResponse(&createdCategory, mapper.DefaultLangCode), nil
 }
@@ -183,6 +234,10 @@ func (s *Service) BulkCreateCategories(ctx context.Context, payload *domain.Bulk
 				return domain.BulkCreateCategoriesResponse{}, domain.ErrNotFoundWithKey(utils.ErrCategoryNotFoundKey)
 			}
 		}
+
+		if err := domain.ValidateCategoryAttributes(catPayload.Attributes); err != nil {
+			return domain.BulkCreateCategoriesResponse{}, err
+		}
 	}
 
 	// Check all codes against database
@@ -197,16 +252,16 @@ func (s *Service) BulkCreateCategories(ctx context.Context, payload *domain.Bulk
 	}
 
 	categories := make([]domain.Category, len(payload.Categories))
-	categoriesToTranslate := []struct {
-		categoryID   string
-		translations []domain.CreateCategoryTranslationPayload
-	}{}
 
 	for i, catPayload := range payload.Categories {
 		cat := domain.Category{
-			ParentID:     catPayload.ParentID,
-			CategoryCode: catPayload.CategoryCode,
-			Translations: make([]domain.CategoryTranslation, len(catPayload.Translations)),
+			ParentID:            catPayload.ParentID,
+			CategoryCode:        catPayload.CategoryCode,
+			DepreciationMethod:  catPayload.DepreciationMethod,
+			UsefulLifeYears:     catPayload.UsefulLifeYears,
+			SalvageValuePercent: catPayload.SalvageValuePercent,
+			Attributes:          catPayload.Attributes,
+			Translations:        make([]domain.CategoryTranslation, len(catPayload.Translations)),
 		}
 
 		// Convert user input translations only
@@ -220,31 +275,37 @@ func (s *Service) BulkCreateCategories(ctx context.Context, payload *domain.Bulk
 		categories[i] = cat
 	}
 
-	createdCategories, err := s.Repo.BulkCreateCategories(ctx, categories)
-	if err != nil {
-		return domain.BulkCreateCategoriesResponse{}, err
-	}
+	var createdCategories []domain.Category
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdCategories, err = s.Repo.BulkCreateCategories(ctx, categories)
+		if err != nil {
+			return err
+		}
 
-	// * Collect categories that need auto-translation
-	for i, catPayload := range payload.Categories {
-		if len(catPayload.Translations) < 3 {
-			categoriesToTranslate = append(categoriesToTranslate, struct {
-				categoryID   string
-				translations []domain.CreateCategoryTranslationPayload
-			}{
-				categoryID:   createdCategories[i].ID,
-				translations: catPayload.Translations,
-			})
+		for _, createdCategory := range createdCategories {
+			if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityCategory, createdCategory.ID, createdCategory); err != nil {
+				return err
+			}
 		}
-	}
 
-	// * Auto-translate missing languages in background
-	if len(categoriesToTranslate) > 0 {
-		go func() {
-			for _, item := range categoriesToTranslate {
-				s.autoTranslateCategoryAsync(item.categoryID, item.translations)
+		// * Auto-translate missing languages in background
+		for i, catPayload := range payload.Categories {
+			if len(catPayload.Translations) >= 3 {
+				continue
 			}
-		}()
+			err := s.JobQueue.Enqueue(ctx, domain.JobTypeCategoryTranslation, translationJob{
+				CategoryID: createdCategories[i].ID,
+				Created:    catPayload.Translations,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.BulkCreateCategoriesResponse{}, err
 	}
 
 	response := domain.BulkCreateCategoriesResponse{
@@ -279,16 +340,23 @@ func (s *Service) UpdateCategory(ctx context.Context, categoryId string, payload
 		}
 	}
 
+	// * Validate custom attribute schema if being replaced
+	if payload.Attributes != nil {
+		if err := domain.ValidateCategoryAttributes(*payload.Attributes); err != nil {
+			return domain.CategoryResponse{}, err
+		}
+	}
+
 	// * Handle image upload if file is provided
 	if imageFile != nil {
-		// Upload file to Cloudinary if client is available
-		if s.CloudinaryClient != nil {
-			uploadConfig := cloudinary.GetCategoryImageUploadConfig()
+		// Upload file to file storage if client is available
+		if s.FileStorage != nil {
+			uploadConfig := storage.GetCategoryImageUploadConfig()
 			publicID := "category-" + categoryId + "-image"
 			uploadConfig.PublicID = &publicID
 			uploadConfig.Overwrite = true // Overwrite existing image
 
-			uploadResult, err := s.CloudinaryClient.UploadSingleFile(ctx, imageFile, uploadConfig)
+			uploadResult, err := s.FileStorage.UploadSingleFile(ctx, imageFile, uploadConfig)
 			if err != nil {
 				// Provide detailed error message
 				errorMsg := "Failed to upload category image: " + err.Error()
@@ -297,24 +365,34 @@ func (s *Service) UpdateCategory(ctx context.Context, categoryId string, payload
 			newImageURL := uploadResult.SecureURL
 			payload.ImageURL = &newImageURL
 		} else {
-			return domain.CategoryResponse{}, domain.ErrBadRequestWithKey(utils.ErrCloudinaryConfigKey)
+			return domain.CategoryResponse{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
 		}
 	} else if payload.ImageURL != nil && *payload.ImageURL == "" {
-		// If imageUrl is explicitly set to empty string, delete the old image from Cloudinary
-		if s.CloudinaryClient != nil && existingCategory.ImageURL != nil {
-			// Extract public ID from existing URL and delete (optional - Cloudinary has storage limits)
+		// If imageUrl is explicitly set to empty string, delete the old image from file storage
+		if s.FileStorage != nil && existingCategory.ImageURL != nil {
+			// Extract public ID from existing URL and delete (optional - storage quota is limited)
 			// For now, we just set it to nil in the database
 		}
 	}
 
 	// * Update category with user's input translations only
-	updatedCategory, err := s.Repo.UpdateCategory(ctx, categoryId, payload)
-	if err != nil {
-		return domain.CategoryResponse{}, err
-	}
+	var updatedCategory domain.Category
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedCategory, err = s.Repo.UpdateCategory(ctx, categoryId, payload)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityCategory, categoryId, existingCategory, updatedCategory); err != nil {
+			return err
+		}
+
+		// * Auto-translate missing languages in background if translations were updated
+		if len(payload.Translations) == 0 {
+			return nil
+		}
 
-	// * Auto-translate missing languages in background if translations were updated
-	if len(payload.Translations) > 0 {
 		// Get current translation count after update
 		currentLangCodes := make([]string, 0)
 		for _, trans := range existingCategory.Translations {
@@ -333,10 +411,18 @@ func (s *Service) UpdateCategory(ctx context.Context, categoryId string, payload
 			}
 		}
 
-		// Launch background translation if incomplete
+		// Queue background translation if incomplete
 		if len(currentLangCodes) < 3 {
-			go s.autoTranslateUpdateCategoryAsync(categoryId, payload.Translations, updatedCategory.Translations)
+			return s.JobQueue.Enqueue(ctx, domain.JobTypeCategoryTranslation, translationJob{
+				CategoryID: categoryId,
+				Updated:    payload.Translations,
+				Existing:   updatedCategory.Translations,
+			})
 		}
+		return nil
+	})
+	if err != nil {
+		return domain.CategoryResponse{}, err
 	}
 
 	// * Send notification to all admin users
@@ -347,11 +433,19 @@ func (s *Service) UpdateCategory(ctx context.Context, categoryId string, payload
 }
 
 func (s *Service) DeleteCategory(ctx context.Context, categoryId string) error {
-	err := s.Repo.DeleteCategory(ctx, categoryId)
+	// * Get category data for audit trail before deletion
+	existingCategory, err := s.Repo.GetCategoryById(ctx, categoryId)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteCategory(ctx, categoryId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityCategory, categoryId, existingCategory)
+	})
 }
 
 func (s *Service) BulkDeleteCategories(ctx context.Context, payload *domain.BulkDeleteCategoriesPayload) (domain.BulkDeleteCategoriesResponse, error) {
@@ -361,7 +455,21 @@ func (s *Service) BulkDeleteCategories(ctx context.Context, payload *domain.Bulk
 	}
 
 	// * Perform bulk delete operation
-	result, err := s.Repo.BulkDeleteCategories(ctx, payload.IDS)
+	var result domain.BulkDeleteCategories
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.Repo.BulkDeleteCategories(ctx, payload.IDS)
+		if err != nil {
+			return err
+		}
+
+		for _, deletedId := range result.DeletedIDS {
+			if err := s.AuditLogService.RecordDelete(ctx, domain.AuditEntityCategory, deletedId, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkDeleteCategoriesResponse{}, err
 	}
@@ -545,15 +653,25 @@ func (s *Service) sendCategoryUpdatedNotification(ctx context.Context, categoryI
 	}
 }
 
-// *===========================BACKGROUND TRANSLATION HELPERS===========================*
+// *===========================JOB HANDLERS===========================*
+
+// HandleTranslationJob fills the missing category translations queued by create and update
+func (s *Service) HandleTranslationJob(ctx context.Context, job *domain.Job) error {
+	var payload translationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
 
-// autoTranslateCategoryAsync translates missing category translations in background
-// Timeout: 30 seconds
-func (s *Service) autoTranslateCategoryAsync(categoryID string, userTranslations []domain.CreateCategoryTranslationPayload) {
-	// Create context with 30-second timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	if payload.Updated != nil {
+		return s.autoTranslateUpdateCategory(ctx, payload.CategoryID, payload.Updated, payload.Existing)
+	}
+	return s.autoTranslateCategory(ctx, payload.CategoryID, payload.Created)
+}
 
+// *===========================BACKGROUND TRANSLATION HELPERS===========================*
+
+// autoTranslateCategory translates missing category translations, dijalankan worker lewat HandleTranslationJob
+func (s *Service) autoTranslateCategory(ctx context.Context, categoryID string, userTranslations []domain.CreateCategoryTranslationPayload) error {
 	log.Printf("Starting background translation for category ID: %s", categoryID)
 
 	// Get missing language codes
@@ -565,7 +683,7 @@ func (s *Service) autoTranslateCategoryAsync(categoryID string, userTranslations
 
 	if len(missingLangCodes) == 0 {
 		log.Printf("No missing translations for category ID: %s", categoryID)
-		return
+		return nil
 	}
 
 	// Convert domain types to utils types
@@ -582,7 +700,7 @@ func (s *Service) autoTranslateCategoryAsync(categoryID string, userTranslations
 	translatedPayloads, err := utils.AutoTranslateCategoryCreate(ctx, s.Translator, utilsTranslations)
 	if err != nil {
 		log.Printf("Failed to auto-translate category ID %s: %v", categoryID, err)
-		return
+		return err
 	}
 
 	// Extract only new translations
@@ -611,19 +729,15 @@ func (s *Service) autoTranslateCategoryAsync(categoryID string, userTranslations
 		err = s.Repo.AddCategoryTranslations(ctx, categoryID, newTranslations)
 		if err != nil {
 			log.Printf("Failed to save auto-translated translations for category ID %s: %v", categoryID, err)
-		} else {
-			log.Printf("Successfully saved %d auto-translated translations for category ID: %s", len(newTranslations), categoryID)
+			return err
 		}
+		log.Printf("Successfully saved %d auto-translated translations for category ID: %s", len(newTranslations), categoryID)
 	}
+	return nil
 }
 
-// autoTranslateUpdateCategoryAsync translates missing category update translations in background
-// Timeout: 30 seconds
-func (s *Service) autoTranslateUpdateCategoryAsync(categoryID string, userUpdates []domain.UpdateCategoryTranslationPayload, existingTranslations []domain.CategoryTranslation) {
-	// Create context with 30-second timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
+// autoTranslateUpdateCategory translates missing category update translations, dijalankan worker lewat HandleTranslationJob
+func (s *Service) autoTranslateUpdateCategory(ctx context.Context, categoryID string, userUpdates []domain.UpdateCategoryTranslationPayload, existingTranslations []domain.CategoryTranslation) error {
 	log.Printf("Starting background translation for updated category ID: %s", categoryID)
 
 	// Get missing language codes
@@ -654,7 +768,7 @@ func (s *Service) autoTranslateUpdateCategoryAsync(categoryID string, userUpdate
 	missingLangCodes := utils.GetMissingTranslationLangCodes(currentCodes)
 	if len(missingLangCodes) == 0 {
 		log.Printf("No missing translations for updated category ID: %s", categoryID)
-		return
+		return nil
 	}
 
 	// Convert domain types to utils types
@@ -680,7 +794,7 @@ func (s *Service) autoTranslateUpdateCategoryAsync(categoryID string, userUpdate
 	translatedPayloads, err := utils.AutoTranslateCategoryUpdate(ctx, s.Translator, utilsUpdates, utilsExisting)
 	if err != nil {
 		log.Printf("Failed to auto-translate updated category ID %s: %v", categoryID, err)
-		return
+		return err
 	}
 
 	// Extract only new translations (not in userUpdates)
@@ -709,8 +823,9 @@ func (s *Service) autoTranslateUpdateCategoryAsync(categoryID string, userUpdate
 		err = s.Repo.AddCategoryTranslations(ctx, categoryID, newTranslations)
 		if err != nil {
 			log.Printf("Failed to save auto-translated translations for updated category ID %s: %v", categoryID, err)
-		} else {
-			log.Printf("Successfully saved %d auto-translated translations for updated category ID: %s", len(newTranslations), categoryID)
+			return err
 		}
+		log.Printf("Successfully saved %d auto-translated translations for updated category ID: %s", len(newTranslations), categoryID)
 	}
+	return nil
 }
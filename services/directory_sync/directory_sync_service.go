@@ -0,0 +1,616 @@
+package directory_sync
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/ldap"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	defaultSyncSchedule = "0 0 2 * * *"
+	// * Batas panjang kolom users, nilai dari direktori yang lebih panjang diabaikan
+	fullNameMaxLength    = 100
+	employeeIDMaxLength  = 20
+	phoneNumberMaxLength = 20
+)
+
+// * Alasan entry dilewati, dikirim apa adanya di laporan sync
+const (
+	skipReasonMissingID        = "missing_id"
+	skipReasonMissingEmail     = "missing_email"
+	skipReasonDuplicateEntry   = "duplicate_entry"
+	skipReasonDuplicateEmail   = "duplicate_email"
+	skipReasonEmailConflict    = "email_conflict"
+	skipReasonIdentityConflict = "identity_conflict"
+	skipReasonEmailExists      = "email_exists"
+)
+
+// * UserRepository interface for the users managed by the directory sync
+type UserRepository interface {
+	// * MUTATION
+	CreateUser(ctx context.Context, payload *domain.User) (domain.User, error)
+	UpdateUser(ctx context.Context, userId string, payload *domain.UpdateUserPayload) (domain.User, error)
+
+	// * QUERY
+	GetUserById(ctx context.Context, userId string) (domain.User, error)
+	GetUserByEmail(ctx context.Context, email string) (domain.User, error)
+	CheckNameExists(ctx context.Context, name string) (bool, error)
+	CheckEmailExists(ctx context.Context, email string) (bool, error)
+	CheckEmailExistsExcluding(ctx context.Context, email string, excludeUserId string) (bool, error)
+}
+
+// * IdentityRepository interface for the links between users and directory entries
+type IdentityRepository interface {
+	CreateUserIdentity(ctx context.Context, payload *domain.UserIdentity) (domain.UserIdentity, error)
+	GetUserIdentitiesByIssuer(ctx context.Context, issuer string) ([]domain.UserIdentity, error)
+	MarkUserIdentitySyncDeactivated(ctx context.Context, identityId string, reason string, deactivatedAt time.Time) error
+	ClearUserIdentitySyncDeactivated(ctx context.Context, identityId string) error
+}
+
+// * SessionRepository interface for revoking sessions of deactivated users
+type SessionRepository interface {
+	RevokeUserSessions(ctx context.Context, userId string, reason domain.SessionRevokedReason) error
+}
+
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+}
+
+// * Transactor interface for applying a change and its audit entry in one transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// SyncRunner exposes on-demand directory sync runs
+type SyncRunner interface {
+	RunSync(ctx context.Context, dryRun bool, trigger domain.DirectorySyncTrigger) (domain.DirectorySyncResult, error)
+	GetLastRun(ctx context.Context) (domain.DirectorySyncResult, error)
+}
+
+// Service synchronizes users from the LDAP directory on a schedule and on demand
+type Service struct {
+	cron            *cron.Cron
+	userRepo        UserRepository
+	identityRepo    IdentityRepository
+	sessionRepo     SessionRepository
+	auditLogService AuditLogService
+	transactor      Transactor
+	ldapClient      *ldap.Client // * Nil kalau LDAP tidak diaktifkan
+
+	// running guards against the scheduled and an on-demand sync overlapping
+	mu      sync.Mutex
+	running bool
+	lastRun *domain.DirectorySyncResult
+}
+
+// * Ensure Service implements SyncRunner interface
+var _ SyncRunner = (*Service)(nil)
+
+// plannedChange is a change in the report together with what is needed to apply it
+type plannedChange struct {
+	change   domain.DirectorySyncChange
+	entry    ldap.Entry
+	user     domain.User
+	identity *domain.UserIdentity
+	update   *domain.UpdateUserPayload
+}
+
+// NewService creates a new directory sync service instance
+func NewService(userRepo UserRepository, identityRepo IdentityRepository, sessionRepo SessionRepository, auditLogService AuditLogService, transactor Transactor, ldapClient *ldap.Client) *Service {
+	// Create cron instance with seconds field support
+	c := cron.New(cron.WithSeconds())
+
+	return &Service{
+		cron:            c,
+		userRepo:        userRepo,
+		identityRepo:    identityRepo,
+		sessionRepo:     sessionRepo,
+		auditLogService: auditLogService,
+		transactor:      transactor,
+		ldapClient:      ldapClient,
+	}
+}
+
+// Start schedules the directory sync when LDAP_SYNC_ENABLED=true
+func (s *Service) Start() error {
+	if s.ldapClient == nil || os.Getenv("LDAP_SYNC_ENABLED") != "true" {
+		log.Println("Directory sync schedule disabled")
+		return nil
+	}
+
+	schedule := os.Getenv("LDAP_SYNC_SCHEDULE")
+	if schedule == "" {
+		schedule = defaultSyncSchedule
+	}
+
+	if _, err := s.cron.AddFunc(schedule, s.runScheduledSync); err != nil {
+		return err
+	}
+
+	s.cron.Start()
+	log.Printf("Directory sync service started with schedule %q", schedule)
+	return nil
+}
+
+// Stop gracefully stops the scheduled sync
+func (s *Service) Stop() {
+	ctx := s.cron.Stop()
+	<-ctx.Done()
+	log.Println("Directory sync service stopped")
+}
+
+// *===========================MUTATION===========================*
+
+// RunSync compares the directory with the users table, dry run hanya mengembalikan rencana perubahan tanpa menyimpan apa pun
+func (s *Service) RunSync(ctx context.Context, dryRun bool, trigger domain.DirectorySyncTrigger) (domain.DirectorySyncResult, error) {
+	if s.ldapClient == nil {
+		return domain.DirectorySyncResult{}, domain.ErrNotFoundWithKey(utils.ErrDirectorySyncDisabledKey)
+	}
+
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return domain.DirectorySyncResult{}, domain.ErrConflictWithKey(utils.ErrDirectorySyncAlreadyRunningKey)
+	}
+	s.running = true
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.running = false
+		s.mu.Unlock()
+	}()
+
+	result := domain.DirectorySyncResult{
+		DryRun:    dryRun,
+		Trigger:   trigger,
+		StartedAt: time.Now(),
+	}
+
+	entries, err := s.ldapClient.SearchUsers(ctx)
+	if err != nil {
+		log.Printf("Failed to read users from directory: %v", err)
+		return domain.DirectorySyncResult{}, domain.ErrInternalWithKey(utils.ErrDirectorySyncFailedKey)
+	}
+
+	planned, unchanged, err := s.plan(ctx, entries)
+	if err != nil {
+		return domain.DirectorySyncResult{}, err
+	}
+
+	if !dryRun {
+		for i := range planned {
+			if err := s.apply(ctx, &planned[i]); err != nil {
+				message := err.Error()
+				planned[i].change.Error = &message
+				log.Printf("Directory sync failed to %s %s: %v", planned[i].change.Action, planned[i].change.Email, err)
+			}
+		}
+	}
+
+	result.Changes = make([]domain.DirectorySyncChange, len(planned))
+	for i, p := range planned {
+		result.Changes[i] = p.change
+	}
+	result.Summary = summarize(result.Changes, len(entries), unchanged)
+	result.FinishedAt = time.Now()
+
+	s.mu.Lock()
+	s.lastRun = &result
+	s.mu.Unlock()
+
+	return result, nil
+}
+
+// *===========================QUERY===========================*
+
+// GetLastRun returns the result of the most recent sync since the server started
+func (s *Service) GetLastRun(ctx context.Context) (domain.DirectorySyncResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lastRun == nil {
+		return domain.DirectorySyncResult{}, domain.ErrNotFoundWithKey(utils.ErrDirectorySyncNoRunKey)
+	}
+
+	return *s.lastRun, nil
+}
+
+// *===========================HELPER===========================*
+
+// runScheduledSync is the cron entry point, errors are only logged since there is no caller to report to
+func (s *Service) runScheduledSync() {
+	dryRun := os.Getenv("LDAP_SYNC_DRY_RUN") == "true"
+
+	result, err := s.RunSync(context.Background(), dryRun, domain.DirectorySyncTriggerSchedule)
+	if err != nil {
+		log.Printf("Scheduled directory sync failed: %v", err)
+		return
+	}
+
+	log.Printf("Directory sync completed (dry run: %t). Entries: %d, created: %d, updated: %d, deactivated: %d, skipped: %d, failed: %d",
+		dryRun, result.Summary.DirectoryEntries, result.Summary.Created, result.Summary.Updated,
+		result.Summary.Deactivated, result.Summary.Skipped, result.Summary.Failed)
+}
+
+// plan decides what has to happen for every directory entry and every linked user missing from the directory
+func (s *Service) plan(ctx context.Context, entries []ldap.Entry) ([]plannedChange, int, error) {
+	identities, err := s.identityRepo.GetUserIdentitiesByIssuer(ctx, domain.LDAPIdentityIssuer)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// ! Direktori kosong biasanya salah konfigurasi filter/base DN, jangan sampai semua user dinonaktifkan
+	if len(entries) == 0 && len(identities) > 0 {
+		return nil, 0, domain.ErrInternalWithKey(utils.ErrDirectorySyncEmptyDirectoryKey)
+	}
+
+	identityBySubject := make(map[string]domain.UserIdentity, len(identities))
+	linkedUserIds := make(map[string]bool, len(identities))
+	for _, identity := range identities {
+		identityBySubject[identity.Subject] = identity
+		linkedUserIds[identity.UserID] = true
+	}
+
+	planned := make([]plannedChange, 0)
+	unchanged := 0
+	seenIds := make(map[string]bool, len(entries))
+	seenEmails := make(map[string]bool, len(entries))
+
+	for _, entry := range entries {
+		email := strings.ToLower(entry.Email)
+		p := plannedChange{
+			entry: entry,
+			change: domain.DirectorySyncChange{
+				DirectoryID: entry.ID,
+				Username:    entry.Username,
+				Email:       email,
+			},
+		}
+
+		reason := entrySkipReason(entry, email, seenIds, seenEmails)
+		// * Entry yang dilewati tetap dianggap ada di direktori supaya user-nya tidak ikut dinonaktifkan
+		if entry.ID != "" {
+			seenIds[entry.ID] = true
+		}
+		if reason != "" {
+			planned = append(planned, skipped(p, reason))
+			continue
+		}
+		seenEmails[email] = true
+
+		// * Entry yang sudah terhubung, cukup samakan atributnya
+		if identity, ok := identityBySubject[entry.ID]; ok {
+			user, err := s.userRepo.GetUserById(ctx, identity.UserID)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			p.identity = &identity
+			p, reason, err = s.planUpdate(ctx, p, user)
+			if err != nil {
+				return nil, 0, err
+			}
+			if reason != "" {
+				planned = append(planned, skipped(p, reason))
+			} else if p.update != nil {
+				planned = append(planned, p)
+			} else {
+				unchanged++
+			}
+			continue
+		}
+
+		emailExists, err := s.userRepo.CheckEmailExists(ctx, email)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !emailExists {
+			p.change.Action = domain.DirectorySyncActionCreate
+			p.change.Username = utils.UsernameBase(entry.Username, email)
+			planned = append(planned, p)
+			continue
+		}
+
+		// * User lama dengan email yang sama dihubungkan ke direktori, kecuali sudah terhubung ke entry lain
+		user, err := s.userRepo.GetUserByEmail(ctx, email)
+		if err != nil {
+			return nil, 0, err
+		}
+		if linkedUserIds[user.ID] {
+			planned = append(planned, skipped(p, skipReasonIdentityConflict))
+			continue
+		}
+		if os.Getenv("LDAP_SYNC_LINK_BY_EMAIL") == "false" {
+			planned = append(planned, skipped(p, skipReasonEmailExists))
+			continue
+		}
+
+		p, reason, err = s.planUpdate(ctx, p, user)
+		if err != nil {
+			return nil, 0, err
+		}
+		if reason != "" {
+			planned = append(planned, skipped(p, reason))
+			continue
+		}
+		p.change.Action = domain.DirectorySyncActionUpdate
+		p.change.Linked = true
+		planned = append(planned, p)
+	}
+
+	// * User yang terhubung tapi sudah tidak ada di direktori dinonaktifkan
+	for _, identity := range identities {
+		if seenIds[identity.Subject] {
+			continue
+		}
+
+		user, err := s.userRepo.GetUserById(ctx, identity.UserID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !user.IsActive {
+			continue
+		}
+
+		isActive := false
+		userId := user.ID
+		reason := domain.DirectorySyncDeactivatedRemoved
+		planned = append(planned, plannedChange{
+			user:     user,
+			identity: &identity,
+			update:   &domain.UpdateUserPayload{IsActive: &isActive},
+			change: domain.DirectorySyncChange{
+				Action:      domain.DirectorySyncActionDeactivate,
+				UserID:      &userId,
+				DirectoryID: identity.Subject,
+				Username:    user.Name,
+				Email:       user.Email,
+				Fields:      []domain.DirectorySyncFieldChange{{Field: "isActive", Before: true, After: false}},
+				Reason:      &reason,
+			},
+		})
+	}
+
+	return planned, unchanged, nil
+}
+
+// planUpdate diffs the user against the directory entry, update tetap nil kalau tidak ada yang berubah
+func (s *Service) planUpdate(ctx context.Context, p plannedChange, user domain.User) (plannedChange, string, error) {
+	userId := user.ID
+	p.user = user
+	p.change.UserID = &userId
+	p.change.Username = user.Name
+
+	payload := &domain.UpdateUserPayload{}
+	fields := make([]domain.DirectorySyncFieldChange, 0)
+
+	if fullName := limitedValue(p.entry.FullName, fullNameMaxLength, "full name", p.change.Email); fullName != nil && *fullName != user.FullName {
+		payload.FullName = fullName
+		fields = append(fields, domain.DirectorySyncFieldChange{Field: "fullName", Before: user.FullName, After: *fullName})
+	}
+
+	if !strings.EqualFold(user.Email, p.change.Email) {
+		taken, err := s.userRepo.CheckEmailExistsExcluding(ctx, p.change.Email, user.ID)
+		if err != nil {
+			return p, "", err
+		}
+		if taken {
+			return p, skipReasonEmailConflict, nil
+		}
+		payload.Email = &p.change.Email
+		fields = append(fields, domain.DirectorySyncFieldChange{Field: "email", Before: user.Email, After: p.change.Email})
+	}
+
+	if employeeId := limitedValue(p.entry.EmployeeID, employeeIDMaxLength, "employee ID", p.change.Email); employeeId != nil && (user.EmployeeID == nil || *user.EmployeeID != *employeeId) {
+		payload.EmployeeID = employeeId
+		fields = append(fields, domain.DirectorySyncFieldChange{Field: "employeeId", Before: user.EmployeeID, After: *employeeId})
+	}
+
+	if phoneNumber := limitedValue(p.entry.PhoneNumber, phoneNumberMaxLength, "phone number", p.change.Email); phoneNumber != nil && (user.PhoneNumber == nil || *user.PhoneNumber != *phoneNumber) {
+		payload.PhoneNumber = phoneNumber
+		fields = append(fields, domain.DirectorySyncFieldChange{Field: "phoneNumber", Before: user.PhoneNumber, After: *phoneNumber})
+	}
+
+	// * Hanya user yang dinonaktifkan sync sendiri yang diaktifkan kembali, user yang dinonaktifkan admin tetap nonaktif
+	if !user.IsActive && reactivatable(p.identity, user) {
+		isActive := true
+		payload.IsActive = &isActive
+		fields = append(fields, domain.DirectorySyncFieldChange{Field: "isActive", Before: false, After: true})
+	}
+
+	if len(fields) > 0 {
+		p.update = payload
+		p.change.Action = domain.DirectorySyncActionUpdate
+		p.change.Fields = fields
+	}
+
+	return p, "", nil
+}
+
+// apply executes a planned change in its own transaction, error dicatat di laporan dan tidak menghentikan change lainnya
+func (s *Service) apply(ctx context.Context, p *plannedChange) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.applyChange(ctx, p)
+	})
+}
+
+func (s *Service) applyChange(ctx context.Context, p *plannedChange) error {
+	switch p.change.Action {
+	case domain.DirectorySyncActionCreate:
+		return s.applyCreate(ctx, p)
+	case domain.DirectorySyncActionUpdate:
+		if p.change.Linked {
+			if _, err := s.identityRepo.CreateUserIdentity(ctx, &domain.UserIdentity{
+				UserID:  p.user.ID,
+				Issuer:  domain.LDAPIdentityIssuer,
+				Subject: p.entry.ID,
+				Email:   &p.change.Email,
+			}); err != nil {
+				return err
+			}
+		}
+		if p.update == nil {
+			return nil
+		}
+
+		updatedUser, err := s.userRepo.UpdateUser(ctx, p.user.ID, p.update)
+		if err != nil {
+			return err
+		}
+		if p.update.IsActive != nil && *p.update.IsActive && p.identity != nil {
+			if err := s.identityRepo.ClearUserIdentitySyncDeactivated(ctx, p.identity.ID); err != nil {
+				return err
+			}
+		}
+		return s.auditLogService.RecordUpdate(ctx, domain.AuditEntityUser, p.user.ID, p.user, updatedUser)
+	case domain.DirectorySyncActionDeactivate:
+		updatedUser, err := s.userRepo.UpdateUser(ctx, p.user.ID, p.update)
+		if err != nil {
+			return err
+		}
+		if err := s.auditLogService.RecordUpdate(ctx, domain.AuditEntityUser, p.user.ID, p.user, updatedUser); err != nil {
+			return err
+		}
+
+		// * Waktu dicatat sama dengan updated_at user, perubahan user setelahnya berarti bukan lagi hasil sync
+		if err := s.identityRepo.MarkUserIdentitySyncDeactivated(ctx, p.identity.ID, *p.change.Reason, updatedUser.UpdatedAt); err != nil {
+			return err
+		}
+
+		// * User yang dinonaktifkan langsung kehilangan semua session
+		return s.sessionRepo.RevokeUserSessions(ctx, p.user.ID, domain.SessionRevokedUserDeactivated)
+	default:
+		return nil
+	}
+}
+
+// applyCreate provisions the user with an empty password, login lewat LDAP bind atau reset password
+func (s *Service) applyCreate(ctx context.Context, p *plannedChange) error {
+	name, err := utils.UniqueUsername(ctx, p.change.Username, s.userRepo.CheckNameExists)
+	if err != nil {
+		return err
+	}
+
+	fullName := name
+	if value := limitedValue(p.entry.FullName, fullNameMaxLength, "full name", p.change.Email); value != nil {
+		fullName = *value
+	}
+
+	createdUser, err := s.userRepo.CreateUser(ctx, &domain.User{
+		Name:        name,
+		Email:       p.change.Email,
+		FullName:    fullName,
+		Role:        defaultRole(),
+		EmployeeID:  limitedValue(p.entry.EmployeeID, employeeIDMaxLength, "employee ID", p.change.Email),
+		PhoneNumber: limitedValue(p.entry.PhoneNumber, phoneNumberMaxLength, "phone number", p.change.Email),
+		IsActive:    true,
+	})
+	if err != nil {
+		return err
+	}
+
+	userId := createdUser.ID
+	p.change.UserID = &userId
+	p.change.Username = createdUser.Name
+
+	if _, err := s.identityRepo.CreateUserIdentity(ctx, &domain.UserIdentity{
+		UserID:  createdUser.ID,
+		Issuer:  domain.LDAPIdentityIssuer,
+		Subject: p.entry.ID,
+		Email:   &p.change.Email,
+	}); err != nil {
+		return err
+	}
+
+	return s.auditLogService.RecordCreate(ctx, domain.AuditEntityUser, createdUser.ID, createdUser)
+}
+
+// reactivatable reports whether the sync deactivated the user itself and nobody changed the user since then
+func reactivatable(identity *domain.UserIdentity, user domain.User) bool {
+	if identity == nil || identity.SyncDeactivatedAt == nil {
+		return false
+	}
+	return !user.UpdatedAt.After(*identity.SyncDeactivatedAt)
+}
+
+func entrySkipReason(entry ldap.Entry, email string, seenIds map[string]bool, seenEmails map[string]bool) string {
+	switch {
+	case entry.ID == "":
+		return skipReasonMissingID
+	case email == "":
+		return skipReasonMissingEmail
+	case seenIds[entry.ID]:
+		return skipReasonDuplicateEntry
+	case seenEmails[email]:
+		return skipReasonDuplicateEmail
+	default:
+		return ""
+	}
+}
+
+func skipped(p plannedChange, reason string) plannedChange {
+	p.change.Action = domain.DirectorySyncActionSkip
+	p.change.Reason = &reason
+	p.change.Fields = nil
+	p.update = nil
+	return p
+}
+
+// limitedValue returns nil for empty values and values that do not fit the column
+func limitedValue(value string, maxLength int, field string, email string) *string {
+	if value == "" {
+		return nil
+	}
+	if len(value) > maxLength {
+		log.Printf("⚠️ Directory sync ignoring %s of %s, longer than %d characters", field, email, maxLength)
+		return nil
+	}
+	return &value
+}
+
+func defaultRole() domain.UserRole {
+	switch role := domain.UserRole(os.Getenv("LDAP_SYNC_DEFAULT_ROLE")); role {
+	case domain.RoleAdmin, domain.RoleStaff, domain.RoleEmployee:
+		return role
+	default:
+		return domain.RoleEmployee
+	}
+}
+
+func summarize(changes []domain.DirectorySyncChange, entries int, unchanged int) domain.DirectorySyncSummary {
+	summary := domain.DirectorySyncSummary{
+		DirectoryEntries: entries,
+		Unchanged:        unchanged,
+	}
+
+	for _, change := range changes {
+		if change.Error != nil {
+			summary.Failed++
+			continue
+		}
+
+		switch change.Action {
+		case domain.DirectorySyncActionCreate:
+			summary.Created++
+		case domain.DirectorySyncActionUpdate:
+			summary.Updated++
+		case domain.DirectorySyncActionDeactivate:
+			summary.Deactivated++
+		case domain.DirectorySyncActionSkip:
+			summary.Skipped++
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,91 @@
+package export_job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+	"github.com/robfig/cron/v3"
+)
+
+// expiredExportBatchSize limits how many jobs are cleaned up per tick, sisanya diambil di tick berikutnya
+const expiredExportBatchSize = 100
+
+// CleanupRepository defines the export job operations used by the cleanup cron
+type CleanupRepository interface {
+	GetExpiredExportJobs(ctx context.Context, now time.Time, limit int) ([]domain.ExportJob, error)
+	MarkExportJobExpired(ctx context.Context, exportJobId string) error
+}
+
+// CronService removes export files that are past their retention
+type CronService struct {
+	cron        *cron.Cron
+	repo        CleanupRepository
+	fileStorage storage.Storage
+}
+
+// NewCronService creates a new export job cleanup cron service instance
+func NewCronService(repo CleanupRepository, fileStorage storage.Storage) *CronService {
+	// * Skip kalau tick sebelumnya belum selesai
+	c := cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DefaultLogger)))
+
+	return &CronService{
+		cron:        c,
+		repo:        repo,
+		fileStorage: fileStorage,
+	}
+}
+
+// Start begins all scheduled cron jobs
+func (cs *CronService) Start() error {
+	// Clean up expired export files every hour
+	_, err := cs.cron.AddFunc("0 0 * * * *", cs.cleanupExpiredExports)
+	if err != nil {
+		return err
+	}
+
+	cs.cron.Start()
+	log.Println("Export job cleanup cron service started successfully")
+	return nil
+}
+
+// Stop gracefully stops all cron jobs
+func (cs *CronService) Stop() {
+	ctx := cs.cron.Stop()
+	<-ctx.Done()
+	log.Println("Export job cleanup cron service stopped")
+}
+
+// cleanupExpiredExports deletes the files of expired jobs and keeps the rows as history,
+// job yang file-nya gagal dihapus dicoba lagi di tick berikutnya
+func (cs *CronService) cleanupExpiredExports() {
+	ctx := context.Background()
+
+	exportJobs, err := cs.repo.GetExpiredExportJobs(ctx, time.Now(), expiredExportBatchSize)
+	if err != nil {
+		log.Printf("Failed to get expired export jobs: %v", err)
+		return
+	}
+
+	cleaned := 0
+	for _, exportJob := range exportJobs {
+		if exportJob.PublicID != nil && *exportJob.PublicID != "" && cs.fileStorage != nil {
+			if err := cs.fileStorage.DeleteFile(ctx, *exportJob.PublicID); err != nil {
+				log.Printf("Failed to delete file of expired export job %s: %v", exportJob.ID, err)
+				continue
+			}
+		}
+
+		if err := cs.repo.MarkExportJobExpired(ctx, exportJob.ID); err != nil {
+			log.Printf("Failed to mark export job %s as expired: %v", exportJob.ID, err)
+			continue
+		}
+		cleaned++
+	}
+
+	if cleaned > 0 {
+		log.Printf("Cleaned up %d expired export jobs", cleaned)
+	}
+}
@@ -0,0 +1,407 @@
+package export_job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+const (
+	defaultMaxActivePerUser = 2
+	defaultBatchSize        = 500
+	defaultRetention        = 24 * time.Hour
+	defaultDownloadLinkTTL  = 15 * time.Minute
+)
+
+// * Repository interface defines the contract for export job data operations
+type Repository interface {
+	// * MUTATION
+	CreateExportJob(ctx context.Context, payload *domain.ExportJob) (domain.ExportJob, error)
+	LockUserExportJobs(ctx context.Context, userId string) error
+	StartExportJob(ctx context.Context, exportJobId string) error
+	UpdateExportJobProgress(ctx context.Context, exportJobId string, processedRows int, totalRows int) error
+	CompleteExportJob(ctx context.Context, exportJobId string, fileName string, fileURL string, publicID string, fileSize int64, expiresAt time.Time) error
+	FailExportJob(ctx context.Context, exportJobId string, errMsg string, expiresAt time.Time) error
+	MarkExportJobExpired(ctx context.Context, exportJobId string) error
+	DeleteExportJob(ctx context.Context, exportJobId string) error
+
+	// * QUERY
+	GetExportJobsCursor(ctx context.Context, userId string, params domain.ExportJobParams) ([]domain.ExportJob, error)
+	GetExportJobById(ctx context.Context, exportJobId string) (domain.ExportJob, error)
+	CountActiveExportJobs(ctx context.Context, userId string) (int64, error)
+	GetExpiredExportJobs(ctx context.Context, now time.Time, limit int) ([]domain.ExportJob, error)
+}
+
+// * ExportJobService interface defines the contract for export job business operations
+type ExportJobService interface {
+	// * MUTATION
+	CreateExportJob(ctx context.Context, userId string, payload *domain.CreateExportJobPayload, langCode string) (domain.ExportJobResponse, error)
+	DeleteExportJob(ctx context.Context, userId string, exportJobId string) error
+	HandleExportJob(ctx context.Context, job *domain.Job) error
+
+	// * QUERY
+	GetExportJobsCursor(ctx context.Context, userId string, params domain.ExportJobParams) ([]domain.ExportJobResponse, error)
+	GetExportJobById(ctx context.Context, userId string, exportJobId string) (domain.ExportJobResponse, error)
+	DownloadExportJob(ctx context.Context, exportJobId string, expires string, signature string) (domain.ExportJobFile, error)
+}
+
+// * UserRepository interface for loading the owner of an export job
+type UserRepository interface {
+	GetUserById(ctx context.Context, userId string) (domain.User, error)
+}
+
+// * RoleService interface for checking the export permission of the owner
+type RoleService interface {
+	GetUserPermissions(ctx context.Context, userId string) ([]string, error)
+}
+
+// * LocationService interface for applying the owner's location scope when the export runs in the background
+type LocationService interface {
+	GetUserLocationIds(ctx context.Context, userId string) ([]string, error)
+}
+
+// * JobQueue interface for queueing export jobs
+type JobQueue interface {
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// * Repository per entity, query sama dengan export list sinkron ditambah count dan pagination per batch
+type AssetSource interface {
+	CountAssets(ctx context.Context, params domain.AssetParams) (int64, error)
+	GetAssetsForExport(ctx context.Context, params domain.AssetParams, langCode string) ([]domain.Asset, error)
+	GetCategoriesForExport(ctx context.Context, params domain.AssetParams) ([]domain.Category, error)
+	GetCustomAttributeKeysForExport(ctx context.Context, params domain.AssetParams) ([]string, error)
+}
+
+type AssetMovementSource interface {
+	CountAssetMovements(ctx context.Context, params domain.AssetMovementParams) (int64, error)
+	GetAssetMovementsForExport(ctx context.Context, params domain.AssetMovementParams, langCode string) ([]domain.AssetMovement, error)
+}
+
+type IssueReportSource interface {
+	CountIssueReports(ctx context.Context, params domain.IssueReportParams) (int64, error)
+	GetIssueReportsForExport(ctx context.Context, params domain.IssueReportParams, langCode string) ([]domain.IssueReport, error)
+}
+
+type MaintenanceScheduleSource interface {
+	CountSchedules(ctx context.Context, params domain.MaintenanceScheduleParams) (int64, error)
+	GetMaintenanceSchedulesForExport(ctx context.Context, params domain.MaintenanceScheduleParams, langCode string) ([]domain.MaintenanceSchedule, error)
+}
+
+type MaintenanceRecordSource interface {
+	CountRecords(ctx context.Context, params domain.MaintenanceRecordParams) (int64, error)
+	GetMaintenanceRecordsForExport(ctx context.Context, params domain.MaintenanceRecordParams, langCode string) ([]domain.MaintenanceRecord, error)
+}
+
+type ScanLogSource interface {
+	CountScanLogs(ctx context.Context, params domain.ScanLogParams) (int64, error)
+	GetScanLogsForExport(ctx context.Context, params domain.ScanLogParams) ([]domain.ScanLog, error)
+}
+
+type UserSource interface {
+	CountUsers(ctx context.Context, params domain.UserParams) (int64, error)
+	GetUsersForExport(ctx context.Context, params domain.UserParams) ([]domain.User, error)
+}
+
+// Sources groups the repositories an export job can read from
+type Sources struct {
+	Asset               AssetSource
+	AssetMovement       AssetMovementSource
+	IssueReport         IssueReportSource
+	MaintenanceSchedule MaintenanceScheduleSource
+	MaintenanceRecord   MaintenanceRecordSource
+	ScanLog             ScanLogSource
+	User                UserSource
+}
+
+type Service struct {
+	Repo            Repository
+	UserRepo        UserRepository
+	RoleService     RoleService
+	LocationService LocationService
+	Sources         Sources
+	FileStorage     storage.Storage
+	JobQueue        JobQueue
+}
+
+// * Ensure Service implements ExportJobService interface
+var _ ExportJobService = (*Service)(nil)
+
+func NewService(
+	r Repository,
+	userRepo UserRepository,
+	roleService RoleService,
+	locationService LocationService,
+	sources Sources,
+	fileStorage storage.Storage,
+	jobQueue JobQueue,
+) ExportJobService {
+	return &Service{
+		Repo:            r,
+		UserRepo:        userRepo,
+		RoleService:     roleService,
+		LocationService: locationService,
+		Sources:         sources,
+		FileStorage:     fileStorage,
+		JobQueue:        jobQueue,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (s *Service) CreateExportJob(ctx context.Context, userId string, payload *domain.CreateExportJobPayload, langCode string) (domain.ExportJobResponse, error) {
+	if !payload.EntityType.IsValid() {
+		return domain.ExportJobResponse{}, domain.ErrBadRequestWithKey(utils.ErrExportJobEntityTypeInvalidKey, string(payload.EntityType))
+	}
+
+	// * File hasil export hanya bisa diambil dari storage
+	if s.FileStorage == nil {
+		return domain.ExportJobResponse{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
+	}
+
+	newJob := domain.ExportJob{
+		UserID:      userId,
+		EntityType:  payload.EntityType,
+		Format:      payload.Format,
+		SearchQuery: normalizeSearchQuery(payload.SearchQuery),
+		Filters:     payload.Filters,
+		Sort:        payload.Sort,
+		LangCode:    langCode,
+		Status:      domain.ExportJobStatusPending,
+	}
+	if _, err := decodeExportPayload(&newJob); err != nil {
+		return domain.ExportJobResponse{}, err
+	}
+
+	if err := s.checkExportPermission(ctx, userId, payload.EntityType); err != nil {
+		return domain.ExportJobResponse{}, err
+	}
+
+	maxActive := utils.EnvPositiveInt("EXPORT_JOB_MAX_ACTIVE_PER_USER", defaultMaxActivePerUser)
+
+	var createdJob domain.ExportJob
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		// * Lock per user supaya request paralel tidak bisa melewati batas job aktif
+		if err := s.Repo.LockUserExportJobs(ctx, userId); err != nil {
+			return err
+		}
+
+		activeCount, err := s.Repo.CountActiveExportJobs(ctx, userId)
+		if err != nil {
+			return err
+		}
+		if activeCount >= int64(maxActive) {
+			return domain.ErrTooManyRequestsWithKey(utils.ErrExportJobTooManyActiveKey, strconv.Itoa(maxActive))
+		}
+
+		if createdJob, err = s.Repo.CreateExportJob(ctx, &newJob); err != nil {
+			return err
+		}
+		return s.JobQueue.Enqueue(ctx, domain.JobTypeExportList, exportListJob{ExportJobID: createdJob.ID})
+	})
+	if err != nil {
+		return domain.ExportJobResponse{}, err
+	}
+
+	return s.toResponse(&createdJob), nil
+}
+
+// DeleteExportJob removes the job and its file, job yang sedang berjalan harus ditunggu selesai dulu
+func (s *Service) DeleteExportJob(ctx context.Context, userId string, exportJobId string) error {
+	exportJob, err := s.getOwnedExportJob(ctx, userId, exportJobId)
+	if err != nil {
+		return err
+	}
+	if exportJob.Status == domain.ExportJobStatusRunning {
+		return domain.ErrConflictWithKey(utils.ErrExportJobRunningKey)
+	}
+
+	if err := s.Repo.DeleteExportJob(ctx, exportJobId); err != nil {
+		return err
+	}
+
+	// * Gagal hapus file cukup di-log, datanya sudah terhapus
+	if s.FileStorage != nil && exportJob.PublicID != nil && *exportJob.PublicID != "" {
+		if err := s.FileStorage.DeleteFile(ctx, *exportJob.PublicID); err != nil {
+			log.Printf("Failed to delete file of export job %s: %v", exportJobId, err)
+		}
+	}
+
+	return nil
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetExportJobsCursor(ctx context.Context, userId string, params domain.ExportJobParams) ([]domain.ExportJobResponse, error) {
+	if params.Filters != nil && params.Filters.EntityType != nil && !params.Filters.EntityType.IsValid() {
+		return nil, domain.ErrBadRequestWithKey(utils.ErrExportJobEntityTypeInvalidKey, string(*params.Filters.EntityType))
+	}
+
+	exportJobs, err := s.Repo.GetExportJobsCursor(ctx, userId, params)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]domain.ExportJobResponse, len(exportJobs))
+	for i, exportJob := range exportJobs {
+		responses[i] = s.toResponse(&exportJob)
+	}
+	return responses, nil
+}
+
+func (s *Service) GetExportJobById(ctx context.Context, userId string, exportJobId string) (domain.ExportJobResponse, error) {
+	exportJob, err := s.getOwnedExportJob(ctx, userId, exportJobId)
+	if err != nil {
+		return domain.ExportJobResponse{}, err
+	}
+
+	return s.toResponse(&exportJob), nil
+}
+
+// DownloadExportJob serves the file of a signed link, tidak butuh login karena link sudah ditandatangani dan berbatas waktu.
+// Content file wajib ditutup caller
+func (s *Service) DownloadExportJob(ctx context.Context, exportJobId string, expires string, signature string) (domain.ExportJobFile, error) {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || signature == "" || !utils.VerifyDownloadLink(downloadLinkResource(exportJobId), expiresAt, signature) {
+		return domain.ExportJobFile{}, domain.ErrForbiddenWithKey(utils.ErrExportJobDownloadLinkInvalidKey)
+	}
+	if time.Now().Unix() > expiresAt {
+		return domain.ExportJobFile{}, domain.ErrForbiddenWithKey(utils.ErrExportJobDownloadLinkExpiredKey)
+	}
+
+	exportJob, err := s.Repo.GetExportJobById(ctx, exportJobId)
+	if err != nil {
+		return domain.ExportJobFile{}, err
+	}
+	if exportJob.Status != domain.ExportJobStatusSucceeded || exportJob.FileURL == nil || *exportJob.FileURL == "" {
+		return domain.ExportJobFile{}, domain.ErrNotFoundWithKey(utils.ErrExportJobFileNotAvailableKey)
+	}
+
+	if s.FileStorage == nil {
+		return domain.ExportJobFile{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
+	}
+
+	content, size, err := storage.OpenFile(ctx, s.FileStorage, *exportJob.FileURL)
+	if err != nil {
+		return domain.ExportJobFile{}, domain.ErrInternal(err)
+	}
+
+	fileName := "export" + exportFileExtension(exportJob.Format)
+	if exportJob.FileName != nil && *exportJob.FileName != "" {
+		fileName = *exportJob.FileName
+	}
+
+	return domain.ExportJobFile{
+		FileName:    fileName,
+		ContentType: exportContentType(exportJob.Format),
+		Content:     content,
+		Size:        size,
+	}, nil
+}
+
+// *===========================HELPER METHODS===========================*
+// getOwnedExportJob returns the job only to its owner, job milik user lain dianggap tidak ada
+func (s *Service) getOwnedExportJob(ctx context.Context, userId string, exportJobId string) (domain.ExportJob, error) {
+	exportJob, err := s.Repo.GetExportJobById(ctx, exportJobId)
+	if err != nil {
+		return domain.ExportJob{}, err
+	}
+	if exportJob.UserID != userId {
+		return domain.ExportJob{}, domain.ErrNotFound("export job")
+	}
+	return exportJob, nil
+}
+
+// checkExportPermission mirrors the permission the entity's export list endpoint requires
+func (s *Service) checkExportPermission(ctx context.Context, userId string, entityType domain.SavedFilterEntityType) error {
+	required, ok := entityType.ExportPermission()
+	if !ok {
+		return nil
+	}
+
+	permissions, err := s.RoleService.GetUserPermissions(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !domain.HasPermission(permissions, required) {
+		return domain.ErrForbiddenWithKey(utils.ErrForbiddenKey)
+	}
+	return nil
+}
+
+// toResponse adds a freshly signed download link to finished jobs, link tidak berlaku melewati masa simpan file
+func (s *Service) toResponse(exportJob *domain.ExportJob) domain.ExportJobResponse {
+	response := mapper.ExportJobToResponse(exportJob)
+	if exportJob.Status != domain.ExportJobStatusSucceeded || exportJob.FileURL == nil || *exportJob.FileURL == "" {
+		return response
+	}
+
+	expiresAt := time.Now().Add(utils.EnvPositiveDuration("EXPORT_DOWNLOAD_LINK_TTL", defaultDownloadLinkTTL))
+	if exportJob.ExpiresAt != nil && exportJob.ExpiresAt.Before(expiresAt) {
+		expiresAt = *exportJob.ExpiresAt
+	}
+	expires := expiresAt.Unix()
+
+	signature := utils.SignDownloadLink(downloadLinkResource(exportJob.ID), expires)
+	if signature == "" {
+		log.Printf("Export job %s has no download link, EXPORT_LINK_SECRET and JWT_ACCESS_SECRET are empty", exportJob.ID)
+		return response
+	}
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", signature)
+	downloadURL := fmt.Sprintf("/api/v1/export-jobs/%s/download?%s", exportJob.ID, query.Encode())
+	linkExpiresAt := time.Unix(expires, 0)
+
+	response.DownloadURL = &downloadURL
+	response.DownloadURLExpiresAt = &linkExpiresAt
+	return response
+}
+
+func downloadLinkResource(exportJobId string) string {
+	return "export_jobs/" + exportJobId
+}
+
+// decodeExportPayload decodes the search, filters and sort into the entity's export list payload,
+// field yang tidak dikenal ditolak saat job dibuat, bukan baru ketahuan di worker
+func decodeExportPayload(exportJob *domain.ExportJob) (any, error) {
+	payload, err := exportJob.EntityType.DecodeExportListPayload(exportJob.Format, exportJob.SearchQuery, exportJob.Filters, exportJob.Sort)
+	if err != nil {
+		return nil, domain.ErrBadRequestWithKey(utils.ErrExportJobParamsInvalidKey, err.Error())
+	}
+	return payload, nil
+}
+
+func normalizeSearchQuery(searchQuery *string) *string {
+	if searchQuery == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*searchQuery)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+func exportFileExtension(format domain.ExportFormat) string {
+	if format == domain.ExportFormatExcel {
+		return ".xlsx"
+	}
+	return ".pdf"
+}
+
+func exportContentType(format domain.ExportFormat) string {
+	if format == domain.ExportFormatExcel {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "application/pdf"
+}
@@ -0,0 +1,179 @@
+package export_job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	jobQueue "github.com/Rizz404/inventory-api/services/job"
+)
+
+type exportListJob struct {
+	ExportJobID string `json:"exportJobId"`
+}
+
+// *===========================JOB HANDLERS===========================*
+
+// HandleExportJob generates the export file batch by batch and uploads it to storage.
+// Error sementara (DB, storage) membuat job di-retry dari awal, progress di-reset saat job dimulai lagi
+func (s *Service) HandleExportJob(ctx context.Context, job *domain.Job) error {
+	var payload exportListJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	exportJob, err := s.Repo.GetExportJobById(ctx, payload.ExportJobID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			log.Printf("Export job %s no longer exists, skipping", payload.ExportJobID)
+			return nil
+		}
+		return err
+	}
+	if exportJob.Status == domain.ExportJobStatusSucceeded || exportJob.Status == domain.ExportJobStatusExpired {
+		return nil
+	}
+
+	if err := s.Repo.StartExportJob(ctx, exportJob.ID); err != nil {
+		return err
+	}
+
+	if err := s.runExportJob(ctx, &exportJob); err != nil {
+		errMsg := domain.ErrorMessageWithCause(err, exportJob.LangCode)
+		expiresAt := time.Now().Add(utils.EnvPositiveDuration("EXPORT_JOB_RETENTION", defaultRetention))
+		if failErr := s.Repo.FailExportJob(ctx, exportJob.ID, errMsg, expiresAt); failErr != nil {
+			log.Printf("Failed to mark export job %s as failed: %v", exportJob.ID, failErr)
+		}
+
+		// * Error permanen tidak di-retry, job sudah ditandai failed
+		if jobQueue.IsPermanent(err) {
+			log.Printf("Export job %s failed permanently: %s", exportJob.ID, errMsg)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// runExportJob runs the export as the owner of the job, jadi permission dan scope lokasi sama dengan export list sinkron
+func (s *Service) runExportJob(ctx context.Context, exportJob *domain.ExportJob) error {
+	owner, err := s.UserRepo.GetUserById(ctx, exportJob.UserID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return jobQueue.Permanent(err)
+		}
+		return err
+	}
+	if !owner.IsActive {
+		return jobQueue.Permanent(fmt.Errorf("owner %s of export job is inactive", owner.ID))
+	}
+
+	if err := s.checkExportPermission(ctx, owner.ID, exportJob.EntityType); err != nil {
+		if domain.IsClientError(err) {
+			return jobQueue.Permanent(err)
+		}
+		return err
+	}
+
+	if s.FileStorage == nil {
+		return jobQueue.Permanent(errors.New("file storage is not configured"))
+	}
+
+	ctx, err = web.WithRequestUserScope(ctx, owner.ID, s.RoleService.GetUserPermissions, s.LocationService.GetUserLocationIds)
+	if err != nil {
+		return err
+	}
+
+	exportPayload, err := decodeExportPayload(exportJob)
+	if err != nil {
+		return jobQueue.Permanent(err)
+	}
+
+	source, err := s.newExportSource(ctx, exportPayload, exportJob.LangCode)
+	if err != nil {
+		return err
+	}
+
+	total, err := source.count(ctx)
+	if err != nil {
+		return err
+	}
+	totalRows := int(total)
+	if err := s.Repo.UpdateExportJobProgress(ctx, exportJob.ID, 0, totalRows); err != nil {
+		return err
+	}
+
+	writer, err := newTableWriter(source, exportJob.Format, exportJob.LangCode)
+	if err != nil {
+		return err
+	}
+	defer writer.Close()
+
+	// * Data diambil per batch, baris yang sudah ditulis tidak ditahan di memory
+	batchSize := utils.EnvPositiveInt("EXPORT_JOB_BATCH_SIZE", defaultBatchSize)
+	processedRows := 0
+	for processedRows < totalRows {
+		rows, err := source.fetch(ctx, processedRows, batchSize)
+		if err != nil {
+			return err
+		}
+		if err := writer.WriteRows(rows); err != nil {
+			return err
+		}
+
+		processedRows += len(rows)
+		if err := s.Repo.UpdateExportJobProgress(ctx, exportJob.ID, processedRows, totalRows); err != nil {
+			return err
+		}
+
+		// * Data berkurang selama export berjalan
+		if len(rows) < batchSize {
+			break
+		}
+	}
+
+	ext := exportFileExtension(exportJob.Format)
+	tmpFile, err := os.CreateTemp("", "export-*"+ext)
+	if err != nil {
+		return err
+	}
+	tmpPath := tmpFile.Name()
+	defer os.Remove(tmpPath)
+
+	if err := writer.Finish(tmpFile, processedRows); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	fileInfo, err := os.Stat(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	fileName := fmt.Sprintf("%s_%s%s", source.filePrefix, time.Now().Format("2006-01-02_15-04-05"), ext)
+	uploadResult, err := storage.UploadFile(ctx, s.FileStorage, fileName, tmpPath, storage.GetExportUploadConfig())
+	if err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(utils.EnvPositiveDuration("EXPORT_JOB_RETENTION", defaultRetention))
+	if err := s.Repo.CompleteExportJob(ctx, exportJob.ID, fileName, uploadResult.SecureURL, uploadResult.PublicID, fileInfo.Size(), expiresAt); err != nil {
+		if deleteErr := s.FileStorage.DeleteFile(ctx, uploadResult.PublicID); deleteErr != nil {
+			log.Printf("Warning: Failed to delete orphaned export file %s: %v", uploadResult.PublicID, deleteErr)
+		}
+		return err
+	}
+
+	return nil
+}
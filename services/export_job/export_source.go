@@ -0,0 +1,598 @@
+package export_job
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+// exportColumn is one column of an export file, header Excel sama dengan export list sinkron
+// dan PDF hanya memuat kolom yang juga ada di PDF sinkron karena lebar halaman terbatas
+type exportColumn struct {
+	header   string           // Header Excel, kosong berarti kolom hanya ada di PDF
+	pdfTitle utils.MessageKey // Judul kolom PDF, kosong berarti kolom hanya ada di Excel
+	pdfWidth float64
+}
+
+// exportSource reads one entity in batches, sumber data untuk tableWriter
+type exportSource struct {
+	filePrefix string
+	sheetName  string
+	titleKey   utils.MessageKey
+	totalKey   utils.MessageKey
+	columns    []exportColumn
+	count      func(ctx context.Context) (int64, error)
+	// fetch returns rows [offset, offset+limit), satu slice per baris sejajar dengan columns
+	fetch func(ctx context.Context, offset int, limit int) ([][]string, error)
+}
+
+// newExportSource builds the source of the decoded export list payload, params sama dengan endpoint export list entity-nya
+func (s *Service) newExportSource(ctx context.Context, payload any, langCode string) (*exportSource, error) {
+	switch p := payload.(type) {
+	case *domain.ExportAssetListPayload:
+		return s.assetSource(ctx, domain.AssetParams{SearchQuery: p.SearchQuery, Filters: p.Filters, Sort: p.Sort}, langCode)
+	case *domain.ExportAssetMovementListPayload:
+		return s.assetMovementSource(domain.AssetMovementParams{SearchQuery: p.SearchQuery, Filters: p.Filters, Sort: p.Sort}, langCode), nil
+	case *domain.ExportIssueReportListPayload:
+		return s.issueReportSource(domain.IssueReportParams{SearchQuery: p.SearchQuery, Filters: p.Filters, Sort: p.Sort}, langCode), nil
+	case *domain.ExportMaintenanceScheduleListPayload:
+		return s.maintenanceScheduleSource(domain.MaintenanceScheduleParams{SearchQuery: p.SearchQuery, Filters: p.Filters, Sort: p.Sort}, langCode), nil
+	case *domain.ExportMaintenanceRecordListPayload:
+		return s.maintenanceRecordSource(domain.MaintenanceRecordParams{SearchQuery: p.SearchQuery, Filters: p.Filters, Sort: p.Sort}, langCode), nil
+	case *domain.ExportScanLogListPayload:
+		return s.scanLogSource(domain.ScanLogParams{SearchQuery: p.SearchQuery, Filters: p.Filters, Sort: p.Sort}), nil
+	case *domain.ExportUserListPayload:
+		return s.userSource(domain.UserParams{SearchQuery: p.SearchQuery, Filters: p.Filters, Sort: p.Sort}), nil
+	default:
+		return nil, fmt.Errorf("unsupported export payload %T", payload)
+	}
+}
+
+func batchPagination(offset int, limit int) *domain.PaginationOptions {
+	return &domain.PaginationOptions{Limit: limit, Offset: offset}
+}
+
+// *===========================ASSET===========================*
+func (s *Service) assetSource(ctx context.Context, params domain.AssetParams, langCode string) (*exportSource, error) {
+	// * Kolom custom attribute harus diketahui sebelum header ditulis, jadi diambil dulu dari kategori dan data yang cocok
+	attributeColumns, err := s.assetAttributeColumns(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []exportColumn{
+		{header: "Asset Tag", pdfTitle: utils.PDFAssetAssetTagKey, pdfWidth: 70},
+		{header: "Asset Name", pdfTitle: utils.PDFAssetAssetNameKey, pdfWidth: 140},
+		{header: "Category", pdfTitle: utils.PDFAssetCategoryKey, pdfWidth: 100},
+		{header: "Brand", pdfTitle: utils.PDFAssetBrandKey, pdfWidth: 80},
+		{header: "Model", pdfTitle: utils.PDFAssetModelKey, pdfWidth: 80},
+		{header: "Serial Number"},
+		{header: "Purchase Date"},
+		{header: "Purchase Price"},
+		{header: "Vendor"},
+		{header: "Warranty End"},
+		{header: "Status", pdfTitle: utils.PDFAssetStatusKey, pdfWidth: 80},
+		{header: "Condition", pdfTitle: utils.PDFAssetConditionKey, pdfWidth: 80},
+		{header: "Location", pdfTitle: utils.PDFAssetLocationKey, pdfWidth: 100},
+		{header: "Assigned To"},
+	}
+	for _, column := range attributeColumns {
+		columns = append(columns, exportColumn{header: column.label})
+	}
+
+	return &exportSource{
+		filePrefix: "asset_list",
+		sheetName:  "Assets",
+		titleKey:   utils.PDFAssetListReportKey,
+		totalKey:   utils.PDFAssetTotalAssetsKey,
+		columns:    columns,
+		count: func(ctx context.Context) (int64, error) {
+			return s.Sources.Asset.CountAssets(ctx, params)
+		},
+		fetch: func(ctx context.Context, offset int, limit int) ([][]string, error) {
+			batchParams := params
+			batchParams.Pagination = batchPagination(offset, limit)
+			assets, err := s.Sources.Asset.GetAssetsForExport(ctx, batchParams, langCode)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, 0, len(assets))
+			for _, asset := range mapper.AssetsToResponses(assets, langCode) {
+				categoryName := ""
+				if asset.Category != nil {
+					categoryName = asset.Category.CategoryName
+				}
+				locationName := ""
+				if asset.Location != nil {
+					locationName = asset.Location.LocationName
+				}
+				assignedToName := ""
+				if asset.AssignedTo != nil {
+					assignedToName = asset.AssignedTo.FullName
+				}
+				purchaseDate := ""
+				if asset.PurchaseDate != nil {
+					purchaseDate = asset.PurchaseDate.Format("2006-01-02")
+				}
+				purchasePrice := ""
+				if asset.PurchasePrice != nil && asset.PurchasePrice.Valid {
+					value, _ := asset.PurchasePrice.Float64()
+					purchasePrice = fmt.Sprintf("%.2f", value)
+				}
+				warrantyEnd := ""
+				if asset.WarrantyEnd != nil {
+					warrantyEnd = asset.WarrantyEnd.Format("2006-01-02")
+				}
+
+				row := []string{
+					asset.AssetTag,
+					asset.AssetName,
+					categoryName,
+					stringValue(asset.Brand),
+					stringValue(asset.Model),
+					stringValue(asset.SerialNumber),
+					purchaseDate,
+					purchasePrice,
+					stringValue(asset.VendorName),
+					warrantyEnd,
+					string(asset.Status),
+					string(asset.Condition),
+					locationName,
+					assignedToName,
+				}
+				for _, column := range attributeColumns {
+					row = append(row, domain.FormatAttributeValue(asset.CustomAttributes[column.key]))
+				}
+				rows = append(rows, row)
+			}
+			return rows, nil
+		},
+	}, nil
+}
+
+type assetAttributeColumn struct {
+	key   string
+	label string
+}
+
+// assetAttributeColumns returns the schema attributes of the matching categories ordered by category code,
+// followed by stored keys no longer defined by a schema
+func (s *Service) assetAttributeColumns(ctx context.Context, params domain.AssetParams) ([]assetAttributeColumn, error) {
+	categories, err := s.Sources.Asset.GetCategoriesForExport(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.Sources.Asset.GetCustomAttributeKeysForExport(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []assetAttributeColumn{}
+	seen := make(map[string]struct{})
+	for _, category := range categories {
+		for _, attribute := range category.Attributes {
+			if _, exists := seen[attribute.Key]; exists {
+				continue
+			}
+			seen[attribute.Key] = struct{}{}
+			columns = append(columns, assetAttributeColumn{key: attribute.Key, label: attribute.Label})
+		}
+	}
+
+	orphanKeys := []string{}
+	for _, key := range keys {
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		seen[key] = struct{}{}
+		orphanKeys = append(orphanKeys, key)
+	}
+	sort.Strings(orphanKeys)
+	for _, key := range orphanKeys {
+		columns = append(columns, assetAttributeColumn{key: key, label: key})
+	}
+
+	return columns, nil
+}
+
+// *===========================ASSET MOVEMENT===========================*
+func (s *Service) assetMovementSource(params domain.AssetMovementParams, langCode string) *exportSource {
+	return &exportSource{
+		filePrefix: "asset_movements",
+		sheetName:  "Asset Movements",
+		titleKey:   utils.PDFAssetMovementReportKey,
+		totalKey:   utils.PDFAssetMovementTotalKey,
+		columns: []exportColumn{
+			{header: "Asset Tag", pdfTitle: utils.PDFAssetAssetTagKey, pdfWidth: 70},
+			{header: "Asset Name", pdfTitle: utils.PDFAssetAssetNameKey, pdfWidth: 110},
+			{header: "From Location", pdfTitle: utils.PDFAssetMovementFromLocationKey, pdfWidth: 90},
+			{header: "To Location", pdfTitle: utils.PDFAssetMovementToLocationKey, pdfWidth: 90},
+			{header: "From User", pdfTitle: utils.PDFAssetMovementFromUserKey, pdfWidth: 90},
+			{header: "To User", pdfTitle: utils.PDFAssetMovementToUserKey, pdfWidth: 90},
+			{header: "Moved By", pdfTitle: utils.PDFAssetMovementMovedByKey, pdfWidth: 90},
+			{header: "Movement Date", pdfTitle: utils.PDFAssetMovementDateKey, pdfWidth: 90},
+			{header: "Notes", pdfTitle: utils.PDFAssetMovementNotesKey, pdfWidth: 100},
+		},
+		count: func(ctx context.Context) (int64, error) {
+			return s.Sources.AssetMovement.CountAssetMovements(ctx, params)
+		},
+		fetch: func(ctx context.Context, offset int, limit int) ([][]string, error) {
+			batchParams := params
+			batchParams.Pagination = batchPagination(offset, limit)
+			movements, err := s.Sources.AssetMovement.GetAssetMovementsForExport(ctx, batchParams, langCode)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, 0, len(movements))
+			for _, movement := range mapper.AssetMovementsToResponses(movements, langCode) {
+				fromLoc := ""
+				if movement.FromLocation != nil {
+					fromLoc = movement.FromLocation.LocationName
+				}
+				toLoc := ""
+				if movement.ToLocation != nil {
+					toLoc = movement.ToLocation.LocationName
+				}
+				fromUser := ""
+				if movement.FromUser != nil {
+					fromUser = movement.FromUser.FullName
+				}
+				toUser := ""
+				if movement.ToUser != nil {
+					toUser = movement.ToUser.FullName
+				}
+
+				rows = append(rows, []string{
+					movement.Asset.AssetTag,
+					movement.Asset.AssetName,
+					fromLoc,
+					toLoc,
+					fromUser,
+					toUser,
+					movement.MovedBy.FullName,
+					movement.MovementDate.Format("2006-01-02"),
+					stringValue(movement.Notes),
+				})
+			}
+			return rows, nil
+		},
+	}
+}
+
+// *===========================ISSUE REPORT===========================*
+func (s *Service) issueReportSource(params domain.IssueReportParams, langCode string) *exportSource {
+	return &exportSource{
+		filePrefix: "issue_reports",
+		sheetName:  "Issue Reports",
+		titleKey:   utils.PDFIssueReportReportKey,
+		totalKey:   utils.PDFIssueReportTotalKey,
+		columns: []exportColumn{
+			{header: "Asset Tag", pdfTitle: utils.PDFAssetAssetTagKey, pdfWidth: 70},
+			{header: "Asset Name", pdfTitle: utils.PDFAssetAssetNameKey, pdfWidth: 100},
+			{header: "Title", pdfTitle: utils.PDFIssueReportTitleKey, pdfWidth: 120},
+			{header: "Issue Type", pdfTitle: utils.PDFIssueReportTypeKey, pdfWidth: 80},
+			{header: "Priority", pdfTitle: utils.PDFIssueReportPriorityKey, pdfWidth: 70},
+			{header: "Status", pdfTitle: utils.PDFAssetStatusKey, pdfWidth: 70},
+			{header: "Reported By", pdfTitle: utils.PDFIssueReportReportedByKey, pdfWidth: 100},
+			{header: "Reported Date", pdfTitle: utils.PDFIssueReportReportedDateKey, pdfWidth: 80},
+			{header: "Resolved By"},
+			{header: "Resolved Date"},
+			{header: "Description"},
+			{header: "Resolution Notes"},
+		},
+		count: func(ctx context.Context) (int64, error) {
+			return s.Sources.IssueReport.CountIssueReports(ctx, params)
+		},
+		fetch: func(ctx context.Context, offset int, limit int) ([][]string, error) {
+			batchParams := params
+			batchParams.Pagination = batchPagination(offset, limit)
+			reports, err := s.Sources.IssueReport.GetIssueReportsForExport(ctx, batchParams, langCode)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, 0, len(reports))
+			for _, report := range mapper.IssueReportsToResponses(reports, langCode) {
+				resolvedBy := ""
+				if report.ResolvedBy != nil {
+					resolvedBy = report.ResolvedBy.FullName
+				}
+				resolvedDate := ""
+				if report.ResolvedDate != nil {
+					resolvedDate = report.ResolvedDate.Format("2006-01-02")
+				}
+
+				rows = append(rows, []string{
+					report.Asset.AssetTag,
+					report.Asset.AssetName,
+					report.Title,
+					report.IssueType,
+					string(report.Priority),
+					string(report.Status),
+					report.ReportedBy.FullName,
+					report.ReportedDate.Format("2006-01-02"),
+					resolvedBy,
+					resolvedDate,
+					stringValue(report.Description),
+					stringValue(report.ResolutionNotes),
+				})
+			}
+			return rows, nil
+		},
+	}
+}
+
+// *===========================MAINTENANCE SCHEDULE===========================*
+func (s *Service) maintenanceScheduleSource(params domain.MaintenanceScheduleParams, langCode string) *exportSource {
+	return &exportSource{
+		filePrefix: "maintenance_schedules",
+		sheetName:  "Maintenance Schedules",
+		titleKey:   utils.PDFMaintenanceScheduleReportKey,
+		totalKey:   utils.PDFMaintenanceScheduleTotalKey,
+		columns: []exportColumn{
+			{header: "Asset Tag", pdfTitle: utils.PDFAssetAssetTagKey, pdfWidth: 70},
+			{header: "Asset Name", pdfTitle: utils.PDFAssetAssetNameKey, pdfWidth: 100},
+			{header: "Title", pdfTitle: utils.PDFMaintenanceScheduleTitleKey, pdfWidth: 120},
+			{header: "Maintenance Type", pdfTitle: utils.PDFMaintenanceScheduleTypeKey, pdfWidth: 90},
+			{header: "Next Scheduled Date", pdfTitle: utils.PDFMaintenanceScheduleNextDateKey, pdfWidth: 80},
+			{header: "Last Executed Date"},
+			{header: "Is Recurring", pdfTitle: utils.PDFMaintenanceScheduleRecurringKey, pdfWidth: 70},
+			{header: "Interval"},
+			{header: "State", pdfTitle: utils.PDFMaintenanceScheduleStateKey, pdfWidth: 70},
+			{header: "Auto Complete"},
+			{header: "Estimated Cost", pdfTitle: utils.PDFMaintenanceScheduleCostKey, pdfWidth: 80},
+			{header: "Created By"},
+			{header: "Description"},
+		},
+		count: func(ctx context.Context) (int64, error) {
+			return s.Sources.MaintenanceSchedule.CountSchedules(ctx, params)
+		},
+		fetch: func(ctx context.Context, offset int, limit int) ([][]string, error) {
+			batchParams := params
+			batchParams.Pagination = batchPagination(offset, limit)
+			schedules, err := s.Sources.MaintenanceSchedule.GetMaintenanceSchedulesForExport(ctx, batchParams, langCode)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, 0, len(schedules))
+			for _, schedule := range mapper.MaintenanceSchedulesToResponses(schedules, langCode) {
+				lastExecutedDate := ""
+				if schedule.LastExecutedDate != nil {
+					lastExecutedDate = schedule.LastExecutedDate.Format("2006-01-02")
+				}
+				interval := "-"
+				if schedule.IntervalValue != nil && schedule.IntervalUnit != nil {
+					interval = fmt.Sprintf("%d %s", *schedule.IntervalValue, *schedule.IntervalUnit)
+				}
+				estimatedCost := ""
+				if schedule.EstimatedCost != nil && schedule.EstimatedCost.Valid {
+					value, _ := schedule.EstimatedCost.Float64()
+					estimatedCost = fmt.Sprintf("%.2f", value)
+				}
+
+				rows = append(rows, []string{
+					schedule.Asset.AssetTag,
+					schedule.Asset.AssetName,
+					schedule.Title,
+					string(schedule.MaintenanceType),
+					schedule.NextScheduledDate.Format("2006-01-02"),
+					lastExecutedDate,
+					yesNo(schedule.IsRecurring),
+					interval,
+					string(schedule.State),
+					yesNo(schedule.AutoComplete),
+					estimatedCost,
+					schedule.CreatedBy.FullName,
+					stringValue(schedule.Description),
+				})
+			}
+			return rows, nil
+		},
+	}
+}
+
+// *===========================MAINTENANCE RECORD===========================*
+func (s *Service) maintenanceRecordSource(params domain.MaintenanceRecordParams, langCode string) *exportSource {
+	return &exportSource{
+		filePrefix: "maintenance_records",
+		sheetName:  "Maintenance Records",
+		titleKey:   utils.PDFMaintenanceRecordReportKey,
+		totalKey:   utils.PDFMaintenanceRecordTotalKey,
+		columns: []exportColumn{
+			{header: "Asset Tag", pdfTitle: utils.PDFAssetAssetTagKey, pdfWidth: 70},
+			{header: "Asset Name", pdfTitle: utils.PDFAssetAssetNameKey, pdfWidth: 110},
+			{header: "Title", pdfTitle: utils.PDFMaintenanceRecordTitleKey, pdfWidth: 140},
+			{header: "Maintenance Date", pdfTitle: utils.PDFMaintenanceRecordDateKey, pdfWidth: 80},
+			{header: "Completion Date", pdfTitle: utils.PDFMaintenanceRecordCompletionKey, pdfWidth: 80},
+			{header: "Duration (min)"},
+			// * PDF menggabungkan user dan vendor dalam satu kolom pelaksana
+			{pdfTitle: utils.PDFMaintenanceRecordPerformerKey, pdfWidth: 100},
+			{header: "Performed By User"},
+			{header: "Performed By Vendor"},
+			{header: "Result", pdfTitle: utils.PDFMaintenanceRecordResultKey, pdfWidth: 70},
+			{header: "Actual Cost", pdfTitle: utils.PDFMaintenanceRecordCostKey, pdfWidth: 70},
+			{header: "Notes"},
+		},
+		count: func(ctx context.Context) (int64, error) {
+			return s.Sources.MaintenanceRecord.CountRecords(ctx, params)
+		},
+		fetch: func(ctx context.Context, offset int, limit int) ([][]string, error) {
+			batchParams := params
+			batchParams.Pagination = batchPagination(offset, limit)
+			records, err := s.Sources.MaintenanceRecord.GetMaintenanceRecordsForExport(ctx, batchParams, langCode)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, 0, len(records))
+			for _, record := range mapper.MaintenanceRecordsToResponses(records, langCode) {
+				completionDate := ""
+				if record.CompletionDate != nil {
+					completionDate = record.CompletionDate.Format("2006-01-02")
+				}
+				duration := ""
+				if record.DurationMinutes != nil {
+					duration = fmt.Sprintf("%d", *record.DurationMinutes)
+				}
+				performedByUser := ""
+				if record.PerformedByUser != nil {
+					performedByUser = record.PerformedByUser.FullName
+				}
+				performedByVendor := stringValue(record.PerformedByVendor)
+				performer := performedByUser
+				if performer == "" {
+					performer = performedByVendor
+				}
+				actualCost := ""
+				if record.ActualCost != nil && record.ActualCost.Valid {
+					value, _ := record.ActualCost.Float64()
+					actualCost = fmt.Sprintf("%.2f", value)
+				}
+
+				rows = append(rows, []string{
+					record.Asset.AssetTag,
+					record.Asset.AssetName,
+					record.Title,
+					record.MaintenanceDate.Format("2006-01-02"),
+					completionDate,
+					duration,
+					performer,
+					performedByUser,
+					performedByVendor,
+					string(record.Result),
+					actualCost,
+					stringValue(record.Notes),
+				})
+			}
+			return rows, nil
+		},
+	}
+}
+
+// *===========================SCAN LOG===========================*
+func (s *Service) scanLogSource(params domain.ScanLogParams) *exportSource {
+	return &exportSource{
+		filePrefix: "scan_logs",
+		sheetName:  "Scan Logs",
+		titleKey:   utils.PDFScanLogReportKey,
+		totalKey:   utils.PDFScanLogTotalKey,
+		columns: []exportColumn{
+			{header: "Asset ID"},
+			{header: "Scanned Value", pdfTitle: utils.PDFScanLogScannedValueKey, pdfWidth: 120},
+			{header: "Scan Method", pdfTitle: utils.PDFScanLogMethodKey, pdfWidth: 100},
+			{header: "Scanned By", pdfTitle: utils.PDFScanLogScannedByKey, pdfWidth: 110},
+			{header: "Scan Timestamp", pdfTitle: utils.PDFScanLogTimestampKey, pdfWidth: 120},
+			{header: "Scan Result", pdfTitle: utils.PDFScanLogResultKey, pdfWidth: 90},
+			{header: "Latitude"},
+			{header: "Longitude"},
+			{pdfTitle: utils.PDFScanLogCoordinatesKey, pdfWidth: 120},
+		},
+		count: func(ctx context.Context) (int64, error) {
+			return s.Sources.ScanLog.CountScanLogs(ctx, params)
+		},
+		fetch: func(ctx context.Context, offset int, limit int) ([][]string, error) {
+			batchParams := params
+			batchParams.Pagination = batchPagination(offset, limit)
+			logs, err := s.Sources.ScanLog.GetScanLogsForExport(ctx, batchParams)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, 0, len(logs))
+			for _, log := range mapper.ScanLogsToListResponses(logs) {
+				lat := ""
+				if log.ScanLocationLat != nil {
+					lat = fmt.Sprintf("%.6f", *log.ScanLocationLat)
+				}
+				lng := ""
+				if log.ScanLocationLng != nil {
+					lng = fmt.Sprintf("%.6f", *log.ScanLocationLng)
+				}
+				coordinates := ""
+				if log.ScanLocationLat != nil && log.ScanLocationLng != nil {
+					coordinates = fmt.Sprintf("%.4f, %.4f", *log.ScanLocationLat, *log.ScanLocationLng)
+				}
+
+				rows = append(rows, []string{
+					stringValue(log.AssetID),
+					log.ScannedValue,
+					string(log.ScanMethod),
+					log.ScannedByID,
+					log.ScanTimestamp.Format("2006-01-02 15:04:05"),
+					string(log.ScanResult),
+					lat,
+					lng,
+					coordinates,
+				})
+			}
+			return rows, nil
+		},
+	}
+}
+
+// *===========================USER===========================*
+func (s *Service) userSource(params domain.UserParams) *exportSource {
+	return &exportSource{
+		filePrefix: "users",
+		sheetName:  "Users",
+		titleKey:   utils.PDFUserReportKey,
+		totalKey:   utils.PDFUserTotalKey,
+		columns: []exportColumn{
+			{header: "Name", pdfTitle: utils.PDFUserNameKey, pdfWidth: 100},
+			{header: "Email", pdfTitle: utils.PDFUserEmailKey, pdfWidth: 180},
+			{header: "Full Name", pdfTitle: utils.PDFUserFullNameKey, pdfWidth: 140},
+			{header: "Role", pdfTitle: utils.PDFUserRoleKey, pdfWidth: 80},
+			{header: "Employee ID", pdfTitle: utils.PDFUserEmployeeIDKey, pdfWidth: 90},
+			{header: "Preferred Language"},
+			{header: "Is Active", pdfTitle: utils.PDFUserIsActiveKey, pdfWidth: 70},
+			{header: "Created At"},
+		},
+		count: func(ctx context.Context) (int64, error) {
+			return s.Sources.User.CountUsers(ctx, params)
+		},
+		fetch: func(ctx context.Context, offset int, limit int) ([][]string, error) {
+			batchParams := params
+			batchParams.Pagination = batchPagination(offset, limit)
+			users, err := s.Sources.User.GetUsersForExport(ctx, batchParams)
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, 0, len(users))
+			for _, user := range mapper.UsersToListResponses(users) {
+				rows = append(rows, []string{
+					user.Name,
+					user.Email,
+					user.FullName,
+					string(user.Role),
+					stringValue(user.EmployeeID),
+					user.PreferredLang,
+					yesNo(user.IsActive),
+					user.CreatedAt.Format("2006-01-02 15:04:05"),
+				})
+			}
+			return rows, nil
+		},
+	}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "Yes"
+	}
+	return "No"
+}
@@ -0,0 +1,357 @@
+package export_job
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/signintech/gopdf"
+	"github.com/xuri/excelize/v2"
+)
+
+// tableWriter writes an export file batch by batch, baris yang sudah ditulis tidak disimpan lagi di memory
+type tableWriter interface {
+	WriteRows(rows [][]string) error
+	// Finish writes the footer and the whole file to w
+	Finish(w io.Writer, totalRows int) error
+	Close() error
+}
+
+func newTableWriter(source *exportSource, format domain.ExportFormat, langCode string) (tableWriter, error) {
+	switch format {
+	case domain.ExportFormatExcel:
+		return newExcelTableWriter(source)
+	case domain.ExportFormatPDF:
+		return newPDFTableWriter(source, langCode)
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+// *===========================EXCEL===========================*
+
+// excelTableWriter uses the excelize StreamWriter, baris besar di-flush ke temp file oleh excelize
+type excelTableWriter struct {
+	file    *excelize.File
+	stream  *excelize.StreamWriter
+	columns []int
+	nextRow int
+}
+
+func newExcelTableWriter(source *exportSource) (*excelTableWriter, error) {
+	f := excelize.NewFile()
+
+	sheetName := source.sheetName
+	index, err := f.NewSheet(sheetName)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	// Set active sheet
+	f.SetActiveSheet(index)
+
+	// Create header style
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 12,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#4472C4"},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+	})
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	stream, err := f.NewStreamWriter(sheetName)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	columns := []int{}
+	header := []any{}
+	for i, column := range source.columns {
+		if column.header == "" {
+			continue
+		}
+		columns = append(columns, i)
+		header = append(header, excelize.Cell{StyleID: headerStyle, Value: column.header})
+	}
+
+	// * Lebar kolom harus diset sebelum baris pertama ditulis
+	if err := stream.SetColWidth(1, len(columns), 18); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := stream.SetRow("A1", header); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &excelTableWriter{
+		file:    f,
+		stream:  stream,
+		columns: columns,
+		nextRow: 2,
+	}, nil
+}
+
+func (w *excelTableWriter) WriteRows(rows [][]string) error {
+	for _, row := range rows {
+		values := make([]any, len(w.columns))
+		for i, column := range w.columns {
+			values[i] = row[column]
+		}
+
+		cell, _ := excelize.CoordinatesToCellName(1, w.nextRow)
+		if err := w.stream.SetRow(cell, values); err != nil {
+			return err
+		}
+		w.nextRow++
+	}
+	return nil
+}
+
+func (w *excelTableWriter) Finish(out io.Writer, totalRows int) error {
+	if err := w.stream.Flush(); err != nil {
+		return err
+	}
+	return w.file.Write(out)
+}
+
+func (w *excelTableWriter) Close() error {
+	return w.file.Close()
+}
+
+// *===========================PDF===========================*
+
+// Page setup (A4 Landscape: 842 x 595 points), sama dengan export list PDF sinkron
+const (
+	pdfMarginLeft   = 30.0
+	pdfMarginTop    = 50.0
+	pdfPageWidth    = 842.0
+	pdfPageHeight   = 595.0
+	pdfHeaderHeight = 25.0
+)
+
+// pdfTableWriter draws the table page by page, header diulang di setiap halaman baru.
+// gopdf tetap menyimpan dokumen di memory, hanya data entity yang diambil per batch
+type pdfTableWriter struct {
+	pdf       *gopdf.GoPdf
+	columns   []int
+	headers   []string
+	widths    []float64
+	totalText string
+	y         float64
+	rowIndex  int
+}
+
+func newPDFTableWriter(source *exportSource, langCode string) (*pdfTableWriter, error) {
+	// Get absolute path for fonts and logo
+	workDir, _ := os.Getwd()
+	fontRegularPath := filepath.Join(workDir, "assets", "fonts", "NotoSansJP-Regular.ttf")
+	fontBoldPath := filepath.Join(workDir, "assets", "fonts", "NotoSansJP-Bold.ttf")
+	logoPath := filepath.Join(workDir, "assets", "images", "fts-logo.png")
+
+	pdf := &gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{
+		PageSize: *gopdf.PageSizeA4Landscape,
+		Unit:     gopdf.Unit_PT,
+	})
+	pdf.AddPage()
+
+	// Load fonts - Always use Noto Sans for Unicode support (works for all languages)
+	if err := pdf.AddTTFFont("noto-regular", fontRegularPath); err != nil {
+		return nil, fmt.Errorf("failed to load regular font: %w", err)
+	}
+	if err := pdf.AddTTFFont("noto-bold", fontBoldPath); err != nil {
+		return nil, fmt.Errorf("failed to load bold font: %w", err)
+	}
+
+	w := &pdfTableWriter{
+		pdf:       pdf,
+		totalText: utils.GetLocalizedMessage(source.totalKey, langCode),
+	}
+	for i, column := range source.columns {
+		if column.pdfTitle == "" {
+			continue
+		}
+		w.columns = append(w.columns, i)
+		w.headers = append(w.headers, utils.GetLocalizedMessage(column.pdfTitle, langCode))
+		w.widths = append(w.widths, column.pdfWidth)
+	}
+
+	reportTitle := utils.GetLocalizedMessage(source.titleKey, langCode)
+	generatedOnText := utils.GetLocalizedMessage(utils.PDFAssetGeneratedOnKey, langCode)
+
+	// Add company logo if exists
+	currentY := pdfMarginTop
+	if _, err := os.Stat(logoPath); err == nil {
+		rect := &gopdf.Rect{W: 60, H: 60}
+		pdf.Image(logoPath, pdfMarginLeft, currentY-10, rect)
+
+		// Title next to logo
+		pdf.SetFont("noto-bold", "", 16)
+		pdf.SetX(pdfMarginLeft + 70)
+		pdf.SetY(currentY + 15)
+		pdf.Cell(nil, reportTitle)
+
+		currentY += 50
+	} else {
+		// No logo, centered title
+		pdf.SetFont("noto-bold", "", 16)
+		titleWidth, _ := pdf.MeasureTextWidth(reportTitle)
+		pdf.SetX((pdfPageWidth - titleWidth) / 2)
+		pdf.SetY(currentY)
+		pdf.Cell(nil, reportTitle)
+
+		currentY += 30
+	}
+
+	// Subtitle with date
+	pdf.SetFont("noto-regular", "", 10)
+	dateText := fmt.Sprintf("%s: %s", generatedOnText, time.Now().Format("2006-01-02 15:04:05"))
+	dateWidth, _ := pdf.MeasureTextWidth(dateText)
+	pdf.SetX((pdfPageWidth - dateWidth) / 2)
+	pdf.SetY(currentY)
+	pdf.Cell(nil, dateText)
+
+	w.y = currentY + 25
+	w.drawHeader()
+
+	return w, nil
+}
+
+func (w *pdfTableWriter) drawHeader() {
+	pdf := w.pdf
+	contentWidth := pdfPageWidth - (pdfMarginLeft * 2)
+
+	pdf.SetFillColor(68, 114, 196) // Blue background
+	pdf.RectFromUpperLeftWithStyle(pdfMarginLeft, w.y, contentWidth, pdfHeaderHeight, "F")
+	pdf.SetTextColor(255, 255, 255) // White text
+	pdf.SetFont("noto-bold", "", 9)
+
+	x := pdfMarginLeft
+	for i, header := range w.headers {
+		pdf.SetX(x + 3)
+		pdf.SetY(w.y + 8)
+		pdf.Cell(nil, header)
+		x += w.widths[i]
+	}
+
+	// Reset for data rows
+	w.y += pdfHeaderHeight
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont("noto-regular", "", 8)
+}
+
+// wrapText splits text into lines that fit maxWidth, per karakter supaya teks CJK tanpa spasi ikut terpotong
+func (w *pdfTableWriter) wrapText(text string, maxWidth float64) []string {
+	lines := []string{}
+	currentLine := ""
+
+	for _, char := range text {
+		testLine := currentLine + string(char)
+		width, _ := w.pdf.MeasureTextWidth(testLine)
+
+		if width > maxWidth-10 { // -10 for padding
+			if currentLine != "" {
+				lines = append(lines, currentLine)
+			}
+			currentLine = string(char)
+		} else {
+			currentLine = testLine
+		}
+	}
+	if currentLine != "" {
+		lines = append(lines, currentLine)
+	}
+
+	if len(lines) == 0 {
+		return []string{text}
+	}
+	return lines
+}
+
+func (w *pdfTableWriter) WriteRows(rows [][]string) error {
+	pdf := w.pdf
+	contentWidth := pdfPageWidth - (pdfMarginLeft * 2)
+
+	for _, row := range rows {
+		// Calculate row height based on content (check for multi-line text)
+		cellLines := make([][]string, len(w.columns))
+		maxLines := 1
+		for i, column := range w.columns {
+			cellLines[i] = w.wrapText(row[column], w.widths[i])
+			if len(cellLines[i]) > maxLines {
+				maxLines = len(cellLines[i])
+			}
+		}
+
+		rowHeight := float64(maxLines) * 12.0
+		if rowHeight < 18 {
+			rowHeight = 18
+		}
+
+		// Check if need new page
+		if w.y+rowHeight > pdfPageHeight-40 {
+			pdf.AddPage()
+			w.y = pdfMarginTop
+			w.drawHeader()
+		}
+
+		// Zebra striping
+		if w.rowIndex%2 == 1 {
+			pdf.SetFillColor(242, 242, 242)
+			pdf.RectFromUpperLeftWithStyle(pdfMarginLeft, w.y, contentWidth, rowHeight, "F")
+		}
+
+		x := pdfMarginLeft
+		cellY := w.y + 5
+		for i, lines := range cellLines {
+			for lineIdx, line := range lines {
+				pdf.SetX(x + 3)
+				pdf.SetY(cellY + float64(lineIdx)*10)
+				pdf.Cell(nil, line)
+			}
+			x += w.widths[i]
+		}
+
+		w.y += rowHeight
+		w.rowIndex++
+	}
+	return nil
+}
+
+func (w *pdfTableWriter) Finish(out io.Writer, totalRows int) error {
+	// Footer - Total count
+	y := w.y + 15
+	if y > pdfPageHeight-40 {
+		w.pdf.AddPage()
+		y = pdfMarginTop
+	}
+	w.pdf.SetFont("noto-bold", "", 11)
+	w.pdf.SetX(pdfMarginLeft)
+	w.pdf.SetY(y)
+	w.pdf.Cell(nil, fmt.Sprintf("%s: %d", w.totalText, totalRows))
+
+	return w.pdf.Write(out)
+}
+
+func (w *pdfTableWriter) Close() error {
+	return w.pdf.Close()
+}
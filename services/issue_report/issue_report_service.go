@@ -47,6 +47,18 @@ type UserRepository interface {
 	GetUsersPaginated(ctx context.Context, params domain.UserParams) ([]domain.User, error)
 }
 
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// * WebhookService interface for emitting outbound webhook events
+type WebhookService interface {
+	Emit(ctx context.Context, eventType domain.WebhookEventType, data any) error
+}
+
 // * IssueReportService interface defines the contract for issue report business operations
 type IssueReportService interface {
 	// * MUTATION
@@ -64,6 +76,35 @@ type IssueReportService interface {
 	CountIssueReports(ctx context.Context, params domain.IssueReportParams) (int64, error)
 	GetIssueReportStatistics(ctx context.Context) (domain.IssueReportStatisticsResponse, error)
 	ExportIssueReportList(ctx context.Context, payload domain.ExportIssueReportListPayload, params domain.IssueReportParams, langCode string) ([]byte, string, error)
+
+	// * JOB HANDLERS
+	HandleTranslationJob(ctx context.Context, job *domain.Job) error
+	HandleNotificationJob(ctx context.Context, job *domain.Job) error
+}
+
+// * JobQueue interface for enqueueing background jobs in the same transaction as the mutation
+type JobQueue interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+}
+
+// translationJob is the payload of domain.JobTypeIssueReportTranslation, Updated terisi untuk translate setelah update
+type translationJob struct {
+	IssueReportID string                                       `json:"issueReportId"`
+	Created       []domain.CreateIssueReportTranslationPayload `json:"created,omitempty"`
+	Updated       []domain.UpdateIssueReportTranslationPayload `json:"updated,omitempty"`
+	Existing      []domain.IssueReportTranslation              `json:"existing,omitempty"`
+}
+
+const (
+	issueReportedEvent = "reported"
+	issueUpdatedEvent  = "updated"
+)
+
+// notificationJob is the payload of domain.JobTypeIssueReportNotification, IssueReport adalah snapshot saat mutasi
+type notificationJob struct {
+	Event       string             `json:"event"`
+	IssueReport domain.IssueReport `json:"issueReport"`
 }
 
 type Service struct {
@@ -72,18 +113,24 @@ type Service struct {
 	AssetService        AssetService
 	UserRepo            UserRepository
 	Translator          *gtranslate.Client
+	AuditLogService     AuditLogService
+	WebhookService      WebhookService
+	JobQueue            JobQueue
 }
 
 // * Ensure Service implements IssueReportService interface
 var _ IssueReportService = (*Service)(nil)
 
-func NewService(r Repository, notificationService NotificationService, assetService AssetService, userRepo UserRepository, translator *gtranslate.Client) IssueReportService {
+func NewService(r Repository, notificationService NotificationService, assetService AssetService, userRepo UserRepository, translator *gtranslate.Client, auditLogService AuditLogService, webhookService WebhookService, jobQueue JobQueue) IssueReportService {
 	return &Service{
 		Repo:                r,
 		NotificationService: notificationService,
 		AssetService:        assetService,
 		UserRepo:            userRepo,
 		Translator:          translator,
+		AuditLogService:     auditLogService,
+		WebhookService:      webhookService,
+		JobQueue:            jobQueue,
 	}
 }
 
@@ -109,51 +156,112 @@ func (s *Service) CreateIssueReport(ctx context.Context, payload *domain.CreateI
 		}
 	}
 
-	createdIssueReport, err := s.Repo.CreateIssueReport(ctx, &newIssueReport)
+	var createdIssueReport domain.IssueReport
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdIssueReport, err = s.Repo.CreateIssueReport(ctx, &newIssueReport)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityIssueReport, createdIssueReport.ID, createdIssueReport); err != nil {
+			return err
+		}
+
+		// * Auto-translate missing languages in background
+		if len(payload.Translations) < 3 {
+			err = s.JobQueue.Enqueue(ctx, domain.JobTypeIssueReportTranslation, translationJob{
+				IssueReportID: createdIssueReport.ID,
+				Created:       payload.Translations,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// * Send notification asynchronously
+		err = s.JobQueue.Enqueue(ctx, domain.JobTypeIssueReportNotification, notificationJob{
+			Event:       issueReportedEvent,
+			IssueReport: createdIssueReport,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.WebhookService.Emit(ctx, domain.WebhookEventIssueReported, mapper.IssueReportToResponse(&createdIssueReport, mapper.DefaultLangCode))
+	})
 	if err != nil {
 		return domain.IssueReportResponse{}, err
 	}
 
-	// * Auto-translate missing languages in background
-	go s.autoTranslateCreateIssueReportAsync(createdIssueReport.ID, payload.Translations)
-
-	// * Send notification asynchronously
-	go s.sendIssueReportedNotification(context.Background(), &createdIssueReport)
-
 	// * Convert to IssueReportResponse using mapper
 	return mapper.IssueReportToResponse(&createdIssueReport, mapper.DefaultLangCode), nil
 }
 
 func (s *Service) UpdateIssueReport(ctx context.Context, issueReportId string, payload *domain.UpdateIssueReportPayload, langCode string) (domain.IssueReportResponse, error) {
 	// * Check if issue report exists
-	_, err := s.Repo.GetIssueReportById(ctx, issueReportId)
+	existingIssueReport, err := s.Repo.GetIssueReportById(ctx, issueReportId)
 	if err != nil {
 		return domain.IssueReportResponse{}, err
 	}
 
-	updatedIssueReport, err := s.Repo.UpdateIssueReport(ctx, issueReportId, payload)
+	var updatedIssueReport domain.IssueReport
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedIssueReport, err = s.Repo.UpdateIssueReport(ctx, issueReportId, payload)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityIssueReport, issueReportId, existingIssueReport, updatedIssueReport); err != nil {
+			return err
+		}
+
+		// * Auto-translate missing languages in background if translations updated
+		if len(payload.Translations) > 0 && len(payload.Translations) < 3 {
+			err = s.JobQueue.Enqueue(ctx, domain.JobTypeIssueReportTranslation, translationJob{
+				IssueReportID: issueReportId,
+				Updated:       payload.Translations,
+				Existing:      updatedIssueReport.Translations,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// * Send notification asynchronously
+		err = s.JobQueue.Enqueue(ctx, domain.JobTypeIssueReportNotification, notificationJob{
+			Event:       issueUpdatedEvent,
+			IssueReport: updatedIssueReport,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.emitIssueUpdatedWebhook(ctx, &existingIssueReport, &updatedIssueReport)
+	})
 	if err != nil {
 		return domain.IssueReportResponse{}, err
 	}
 
-	// * Auto-translate missing languages in background if translations updated
-	if len(payload.Translations) > 0 {
-		go s.autoTranslateUpdateIssueReportAsync(issueReportId, payload.Translations, updatedIssueReport.Translations)
-	}
-
-	// * Send notification asynchronously
-	go s.sendIssueUpdatedNotification(context.Background(), &updatedIssueReport)
-
 	// * Convert to IssueReportResponse using mapper with requested lang code
 	return mapper.IssueReportToResponse(&updatedIssueReport, langCode), nil
 }
 
 func (s *Service) DeleteIssueReport(ctx context.Context, issueReportId string) error {
-	err := s.Repo.DeleteIssueReport(ctx, issueReportId)
+	// * Get issue report data for audit trail before deletion
+	existingIssueReport, err := s.Repo.GetIssueReportById(ctx, issueReportId)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteIssueReport(ctx, issueReportId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityIssueReport, issueReportId, existingIssueReport)
+	})
 }
 
 func (s *Service) BulkCreateIssueReports(ctx context.Context, payload *domain.BulkCreateIssueReportsPayload, reportedBy string) (domain.BulkCreateIssueReportsResponse, error) {
@@ -203,16 +311,36 @@ func (s *Service) BulkCreateIssueReports(ctx context.Context, payload *domain.Bu
 	}
 
 	// * Call repository bulk create
-	created, err := s.Repo.BulkCreateIssueReports(ctx, issueReports)
+	var created []domain.IssueReport
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.Repo.BulkCreateIssueReports(ctx, issueReports)
+		if err != nil {
+			return err
+		}
+
+		// * Send notifications asynchronously
+		for i := range created {
+			if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityIssueReport, created[i].ID, created[i]); err != nil {
+				return err
+			}
+			err := s.JobQueue.Enqueue(ctx, domain.JobTypeIssueReportNotification, notificationJob{
+				Event:       issueReportedEvent,
+				IssueReport: created[i],
+			})
+			if err != nil {
+				return err
+			}
+			if err := s.WebhookService.Emit(ctx, domain.WebhookEventIssueReported, mapper.IssueReportToResponse(&created[i], mapper.DefaultLangCode)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkCreateIssueReportsResponse{}, err
 	}
 
-	// * Send notifications asynchronously
-	for i := range created {
-		go s.sendIssueReportedNotification(context.Background(), &created[i])
-	}
-
 	// * Convert to responses
 	response := domain.BulkCreateIssueReportsResponse{
 		IssueReports: mapper.IssueReportsToResponses(created, mapper.DefaultLangCode),
@@ -227,7 +355,21 @@ func (s *Service) BulkDeleteIssueReports(ctx context.Context, payload *domain.Bu
 	}
 
 	// * Perform bulk delete operation
-	result, err := s.Repo.BulkDeleteIssueReports(ctx, payload.IDS)
+	var result domain.BulkDeleteIssueReports
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.Repo.BulkDeleteIssueReports(ctx, payload.IDS)
+		if err != nil {
+			return err
+		}
+
+		for _, deletedId := range result.DeletedIDS {
+			if err := s.AuditLogService.RecordDelete(ctx, domain.AuditEntityIssueReport, deletedId, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkDeleteIssueReportsResponse{}, err
 	}
@@ -376,6 +518,24 @@ func (s *Service) sendIssueUpdatedNotification(ctx context.Context, issueReport
 	}
 }
 
+// emitIssueUpdatedWebhook queues issue.updated, plus issue.resolved when the update moved the issue to Resolved
+func (s *Service) emitIssueUpdatedWebhook(ctx context.Context, oldIssueReport, newIssueReport *domain.IssueReport) error {
+	issueReportResponse := mapper.IssueReportToResponse(newIssueReport, mapper.DefaultLangCode)
+
+	err := s.WebhookService.Emit(ctx, domain.WebhookEventIssueUpdated, domain.WebhookIssueUpdatedData{
+		IssueReport:    issueReportResponse,
+		PreviousStatus: oldIssueReport.Status,
+	})
+	if err != nil {
+		return err
+	}
+
+	if newIssueReport.Status == domain.IssueStatusResolved && oldIssueReport.Status != domain.IssueStatusResolved {
+		return s.WebhookService.Emit(ctx, domain.WebhookEventIssueResolved, issueReportResponse)
+	}
+	return nil
+}
+
 // Helper function to determine notification priority based on issue priority
 func determinePriorityFromIssue(issue *domain.IssueReport) domain.NotificationPriority {
 	switch issue.Priority {
@@ -459,17 +619,47 @@ func (s *Service) GetIssueReportStatistics(ctx context.Context) (domain.IssueRep
 	return mapper.IssueReportStatisticsToResponse(&stats), nil
 }
 
+// *===========================JOB HANDLERS===========================*
+
+// HandleTranslationJob fills the missing issue report translations queued by create and update
+func (s *Service) HandleTranslationJob(ctx context.Context, job *domain.Job) error {
+	var payload translationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	if payload.Updated != nil {
+		return s.autoTranslateUpdateIssueReport(ctx, payload.IssueReportID, payload.Updated, payload.Existing)
+	}
+	return s.autoTranslateCreateIssueReport(ctx, payload.IssueReportID, payload.Created)
+}
+
+// HandleNotificationJob sends the issue reported/updated notifications queued by the mutations
+func (s *Service) HandleNotificationJob(ctx context.Context, job *domain.Job) error {
+	var payload notificationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	switch payload.Event {
+	case issueReportedEvent:
+		s.sendIssueReportedNotification(ctx, &payload.IssueReport)
+	case issueUpdatedEvent:
+		s.sendIssueUpdatedNotification(ctx, &payload.IssueReport)
+	default:
+		log.Printf("Unknown issue report notification event %q in job %s", payload.Event, job.ID)
+	}
+	return nil
+}
+
 // *===========================ASYNC TRANSLATION===========================*
 
-// autoTranslateCreateIssueReportAsync translates issue report to missing languages in background
-func (s *Service) autoTranslateCreateIssueReportAsync(issueReportID string, userTranslations []domain.CreateIssueReportTranslationPayload) {
+// autoTranslateCreateIssueReport translates issue report to missing languages, dijalankan worker lewat HandleTranslationJob
+func (s *Service) autoTranslateCreateIssueReport(ctx context.Context, issueReportID string, userTranslations []domain.CreateIssueReportTranslationPayload) error {
 	if len(userTranslations) >= 3 {
-		return // All languages provided, no need to translate
+		return nil // All languages provided, no need to translate
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
 	// Convert domain types to utils types
 	utilsTranslations := make([]utils.IssueReportCreateTranslation, len(userTranslations))
 	for i, t := range userTranslations {
@@ -485,7 +675,7 @@ func (s *Service) autoTranslateCreateIssueReportAsync(issueReportID string, user
 	translatedPayloads, err := utils.AutoTranslateIssueReportCreate(ctx, s.Translator, utilsTranslations)
 	if err != nil {
 		log.Printf("Failed to auto-translate issue report ID %s: %v", issueReportID, err)
-		return
+		return err
 	}
 
 	// Extract only new translations
@@ -514,19 +704,18 @@ func (s *Service) autoTranslateCreateIssueReportAsync(issueReportID string, user
 	if len(newTranslations) > 0 {
 		if err := s.Repo.AddIssueReportTranslations(ctx, issueReportID, newTranslations); err != nil {
 			log.Printf("Failed to save auto-translated issue report translations for ID %s: %v", issueReportID, err)
+			return err
 		}
 	}
+	return nil
 }
 
-// autoTranslateUpdateIssueReportAsync translates issue report updates to missing languages in background
-func (s *Service) autoTranslateUpdateIssueReportAsync(issueReportID string, userUpdates []domain.UpdateIssueReportTranslationPayload, existingTranslations []domain.IssueReportTranslation) {
+// autoTranslateUpdateIssueReport translates issue report updates to missing languages, dijalankan worker lewat HandleTranslationJob
+func (s *Service) autoTranslateUpdateIssueReport(ctx context.Context, issueReportID string, userUpdates []domain.UpdateIssueReportTranslationPayload, existingTranslations []domain.IssueReportTranslation) error {
 	if len(userUpdates) == 0 {
-		return // No updates provided
+		return nil // No updates provided
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
 	// If user updated all 3 languages, no need to auto-translate
 	updatedLangCodes := make([]string, len(userUpdates))
 	for i, t := range userUpdates {
@@ -534,7 +723,7 @@ func (s *Service) autoTranslateUpdateIssueReportAsync(issueReportID string, user
 	}
 
 	if len(updatedLangCodes) >= 3 {
-		return
+		return nil
 	}
 
 	// Convert domain types to utils types
@@ -562,7 +751,7 @@ func (s *Service) autoTranslateUpdateIssueReportAsync(issueReportID string, user
 	translatedPayloads, err := utils.AutoTranslateIssueReportUpdate(ctx, s.Translator, utilsUpdates, utilsExisting)
 	if err != nil {
 		log.Printf("Failed to auto-translate updated issue report ID %s: %v", issueReportID, err)
-		return
+		return err
 	}
 
 	// Extract only new translations (not in userUpdates)
@@ -623,6 +812,8 @@ func (s *Service) autoTranslateUpdateIssueReportAsync(issueReportID string, user
 	if len(newTranslations) > 0 {
 		if err := s.Repo.AddIssueReportTranslations(ctx, issueReportID, newTranslations); err != nil {
 			log.Printf("Failed to save auto-translated issue report update translations for ID %s: %v", issueReportID, err)
+			return err
 		}
 	}
+	return nil
 }
@@ -0,0 +1,28 @@
+package job
+
+import "errors"
+
+// PermanentError marks a handler failure that will not go away on retry.
+// Handler menandai entity-nya failed lalu return nil supaya job tidak di-retry
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent wraps err as a PermanentError
+func Permanent(err error) error {
+	return &PermanentError{Err: err}
+}
+
+// IsPermanent reports whether err or any error it wraps is a PermanentError
+func IsPermanent(err error) bool {
+	var permanent *PermanentError
+	return errors.As(err, &permanent)
+}
@@ -0,0 +1,152 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+// * Repository interface defines the contract for job data operations
+type Repository interface {
+	// * MUTATION
+	CreateJob(ctx context.Context, payload *domain.Job) error
+	RetryJob(ctx context.Context, jobId string) (domain.Job, error)
+	RetryDeadJobs(ctx context.Context, jobType *domain.JobType) (int64, error)
+	PurgeJobs(ctx context.Context, status domain.JobStatus, jobType *domain.JobType, olderThan *time.Time) (int64, error)
+
+	// * QUERY
+	GetJobsCursor(ctx context.Context, params domain.JobParams) ([]domain.Job, error)
+	GetJobById(ctx context.Context, jobId string) (domain.Job, error)
+	GetJobStatistics(ctx context.Context) (domain.JobStatistics, error)
+}
+
+// * Transactor runs repository calls in one database transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// * JobService interface defines the contract for job queue operations
+type JobService interface {
+	// * MUTATION
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	RetryJob(ctx context.Context, jobId string) (domain.JobResponse, error)
+	RetryDeadJobs(ctx context.Context, payload *domain.RetryJobsPayload) (domain.RetryJobsResponse, error)
+	PurgeJobs(ctx context.Context, payload *domain.PurgeJobsPayload) (domain.PurgeJobsResponse, error)
+
+	// * QUERY
+	GetJobsCursor(ctx context.Context, params domain.JobParams) ([]domain.JobResponse, error)
+	GetJobById(ctx context.Context, jobId string) (domain.JobResponse, error)
+	GetJobStatistics(ctx context.Context) (domain.JobStatisticsResponse, error)
+}
+
+type Service struct {
+	Repo       Repository
+	Transactor Transactor
+}
+
+// * Ensure Service implements JobService interface
+var _ JobService = (*Service)(nil)
+
+func NewService(r Repository, transactor Transactor) JobService {
+	return &Service{
+		Repo:       r,
+		Transactor: transactor,
+	}
+}
+
+// *===========================MUTATION===========================*
+
+// Enqueue stores a job for the worker pool, panggil dengan ctx dari WithinTransaction supaya job hanya ada kalau mutasinya commit
+func (s *Service) Enqueue(ctx context.Context, jobType domain.JobType, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+
+	return s.Repo.CreateJob(ctx, &domain.Job{
+		Type:        jobType,
+		Payload:     string(body),
+		Status:      domain.JobStatusPending,
+		MaxAttempts: utils.EnvPositiveInt("JOB_MAX_ATTEMPTS", defaultMaxAttempts),
+		RunAt:       time.Now(),
+	})
+}
+
+// WithinTransaction runs the mutation and its Enqueue calls in one database transaction
+func (s *Service) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return s.Transactor.WithinTransaction(ctx, fn)
+}
+
+func (s *Service) RetryJob(ctx context.Context, jobId string) (domain.JobResponse, error) {
+	existingJob, err := s.Repo.GetJobById(ctx, jobId)
+	if err != nil {
+		return domain.JobResponse{}, err
+	}
+
+	// * Job yang sedang jalan atau sudah sukses tidak bisa di-retry
+	if existingJob.Status == domain.JobStatusRunning || existingJob.Status == domain.JobStatusSucceeded {
+		return domain.JobResponse{}, domain.ErrConflictWithKey(utils.ErrJobNotRetryableKey)
+	}
+
+	retriedJob, err := s.Repo.RetryJob(ctx, jobId)
+	if err != nil {
+		return domain.JobResponse{}, err
+	}
+
+	return mapper.JobToResponse(&retriedJob), nil
+}
+
+func (s *Service) RetryDeadJobs(ctx context.Context, payload *domain.RetryJobsPayload) (domain.RetryJobsResponse, error) {
+	retried, err := s.Repo.RetryDeadJobs(ctx, payload.Type)
+	if err != nil {
+		return domain.RetryJobsResponse{}, err
+	}
+
+	return domain.RetryJobsResponse{Retried: retried}, nil
+}
+
+func (s *Service) PurgeJobs(ctx context.Context, payload *domain.PurgeJobsPayload) (domain.PurgeJobsResponse, error) {
+	if payload.Status != domain.JobStatusSucceeded && payload.Status != domain.JobStatusDead {
+		return domain.PurgeJobsResponse{}, domain.ErrBadRequestWithKey(utils.ErrJobPurgeStatusInvalidKey)
+	}
+
+	purged, err := s.Repo.PurgeJobs(ctx, payload.Status, payload.Type, payload.OlderThan)
+	if err != nil {
+		return domain.PurgeJobsResponse{}, err
+	}
+
+	return domain.PurgeJobsResponse{Purged: purged}, nil
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetJobsCursor(ctx context.Context, params domain.JobParams) ([]domain.JobResponse, error) {
+	jobs, err := s.Repo.GetJobsCursor(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.JobsToResponses(jobs), nil
+}
+
+func (s *Service) GetJobById(ctx context.Context, jobId string) (domain.JobResponse, error) {
+	job, err := s.Repo.GetJobById(ctx, jobId)
+	if err != nil {
+		return domain.JobResponse{}, err
+	}
+
+	return mapper.JobToResponse(&job), nil
+}
+
+func (s *Service) GetJobStatistics(ctx context.Context) (domain.JobStatisticsResponse, error) {
+	stats, err := s.Repo.GetJobStatistics(ctx)
+	if err != nil {
+		return domain.JobStatisticsResponse{}, err
+	}
+
+	return mapper.JobStatisticsToResponse(&stats), nil
+}
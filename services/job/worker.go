@@ -0,0 +1,298 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	defaultConcurrency    = 10
+	defaultPollInterval   = time.Second
+	defaultJobTimeout     = 2 * time.Minute
+	defaultMaxAttempts    = 5
+	defaultRetryBaseDelay = 15 * time.Second
+	defaultRetryMaxDelay  = time.Hour
+	defaultRetentionDays  = 7
+	// * Lease sedikit lebih lama dari timeout handler, job yang lease-nya habis dianggap worker-nya mati
+	leaseMargin = time.Minute
+)
+
+// Handler processes one job, error membuat job di-retry dengan backoff
+type Handler func(ctx context.Context, job *domain.Job) error
+
+// WorkerRepository defines the queue operations used by the worker
+type WorkerRepository interface {
+	ClaimDueJob(ctx context.Context, jobTypes []domain.JobType, workerId string, lease time.Duration) (domain.Job, bool, error)
+	CompleteJob(ctx context.Context, jobId string, workerId string) error
+	FailJob(ctx context.Context, jobId string, workerId string, lastError string, status domain.JobStatus, runAt time.Time) error
+	PurgeJobs(ctx context.Context, status domain.JobStatus, jobType *domain.JobType, olderThan *time.Time) (int64, error)
+}
+
+type registeredHandler struct {
+	handler     Handler
+	concurrency int
+	running     int
+}
+
+// Worker is the pool that claims and runs queued jobs
+type Worker struct {
+	repo     WorkerRepository
+	id       string
+	cron     *cron.Cron
+	handlers map[domain.JobType]*registeredHandler
+
+	mu          sync.Mutex
+	running     int
+	concurrency int
+	timeout     time.Duration
+
+	wake chan struct{}
+	stop chan struct{}
+	done chan struct{}
+	jobs sync.WaitGroup
+}
+
+// NewWorker creates a new job worker instance
+func NewWorker(repo WorkerRepository) *Worker {
+	hostname, _ := os.Hostname()
+
+	return &Worker{
+		repo:     repo,
+		id:       fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		cron:     cron.New(cron.WithSeconds()),
+		handlers: make(map[domain.JobType]*registeredHandler),
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Register sets the handler of a job type, concurrency > 0 membatasi job tipe ini yang berjalan bersamaan
+func (w *Worker) Register(jobType domain.JobType, concurrency int, handler Handler) {
+	w.handlers[jobType] = &registeredHandler{
+		handler:     handler,
+		concurrency: concurrency,
+	}
+}
+
+// Start begins processing jobs unless JOB_WORKER_ENABLED=false, job tetap di-enqueue dan diproses instance lain
+func (w *Worker) Start() error {
+	if os.Getenv("JOB_WORKER_ENABLED") == "false" {
+		log.Println("Job worker disabled")
+		close(w.done)
+		return nil
+	}
+
+	w.concurrency = utils.EnvPositiveInt("JOB_WORKER_CONCURRENCY", defaultConcurrency)
+	w.timeout = utils.EnvPositiveDuration("JOB_TIMEOUT", defaultJobTimeout)
+
+	// Purge old succeeded jobs daily at 4:00 AM
+	if _, err := w.cron.AddFunc("0 0 4 * * *", w.purgeSucceededJobs); err != nil {
+		return err
+	}
+	w.cron.Start()
+
+	go w.run(utils.EnvPositiveDuration("JOB_POLL_INTERVAL", defaultPollInterval))
+
+	log.Printf("Job worker %s started with %d slots for %d job types", w.id, w.concurrency, len(w.handlers))
+	return nil
+}
+
+// Stop stops claiming new jobs and waits for running jobs to finish
+func (w *Worker) Stop() {
+	select {
+	case <-w.done:
+	default:
+		close(w.stop)
+		<-w.done
+	}
+
+	ctx := w.cron.Stop()
+	<-ctx.Done()
+	w.jobs.Wait()
+	log.Println("Job worker stopped")
+}
+
+func (w *Worker) run(pollInterval time.Duration) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.claimJobs()
+
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+		case <-w.wake:
+		}
+	}
+}
+
+// claimJobs fills the free slots, satu job per query supaya limit per tipe tetap akurat
+func (w *Worker) claimJobs() {
+	for {
+		jobTypes := w.availableJobTypes()
+		if len(jobTypes) == 0 {
+			return
+		}
+
+		job, found, err := w.repo.ClaimDueJob(context.Background(), jobTypes, w.id, w.timeout+leaseMargin)
+		if err != nil {
+			log.Printf("Failed to claim job: %v", err)
+			return
+		}
+		if !found {
+			return
+		}
+
+		w.startJob(&job)
+	}
+}
+
+// availableJobTypes returns the registered types that still have a free slot
+func (w *Worker) availableJobTypes() []domain.JobType {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.running >= w.concurrency {
+		return nil
+	}
+
+	jobTypes := make([]domain.JobType, 0, len(w.handlers))
+	for jobType, registered := range w.handlers {
+		if registered.concurrency > 0 && registered.running >= registered.concurrency {
+			continue
+		}
+		jobTypes = append(jobTypes, jobType)
+	}
+	slices.Sort(jobTypes)
+	return jobTypes
+}
+
+func (w *Worker) startJob(job *domain.Job) {
+	registered := w.handlers[job.Type]
+
+	w.mu.Lock()
+	w.running++
+	registered.running++
+	w.mu.Unlock()
+
+	w.jobs.Add(1)
+	go func() {
+		defer w.jobs.Done()
+		defer func() {
+			w.mu.Lock()
+			w.running--
+			registered.running--
+			w.mu.Unlock()
+
+			// * Slot kosong, langsung claim job berikutnya tanpa menunggu poll
+			select {
+			case w.wake <- struct{}{}:
+			default:
+			}
+		}()
+
+		w.process(job, registered.handler)
+	}()
+}
+
+func (w *Worker) process(job *domain.Job, handler Handler) {
+	ctx := context.Background()
+
+	// * Lease habis di attempt terakhir (worker mati), jangan dijalankan lagi
+	if job.Attempts > job.MaxAttempts {
+		w.fail(ctx, job, "lease expired on the last attempt")
+		return
+	}
+
+	if err := w.runHandler(job, handler); err != nil {
+		w.fail(ctx, job, errorMessage(err))
+		return
+	}
+
+	if err := w.repo.CompleteJob(ctx, job.ID, w.id); err != nil {
+		log.Printf("Failed to complete job %s (%s): %v", job.ID, job.Type, err)
+	}
+}
+
+// runHandler calls the handler with JOB_TIMEOUT, panic di handler dianggap error biasa
+func (w *Worker) runHandler(job *domain.Job, handler Handler) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return handler(ctx, job)
+}
+
+// fail schedules the retry with exponential backoff, setelah JOB_MAX_ATTEMPTS job masuk dead letter
+func (w *Worker) fail(ctx context.Context, job *domain.Job, errMsg string) {
+	status := domain.JobStatusPending
+	runAt := time.Now().Add(retryDelay(job.Attempts))
+	if job.Attempts >= job.MaxAttempts {
+		status = domain.JobStatusDead
+		runAt = time.Now()
+		log.Printf("Job %s (%s) moved to dead letter after %d attempts: %s", job.ID, job.Type, job.Attempts, errMsg)
+	} else {
+		log.Printf("Job %s (%s) failed on attempt %d, retrying at %s: %s", job.ID, job.Type, job.Attempts, runAt.Format(time.RFC3339), errMsg)
+	}
+
+	if err := w.repo.FailJob(ctx, job.ID, w.id, errMsg, status, runAt); err != nil {
+		log.Printf("Failed to record job failure %s (%s): %v", job.ID, job.Type, err)
+	}
+}
+
+// purgeSucceededJobs removes succeeded jobs older than JOB_RETENTION_DAYS, 0 keeps them forever
+func (w *Worker) purgeSucceededJobs() {
+	retentionDays := utils.EnvInt("JOB_RETENTION_DAYS", defaultRetentionDays)
+	if retentionDays <= 0 {
+		return
+	}
+
+	olderThan := time.Now().AddDate(0, 0, -retentionDays)
+	purged, err := w.repo.PurgeJobs(context.Background(), domain.JobStatusSucceeded, nil, &olderThan)
+	if err != nil {
+		log.Printf("Failed to purge succeeded jobs: %v", err)
+		return
+	}
+
+	log.Printf("Purged %d succeeded jobs older than %d days", purged, retentionDays)
+}
+
+// errorMessage keeps the wrapped cause, Error() dari domain.ErrInternal hanya pesan generik
+func errorMessage(err error) string {
+	if cause := errors.Unwrap(err); cause != nil {
+		return err.Error() + ": " + cause.Error()
+	}
+	return err.Error()
+}
+
+// retryDelay doubles the wait after every failed attempt: 15s, 30s, 1m, ... sampai JOB_RETRY_MAX_DELAY
+func retryDelay(attempts int) time.Duration {
+	baseDelay := utils.EnvPositiveDuration("JOB_RETRY_BASE_DELAY", defaultRetryBaseDelay)
+	maxDelay := utils.EnvPositiveDuration("JOB_RETRY_MAX_DELAY", defaultRetryMaxDelay)
+
+	delay := baseDelay
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
@@ -3,7 +3,6 @@ package location
 import (
 	"context"
 	"log"
-	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/client/gtranslate"
@@ -21,6 +20,7 @@ type Repository interface {
 	DeleteLocation(ctx context.Context, locationId string) error
 	BulkDeleteLocations(ctx context.Context, locationIds []string) (domain.BulkDeleteLocations, error)
 	AddLocationTranslations(ctx context.Context, locationId string, translations []domain.LocationTranslation) error
+	SetUserLocations(ctx context.Context, userId string, locationIds []string) error
 
 	// * QUERY
 	GetLocationsPaginated(ctx context.Context, params domain.LocationParams, langCode string) ([]domain.Location, error)
@@ -32,6 +32,9 @@ type Repository interface {
 	CheckLocationCodeExistExcluding(ctx context.Context, locationCode string, excludeLocationId string) (bool, error)
 	CountLocations(ctx context.Context, params domain.LocationParams) (int64, error)
 	GetLocationStatistics(ctx context.Context) (domain.LocationStatistics, error)
+	GetLocationsByIds(ctx context.Context, locationIds []string) ([]domain.Location, error)
+	GetUserLocations(ctx context.Context, userId string) ([]domain.Location, error)
+	GetUserLocationIds(ctx context.Context, userId string) ([]string, error)
 }
 
 // * LocationService interface defines the contract for location business operations
@@ -42,6 +45,7 @@ type LocationService interface {
 	UpdateLocation(ctx context.Context, locationId string, payload *domain.UpdateLocationPayload, langCode string) (domain.LocationResponse, error)
 	DeleteLocation(ctx context.Context, locationId string) error
 	BulkDeleteLocations(ctx context.Context, payload *domain.BulkDeleteLocationsPayload) (domain.BulkDeleteLocationsResponse, error)
+	AssignUserLocations(ctx context.Context, userId string, payload *domain.AssignUserLocationsPayload, langCode string) (domain.UserLocationsResponse, error)
 
 	// * QUERY
 	GetLocationsPaginated(ctx context.Context, params domain.LocationParams, langCode string) ([]domain.LocationResponse, int64, error)
@@ -52,6 +56,11 @@ type LocationService interface {
 	CheckLocationCodeExists(ctx context.Context, locationCode string) (bool, error)
 	CountLocations(ctx context.Context, params domain.LocationParams) (int64, error)
 	GetLocationStatistics(ctx context.Context) (domain.LocationStatisticsResponse, error)
+	GetUserLocations(ctx context.Context, userId string, langCode string) (domain.UserLocationsResponse, error)
+	GetUserLocationIds(ctx context.Context, userId string) ([]string, error)
+
+	// * JOB HANDLERS
+	HandleTranslationJob(ctx context.Context, job *domain.Job) error
 }
 
 // * NotificationService interface for creating notifications
@@ -62,6 +71,28 @@ type NotificationService interface {
 // * UserRepository interface for getting user details
 type UserRepository interface {
 	GetUsersPaginated(ctx context.Context, params domain.UserParams) ([]domain.User, error)
+	GetUserById(ctx context.Context, userId string) (domain.User, error)
+}
+
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// * JobQueue interface for enqueueing background jobs in the same transaction as the mutation
+type JobQueue interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+}
+
+// translationJob is the payload of domain.JobTypeLocationTranslation, Updated terisi untuk translate setelah update
+type translationJob struct {
+	LocationID string                                    `json:"locationId"`
+	Created    []domain.CreateLocationTranslationPayload `json:"created,omitempty"`
+	Updated    []domain.UpdateLocationTranslationPayload `json:"updated,omitempty"`
+	Existing   []domain.LocationTranslation              `json:"existing,omitempty"`
 }
 
 type Service struct {
@@ -69,17 +100,21 @@ type Service struct {
 	NotificationService NotificationService
 	UserRepo            UserRepository
 	Translator          *gtranslate.Client
+	AuditLogService     AuditLogService
+	JobQueue            JobQueue
 }
 
 // * Ensure Service implements LocationService interface
 var _ LocationService = (*Service)(nil)
 
-func NewService(r Repository, notificationService NotificationService, userRepo UserRepository, translator *gtranslate.Client) LocationService {
+func NewService(r Repository, notificationService NotificationService, userRepo UserRepository, translator *gtranslate.Client, auditLogService AuditLogService, jobQueue JobQueue) LocationService {
 	return &Service{
 		Repo:                r,
 		NotificationService: notificationService,
 		UserRepo:            userRepo,
 		Translator:          translator,
+		AuditLogService:     auditLogService,
+		JobQueue:            jobQueue,
 	}
 }
 
@@ -110,14 +145,31 @@ func (s *Service) CreateLocation(ctx context.Context, payload *domain.CreateLoca
 		}
 	}
 
-	createdLocation, err := s.Repo.CreateLocation(ctx, &newLocation)
+	var createdLocation domain.Location
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdLocation, err = s.Repo.CreateLocation(ctx, &newLocation)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityLocation, createdLocation.ID, createdLocation); err != nil {
+			return err
+		}
+
+		// * Auto-translate missing languages in background
+		if len(payload.Translations) < 3 {
+			return s.JobQueue.Enqueue(ctx, domain.JobTypeLocationTranslation, translationJob{
+				LocationID: createdLocation.ID,
+				Created:    payload.Translations,
+			})
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.LocationResponse{}, err
 	}
 
-	// * Auto-translate missing languages in background
-	go s.autoTranslateCreateLocationAsync(createdLocation.ID, payload.Translations)
-
 	// * Convert to LocationResponse using mapper
 	return mapper.LocationToResponse(&createdLocation, mapper.DefaultLangCode), nil
 }
@@ -166,7 +218,21 @@ func (s *Service) BulkCreateLocations(ctx context.Context, payload *domain.BulkC
 		locations[i] = loc
 	}
 
-	createdLocations, err := s.Repo.BulkCreateLocations(ctx, locations)
+	var createdLocations []domain.Location
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdLocations, err = s.Repo.BulkCreateLocations(ctx, locations)
+		if err != nil {
+			return err
+		}
+
+		for _, createdLocation := range createdLocations {
+			if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityLocation, createdLocation.ID, createdLocation); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkCreateLocationsResponse{}, err
 	}
@@ -180,7 +246,7 @@ func (s *Service) BulkCreateLocations(ctx context.Context, payload *domain.BulkC
 
 func (s *Service) UpdateLocation(ctx context.Context, locationId string, payload *domain.UpdateLocationPayload, langCode string) (domain.LocationResponse, error) {
 	// * Check if location exists
-	_, err := s.Repo.GetLocationById(ctx, locationId)
+	existingLocation, err := s.Repo.GetLocationById(ctx, locationId)
 	if err != nil {
 		return domain.LocationResponse{}, err
 	}
@@ -194,16 +260,32 @@ func (s *Service) UpdateLocation(ctx context.Context, locationId string, payload
 		}
 	}
 
-	updatedLocation, err := s.Repo.UpdateLocation(ctx, locationId, payload)
+	var updatedLocation domain.Location
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedLocation, err = s.Repo.UpdateLocation(ctx, locationId, payload)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityLocation, locationId, existingLocation, updatedLocation); err != nil {
+			return err
+		}
+
+		// * Auto-translate missing languages in background if translations updated
+		if len(payload.Translations) > 0 && len(payload.Translations) < 3 {
+			return s.JobQueue.Enqueue(ctx, domain.JobTypeLocationTranslation, translationJob{
+				LocationID: locationId,
+				Updated:    payload.Translations,
+				Existing:   updatedLocation.Translations,
+			})
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.LocationResponse{}, err
 	}
 
-	// * Auto-translate missing languages in background if translations updated
-	if len(payload.Translations) > 0 {
-		go s.autoTranslateUpdateLocationAsync(locationId, payload.Translations, updatedLocation.Translations)
-	}
-
 	// * Send notification to all admin users
 	s.sendLocationUpdatedNotificationToAdmins(ctx, &updatedLocation)
 
@@ -212,11 +294,19 @@ func (s *Service) UpdateLocation(ctx context.Context, locationId string, payload
 }
 
 func (s *Service) DeleteLocation(ctx context.Context, locationId string) error {
-	err := s.Repo.DeleteLocation(ctx, locationId)
+	// * Get location data for audit trail before deletion
+	existingLocation, err := s.Repo.GetLocationById(ctx, locationId)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteLocation(ctx, locationId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityLocation, locationId, existingLocation)
+	})
 }
 
 func (s *Service) BulkDeleteLocations(ctx context.Context, payload *domain.BulkDeleteLocationsPayload) (domain.BulkDeleteLocationsResponse, error) {
@@ -226,7 +316,21 @@ func (s *Service) BulkDeleteLocations(ctx context.Context, payload *domain.BulkD
 	}
 
 	// * Perform bulk delete operation
-	result, err := s.Repo.BulkDeleteLocations(ctx, payload.IDS)
+	var result domain.BulkDeleteLocations
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.Repo.BulkDeleteLocations(ctx, payload.IDS)
+		if err != nil {
+			return err
+		}
+
+		for _, deletedId := range result.DeletedIDS {
+			if err := s.AuditLogService.RecordDelete(ctx, domain.AuditEntityLocation, deletedId, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkDeleteLocationsResponse{}, err
 	}
@@ -240,6 +344,54 @@ func (s *Service) BulkDeleteLocations(ctx context.Context, payload *domain.BulkD
 	return response, nil
 }
 
+func (s *Service) AssignUserLocations(ctx context.Context, userId string, payload *domain.AssignUserLocationsPayload, langCode string) (domain.UserLocationsResponse, error) {
+	if _, err := s.UserRepo.GetUserById(ctx, userId); err != nil {
+		return domain.UserLocationsResponse{}, err
+	}
+
+	locationIds := make([]string, 0, len(payload.LocationIDs))
+	seen := make(map[string]struct{}, len(payload.LocationIDs))
+	for _, locationId := range payload.LocationIDs {
+		if _, exists := seen[locationId]; exists {
+			continue
+		}
+		seen[locationId] = struct{}{}
+		locationIds = append(locationIds, locationId)
+	}
+
+	if len(locationIds) > 0 {
+		locations, err := s.Repo.GetLocationsByIds(ctx, locationIds)
+		if err != nil {
+			return domain.UserLocationsResponse{}, err
+		}
+		if len(locations) != len(locationIds) {
+			return domain.UserLocationsResponse{}, domain.ErrNotFoundWithKey(utils.ErrLocationNotFoundKey)
+		}
+	}
+
+	previousLocationIds, err := s.Repo.GetUserLocationIds(ctx, userId)
+	if err != nil {
+		return domain.UserLocationsResponse{}, err
+	}
+
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.SetUserLocations(ctx, userId, locationIds); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityUser, userId,
+			map[string]any{"locationIds": previousLocationIds},
+			map[string]any{"locationIds": locationIds},
+		)
+	})
+	if err != nil {
+		return domain.UserLocationsResponse{}, err
+	}
+
+	// * Scope baru berlaku setelah user login ulang atau refresh token
+	return s.GetUserLocations(ctx, userId, langCode)
+}
+
 // *===========================QUERY===========================*
 func (s *Service) GetLocationsPaginated(ctx context.Context, params domain.LocationParams, langCode string) ([]domain.LocationResponse, int64, error) {
 	locations, err := s.Repo.GetLocationsPaginated(ctx, params, langCode)
@@ -325,6 +477,26 @@ func (s *Service) GetLocationStatistics(ctx context.Context) (domain.LocationSta
 	return mapper.LocationStatisticsToResponse(&stats), nil
 }
 
+func (s *Service) GetUserLocations(ctx context.Context, userId string, langCode string) (domain.UserLocationsResponse, error) {
+	if _, err := s.UserRepo.GetUserById(ctx, userId); err != nil {
+		return domain.UserLocationsResponse{}, err
+	}
+
+	locations, err := s.Repo.GetUserLocations(ctx, userId)
+	if err != nil {
+		return domain.UserLocationsResponse{}, err
+	}
+
+	return domain.UserLocationsResponse{
+		UserID:    userId,
+		Locations: mapper.LocationsToResponses(locations, langCode),
+	}, nil
+}
+
+func (s *Service) GetUserLocationIds(ctx context.Context, userId string) ([]string, error) {
+	return s.Repo.GetUserLocationIds(ctx, userId)
+}
+
 // *===========================HELPER METHODS===========================*
 
 // sendLocationUpdatedNotificationToAdmins sends notification for location update to all admin users
@@ -411,17 +583,29 @@ func (s *Service) sendLocationUpdatedNotification(ctx context.Context, locationI
 	}
 }
 
+// *===========================JOB HANDLERS===========================*
+
+// HandleTranslationJob fills the missing location translations queued by create and update
+func (s *Service) HandleTranslationJob(ctx context.Context, job *domain.Job) error {
+	var payload translationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	if payload.Updated != nil {
+		return s.autoTranslateUpdateLocation(ctx, payload.LocationID, payload.Updated, payload.Existing)
+	}
+	return s.autoTranslateCreateLocation(ctx, payload.LocationID, payload.Created)
+}
+
 // *===========================ASYNC TRANSLATION===========================*
 
-// autoTranslateCreateLocationAsync translates location to missing languages in background
-func (s *Service) autoTranslateCreateLocationAsync(locationID string, userTranslations []domain.CreateLocationTranslationPayload) {
+// autoTranslateCreateLocation translates location to missing languages, dijalankan worker lewat HandleTranslationJob
+func (s *Service) autoTranslateCreateLocation(ctx context.Context, locationID string, userTranslations []domain.CreateLocationTranslationPayload) error {
 	if len(userTranslations) >= 3 {
-		return // All languages provided, no need to translate
+		return nil // All languages provided, no need to translate
 	}
 
-	ctx, cancel :=  context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
 	// Convert domain types to utils types
 	utilsTranslations := make([]utils.LocationCreateTranslation, len(userTranslations))
 	for i, t := range userTranslations {
@@ -435,7 +619,7 @@ func (s *Service) autoTranslateCreateLocationAsync(locationID string, userTransl
 	translatedPayloads, err := utils.AutoTranslateLocationCreate(ctx, s.Translator, utilsTranslations)
 	if err != nil {
 		log.Printf("Failed to auto-translate location ID %s: %v", locationID, err)
-		return
+		return err
 	}
 
 	// Extract only new translations
@@ -462,19 +646,18 @@ func (s *Service) autoTranslateCreateLocationAsync(locationID string, userTransl
 	if len(newTranslations) > 0 {
 		if err := s.Repo.AddLocationTranslations(ctx, locationID, newTranslations); err != nil {
 			log.Printf("Failed to save auto-translated location translations for ID %s: %v", locationID, err)
+			return err
 		}
 	}
+	return nil
 }
 
-// autoTranslateUpdateLocationAsync translates location updates to missing languages in background
-func (s *Service) autoTranslateUpdateLocationAsync(locationID string, userUpdates []domain.UpdateLocationTranslationPayload, existingTranslations []domain.LocationTranslation) {
+// autoTranslateUpdateLocation translates location updates to missing languages, dijalankan worker lewat HandleTranslationJob
+func (s *Service) autoTranslateUpdateLocation(ctx context.Context, locationID string, userUpdates []domain.UpdateLocationTranslationPayload, existingTranslations []domain.LocationTranslation) error {
 	if len(userUpdates) == 0 {
-		return // No updates provided
+		return nil // No updates provided
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
 	// Determine existing lang codes from all sources
 	existingLangCodes := make([]string, len(existingTranslations))
 	for i, t := range existingTranslations {
@@ -489,7 +672,7 @@ func (s *Service) autoTranslateUpdateLocationAsync(locationID string, userUpdate
 
 	// If user updated all 3 languages, no need to auto-translate
 	if len(updatedLangCodes) >= 3 {
-		return
+		return nil
 	}
 
 	// Convert domain types to utils types
@@ -513,7 +696,7 @@ func (s *Service) autoTranslateUpdateLocationAsync(locationID string, userUpdate
 	translatedPayloads, err := utils.AutoTranslateLocationUpdate(ctx, s.Translator, utilsUpdates, utilsExisting)
 	if err != nil {
 		log.Printf("Failed to auto-translate updated location ID %s: %v", locationID, err)
-		return
+		return err
 	}
 
 	// Extract only new translations (not in userUpdates)
@@ -558,6 +741,8 @@ func (s *Service) autoTranslateUpdateLocationAsync(locationID string, userUpdate
 	if len(newTranslations) > 0 {
 		if err := s.Repo.AddLocationTranslations(ctx, locationID, newTranslations); err != nil {
 			log.Printf("Failed to save auto-translated location update translations for ID %s: %v", locationID, err)
+			return err
 		}
 	}
+	return nil
 }
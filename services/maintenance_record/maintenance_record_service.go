@@ -52,6 +52,24 @@ type NotificationService interface {
 	CreateNotification(ctx context.Context, payload *domain.CreateNotificationPayload) (domain.NotificationResponse, error)
 }
 
+// StockItemService for consuming parts from stock
+type StockItemService interface {
+	GetStockItemById(ctx context.Context, itemId string, langCode string) (domain.StockItemResponse, error)
+	NotifyIfLowStock(ctx context.Context, itemId string, decreasedBy int)
+}
+
+// AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// WebhookService interface for emitting outbound webhook events
+type WebhookService interface {
+	Emit(ctx context.Context, eventType domain.WebhookEventType, data any) error
+}
+
 // MaintenanceRecordService business operations
 type MaintenanceRecordService interface {
 	CreateMaintenanceRecord(ctx context.Context, payload *domain.CreateMaintenanceRecordPayload, performedBy string) (domain.MaintenanceRecordResponse, error)
@@ -66,6 +84,36 @@ type MaintenanceRecordService interface {
 	CountMaintenanceRecords(ctx context.Context, params domain.MaintenanceRecordParams) (int64, error)
 	GetMaintenanceRecordStatistics(ctx context.Context) (domain.MaintenanceRecordStatisticsResponse, error)
 	ExportMaintenanceRecordList(ctx context.Context, payload domain.ExportMaintenanceRecordListPayload, params domain.MaintenanceRecordParams, langCode string) ([]byte, string, error)
+
+	// Job handlers
+	HandleTranslationJob(ctx context.Context, job *domain.Job) error
+	HandleNotificationJob(ctx context.Context, job *domain.Job) error
+}
+
+// JobQueue interface for enqueueing background jobs in the same transaction as the mutation
+type JobQueue interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+}
+
+// translationJob is the payload of domain.JobTypeMaintenanceRecordTranslation, Updated terisi untuk translate setelah update
+type translationJob struct {
+	RecordID string                                             `json:"recordId"`
+	Created  []domain.CreateMaintenanceRecordTranslationPayload `json:"created,omitempty"`
+	Updated  []domain.UpdateMaintenanceRecordTranslationPayload `json:"updated,omitempty"`
+	Existing []domain.MaintenanceRecordTranslation              `json:"existing,omitempty"`
+}
+
+const (
+	maintenanceCompletedEvent = "completed"
+	maintenanceFailedEvent    = "failed"
+)
+
+// notificationJob is the payload of domain.JobTypeMaintenanceRecordNotification, Record adalah snapshot saat mutasi
+type notificationJob struct {
+	Event         string                   `json:"event"`
+	Record        domain.MaintenanceRecord `json:"record"`
+	FailureReason string                   `json:"failureReason,omitempty"`
 }
 
 type Service struct {
@@ -74,12 +122,16 @@ type Service struct {
 	UserService         UserService
 	NotificationService NotificationService
 	Translator          *gtranslate.Client
+	AuditLogService     AuditLogService
+	StockItemService    StockItemService
+	WebhookService      WebhookService
+	JobQueue            JobQueue
 }
 
 var _ MaintenanceRecordService = (*Service)(nil)
 
-func NewService(r Repository, assetSvc AssetService, userSvc UserService, notificationSvc NotificationService, translator *gtranslate.Client) MaintenanceRecordService {
-	return &Service{Repo: r, AssetService: assetSvc, UserService: userSvc, NotificationService: notificationSvc, Translator: translator}
+func NewService(r Repository, assetSvc AssetService, userSvc UserService, notificationSvc NotificationService, translator *gtranslate.Client, auditLogSvc AuditLogService, stockItemSvc StockItemService, webhookSvc WebhookService, jobQueue JobQueue) MaintenanceRecordService {
+	return &Service{Repo: r, AssetService: assetSvc, UserService: userSvc, NotificationService: notificationSvc, Translator: translator, AuditLogService: auditLogSvc, StockItemService: stockItemSvc, WebhookService: webhookSvc, JobQueue: jobQueue}
 }
 
 func (s *Service) CreateMaintenanceRecord(ctx context.Context, payload *domain.CreateMaintenanceRecordPayload, performedBy string) (domain.MaintenanceRecordResponse, error) {
@@ -123,6 +175,20 @@ func (s *Service) CreateMaintenanceRecord(ctx context.Context, payload *domain.C
 		completionDate = &parsed
 	}
 
+	// Validate consumed parts, their cost is rolled into the actual cost
+	stockUsages, partsCost, err := s.buildStockUsages(ctx, payload.StockUsages, performedBy)
+	if err != nil {
+		return domain.MaintenanceRecordResponse{}, err
+	}
+	actualCost := payload.ActualCost
+	if partsCost > 0 {
+		totalCost := partsCost
+		if actualCost != nil {
+			totalCost += *actualCost
+		}
+		actualCost = &totalCost
+	}
+
 	// Build domain entity
 	record := domain.MaintenanceRecord{
 		ScheduleID:        payload.ScheduleID,
@@ -133,8 +199,9 @@ func (s *Service) CreateMaintenanceRecord(ctx context.Context, payload *domain.C
 		PerformedByUser:   performerPtr,
 		PerformedByVendor: payload.PerformedByVendor,
 		Result:            payload.Result,
-		ActualCost:        payload.ActualCost,
+		ActualCost:        actualCost,
 		Translations:      make([]domain.MaintenanceRecordTranslation, len(payload.Translations)),
+		StockUsages:       stockUsages,
 	}
 	for i, t := range payload.Translations {
 		record.Translations[i] = domain.MaintenanceRecordTranslation{
@@ -144,20 +211,122 @@ func (s *Service) CreateMaintenanceRecord(ctx context.Context, payload *domain.C
 		}
 	}
 
-	created, err := s.Repo.CreateRecord(ctx, &record)
+	var created domain.MaintenanceRecord
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.Repo.CreateRecord(ctx, &record)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityMaintenanceRecord, created.ID, created); err != nil {
+			return err
+		}
+
+		// * Auto-translate missing languages in background
+		if len(payload.Translations) < 3 {
+			err = s.JobQueue.Enqueue(ctx, domain.JobTypeMaintenanceRecordTranslation, translationJob{
+				RecordID: created.ID,
+				Created:  payload.Translations,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Send notification for completed maintenance
+		err = s.JobQueue.Enqueue(ctx, domain.JobTypeMaintenanceRecordNotification, notificationJob{
+			Event:  maintenanceCompletedEvent,
+			Record: created,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.WebhookService.Emit(ctx, domain.WebhookEventMaintenanceCompleted, mapper.MaintenanceRecordToResponse(&created, mapper.DefaultLangCode))
+	})
 	if err != nil {
 		return domain.MaintenanceRecordResponse{}, err
 	}
 
-	// * Auto-translate missing languages in background
-	go s.autoTranslateCreateMaintenanceRecordAsync(created.ID, payload.Translations)
-
-	// Send notification for completed maintenance
-	s.sendMaintenanceCompletedNotification(ctx, &created)
+	// Consumed parts may have pushed items below their reorder point
+	consumedByItem := make(map[string]int)
+	for _, usage := range stockUsages {
+		consumedByItem[usage.ItemID] += usage.Quantity
+	}
+	for itemId, consumed := range consumedByItem {
+		s.StockItemService.NotifyIfLowStock(ctx, itemId, consumed)
+	}
 
 	return mapper.MaintenanceRecordToResponse(&created, mapper.DefaultLangCode), nil
 }
 
+// buildStockUsages validates the consumed parts against current stock and returns their total cost
+func (s *Service) buildStockUsages(ctx context.Context, usages []domain.MaintenanceStockUsagePayload, performedBy string) ([]domain.StockTransaction, float64, error) {
+	if len(usages) == 0 {
+		return nil, 0, nil
+	}
+
+	var performedByPtr *string
+	if performedBy != "" {
+		performedByPtr = &performedBy
+	}
+
+	stockUsages := make([]domain.StockTransaction, len(usages))
+	requested := make(map[string]int)
+	items := make(map[string]domain.StockItemResponse)
+	partsCost := 0.0
+
+	for i, usage := range usages {
+		item, ok := items[usage.StockItemID]
+		if !ok {
+			fetched, err := s.StockItemService.GetStockItemById(ctx, usage.StockItemID, mapper.DefaultLangCode)
+			if err != nil {
+				return nil, 0, err
+			}
+			if !fetched.IsActive {
+				return nil, 0, domain.ErrBadRequestWithKey(utils.ErrStockItemInactiveKey)
+			}
+			items[usage.StockItemID] = fetched
+			item = fetched
+		}
+
+		// Same item and location may be listed more than once, check the combined quantity
+		levelKey := usage.StockItemID + ":" + usage.LocationID
+		requested[levelKey] += usage.Quantity
+		available := 0
+		for _, level := range item.Levels {
+			if level.LocationID == usage.LocationID {
+				available = level.Quantity
+				break
+			}
+		}
+		if available < requested[levelKey] {
+			return nil, 0, domain.ErrBadRequestWithKey(utils.ErrStockInsufficientKey)
+		}
+
+		var unitCost *float64
+		if item.UnitCost != nil {
+			if value, valid := item.UnitCost.Float64(); valid {
+				unitCost = &value
+				partsCost += value * float64(usage.Quantity)
+			}
+		}
+
+		locationId := usage.LocationID
+		stockUsages[i] = domain.StockTransaction{
+			ItemID:         usage.StockItemID,
+			Type:           domain.StockTransactionIssue,
+			Quantity:       usage.Quantity,
+			FromLocationID: &locationId,
+			UnitCost:       unitCost,
+			PerformedBy:    performedByPtr,
+		}
+	}
+
+	return stockUsages, partsCost, nil
+}
+
 func (s *Service) UpdateMaintenanceRecord(ctx context.Context, recordId string, payload *domain.UpdateMaintenanceRecordPayload, langCode string) (domain.MaintenanceRecordResponse, error) {
 	// Ensure record exists
 	if exists, err := s.Repo.CheckRecordExist(ctx, recordId); err != nil {
@@ -175,16 +344,12 @@ func (s *Service) UpdateMaintenanceRecord(ctx context.Context, recordId string,
 		}
 	}
 
-	updated, err := s.Repo.UpdateRecord(ctx, recordId, payload)
+	// Keep previous state for audit trail
+	existing, err := s.Repo.GetRecordById(ctx, recordId)
 	if err != nil {
 		return domain.MaintenanceRecordResponse{}, err
 	}
 
-	// * Auto-translate missing languages in background if translations updated
-	if len(payload.Translations) > 0 {
-		go s.autoTranslateUpdateMaintenanceRecordAsync(recordId, payload.Translations, updated.Translations)
-	}
-
 	// Check if this update indicates a failed maintenance (e.g., notes contain "failed")
 	failureReason := ""
 	for _, t := range payload.Translations {
@@ -193,15 +358,69 @@ func (s *Service) UpdateMaintenanceRecord(ctx context.Context, recordId string,
 			break
 		}
 	}
-	if failureReason != "" {
-		s.sendMaintenanceFailedNotification(ctx, &updated, failureReason)
+
+	var updated domain.MaintenanceRecord
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = s.Repo.UpdateRecord(ctx, recordId, payload)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityMaintenanceRecord, recordId, existing, updated); err != nil {
+			return err
+		}
+
+		// * Auto-translate missing languages in background if translations updated
+		if len(payload.Translations) > 0 && len(payload.Translations) < 3 {
+			err = s.JobQueue.Enqueue(ctx, domain.JobTypeMaintenanceRecordTranslation, translationJob{
+				RecordID: recordId,
+				Updated:  payload.Translations,
+				Existing: updated.Translations,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if failureReason != "" {
+			err = s.JobQueue.Enqueue(ctx, domain.JobTypeMaintenanceRecordNotification, notificationJob{
+				Event:         maintenanceFailedEvent,
+				Record:        updated,
+				FailureReason: failureReason,
+			})
+			if err != nil {
+				return err
+			}
+
+			return s.WebhookService.Emit(ctx, domain.WebhookEventMaintenanceFailed, domain.WebhookMaintenanceFailedData{
+				MaintenanceRecord: mapper.MaintenanceRecordToResponse(&updated, mapper.DefaultLangCode),
+				Reason:            failureReason,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.MaintenanceRecordResponse{}, err
 	}
 
 	return mapper.MaintenanceRecordToResponse(&updated, langCode), nil
 }
 
 func (s *Service) DeleteMaintenanceRecord(ctx context.Context, recordId string) error {
-	return s.Repo.DeleteRecord(ctx, recordId)
+	// Keep previous state for audit trail
+	existing, err := s.Repo.GetRecordById(ctx, recordId)
+	if err != nil {
+		return err
+	}
+
+	return s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteRecord(ctx, recordId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityMaintenanceRecord, recordId, existing)
+	})
 }
 
 func (s *Service) BulkCreateMaintenanceRecords(ctx context.Context, payload *domain.BulkCreateMaintenanceRecordsPayload, performedBy string) (domain.BulkCreateMaintenanceRecordsResponse, error) {
@@ -209,6 +428,13 @@ func (s *Service) BulkCreateMaintenanceRecords(ctx context.Context, payload *dom
 		return domain.BulkCreateMaintenanceRecordsResponse{}, domain.ErrBadRequest("maintenance records payload is required")
 	}
 
+	// * Stock consumption needs per-record validation, use the single create endpoint instead
+	for _, item := range payload.MaintenanceRecords {
+		if len(item.StockUsages) > 0 {
+			return domain.BulkCreateMaintenanceRecordsResponse{}, domain.ErrBadRequestWithKey(utils.ErrStockUsageBulkNotSupportedKey)
+		}
+	}
+
 	// * Validate all assets exist
 	assetMap := make(map[string]struct{})
 	for _, item := range payload.MaintenanceRecords {
@@ -280,16 +506,36 @@ func (s *Service) BulkCreateMaintenanceRecords(ctx context.Context, payload *dom
 	}
 
 	// * Call repository bulk create
-	created, err := s.Repo.BulkCreateRecords(ctx, records)
+	var created []domain.MaintenanceRecord
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.Repo.BulkCreateRecords(ctx, records)
+		if err != nil {
+			return err
+		}
+
+		// * Send notifications asynchronously
+		for i := range created {
+			if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityMaintenanceRecord, created[i].ID, created[i]); err != nil {
+				return err
+			}
+			err := s.JobQueue.Enqueue(ctx, domain.JobTypeMaintenanceRecordNotification, notificationJob{
+				Event:  maintenanceCompletedEvent,
+				Record: created[i],
+			})
+			if err != nil {
+				return err
+			}
+			if err := s.WebhookService.Emit(ctx, domain.WebhookEventMaintenanceCompleted, mapper.MaintenanceRecordToResponse(&created[i], mapper.DefaultLangCode)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkCreateMaintenanceRecordsResponse{}, err
 	}
 
-	// * Send notifications asynchronously
-	for i := range created {
-		go s.sendMaintenanceCompletedNotification(ctx, &created[i])
-	}
-
 	// * Convert to responses
 	response := domain.BulkCreateMaintenanceRecordsResponse{
 		MaintenanceRecords: mapper.MaintenanceRecordsToResponses(created, mapper.DefaultLangCode),
@@ -304,7 +550,21 @@ func (s *Service) BulkDeleteMaintenanceRecords(ctx context.Context, payload *dom
 	}
 
 	// * Perform bulk delete operation
-	result, err := s.Repo.BulkDeleteRecords(ctx, payload.IDS)
+	var result domain.BulkDeleteMaintenanceRecords
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.Repo.BulkDeleteRecords(ctx, payload.IDS)
+		if err != nil {
+			return err
+		}
+
+		for _, deletedId := range result.DeletedIDS {
+			if err := s.AuditLogService.RecordDelete(ctx, domain.AuditEntityMaintenanceRecord, deletedId, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkDeleteMaintenanceRecordsResponse{}, err
 	}
@@ -470,17 +730,47 @@ func (s *Service) sendMaintenanceFailedNotification(ctx context.Context, record
 	}
 }
 
+// *===========================JOB HANDLERS===========================*
+
+// HandleTranslationJob fills the missing maintenance record translations queued by create and update
+func (s *Service) HandleTranslationJob(ctx context.Context, job *domain.Job) error {
+	var payload translationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	if payload.Updated != nil {
+		return s.autoTranslateUpdateMaintenanceRecord(ctx, payload.RecordID, payload.Updated, payload.Existing)
+	}
+	return s.autoTranslateCreateMaintenanceRecord(ctx, payload.RecordID, payload.Created)
+}
+
+// HandleNotificationJob sends the maintenance completed/failed notifications queued by the mutations
+func (s *Service) HandleNotificationJob(ctx context.Context, job *domain.Job) error {
+	var payload notificationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	switch payload.Event {
+	case maintenanceCompletedEvent:
+		s.sendMaintenanceCompletedNotification(ctx, &payload.Record)
+	case maintenanceFailedEvent:
+		s.sendMaintenanceFailedNotification(ctx, &payload.Record, payload.FailureReason)
+	default:
+		log.Printf("Unknown maintenance record notification event %q in job %s", payload.Event, job.ID)
+	}
+	return nil
+}
+
 // *===========================ASYNC TRANSLATION===========================*
 
-// autoTranslateCreateMaintenanceRecordAsync translates maintenance record to missing languages in background
-func (s *Service) autoTranslateCreateMaintenanceRecordAsync(recordID string, userTranslations []domain.CreateMaintenanceRecordTranslationPayload) {
+// autoTranslateCreateMaintenanceRecord translates maintenance record to missing languages, dijalankan worker lewat HandleTranslationJob
+func (s *Service) autoTranslateCreateMaintenanceRecord(ctx context.Context, recordID string, userTranslations []domain.CreateMaintenanceRecordTranslationPayload) error {
 	if len(userTranslations) >= 3 {
-		return // All languages provided, no need to translate
+		return nil // All languages provided, no need to translate
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
 	// Convert domain types to utils types
 	utilsTranslations := make([]utils.MaintenanceRecordCreateTranslation, len(userTranslations))
 	for i, t := range userTranslations {
@@ -495,7 +785,7 @@ func (s *Service) autoTranslateCreateMaintenanceRecordAsync(recordID string, use
 	translatedPayloads, err := utils.AutoTranslateMaintenanceRecordCreate(ctx, s.Translator, utilsTranslations)
 	if err != nil {
 		log.Printf("Failed to auto-translate maintenance record ID %s: %v", recordID, err)
-		return
+		return err
 	}
 
 	// Extract only new translations
@@ -523,19 +813,18 @@ func (s *Service) autoTranslateCreateMaintenanceRecordAsync(recordID string, use
 	if len(newTranslations) > 0 {
 		if err := s.Repo.AddMaintenanceRecordTranslations(ctx, recordID, newTranslations); err != nil {
 			log.Printf("Failed to save auto-translated maintenance record translations for ID %s: %v", recordID, err)
+			return err
 		}
 	}
+	return nil
 }
 
-// autoTranslateUpdateMaintenanceRecordAsync translates maintenance record updates to missing languages in background
-func (s *Service) autoTranslateUpdateMaintenanceRecordAsync(recordID string, userUpdates []domain.UpdateMaintenanceRecordTranslationPayload, existingTranslations []domain.MaintenanceRecordTranslation) {
+// autoTranslateUpdateMaintenanceRecord translates maintenance record updates to missing languages, dijalankan worker lewat HandleTranslationJob
+func (s *Service) autoTranslateUpdateMaintenanceRecord(ctx context.Context, recordID string, userUpdates []domain.UpdateMaintenanceRecordTranslationPayload, existingTranslations []domain.MaintenanceRecordTranslation) error {
 	if len(userUpdates) == 0 {
-		return // No updates provided
+		return nil // No updates provided
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
 	// If user updated all 3 languages, no need to auto-translate
 	updatedLangCodes := make([]string, len(userUpdates))
 	for i, t := range userUpdates {
@@ -543,7 +832,7 @@ func (s *Service) autoTranslateUpdateMaintenanceRecordAsync(recordID string, use
 	}
 
 	if len(updatedLangCodes) >= 3 {
-		return
+		return nil
 	}
 
 	// Convert domain types to utils types
@@ -569,7 +858,7 @@ func (s *Service) autoTranslateUpdateMaintenanceRecordAsync(recordID string, use
 	translatedPayloads, err := utils.AutoTranslateMaintenanceRecordUpdate(ctx, s.Translator, utilsUpdates, utilsExisting)
 	if err != nil {
 		log.Printf("Failed to auto-translate updated maintenance record ID %s: %v", recordID, err)
-		return
+		return err
 	}
 
 	// Extract only new translations (not in userUpdates)
@@ -622,6 +911,8 @@ func (s *Service) autoTranslateUpdateMaintenanceRecordAsync(recordID string, use
 	if len(newTranslations) > 0 {
 		if err := s.Repo.AddMaintenanceRecordTranslations(ctx, recordID, newTranslations); err != nil {
 			log.Printf("Failed to save auto-translated maintenance record update translations for ID %s: %v", recordID, err)
+			return err
 		}
 	}
+	return nil
 }
@@ -3,6 +3,7 @@ package maintenance_schedule
 import (
 	"context"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
@@ -11,43 +12,70 @@ import (
 	"github.com/robfig/cron/v3"
 )
 
+// RecordRepository defines the maintenance record operations used by scheduled tasks
+type RecordRepository interface {
+	CreateRecord(ctx context.Context, payload *domain.MaintenanceRecord) (domain.MaintenanceRecord, error)
+}
+
+// WorkOrderRepository defines the work order operations used by scheduled tasks
+type WorkOrderRepository interface {
+	CreateWorkOrder(ctx context.Context, payload *domain.WorkOrder) (domain.WorkOrder, error)
+}
+
+// JobRunner exposes on-demand execution of the maintenance cron jobs
+type JobRunner interface {
+	RunJob(ctx context.Context, job domain.MaintenanceJob) (domain.MaintenanceJobRunResponse, error)
+}
+
 // CronService manages scheduled tasks for maintenance schedules
 type CronService struct {
 	cron                *cron.Cron
 	repo                Repository
+	recordRepo          RecordRepository
+	workOrderRepo       WorkOrderRepository
 	assetService        AssetService
 	notificationService NotificationService
+
+	// running guards against the same job being executed concurrently by cron and an on-demand run
+	mu      sync.Mutex
+	running map[domain.MaintenanceJob]bool
 }
 
+// * Ensure CronService implements JobRunner interface
+var _ JobRunner = (*CronService)(nil)
+
 // NewCronService creates a new cron service instance
-func NewCronService(repo Repository, assetService AssetService, notificationService NotificationService) *CronService {
+func NewCronService(repo Repository, recordRepo RecordRepository, workOrderRepo WorkOrderRepository, assetService AssetService, notificationService NotificationService) *CronService {
 	// Create cron instance with seconds field support
 	c := cron.New(cron.WithSeconds())
 
 	return &CronService{
 		cron:                c,
 		repo:                repo,
+		recordRepo:          recordRepo,
+		workOrderRepo:       workOrderRepo,
 		assetService:        assetService,
 		notificationService: notificationService,
+		running:             make(map[domain.MaintenanceJob]bool),
 	}
 }
 
 // Start begins all scheduled cron jobs
 func (cs *CronService) Start() error {
 	// Check maintenance due soon daily at 9:00 AM
-	_, err := cs.cron.AddFunc("0 0 9 * * *", cs.checkMaintenanceDueSoon)
+	_, err := cs.cron.AddFunc("0 0 9 * * *", func() { cs.runScheduledJob(domain.MaintenanceJobDueSoon) })
 	if err != nil {
 		return err
 	}
 
 	// Check overdue maintenance daily at 9:30 AM
-	_, err = cs.cron.AddFunc("0 30 9 * * *", cs.checkOverdueMaintenance)
+	_, err = cs.cron.AddFunc("0 30 9 * * *", func() { cs.runScheduledJob(domain.MaintenanceJobOverdue) })
 	if err != nil {
 		return err
 	}
 
-	// Update recurring schedules daily at 10:00 AM
-	_, err = cs.cron.AddFunc("0 0 10 * * *", cs.updateRecurringSchedules)
+	// Process reached schedules daily at 10:00 AM
+	_, err = cs.cron.AddFunc("0 0 10 * * *", func() { cs.runScheduledJob(domain.MaintenanceJobProcessSchedules) })
 	if err != nil {
 		return err
 	}
@@ -64,16 +92,60 @@ func (cs *CronService) Stop() {
 	log.Println("Maintenance schedule cron service stopped")
 }
 
+// RunJob executes a single run of the given job immediately and reports what it did
+func (cs *CronService) RunJob(ctx context.Context, job domain.MaintenanceJob) (domain.MaintenanceJobRunResponse, error) {
+	var run func(ctx context.Context, result *domain.MaintenanceJobRunResponse) error
+	switch job {
+	case domain.MaintenanceJobDueSoon:
+		run = cs.checkMaintenanceDueSoon
+	case domain.MaintenanceJobOverdue:
+		run = cs.checkOverdueMaintenance
+	case domain.MaintenanceJobProcessSchedules:
+		run = cs.processReachedSchedules
+	default:
+		return domain.MaintenanceJobRunResponse{}, domain.ErrBadRequestWithKey(utils.ErrMaintenanceJobInvalidKey)
+	}
+
+	cs.mu.Lock()
+	if cs.running[job] {
+		cs.mu.Unlock()
+		return domain.MaintenanceJobRunResponse{}, domain.ErrConflictWithKey(utils.ErrMaintenanceJobAlreadyRunningKey)
+	}
+	cs.running[job] = true
+	cs.mu.Unlock()
+
+	defer func() {
+		cs.mu.Lock()
+		delete(cs.running, job)
+		cs.mu.Unlock()
+	}()
+
+	result := domain.MaintenanceJobRunResponse{
+		Job:       job,
+		StartedAt: time.Now(),
+	}
+	err := run(ctx, &result)
+	result.FinishedAt = time.Now()
+
+	return result, err
+}
+
+// runScheduledJob is the cron entry point, errors are only logged since there is no caller to report to
+func (cs *CronService) runScheduledJob(job domain.MaintenanceJob) {
+	if _, err := cs.RunJob(context.Background(), job); err != nil {
+		log.Printf("Maintenance job %s failed: %v", job, err)
+	}
+}
+
 // checkMaintenanceDueSoon checks for maintenance schedules due within 7 days
-func (cs *CronService) checkMaintenanceDueSoon() {
-	ctx := context.Background()
+func (cs *CronService) checkMaintenanceDueSoon(ctx context.Context, result *domain.MaintenanceJobRunResponse) error {
 	log.Println("Running maintenance due soon check...")
 
 	// Get schedules due within 7 days
 	schedules, err := cs.repo.GetSchedulesDueSoon(ctx, 7)
 	if err != nil {
 		log.Printf("Failed to fetch maintenance schedules due soon: %v", err)
-		return
+		return err
 	}
 
 	// Send notification asynchronously for each schedule
@@ -81,20 +153,21 @@ func (cs *CronService) checkMaintenanceDueSoon() {
 		scheduleCopy := schedule // Avoid closure issue
 		go cs.sendMaintenanceDueSoonNotification(context.Background(), &scheduleCopy)
 	}
+	result.Processed = len(schedules)
 
 	log.Printf("Maintenance due soon check completed. Found %d schedules due within 7 days", len(schedules))
+	return nil
 }
 
 // checkOverdueMaintenance checks for overdue maintenance schedules
-func (cs *CronService) checkOverdueMaintenance() {
-	ctx := context.Background()
+func (cs *CronService) checkOverdueMaintenance(ctx context.Context, result *domain.MaintenanceJobRunResponse) error {
 	log.Println("Running overdue maintenance check...")
 
 	// Get overdue schedules
 	schedules, err := cs.repo.GetOverdueSchedules(ctx)
 	if err != nil {
 		log.Printf("Failed to fetch overdue maintenance schedules: %v", err)
-		return
+		return err
 	}
 
 	// Send notification asynchronously for each schedule
@@ -102,8 +175,10 @@ func (cs *CronService) checkOverdueMaintenance() {
 		scheduleCopy := schedule // Avoid closure issue
 		go cs.sendMaintenanceOverdueNotification(context.Background(), &scheduleCopy)
 	}
+	result.Processed = len(schedules)
 
 	log.Printf("Overdue maintenance check completed. Found %d overdue schedules", len(schedules))
+	return nil
 }
 
 // sendMaintenanceDueSoonNotification sends notification for maintenance due soon
@@ -208,63 +283,135 @@ func (cs *CronService) sendMaintenanceOverdueNotification(ctx context.Context, s
 	}
 }
 
-// updateRecurringSchedules updates next_scheduled_date for completed/passed recurring schedules
-func (cs *CronService) updateRecurringSchedules() {
-	ctx := context.Background()
-	log.Println("Running recurring schedules update...")
+// processReachedSchedules executes schedules whose next_scheduled_date has passed.
+// Auto-complete schedules get a maintenance record right away, the rest get an open work order
+// that a technician completes later. Recurring schedules are then moved to their next occurrence.
+func (cs *CronService) processReachedSchedules(ctx context.Context, result *domain.MaintenanceJobRunResponse) error {
+	log.Println("Running reached schedules processing...")
 
-	// Get recurring schedules that need updating (past next_scheduled_date and is_recurring = true)
-	schedules, err := cs.repo.GetRecurringSchedulesToUpdate(ctx)
+	schedules, err := cs.repo.GetReachedSchedules(ctx)
 	if err != nil {
-		log.Printf("Failed to fetch recurring schedules for update: %v", err)
-		return
+		log.Printf("Failed to fetch reached maintenance schedules: %v", err)
+		return err
 	}
 
-	updated := 0
 	for _, schedule := range schedules {
-		if schedule.IntervalValue == nil || schedule.IntervalUnit == nil {
+		result.Processed++
+
+		if schedule.IsRecurring && (schedule.IntervalValue == nil || schedule.IntervalUnit == nil) {
 			log.Printf("Schedule ID %s is recurring but missing interval, skipping", schedule.ID)
+			result.Failed++
 			continue
 		}
 
-		// Calculate next scheduled date based on interval
-		nextDate := calculateNextScheduledDate(schedule.NextScheduledDate, *schedule.IntervalValue, *schedule.IntervalUnit)
-
-		// Update the schedule
-		updatePayload := &domain.UpdateMaintenanceSchedulePayload{
-			NextScheduledDate: utils.StringPtr(nextDate.Format("2006-01-02")),
+		if schedule.AutoComplete {
+			if err := cs.autoCompleteSchedule(ctx, &schedule); err != nil {
+				log.Printf("Failed to auto-complete schedule ID %s: %v", schedule.ID, err)
+				result.Failed++
+				continue
+			}
+			result.RecordsCreated++
+		} else {
+			if err := cs.createScheduleWorkOrder(ctx, &schedule); err != nil {
+				log.Printf("Failed to create work order for schedule ID %s: %v", schedule.ID, err)
+				result.Failed++
+				continue
+			}
+			result.WorkOrdersCreated++
 		}
+	}
 
-		// Reset last_executed_date to current next_scheduled_date
-		lastExecuted := schedule.NextScheduledDate
-		updatePayload.NextScheduledDate = utils.StringPtr(nextDate.Format("2006-01-02"))
+	log.Printf("Reached schedules processing completed. Records created: %d, work orders created: %d, failed: %d",
+		result.RecordsCreated, result.WorkOrdersCreated, result.Failed)
+	return nil
+}
 
-		_, err := cs.repo.UpdateSchedule(ctx, schedule.ID, updatePayload)
-		if err != nil {
-			log.Printf("Failed to update recurring schedule ID %s: %v", schedule.ID, err)
-			continue
+// autoCompleteSchedule records the maintenance as performed and marks the occurrence as executed
+func (cs *CronService) autoCompleteSchedule(ctx context.Context, schedule *domain.MaintenanceSchedule) error {
+	occurrence := schedule.NextScheduledDate
+
+	record := &domain.MaintenanceRecord{
+		ScheduleID:      &schedule.ID,
+		AssetID:         schedule.AssetID,
+		MaintenanceDate: occurrence,
+		CompletionDate:  &occurrence,
+		Result:          domain.ResultSuccess,
+		Translations:    make([]domain.MaintenanceRecordTranslation, len(schedule.Translations)),
+	}
+	for i, t := range schedule.Translations {
+		record.Translations[i] = domain.MaintenanceRecordTranslation{
+			LangCode: t.LangCode,
+			Title:    t.Title,
+			Notes:    t.Description,
 		}
+	}
+
+	if _, err := cs.recordRepo.CreateRecord(ctx, record); err != nil {
+		return err
+	}
 
-		// Update last_executed_date using raw update
-		if err := cs.repo.UpdateLastExecutedDate(ctx, schedule.ID, &lastExecuted); err != nil {
-			log.Printf("Failed to update last_executed_date for schedule ID %s: %v", schedule.ID, err)
+	if err := cs.repo.UpdateLastExecutedDate(ctx, schedule.ID, &occurrence); err != nil {
+		return err
+	}
+
+	if !schedule.IsRecurring {
+		completePayload := &domain.UpdateMaintenanceSchedulePayload{
+			State: utils.Ptr(domain.StateCompleted),
 		}
+		_, err := cs.repo.UpdateSchedule(ctx, schedule.ID, completePayload)
+		return err
+	}
 
-		updated++
-		log.Printf("Updated recurring schedule ID %s: next date %s -> %s", schedule.ID, schedule.NextScheduledDate.Format("2006-01-02"), nextDate.Format("2006-01-02"))
+	return cs.advanceSchedule(ctx, schedule)
+}
 
-		// Check if auto-complete is enabled for non-recurring after first execution
-		if schedule.AutoComplete && !schedule.IsRecurring {
-			completePayload := &domain.UpdateMaintenanceSchedulePayload{
-				State: utils.Ptr(domain.StateCompleted),
-			}
-			if _, err := cs.repo.UpdateSchedule(ctx, schedule.ID, completePayload); err != nil {
-				log.Printf("Failed to auto-complete schedule ID %s: %v", schedule.ID, err)
-			}
+// createScheduleWorkOrder opens a work order for the current occurrence. One-off schedules stay
+// active until the work order is completed, recurring schedules move on to their next occurrence.
+func (cs *CronService) createScheduleWorkOrder(ctx context.Context, schedule *domain.MaintenanceSchedule) error {
+	workOrder := &domain.WorkOrder{
+		ScheduleID:   &schedule.ID,
+		AssetID:      schedule.AssetID,
+		Status:       domain.WorkOrderStatusOpen,
+		DueDate:      schedule.NextScheduledDate,
+		Translations: make([]domain.WorkOrderTranslation, len(schedule.Translations)),
+	}
+	for i, t := range schedule.Translations {
+		workOrder.Translations[i] = domain.WorkOrderTranslation{
+			LangCode:    t.LangCode,
+			Title:       t.Title,
+			Description: t.Description,
 		}
 	}
 
-	log.Printf("Recurring schedules update completed. Updated %d schedules", updated)
+	if _, err := cs.workOrderRepo.CreateWorkOrder(ctx, workOrder); err != nil {
+		return err
+	}
+
+	if !schedule.IsRecurring {
+		return nil
+	}
+
+	return cs.advanceSchedule(ctx, schedule)
+}
+
+// advanceSchedule moves a recurring schedule to its next occurrence in the future.
+// Occurrences missed while the job was not running are skipped instead of replayed.
+func (cs *CronService) advanceSchedule(ctx context.Context, schedule *domain.MaintenanceSchedule) error {
+	now := time.Now().UTC()
+	nextDate := calculateNextScheduledDate(schedule.NextScheduledDate, *schedule.IntervalValue, *schedule.IntervalUnit)
+	for !nextDate.After(now) {
+		nextDate = calculateNextScheduledDate(nextDate, *schedule.IntervalValue, *schedule.IntervalUnit)
+	}
+
+	updatePayload := &domain.UpdateMaintenanceSchedulePayload{
+		NextScheduledDate: utils.StringPtr(nextDate.Format("2006-01-02")),
+	}
+	if _, err := cs.repo.UpdateSchedule(ctx, schedule.ID, updatePayload); err != nil {
+		return err
+	}
+
+	log.Printf("Advanced recurring schedule ID %s: next date %s -> %s", schedule.ID, schedule.NextScheduledDate.Format("2006-01-02"), nextDate.Format("2006-01-02"))
+	return nil
 }
 
 // calculateNextScheduledDate calculates the next scheduled date based on interval
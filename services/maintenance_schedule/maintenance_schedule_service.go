@@ -38,7 +38,7 @@ type Repository interface {
 	// Cron-related queries
 	GetSchedulesDueSoon(ctx context.Context, daysFromNow int) ([]domain.MaintenanceSchedule, error)
 	GetOverdueSchedules(ctx context.Context) ([]domain.MaintenanceSchedule, error)
-	GetRecurringSchedulesToUpdate(ctx context.Context) ([]domain.MaintenanceSchedule, error)
+	GetReachedSchedules(ctx context.Context) ([]domain.MaintenanceSchedule, error)
 	UpdateLastExecutedDate(ctx context.Context, scheduleId string, lastExecutedDate *time.Time) error
 }
 
@@ -59,6 +59,18 @@ type NotificationService interface {
 	CreateNotification(ctx context.Context, payload *domain.CreateNotificationPayload) (domain.NotificationResponse, error)
 }
 
+// AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// WebhookService interface for emitting outbound webhook events
+type WebhookService interface {
+	Emit(ctx context.Context, eventType domain.WebhookEventType, data any) error
+}
+
 // MaintenanceScheduleService business operations
 type MaintenanceScheduleService interface {
 	CreateMaintenanceSchedule(ctx context.Context, payload *domain.CreateMaintenanceSchedulePayload, createdBy string) (domain.MaintenanceScheduleResponse, error)
@@ -73,6 +85,29 @@ type MaintenanceScheduleService interface {
 	CountMaintenanceSchedules(ctx context.Context, params domain.MaintenanceScheduleParams) (int64, error)
 	GetMaintenanceScheduleStatistics(ctx context.Context) (domain.MaintenanceScheduleStatisticsResponse, error)
 	ExportMaintenanceScheduleList(ctx context.Context, payload domain.ExportMaintenanceScheduleListPayload, params domain.MaintenanceScheduleParams, langCode string) ([]byte, string, error)
+
+	// Job handlers
+	HandleTranslationJob(ctx context.Context, job *domain.Job) error
+	HandleNotificationJob(ctx context.Context, job *domain.Job) error
+}
+
+// JobQueue interface for enqueueing background jobs in the same transaction as the mutation
+type JobQueue interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+}
+
+// translationJob is the payload of domain.JobTypeMaintenanceScheduleTranslation, Updated terisi untuk translate setelah update
+type translationJob struct {
+	ScheduleID string                                               `json:"scheduleId"`
+	Created    []domain.CreateMaintenanceScheduleTranslationPayload `json:"created,omitempty"`
+	Updated    []domain.UpdateMaintenanceScheduleTranslationPayload `json:"updated,omitempty"`
+	Existing   []domain.MaintenanceScheduleTranslation              `json:"existing,omitempty"`
+}
+
+// notificationJob is the payload of domain.JobTypeMaintenanceScheduleNotification, Schedule adalah snapshot saat dibuat
+type notificationJob struct {
+	Schedule domain.MaintenanceSchedule `json:"schedule"`
 }
 
 type Service struct {
@@ -81,12 +116,15 @@ type Service struct {
 	UserService         UserService
 	NotificationService NotificationService
 	Translator          *gtranslate.Client
+	AuditLogService     AuditLogService
+	WebhookService      WebhookService
+	JobQueue            JobQueue
 }
 
 var _ MaintenanceScheduleService = (*Service)(nil)
 
-func NewService(r Repository, assetSvc AssetService, userSvc UserService, notificationSvc NotificationService, translator *gtranslate.Client) MaintenanceScheduleService {
-	return &Service{Repo: r, AssetService: assetSvc, UserService: userSvc, NotificationService: notificationSvc, Translator: translator}
+func NewService(r Repository, assetSvc AssetService, userSvc UserService, notificationSvc NotificationService, translator *gtranslate.Client, auditLogSvc AuditLogService, webhookSvc WebhookService, jobQueue JobQueue) MaintenanceScheduleService {
+	return &Service{Repo: r, AssetService: assetSvc, UserService: userSvc, NotificationService: notificationSvc, Translator: translator, AuditLogService: auditLogSvc, WebhookService: webhookSvc, JobQueue: jobQueue}
 }
 
 func (s *Service) CreateMaintenanceSchedule(ctx context.Context, payload *domain.CreateMaintenanceSchedulePayload, createdBy string) (domain.MaintenanceScheduleResponse, error) {
@@ -150,17 +188,40 @@ func (s *Service) CreateMaintenanceSchedule(ctx context.Context, payload *domain
 		}
 	}
 
-	created, err := s.Repo.CreateSchedule(ctx, &schedule)
+	var created domain.MaintenanceSchedule
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.Repo.CreateSchedule(ctx, &schedule)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityMaintenanceSchedule, created.ID, created); err != nil {
+			return err
+		}
+
+		// * Auto-translate missing languages in background
+		if len(payload.Translations) < 3 {
+			err = s.JobQueue.Enqueue(ctx, domain.JobTypeMaintenanceScheduleTranslation, translationJob{
+				ScheduleID: created.ID,
+				Created:    payload.Translations,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Send notification asynchronously
+		if err := s.JobQueue.Enqueue(ctx, domain.JobTypeMaintenanceScheduleNotification, notificationJob{Schedule: created}); err != nil {
+			return err
+		}
+
+		return s.WebhookService.Emit(ctx, domain.WebhookEventMaintenanceScheduled, mapper.MaintenanceScheduleToResponse(&created, mapper.DefaultLangCode))
+	})
 	if err != nil {
 		return domain.MaintenanceScheduleResponse{}, err
 	}
 
-	// * Auto-translate missing languages in background
-	go s.autoTranslateCreateMaintenanceScheduleAsync(created.ID, payload.Translations)
-
-	// Send notification asynchronously
-	go s.sendMaintenanceScheduledNotification(ctx, &created)
-
 	return mapper.MaintenanceScheduleToResponse(&created, mapper.DefaultLangCode), nil
 }
 
@@ -172,21 +233,55 @@ func (s *Service) UpdateMaintenanceSchedule(ctx context.Context, scheduleId stri
 		return domain.MaintenanceScheduleResponse{}, domain.ErrNotFoundWithKey(utils.ErrMaintenanceScheduleNotFoundKey)
 	}
 
-	updated, err := s.Repo.UpdateSchedule(ctx, scheduleId, payload)
+	// Keep previous state for audit trail
+	existing, err := s.Repo.GetScheduleById(ctx, scheduleId)
 	if err != nil {
 		return domain.MaintenanceScheduleResponse{}, err
 	}
 
-	// * Auto-translate missing languages in background if translations updated
-	if len(payload.Translations) > 0 {
-		go s.autoTranslateUpdateMaintenanceScheduleAsync(scheduleId, payload.Translations, updated.Translations)
+	var updated domain.MaintenanceSchedule
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = s.Repo.UpdateSchedule(ctx, scheduleId, payload)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityMaintenanceSchedule, scheduleId, existing, updated); err != nil {
+			return err
+		}
+
+		// * Auto-translate missing languages in background if translations updated
+		if len(payload.Translations) > 0 && len(payload.Translations) < 3 {
+			return s.JobQueue.Enqueue(ctx, domain.JobTypeMaintenanceScheduleTranslation, translationJob{
+				ScheduleID: scheduleId,
+				Updated:    payload.Translations,
+				Existing:   updated.Translations,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.MaintenanceScheduleResponse{}, err
 	}
 
 	return mapper.MaintenanceScheduleToResponse(&updated, langCode), nil
 }
 
 func (s *Service) DeleteMaintenanceSchedule(ctx context.Context, scheduleId string) error {
-	return s.Repo.DeleteSchedule(ctx, scheduleId)
+	// Keep previous state for audit trail
+	existing, err := s.Repo.GetScheduleById(ctx, scheduleId)
+	if err != nil {
+		return err
+	}
+
+	return s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteSchedule(ctx, scheduleId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityMaintenanceSchedule, scheduleId, existing)
+	})
 }
 
 func (s *Service) BulkCreateMaintenanceSchedules(ctx context.Context, payload *domain.BulkCreateMaintenanceSchedulesPayload, createdBy string) (domain.BulkCreateMaintenanceSchedulesResponse, error) {
@@ -265,16 +360,32 @@ func (s *Service) BulkCreateMaintenanceSchedules(ctx context.Context, payload *d
 	}
 
 	// * Call repository bulk create
-	created, err := s.Repo.BulkCreateSchedules(ctx, schedules)
+	var created []domain.MaintenanceSchedule
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.Repo.BulkCreateSchedules(ctx, schedules)
+		if err != nil {
+			return err
+		}
+
+		// Send notifications for all created schedules
+		for i := range created {
+			if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityMaintenanceSchedule, created[i].ID, created[i]); err != nil {
+				return err
+			}
+			if err := s.JobQueue.Enqueue(ctx, domain.JobTypeMaintenanceScheduleNotification, notificationJob{Schedule: created[i]}); err != nil {
+				return err
+			}
+			if err := s.WebhookService.Emit(ctx, domain.WebhookEventMaintenanceScheduled, mapper.MaintenanceScheduleToResponse(&created[i], mapper.DefaultLangCode)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkCreateMaintenanceSchedulesResponse{}, err
 	}
 
-	// Send notifications for all created schedules
-	for i := range created {
-		go s.sendMaintenanceScheduledNotification(ctx, &created[i])
-	}
-
 	// * Convert to responses
 	response := domain.BulkCreateMaintenanceSchedulesResponse{
 		MaintenanceSchedules: mapper.MaintenanceSchedulesToResponses(created, mapper.DefaultLangCode),
@@ -289,7 +400,21 @@ func (s *Service) BulkDeleteMaintenanceSchedules(ctx context.Context, payload *d
 	}
 
 	// * Perform bulk delete operation
-	result, err := s.Repo.BulkDeleteSchedules(ctx, payload.IDS)
+	var result domain.BulkDeleteMaintenanceSchedules
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.Repo.BulkDeleteSchedules(ctx, payload.IDS)
+		if err != nil {
+			return err
+		}
+
+		for _, deletedId := range result.DeletedIDS {
+			if err := s.AuditLogService.RecordDelete(ctx, domain.AuditEntityMaintenanceSchedule, deletedId, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkDeleteMaintenanceSchedulesResponse{}, err
 	}
@@ -401,17 +526,40 @@ func (s *Service) sendMaintenanceScheduledNotification(ctx context.Context, sche
 	}
 }
 
+// *===========================JOB HANDLERS===========================*
+
+// HandleTranslationJob fills the missing maintenance schedule translations queued by create and update
+func (s *Service) HandleTranslationJob(ctx context.Context, job *domain.Job) error {
+	var payload translationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	if payload.Updated != nil {
+		return s.autoTranslateUpdateMaintenanceSchedule(ctx, payload.ScheduleID, payload.Updated, payload.Existing)
+	}
+	return s.autoTranslateCreateMaintenanceSchedule(ctx, payload.ScheduleID, payload.Created)
+}
+
+// HandleNotificationJob sends the maintenance scheduled notification queued by create
+func (s *Service) HandleNotificationJob(ctx context.Context, job *domain.Job) error {
+	var payload notificationJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	s.sendMaintenanceScheduledNotification(ctx, &payload.Schedule)
+	return nil
+}
+
 // *===========================ASYNC TRANSLATION===========================*
 
-// autoTranslateCreateMaintenanceScheduleAsync translates maintenance schedule to missing languages in background
-func (s *Service) autoTranslateCreateMaintenanceScheduleAsync(scheduleID string, userTranslations []domain.CreateMaintenanceScheduleTranslationPayload) {
+// autoTranslateCreateMaintenanceSchedule translates maintenance schedule to missing languages, dijalankan worker lewat HandleTranslationJob
+func (s *Service) autoTranslateCreateMaintenanceSchedule(ctx context.Context, scheduleID string, userTranslations []domain.CreateMaintenanceScheduleTranslationPayload) error {
 	if len(userTranslations) >= 3 {
-		return // All languages provided, no need to translate
+		return nil // All languages provided, no need to translate
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
 	// Convert domain types to utils types
 	utilsTranslations := make([]utils.MaintenanceScheduleCreateTranslation, len(userTranslations))
 	for i, t := range userTranslations {
@@ -426,7 +574,7 @@ func (s *Service) autoTranslateCreateMaintenanceScheduleAsync(scheduleID string,
 	translatedPayloads, err := utils.AutoTranslateMaintenanceScheduleCreate(ctx, s.Translator, utilsTranslations)
 	if err != nil {
 		log.Printf("Failed to auto-translate maintenance schedule ID %s: %v", scheduleID, err)
-		return
+		return err
 	}
 
 	// Extract only new translations
@@ -454,19 +602,18 @@ func (s *Service) autoTranslateCreateMaintenanceScheduleAsync(scheduleID string,
 	if len(newTranslations) > 0 {
 		if err := s.Repo.AddMaintenanceScheduleTranslations(ctx, scheduleID, newTranslations); err != nil {
 			log.Printf("Failed to save auto-translated maintenance schedule translations for ID %s: %v", scheduleID, err)
+			return err
 		}
 	}
+	return nil
 }
 
-// autoTranslateUpdateMaintenanceScheduleAsync translates maintenance schedule updates to missing languages in background
-func (s *Service) autoTranslateUpdateMaintenanceScheduleAsync(scheduleID string, userUpdates []domain.UpdateMaintenanceScheduleTranslationPayload, existingTranslations []domain.MaintenanceScheduleTranslation) {
+// autoTranslateUpdateMaintenanceSchedule translates maintenance schedule updates to missing languages, dijalankan worker lewat HandleTranslationJob
+func (s *Service) autoTranslateUpdateMaintenanceSchedule(ctx context.Context, scheduleID string, userUpdates []domain.UpdateMaintenanceScheduleTranslationPayload, existingTranslations []domain.MaintenanceScheduleTranslation) error {
 	if len(userUpdates) == 0 {
-		return // No updates provided
+		return nil // No updates provided
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
 	// If user updated all 3 languages, no need to auto-translate
 	updatedLangCodes := make([]string, len(userUpdates))
 	for i, t := range userUpdates {
@@ -474,7 +621,7 @@ func (s *Service) autoTranslateUpdateMaintenanceScheduleAsync(scheduleID string,
 	}
 
 	if len(updatedLangCodes) >= 3 {
-		return
+		return nil
 	}
 
 	// Convert domain types to utils types
@@ -500,7 +647,7 @@ func (s *Service) autoTranslateUpdateMaintenanceScheduleAsync(scheduleID string,
 	translatedPayloads, err := utils.AutoTranslateMaintenanceScheduleUpdate(ctx, s.Translator, utilsUpdates, utilsExisting)
 	if err != nil {
 		log.Printf("Failed to auto-translate updated maintenance schedule ID %s: %v", scheduleID, err)
-		return
+		return err
 	}
 
 	// Extract only new translations (not in userUpdates)
@@ -553,6 +700,8 @@ func (s *Service) autoTranslateUpdateMaintenanceScheduleAsync(scheduleID string,
 	if len(newTranslations) > 0 {
 		if err := s.Repo.AddMaintenanceScheduleTranslations(ctx, scheduleID, newTranslations); err != nil {
 			log.Printf("Failed to save auto-translated maintenance schedule update translations for ID %s: %v", scheduleID, err)
+			return err
 		}
 	}
+	return nil
 }
@@ -0,0 +1,88 @@
+package notification
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/robfig/cron/v3"
+)
+
+// defaultDigestSchedule sends the daily digest at 07:00 server time
+const defaultDigestSchedule = "0 0 7 * * *"
+
+// DigestRepository defines the queries used by the digest schedule
+type DigestRepository interface {
+	GetEmailDigestUserIds(ctx context.Context) ([]string, error)
+}
+
+// CronService manages scheduled tasks for notifications
+type CronService struct {
+	cron           *cron.Cron
+	preferenceRepo DigestRepository
+	jobQueue       JobQueue
+}
+
+// NewCronService creates a new cron service instance
+func NewCronService(preferenceRepo DigestRepository, jobQueue JobQueue) *CronService {
+	// Create cron instance with seconds field support
+	c := cron.New(cron.WithSeconds())
+
+	return &CronService{
+		cron:           c,
+		preferenceRepo: preferenceRepo,
+		jobQueue:       jobQueue,
+	}
+}
+
+// Start begins all scheduled cron jobs
+func (cs *CronService) Start() error {
+	schedule := os.Getenv("NOTIFICATION_DIGEST_SCHEDULE")
+	if schedule == "" {
+		schedule = defaultDigestSchedule
+	}
+
+	// Queue daily digest emails, dikirim worker lewat HandleDigestJob
+	_, err := cs.cron.AddFunc(schedule, cs.queueDailyDigests)
+	if err != nil {
+		return err
+	}
+
+	cs.cron.Start()
+	log.Println("Notification cron service started successfully")
+	return nil
+}
+
+// Stop gracefully stops all cron jobs
+func (cs *CronService) Stop() {
+	ctx := cs.cron.Stop()
+	<-ctx.Done()
+	log.Println("Notification cron service stopped")
+}
+
+// queueDailyDigests enqueues one digest job per user in digest mode
+func (cs *CronService) queueDailyDigests() {
+	ctx := context.Background()
+	log.Println("Queueing daily notification digests...")
+
+	userIds, err := cs.preferenceRepo.GetEmailDigestUserIds(ctx)
+	if err != nil {
+		log.Printf("Error getting notification digest users: %v", err)
+		return
+	}
+
+	// * Semua job memakai Until yang sama, notifikasi setelah ini masuk digest besok
+	until := time.Now()
+	queued := 0
+	for _, userId := range userIds {
+		if err := cs.jobQueue.Enqueue(ctx, domain.JobTypeNotificationDigest, digestJob{UserID: userId, Until: until}); err != nil {
+			log.Printf("Error queueing notification digest for user %s: %v", userId, err)
+			continue
+		}
+		queued++
+	}
+
+	log.Printf("Queued %d notification digests", queued)
+}
@@ -3,12 +3,21 @@ package notification
 import (
 	"context"
 	"log"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
 	"github.com/Rizz404/inventory-api/internal/client/fcm"
+	"github.com/Rizz404/inventory-api/internal/client/smtp"
+	"github.com/Rizz404/inventory-api/internal/notification/messages"
 	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
 )
 
+// digestMaxItems is the number of notifications listed in one digest email, sisanya hanya disebut jumlahnya
+const digestMaxItems = 50
+
 // * Repository interface defines the contract for notification data operations
 type Repository interface {
 	// * MUTATION
@@ -26,6 +35,22 @@ type Repository interface {
 	CheckNotificationExist(ctx context.Context, notificationId string) (bool, error)
 	CountNotifications(ctx context.Context, params domain.NotificationParams) (int64, error)
 	GetNotificationStatistics(ctx context.Context) (domain.NotificationStatistics, error)
+	GetDigestNotifications(ctx context.Context, userId string, since, until time.Time, excludeTypes []domain.NotificationType, limit int) ([]domain.Notification, int64, error)
+}
+
+// * PreferenceRepository interface for per-user channel preferences and digest settings
+type PreferenceRepository interface {
+	// * MUTATION
+	UpsertNotificationPreferences(ctx context.Context, preferences []domain.NotificationPreference) error
+	SetEmailDigest(ctx context.Context, userId string, enabled bool) error
+	MarkDigestSent(ctx context.Context, userId string, sentAt time.Time) error
+
+	// * QUERY
+	GetNotificationPreferences(ctx context.Context, userId string) ([]domain.NotificationPreference, error)
+	GetNotificationPreference(ctx context.Context, userId string, notificationType domain.NotificationType) (domain.NotificationPreference, error)
+	GetUserNotificationSettings(ctx context.Context, userId string) (domain.UserNotificationSettings, error)
+	LockUserNotificationSettings(ctx context.Context, userId string) (domain.UserNotificationSettings, error)
+	GetEmailDigestUserIds(ctx context.Context) ([]string, error)
 }
 
 // * UserRepository interface for getting user details including FCM token
@@ -50,22 +75,63 @@ type NotificationService interface {
 	CheckNotificationExists(ctx context.Context, notificationId string) (bool, error)
 	CountNotifications(ctx context.Context, params domain.NotificationParams) (int64, error)
 	GetNotificationStatistics(ctx context.Context) (domain.NotificationStatisticsResponse, error)
+
+	// * PREFERENCES
+	GetNotificationPreferences(ctx context.Context, userId string) (domain.NotificationPreferencesResponse, error)
+	UpdateNotificationPreferences(ctx context.Context, userId string, payload *domain.UpdateNotificationPreferencesPayload) (domain.NotificationPreferencesResponse, error)
+
+	// * JOB HANDLERS
+	HandlePushJob(ctx context.Context, job *domain.Job) error
+	HandleEmailJob(ctx context.Context, job *domain.Job) error
+	HandleDigestJob(ctx context.Context, job *domain.Job) error
+}
+
+// * JobQueue interface for enqueueing background jobs in the same transaction as the mutation
+type JobQueue interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+}
+
+// deliveryJob is the payload of domain.JobTypeNotificationPush and domain.JobTypeNotificationEmail,
+// Notification diisi kalau channel in-app dimatikan sehingga notifikasinya tidak disimpan
+type deliveryJob struct {
+	NotificationID string               `json:"notificationId,omitempty"`
+	Notification   *domain.Notification `json:"notification,omitempty"`
+}
+
+// digestJob is the payload of domain.JobTypeNotificationDigest, digest mencakup notifikasi sampai Until
+type digestJob struct {
+	UserID string    `json:"userId"`
+	Until  time.Time `json:"until"`
+}
+
+// deliveryChannels are the channels a notification is delivered to after applying the user's preference
+type deliveryChannels struct {
+	InApp bool
+	Push  bool
+	Email bool
 }
 
 type Service struct {
-	Repo      Repository
-	UserRepo  UserRepository
-	FCMClient *fcm.Client
+	Repo           Repository
+	PreferenceRepo PreferenceRepository
+	UserRepo       UserRepository
+	FCMClient      *fcm.Client
+	SMTPClient     *smtp.Client
+	JobQueue       JobQueue
 }
 
 // * Ensure Service implements NotificationService interface
 var _ NotificationService = (*Service)(nil)
 
-func NewService(r Repository, userRepo UserRepository, fcmClient *fcm.Client) NotificationService {
+func NewService(r Repository, preferenceRepo PreferenceRepository, userRepo UserRepository, fcmClient *fcm.Client, smtpClient *smtp.Client, jobQueue JobQueue) NotificationService {
 	return &Service{
-		Repo:      r,
-		UserRepo:  userRepo,
-		FCMClient: fcmClient,
+		Repo:           r,
+		PreferenceRepo: preferenceRepo,
+		UserRepo:       userRepo,
+		FCMClient:      fcmClient,
+		SMTPClient:     smtpClient,
+		JobQueue:       jobQueue,
 	}
 }
 
@@ -93,13 +159,28 @@ func (s *Service) CreateNotification(ctx context.Context, payload *domain.Create
 		}
 	}
 
-	createdNotification, err := s.Repo.CreateNotification(ctx, &newNotification)
+	channels, err := s.resolveChannels(ctx, payload.UserID, payload.Type)
 	if err != nil {
 		return domain.NotificationResponse{}, err
 	}
 
-	// * Send FCM notification asynchronously (non-blocking)
-	go s.sendFCMNotification(context.Background(), &createdNotification)
+	// * Push dan email di-enqueue dalam transaksi yang sama, jadi tidak hilang kalau proses mati
+	createdNotification := newNotification
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		if channels.InApp {
+			var err error
+			createdNotification, err = s.Repo.CreateNotification(ctx, &newNotification)
+			if err != nil {
+				return err
+			}
+		} else {
+			createdNotification.CreatedAt = time.Now()
+		}
+		return s.enqueueDelivery(ctx, &createdNotification, channels)
+	})
+	if err != nil {
+		return domain.NotificationResponse{}, err
+	}
 
 	// * Convert to NotificationResponse using mapper
 	return mapper.NotificationToResponse(&createdNotification, mapper.DefaultLangCode), nil
@@ -169,15 +250,46 @@ func (s *Service) BulkCreateNotifications(ctx context.Context, payload *domain.B
 		}
 	}
 
-	// * Call repository bulk create
-	created, err := s.Repo.BulkCreateNotifications(ctx, notifications)
-	if err != nil {
-		return domain.BulkCreateNotificationsResponse{}, err
+	// * Notifikasi dengan channel in-app mati tidak disimpan, hanya dikirim lewat push/email
+	var stored, unstored []domain.Notification
+	var storedChannels, unstoredChannels []deliveryChannels
+	for i := range notifications {
+		channels, err := s.resolveChannels(ctx, notifications[i].UserID, notifications[i].Type)
+		if err != nil {
+			return domain.BulkCreateNotificationsResponse{}, err
+		}
+		if channels.InApp {
+			stored = append(stored, notifications[i])
+			storedChannels = append(storedChannels, channels)
+		} else {
+			notifications[i].CreatedAt = time.Now()
+			unstored = append(unstored, notifications[i])
+			unstoredChannels = append(unstoredChannels, channels)
+		}
 	}
 
-	// * Send FCM notifications asynchronously
-	for i := range created {
-		go s.sendFCMNotification(context.Background(), &created[i])
+	// * Call repository bulk create, push dan email di-enqueue dalam transaksi yang sama
+	var created []domain.Notification
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.Repo.BulkCreateNotifications(ctx, stored)
+		if err != nil {
+			return err
+		}
+		for i := range created {
+			if err := s.enqueueDelivery(ctx, &created[i], storedChannels[i]); err != nil {
+				return err
+			}
+		}
+		for i := range unstored {
+			if err := s.enqueueDelivery(ctx, &unstored[i], unstoredChannels[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.BulkCreateNotificationsResponse{}, err
 	}
 
 	// * Convert to responses
@@ -283,14 +395,366 @@ func (s *Service) GetNotificationStatistics(ctx context.Context) (domain.Notific
 	return mapper.NotificationStatisticsToResponse(&stats), nil
 }
 
+// *===========================PREFERENCES===========================*
+func (s *Service) GetNotificationPreferences(ctx context.Context, userId string) (domain.NotificationPreferencesResponse, error) {
+	preferences, err := s.PreferenceRepo.GetNotificationPreferences(ctx, userId)
+	if err != nil {
+		return domain.NotificationPreferencesResponse{}, err
+	}
+
+	settings, err := s.PreferenceRepo.GetUserNotificationSettings(ctx, userId)
+	if err != nil {
+		return domain.NotificationPreferencesResponse{}, err
+	}
+
+	response := mapper.NotificationPreferencesToResponse(userId, preferences, &settings)
+	response.PushAvailable = s.FCMClient != nil
+	response.EmailAvailable = s.SMTPClient.IsEnabled()
+	return response, nil
+}
+
+func (s *Service) UpdateNotificationPreferences(ctx context.Context, userId string, payload *domain.UpdateNotificationPreferencesPayload) (domain.NotificationPreferencesResponse, error) {
+	// * Validate no duplicate types in payload
+	seenTypes := make(map[domain.NotificationType]struct{})
+	for _, item := range payload.Preferences {
+		if _, exists := seenTypes[item.Type]; exists {
+			return domain.NotificationPreferencesResponse{}, domain.ErrBadRequestWithKey(utils.ErrNotificationPreferenceDuplicateTypeKey)
+		}
+		seenTypes[item.Type] = struct{}{}
+	}
+
+	err := s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		if len(payload.Preferences) > 0 {
+			existing, err := s.PreferenceRepo.GetNotificationPreferences(ctx, userId)
+			if err != nil {
+				return err
+			}
+			byType := make(map[domain.NotificationType]domain.NotificationPreference, len(existing))
+			for _, preference := range existing {
+				byType[preference.Type] = preference
+			}
+
+			// * Channel yang tidak dikirim tetap memakai nilai lama atau default
+			preferences := make([]domain.NotificationPreference, 0, len(payload.Preferences))
+			for _, item := range payload.Preferences {
+				preference, ok := byType[item.Type]
+				if !ok {
+					preference = domain.DefaultNotificationPreference(userId, item.Type)
+				}
+				if item.InApp != nil {
+					preference.InApp = *item.InApp
+				}
+				if item.Push != nil {
+					preference.Push = *item.Push
+				}
+				if item.Email != nil {
+					preference.Email = *item.Email
+				}
+				preferences = append(preferences, preference)
+			}
+
+			if err := s.PreferenceRepo.UpsertNotificationPreferences(ctx, preferences); err != nil {
+				return err
+			}
+		}
+
+		if payload.EmailDigest != nil {
+			return s.PreferenceRepo.SetEmailDigest(ctx, userId, *payload.EmailDigest)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.NotificationPreferencesResponse{}, err
+	}
+
+	return s.GetNotificationPreferences(ctx, userId)
+}
+
+// *===========================JOB HANDLERS===========================*
+
+// HandlePushJob sends the FCM push of a notification, error FCM membuat job di-retry
+func (s *Service) HandlePushJob(ctx context.Context, job *domain.Job) error {
+	notification, err := s.loadDeliveryNotification(ctx, job)
+	if err != nil || notification == nil {
+		return err
+	}
+
+	return s.sendFCMNotification(ctx, notification)
+}
+
+// HandleEmailJob sends a single notification email, error SMTP membuat job di-retry
+func (s *Service) HandleEmailJob(ctx context.Context, job *domain.Job) error {
+	notification, err := s.loadDeliveryNotification(ctx, job)
+	if err != nil || notification == nil {
+		return err
+	}
+
+	return s.sendNotificationEmail(ctx, notification)
+}
+
+// HandleDigestJob sends one email with the unread notifications since the previous digest.
+// Settings di-lock selama job berjalan supaya job digest ganda (mis. cron dari beberapa instance) tidak mengirim email yang sama dua kali
+func (s *Service) HandleDigestJob(ctx context.Context, job *domain.Job) error {
+	var payload digestJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	if !s.SMTPClient.IsEnabled() {
+		log.Printf("SMTP client not initialized, skipping notification digest for user ID: %s", payload.UserID)
+		return nil
+	}
+
+	return s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		settings, err := s.PreferenceRepo.LockUserNotificationSettings(ctx, payload.UserID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		// * Digest sudah dimatikan, atau window ini sudah dikirim job lain
+		if !settings.EmailDigest {
+			return nil
+		}
+		since := payload.Until.Add(-24 * time.Hour)
+		if settings.LastDigestSentAt != nil {
+			if !settings.LastDigestSentAt.Before(payload.Until) {
+				return nil
+			}
+			since = *settings.LastDigestSentAt
+		}
+
+		user, err := s.UserRepo.GetUserById(ctx, payload.UserID)
+		if err != nil {
+			if domain.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if !user.IsActive || user.Email == "" {
+			return nil
+		}
+
+		// * Tipe dengan channel email mati tidak ikut digest
+		preferences, err := s.PreferenceRepo.GetNotificationPreferences(ctx, payload.UserID)
+		if err != nil {
+			return err
+		}
+		var excludeTypes []domain.NotificationType
+		for _, preference := range preferences {
+			if !preference.Email {
+				excludeTypes = append(excludeTypes, preference.Type)
+			}
+		}
+
+		notifications, total, err := s.Repo.GetDigestNotifications(ctx, payload.UserID, since, payload.Until, excludeTypes, digestMaxItems)
+		if err != nil {
+			return err
+		}
+
+		if total > 0 {
+			lang := user.PreferredLang
+			email := s.newNotificationEmail(&user)
+			email.Subject = messages.GetEmailMessage(messages.EmailDigestSubjectKey, lang, map[string]string{"count": strconv.FormatInt(total, 10)})
+			email.Intro = messages.GetEmailMessage(messages.EmailDigestIntroKey, lang, nil)
+			for i := range notifications {
+				email.Items = append(email.Items, notificationEmailItem(&notifications[i], lang))
+			}
+			if more := total - int64(len(notifications)); more > 0 {
+				email.MoreText = messages.GetEmailMessage(messages.EmailDigestMoreKey, lang, map[string]string{"count": strconv.FormatInt(more, 10)})
+			}
+
+			if err := s.SMTPClient.SendNotificationEmail(ctx, user.Email, email); err != nil {
+				log.Printf("Failed to send notification digest (user ID: %s): %v", payload.UserID, err)
+				return err
+			}
+			log.Printf("Successfully sent notification digest with %d notifications for user ID: %s", total, payload.UserID)
+		}
+
+		return s.PreferenceRepo.MarkDigestSent(ctx, payload.UserID, payload.Until)
+	})
+}
+
 // *===========================HELPER METHODS===========================*
 
+// resolveChannels applies the user's preference for the notification type and the configured clients
+func (s *Service) resolveChannels(ctx context.Context, userId string, notificationType domain.NotificationType) (deliveryChannels, error) {
+	preference, err := s.PreferenceRepo.GetNotificationPreference(ctx, userId, notificationType)
+	if err != nil {
+		return deliveryChannels{}, err
+	}
+
+	channels := deliveryChannels{
+		InApp: preference.InApp,
+		Push:  preference.Push && s.FCMClient != nil,
+		Email: preference.Email && s.SMTPClient.IsEnabled(),
+	}
+
+	// * User mode digest menerima email lewat digest harian, kecuali notifikasinya tidak disimpan (in-app mati)
+	if channels.Email && channels.InApp {
+		settings, err := s.PreferenceRepo.GetUserNotificationSettings(ctx, userId)
+		if err != nil {
+			return deliveryChannels{}, err
+		}
+		if settings.EmailDigest {
+			channels.Email = false
+		}
+	}
+
+	return channels, nil
+}
+
+// enqueueDelivery queues the push and email jobs of a notification according to the resolved channels
+func (s *Service) enqueueDelivery(ctx context.Context, notification *domain.Notification, channels deliveryChannels) error {
+	payload := deliveryJob{NotificationID: notification.ID}
+	if !channels.InApp {
+		payload = deliveryJob{Notification: notification}
+	}
+
+	if channels.Push {
+		if err := s.JobQueue.Enqueue(ctx, domain.JobTypeNotificationPush, payload); err != nil {
+			return err
+		}
+	}
+	if channels.Email {
+		if err := s.JobQueue.Enqueue(ctx, domain.JobTypeNotificationEmail, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadDeliveryNotification returns the notification of a push/email job, nil kalau notifikasinya sudah dihapus
+func (s *Service) loadDeliveryNotification(ctx context.Context, job *domain.Job) (*domain.Notification, error) {
+	var payload deliveryJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return nil, err
+	}
+
+	if payload.Notification != nil {
+		return payload.Notification, nil
+	}
+
+	notification, err := s.Repo.GetNotificationById(ctx, payload.NotificationID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			log.Printf("Notification %s no longer exists, skipping %s", payload.NotificationID, job.Type)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+// sendNotificationEmail sends a notification email in the user's preferred language
+func (s *Service) sendNotificationEmail(ctx context.Context, notification *domain.Notification) error {
+	// * Skip if SMTP client is not initialized
+	if !s.SMTPClient.IsEnabled() {
+		log.Printf("SMTP client not initialized, skipping notification email for notification ID: %s", notification.ID)
+		return nil
+	}
+
+	user, err := s.UserRepo.GetUserById(ctx, notification.UserID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			log.Printf("User not found, skipping notification email for notification ID: %s, user ID: %s", notification.ID, notification.UserID)
+			return nil
+		}
+		return err
+	}
+
+	// * Skip inactive users and users without email
+	if !user.IsActive || user.Email == "" {
+		log.Printf("User is inactive or has no email, skipping notification email for notification ID: %s, user ID: %s", notification.ID, notification.UserID)
+		return nil
+	}
+
+	lang := user.PreferredLang
+	item := notificationEmailItem(notification, lang)
+
+	email := s.newNotificationEmail(&user)
+	email.Subject = messages.GetEmailMessage(messages.EmailNotificationSubjectKey, lang, map[string]string{"title": item.Title})
+	email.Intro = messages.GetEmailMessage(messages.EmailNotificationIntroKey, lang, nil)
+	email.Items = []smtp.NotificationEmailItem{item}
+
+	if err := s.SMTPClient.SendNotificationEmail(ctx, user.Email, email); err != nil {
+		// * Dikembalikan supaya job di-retry dengan backoff
+		log.Printf("Failed to send notification email (notification ID: %s, user ID: %s): %v", notification.ID, notification.UserID, err)
+		return err
+	}
+
+	log.Printf("Successfully sent notification email for notification ID: %s, user ID: %s", notification.ID, notification.UserID)
+	return nil
+}
+
+// newNotificationEmail fills the parts shared by single and digest emails
+func (s *Service) newNotificationEmail(user *domain.User) *smtp.NotificationEmail {
+	lang := user.PreferredLang
+
+	name := user.FullName
+	if name == "" {
+		name = user.Name
+	}
+
+	email := &smtp.NotificationEmail{
+		LangCode: lang,
+		Greeting: messages.GetEmailMessage(messages.EmailGreetingKey, lang, map[string]string{"name": name}),
+		Footer:   messages.GetEmailMessage(messages.EmailFooterKey, lang, nil),
+	}
+
+	// * Tombol ke aplikasi hanya ditampilkan kalau URL-nya dikonfigurasi
+	if actionURL := os.Getenv("NOTIFICATION_EMAIL_ACTION_URL"); actionURL != "" {
+		email.ActionURL = actionURL
+		email.ActionLabel = messages.GetEmailMessage(messages.EmailActionKey, lang, nil)
+	}
+
+	return email
+}
+
+// notificationEmailItem renders a notification in the given language for the email template
+func notificationEmailItem(notification *domain.Notification, langCode string) smtp.NotificationEmailItem {
+	title, message := notificationTranslation(notification, langCode)
+
+	item := smtp.NotificationEmailItem{
+		Title:   title,
+		Message: message,
+		Time:    notification.CreatedAt.Format("2006-01-02 15:04 MST"),
+	}
+
+	switch notification.Priority {
+	case domain.NotificationPriorityUrgent:
+		item.PriorityLabel = messages.GetEmailMessage(messages.EmailPriorityUrgentKey, langCode, nil)
+		item.Urgent = true
+	case domain.NotificationPriorityHigh:
+		item.PriorityLabel = messages.GetEmailMessage(messages.EmailPriorityHighKey, langCode, nil)
+	}
+
+	return item
+}
+
+// notificationTranslation picks the translation in the given language, fallback ke translation pertama
+func notificationTranslation(notification *domain.Notification, langCode string) (string, string) {
+	for _, translation := range notification.Translations {
+		if translation.LangCode == langCode {
+			return translation.Title, translation.Message
+		}
+	}
+
+	if len(notification.Translations) > 0 {
+		return notification.Translations[0].Title, notification.Translations[0].Message
+	}
+	return "", ""
+}
+
 // sendFCMNotification sends push notification via FCM to the user
-func (s *Service) sendFCMNotification(ctx context.Context, notification *domain.Notification) {
+func (s *Service) sendFCMNotification(ctx context.Context, notification *domain.Notification) error {
 	// * Skip if FCM client is not initialized
 	if s.FCMClient == nil {
 		log.Printf("FCM client not initialized, skipping FCM notification for notification ID: %s", notification.ID)
-		return
+		return nil
 	}
 
 	log.Printf("Starting FCM notification send for notification ID: %s, user ID: %s", notification.ID, notification.UserID)
@@ -298,32 +762,22 @@ func (s *Service) sendFCMNotification(ctx context.Context, notification *domain.
 	// * Get user to retrieve FCM token and preferred language
 	user, err := s.UserRepo.GetUserById(ctx, notification.UserID)
 	if err != nil {
-		// Log error but don't fail the notification creation
+		if domain.IsNotFound(err) {
+			log.Printf("User not found, skipping FCM notification for notification ID: %s, user ID: %s", notification.ID, notification.UserID)
+			return nil
+		}
 		log.Printf("Failed to get user for FCM notification (notification ID: %s, user ID: %s): %v", notification.ID, notification.UserID, err)
-		return
+		return err
 	}
 
 	// * Skip if user doesn't have FCM token
 	if user.FCMToken == nil || *user.FCMToken == "" {
 		log.Printf("User has no FCM token, skipping FCM notification for notification ID: %s, user ID: %s", notification.ID, notification.UserID)
-		return
+		return nil
 	}
 
-	// * Get the appropriate translation based on user's preferred language
-	var title, message string
-	for _, translation := range notification.Translations {
-		if translation.LangCode == user.PreferredLang {
-			title = translation.Title
-			message = translation.Message
-			break
-		}
-	}
-
-	// * Fallback to first translation if preferred language not found
-	if title == "" && len(notification.Translations) > 0 {
-		title = notification.Translations[0].Title
-		message = notification.Translations[0].Message
-	}
+	// * Get the appropriate translation based on user's preferred language, fallback to first translation
+	title, message := notificationTranslation(notification, user.PreferredLang)
 
 	// * Prepare FCM notification data
 	fcmNotification := &fcm.PushNotification{
@@ -357,9 +811,11 @@ func (s *Service) sendFCMNotification(ctx context.Context, notification *domain.
 	// * Send FCM notification
 	_, err = s.FCMClient.SendToToken(ctx, fcmNotification)
 	if err != nil {
-		// Log error but don't fail the notification creation
+		// * Dikembalikan supaya job di-retry dengan backoff
 		log.Printf("Failed to send FCM notification (notification ID: %s, user ID: %s): %v", notification.ID, notification.UserID, err)
-	} else {
-		log.Printf("Successfully sent FCM notification for notification ID: %s, user ID: %s, priority: %s", notification.ID, notification.UserID, notification.Priority)
+		return err
 	}
+
+	log.Printf("Successfully sent FCM notification for notification ID: %s, user ID: %s, priority: %s", notification.ID, notification.UserID, notification.Priority)
+	return nil
 }
@@ -0,0 +1,298 @@
+package role
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+// * Repository interface defines the contract for role data operations
+type Repository interface {
+	// * MUTATION
+	CreateRole(ctx context.Context, payload *domain.Role) (domain.Role, error)
+	UpdateRole(ctx context.Context, roleId string, payload *domain.UpdateRolePayload) (domain.Role, error)
+	DeleteRole(ctx context.Context, roleId string) error
+	SetUserRoles(ctx context.Context, userId string, roleIds []string) error
+
+	// * QUERY
+	GetRoles(ctx context.Context) ([]domain.Role, error)
+	GetRoleById(ctx context.Context, roleId string) (domain.Role, error)
+	GetRolesByIds(ctx context.Context, roleIds []string) ([]domain.Role, error)
+	GetUserRoles(ctx context.Context, userId string) ([]domain.Role, error)
+	GetUserPermissions(ctx context.Context, userId string) ([]string, error)
+	CheckRoleNameExists(ctx context.Context, name string) (bool, error)
+	CheckRoleNameExistsExcluding(ctx context.Context, name string, excludeRoleId string) (bool, error)
+}
+
+// * RoleService interface defines the contract for role business operations
+type RoleService interface {
+	// * MUTATION
+	CreateRole(ctx context.Context, payload *domain.CreateRolePayload) (domain.RoleResponse, error)
+	UpdateRole(ctx context.Context, roleId string, payload *domain.UpdateRolePayload) (domain.RoleResponse, error)
+	DeleteRole(ctx context.Context, roleId string) error
+	AssignUserRoles(ctx context.Context, userId string, payload *domain.AssignUserRolesPayload) (domain.UserRolesResponse, error)
+
+	// * QUERY
+	GetRoles(ctx context.Context) ([]domain.RoleResponse, error)
+	GetRoleById(ctx context.Context, roleId string) (domain.RoleResponse, error)
+	GetPermissions(ctx context.Context) []domain.Permission
+	GetUserRoles(ctx context.Context, userId string) (domain.UserRolesResponse, error)
+	GetUserPermissions(ctx context.Context, userId string) ([]string, error)
+}
+
+// * UserRepository interface for checking user existence
+type UserRepository interface {
+	GetUserById(ctx context.Context, userId string) (domain.User, error)
+}
+
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// * Transactor interface for writing a mutation and its audit entry in one transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Service struct {
+	Repo            Repository
+	UserRepo        UserRepository
+	AuditLogService AuditLogService
+	Transactor      Transactor
+}
+
+// * Ensure Service implements RoleService interface
+var _ RoleService = (*Service)(nil)
+
+func NewService(r Repository, userRepo UserRepository, auditLogService AuditLogService, transactor Transactor) RoleService {
+	return &Service{
+		Repo:            r,
+		UserRepo:        userRepo,
+		AuditLogService: auditLogService,
+		Transactor:      transactor,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (s *Service) CreateRole(ctx context.Context, payload *domain.CreateRolePayload) (domain.RoleResponse, error) {
+	if err := validatePermissions(payload.Permissions); err != nil {
+		return domain.RoleResponse{}, err
+	}
+
+	name := strings.TrimSpace(payload.Name)
+	if nameExists, err := s.Repo.CheckRoleNameExists(ctx, name); err != nil {
+		return domain.RoleResponse{}, err
+	} else if nameExists {
+		return domain.RoleResponse{}, domain.ErrConflictWithKey(utils.ErrRoleNameExistsKey)
+	}
+
+	newRole := domain.Role{
+		Name:        name,
+		Description: payload.Description,
+		IsSystem:    false,
+		Permissions: payload.Permissions,
+	}
+
+	var createdRole domain.Role
+	err := s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdRole, err = s.Repo.CreateRole(ctx, &newRole)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordCreate(ctx, domain.AuditEntityRole, createdRole.ID, createdRole)
+	})
+	if err != nil {
+		return domain.RoleResponse{}, err
+	}
+
+	return mapper.RoleToResponse(&createdRole), nil
+}
+
+func (s *Service) UpdateRole(ctx context.Context, roleId string, payload *domain.UpdateRolePayload) (domain.RoleResponse, error) {
+	existingRole, err := s.Repo.GetRoleById(ctx, roleId)
+	if err != nil {
+		return domain.RoleResponse{}, err
+	}
+
+	if payload.Name != nil {
+		name := strings.TrimSpace(*payload.Name)
+		payload.Name = &name
+
+		if name != existingRole.Name {
+			// * Nama system role dipakai untuk resolve base role user, jadi tidak boleh diganti
+			if existingRole.IsSystem {
+				return domain.RoleResponse{}, domain.ErrForbiddenWithKey(utils.ErrRoleSystemProtectedKey)
+			}
+			if nameExists, err := s.Repo.CheckRoleNameExistsExcluding(ctx, name, roleId); err != nil {
+				return domain.RoleResponse{}, err
+			} else if nameExists {
+				return domain.RoleResponse{}, domain.ErrConflictWithKey(utils.ErrRoleNameExistsKey)
+			}
+		}
+	}
+
+	if payload.Permissions != nil {
+		if existingRole.IsSystem && existingRole.Name == string(domain.RoleAdmin) {
+			return domain.RoleResponse{}, domain.ErrForbiddenWithKey(utils.ErrRoleAdminImmutableKey)
+		}
+		if err := validatePermissions(payload.Permissions); err != nil {
+			return domain.RoleResponse{}, err
+		}
+	}
+
+	var updatedRole domain.Role
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedRole, err = s.Repo.UpdateRole(ctx, roleId, payload)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityRole, roleId, existingRole, updatedRole)
+	})
+	if err != nil {
+		return domain.RoleResponse{}, err
+	}
+
+	return mapper.RoleToResponse(&updatedRole), nil
+}
+
+func (s *Service) DeleteRole(ctx context.Context, roleId string) error {
+	existingRole, err := s.Repo.GetRoleById(ctx, roleId)
+	if err != nil {
+		return err
+	}
+
+	if existingRole.IsSystem {
+		return domain.ErrForbiddenWithKey(utils.ErrRoleSystemProtectedKey)
+	}
+
+	return s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteRole(ctx, roleId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityRole, roleId, existingRole)
+	})
+}
+
+func (s *Service) AssignUserRoles(ctx context.Context, userId string, payload *domain.AssignUserRolesPayload) (domain.UserRolesResponse, error) {
+	if _, err := s.UserRepo.GetUserById(ctx, userId); err != nil {
+		return domain.UserRolesResponse{}, err
+	}
+
+	roleIds := make([]string, 0, len(payload.RoleIDs))
+	seen := make(map[string]struct{}, len(payload.RoleIDs))
+	for _, roleId := range payload.RoleIDs {
+		if _, exists := seen[roleId]; exists {
+			continue
+		}
+		seen[roleId] = struct{}{}
+		roleIds = append(roleIds, roleId)
+	}
+
+	if len(roleIds) > 0 {
+		roles, err := s.Repo.GetRolesByIds(ctx, roleIds)
+		if err != nil {
+			return domain.UserRolesResponse{}, err
+		}
+		if len(roles) != len(roleIds) {
+			return domain.UserRolesResponse{}, domain.ErrNotFoundWithKey(utils.ErrRoleNotFoundKey)
+		}
+	}
+
+	previousRoles, err := s.Repo.GetUserRoles(ctx, userId)
+	if err != nil {
+		return domain.UserRolesResponse{}, err
+	}
+
+	var userRoles domain.UserRolesResponse
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.SetUserRoles(ctx, userId, roleIds); err != nil {
+			return err
+		}
+
+		var err error
+		userRoles, err = s.GetUserRoles(ctx, userId)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityUser, userId,
+			map[string]any{"roles": mapper.RolesToResponses(previousRoles)},
+			map[string]any{"roles": userRoles.Roles},
+		)
+	})
+	if err != nil {
+		return domain.UserRolesResponse{}, err
+	}
+
+	return userRoles, nil
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetRoles(ctx context.Context) ([]domain.RoleResponse, error) {
+	roles, err := s.Repo.GetRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.RolesToResponses(roles), nil
+}
+
+func (s *Service) GetRoleById(ctx context.Context, roleId string) (domain.RoleResponse, error) {
+	role, err := s.Repo.GetRoleById(ctx, roleId)
+	if err != nil {
+		return domain.RoleResponse{}, err
+	}
+	return mapper.RoleToResponse(&role), nil
+}
+
+func (s *Service) GetPermissions(ctx context.Context) []domain.Permission {
+	return domain.AllPermissions
+}
+
+func (s *Service) GetUserRoles(ctx context.Context, userId string) (domain.UserRolesResponse, error) {
+	user, err := s.UserRepo.GetUserById(ctx, userId)
+	if err != nil {
+		return domain.UserRolesResponse{}, err
+	}
+
+	roles, err := s.Repo.GetUserRoles(ctx, userId)
+	if err != nil {
+		return domain.UserRolesResponse{}, err
+	}
+
+	permissions, err := s.Repo.GetUserPermissions(ctx, userId)
+	if err != nil {
+		return domain.UserRolesResponse{}, err
+	}
+
+	return domain.UserRolesResponse{
+		UserID:      userId,
+		BaseRole:    user.Role,
+		Roles:       mapper.RolesToResponses(roles),
+		Permissions: permissions,
+	}, nil
+}
+
+func (s *Service) GetUserPermissions(ctx context.Context, userId string) ([]string, error) {
+	return s.Repo.GetUserPermissions(ctx, userId)
+}
+
+// *===========================HELPER METHODS===========================*
+func validatePermissions(permissions []domain.Permission) error {
+	for _, permission := range permissions {
+		if !permission.IsValid() {
+			return domain.ErrBadRequestWithKey(utils.ErrRolePermissionInvalidKey, string(permission))
+		}
+	}
+	return nil
+}
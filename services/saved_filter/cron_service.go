@@ -0,0 +1,114 @@
+package saved_filter
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/robfig/cron/v3"
+)
+
+// dueReportBatchSize limits how many schedules are claimed per tick, sisanya diambil di tick berikutnya
+const dueReportBatchSize = 50
+
+// ScheduleRepository defines the report schedule operations used by the scheduler
+type ScheduleRepository interface {
+	ClaimDueReportSchedules(ctx context.Context, now time.Time, limit int) ([]domain.ReportSchedule, error)
+	AdvanceReportSchedule(ctx context.Context, scheduleId string, nextRunAt *time.Time, lastRunAt time.Time) error
+	CreateReportRun(ctx context.Context, payload *domain.ReportRun) (domain.ReportRun, error)
+}
+
+// CronService queues the report deliveries of due schedules
+type CronService struct {
+	cron         *cron.Cron
+	scheduleRepo ScheduleRepository
+	jobQueue     JobQueue
+}
+
+// NewCronService creates a new report schedule cron service instance
+func NewCronService(scheduleRepo ScheduleRepository, jobQueue JobQueue) *CronService {
+	// * Skip kalau tick sebelumnya belum selesai
+	c := cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DefaultLogger)))
+
+	return &CronService{
+		cron:         c,
+		scheduleRepo: scheduleRepo,
+		jobQueue:     jobQueue,
+	}
+}
+
+// Start begins all scheduled cron jobs
+func (cs *CronService) Start() error {
+	// Check due report schedules every minute
+	_, err := cs.cron.AddFunc("0 * * * * *", cs.queueDueReports)
+	if err != nil {
+		return err
+	}
+
+	cs.cron.Start()
+	log.Println("Report schedule cron service started successfully")
+	return nil
+}
+
+// Stop gracefully stops all cron jobs
+func (cs *CronService) Stop() {
+	ctx := cs.cron.Stop()
+	<-ctx.Done()
+	log.Println("Report schedule cron service stopped")
+}
+
+// queueDueReports claims due schedules, advances them and queues one delivery job each in the same transaction,
+// jadi beberapa instance API tidak membuat run ganda untuk jadwal yang sama
+func (cs *CronService) queueDueReports() {
+	ctx := context.Background()
+	now := time.Now()
+	queued := 0
+
+	err := cs.jobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		schedules, err := cs.scheduleRepo.ClaimDueReportSchedules(ctx, now, dueReportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, schedule := range schedules {
+			// * Jadwal yang tidak bisa diparse lagi dihentikan, bukan dicoba terus tiap menit
+			var nextRunAt *time.Time
+			if next, ok := nextReportRun(&schedule, now); ok {
+				nextRunAt = &next
+			} else {
+				log.Printf("Report schedule %s has no next run, deactivating", schedule.ID)
+			}
+
+			if err := cs.scheduleRepo.AdvanceReportSchedule(ctx, schedule.ID, nextRunAt, now); err != nil {
+				return err
+			}
+
+			run, err := cs.scheduleRepo.CreateReportRun(ctx, &domain.ReportRun{
+				SavedFilterID: schedule.SavedFilterID,
+				Trigger:       domain.ReportRunTriggerScheduled,
+				Status:        domain.ReportRunStatusPending,
+				Format:        schedule.Format,
+				Recipients:    schedule.Recipients,
+				LangCode:      schedule.LangCode,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := cs.jobQueue.Enqueue(ctx, domain.JobTypeReportDelivery, reportDeliveryJob{RunID: run.ID}); err != nil {
+				return err
+			}
+			queued++
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Error queueing due reports: %v", err)
+		return
+	}
+
+	if queued > 0 {
+		log.Printf("Queued %d scheduled reports", queued)
+	}
+}
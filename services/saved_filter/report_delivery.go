@@ -0,0 +1,247 @@
+package saved_filter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/smtp"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+	"github.com/Rizz404/inventory-api/internal/notification/messages"
+	"github.com/Rizz404/inventory-api/internal/web"
+	jobQueue "github.com/Rizz404/inventory-api/services/job"
+)
+
+type reportDeliveryJob struct {
+	RunID string `json:"runId"`
+}
+
+// *===========================JOB HANDLERS===========================*
+
+// HandleDeliveryJob generates the report of a run, stores it and emails it to the recipients.
+// Error sementara (DB, storage, SMTP) membuat job di-retry, file yang sudah ter-upload dipakai ulang dan
+// penerima yang sudah menerima email tidak dikirimi lagi di attempt berikutnya
+func (s *Service) HandleDeliveryJob(ctx context.Context, job *domain.Job) error {
+	var payload reportDeliveryJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	run, err := s.Repo.GetReportRunById(ctx, payload.RunID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			log.Printf("Report run %s no longer exists, skipping delivery", payload.RunID)
+			return nil
+		}
+		return err
+	}
+	if run.Status == domain.ReportRunStatusSucceeded {
+		return nil
+	}
+
+	savedFilter, err := s.Repo.GetSavedFilterById(ctx, run.SavedFilterID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err := s.Repo.StartReportRun(ctx, run.ID); err != nil {
+		return err
+	}
+
+	if err := s.deliverReport(ctx, &savedFilter, &run); err != nil {
+		errMsg := domain.ErrorMessageWithCause(err, run.LangCode)
+		if finishErr := s.Repo.FinishReportRun(ctx, run.ID, domain.ReportRunStatusFailed, &errMsg); finishErr != nil {
+			log.Printf("Failed to mark report run %s as failed: %v", run.ID, finishErr)
+		}
+
+		// * Error permanen tidak di-retry, run sudah ditandai failed
+		if jobQueue.IsPermanent(err) {
+			log.Printf("Report run %s failed permanently: %s", run.ID, errMsg)
+			return nil
+		}
+		return err
+	}
+
+	return s.Repo.FinishReportRun(ctx, run.ID, domain.ReportRunStatusSucceeded, nil)
+}
+
+// deliverReport runs the export as the owner of the saved filter, jadi permission dan scope lokasi sama dengan saat owner export sendiri
+func (s *Service) deliverReport(ctx context.Context, savedFilter *domain.SavedFilter, run *domain.ReportRun) error {
+	owner, err := s.UserRepo.GetUserById(ctx, savedFilter.UserID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return jobQueue.Permanent(err)
+		}
+		return err
+	}
+	if !owner.IsActive {
+		return jobQueue.Permanent(fmt.Errorf("owner %s of saved filter is inactive", owner.ID))
+	}
+
+	if err := s.checkExportPermission(ctx, owner.ID, savedFilter.EntityType); err != nil {
+		if domain.IsClientError(err) {
+			return jobQueue.Permanent(err)
+		}
+		return err
+	}
+
+	if len(run.Recipients) > 0 && !s.SMTPClient.IsEnabled() {
+		return jobQueue.Permanent(errors.New("smtp is not configured"))
+	}
+
+	ctx, err = web.WithRequestUserScope(ctx, owner.ID, s.RoleService.GetUserPermissions, s.LocationService.GetUserLocationIds)
+	if err != nil {
+		return err
+	}
+
+	file, err := s.reportFile(ctx, savedFilter, run)
+	if err != nil {
+		return err
+	}
+
+	pendingRecipients := make([]string, 0, len(run.Recipients))
+	for _, recipient := range run.Recipients {
+		if !slices.Contains(run.DeliveredRecipients, recipient) {
+			pendingRecipients = append(pendingRecipients, recipient)
+		}
+	}
+	if len(pendingRecipients) == 0 {
+		return nil
+	}
+
+	email := s.buildReportEmail(savedFilter, run, &owner)
+	attachment := smtp.EmailAttachment{
+		FileName:    file.FileName,
+		ContentType: file.ContentType,
+		Data:        file.Data,
+	}
+
+	// * Satu penerima gagal tidak menghentikan penerima lain, retry hanya mengirim ke yang gagal
+	var sendErrs []error
+	for _, recipient := range pendingRecipients {
+		if err := s.SMTPClient.SendReportEmail(ctx, recipient, email, attachment); err != nil {
+			sendErrs = append(sendErrs, fmt.Errorf("failed to send report to %s: %w", recipient, err))
+			continue
+		}
+		if err := s.Repo.AddReportRunDeliveredRecipient(ctx, run.ID, recipient); err != nil {
+			return err
+		}
+	}
+
+	return errors.Join(sendErrs...)
+}
+
+// reportFile reuses the file stored by a previous attempt, kalau belum ada export ulang lalu simpan ke storage
+func (s *Service) reportFile(ctx context.Context, savedFilter *domain.SavedFilter, run *domain.ReportRun) (domain.ReportRunFile, error) {
+	if s.FileStorage != nil && run.FileURL != nil && *run.FileURL != "" {
+		data, err := storage.ReadFile(ctx, s.FileStorage, *run.FileURL)
+		if err == nil {
+			fileName := "report" + exportFileExtension(run.Format)
+			if run.FileName != nil {
+				fileName = *run.FileName
+			}
+			return domain.ReportRunFile{FileName: fileName, ContentType: exportContentType(run.Format), Data: data}, nil
+		}
+		log.Printf("Failed to read stored file of report run %s, regenerating: %v", run.ID, err)
+	}
+
+	data, fileName, err := s.runExport(ctx, savedFilter, run.Format, run.LangCode)
+	if err != nil {
+		if domain.IsClientError(err) {
+			return domain.ReportRunFile{}, jobQueue.Permanent(err)
+		}
+		return domain.ReportRunFile{}, err
+	}
+
+	// * Tanpa storage file tetap dikirim lewat email, hanya tidak bisa di-download dari riwayat
+	if s.FileStorage != nil {
+		uploadResult, err := storage.UploadBytes(ctx, s.FileStorage, fileName, data, storage.GetReportUploadConfig())
+		if err != nil {
+			return domain.ReportRunFile{}, err
+		}
+		if err := s.Repo.SetReportRunFile(ctx, run.ID, fileName, uploadResult.SecureURL, uploadResult.PublicID, int64(len(data))); err != nil {
+			return domain.ReportRunFile{}, err
+		}
+	}
+
+	return domain.ReportRunFile{FileName: fileName, ContentType: exportContentType(run.Format), Data: data}, nil
+}
+
+// runExport calls the export list function of the saved filter entity with the stored search, filters and sort
+func (s *Service) runExport(ctx context.Context, savedFilter *domain.SavedFilter, format domain.ExportFormat, langCode string) ([]byte, string, error) {
+	payload, err := decodeExportPayload(savedFilter, format)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch p := payload.(type) {
+	case *domain.ExportAssetListPayload:
+		return s.Exporters.Asset.ExportAssetList(ctx, p, langCode)
+	case *domain.ExportAssetMovementListPayload:
+		return s.Exporters.AssetMovement.ExportAssetMovementList(ctx, *p, domain.AssetMovementParams{}, langCode)
+	case *domain.ExportIssueReportListPayload:
+		return s.Exporters.IssueReport.ExportIssueReportList(ctx, *p, domain.IssueReportParams{}, langCode)
+	case *domain.ExportMaintenanceScheduleListPayload:
+		return s.Exporters.MaintenanceSchedule.ExportMaintenanceScheduleList(ctx, *p, domain.MaintenanceScheduleParams{}, langCode)
+	case *domain.ExportMaintenanceRecordListPayload:
+		return s.Exporters.MaintenanceRecord.ExportMaintenanceRecordList(ctx, *p, domain.MaintenanceRecordParams{}, langCode)
+	case *domain.ExportScanLogListPayload:
+		return s.Exporters.ScanLog.ExportScanLogList(ctx, *p, domain.ScanLogParams{}, langCode)
+	case *domain.ExportUserListPayload:
+		return s.Exporters.User.ExportUserList(ctx, *p, domain.UserParams{}, langCode)
+	default:
+		return nil, "", fmt.Errorf("unsupported export payload %T", payload)
+	}
+}
+
+func (s *Service) buildReportEmail(savedFilter *domain.SavedFilter, run *domain.ReportRun, owner *domain.User) *smtp.ReportEmail {
+	langCode := run.LangCode
+	nameParams := map[string]string{"name": savedFilter.Name}
+
+	generatedAt := time.Now()
+	if savedFilter.Schedule != nil {
+		if location, err := time.LoadLocation(savedFilter.Schedule.Timezone); err == nil {
+			generatedAt = generatedAt.In(location)
+		}
+	}
+
+	return &smtp.ReportEmail{
+		LangCode: langCode,
+		Subject:  messages.GetReportEmailMessage(messages.ReportEmailSubjectKey, langCode, nameParams),
+		Greeting: messages.GetReportEmailMessage(messages.ReportEmailGreetingKey, langCode, nil),
+		Intro:    messages.GetReportEmailMessage(messages.ReportEmailIntroKey, langCode, nil),
+		Details: []smtp.ReportEmailDetail{
+			{Label: messages.GetReportEmailMessage(messages.ReportEmailReportLabelKey, langCode, nil), Value: savedFilter.Name},
+			{Label: messages.GetReportEmailMessage(messages.ReportEmailDataLabelKey, langCode, nil), Value: messages.GetReportEmailMessage(reportEntityKey(savedFilter.EntityType), langCode, nil)},
+			{Label: messages.GetReportEmailMessage(messages.ReportEmailGeneratedAtLabelKey, langCode, nil), Value: generatedAt.Format("2006-01-02 15:04 MST")},
+			{Label: messages.GetReportEmailMessage(messages.ReportEmailOwnerLabelKey, langCode, nil), Value: owner.FullName},
+		},
+		Footer: messages.GetReportEmailMessage(messages.ReportEmailFooterKey, langCode, map[string]string{"owner": owner.FullName}),
+	}
+}
+
+func reportEntityKey(entityType domain.SavedFilterEntityType) messages.NotificationMessageKey {
+	switch entityType {
+	case domain.SavedFilterEntityAssetMovement:
+		return messages.ReportEntityAssetMovementKey
+	case domain.SavedFilterEntityIssueReport:
+		return messages.ReportEntityIssueReportKey
+	case domain.SavedFilterEntityMaintenanceSchedule:
+		return messages.ReportEntityMaintenanceScheduleKey
+	case domain.SavedFilterEntityMaintenanceRecord:
+		return messages.ReportEntityMaintenanceRecordKey
+	case domain.SavedFilterEntityScanLog:
+		return messages.ReportEntityScanLogKey
+	case domain.SavedFilterEntityUser:
+		return messages.ReportEntityUserKey
+	default:
+		return messages.ReportEntityAssetKey
+	}
+}
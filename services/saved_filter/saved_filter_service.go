@@ -0,0 +1,621 @@
+package saved_filter
+
+import (
+	"context"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/client/smtp"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/robfig/cron/v3"
+)
+
+// * Repository interface defines the contract for saved filter data operations
+type Repository interface {
+	// * MUTATION
+	CreateSavedFilter(ctx context.Context, payload *domain.SavedFilter) (domain.SavedFilter, error)
+	UpdateSavedFilter(ctx context.Context, savedFilterId string, payload *domain.UpdateSavedFilterPayload) (domain.SavedFilter, error)
+	DeleteSavedFilter(ctx context.Context, savedFilterId string) error
+	UpsertReportSchedule(ctx context.Context, payload *domain.ReportSchedule) (domain.ReportSchedule, error)
+	DeleteReportSchedule(ctx context.Context, savedFilterId string) error
+	CreateReportRun(ctx context.Context, payload *domain.ReportRun) (domain.ReportRun, error)
+	StartReportRun(ctx context.Context, runId string) error
+	SetReportRunFile(ctx context.Context, runId string, fileName string, fileURL string, publicID string, fileSize int64) error
+	AddReportRunDeliveredRecipient(ctx context.Context, runId string, recipient string) error
+	FinishReportRun(ctx context.Context, runId string, status domain.ReportRunStatus, errMsg *string) error
+
+	// * QUERY
+	GetSavedFilters(ctx context.Context, userId string, filters *domain.SavedFilterFilterOptions) ([]domain.SavedFilter, error)
+	GetSavedFilterById(ctx context.Context, savedFilterId string) (domain.SavedFilter, error)
+	CheckSavedFilterNameExists(ctx context.Context, userId string, entityType domain.SavedFilterEntityType, name string) (bool, error)
+	CheckSavedFilterNameExistsExcluding(ctx context.Context, userId string, entityType domain.SavedFilterEntityType, name string, excludeSavedFilterId string) (bool, error)
+	GetReportRunsCursor(ctx context.Context, savedFilterId string, params domain.ReportRunParams) ([]domain.ReportRun, error)
+	GetReportRunById(ctx context.Context, runId string) (domain.ReportRun, error)
+	GetReportRunPublicIds(ctx context.Context, savedFilterId string) ([]string, error)
+}
+
+// * SavedFilterService interface defines the contract for saved filter and report schedule business operations
+type SavedFilterService interface {
+	// * MUTATION
+	CreateSavedFilter(ctx context.Context, userId string, payload *domain.CreateSavedFilterPayload) (domain.SavedFilterResponse, error)
+	UpdateSavedFilter(ctx context.Context, userId string, savedFilterId string, payload *domain.UpdateSavedFilterPayload) (domain.SavedFilterResponse, error)
+	DeleteSavedFilter(ctx context.Context, userId string, savedFilterId string) error
+	UpsertReportSchedule(ctx context.Context, userId string, savedFilterId string, payload *domain.UpsertReportSchedulePayload) (domain.SavedFilterResponse, error)
+	DeleteReportSchedule(ctx context.Context, userId string, savedFilterId string) error
+	RunSavedFilter(ctx context.Context, userId string, savedFilterId string, payload *domain.RunSavedFilterPayload) (domain.ReportRunResponse, error)
+	HandleDeliveryJob(ctx context.Context, job *domain.Job) error
+
+	// * QUERY
+	GetSavedFilters(ctx context.Context, userId string, filters *domain.SavedFilterFilterOptions) ([]domain.SavedFilterResponse, error)
+	GetSavedFilterById(ctx context.Context, userId string, savedFilterId string) (domain.SavedFilterResponse, error)
+	GetReportRunsCursor(ctx context.Context, userId string, savedFilterId string, params domain.ReportRunParams) ([]domain.ReportRunResponse, error)
+	GetReportRunById(ctx context.Context, userId string, savedFilterId string, runId string) (domain.ReportRunResponse, error)
+	DownloadReportRun(ctx context.Context, userId string, savedFilterId string, runId string) (domain.ReportRunFile, error)
+}
+
+// * UserRepository interface for loading the owner of a saved filter
+type UserRepository interface {
+	GetUserById(ctx context.Context, userId string) (domain.User, error)
+}
+
+// * RoleService interface for checking the export permission of the owner
+type RoleService interface {
+	GetUserPermissions(ctx context.Context, userId string) ([]string, error)
+}
+
+// * LocationService interface for applying the owner's location scope when the report runs in the background
+type LocationService interface {
+	GetUserLocationIds(ctx context.Context, userId string) ([]string, error)
+}
+
+// * JobQueue interface for queueing report deliveries
+type JobQueue interface {
+	Enqueue(ctx context.Context, jobType domain.JobType, payload any) error
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// * Export list function per entity, sama dengan yang dipanggil endpoint POST /<entity>/export/list
+type AssetExporter interface {
+	ExportAssetList(ctx context.Context, payload *domain.ExportAssetListPayload, langCode string) ([]byte, string, error)
+}
+
+type AssetMovementExporter interface {
+	ExportAssetMovementList(ctx context.Context, payload domain.ExportAssetMovementListPayload, params domain.AssetMovementParams, langCode string) ([]byte, string, error)
+}
+
+type IssueReportExporter interface {
+	ExportIssueReportList(ctx context.Context, payload domain.ExportIssueReportListPayload, params domain.IssueReportParams, langCode string) ([]byte, string, error)
+}
+
+type MaintenanceScheduleExporter interface {
+	ExportMaintenanceScheduleList(ctx context.Context, payload domain.ExportMaintenanceScheduleListPayload, params domain.MaintenanceScheduleParams, langCode string) ([]byte, string, error)
+}
+
+type MaintenanceRecordExporter interface {
+	ExportMaintenanceRecordList(ctx context.Context, payload domain.ExportMaintenanceRecordListPayload, params domain.MaintenanceRecordParams, langCode string) ([]byte, string, error)
+}
+
+type ScanLogExporter interface {
+	ExportScanLogList(ctx context.Context, payload domain.ExportScanLogListPayload, params domain.ScanLogParams, langCode string) ([]byte, string, error)
+}
+
+type UserExporter interface {
+	ExportUserList(ctx context.Context, payload domain.ExportUserListPayload, params domain.UserParams, langCode string) ([]byte, string, error)
+}
+
+// Exporters groups the export list functions a saved filter can run
+type Exporters struct {
+	Asset               AssetExporter
+	AssetMovement       AssetMovementExporter
+	IssueReport         IssueReportExporter
+	MaintenanceSchedule MaintenanceScheduleExporter
+	MaintenanceRecord   MaintenanceRecordExporter
+	ScanLog             ScanLogExporter
+	User                UserExporter
+}
+
+type Service struct {
+	Repo            Repository
+	UserRepo        UserRepository
+	RoleService     RoleService
+	LocationService LocationService
+	Exporters       Exporters
+	FileStorage     storage.Storage
+	SMTPClient      *smtp.Client
+	JobQueue        JobQueue
+}
+
+// * Ensure Service implements SavedFilterService interface
+var _ SavedFilterService = (*Service)(nil)
+
+func NewService(
+	r Repository,
+	userRepo UserRepository,
+	roleService RoleService,
+	locationService LocationService,
+	exporters Exporters,
+	fileStorage storage.Storage,
+	smtpClient *smtp.Client,
+	jobQueue JobQueue,
+) SavedFilterService {
+	return &Service{
+		Repo:            r,
+		UserRepo:        userRepo,
+		RoleService:     roleService,
+		LocationService: locationService,
+		Exporters:       exporters,
+		FileStorage:     fileStorage,
+		SMTPClient:      smtpClient,
+		JobQueue:        jobQueue,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (s *Service) CreateSavedFilter(ctx context.Context, userId string, payload *domain.CreateSavedFilterPayload) (domain.SavedFilterResponse, error) {
+	if !payload.EntityType.IsValid() {
+		return domain.SavedFilterResponse{}, domain.ErrBadRequestWithKey(utils.ErrSavedFilterEntityTypeInvalidKey, string(payload.EntityType))
+	}
+
+	name := strings.TrimSpace(payload.Name)
+	if nameExists, err := s.Repo.CheckSavedFilterNameExists(ctx, userId, payload.EntityType, name); err != nil {
+		return domain.SavedFilterResponse{}, err
+	} else if nameExists {
+		return domain.SavedFilterResponse{}, domain.ErrConflictWithKey(utils.ErrSavedFilterNameExistsKey)
+	}
+
+	newFilter := domain.SavedFilter{
+		UserID:      userId,
+		EntityType:  payload.EntityType,
+		Name:        name,
+		SearchQuery: normalizeSearchQuery(payload.SearchQuery),
+		Filters:     payload.Filters,
+		Sort:        payload.Sort,
+	}
+	if _, err := decodeExportPayload(&newFilter, domain.ExportFormatPDF); err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	createdFilter, err := s.Repo.CreateSavedFilter(ctx, &newFilter)
+	if err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	return mapper.SavedFilterToResponse(&createdFilter), nil
+}
+
+func (s *Service) UpdateSavedFilter(ctx context.Context, userId string, savedFilterId string, payload *domain.UpdateSavedFilterPayload) (domain.SavedFilterResponse, error) {
+	existingFilter, err := s.getOwnedSavedFilter(ctx, userId, savedFilterId)
+	if err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	if payload.Name != nil {
+		name := strings.TrimSpace(*payload.Name)
+		payload.Name = &name
+
+		if name != existingFilter.Name {
+			if nameExists, err := s.Repo.CheckSavedFilterNameExistsExcluding(ctx, userId, existingFilter.EntityType, name, savedFilterId); err != nil {
+				return domain.SavedFilterResponse{}, err
+			} else if nameExists {
+				return domain.SavedFilterResponse{}, domain.ErrConflictWithKey(utils.ErrSavedFilterNameExistsKey)
+			}
+		}
+	}
+
+	// * Validasi hasil akhir filter, field yang tidak dikirim tetap memakai nilai lama
+	updatedFilter := existingFilter
+	if payload.SearchQuery != nil {
+		updatedFilter.SearchQuery = normalizeSearchQuery(payload.SearchQuery)
+	}
+	if payload.Filters != nil {
+		updatedFilter.Filters = payload.Filters
+	}
+	if payload.Sort != nil {
+		updatedFilter.Sort = payload.Sort
+	}
+	if _, err := decodeExportPayload(&updatedFilter, domain.ExportFormatPDF); err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	savedFilter, err := s.Repo.UpdateSavedFilter(ctx, savedFilterId, payload)
+	if err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	return mapper.SavedFilterToResponse(&savedFilter), nil
+}
+
+// DeleteSavedFilter removes the filter with its schedule and run history, file report yang tersimpan ikut dihapus
+func (s *Service) DeleteSavedFilter(ctx context.Context, userId string, savedFilterId string) error {
+	if _, err := s.getOwnedSavedFilter(ctx, userId, savedFilterId); err != nil {
+		return err
+	}
+
+	publicIds, err := s.Repo.GetReportRunPublicIds(ctx, savedFilterId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Repo.DeleteSavedFilter(ctx, savedFilterId); err != nil {
+		return err
+	}
+
+	// * Gagal hapus file cukup di-log, datanya sudah terhapus
+	if s.FileStorage != nil && len(publicIds) > 0 {
+		if _, failedIds, err := s.FileStorage.DeleteMultipleFiles(ctx, publicIds); err != nil {
+			log.Printf("Failed to delete report files of saved filter %s: %v", savedFilterId, err)
+		} else if len(failedIds) > 0 {
+			log.Printf("Failed to delete %d report files of saved filter %s: %v", len(failedIds), savedFilterId, failedIds)
+		}
+	}
+
+	return nil
+}
+
+// UpsertReportSchedule creates or replaces the delivery schedule of a saved filter
+func (s *Service) UpsertReportSchedule(ctx context.Context, userId string, savedFilterId string, payload *domain.UpsertReportSchedulePayload) (domain.SavedFilterResponse, error) {
+	savedFilter, err := s.getOwnedSavedFilter(ctx, userId, savedFilterId)
+	if err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	if !s.SMTPClient.IsEnabled() {
+		return domain.SavedFilterResponse{}, domain.ErrBadRequestWithKey(utils.ErrReportEmailNotConfiguredKey)
+	}
+
+	if err := s.checkExportPermission(ctx, userId, savedFilter.EntityType); err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	timezone := defaultReportTimezone()
+	if payload.Timezone != nil && strings.TrimSpace(*payload.Timezone) != "" {
+		timezone = strings.TrimSpace(*payload.Timezone)
+	}
+
+	cronExpression := strings.TrimSpace(payload.CronExpression)
+	nextRunAt, err := validateReportSchedule(cronExpression, timezone, time.Now())
+	if err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	langCode, err := s.resolveLangCode(ctx, userId, payload.LangCode)
+	if err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	schedule := domain.ReportSchedule{
+		SavedFilterID:  savedFilterId,
+		CronExpression: cronExpression,
+		Timezone:       timezone,
+		Format:         payload.Format,
+		Recipients:     normalizeRecipients(payload.Recipients),
+		LangCode:       langCode,
+		IsActive:       payload.IsActive == nil || *payload.IsActive,
+	}
+	if schedule.IsActive {
+		schedule.NextRunAt = &nextRunAt
+	}
+
+	if _, err := s.Repo.UpsertReportSchedule(ctx, &schedule); err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	return s.GetSavedFilterById(ctx, userId, savedFilterId)
+}
+
+func (s *Service) DeleteReportSchedule(ctx context.Context, userId string, savedFilterId string) error {
+	if _, err := s.getOwnedSavedFilter(ctx, userId, savedFilterId); err != nil {
+		return err
+	}
+
+	return s.Repo.DeleteReportSchedule(ctx, savedFilterId)
+}
+
+// RunSavedFilter queues a manual run, tanpa penerima file hanya disimpan di riwayat untuk di-download
+func (s *Service) RunSavedFilter(ctx context.Context, userId string, savedFilterId string, payload *domain.RunSavedFilterPayload) (domain.ReportRunResponse, error) {
+	savedFilter, err := s.getOwnedSavedFilter(ctx, userId, savedFilterId)
+	if err != nil {
+		return domain.ReportRunResponse{}, err
+	}
+
+	run := domain.ReportRun{
+		SavedFilterID: savedFilterId,
+		Trigger:       domain.ReportRunTriggerManual,
+		Status:        domain.ReportRunStatusPending,
+		Recipients:    []string{},
+		CreatedBy:     &userId,
+	}
+
+	if savedFilter.Schedule != nil {
+		run.Format = savedFilter.Schedule.Format
+		run.Recipients = savedFilter.Schedule.Recipients
+		run.LangCode = savedFilter.Schedule.LangCode
+	}
+	if payload.Format != nil {
+		run.Format = *payload.Format
+	}
+	if payload.Recipients != nil {
+		run.Recipients = normalizeRecipients(payload.Recipients)
+	}
+	if payload.LangCode != nil || run.LangCode == "" {
+		if run.LangCode, err = s.resolveLangCode(ctx, userId, payload.LangCode); err != nil {
+			return domain.ReportRunResponse{}, err
+		}
+	}
+
+	if run.Format == "" {
+		return domain.ReportRunResponse{}, domain.ErrBadRequestWithKey(utils.ErrReportRunFormatRequiredKey)
+	}
+	if len(run.Recipients) > 0 && !s.SMTPClient.IsEnabled() {
+		return domain.ReportRunResponse{}, domain.ErrBadRequestWithKey(utils.ErrReportEmailNotConfiguredKey)
+	}
+	if len(run.Recipients) == 0 && s.FileStorage == nil {
+		return domain.ReportRunResponse{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
+	}
+
+	if err := s.checkExportPermission(ctx, userId, savedFilter.EntityType); err != nil {
+		return domain.ReportRunResponse{}, err
+	}
+
+	var createdRun domain.ReportRun
+	err = s.JobQueue.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if createdRun, err = s.Repo.CreateReportRun(ctx, &run); err != nil {
+			return err
+		}
+		return s.JobQueue.Enqueue(ctx, domain.JobTypeReportDelivery, reportDeliveryJob{RunID: createdRun.ID})
+	})
+	if err != nil {
+		return domain.ReportRunResponse{}, err
+	}
+
+	return mapper.ReportRunToResponse(&createdRun), nil
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetSavedFilters(ctx context.Context, userId string, filters *domain.SavedFilterFilterOptions) ([]domain.SavedFilterResponse, error) {
+	if filters != nil && filters.EntityType != nil && !filters.EntityType.IsValid() {
+		return nil, domain.ErrBadRequestWithKey(utils.ErrSavedFilterEntityTypeInvalidKey, string(*filters.EntityType))
+	}
+
+	savedFilters, err := s.Repo.GetSavedFilters(ctx, userId, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.SavedFiltersToResponses(savedFilters), nil
+}
+
+func (s *Service) GetSavedFilterById(ctx context.Context, userId string, savedFilterId string) (domain.SavedFilterResponse, error) {
+	savedFilter, err := s.getOwnedSavedFilter(ctx, userId, savedFilterId)
+	if err != nil {
+		return domain.SavedFilterResponse{}, err
+	}
+
+	return mapper.SavedFilterToResponse(&savedFilter), nil
+}
+
+func (s *Service) GetReportRunsCursor(ctx context.Context, userId string, savedFilterId string, params domain.ReportRunParams) ([]domain.ReportRunResponse, error) {
+	if _, err := s.getOwnedSavedFilter(ctx, userId, savedFilterId); err != nil {
+		return nil, err
+	}
+
+	runs, err := s.Repo.GetReportRunsCursor(ctx, savedFilterId, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.ReportRunsToResponses(runs), nil
+}
+
+func (s *Service) GetReportRunById(ctx context.Context, userId string, savedFilterId string, runId string) (domain.ReportRunResponse, error) {
+	run, err := s.getOwnedReportRun(ctx, userId, savedFilterId, runId)
+	if err != nil {
+		return domain.ReportRunResponse{}, err
+	}
+
+	return mapper.ReportRunToResponse(&run), nil
+}
+
+func (s *Service) DownloadReportRun(ctx context.Context, userId string, savedFilterId string, runId string) (domain.ReportRunFile, error) {
+	run, err := s.getOwnedReportRun(ctx, userId, savedFilterId, runId)
+	if err != nil {
+		return domain.ReportRunFile{}, err
+	}
+	if run.FileURL == nil || *run.FileURL == "" {
+		return domain.ReportRunFile{}, domain.ErrNotFoundWithKey(utils.ErrReportRunFileNotFoundKey)
+	}
+
+	if s.FileStorage == nil {
+		return domain.ReportRunFile{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
+	}
+
+	data, err := storage.ReadFile(ctx, s.FileStorage, *run.FileURL)
+	if err != nil {
+		return domain.ReportRunFile{}, domain.ErrInternal(err)
+	}
+
+	fileName := "report" + exportFileExtension(run.Format)
+	if run.FileName != nil && *run.FileName != "" {
+		fileName = *run.FileName
+	}
+
+	return domain.ReportRunFile{
+		FileName:    fileName,
+		ContentType: exportContentType(run.Format),
+		Data:        data,
+	}, nil
+}
+
+// *===========================HELPER METHODS===========================*
+// getOwnedSavedFilter returns the saved filter only to its owner, filter milik user lain dianggap tidak ada
+func (s *Service) getOwnedSavedFilter(ctx context.Context, userId string, savedFilterId string) (domain.SavedFilter, error) {
+	savedFilter, err := s.Repo.GetSavedFilterById(ctx, savedFilterId)
+	if err != nil {
+		return domain.SavedFilter{}, err
+	}
+	if savedFilter.UserID != userId {
+		return domain.SavedFilter{}, domain.ErrNotFound("saved filter")
+	}
+	return savedFilter, nil
+}
+
+// getOwnedReportRun returns the run only when it belongs to the owner's saved filter in the route
+func (s *Service) getOwnedReportRun(ctx context.Context, userId string, savedFilterId string, runId string) (domain.ReportRun, error) {
+	if _, err := s.getOwnedSavedFilter(ctx, userId, savedFilterId); err != nil {
+		return domain.ReportRun{}, err
+	}
+
+	run, err := s.Repo.GetReportRunById(ctx, runId)
+	if err != nil {
+		return domain.ReportRun{}, err
+	}
+	if run.SavedFilterID != savedFilterId {
+		return domain.ReportRun{}, domain.ErrNotFound("report run")
+	}
+	return run, nil
+}
+
+// checkExportPermission mirrors the permission the entity's export list endpoint requires
+func (s *Service) checkExportPermission(ctx context.Context, userId string, entityType domain.SavedFilterEntityType) error {
+	required, ok := entityType.ExportPermission()
+	if !ok {
+		return nil
+	}
+
+	permissions, err := s.RoleService.GetUserPermissions(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !domain.HasPermission(permissions, required) {
+		return domain.ErrForbiddenWithKey(utils.ErrForbiddenKey)
+	}
+	return nil
+}
+
+// resolveLangCode picks the requested language, fallback ke bahasa pilihan owner
+func (s *Service) resolveLangCode(ctx context.Context, userId string, langCode *string) (string, error) {
+	if langCode != nil && *langCode != "" {
+		return *langCode, nil
+	}
+
+	user, err := s.UserRepo.GetUserById(ctx, userId)
+	if err != nil {
+		return "", err
+	}
+	if user.PreferredLang == "" {
+		return "en-US", nil
+	}
+	return user.PreferredLang, nil
+}
+
+// decodeExportPayload decodes the saved search, filters and sort into the entity's export list payload,
+// field yang tidak dikenal ditolak supaya salah ketik di filters ketahuan saat disimpan, bukan saat report jalan
+func decodeExportPayload(savedFilter *domain.SavedFilter, format domain.ExportFormat) (any, error) {
+	if !savedFilter.EntityType.IsValid() {
+		return nil, domain.ErrBadRequestWithKey(utils.ErrSavedFilterEntityTypeInvalidKey, string(savedFilter.EntityType))
+	}
+
+	payload, err := savedFilter.EntityType.DecodeExportListPayload(format, savedFilter.SearchQuery, savedFilter.Filters, savedFilter.Sort)
+	if err != nil {
+		return nil, domain.ErrBadRequestWithKey(utils.ErrSavedFilterParamsInvalidKey, err.Error())
+	}
+
+	return payload, nil
+}
+
+// validateReportSchedule parses the cron expression in the schedule timezone and returns the first run
+func validateReportSchedule(cronExpression string, timezone string, now time.Time) (time.Time, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, domain.ErrBadRequestWithKey(utils.ErrReportScheduleTimezoneInvalidKey, timezone)
+	}
+
+	// * Timezone diatur lewat field timezone, prefix CRON_TZ/TZ di expression ditolak supaya tidak ada dua sumber
+	if strings.Contains(cronExpression, "TZ=") {
+		return time.Time{}, domain.ErrBadRequestWithKey(utils.ErrReportScheduleCronInvalidKey)
+	}
+
+	schedule, err := cron.ParseStandard(cronExpression)
+	if err != nil {
+		return time.Time{}, domain.ErrBadRequestWithKey(utils.ErrReportScheduleCronInvalidKey)
+	}
+
+	first := schedule.Next(now.In(location))
+	if first.IsZero() {
+		return time.Time{}, domain.ErrBadRequestWithKey(utils.ErrReportScheduleCronInvalidKey)
+	}
+	if second := schedule.Next(first); !second.IsZero() && second.Sub(first) < domain.MinReportScheduleInterval {
+		return time.Time{}, domain.ErrBadRequestWithKey(utils.ErrReportScheduleTooFrequentKey)
+	}
+
+	return first, nil
+}
+
+// nextReportRun returns the next run after now, ok false kalau jadwal tidak valid lagi atau tidak punya run berikutnya
+func nextReportRun(schedule *domain.ReportSchedule, now time.Time) (time.Time, bool) {
+	location, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	parsed, err := cron.ParseStandard(schedule.CronExpression)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	next := parsed.Next(now.In(location))
+	return next, !next.IsZero()
+}
+
+// defaultReportTimezone follows the server TZ (Asia/Jakarta di image Docker), fallback ke UTC
+func defaultReportTimezone() string {
+	if timezone := os.Getenv("TZ"); timezone != "" {
+		return timezone
+	}
+	return "UTC"
+}
+
+func normalizeSearchQuery(searchQuery *string) *string {
+	if searchQuery == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*searchQuery)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// normalizeRecipients trims and deduplicates the recipient addresses, case-insensitive
+func normalizeRecipients(recipients []string) []string {
+	result := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		recipient = strings.TrimSpace(recipient)
+		if recipient == "" || slices.ContainsFunc(result, func(existing string) bool { return strings.EqualFold(existing, recipient) }) {
+			continue
+		}
+		result = append(result, recipient)
+	}
+	return result
+}
+
+func exportFileExtension(format domain.ExportFormat) string {
+	if format == domain.ExportFormatExcel {
+		return ".xlsx"
+	}
+	return ".pdf"
+}
+
+func exportContentType(format domain.ExportFormat) string {
+	if format == domain.ExportFormatExcel {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "application/pdf"
+}
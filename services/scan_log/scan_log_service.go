@@ -2,6 +2,7 @@ package scan_log
 
 import (
 	"context"
+	"log"
 	"time"
 
 	"github.com/Rizz404/inventory-api/domain"
@@ -28,6 +29,12 @@ type Repository interface {
 	GetScanLogsForExport(ctx context.Context, params domain.ScanLogParams) ([]domain.ScanLog, error)
 }
 
+// * AuditSessionService interface for attaching scans to an open stocktake
+type AuditSessionService interface {
+	ResolveOpenAuditSession(ctx context.Context, auditSessionId *string, assetId *string) (*string, error)
+	RecordAuditSessionScans(ctx context.Context, scanLogs []domain.ScanLog) error
+}
+
 // * ScanLogService interface defines the contract for scan log business operations
 type ScanLogService interface {
 	// * MUTATION
@@ -49,15 +56,17 @@ type ScanLogService interface {
 }
 
 type Service struct {
-	Repo Repository
+	Repo                Repository
+	AuditSessionService AuditSessionService
 }
 
 // * Ensure Service implements ScanLogService interface
 var _ ScanLogService = (*Service)(nil)
 
-func NewService(r Repository) ScanLogService {
+func NewService(r Repository, auditSessionService AuditSessionService) ScanLogService {
 	return &Service{
-		Repo: r,
+		Repo:                r,
+		AuditSessionService: auditSessionService,
 	}
 }
 
@@ -70,13 +79,25 @@ func (s *Service) CreateScanLog(ctx context.Context, payload *domain.CreateScanL
 		ScanMethod:      payload.ScanMethod,
 		ScannedBy:       scannedBy,
 		ScanTimestamp:   time.Now().UTC(),
+		ScanLocationLat: payload.ScanLocationLat,
+		ScanLocationLng: payload.ScanLocationLng,
+		ScanResult:      payload.ScanResult,
 	}
 
+	// * Attach to the open audit session, if any
+	auditSessionId, err := s.AuditSessionService.ResolveOpenAuditSession(ctx, payload.AuditSessionID, payload.AssetID)
+	if err != nil {
+		return domain.ScanLogResponse{}, err
+	}
+	newScanLog.AuditSessionID = auditSessionId
+
 	createdScanLog, err := s.Repo.CreateScanLog(ctx, &newScanLog)
 	if err != nil {
 		return domain.ScanLogResponse{}, err
 	}
 
+	s.recordAuditSessionScans(ctx, []domain.ScanLog{createdScanLog})
+
 	// * Convert to ScanLogResponse using mapper
 	return mapper.ScanLogToResponse(&createdScanLog), nil
 }
@@ -89,6 +110,12 @@ func (s *Service) BulkCreateScanLogs(ctx context.Context, payload *domain.BulkCr
 	// * Build domain scan logs
 	scanLogs := make([]domain.ScanLog, len(payload.ScanLogs))
 	for i, item := range payload.ScanLogs {
+		// * Attach to the open audit session, if any
+		auditSessionId, err := s.AuditSessionService.ResolveOpenAuditSession(ctx, item.AuditSessionID, item.AssetID)
+		if err != nil {
+			return domain.BulkCreateScanLogsResponse{}, err
+		}
+
 		scanLogs[i] = domain.ScanLog{
 			AssetID:         item.AssetID,
 			ScannedValue:    item.ScannedValue,
@@ -98,6 +125,7 @@ func (s *Service) BulkCreateScanLogs(ctx context.Context, payload *domain.BulkCr
 			ScanLocationLat: item.ScanLocationLat,
 			ScanLocationLng: item.ScanLocationLng,
 			ScanResult:      item.ScanResult,
+			AuditSessionID:  auditSessionId,
 		}
 	}
 
@@ -107,6 +135,8 @@ func (s *Service) BulkCreateScanLogs(ctx context.Context, payload *domain.BulkCr
 		return domain.BulkCreateScanLogsResponse{}, err
 	}
 
+	s.recordAuditSessionScans(ctx, created)
+
 	// * Convert to responses
 	response := domain.BulkCreateScanLogsResponse{
 		ScanLogs: mapper.ScanLogsToResponses(created),
@@ -242,3 +272,12 @@ func (s *Service) GetScanLogStatistics(ctx context.Context) (domain.ScanLogStati
 	// Convert to ScanLogStatisticsResponse using mapper
 	return mapper.ScanLogStatisticsToResponse(&stats), nil
 }
+
+// *===========================HELPER METHODS===========================*
+
+// * recordAuditSessionScans reconciles created scans with their audit session, failures never fail the scan itself
+func (s *Service) recordAuditSessionScans(ctx context.Context, scanLogs []domain.ScanLog) {
+	if err := s.AuditSessionService.RecordAuditSessionScans(ctx, scanLogs); err != nil {
+		log.Printf("Failed to record scans for audit session: %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package search
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+// * Repository interface defines the contract for global search data operations
+type Repository interface {
+	// * QUERY
+	SearchAssets(ctx context.Context, searchQuery string, limit int) ([]domain.SearchHit, error)
+	SearchUsers(ctx context.Context, searchQuery string, limit int) ([]domain.SearchHit, error)
+	SearchLocations(ctx context.Context, searchQuery string, langCode string, limit int) ([]domain.SearchHit, error)
+	SearchCategories(ctx context.Context, searchQuery string, langCode string, limit int) ([]domain.SearchHit, error)
+	SearchIssueReports(ctx context.Context, searchQuery string, langCode string, limit int) ([]domain.SearchHit, error)
+	SearchMaintenanceRecords(ctx context.Context, searchQuery string, langCode string, limit int) ([]domain.SearchHit, error)
+}
+
+// * SearchService interface defines the contract for global search business operations
+type SearchService interface {
+	// * QUERY
+	GlobalSearch(ctx context.Context, params domain.GlobalSearchParams, langCode string) (domain.GlobalSearchResponse, error)
+}
+
+type Service struct {
+	Repo Repository
+}
+
+// * Ensure Service implements SearchService interface
+var _ SearchService = (*Service)(nil)
+
+func NewService(r Repository) SearchService {
+	return &Service{
+		Repo: r,
+	}
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GlobalSearch(ctx context.Context, params domain.GlobalSearchParams, langCode string) (domain.GlobalSearchResponse, error) {
+	searchQuery := strings.TrimSpace(params.Query)
+	if searchQuery == "" {
+		return domain.GlobalSearchResponse{}, domain.ErrBadRequestWithKey(utils.ErrSearchQueryRequiredKey)
+	}
+	if utf8.RuneCountInString(searchQuery) < domain.MinSearchQueryLength {
+		return domain.GlobalSearchResponse{}, domain.ErrBadRequestWithKey(utils.ErrSearchQueryTooShortKey, strconv.Itoa(domain.MinSearchQueryLength))
+	}
+
+	types := params.Types
+	if len(types) == 0 {
+		types = domain.SearchEntityTypes
+	}
+	for _, entityType := range types {
+		if !entityType.IsValid() {
+			return domain.GlobalSearchResponse{}, domain.ErrBadRequestWithKey(utils.ErrSearchTypeInvalidKey, string(entityType))
+		}
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = domain.DefaultSearchLimit
+	}
+	if limit > domain.MaxSearchLimit {
+		limit = domain.MaxSearchLimit
+	}
+
+	response := domain.GlobalSearchResponse{
+		Query:              searchQuery,
+		Assets:             []domain.SearchHitResponse{},
+		Users:              []domain.SearchHitResponse{},
+		Locations:          []domain.SearchHitResponse{},
+		Categories:         []domain.SearchHitResponse{},
+		IssueReports:       []domain.SearchHitResponse{},
+		MaintenanceRecords: []domain.SearchHitResponse{},
+	}
+
+	groups := map[domain.SearchEntityType]*[]domain.SearchHitResponse{
+		domain.SearchEntityAsset:             &response.Assets,
+		domain.SearchEntityUser:              &response.Users,
+		domain.SearchEntityLocation:          &response.Locations,
+		domain.SearchEntityCategory:          &response.Categories,
+		domain.SearchEntityIssueReport:       &response.IssueReports,
+		domain.SearchEntityMaintenanceRecord: &response.MaintenanceRecords,
+	}
+
+	for _, entityType := range domain.SearchEntityTypes {
+		if !slices.Contains(types, entityType) {
+			continue
+		}
+
+		hits, err := s.searchGroup(ctx, entityType, searchQuery, langCode, limit)
+		if err != nil {
+			return domain.GlobalSearchResponse{}, err
+		}
+
+		*groups[entityType] = mapper.SearchHitsToResponses(hits)
+		response.Total += len(hits)
+	}
+
+	return response, nil
+}
+
+// *===========================HELPER METHODS===========================*
+func (s *Service) searchGroup(ctx context.Context, entityType domain.SearchEntityType, searchQuery string, langCode string, limit int) ([]domain.SearchHit, error) {
+	switch entityType {
+	case domain.SearchEntityAsset:
+		return s.Repo.SearchAssets(ctx, searchQuery, limit)
+	case domain.SearchEntityUser:
+		return s.Repo.SearchUsers(ctx, searchQuery, limit)
+	case domain.SearchEntityLocation:
+		return s.Repo.SearchLocations(ctx, searchQuery, langCode, limit)
+	case domain.SearchEntityCategory:
+		return s.Repo.SearchCategories(ctx, searchQuery, langCode, limit)
+	case domain.SearchEntityIssueReport:
+		return s.Repo.SearchIssueReports(ctx, searchQuery, langCode, limit)
+	case domain.SearchEntityMaintenanceRecord:
+		return s.Repo.SearchMaintenanceRecords(ctx, searchQuery, langCode, limit)
+	}
+	return []domain.SearchHit{}, nil
+}
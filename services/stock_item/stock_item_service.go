@@ -0,0 +1,459 @@
+package stock_item
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/notification/messages"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+// * Repository interface defines the contract for stock item data operations
+type Repository interface {
+	// * MUTATION
+	CreateStockItem(ctx context.Context, payload *domain.StockItem) (domain.StockItem, error)
+	UpdateStockItem(ctx context.Context, itemId string, payload *domain.UpdateStockItemPayload) (domain.StockItem, error)
+	DeleteStockItem(ctx context.Context, itemId string) error
+	CreateStockTransaction(ctx context.Context, payload *domain.StockTransaction) (domain.StockTransaction, error)
+
+	// * QUERY
+	GetStockItemsPaginated(ctx context.Context, params domain.StockItemParams) ([]domain.StockItem, error)
+	GetStockItemsCursor(ctx context.Context, params domain.StockItemParams) ([]domain.StockItem, error)
+	GetStockItemById(ctx context.Context, itemId string) (domain.StockItem, error)
+	GetLowStockItems(ctx context.Context) ([]domain.StockItem, error)
+	CheckStockItemExist(ctx context.Context, itemId string) (bool, error)
+	CheckSKUExists(ctx context.Context, sku string) (bool, error)
+	CheckSKUExistsExcluding(ctx context.Context, sku string, excludeItemId string) (bool, error)
+	CheckStockItemHasTransactions(ctx context.Context, itemId string) (bool, error)
+	CountStockItems(ctx context.Context, params domain.StockItemParams) (int64, error)
+	GetStockTransactionsPaginated(ctx context.Context, params domain.StockTransactionParams) ([]domain.StockTransaction, error)
+	GetStockTransactionsCursor(ctx context.Context, params domain.StockTransactionParams) ([]domain.StockTransaction, error)
+	CountStockTransactions(ctx context.Context, params domain.StockTransactionParams) (int64, error)
+}
+
+// * LocationService interface for checking location existence
+type LocationService interface {
+	CheckLocationExists(ctx context.Context, locationId string) (bool, error)
+}
+
+// * NotificationService interface for creating notifications
+type NotificationService interface {
+	CreateNotification(ctx context.Context, payload *domain.CreateNotificationPayload) (domain.NotificationResponse, error)
+}
+
+// * UserRepository interface for getting users to notify
+type UserRepository interface {
+	GetUsersPaginated(ctx context.Context, params domain.UserParams) ([]domain.User, error)
+}
+
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+type StockItemService interface {
+	// * MUTATION
+	CreateStockItem(ctx context.Context, payload *domain.CreateStockItemPayload, langCode string) (domain.StockItemResponse, error)
+	UpdateStockItem(ctx context.Context, itemId string, payload *domain.UpdateStockItemPayload, langCode string) (domain.StockItemResponse, error)
+	DeleteStockItem(ctx context.Context, itemId string) error
+	CreateStockTransaction(ctx context.Context, itemId string, payload *domain.CreateStockTransactionPayload, performedBy string, langCode string) (domain.StockTransactionResponse, error)
+	NotifyIfLowStock(ctx context.Context, itemId string, decreasedBy int)
+
+	// * QUERY
+	GetStockItemsPaginated(ctx context.Context, params domain.StockItemParams, langCode string) ([]domain.StockItemResponse, int64, error)
+	GetStockItemsCursor(ctx context.Context, params domain.StockItemParams, langCode string) ([]domain.StockItemResponse, error)
+	GetStockItemById(ctx context.Context, itemId string, langCode string) (domain.StockItemResponse, error)
+	GetLowStockItems(ctx context.Context, langCode string) ([]domain.StockItemResponse, error)
+	CheckStockItemExists(ctx context.Context, itemId string) (bool, error)
+	CountStockItems(ctx context.Context, params domain.StockItemParams) (int64, error)
+	GetStockTransactionsPaginated(ctx context.Context, params domain.StockTransactionParams, langCode string) ([]domain.StockTransactionResponse, int64, error)
+	GetStockTransactionsCursor(ctx context.Context, params domain.StockTransactionParams, langCode string) ([]domain.StockTransactionResponse, error)
+	CountStockTransactions(ctx context.Context, params domain.StockTransactionParams) (int64, error)
+}
+
+// * Transactor interface for writing a mutation and its audit entry in one transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Service struct {
+	Repo                Repository
+	LocationService     LocationService
+	NotificationService NotificationService
+	UserRepo            UserRepository
+	AuditLogService     AuditLogService
+	Transactor          Transactor
+}
+
+// * Ensure Service implements StockItemService interface
+var _ StockItemService = (*Service)(nil)
+
+func NewService(r Repository, locationService LocationService, notificationService NotificationService, userRepo UserRepository, auditLogService AuditLogService, transactor Transactor) StockItemService {
+	return &Service{
+		Repo:                r,
+		LocationService:     locationService,
+		NotificationService: notificationService,
+		UserRepo:            userRepo,
+		AuditLogService:     auditLogService,
+		Transactor:          transactor,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (s *Service) CreateStockItem(ctx context.Context, payload *domain.CreateStockItemPayload, langCode string) (domain.StockItemResponse, error) {
+	if skuExists, err := s.Repo.CheckSKUExists(ctx, payload.SKU); err != nil {
+		return domain.StockItemResponse{}, err
+	} else if skuExists {
+		return domain.StockItemResponse{}, domain.ErrConflictWithKey(utils.ErrStockItemSKUExistsKey)
+	}
+
+	newItem := domain.StockItem{
+		SKU:          payload.SKU,
+		ItemName:     payload.ItemName,
+		Description:  payload.Description,
+		Unit:         payload.Unit,
+		ReorderPoint: payload.ReorderPoint,
+		UnitCost:     payload.UnitCost,
+		IsActive:     true,
+	}
+
+	var createdItem domain.StockItem
+	err := s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdItem, err = s.Repo.CreateStockItem(ctx, &newItem)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordCreate(ctx, domain.AuditEntityStockItem, createdItem.ID, createdItem)
+	})
+	if err != nil {
+		return domain.StockItemResponse{}, err
+	}
+
+	return mapper.StockItemToResponse(&createdItem, langCode), nil
+}
+
+func (s *Service) UpdateStockItem(ctx context.Context, itemId string, payload *domain.UpdateStockItemPayload, langCode string) (domain.StockItemResponse, error) {
+	existingItem, err := s.Repo.GetStockItemById(ctx, itemId)
+	if err != nil {
+		return domain.StockItemResponse{}, err
+	}
+
+	if payload.SKU != nil && *payload.SKU != existingItem.SKU {
+		if skuExists, err := s.Repo.CheckSKUExistsExcluding(ctx, *payload.SKU, itemId); err != nil {
+			return domain.StockItemResponse{}, err
+		} else if skuExists {
+			return domain.StockItemResponse{}, domain.ErrConflictWithKey(utils.ErrStockItemSKUExistsKey)
+		}
+	}
+
+	var updatedItem domain.StockItem
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedItem, err = s.Repo.UpdateStockItem(ctx, itemId, payload)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityStockItem, itemId, existingItem, updatedItem)
+	})
+	if err != nil {
+		return domain.StockItemResponse{}, err
+	}
+
+	return mapper.StockItemToResponse(&updatedItem, langCode), nil
+}
+
+func (s *Service) DeleteStockItem(ctx context.Context, itemId string) error {
+	existingItem, err := s.Repo.GetStockItemById(ctx, itemId)
+	if err != nil {
+		return err
+	}
+
+	// * Ledger harus tetap utuh, item yang sudah punya transaksi cukup dinonaktifkan
+	if hasTransactions, err := s.Repo.CheckStockItemHasTransactions(ctx, itemId); err != nil {
+		return err
+	} else if hasTransactions {
+		return domain.ErrConflictWithKey(utils.ErrStockItemInUseKey)
+	}
+
+	return s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteStockItem(ctx, itemId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityStockItem, itemId, existingItem)
+	})
+}
+
+func (s *Service) CreateStockTransaction(ctx context.Context, itemId string, payload *domain.CreateStockTransactionPayload, performedBy string, langCode string) (domain.StockTransactionResponse, error) {
+	if payload.FromLocationID != nil && *payload.FromLocationID == "" {
+		payload.FromLocationID = nil
+	}
+	if payload.ToLocationID != nil && *payload.ToLocationID == "" {
+		payload.ToLocationID = nil
+	}
+
+	item, err := s.Repo.GetStockItemById(ctx, itemId)
+	if err != nil {
+		return domain.StockTransactionResponse{}, err
+	}
+
+	// * Inactive items can still be adjusted to write off what is left
+	if !item.IsActive && payload.Type != domain.StockTransactionAdjust {
+		return domain.StockTransactionResponse{}, domain.ErrBadRequestWithKey(utils.ErrStockItemInactiveKey)
+	}
+
+	if err := validateStockTransactionLocations(payload); err != nil {
+		return domain.StockTransactionResponse{}, err
+	}
+
+	for _, locationId := range []*string{payload.FromLocationID, payload.ToLocationID} {
+		if locationId == nil {
+			continue
+		}
+		if locationExists, err := s.LocationService.CheckLocationExists(ctx, *locationId); err != nil {
+			return domain.StockTransactionResponse{}, err
+		} else if !locationExists {
+			return domain.StockTransactionResponse{}, domain.ErrNotFoundWithKey(utils.ErrLocationNotFoundKey)
+		}
+	}
+
+	if payload.FromLocationID != nil && item.QuantityAt(*payload.FromLocationID) < payload.Quantity {
+		return domain.StockTransactionResponse{}, domain.ErrBadRequestWithKey(utils.ErrStockInsufficientKey)
+	}
+
+	// * Receipts carry their own price, other movements are valued at the current item cost
+	unitCost := item.UnitCost
+	if payload.Type == domain.StockTransactionReceive && payload.UnitCost != nil {
+		unitCost = payload.UnitCost
+	}
+
+	newTransaction := domain.StockTransaction{
+		ItemID:         itemId,
+		Type:           payload.Type,
+		Quantity:       payload.Quantity,
+		FromLocationID: payload.FromLocationID,
+		ToLocationID:   payload.ToLocationID,
+		UnitCost:       unitCost,
+		PerformedBy:    &performedBy,
+		Notes:          payload.Notes,
+	}
+
+	createdTransaction, err := s.Repo.CreateStockTransaction(ctx, &newTransaction)
+	if err != nil {
+		return domain.StockTransactionResponse{}, err
+	}
+
+	// * Transfer only moves stock around, the total does not change
+	if payload.FromLocationID != nil && payload.ToLocationID == nil {
+		s.NotifyIfLowStock(ctx, itemId, payload.Quantity)
+	}
+
+	return mapper.StockTransactionToResponse(&createdTransaction, langCode), nil
+}
+
+// validateStockTransactionLocations checks that the given locations match the transaction type
+func validateStockTransactionLocations(payload *domain.CreateStockTransactionPayload) error {
+	hasFrom := payload.FromLocationID != nil
+	hasTo := payload.ToLocationID != nil
+
+	var valid bool
+	switch payload.Type {
+	case domain.StockTransactionReceive:
+		valid = !hasFrom && hasTo
+	case domain.StockTransactionIssue:
+		valid = hasFrom && !hasTo
+	case domain.StockTransactionTransfer:
+		valid = hasFrom && hasTo
+	case domain.StockTransactionAdjust:
+		valid = hasFrom != hasTo
+	}
+	if !valid {
+		return domain.ErrBadRequestWithKey(utils.ErrStockTransactionLocationInvalidKey)
+	}
+
+	if hasFrom && hasTo && *payload.FromLocationID == *payload.ToLocationID {
+		return domain.ErrBadRequestWithKey(utils.ErrStockTransactionSameLocationKey)
+	}
+
+	return nil
+}
+
+// NotifyIfLowStock alerts admins when a decrease pushed the item total to or below its reorder point.
+// Only the crossing is notified so repeated issues of an already low item do not spam admins
+func (s *Service) NotifyIfLowStock(ctx context.Context, itemId string, decreasedBy int) {
+	item, err := s.Repo.GetStockItemById(ctx, itemId)
+	if err != nil {
+		log.Printf("Failed to get stock item %s for low stock check: %v", itemId, err)
+		return
+	}
+
+	if !item.IsActive {
+		return
+	}
+
+	currentQuantity := item.TotalQuantity()
+	previousQuantity := currentQuantity + decreasedBy
+
+	switch {
+	case currentQuantity == 0 && previousQuantity > 0:
+		titleKey, messageKey, params := messages.StockItemOutOfStockNotification(item.ItemName, item.SKU)
+		s.sendStockNotificationToAdmins(ctx, &item, domain.NotificationPriorityUrgent, titleKey, messageKey, params)
+	case currentQuantity <= item.ReorderPoint && previousQuantity > item.ReorderPoint:
+		titleKey, messageKey, params := messages.StockItemLowStockNotification(
+			item.ItemName,
+			item.SKU,
+			strconv.Itoa(currentQuantity),
+			item.Unit,
+			strconv.Itoa(item.ReorderPoint),
+		)
+		s.sendStockNotificationToAdmins(ctx, &item, domain.NotificationPriorityHigh, titleKey, messageKey, params)
+	}
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetStockItemsPaginated(ctx context.Context, params domain.StockItemParams, langCode string) ([]domain.StockItemResponse, int64, error) {
+	items, err := s.Repo.GetStockItemsPaginated(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// * Count total for pagination
+	count, err := s.Repo.CountStockItems(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return mapper.StockItemsToResponses(items, langCode), count, nil
+}
+
+func (s *Service) GetStockItemsCursor(ctx context.Context, params domain.StockItemParams, langCode string) ([]domain.StockItemResponse, error) {
+	items, err := s.Repo.GetStockItemsCursor(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.StockItemsToResponses(items, langCode), nil
+}
+
+func (s *Service) GetStockItemById(ctx context.Context, itemId string, langCode string) (domain.StockItemResponse, error) {
+	item, err := s.Repo.GetStockItemById(ctx, itemId)
+	if err != nil {
+		return domain.StockItemResponse{}, err
+	}
+
+	return mapper.StockItemToResponse(&item, langCode), nil
+}
+
+func (s *Service) GetLowStockItems(ctx context.Context, langCode string) ([]domain.StockItemResponse, error) {
+	items, err := s.Repo.GetLowStockItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.StockItemsToResponses(items, langCode), nil
+}
+
+func (s *Service) CheckStockItemExists(ctx context.Context, itemId string) (bool, error) {
+	return s.Repo.CheckStockItemExist(ctx, itemId)
+}
+
+func (s *Service) CountStockItems(ctx context.Context, params domain.StockItemParams) (int64, error) {
+	return s.Repo.CountStockItems(ctx, params)
+}
+
+func (s *Service) GetStockTransactionsPaginated(ctx context.Context, params domain.StockTransactionParams, langCode string) ([]domain.StockTransactionResponse, int64, error) {
+	transactions, err := s.Repo.GetStockTransactionsPaginated(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// * Count total for pagination
+	count, err := s.Repo.CountStockTransactions(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return mapper.StockTransactionsToResponses(transactions, langCode), count, nil
+}
+
+func (s *Service) GetStockTransactionsCursor(ctx context.Context, params domain.StockTransactionParams, langCode string) ([]domain.StockTransactionResponse, error) {
+	transactions, err := s.Repo.GetStockTransactionsCursor(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.StockTransactionsToResponses(transactions, langCode), nil
+}
+
+func (s *Service) CountStockTransactions(ctx context.Context, params domain.StockTransactionParams) (int64, error) {
+	return s.Repo.CountStockTransactions(ctx, params)
+}
+
+// *===========================NOTIFICATION HELPERS===========================*
+
+// sendStockNotificationToAdmins sends a stock notification to every admin user
+func (s *Service) sendStockNotificationToAdmins(ctx context.Context, item *domain.StockItem, priority domain.NotificationPriority, titleKey, messageKey messages.NotificationMessageKey, params map[string]string) {
+	if s.NotificationService == nil {
+		log.Printf("Notification service not available, skipping stock notification for stock item ID: %s", item.ID)
+		return
+	}
+
+	if s.UserRepo == nil {
+		log.Printf("User repository not available, skipping stock notification for stock item ID: %s", item.ID)
+		return
+	}
+
+	adminRole := domain.RoleAdmin
+	userParams := domain.UserParams{
+		Filters: &domain.UserFilterOptions{
+			Role: &adminRole,
+		},
+	}
+	admins, err := s.UserRepo.GetUsersPaginated(ctx, userParams)
+	if err != nil {
+		log.Printf("Failed to get admin users for stock notification: %v", err)
+		return
+	}
+
+	if len(admins) == 0 {
+		log.Printf("No admin users found, skipping stock notification for stock item ID: %s", item.ID)
+		return
+	}
+
+	utilTranslations := messages.GetStockItemNotificationTranslations(titleKey, messageKey, params)
+
+	// Convert to domain translations
+	translations := make([]domain.CreateNotificationTranslationPayload, len(utilTranslations))
+	for i, t := range utilTranslations {
+		translations[i] = domain.CreateNotificationTranslationPayload{
+			LangCode: t.LangCode,
+			Title:    t.Title,
+			Message:  t.Message,
+		}
+	}
+
+	for _, admin := range admins {
+		notificationPayload := &domain.CreateNotificationPayload{
+			UserID:            admin.ID,
+			RelatedEntityType: utils.StringPtr("stock_item"),
+			RelatedEntityID:   utils.StringPtr(item.ID),
+			Type:              domain.NotificationTypeLowStock,
+			Priority:          priority,
+			Translations:      translations,
+		}
+
+		if _, err := s.NotificationService.CreateNotification(ctx, notificationPayload); err != nil {
+			log.Printf("Failed to create stock notification for user ID: %s: %v", admin.ID, err)
+		}
+	}
+
+	log.Printf("Successfully sent stock notification to %d admin(s) for stock item ID: %s", len(admins), item.ID)
+}
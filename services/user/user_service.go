@@ -6,7 +6,7 @@ import (
 	"strings"
 
 	"github.com/Rizz404/inventory-api/domain"
-	"github.com/Rizz404/inventory-api/internal/client/cloudinary"
+	"github.com/Rizz404/inventory-api/internal/client/storage"
 	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
 	"github.com/Rizz404/inventory-api/internal/utils"
 	"github.com/oklog/ulid/v2"
@@ -21,6 +21,7 @@ type Repository interface {
 	DeleteUser(ctx context.Context, userId string) error
 	BulkDeleteUsers(ctx context.Context, userIds []string) (domain.BulkDeleteUsers, error)
 	UpdatePassword(ctx context.Context, userId string, hashedPassword string) error
+	ResetFailedLogins(ctx context.Context, userId string) error
 
 	// * QUERY
 	GetUsersPaginated(ctx context.Context, params domain.UserParams) ([]domain.User, error)
@@ -51,6 +52,7 @@ type UserService interface {
 	BulkDeleteUsers(ctx context.Context, payload *domain.BulkDeleteUsersPayload) (domain.BulkDeleteUsersResponse, error)
 	ChangePassword(ctx context.Context, userId string, payload *domain.ChangePasswordPayload) error
 	ChangeCurrentUserPassword(ctx context.Context, currentUserId string, payload *domain.ChangePasswordPayload) error
+	UnlockUser(ctx context.Context, userId string) (domain.UserResponse, error)
 
 	// * QUERY
 	GetUsersPaginated(ctx context.Context, params domain.UserParams) ([]domain.UserResponse, int64, error)
@@ -69,18 +71,41 @@ type UserService interface {
 	ExportUserList(ctx context.Context, payload domain.ExportUserListPayload, params domain.UserParams, langCode string) ([]byte, string, error)
 }
 
+// * SessionRepository interface for revoking refresh token sessions
+type SessionRepository interface {
+	RevokeUserSessions(ctx context.Context, userId string, reason domain.SessionRevokedReason) error
+}
+
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// * Transactor interface for writing a mutation and its audit entry in one transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type Service struct {
-	Repo             Repository
-	CloudinaryClient *cloudinary.Client
+	Repo            Repository
+	SessionRepo     SessionRepository
+	FileStorage     storage.Storage
+	AuditLogService AuditLogService
+	Transactor      Transactor
 }
 
 // * Ensure Service implements UserService interface
 var _ UserService = (*Service)(nil)
 
-func NewService(r Repository, cloudinaryClient *cloudinary.Client) UserService {
+func NewService(r Repository, sessionRepo SessionRepository, fileStorage storage.Storage, auditLogService AuditLogService, transactor Transactor) UserService {
 	return &Service{
-		Repo:             r,
-		CloudinaryClient: cloudinaryClient,
+		Repo:            r,
+		SessionRepo:     sessionRepo,
+		FileStorage:     fileStorage,
+		AuditLogService: auditLogService,
+		Transactor:      transactor,
 	}
 }
 
@@ -113,15 +138,15 @@ func (s *Service) CreateUser(ctx context.Context, payload *domain.CreateUserPayl
 	// * Handle avatar upload if file is provided
 	var avatarURL *string
 	if avatarFile != nil {
-		// Upload file to Cloudinary if client is available
-		if s.CloudinaryClient != nil {
+		// Upload file to file storage if client is available
+		if s.FileStorage != nil {
 			// Generate temporary user ID for avatar naming
 			tempUserID := "temp-" + ulid.Make().String()
-			uploadConfig := cloudinary.GetAvatarUploadConfig()
+			uploadConfig := storage.GetAvatarUploadConfig()
 			publicID := "user-" + tempUserID + "-avatar"
 			uploadConfig.PublicID = &publicID
 
-			uploadResult, err := s.CloudinaryClient.UploadSingleFile(ctx, avatarFile, uploadConfig)
+			uploadResult, err := s.FileStorage.UploadSingleFile(ctx, avatarFile, uploadConfig)
 			if err != nil {
 				// Provide detailed error message
 				errorMsg := "Failed to upload avatar: " + err.Error()
@@ -129,7 +154,7 @@ func (s *Service) CreateUser(ctx context.Context, payload *domain.CreateUserPayl
 			}
 			avatarURL = &uploadResult.SecureURL
 		} else {
-			return domain.UserResponse{}, domain.ErrBadRequestWithKey(utils.ErrCloudinaryConfigKey)
+			return domain.UserResponse{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
 		}
 	} else if payload.AvatarURL != nil {
 		// Use provided avatar URL from JSON/form data
@@ -155,20 +180,29 @@ func (s *Service) CreateUser(ctx context.Context, payload *domain.CreateUserPayl
 		AvatarURL:     avatarURL,
 	}
 
-	createdUser, err := s.Repo.CreateUser(ctx, &newUser)
+	var createdUser domain.User
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdUser, err = s.Repo.CreateUser(ctx, &newUser)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordCreate(ctx, domain.AuditEntityUser, createdUser.ID, createdUser)
+	})
 	if err != nil {
 		// * Repository sudah menerjemahkan error (misal: conflict), jadi langsung kembalikan
 		return domain.UserResponse{}, err
 	}
 
 	// * Update avatar public ID with actual user ID if file was uploaded
-	if avatarFile != nil && s.CloudinaryClient != nil && avatarURL != nil {
+	if avatarFile != nil && s.FileStorage != nil && avatarURL != nil {
 		// Re-upload with correct public ID
-		uploadConfig := cloudinary.GetAvatarUploadConfig()
+		uploadConfig := storage.GetAvatarUploadConfig()
 		finalPublicID := "user-" + createdUser.ID + "-avatar"
 		uploadConfig.PublicID = &finalPublicID
 
-		uploadResult, err := s.CloudinaryClient.UploadSingleFile(ctx, avatarFile, uploadConfig)
+		uploadResult, err := s.FileStorage.UploadSingleFile(ctx, avatarFile, uploadConfig)
 		if err == nil {
 			// Update user with final avatar URL
 			updatePayload := &domain.UpdateUserPayload{
@@ -253,7 +287,21 @@ func (s *Service) BulkCreateUsers(ctx context.Context, payload *domain.BulkCreat
 		}
 	}
 
-	createdUsers, err := s.Repo.BulkCreateUsers(ctx, users)
+	var createdUsers []domain.User
+	err := s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdUsers, err = s.Repo.BulkCreateUsers(ctx, users)
+		if err != nil {
+			return err
+		}
+
+		for _, createdUser := range createdUsers {
+			if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityUser, createdUser.ID, createdUser); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkCreateUsersResponse{}, err
 	}
@@ -291,16 +339,15 @@ func (s *Service) UpdateUser(ctx context.Context, userId string, payload *domain
 
 	// * Handle avatar update
 	var shouldDeleteOldAvatar bool
-	oldAvatarPublicID := "user-" + userId + "-avatar"
 
 	if avatarFile != nil {
 		// Upload new avatar file
-		if s.CloudinaryClient != nil {
-			uploadConfig := cloudinary.GetAvatarUploadConfig()
+		if s.FileStorage != nil {
+			uploadConfig := storage.GetAvatarUploadConfig()
 			publicID := "user-" + userId + "-avatar"
 			uploadConfig.PublicID = &publicID
 
-			uploadResult, err := s.CloudinaryClient.UploadSingleFile(ctx, avatarFile, uploadConfig)
+			uploadResult, err := s.FileStorage.UploadSingleFile(ctx, avatarFile, uploadConfig)
 			if err != nil {
 				// Provide detailed error message
 				errorMsg := "Failed to upload avatar: " + err.Error()
@@ -309,9 +356,9 @@ func (s *Service) UpdateUser(ctx context.Context, userId string, payload *domain
 
 			// Set new avatar URL in payload
 			payload.AvatarURL = &uploadResult.SecureURL
-			// Note: Cloudinary will automatically overwrite old avatar due to same public ID
+			// Note: Storage will automatically overwrite old avatar due to same public ID
 		} else {
-			return domain.UserResponse{}, domain.ErrBadRequestWithKey(utils.ErrCloudinaryConfigKey)
+			return domain.UserResponse{}, domain.ErrBadRequestWithKey(utils.ErrFileStorageConfigKey)
 		}
 	} else if payload.AvatarURL != nil {
 		// Handle avatar URL changes from JSON/form data
@@ -324,16 +371,34 @@ func (s *Service) UpdateUser(ctx context.Context, userId string, payload *domain
 	}
 
 	// Use the UpdateUser method
-	updatedUser, err := s.Repo.UpdateUser(ctx, userId, payload)
+	var updatedUser domain.User
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedUser, err = s.Repo.UpdateUser(ctx, userId, payload)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityUser, userId, existingUser, updatedUser); err != nil {
+			return err
+		}
+
+		// * User yang dinonaktifkan langsung kehilangan semua session
+		if existingUser.IsActive && !updatedUser.IsActive {
+			return s.SessionRepo.RevokeUserSessions(ctx, userId, domain.SessionRevokedUserDeactivated)
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.UserResponse{}, err
 	}
 
-	// * Delete old avatar from Cloudinary if needed
-	if shouldDeleteOldAvatar && s.CloudinaryClient != nil && existingUser.AvatarURL != nil && *existingUser.AvatarURL != "" {
-		// Only delete if the old avatar was stored in Cloudinary (contains our public ID pattern)
-		if strings.Contains(*existingUser.AvatarURL, "user_"+userId+"_avatar") {
-			_ = s.CloudinaryClient.DeleteFile(ctx, oldAvatarPublicID)
+	// * Delete old avatar from file storage if needed
+	if shouldDeleteOldAvatar && s.FileStorage != nil && existingUser.AvatarURL != nil && *existingUser.AvatarURL != "" {
+		// Only delete if the old avatar was stored in file storage (contains our public ID pattern)
+		oldAvatarPublicID := s.FileStorage.ExtractPublicIDFromURL(*existingUser.AvatarURL)
+		if strings.HasSuffix(oldAvatarPublicID, "user-"+userId+"-avatar") {
+			_ = s.FileStorage.DeleteFile(ctx, oldAvatarPublicID)
 			// Note: We don't return error here to avoid failing user update if avatar deletion fails
 		}
 	}
@@ -343,11 +408,19 @@ func (s *Service) UpdateUser(ctx context.Context, userId string, payload *domain
 }
 
 func (s *Service) DeleteUser(ctx context.Context, userId string) error {
-	err := s.Repo.DeleteUser(ctx, userId)
+	// * Get user data for audit trail before deletion
+	existingUser, err := s.Repo.GetUserById(ctx, userId)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteUser(ctx, userId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityUser, userId, existingUser)
+	})
 }
 
 func (s *Service) BulkDeleteUsers(ctx context.Context, payload *domain.BulkDeleteUsersPayload) (domain.BulkDeleteUsersResponse, error) {
@@ -357,7 +430,21 @@ func (s *Service) BulkDeleteUsers(ctx context.Context, payload *domain.BulkDelet
 	}
 
 	// * Perform bulk delete operation
-	result, err := s.Repo.BulkDeleteUsers(ctx, payload.IDS)
+	var result domain.BulkDeleteUsers
+	err := s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = s.Repo.BulkDeleteUsers(ctx, payload.IDS)
+		if err != nil {
+			return err
+		}
+
+		for _, deletedId := range result.DeletedIDS {
+			if err := s.AuditLogService.RecordDelete(ctx, domain.AuditEntityUser, deletedId, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return domain.BulkDeleteUsersResponse{}, err
 	}
@@ -385,11 +472,20 @@ func (s *Service) ChangePassword(ctx context.Context, userId string, payload *do
 		return domain.ErrInternal(err)
 	}
 
-	// Update repository
-	if err := s.Repo.UpdatePassword(ctx, userId, hashed); err != nil {
-		return err
-	}
-	return nil
+	return s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Update repository
+		if err := s.Repo.UpdatePassword(ctx, userId, hashed); err != nil {
+			return err
+		}
+
+		// * Password direset admin, session lama harus login ulang
+		if err := s.SessionRepo.RevokeUserSessions(ctx, userId, domain.SessionRevokedPasswordChanged); err != nil {
+			return err
+		}
+
+		// * Password hash is never stored in the audit trail, only the fact that it changed
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityUser, userId, map[string]any{"passwordChanged": false}, map[string]any{"passwordChanged": true})
+	})
 }
 
 // ChangeCurrentUserPassword allows a user to change their own password by providing the old password.
@@ -411,12 +507,43 @@ func (s *Service) ChangeCurrentUserPassword(ctx context.Context, currentUserId s
 		return domain.ErrInternal(err)
 	}
 
-	// Update password
-	if err := s.Repo.UpdatePassword(ctx, currentUserId, hashed); err != nil {
-		return err
+	return s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Update password
+		if err := s.Repo.UpdatePassword(ctx, currentUserId, hashed); err != nil {
+			return err
+		}
+
+		// * Password hash is never stored in the audit trail, only the fact that it changed
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityUser, currentUserId, map[string]any{"passwordChanged": false}, map[string]any{"passwordChanged": true})
+	})
+}
+
+// UnlockUser lifts a login lockout and clears the failed attempt counter
+func (s *Service) UnlockUser(ctx context.Context, userId string) (domain.UserResponse, error) {
+	existingUser, err := s.Repo.GetUserById(ctx, userId)
+	if err != nil {
+		return domain.UserResponse{}, err
+	}
+
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.ResetFailedLogins(ctx, userId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityUser, userId,
+			map[string]any{"failedLoginAttempts": existingUser.FailedLoginAttempts, "lockedUntil": existingUser.LockedUntil},
+			map[string]any{"failedLoginAttempts": 0, "lockedUntil": nil},
+		)
+	})
+	if err != nil {
+		return domain.UserResponse{}, err
 	}
 
-	return nil
+	unlockedUser := existingUser
+	unlockedUser.FailedLoginAttempts = 0
+	unlockedUser.LockedUntil = nil
+
+	return mapper.UserToResponse(&unlockedUser), nil
 }
 
 // *===========================QUERY===========================*
@@ -0,0 +1,245 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	defaultDispatchInterval = 10 * time.Second
+	defaultBatchSize        = 50
+	defaultConcurrency      = 5
+	defaultRequestTimeout   = 10 * time.Second
+	defaultMaxAttempts      = 8
+	defaultRetryBaseDelay   = 30 * time.Second
+	defaultRetryMaxDelay    = 6 * time.Hour
+	defaultRetentionDays    = 30
+	// * Lease harus lebih lama dari satu batch, baris yang lease-nya habis (mis. proses mati) diambil lagi
+	deliveryLease = 5 * time.Minute
+	// * Response body receiver disimpan sebagian saja untuk debugging
+	responseBodyMaxLength = 2048
+	userAgent             = "inventory-api-webhooks/1.0"
+)
+
+// DispatchRepository defines the outbox operations used by the dispatcher
+type DispatchRepository interface {
+	ClaimDueWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]domain.WebhookDelivery, error)
+	RecordWebhookDeliveryAttempt(ctx context.Context, deliveryId string, attempt *domain.WebhookDeliveryAttempt) error
+	DeleteFinishedWebhookDeliveries(ctx context.Context, before time.Time) (int64, error)
+	GetWebhookEndpointById(ctx context.Context, endpointId string) (domain.WebhookEndpoint, error)
+}
+
+// Dispatcher sends queued deliveries from the outbox and retries failures with exponential backoff
+type Dispatcher struct {
+	cron       *cron.Cron
+	repo       DispatchRepository
+	httpClient *http.Client
+}
+
+// NewDispatcher creates a new webhook dispatcher instance
+func NewDispatcher(repo DispatchRepository) *Dispatcher {
+	// Create cron instance with seconds field support
+	c := cron.New(cron.WithSeconds(), cron.WithChain(cron.SkipIfStillRunning(cron.DefaultLogger)))
+
+	return &Dispatcher{
+		cron: c,
+		repo: repo,
+		httpClient: &http.Client{
+			Timeout:   utils.EnvPositiveDuration("WEBHOOK_TIMEOUT", defaultRequestTimeout),
+			Transport: newDeliveryTransport(),
+			// * Redirect tidak diikuti, receiver harus membalas langsung dari URL yang didaftarkan
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Start begins polling the outbox unless WEBHOOK_DISPATCH_ENABLED=false
+func (d *Dispatcher) Start() error {
+	if os.Getenv("WEBHOOK_DISPATCH_ENABLED") == "false" {
+		log.Println("Webhook dispatcher disabled")
+		return nil
+	}
+
+	interval := utils.EnvPositiveDuration("WEBHOOK_DISPATCH_INTERVAL", defaultDispatchInterval)
+	if _, err := d.cron.AddFunc("@every "+interval.String(), d.dispatchDue); err != nil {
+		return err
+	}
+
+	// Prune old delivery logs daily at 3:30 AM
+	if _, err := d.cron.AddFunc("0 30 3 * * *", d.pruneDeliveries); err != nil {
+		return err
+	}
+
+	d.cron.Start()
+	log.Printf("Webhook dispatcher started, polling every %s", interval)
+	return nil
+}
+
+// Stop gracefully stops the dispatcher, running deliveries are allowed to finish
+func (d *Dispatcher) Stop() {
+	ctx := d.cron.Stop()
+	<-ctx.Done()
+	log.Println("Webhook dispatcher stopped")
+}
+
+// dispatchDue sends one batch of due deliveries, endpoint lambat tidak menahan endpoint lain karena dikirim paralel
+func (d *Dispatcher) dispatchDue() {
+	ctx := context.Background()
+
+	deliveries, err := d.repo.ClaimDueWebhookDeliveries(ctx, utils.EnvPositiveInt("WEBHOOK_DISPATCH_BATCH_SIZE", defaultBatchSize), deliveryLease)
+	if err != nil {
+		log.Printf("Failed to claim webhook deliveries: %v", err)
+		return
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	endpoints := make(map[string]*domain.WebhookEndpoint)
+	for _, delivery := range deliveries {
+		if _, loaded := endpoints[delivery.EndpointID]; loaded {
+			continue
+		}
+		endpoint, err := d.repo.GetWebhookEndpointById(ctx, delivery.EndpointID)
+		if err != nil {
+			// * Endpoint terhapus di tengah jalan, delivery ikut terhapus lewat cascade
+			log.Printf("Failed to load webhook endpoint %s: %v", delivery.EndpointID, err)
+			endpoints[delivery.EndpointID] = nil
+			continue
+		}
+		endpoints[delivery.EndpointID] = &endpoint
+	}
+
+	sem := make(chan struct{}, utils.EnvPositiveInt("WEBHOOK_DISPATCH_CONCURRENCY", defaultConcurrency))
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		endpoint := endpoints[deliveries[i].EndpointID]
+		if endpoint == nil {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(delivery *domain.WebhookDelivery) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			attempt := d.deliver(ctx, delivery, endpoint)
+			if err := d.repo.RecordWebhookDeliveryAttempt(ctx, delivery.ID, &attempt); err != nil {
+				log.Printf("Failed to record webhook delivery %s: %v", delivery.ID, err)
+			}
+		}(&deliveries[i])
+	}
+	wg.Wait()
+
+	log.Printf("Webhook dispatcher processed %d deliveries", len(deliveries))
+}
+
+// deliver posts the event to the endpoint and decides whether the delivery is retried
+func (d *Dispatcher) deliver(ctx context.Context, delivery *domain.WebhookDelivery, endpoint *domain.WebhookEndpoint) domain.WebhookDeliveryAttempt {
+	attempt := domain.WebhookDeliveryAttempt{AttemptedAt: time.Now()}
+
+	if !endpoint.IsActive {
+		// * Endpoint dinonaktifkan setelah event masuk antrean, tidak dicoba lagi tapi bisa redeliver manual
+		errMsg := "endpoint is inactive"
+		attempt.Status = domain.WebhookDeliveryStatusFailed
+		attempt.Error = &errMsg
+		return attempt
+	}
+
+	body := []byte(delivery.Payload)
+	timestamp := attempt.AttemptedAt.Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return d.failAttempt(attempt, delivery, err.Error())
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("X-Webhook-Id", delivery.EventID)
+	req.Header.Set("X-Webhook-Delivery", delivery.ID)
+	req.Header.Set("X-Webhook-Event", string(delivery.EventType))
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Webhook-Signature", utils.SignWebhookPayload(endpoint.Secret, timestamp, body))
+
+	resp, err := d.httpClient.Do(req)
+	attempt.DurationMs = time.Since(attempt.AttemptedAt).Milliseconds()
+	if err != nil {
+		return d.failAttempt(attempt, delivery, err.Error())
+	}
+	defer resp.Body.Close()
+
+	responseBody, _ := io.ReadAll(io.LimitReader(resp.Body, responseBodyMaxLength))
+	statusCode := resp.StatusCode
+	attempt.ResponseStatus = &statusCode
+	if len(responseBody) > 0 {
+		responseBodyStr := string(responseBody)
+		attempt.ResponseBody = &responseBodyStr
+	}
+
+	if statusCode < 200 || statusCode >= 300 {
+		return d.failAttempt(attempt, delivery, fmt.Sprintf("unexpected response status %d", statusCode))
+	}
+
+	attempt.Status = domain.WebhookDeliveryStatusSucceeded
+	return attempt
+}
+
+// failAttempt schedules the next retry, after WEBHOOK_MAX_ATTEMPTS the delivery is marked failed
+func (d *Dispatcher) failAttempt(attempt domain.WebhookDeliveryAttempt, delivery *domain.WebhookDelivery, errMsg string) domain.WebhookDeliveryAttempt {
+	attempt.Error = &errMsg
+
+	attempts := delivery.Attempts + 1
+	if attempts >= utils.EnvPositiveInt("WEBHOOK_MAX_ATTEMPTS", defaultMaxAttempts) {
+		attempt.Status = domain.WebhookDeliveryStatusFailed
+		log.Printf("Webhook delivery %s (%s) failed permanently after %d attempts: %s", delivery.ID, delivery.EventType, attempts, errMsg)
+		return attempt
+	}
+
+	nextAttemptAt := attempt.AttemptedAt.Add(retryDelay(attempts))
+	attempt.Status = domain.WebhookDeliveryStatusPending
+	attempt.NextAttemptAt = &nextAttemptAt
+	return attempt
+}
+
+// pruneDeliveries removes finished deliveries older than WEBHOOK_DELIVERY_RETENTION_DAYS, 0 keeps them forever
+func (d *Dispatcher) pruneDeliveries() {
+	retentionDays := utils.EnvInt("WEBHOOK_DELIVERY_RETENTION_DAYS", defaultRetentionDays)
+	if retentionDays <= 0 {
+		return
+	}
+
+	deleted, err := d.repo.DeleteFinishedWebhookDeliveries(context.Background(), time.Now().AddDate(0, 0, -retentionDays))
+	if err != nil {
+		log.Printf("Failed to prune webhook deliveries: %v", err)
+		return
+	}
+
+	log.Printf("Pruned %d webhook deliveries older than %d days", deleted, retentionDays)
+}
+
+// retryDelay doubles the wait after every failed attempt: 30s, 1m, 2m, ... sampai WEBHOOK_RETRY_MAX_DELAY
+func retryDelay(attempts int) time.Duration {
+	baseDelay := utils.EnvPositiveDuration("WEBHOOK_RETRY_BASE_DELAY", defaultRetryBaseDelay)
+	maxDelay := utils.EnvPositiveDuration("WEBHOOK_RETRY_MAX_DELAY", defaultRetryMaxDelay)
+
+	delay := baseDelay
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// errPrivateTarget is returned when an endpoint resolves to an internal address
+var errPrivateTarget = errors.New("webhook target resolves to a private address")
+
+// * Range di luar IsPrivate/IsLoopback/IsLinkLocal yang tetap tidak boleh dituju dari server
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"), // Carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("64:ff9b::/96"), // NAT64, bisa membungkus alamat IPv4 internal
+}
+
+// privateTargetsAllowed reports whether endpoints may point to internal addresses, hanya untuk development
+// atau receiver di jaringan yang sama lewat WEBHOOK_ALLOW_PRIVATE_TARGETS=true
+func privateTargetsAllowed() bool {
+	return os.Getenv("WEBHOOK_ALLOW_PRIVATE_TARGETS") == "true"
+}
+
+// isPrivateAddr reports whether the address is loopback, private, link-local (termasuk metadata cloud 169.254.169.254)
+// or another range that should never be reached by a webhook
+func isPrivateAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return true
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrivateHost catches endpoints that are obviously internal when they are registered,
+// hostname lain baru dicek setelah DNS resolve saat dispatch
+func isPrivateHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	addr, err := netip.ParseAddr(strings.Trim(host, "[]"))
+	return err == nil && isPrivateAddr(addr)
+}
+
+// rejectPrivateTarget runs after DNS resolution for every connection, jadi DNS rebinding ke alamat internal tetap ditolak
+func rejectPrivateTarget(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid dial address %q: %w", address, err)
+	}
+	if isPrivateAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", errPrivateTarget, addrPort.Addr())
+	}
+	return nil
+}
+
+// newDeliveryTransport dials webhook receivers directly, proxy dimatikan supaya pengecekan alamat berlaku untuk receiver
+func newDeliveryTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if !privateTargetsAllowed() {
+		dialer.Control = rejectPrivateTarget
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
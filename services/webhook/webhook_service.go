@@ -0,0 +1,440 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+	"github.com/Rizz404/inventory-api/internal/web"
+	"github.com/oklog/ulid/v2"
+)
+
+// * Repository interface defines the contract for webhook data operations
+type Repository interface {
+	// * MUTATION
+	CreateWebhookEndpoint(ctx context.Context, payload *domain.WebhookEndpoint) (domain.WebhookEndpoint, error)
+	UpdateWebhookEndpoint(ctx context.Context, endpointId string, payload *domain.UpdateWebhookEndpointPayload) (domain.WebhookEndpoint, error)
+	RotateWebhookEndpointSecret(ctx context.Context, endpointId string, secret string) (domain.WebhookEndpoint, error)
+	DeleteWebhookEndpoint(ctx context.Context, endpointId string) error
+	CreateWebhookDelivery(ctx context.Context, payload *domain.WebhookDelivery) (domain.WebhookDelivery, error)
+	CreateWebhookDeliveries(ctx context.Context, payloads []domain.WebhookDelivery) error
+
+	// * QUERY
+	GetWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpoint, error)
+	GetActiveWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpoint, error)
+	GetWebhookEndpointById(ctx context.Context, endpointId string) (domain.WebhookEndpoint, error)
+	CheckWebhookEndpointNameExists(ctx context.Context, name string) (bool, error)
+	CheckWebhookEndpointNameExistsExcluding(ctx context.Context, name string, excludeEndpointId string) (bool, error)
+	GetWebhookDeliveriesCursor(ctx context.Context, endpointId string, params domain.WebhookDeliveryParams) ([]domain.WebhookDelivery, error)
+	GetWebhookDeliveryById(ctx context.Context, deliveryId string) (domain.WebhookDelivery, error)
+}
+
+// * WebhookService interface defines the contract for webhook business operations
+type WebhookService interface {
+	// * MUTATION
+	CreateWebhookEndpoint(ctx context.Context, payload *domain.CreateWebhookEndpointPayload) (domain.WebhookEndpointSecretResponse, error)
+	UpdateWebhookEndpoint(ctx context.Context, endpointId string, payload *domain.UpdateWebhookEndpointPayload) (domain.WebhookEndpointResponse, error)
+	RotateWebhookEndpointSecret(ctx context.Context, endpointId string) (domain.WebhookEndpointSecretResponse, error)
+	DeleteWebhookEndpoint(ctx context.Context, endpointId string) error
+	PingWebhookEndpoint(ctx context.Context, endpointId string) (domain.WebhookDeliveryResponse, error)
+	RedeliverWebhookDelivery(ctx context.Context, endpointId string, deliveryId string) (domain.WebhookDeliveryResponse, error)
+	Emit(ctx context.Context, eventType domain.WebhookEventType, data any) error
+
+	// * QUERY
+	GetWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpointResponse, error)
+	GetWebhookEndpointById(ctx context.Context, endpointId string) (domain.WebhookEndpointResponse, error)
+	GetWebhookDeliveriesCursor(ctx context.Context, endpointId string, params domain.WebhookDeliveryParams) ([]domain.WebhookDeliveryResponse, error)
+	GetWebhookDeliveryById(ctx context.Context, endpointId string, deliveryId string) (domain.WebhookDeliveryResponse, error)
+}
+
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// * Transactor interface for writing a mutation and its audit entry in one transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Service struct {
+	Repo            Repository
+	AuditLogService AuditLogService
+	Transactor      Transactor
+}
+
+// * Ensure Service implements WebhookService interface
+var _ WebhookService = (*Service)(nil)
+
+func NewService(r Repository, auditLogService AuditLogService, transactor Transactor) WebhookService {
+	return &Service{
+		Repo:            r,
+		AuditLogService: auditLogService,
+		Transactor:      transactor,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (s *Service) CreateWebhookEndpoint(ctx context.Context, payload *domain.CreateWebhookEndpointPayload) (domain.WebhookEndpointSecretResponse, error) {
+	name := strings.TrimSpace(payload.Name)
+	if nameExists, err := s.Repo.CheckWebhookEndpointNameExists(ctx, name); err != nil {
+		return domain.WebhookEndpointSecretResponse{}, err
+	} else if nameExists {
+		return domain.WebhookEndpointSecretResponse{}, domain.ErrConflictWithKey(utils.ErrWebhookNameExistsKey)
+	}
+
+	if err := validateEndpointURL(payload.URL); err != nil {
+		return domain.WebhookEndpointSecretResponse{}, err
+	}
+
+	eventTypes, err := normalizeEventTypes(payload.EventTypes)
+	if err != nil {
+		return domain.WebhookEndpointSecretResponse{}, err
+	}
+
+	secret, err := utils.GenerateWebhookSecret()
+	if err != nil {
+		return domain.WebhookEndpointSecretResponse{}, domain.ErrInternal(err)
+	}
+
+	newEndpoint := domain.WebhookEndpoint{
+		Name:        name,
+		URL:         strings.TrimSpace(payload.URL),
+		Description: payload.Description,
+		Secret:      secret,
+		EventTypes:  eventTypes,
+		IsActive:    payload.IsActive == nil || *payload.IsActive,
+	}
+	if actorId, ok := web.GetUserIDFromRequestContext(ctx); ok {
+		newEndpoint.CreatedBy = &actorId
+	}
+
+	var createdEndpoint domain.WebhookEndpoint
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		createdEndpoint, err = s.Repo.CreateWebhookEndpoint(ctx, &newEndpoint)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordCreate(ctx, domain.AuditEntityWebhookEndpoint, createdEndpoint.ID, createdEndpoint)
+	})
+	if err != nil {
+		return domain.WebhookEndpointSecretResponse{}, err
+	}
+
+	return domain.WebhookEndpointSecretResponse{
+		WebhookEndpointResponse: mapper.WebhookEndpointToResponse(&createdEndpoint),
+		Secret:                  secret,
+	}, nil
+}
+
+func (s *Service) UpdateWebhookEndpoint(ctx context.Context, endpointId string, payload *domain.UpdateWebhookEndpointPayload) (domain.WebhookEndpointResponse, error) {
+	existingEndpoint, err := s.Repo.GetWebhookEndpointById(ctx, endpointId)
+	if err != nil {
+		return domain.WebhookEndpointResponse{}, err
+	}
+
+	if payload.Name != nil {
+		name := strings.TrimSpace(*payload.Name)
+		payload.Name = &name
+
+		if name != existingEndpoint.Name {
+			if nameExists, err := s.Repo.CheckWebhookEndpointNameExistsExcluding(ctx, name, endpointId); err != nil {
+				return domain.WebhookEndpointResponse{}, err
+			} else if nameExists {
+				return domain.WebhookEndpointResponse{}, domain.ErrConflictWithKey(utils.ErrWebhookNameExistsKey)
+			}
+		}
+	}
+
+	if payload.URL != nil {
+		endpointURL := strings.TrimSpace(*payload.URL)
+		if err := validateEndpointURL(endpointURL); err != nil {
+			return domain.WebhookEndpointResponse{}, err
+		}
+		payload.URL = &endpointURL
+	}
+
+	if payload.EventTypes != nil {
+		eventTypes, err := normalizeEventTypes(payload.EventTypes)
+		if err != nil {
+			return domain.WebhookEndpointResponse{}, err
+		}
+		payload.EventTypes = eventTypes
+	}
+
+	var updatedEndpoint domain.WebhookEndpoint
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedEndpoint, err = s.Repo.UpdateWebhookEndpoint(ctx, endpointId, payload)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityWebhookEndpoint, endpointId, existingEndpoint, updatedEndpoint)
+	})
+	if err != nil {
+		return domain.WebhookEndpointResponse{}, err
+	}
+
+	return mapper.WebhookEndpointToResponse(&updatedEndpoint), nil
+}
+
+// RotateWebhookEndpointSecret issues a new signing secret, pending retries are signed with the new secret too
+func (s *Service) RotateWebhookEndpointSecret(ctx context.Context, endpointId string) (domain.WebhookEndpointSecretResponse, error) {
+	if _, err := s.Repo.GetWebhookEndpointById(ctx, endpointId); err != nil {
+		return domain.WebhookEndpointSecretResponse{}, err
+	}
+
+	secret, err := utils.GenerateWebhookSecret()
+	if err != nil {
+		return domain.WebhookEndpointSecretResponse{}, domain.ErrInternal(err)
+	}
+
+	var rotatedEndpoint domain.WebhookEndpoint
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		rotatedEndpoint, err = s.Repo.RotateWebhookEndpointSecret(ctx, endpointId, secret)
+		if err != nil {
+			return err
+		}
+
+		// * Secret tidak ikut diff karena json:"-", rotasi dicatat sebagai perubahan eksplisit
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityWebhookEndpoint, endpointId, map[string]any{"secretRotated": false}, map[string]any{"secretRotated": true})
+	})
+	if err != nil {
+		return domain.WebhookEndpointSecretResponse{}, err
+	}
+
+	return domain.WebhookEndpointSecretResponse{
+		WebhookEndpointResponse: mapper.WebhookEndpointToResponse(&rotatedEndpoint),
+		Secret:                  secret,
+	}, nil
+}
+
+func (s *Service) DeleteWebhookEndpoint(ctx context.Context, endpointId string) error {
+	existingEndpoint, err := s.Repo.GetWebhookEndpointById(ctx, endpointId)
+	if err != nil {
+		return err
+	}
+
+	return s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteWebhookEndpoint(ctx, endpointId); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityWebhookEndpoint, endpointId, existingEndpoint)
+	})
+}
+
+// PingWebhookEndpoint queues a webhook.ping event for one endpoint regardless of its event filter
+func (s *Service) PingWebhookEndpoint(ctx context.Context, endpointId string) (domain.WebhookDeliveryResponse, error) {
+	endpoint, err := s.Repo.GetWebhookEndpointById(ctx, endpointId)
+	if err != nil {
+		return domain.WebhookDeliveryResponse{}, err
+	}
+	if !endpoint.IsActive {
+		return domain.WebhookDeliveryResponse{}, domain.ErrBadRequestWithKey(utils.ErrWebhookEndpointInactiveKey)
+	}
+
+	event, payload, err := newEvent(domain.WebhookEventPing, map[string]string{"endpointId": endpoint.ID})
+	if err != nil {
+		return domain.WebhookDeliveryResponse{}, domain.ErrInternal(err)
+	}
+
+	now := time.Now()
+	delivery, err := s.Repo.CreateWebhookDelivery(ctx, &domain.WebhookDelivery{
+		EndpointID:    endpoint.ID,
+		EventID:       event.ID,
+		EventType:     event.Type,
+		Payload:       payload,
+		Status:        domain.WebhookDeliveryStatusPending,
+		NextAttemptAt: &now,
+	})
+	if err != nil {
+		return domain.WebhookDeliveryResponse{}, err
+	}
+
+	return mapper.WebhookDeliveryToResponse(&delivery), nil
+}
+
+// RedeliverWebhookDelivery queues the same event again as a new delivery, event id tetap sama supaya receiver bisa dedupe
+func (s *Service) RedeliverWebhookDelivery(ctx context.Context, endpointId string, deliveryId string) (domain.WebhookDeliveryResponse, error) {
+	endpoint, err := s.Repo.GetWebhookEndpointById(ctx, endpointId)
+	if err != nil {
+		return domain.WebhookDeliveryResponse{}, err
+	}
+	if !endpoint.IsActive {
+		return domain.WebhookDeliveryResponse{}, domain.ErrBadRequestWithKey(utils.ErrWebhookEndpointInactiveKey)
+	}
+
+	original, err := s.getEndpointDelivery(ctx, endpointId, deliveryId)
+	if err != nil {
+		return domain.WebhookDeliveryResponse{}, err
+	}
+	if original.Status == domain.WebhookDeliveryStatusPending {
+		return domain.WebhookDeliveryResponse{}, domain.ErrConflictWithKey(utils.ErrWebhookDeliveryPendingKey)
+	}
+
+	now := time.Now()
+	delivery, err := s.Repo.CreateWebhookDelivery(ctx, &domain.WebhookDelivery{
+		EndpointID:    original.EndpointID,
+		EventID:       original.EventID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		Status:        domain.WebhookDeliveryStatusPending,
+		NextAttemptAt: &now,
+		RedeliveryOf:  &original.ID,
+	})
+	if err != nil {
+		return domain.WebhookDeliveryResponse{}, err
+	}
+
+	return mapper.WebhookDeliveryToResponse(&delivery), nil
+}
+
+// Emit queues an event for every active endpoint subscribed to it.
+// Panggil di dalam transaksi mutasinya, delivery ikut tx dari ctx (outbox) jadi tidak ada event tanpa perubahan atau sebaliknya
+func (s *Service) Emit(ctx context.Context, eventType domain.WebhookEventType, data any) error {
+	endpoints, err := s.Repo.GetActiveWebhookEndpoints(ctx)
+	if err != nil {
+		return err
+	}
+
+	var subscribed []domain.WebhookEndpoint
+	for i := range endpoints {
+		if endpoints[i].Subscribes(eventType) {
+			subscribed = append(subscribed, endpoints[i])
+		}
+	}
+	if len(subscribed) == 0 {
+		return nil
+	}
+
+	event, payload, err := newEvent(eventType, data)
+	if err != nil {
+		return domain.ErrInternal(err)
+	}
+
+	now := time.Now()
+	deliveries := make([]domain.WebhookDelivery, len(subscribed))
+	for i, endpoint := range subscribed {
+		deliveries[i] = domain.WebhookDelivery{
+			EndpointID:    endpoint.ID,
+			EventID:       event.ID,
+			EventType:     event.Type,
+			Payload:       payload,
+			Status:        domain.WebhookDeliveryStatusPending,
+			NextAttemptAt: &now,
+		}
+	}
+
+	return s.Repo.CreateWebhookDeliveries(ctx, deliveries)
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetWebhookEndpoints(ctx context.Context) ([]domain.WebhookEndpointResponse, error) {
+	endpoints, err := s.Repo.GetWebhookEndpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.WebhookEndpointsToResponses(endpoints), nil
+}
+
+func (s *Service) GetWebhookEndpointById(ctx context.Context, endpointId string) (domain.WebhookEndpointResponse, error) {
+	endpoint, err := s.Repo.GetWebhookEndpointById(ctx, endpointId)
+	if err != nil {
+		return domain.WebhookEndpointResponse{}, err
+	}
+
+	return mapper.WebhookEndpointToResponse(&endpoint), nil
+}
+
+func (s *Service) GetWebhookDeliveriesCursor(ctx context.Context, endpointId string, params domain.WebhookDeliveryParams) ([]domain.WebhookDeliveryResponse, error) {
+	if _, err := s.Repo.GetWebhookEndpointById(ctx, endpointId); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.Repo.GetWebhookDeliveriesCursor(ctx, endpointId, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.WebhookDeliveriesToResponses(deliveries), nil
+}
+
+func (s *Service) GetWebhookDeliveryById(ctx context.Context, endpointId string, deliveryId string) (domain.WebhookDeliveryResponse, error) {
+	delivery, err := s.getEndpointDelivery(ctx, endpointId, deliveryId)
+	if err != nil {
+		return domain.WebhookDeliveryResponse{}, err
+	}
+
+	return mapper.WebhookDeliveryToResponse(&delivery), nil
+}
+
+// *===========================HELPER METHODS===========================*
+// getEndpointDelivery returns the delivery only when it belongs to the endpoint in the route
+func (s *Service) getEndpointDelivery(ctx context.Context, endpointId string, deliveryId string) (domain.WebhookDelivery, error) {
+	delivery, err := s.Repo.GetWebhookDeliveryById(ctx, deliveryId)
+	if err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+	if delivery.EndpointID != endpointId {
+		return domain.WebhookDelivery{}, domain.ErrNotFound("webhook delivery")
+	}
+	return delivery, nil
+}
+
+func newEvent(eventType domain.WebhookEventType, data any) (domain.WebhookEvent, string, error) {
+	event := domain.WebhookEvent{
+		ID:        ulid.Make().String(),
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return domain.WebhookEvent{}, "", err
+	}
+	return event, string(payload), nil
+}
+
+func validateEndpointURL(rawURL string) error {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return domain.ErrBadRequestWithKey(utils.ErrWebhookURLInvalidKey)
+	}
+	if !privateTargetsAllowed() && isPrivateHost(parsed.Hostname()) {
+		return domain.ErrBadRequestWithKey(utils.ErrWebhookURLPrivateKey)
+	}
+	return nil
+}
+
+// normalizeEventTypes validates and deduplicates the subscription, "*" already covers every event
+func normalizeEventTypes(eventTypes []domain.WebhookEventType) ([]domain.WebhookEventType, error) {
+	result := make([]domain.WebhookEventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !eventType.IsValid() {
+			return nil, domain.ErrBadRequestWithKey(utils.ErrWebhookEventTypeInvalidKey)
+		}
+		if !slices.Contains(result, eventType) {
+			result = append(result, eventType)
+		}
+	}
+
+	if slices.Contains(result, domain.WebhookEventAll) {
+		return []domain.WebhookEventType{domain.WebhookEventAll}, nil
+	}
+	return result, nil
+}
@@ -0,0 +1,477 @@
+package work_order
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+
+	"github.com/signintech/gopdf"
+	"github.com/xuri/excelize/v2"
+)
+
+// ExportWorkOrderList exports work order list to PDF or Excel format
+func (s *Service) ExportWorkOrderList(ctx context.Context, payload domain.ExportWorkOrderListPayload, params domain.WorkOrderParams, langCode string) ([]byte, string, error) {
+	// Override params with payload if provided
+	if payload.SearchQuery != nil {
+		params.SearchQuery = payload.SearchQuery
+	}
+	if payload.Filters != nil {
+		params.Filters = payload.Filters
+	}
+	if payload.Sort != nil {
+		params.Sort = payload.Sort
+	}
+
+	// Get work orders without pagination
+	workOrders, err := s.Repo.GetWorkOrdersForExport(ctx, params, langCode)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Convert to responses (includes translations)
+	workOrderResponses := mapper.WorkOrdersToResponses(workOrders, langCode)
+
+	switch payload.Format {
+	case domain.ExportFormatPDF:
+		data, err := s.exportWorkOrderListToPDF(workOrderResponses, langCode)
+		if err != nil {
+			return nil, "", domain.ErrInternal(err)
+		}
+		timestamp := time.Now().Format("2006-01-02_15-04-05")
+		filename := fmt.Sprintf("work_orders_%s.pdf", timestamp)
+		return data, filename, nil
+
+	case domain.ExportFormatExcel:
+		data, err := s.exportWorkOrderListToExcel(workOrderResponses)
+		if err != nil {
+			return nil, "", domain.ErrInternal(err)
+		}
+		timestamp := time.Now().Format("2006-01-02_15-04-05")
+		filename := fmt.Sprintf("work_orders_%s.xlsx", timestamp)
+		return data, filename, nil
+
+	default:
+		return nil, "", domain.ErrBadRequest("Invalid export format")
+	}
+}
+
+// exportWorkOrderListToPDF generates PDF file for work order list using gopdf
+func (s *Service) exportWorkOrderListToPDF(workOrders []domain.WorkOrderResponse, langCode string) ([]byte, error) {
+	workDir, _ := os.Getwd()
+	fontRegularPath := filepath.Join(workDir, "assets", "fonts", "NotoSansJP-Regular.ttf")
+	fontBoldPath := filepath.Join(workDir, "assets", "fonts", "NotoSansJP-Bold.ttf")
+	logoPath := filepath.Join(workDir, "assets", "images", "fts-logo.png")
+
+	pdf := gopdf.GoPdf{}
+	pdf.Start(gopdf.Config{
+		PageSize: *gopdf.PageSizeA4Landscape,
+		Unit:     gopdf.Unit_PT,
+	})
+	pdf.AddPage()
+
+	if err := pdf.AddTTFFont("noto-regular", fontRegularPath); err != nil {
+		return nil, fmt.Errorf("failed to load regular font: %w", err)
+	}
+	if err := pdf.AddTTFFont("noto-bold", fontBoldPath); err != nil {
+		return nil, fmt.Errorf("failed to load bold font: %w", err)
+	}
+
+	pdf.SetFont("noto-regular", "", 10)
+
+	// Localized text
+	reportTitle := utils.GetLocalizedMessage(utils.PDFWorkOrderListReportKey, langCode)
+	generatedOnText := utils.GetLocalizedMessage(utils.PDFAssetGeneratedOnKey, langCode)
+	totalWorkOrdersText := utils.GetLocalizedMessage(utils.PDFWorkOrderTotalKey, langCode)
+	assetTagText := utils.GetLocalizedMessage(utils.PDFAssetAssetTagKey, langCode)
+	assetNameText := utils.GetLocalizedMessage(utils.PDFAssetAssetNameKey, langCode)
+	titleText := utils.GetLocalizedMessage(utils.PDFWorkOrderTitleKey, langCode)
+	statusText := utils.GetLocalizedMessage(utils.PDFWorkOrderStatusKey, langCode)
+	priorityText := utils.GetLocalizedMessage(utils.PDFWorkOrderPriorityKey, langCode)
+	dueDateText := utils.GetLocalizedMessage(utils.PDFWorkOrderDueDateKey, langCode)
+	assignedToText := utils.GetLocalizedMessage(utils.PDFWorkOrderAssignedToKey, langCode)
+	completedAtText := utils.GetLocalizedMessage(utils.PDFWorkOrderCompletedAtKey, langCode)
+	partsCostText := utils.GetLocalizedMessage(utils.PDFWorkOrderPartsCostKey, langCode)
+
+	marginLeft := 30.0
+	marginTop := 50.0
+	pageWidth := 842.0
+	pageHeight := 595.0
+	contentWidth := pageWidth - (marginLeft * 2)
+
+	currentY := marginTop
+	if _, err := os.Stat(logoPath); err == nil {
+		rect := &gopdf.Rect{W: 60, H: 60}
+		pdf.Image(logoPath, marginLeft, currentY-10, rect)
+
+		pdf.SetFont("noto-bold", "", 16)
+		pdf.SetX(marginLeft + 70)
+		pdf.SetY(currentY + 15)
+		pdf.Cell(nil, reportTitle)
+
+		currentY += 50
+	} else {
+		pdf.SetFont("noto-bold", "", 16)
+		titleWidth, _ := pdf.MeasureTextWidth(reportTitle)
+		pdf.SetX((pageWidth - titleWidth) / 2)
+		pdf.SetY(currentY)
+		pdf.Cell(nil, reportTitle)
+
+		currentY += 30
+	}
+
+	pdf.SetFont("noto-regular", "", 10)
+	dateText := fmt.Sprintf("%s: %s", generatedOnText, time.Now().Format("2006-01-02 15:04:05"))
+	dateWidth, _ := pdf.MeasureTextWidth(dateText)
+	pdf.SetX((pageWidth - dateWidth) / 2)
+	pdf.SetY(currentY)
+	pdf.Cell(nil, dateText)
+
+	currentY += 25
+
+	startY := currentY
+	colWidths := []float64{70, 110, 150, 70, 60, 70, 100, 70, 82}
+	headers := []string{assetTagText, assetNameText, titleText, statusText, priorityText, dueDateText, assignedToText, completedAtText, partsCostText}
+
+	pdf.SetFillColor(68, 114, 196)
+	pdf.RectFromUpperLeftWithStyle(marginLeft, startY, contentWidth, 25, "F")
+
+	pdf.SetTextColor(255, 255, 255)
+	pdf.SetFont("noto-bold", "", 9)
+
+	x := marginLeft
+	y := startY
+	for i, header := range headers {
+		pdf.SetX(x + 3)
+		pdf.SetY(y + 8)
+		pdf.Cell(nil, header)
+		x += colWidths[i]
+	}
+
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont("noto-regular", "", 8)
+	y += 25
+
+	wrapText := func(text string, maxWidth float64) []string {
+		words := []string{}
+		currentLine := ""
+
+		for _, char := range text {
+			testLine := currentLine + string(char)
+			width, _ := pdf.MeasureTextWidth(testLine)
+
+			if width > maxWidth-10 {
+				if currentLine != "" {
+					words = append(words, currentLine)
+				}
+				currentLine = string(char)
+			} else {
+				currentLine = testLine
+			}
+		}
+		if currentLine != "" {
+			words = append(words, currentLine)
+		}
+
+		if len(words) == 0 {
+			return []string{text}
+		}
+		return words
+	}
+
+	for i, workOrder := range workOrders {
+		maxLines := 1
+
+		nameLines := wrapText(workOrder.Asset.AssetName, colWidths[1])
+		if len(nameLines) > maxLines {
+			maxLines = len(nameLines)
+		}
+
+		titleLines := wrapText(workOrder.Title, colWidths[2])
+		if len(titleLines) > maxLines {
+			maxLines = len(titleLines)
+		}
+
+		rowHeight := float64(maxLines) * 12.0
+		if rowHeight < 18 {
+			rowHeight = 18
+		}
+
+		if y+rowHeight > pageHeight-40 {
+			pdf.AddPage()
+			y = marginTop
+
+			pdf.SetFillColor(68, 114, 196)
+			pdf.RectFromUpperLeftWithStyle(marginLeft, y, contentWidth, 25, "F")
+			pdf.SetTextColor(255, 255, 255)
+			pdf.SetFont("noto-bold", "", 9)
+
+			x = marginLeft
+			for j, header := range headers {
+				pdf.SetX(x + 3)
+				pdf.SetY(y + 8)
+				pdf.Cell(nil, header)
+				x += colWidths[j]
+			}
+
+			y += 25
+			pdf.SetTextColor(0, 0, 0)
+			pdf.SetFont("noto-regular", "", 8)
+		}
+
+		if i%2 == 1 {
+			pdf.SetFillColor(242, 242, 242)
+			pdf.RectFromUpperLeftWithStyle(marginLeft, y, contentWidth, rowHeight, "F")
+		}
+
+		x = marginLeft
+		cellY := y + 5
+
+		// Asset Tag
+		pdf.SetX(x + 3)
+		pdf.SetY(cellY)
+		pdf.Cell(nil, workOrder.Asset.AssetTag)
+		x += colWidths[0]
+
+		// Asset Name (multi-line)
+		for lineIdx, line := range nameLines {
+			pdf.SetX(x + 3)
+			pdf.SetY(cellY + float64(lineIdx)*10)
+			pdf.Cell(nil, line)
+		}
+		x += colWidths[1]
+
+		// Title (multi-line)
+		for lineIdx, line := range titleLines {
+			pdf.SetX(x + 3)
+			pdf.SetY(cellY + float64(lineIdx)*10)
+			pdf.Cell(nil, line)
+		}
+		x += colWidths[2]
+
+		// Status (with color)
+		switch workOrder.Status {
+		case domain.WorkOrderStatusDone:
+			pdf.SetTextColor(34, 139, 34)
+		case domain.WorkOrderStatusInProgress:
+			pdf.SetTextColor(30, 144, 255)
+		case domain.WorkOrderStatusOnHold:
+			pdf.SetTextColor(255, 140, 0)
+		case domain.WorkOrderStatusCancelled:
+			pdf.SetTextColor(128, 128, 128)
+		}
+		pdf.SetX(x + 3)
+		pdf.SetY(cellY)
+		pdf.Cell(nil, string(workOrder.Status))
+		pdf.SetTextColor(0, 0, 0)
+		x += colWidths[3]
+
+		// Priority
+		pdf.SetX(x + 3)
+		pdf.SetY(cellY)
+		pdf.Cell(nil, string(workOrder.Priority))
+		x += colWidths[4]
+
+		// Due Date (overdue in red)
+		if workOrder.IsOverdue {
+			pdf.SetTextColor(220, 20, 60)
+		}
+		pdf.SetX(x + 3)
+		pdf.SetY(cellY)
+		pdf.Cell(nil, workOrder.DueDate.Format("2006-01-02"))
+		pdf.SetTextColor(0, 0, 0)
+		x += colWidths[5]
+
+		// Assigned To
+		assignedTo := "-"
+		if workOrder.AssignedTo != nil {
+			assignedTo = workOrder.AssignedTo.FullName
+		}
+		pdf.SetX(x + 3)
+		pdf.SetY(cellY)
+		pdf.Cell(nil, assignedTo)
+		x += colWidths[6]
+
+		// Completed At
+		completedAt := "-"
+		if workOrder.CompletedAt != nil {
+			completedAt = workOrder.CompletedAt.Format("2006-01-02")
+		}
+		pdf.SetX(x + 3)
+		pdf.SetY(cellY)
+		pdf.Cell(nil, completedAt)
+		x += colWidths[7]
+
+		// Parts Cost
+		partsCost := "-"
+		if workOrder.PartsCost != nil && workOrder.PartsCost.Valid {
+			value, _ := workOrder.PartsCost.Float64()
+			partsCost = fmt.Sprintf("$%.2f", value)
+		}
+		pdf.SetX(x + 3)
+		pdf.SetY(cellY)
+		pdf.Cell(nil, partsCost)
+
+		y += rowHeight
+	}
+
+	// Footer
+	y += 15
+	if y > pageHeight-40 {
+		pdf.AddPage()
+		y = marginTop
+	}
+	pdf.SetFont("noto-bold", "", 11)
+	pdf.SetX(marginLeft)
+	pdf.SetY(y)
+	pdf.Cell(nil, fmt.Sprintf("%s: %d", totalWorkOrdersText, len(workOrders)))
+
+	var buf bytes.Buffer
+	if err := pdf.Write(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// exportWorkOrderListToExcel generates Excel file for work order list
+func (s *Service) exportWorkOrderListToExcel(workOrders []domain.WorkOrderResponse) ([]byte, error) {
+	f := excelize.NewFile()
+	defer func() {
+		if err := f.Close(); err != nil {
+			fmt.Println("Error closing Excel file:", err)
+		}
+	}()
+
+	sheetName := "Work Orders"
+	index, err := f.NewSheet(sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	f.SetActiveSheet(index)
+
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font: &excelize.Font{
+			Bold: true,
+			Size: 12,
+		},
+		Fill: excelize.Fill{
+			Type:    "pattern",
+			Color:   []string{"#4472C4"},
+			Pattern: 1,
+		},
+		Alignment: &excelize.Alignment{
+			Horizontal: "center",
+			Vertical:   "center",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	headers := []string{
+		"Asset Tag", "Asset Name", "Title", "Description", "Status",
+		"Priority", "Due Date", "Overdue", "Assigned To", "Started At",
+		"Completed At", "Completed By", "Schedule", "Issue Report", "Parts", "Parts Cost",
+	}
+
+	for col, header := range headers {
+		cell, _ := excelize.CoordinatesToCellName(col+1, 1)
+		f.SetCellValue(sheetName, cell, header)
+		f.SetCellStyle(sheetName, cell, cell, headerStyle)
+	}
+
+	for row, workOrder := range workOrders {
+		rowNum := row + 2
+
+		description := ""
+		if workOrder.Description != nil {
+			description = *workOrder.Description
+		}
+
+		overdue := "No"
+		if workOrder.IsOverdue {
+			overdue = "Yes"
+		}
+
+		assignedTo := ""
+		if workOrder.AssignedTo != nil {
+			assignedTo = workOrder.AssignedTo.FullName
+		}
+
+		startedAt := ""
+		if workOrder.StartedAt != nil {
+			startedAt = workOrder.StartedAt.Format("2006-01-02 15:04")
+		}
+
+		completedAt := ""
+		if workOrder.CompletedAt != nil {
+			completedAt = workOrder.CompletedAt.Format("2006-01-02")
+		}
+
+		completedBy := ""
+		if workOrder.CompletedBy != nil {
+			completedBy = workOrder.CompletedBy.FullName
+		}
+
+		schedule := ""
+		if workOrder.Schedule != nil {
+			schedule = workOrder.Schedule.Title
+		}
+
+		issueReport := ""
+		if workOrder.IssueReport != nil {
+			issueReport = workOrder.IssueReport.Title
+		}
+
+		parts := ""
+		for i, part := range workOrder.Parts {
+			if i > 0 {
+				parts += ", "
+			}
+			parts += fmt.Sprintf("%s x%d", part.PartName, part.Quantity)
+		}
+
+		partsCost := ""
+		if workOrder.PartsCost != nil && workOrder.PartsCost.Valid {
+			value, _ := workOrder.PartsCost.Float64()
+			partsCost = fmt.Sprintf("%.2f", value)
+		}
+
+		f.SetCellValue(sheetName, fmt.Sprintf("A%d", rowNum), workOrder.Asset.AssetTag)
+		f.SetCellValue(sheetName, fmt.Sprintf("B%d", rowNum), workOrder.Asset.AssetName)
+		f.SetCellValue(sheetName, fmt.Sprintf("C%d", rowNum), workOrder.Title)
+		f.SetCellValue(sheetName, fmt.Sprintf("D%d", rowNum), description)
+		f.SetCellValue(sheetName, fmt.Sprintf("E%d", rowNum), string(workOrder.Status))
+		f.SetCellValue(sheetName, fmt.Sprintf("F%d", rowNum), string(workOrder.Priority))
+		f.SetCellValue(sheetName, fmt.Sprintf("G%d", rowNum), workOrder.DueDate.Format("2006-01-02"))
+		f.SetCellValue(sheetName, fmt.Sprintf("H%d", rowNum), overdue)
+		f.SetCellValue(sheetName, fmt.Sprintf("I%d", rowNum), assignedTo)
+		f.SetCellValue(sheetName, fmt.Sprintf("J%d", rowNum), startedAt)
+		f.SetCellValue(sheetName, fmt.Sprintf("K%d", rowNum), completedAt)
+		f.SetCellValue(sheetName, fmt.Sprintf("L%d", rowNum), completedBy)
+		f.SetCellValue(sheetName, fmt.Sprintf("M%d", rowNum), schedule)
+		f.SetCellValue(sheetName, fmt.Sprintf("N%d", rowNum), issueReport)
+		f.SetCellValue(sheetName, fmt.Sprintf("O%d", rowNum), parts)
+		f.SetCellValue(sheetName, fmt.Sprintf("P%d", rowNum), partsCost)
+	}
+
+	for col := 1; col <= len(headers); col++ {
+		colName, _ := excelize.ColumnNumberToName(col)
+		f.SetColWidth(sheetName, colName, colName, 16)
+	}
+
+	buffer, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
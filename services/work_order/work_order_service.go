@@ -0,0 +1,594 @@
+package work_order
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Rizz404/inventory-api/domain"
+	"github.com/Rizz404/inventory-api/internal/notification/messages"
+	"github.com/Rizz404/inventory-api/internal/postgresql/mapper"
+	"github.com/Rizz404/inventory-api/internal/utils"
+)
+
+// * Repository interface defines the contract for work order data operations
+type Repository interface {
+	// * MUTATION
+	CreateWorkOrder(ctx context.Context, payload *domain.WorkOrder) (domain.WorkOrder, error)
+	UpdateWorkOrder(ctx context.Context, workOrderId string, payload *domain.UpdateWorkOrderPayload) (domain.WorkOrder, error)
+	DeleteWorkOrder(ctx context.Context, workOrder *domain.WorkOrder) error
+	AssignWorkOrder(ctx context.Context, workOrderId string, assignedTo string) (domain.WorkOrder, error)
+	UpdateWorkOrderStatus(ctx context.Context, workOrder *domain.WorkOrder, status domain.WorkOrderStatus) (domain.WorkOrder, error)
+	CompleteWorkOrder(ctx context.Context, workOrder *domain.WorkOrder, record *domain.MaintenanceRecord) (domain.WorkOrder, error)
+
+	// * QUERY
+	GetWorkOrdersPaginated(ctx context.Context, params domain.WorkOrderParams, langCode string) ([]domain.WorkOrder, error)
+	GetWorkOrdersCursor(ctx context.Context, params domain.WorkOrderParams, langCode string) ([]domain.WorkOrder, error)
+	GetWorkOrderById(ctx context.Context, workOrderId string) (domain.WorkOrder, error)
+	CheckWorkOrderExist(ctx context.Context, workOrderId string) (bool, error)
+	CountWorkOrders(ctx context.Context, params domain.WorkOrderParams) (int64, error)
+	GetWorkOrderStatistics(ctx context.Context) (domain.WorkOrderStatistics, error)
+	GetWorkOrdersForExport(ctx context.Context, params domain.WorkOrderParams, langCode string) ([]domain.WorkOrder, error)
+}
+
+// * AssetService interface for asset operations
+type AssetService interface {
+	CheckAssetExists(ctx context.Context, assetId string) (bool, error)
+}
+
+// * UserService interface for technician checks
+type UserService interface {
+	CheckUserExists(ctx context.Context, userId string) (bool, error)
+}
+
+// * MaintenanceScheduleService interface for linking work orders to schedules
+type MaintenanceScheduleService interface {
+	GetMaintenanceScheduleById(ctx context.Context, scheduleId string, langCode string) (domain.MaintenanceScheduleResponse, error)
+}
+
+// * IssueReportService interface for linking work orders to issue reports
+type IssueReportService interface {
+	GetIssueReportById(ctx context.Context, issueReportId string, langCode string) (domain.IssueReportResponse, error)
+}
+
+// * NotificationService interface for creating notifications
+type NotificationService interface {
+	CreateNotification(ctx context.Context, payload *domain.CreateNotificationPayload) (domain.NotificationResponse, error)
+}
+
+// * AuditLogService interface for recording audit trail entries
+type AuditLogService interface {
+	RecordCreate(ctx context.Context, entityType domain.AuditEntityType, entityId string, after any) error
+	RecordUpdate(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any, after any) error
+	RecordDelete(ctx context.Context, entityType domain.AuditEntityType, entityId string, before any) error
+}
+
+// * WebhookService interface for emitting outbound webhook events
+type WebhookService interface {
+	Emit(ctx context.Context, eventType domain.WebhookEventType, data any) error
+}
+
+type WorkOrderService interface {
+	// * MUTATION
+	CreateWorkOrder(ctx context.Context, payload *domain.CreateWorkOrderPayload, createdBy string, langCode string) (domain.WorkOrderResponse, error)
+	UpdateWorkOrder(ctx context.Context, workOrderId string, payload *domain.UpdateWorkOrderPayload, langCode string) (domain.WorkOrderResponse, error)
+	DeleteWorkOrder(ctx context.Context, workOrderId string) error
+	AssignWorkOrder(ctx context.Context, workOrderId string, payload *domain.AssignWorkOrderPayload, langCode string) (domain.WorkOrderResponse, error)
+	UpdateWorkOrderStatus(ctx context.Context, workOrderId string, payload *domain.UpdateWorkOrderStatusPayload, langCode string) (domain.WorkOrderResponse, error)
+	CompleteWorkOrder(ctx context.Context, workOrderId string, payload *domain.CompleteWorkOrderPayload, completedBy string, langCode string) (domain.WorkOrderResponse, error)
+
+	// * QUERY
+	GetWorkOrdersPaginated(ctx context.Context, params domain.WorkOrderParams, langCode string) ([]domain.WorkOrderResponse, int64, error)
+	GetWorkOrdersCursor(ctx context.Context, params domain.WorkOrderParams, langCode string) ([]domain.WorkOrderResponse, error)
+	GetWorkOrderById(ctx context.Context, workOrderId string, langCode string) (domain.WorkOrderResponse, error)
+	CheckWorkOrderExists(ctx context.Context, workOrderId string) (bool, error)
+	CountWorkOrders(ctx context.Context, params domain.WorkOrderParams) (int64, error)
+	GetWorkOrderStatistics(ctx context.Context) (domain.WorkOrderStatisticsResponse, error)
+	ExportWorkOrderList(ctx context.Context, payload domain.ExportWorkOrderListPayload, params domain.WorkOrderParams, langCode string) ([]byte, string, error)
+}
+
+// * Transactor interface for writing a mutation and its audit entry in one transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Service struct {
+	Repo                       Repository
+	AssetService               AssetService
+	UserService                UserService
+	MaintenanceScheduleService MaintenanceScheduleService
+	IssueReportService         IssueReportService
+	NotificationService        NotificationService
+	AuditLogService            AuditLogService
+	WebhookService             WebhookService
+	Transactor                 Transactor
+}
+
+// * Ensure Service implements WorkOrderService interface
+var _ WorkOrderService = (*Service)(nil)
+
+func NewService(r Repository, assetService AssetService, userService UserService, maintenanceScheduleService MaintenanceScheduleService, issueReportService IssueReportService, notificationService NotificationService, auditLogService AuditLogService, webhookService WebhookService, transactor Transactor) WorkOrderService {
+	return &Service{
+		Repo:                       r,
+		AssetService:               assetService,
+		UserService:                userService,
+		MaintenanceScheduleService: maintenanceScheduleService,
+		IssueReportService:         issueReportService,
+		NotificationService:        notificationService,
+		AuditLogService:            auditLogService,
+		WebhookService:             webhookService,
+		Transactor:                 transactor,
+	}
+}
+
+// *===========================MUTATION===========================*
+func (s *Service) CreateWorkOrder(ctx context.Context, payload *domain.CreateWorkOrderPayload, createdBy string, langCode string) (domain.WorkOrderResponse, error) {
+	// * Validate asset exists
+	if exists, err := s.AssetService.CheckAssetExists(ctx, payload.AssetID); err != nil {
+		return domain.WorkOrderResponse{}, err
+	} else if !exists {
+		return domain.WorkOrderResponse{}, domain.ErrNotFoundWithKey(utils.ErrAssetNotFoundKey)
+	}
+
+	// * Linked schedule and issue report must be about the same asset
+	if payload.ScheduleID != nil && *payload.ScheduleID != "" {
+		schedule, err := s.MaintenanceScheduleService.GetMaintenanceScheduleById(ctx, *payload.ScheduleID, langCode)
+		if err != nil {
+			return domain.WorkOrderResponse{}, err
+		}
+		if schedule.AssetID != payload.AssetID {
+			return domain.WorkOrderResponse{}, domain.ErrBadRequestWithKey(utils.ErrWorkOrderScheduleAssetMismatchKey)
+		}
+	}
+
+	if payload.IssueReportID != nil && *payload.IssueReportID != "" {
+		issueReport, err := s.IssueReportService.GetIssueReportById(ctx, *payload.IssueReportID, langCode)
+		if err != nil {
+			return domain.WorkOrderResponse{}, err
+		}
+		if issueReport.AssetID != payload.AssetID {
+			return domain.WorkOrderResponse{}, domain.ErrBadRequestWithKey(utils.ErrWorkOrderIssueAssetMismatchKey)
+		}
+	}
+
+	if payload.AssignedTo != nil && *payload.AssignedTo != "" {
+		if exists, err := s.UserService.CheckUserExists(ctx, *payload.AssignedTo); err != nil {
+			return domain.WorkOrderResponse{}, err
+		} else if !exists {
+			return domain.WorkOrderResponse{}, domain.ErrNotFoundWithKey(utils.ErrWorkOrderAssigneeNotFoundKey)
+		}
+	}
+
+	dueDate, err := time.ParseInLocation("2006-01-02", payload.DueDate, time.UTC)
+	if err != nil {
+		return domain.WorkOrderResponse{}, domain.ErrBadRequest("invalid due date format")
+	}
+
+	priority := domain.WorkOrderPriorityMedium
+	if payload.Priority != nil {
+		priority = *payload.Priority
+	}
+
+	newWorkOrder := domain.WorkOrder{
+		ScheduleID:    payload.ScheduleID,
+		IssueReportID: payload.IssueReportID,
+		AssetID:       payload.AssetID,
+		Status:        domain.WorkOrderStatusOpen,
+		Priority:      priority,
+		DueDate:       dueDate,
+		CreatedBy:     &createdBy,
+		Translations:  make([]domain.WorkOrderTranslation, len(payload.Translations)),
+		Parts:         make([]domain.WorkOrderPart, len(payload.Parts)),
+	}
+
+	// * Assigning on create skips the open status
+	if payload.AssignedTo != nil && *payload.AssignedTo != "" {
+		now := time.Now()
+		newWorkOrder.Status = domain.WorkOrderStatusAssigned
+		newWorkOrder.AssignedTo = payload.AssignedTo
+		newWorkOrder.AssignedAt = &now
+	}
+
+	for i, t := range payload.Translations {
+		newWorkOrder.Translations[i] = domain.WorkOrderTranslation{
+			LangCode:    t.LangCode,
+			Title:       t.Title,
+			Description: t.Description,
+		}
+	}
+	for i, p := range payload.Parts {
+		newWorkOrder.Parts[i] = domain.WorkOrderPart{
+			PartName:   p.PartName,
+			PartNumber: p.PartNumber,
+			Quantity:   p.Quantity,
+			UnitCost:   p.UnitCost,
+		}
+	}
+
+	var workOrder domain.WorkOrder
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		created, err := s.Repo.CreateWorkOrder(ctx, &newWorkOrder)
+		if err != nil {
+			return err
+		}
+
+		// * Reload to populate relations for the response and notification
+		workOrder, err = s.Repo.GetWorkOrderById(ctx, created.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordCreate(ctx, domain.AuditEntityWorkOrder, workOrder.ID, workOrder); err != nil {
+			return err
+		}
+
+		if err := s.WebhookService.Emit(ctx, domain.WebhookEventWorkOrderCreated, mapper.WorkOrderToResponse(&workOrder, mapper.DefaultLangCode)); err != nil {
+			return err
+		}
+		if workOrder.AssignedTo != nil {
+			return s.WebhookService.Emit(ctx, domain.WebhookEventWorkOrderAssigned, mapper.WorkOrderToResponse(&workOrder, mapper.DefaultLangCode))
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.WorkOrderResponse{}, err
+	}
+
+	if workOrder.AssignedTo != nil {
+		s.sendWorkOrderAssignedNotification(ctx, &workOrder)
+	}
+
+	return mapper.WorkOrderToResponse(&workOrder, langCode), nil
+}
+
+func (s *Service) UpdateWorkOrder(ctx context.Context, workOrderId string, payload *domain.UpdateWorkOrderPayload, langCode string) (domain.WorkOrderResponse, error) {
+	existing, err := s.Repo.GetWorkOrderById(ctx, workOrderId)
+	if err != nil {
+		return domain.WorkOrderResponse{}, err
+	}
+
+	if existing.Status.IsTerminal() {
+		return domain.WorkOrderResponse{}, domain.ErrBadRequestWithKey(utils.ErrWorkOrderClosedKey)
+	}
+
+	var updated domain.WorkOrder
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = s.Repo.UpdateWorkOrder(ctx, workOrderId, payload)
+		if err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityWorkOrder, workOrderId, existing, updated)
+	})
+	if err != nil {
+		return domain.WorkOrderResponse{}, err
+	}
+
+	return mapper.WorkOrderToResponse(&updated, langCode), nil
+}
+
+func (s *Service) DeleteWorkOrder(ctx context.Context, workOrderId string) error {
+	// * Keep previous state for audit trail
+	existing, err := s.Repo.GetWorkOrderById(ctx, workOrderId)
+	if err != nil {
+		return err
+	}
+
+	return s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.Repo.DeleteWorkOrder(ctx, &existing); err != nil {
+			return err
+		}
+
+		return s.AuditLogService.RecordDelete(ctx, domain.AuditEntityWorkOrder, workOrderId, existing)
+	})
+}
+
+func (s *Service) AssignWorkOrder(ctx context.Context, workOrderId string, payload *domain.AssignWorkOrderPayload, langCode string) (domain.WorkOrderResponse, error) {
+	existing, err := s.Repo.GetWorkOrderById(ctx, workOrderId)
+	if err != nil {
+		return domain.WorkOrderResponse{}, err
+	}
+
+	if existing.Status.IsTerminal() {
+		return domain.WorkOrderResponse{}, domain.ErrBadRequestWithKey(utils.ErrWorkOrderClosedKey)
+	}
+
+	if exists, err := s.UserService.CheckUserExists(ctx, payload.AssignedTo); err != nil {
+		return domain.WorkOrderResponse{}, err
+	} else if !exists {
+		return domain.WorkOrderResponse{}, domain.ErrNotFoundWithKey(utils.ErrWorkOrderAssigneeNotFoundKey)
+	}
+
+	// * Only notify when the technician actually changed
+	reassigned := existing.AssignedTo == nil || *existing.AssignedTo != payload.AssignedTo
+
+	var updated domain.WorkOrder
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = s.Repo.AssignWorkOrder(ctx, workOrderId, payload.AssignedTo)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityWorkOrder, workOrderId, existing, updated); err != nil {
+			return err
+		}
+
+		if reassigned {
+			return s.WebhookService.Emit(ctx, domain.WebhookEventWorkOrderAssigned, mapper.WorkOrderToResponse(&updated, mapper.DefaultLangCode))
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.WorkOrderResponse{}, err
+	}
+
+	if reassigned {
+		s.sendWorkOrderAssignedNotification(ctx, &updated)
+	}
+
+	return mapper.WorkOrderToResponse(&updated, langCode), nil
+}
+
+func (s *Service) UpdateWorkOrderStatus(ctx context.Context, workOrderId string, payload *domain.UpdateWorkOrderStatusPayload, langCode string) (domain.WorkOrderResponse, error) {
+	existing, err := s.Repo.GetWorkOrderById(ctx, workOrderId)
+	if err != nil {
+		return domain.WorkOrderResponse{}, err
+	}
+
+	if !existing.Status.CanTransitionTo(payload.Status) {
+		return domain.WorkOrderResponse{}, domain.ErrBadRequestWithKey(utils.ErrWorkOrderInvalidTransitionKey)
+	}
+
+	var updated domain.WorkOrder
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = s.Repo.UpdateWorkOrderStatus(ctx, &existing, payload.Status)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityWorkOrder, workOrderId, existing, updated); err != nil {
+			return err
+		}
+
+		if updated.Status == domain.WorkOrderStatusCancelled {
+			return s.WebhookService.Emit(ctx, domain.WebhookEventWorkOrderCancelled, mapper.WorkOrderToResponse(&updated, mapper.DefaultLangCode))
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.WorkOrderResponse{}, err
+	}
+
+	if updated.Status == domain.WorkOrderStatusCancelled && updated.AssignedTo != nil {
+		s.sendWorkOrderCancelledNotification(ctx, &updated)
+	}
+
+	return mapper.WorkOrderToResponse(&updated, langCode), nil
+}
+
+func (s *Service) CompleteWorkOrder(ctx context.Context, workOrderId string, payload *domain.CompleteWorkOrderPayload, completedBy string, langCode string) (domain.WorkOrderResponse, error) {
+	workOrder, err := s.Repo.GetWorkOrderById(ctx, workOrderId)
+	if err != nil {
+		return domain.WorkOrderResponse{}, err
+	}
+
+	if !workOrder.Status.CanTransitionTo(domain.WorkOrderStatusDone) {
+		return domain.WorkOrderResponse{}, domain.ErrBadRequestWithKey(utils.ErrWorkOrderInvalidTransitionKey)
+	}
+	existing := workOrder
+
+	// * Completion date defaults to now when not provided
+	completionDate := time.Now().UTC()
+	if payload.CompletionDate != nil && *payload.CompletionDate != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", *payload.CompletionDate, time.UTC)
+		if err != nil {
+			return domain.WorkOrderResponse{}, domain.ErrBadRequest("invalid completion date format")
+		}
+		completionDate = parsed
+	}
+
+	workOrder.CompletedBy = &completedBy
+	workOrder.CompletedAt = &completionDate
+
+	// * Actual cost falls back to the parts used when not given explicitly
+	actualCost := payload.ActualCost
+	if actualCost == nil {
+		actualCost = mapper.WorkOrderPartsCost(workOrder.Parts)
+	}
+
+	// * The assigned technician is the performer, otherwise whoever closes the work order
+	performedBy := completedBy
+	if workOrder.AssignedTo != nil {
+		performedBy = *workOrder.AssignedTo
+	}
+
+	// * Completing a work order is what produces the maintenance record
+	record := domain.MaintenanceRecord{
+		ScheduleID:        workOrder.ScheduleID,
+		AssetID:           workOrder.AssetID,
+		MaintenanceDate:   completionDate,
+		CompletionDate:    &completionDate,
+		DurationMinutes:   payload.DurationMinutes,
+		PerformedByUser:   &performedBy,
+		PerformedByVendor: payload.PerformedByVendor,
+		Result:            payload.Result,
+		ActualCost:        actualCost,
+		Translations:      make([]domain.MaintenanceRecordTranslation, len(workOrder.Translations)),
+	}
+	for i, t := range workOrder.Translations {
+		record.Translations[i] = domain.MaintenanceRecordTranslation{
+			LangCode: t.LangCode,
+			Title:    t.Title,
+			Notes:    payload.Notes,
+		}
+	}
+
+	var completed domain.WorkOrder
+	err = s.Transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		completed, err = s.Repo.CompleteWorkOrder(ctx, &workOrder, &record)
+		if err != nil {
+			return err
+		}
+
+		if err := s.AuditLogService.RecordUpdate(ctx, domain.AuditEntityWorkOrder, workOrderId, existing, completed); err != nil {
+			return err
+		}
+		if completed.MaintenanceRecordID != nil {
+			record.ID = *completed.MaintenanceRecordID
+			return s.AuditLogService.RecordCreate(ctx, domain.AuditEntityMaintenanceRecord, record.ID, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.WorkOrderResponse{}, err
+	}
+
+	return mapper.WorkOrderToResponse(&completed, langCode), nil
+}
+
+// *===========================QUERY===========================*
+func (s *Service) GetWorkOrdersPaginated(ctx context.Context, params domain.WorkOrderParams, langCode string) ([]domain.WorkOrderResponse, int64, error) {
+	workOrders, err := s.Repo.GetWorkOrdersPaginated(ctx, params, langCode)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := s.Repo.CountWorkOrders(ctx, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return mapper.WorkOrdersToResponses(workOrders, langCode), count, nil
+}
+
+func (s *Service) GetWorkOrdersCursor(ctx context.Context, params domain.WorkOrderParams, langCode string) ([]domain.WorkOrderResponse, error) {
+	workOrders, err := s.Repo.GetWorkOrdersCursor(ctx, params, langCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapper.WorkOrdersToResponses(workOrders, langCode), nil
+}
+
+func (s *Service) GetWorkOrderById(ctx context.Context, workOrderId string, langCode string) (domain.WorkOrderResponse, error) {
+	workOrder, err := s.Repo.GetWorkOrderById(ctx, workOrderId)
+	if err != nil {
+		return domain.WorkOrderResponse{}, err
+	}
+
+	return mapper.WorkOrderToResponse(&workOrder, langCode), nil
+}
+
+func (s *Service) CheckWorkOrderExists(ctx context.Context, workOrderId string) (bool, error) {
+	return s.Repo.CheckWorkOrderExist(ctx, workOrderId)
+}
+
+func (s *Service) CountWorkOrders(ctx context.Context, params domain.WorkOrderParams) (int64, error) {
+	count, err := s.Repo.CountWorkOrders(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *Service) GetWorkOrderStatistics(ctx context.Context) (domain.WorkOrderStatisticsResponse, error) {
+	stats, err := s.Repo.GetWorkOrderStatistics(ctx)
+	if err != nil {
+		return domain.WorkOrderStatisticsResponse{}, err
+	}
+	return mapper.WorkOrderStatisticsToResponse(&stats), nil
+}
+
+// *===========================NOTIFICATION===========================*
+
+// sendWorkOrderAssignedNotification notifies the technician that a work order was assigned to them
+func (s *Service) sendWorkOrderAssignedNotification(ctx context.Context, workOrder *domain.WorkOrder) {
+	if s.NotificationService == nil {
+		log.Printf("Notification service not available, skipping assigned notification for work order ID: %s", workOrder.ID)
+		return
+	}
+
+	title, assetName, assetTag := workOrderNotificationSubject(workOrder)
+	titleKey, messageKey, params := messages.WorkOrderAssignedNotification(title, assetName, assetTag, string(workOrder.Priority), workOrder.DueDate.Format("2006-01-02"))
+
+	priority := domain.NotificationPriorityNormal
+	switch workOrder.Priority {
+	case domain.WorkOrderPriorityCritical:
+		priority = domain.NotificationPriorityUrgent
+	case domain.WorkOrderPriorityHigh:
+		priority = domain.NotificationPriorityHigh
+	}
+
+	s.createWorkOrderNotification(ctx, workOrder, *workOrder.AssignedTo, priority, titleKey, messageKey, params)
+}
+
+// sendWorkOrderCancelledNotification notifies the assigned technician that the work is no longer needed
+func (s *Service) sendWorkOrderCancelledNotification(ctx context.Context, workOrder *domain.WorkOrder) {
+	if s.NotificationService == nil {
+		log.Printf("Notification service not available, skipping cancelled notification for work order ID: %s", workOrder.ID)
+		return
+	}
+
+	title, assetName, assetTag := workOrderNotificationSubject(workOrder)
+	titleKey, messageKey, params := messages.WorkOrderCancelledNotification(title, assetName, assetTag)
+	s.createWorkOrderNotification(ctx, workOrder, *workOrder.AssignedTo, domain.NotificationPriorityLow, titleKey, messageKey, params)
+}
+
+func (s *Service) createWorkOrderNotification(ctx context.Context, workOrder *domain.WorkOrder, userId string, priority domain.NotificationPriority, titleKey, messageKey messages.NotificationMessageKey, params map[string]string) {
+	utilTranslations := messages.GetWorkOrderNotificationTranslations(titleKey, messageKey, params)
+
+	// Convert to domain translations
+	translations := make([]domain.CreateNotificationTranslationPayload, len(utilTranslations))
+	for i, t := range utilTranslations {
+		translations[i] = domain.CreateNotificationTranslationPayload{
+			LangCode: t.LangCode,
+			Title:    t.Title,
+			Message:  t.Message,
+		}
+	}
+
+	entityType := "work_order"
+
+	notificationPayload := &domain.CreateNotificationPayload{
+		UserID:            userId,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &workOrder.ID,
+		RelatedAssetID:    &workOrder.AssetID,
+		Type:              domain.NotificationTypeMaintenance,
+		Priority:          priority,
+		Translations:      translations,
+	}
+
+	_, err := s.NotificationService.CreateNotification(ctx, notificationPayload)
+	if err != nil {
+		log.Printf("Failed to create work order notification for work order ID: %s: %v", workOrder.ID, err)
+	} else {
+		log.Printf("Successfully created work order notification for work order ID: %s, user ID: %s", workOrder.ID, userId)
+	}
+}
+
+// workOrderNotificationSubject picks the default language title and the asset identity for notification params
+func workOrderNotificationSubject(workOrder *domain.WorkOrder) (string, string, string) {
+	title := ""
+	for _, t := range workOrder.Translations {
+		if t.LangCode == mapper.DefaultLangCode {
+			title = t.Title
+			break
+		}
+	}
+	if title == "" && len(workOrder.Translations) > 0 {
+		title = workOrder.Translations[0].Title
+	}
+
+	assetName, assetTag := "", ""
+	if workOrder.Asset != nil {
+		assetName = workOrder.Asset.AssetName
+		assetTag = workOrder.Asset.AssetTag
+	}
+
+	return title, assetName, assetTag
+}